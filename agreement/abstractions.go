@@ -293,6 +293,12 @@ type Network interface {
 	// associated with the given MessageHandle.
 	Disconnect(MessageHandle)
 
+	// Ignore sends the Network a hint that the message under some
+	// protocol.Tag associated with the given MessageHandle will neither be
+	// relayed nor cause a disconnection. A proposal may carry a vote under
+	// the same MessageHandle, whose Tag tells them apart.
+	Ignore(MessageHandle, protocol.Tag)
+
 	// Start notifies the network that the agreement service is ready
 	// to start receiving messages.
 	Start()
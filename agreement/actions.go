@@ -124,7 +124,7 @@ func (a networkAction) String() string {
 }
 
 func (a networkAction) ComparableStr() string {
-	if a.Tag == protocol.AgreementVoteTag {
+	if a.Tag == protocol.AgreementVoteTag && a.T != ignore {
 		return fmt.Sprintf("%s: %2v: %3v-%2v-%2v", a.t().String(), a.Tag, a.UnauthenticatedVote.R.Round, a.UnauthenticatedVote.R.Period, a.UnauthenticatedVote.R.Step)
 	}
 	return a.String()
@@ -152,6 +152,15 @@ func (a networkAction) do(ctx context.Context, s *Service) {
 		return
 	}
 
+	switch a.T {
+	case ignore:
+		s.Network.Ignore(a.h, a.Tag)
+		return
+	case disconnect:
+		s.Network.Disconnect(a.h)
+		return
+	}
+
 	var data []byte
 	switch a.Tag {
 	case protocol.AgreementVoteTag:
@@ -172,10 +181,6 @@ func (a networkAction) do(ctx context.Context, s *Service) {
 		s.Network.Broadcast(a.Tag, data)
 	case relay:
 		s.Network.Relay(a.h, a.Tag, data)
-	case disconnect:
-		s.Network.Disconnect(a.h)
-	case ignore:
-		// pass
 	}
 }
 
@@ -457,7 +462,7 @@ func (a pseudonodeAction) do(ctx context.Context, s *Service) {
 }
 
 func ignoreAction(e messageEvent, err *serializableError) action {
-	return networkAction{T: ignore, Err: err, h: e.Input.messageHandle}
+	return networkAction{T: ignore, Err: err, h: e.Input.messageHandle, Tag: e.Input.Tag}
 }
 
 func disconnectAction(e messageEvent, err *serializableError) action {
@@ -585,6 +585,7 @@ func (n *Fuzzer) CrashNode(nodeID int) {
 	// we need to clear the timeouts, since we want to wait for the timeouts from the new agreement service.
 	n.facades[nodeID].Zero()
 	n.facades[nodeID].ClearHandlers()
+	n.facades[nodeID].ClearValidatorHandlers()
 	n.ledgers[nodeID].ClearNotifications()
 
 	n.agreementParams[nodeID].Network = gossip.WrapNetwork(n.facades[nodeID], n.log, config.GetDefaultLocal())
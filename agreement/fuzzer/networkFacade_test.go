@@ -269,6 +269,16 @@ func (n *NetworkFacade) ClearHandlers() {
 	n.mux.ClearHandlers([]network.Tag{})
 }
 
+// RegisterValidatorHandlers
+func (n *NetworkFacade) RegisterValidatorHandlers(dispatch []network.TaggedMessageValidatorHandler) {
+	n.mux.RegisterValidatorHandlers(dispatch)
+}
+
+// ClearValidatorHandlers
+func (n *NetworkFacade) ClearValidatorHandlers() {
+	n.mux.ClearValidatorHandlers([]network.Tag{})
+}
+
 // SetDownstreamFilter sets the downstream filter.
 func (n *NetworkFacade) SetDownstreamFilter(f DownstreamFilter) {
 	n.downstreamMu.Lock()
@@ -20,10 +20,14 @@ package gossip
 
 import (
 	"context"
+	"sync/atomic"
 	"time"
 
+	"github.com/algorand/go-deadlock"
+
 	"github.com/Quarkonium-chain/go-quarkonium/agreement"
 	"github.com/Quarkonium-chain/go-quarkonium/config"
+	"github.com/Quarkonium-chain/go-quarkonium/crypto"
 	"github.com/Quarkonium-chain/go-quarkonium/logging"
 	"github.com/Quarkonium-chain/go-quarkonium/network"
 	"github.com/Quarkonium-chain/go-quarkonium/network/messagetracer"
@@ -37,6 +41,7 @@ var messagesHandledByType = metrics.NewTagCounter("algod_agreement_handled_{TAG}
 var messagesDroppedTotal = metrics.MakeCounter(metrics.AgreementMessagesDropped)
 var messagesDroppedByType = metrics.NewTagCounter("algod_agreement_dropped_{TAG}", "Number of agreement {TAG} messages dropped",
 	agreementVoteMessageType, agreementProposalMessageType, agreementBundleMessageType)
+var messagesValidationTimeouts = metrics.MakeCounter(metrics.MetricName{Name: "algod_agreement_validation_timeouts", Description: "Number of agreement messages agreement did not decide on before their gossip validator gave up"})
 
 const (
 	agreementVoteMessageType     = "vote"
@@ -44,8 +49,65 @@ const (
 	agreementBundleMessageType   = "bundle"
 )
 
+// validationTimeout bounds how long a gossip validator waits for agreement to decide on a message.
+// Agreement relays, ignores or disconnects the sender of the messages it processes, so this only
+// matters for messages it never decides on, such as those whose verification is cancelled.
+const validationTimeout = 2 * time.Second
+
 type messageMetadata struct {
 	raw network.IncomingMessage
+	// validated is set for messages received through a synchronous validator
+	validated bool
+}
+
+// pendingValidations holds the verdict channels of validators waiting for agreement to
+// decide on a message, keyed by message content: the same vote or proposal may arrive over
+// several networks and relaying any copy of it settles the validation.
+type pendingValidations struct {
+	mu       deadlock.Mutex
+	verdicts map[crypto.Digest]chan network.ForwardingPolicy
+	count    atomic.Int32
+}
+
+func (p *pendingValidations) add(key crypto.Digest) (chan network.ForwardingPolicy, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if _, has := p.verdicts[key]; has {
+		return nil, false
+	}
+	if p.verdicts == nil {
+		p.verdicts = make(map[crypto.Digest]chan network.ForwardingPolicy)
+	}
+	verdict := make(chan network.ForwardingPolicy, 1)
+	p.verdicts[key] = verdict
+	p.count.Add(1)
+	return verdict, true
+}
+
+func (p *pendingValidations) remove(key crypto.Digest) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	delete(p.verdicts, key)
+	p.count.Add(-1)
+}
+
+// resolve hands the action to the validator waiting on data, if any, and reports whether there was one.
+func (p *pendingValidations) resolve(data []byte, action network.ForwardingPolicy) bool {
+	if p.count.Load() == 0 {
+		return false
+	}
+	key := crypto.Hash(data)
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	verdict, has := p.verdicts[key]
+	if !has {
+		return false
+	}
+	select {
+	case verdict <- action:
+	default:
+	}
+	return true
 }
 
 // networkImpl wraps network.GossipNode to provide a compatible interface with agreement.
@@ -58,6 +120,9 @@ type networkImpl struct {
 	log logging.Logger
 
 	trace messagetracer.MessageTracer
+
+	pending           pendingValidations
+	validationTimeout time.Duration
 }
 
 // WrapNetwork adapts a network.GossipNode into an agreement.Network.
@@ -70,6 +135,7 @@ func WrapNetwork(net network.GossipNode, log logging.Logger, cfg config.Local) a
 
 	i.net = net
 	i.log = log
+	i.validationTimeout = validationTimeout
 
 	return i
 }
@@ -87,6 +153,13 @@ func (i *networkImpl) Start() {
 		{Tag: protocol.VoteBundleTag, MessageHandler: network.HandlerFunc(i.processBundleMessage)},
 	}
 	i.net.RegisterHandlers(handlers)
+
+	// votes and proposals relayed over gossipsub topics need a decision before they are forwarded
+	validators := []network.TaggedMessageValidatorHandler{
+		{Tag: protocol.AgreementVoteTag, MessageHandler: network.ValidateHandleFunc(i.validateVoteMessage)},
+		{Tag: protocol.ProposalPayloadTag, MessageHandler: network.ValidateHandleFunc(i.validateProposalMessage)},
+	}
+	i.net.RegisterValidatorHandlers(validators)
 }
 
 func messageMetadataFromHandle(h agreement.MessageHandle) *messageMetadata {
@@ -113,22 +186,64 @@ func (i *networkImpl) processBundleMessage(raw network.IncomingMessage) network.
 
 // i.e. process<Type>Message
 func (i *networkImpl) processMessage(raw network.IncomingMessage, submit chan<- agreement.Message, msgType string) network.OutgoingMessage {
-	metadata := &messageMetadata{raw: raw}
+	i.submitMessage(&messageMetadata{raw: raw}, submit, msgType)
+
+	// Immediately ignore everything here, sometimes Relay/Broadcast/Disconnect later based on API handles saved from IncomingMessage
+	return network.OutgoingMessage{Action: network.Ignore}
+}
 
+func (i *networkImpl) submitMessage(metadata *messageMetadata, submit chan<- agreement.Message, msgType string) bool {
 	select {
-	case submit <- agreement.Message{MessageHandle: agreement.MessageHandle(metadata), Data: raw.Data}:
+	case submit <- agreement.Message{MessageHandle: agreement.MessageHandle(metadata), Data: metadata.raw.Data}:
 		// It would be slightly better to measure at de-queue
 		// time, but that happens in many places in code and
 		// this is much easier.
 		messagesHandledTotal.Inc(nil)
 		messagesHandledByType.Add(msgType, 1)
+		return true
 	default:
 		messagesDroppedTotal.Inc(nil)
 		messagesDroppedByType.Add(msgType, 1)
+		return false
 	}
+}
 
-	// Immediately ignore everything here, sometimes Relay/Broadcast/Disconnect later based on API handles saved from IncomingMessage
-	return network.OutgoingMessage{Action: network.Ignore}
+func (i *networkImpl) validateVoteMessage(raw network.IncomingMessage) network.OutgoingMessage {
+	return i.validateMessage(raw, i.voteCh, agreementVoteMessageType)
+}
+
+func (i *networkImpl) validateProposalMessage(raw network.IncomingMessage) network.OutgoingMessage {
+	if i.trace != nil {
+		i.trace.HashTrace(messagetracer.Proposal, raw.Data)
+	}
+	return i.validateMessage(raw, i.proposalCh, agreementProposalMessageType)
+}
+
+// validateMessage submits the message to agreement and blocks until agreement relays it (Accept), ignores
+// it (Ignore) or asks to disconnect its sender (Disconnect). The message is ignored if agreement has not
+// decided once validationTimeout expires.
+func (i *networkImpl) validateMessage(raw network.IncomingMessage, submit chan<- agreement.Message, msgType string) network.OutgoingMessage {
+	key := crypto.Hash(raw.Data)
+	verdict, ok := i.pending.add(key)
+	if !ok {
+		// the same message is already being validated
+		return network.OutgoingMessage{Action: network.Ignore}
+	}
+	defer i.pending.remove(key)
+
+	if !i.submitMessage(&messageMetadata{raw: raw, validated: true}, submit, msgType) {
+		return network.OutgoingMessage{Action: network.Ignore}
+	}
+
+	timer := time.NewTimer(i.validationTimeout)
+	defer timer.Stop()
+	select {
+	case action := <-verdict:
+		return network.OutgoingMessage{Action: action}
+	case <-timer.C:
+		messagesValidationTimeouts.Inc(nil)
+		return network.OutgoingMessage{Action: network.Ignore}
+	}
 }
 
 func (i *networkImpl) Messages(t protocol.Tag) <-chan agreement.Message {
@@ -161,7 +276,14 @@ func (i *networkImpl) Relay(h agreement.MessageHandle, t protocol.Tag, data []by
 			i.log.Infof("agreement: could not (pseudo)relay message with tag %v: %v", t, err)
 		}
 	} else {
-		err = i.net.Relay(context.Background(), t, data, false, metadata.raw.Sender)
+		except := metadata.raw.Sender
+		// a relayed message settles the validation of any of its copies, whichever network they came from
+		if !i.pending.resolve(data, network.Accept) && metadata.validated {
+			// the validator gave up or the relayed encoding differs: relay to every peer
+			// and let gossipsub deduplicate the bytes it has already seen
+			except = nil
+		}
+		err = i.net.Relay(context.Background(), t, data, false, except)
 		if err != nil {
 			i.log.Infof("agreement: could not relay message from %v with tag %v: %v", metadata.raw.Sender, t, err)
 		}
@@ -177,9 +299,22 @@ func (i *networkImpl) Disconnect(h agreement.MessageHandle) {
 		return
 	}
 
+	if metadata.validated {
+		i.pending.resolve(metadata.raw.Data, network.Disconnect)
+	}
 	i.net.Disconnect(metadata.raw.Sender)
 }
 
+func (i *networkImpl) Ignore(h agreement.MessageHandle, t protocol.Tag) {
+	metadata := messageMetadataFromHandle(h)
+
+	// ignoring the vote carried by a proposal does not settle the validation of the proposal
+	if metadata == nil || !metadata.validated || metadata.raw.Tag != t {
+		return
+	}
+	i.pending.resolve(metadata.raw.Data, network.Ignore)
+}
+
 // broadcastTimeout is currently only used by test code.
 // In test code we want to queue up a bunch of outbound packets and then see that they got through, so we need to wait at least a little bit for them to all go out.
 // Normal agreement state machine code uses GossipNode.Broadcast non-blocking and may drop outbound packets.
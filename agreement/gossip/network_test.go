@@ -25,6 +25,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/algorand/go-deadlock"
 
@@ -96,6 +97,11 @@ func (w *whiteholeNetwork) ClearHandlers() {
 	w.mux.ClearHandlers([]network.Tag{})
 }
 
+// RegisterValidatorHandlers registers the set of given message validators.
+func (w *whiteholeNetwork) RegisterValidatorHandlers(dispatch []network.TaggedMessageValidatorHandler) {
+	w.mux.RegisterValidatorHandlers(dispatch)
+}
+
 func (w *whiteholeNetwork) Address() (string, bool) {
 	return "", false
 }
@@ -400,3 +406,68 @@ func TestNetworkImpl(t *testing.T) {
 	domain.reconnectNetwork(net1, net2, net3)
 
 }
+
+func TestNetworkImplValidator(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	t.Parallel()
+
+	domain := &whiteholeDomain{
+		messages: make([]sentMessage, 0),
+		peerIdx:  uint32(0),
+		log:      logging.TestingLog(t),
+	}
+	domain.messagesCond = sync.NewCond(&domain.messagesMu)
+	w := makewhiteholeNetwork(domain)
+	netImpl := WrapNetwork(w, logging.TestingLog(t), config.GetDefaultLocal()).(*networkImpl)
+	netImpl.Start()
+
+	validate := func(tag protocol.Tag, data []byte) <-chan network.OutgoingMessage {
+		out := make(chan network.OutgoingMessage, 1)
+		go func() {
+			out <- w.mux.ValidateHandle(network.IncomingMessage{Tag: tag, Data: data})
+		}()
+		return out
+	}
+
+	// relaying the message accepts it
+	out := validate(protocol.AgreementVoteTag, []byte{1})
+	msg := <-netImpl.Messages(protocol.AgreementVoteTag)
+	require.Equal(t, []byte{1}, msg.Data)
+	require.NoError(t, netImpl.Relay(msg.MessageHandle, protocol.AgreementVoteTag, msg.Data))
+	require.Equal(t, network.Accept, (<-out).Action)
+
+	// disconnecting rejects it
+	out = validate(protocol.ProposalPayloadTag, []byte{2})
+	msg = <-netImpl.Messages(protocol.ProposalPayloadTag)
+	netImpl.Disconnect(msg.MessageHandle)
+	require.Equal(t, network.Disconnect, (<-out).Action)
+
+	// relaying a copy received over another network settles the validation as well
+	out = validate(protocol.AgreementVoteTag, []byte{3})
+	<-netImpl.Messages(protocol.AgreementVoteTag)
+	netImpl.processVoteMessage(network.IncomingMessage{Tag: protocol.AgreementVoteTag, Data: []byte{3}})
+	msg = <-netImpl.Messages(protocol.AgreementVoteTag)
+	require.False(t, msg.MessageHandle.(*messageMetadata).validated)
+	require.NoError(t, netImpl.Relay(msg.MessageHandle, protocol.AgreementVoteTag, msg.Data))
+	require.Equal(t, network.Accept, (<-out).Action)
+
+	// ignoring it settles the validation right away, but ignoring the vote a proposal carries does not
+	out = validate(protocol.ProposalPayloadTag, []byte{5})
+	msg = <-netImpl.Messages(protocol.ProposalPayloadTag)
+	netImpl.Ignore(msg.MessageHandle, protocol.AgreementVoteTag)
+	select {
+	case <-out:
+		require.Fail(t, "ignoring the vote of a proposal settled its validation")
+	case <-time.After(10 * time.Millisecond):
+	}
+	netImpl.Ignore(msg.MessageHandle, protocol.ProposalPayloadTag)
+	require.Equal(t, network.Ignore, (<-out).Action)
+
+	// messages agreement does not act upon are ignored once the timeout expires
+	netImpl.validationTimeout = 10 * time.Millisecond
+	out = validate(protocol.AgreementVoteTag, []byte{4})
+	<-netImpl.Messages(protocol.AgreementVoteTag)
+	require.Equal(t, network.Ignore, (<-out).Action)
+	require.Zero(t, netImpl.pending.count.Load())
+}
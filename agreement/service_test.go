@@ -510,6 +510,8 @@ func (e *testingNetworkEndpoint) Disconnect(h MessageHandle) {
 	e.parent.disconnect(e.id, sourceID)
 }
 
+func (e *testingNetworkEndpoint) Ignore(h MessageHandle, t protocol.Tag) {}
+
 func (e *testingNetworkEndpoint) Start() {}
 
 type activityMonitor struct {
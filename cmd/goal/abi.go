@@ -0,0 +1,225 @@
+// Copyright (C) 2019-2024 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/algorand/avm-abi/abi"
+	"github.com/spf13/cobra"
+
+	"github.com/Quarkonium-chain/go-quarkonium/cmd/util/datadir"
+	"github.com/Quarkonium-chain/go-quarkonium/data/basics"
+	"github.com/Quarkonium-chain/go-quarkonium/libgoal"
+)
+
+var (
+	abiContractFile string
+	abiDecodeTxID   string
+	// abiContractFiles are contract descriptions given on the command line,
+	// tried for the calls of applications with no registered contract
+	abiContractFiles []string
+)
+
+func init() {
+	appCmd.AddCommand(appABICmd)
+
+	appABICmd.AddCommand(appABIRegisterCmd)
+	appABICmd.AddCommand(appABIListCmd)
+	appABICmd.AddCommand(appABIRemoveCmd)
+	appABICmd.AddCommand(appABIDecodeCmd)
+
+	appABIRegisterCmd.Flags().Uint64Var(&appIdx, "app-id", 0, "Application ID")
+	appABIRegisterCmd.Flags().StringVar(&abiContractFile, "contract", "", "ARC-4 contract JSON or ARC-56 application specification file")
+	panicIfErr(appABIRegisterCmd.MarkFlagRequired("app-id"))
+	panicIfErr(appABIRegisterCmd.MarkFlagRequired("contract"))
+
+	appABIRemoveCmd.Flags().Uint64Var(&appIdx, "app-id", 0, "Application ID")
+	panicIfErr(appABIRemoveCmd.MarkFlagRequired("app-id"))
+
+	appABIDecodeCmd.Flags().StringVar(&abiDecodeTxID, "txid", "", "ID of the pending or recently confirmed transaction to decode")
+	appABIDecodeCmd.Flags().StringArrayVar(&abiContractFiles, "abi", nil, "ARC-4 or ARC-56 contract file to decode the calls of applications with no registered contract")
+	panicIfErr(appABIDecodeCmd.MarkFlagRequired("txid"))
+}
+
+var appABICmd = &cobra.Command{
+	Use:   "abi",
+	Short: "Manage the ABI contracts used to decode application calls",
+	Long:  `Register the ARC-4 contract JSON, or the ARC-56 application specification, of applications. The method arguments, return values and ARC-28 events of the calls of registered applications are decoded by goal app method, goal app abi decode, goal clerk inspect and goal clerk simulate.`,
+	Args:  cobra.ArbitraryArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		// If no arguments passed, we should fallback to help
+		cmd.HelpFunc()(cmd, args)
+	},
+}
+
+var appABIRegisterCmd = &cobra.Command{
+	Use:   "register",
+	Short: "Register the ABI contract of an application",
+	Args:  validateNoPosArgsFn,
+	Run: func(cmd *cobra.Command, _ []string) {
+		dataDir := datadir.EnsureSingleDataDir()
+		client := ensureGoalClient(dataDir, libgoal.DynamicClient)
+		data, err := readFile(abiContractFile)
+		if err != nil {
+			reportErrorf(fileReadError, abiContractFile, err)
+		}
+		err = client.RegisterABIContract(basics.AppIndex(appIdx), data)
+		if err != nil {
+			reportErrorf("Cannot register the contract of app %d: %v", appIdx, err)
+		}
+		reportInfof("Registered the contract in %s for app %d", abiContractFile, appIdx)
+	},
+}
+
+var appABIListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the applications with a registered ABI contract",
+	Args:  validateNoPosArgsFn,
+	Run: func(cmd *cobra.Command, _ []string) {
+		dataDir := datadir.EnsureSingleDataDir()
+		client := ensureGoalClient(dataDir, libgoal.DynamicClient)
+		contracts, err := client.LoadABIContracts()
+		if err != nil {
+			reportErrorf("Cannot load the registered contracts: %v", err)
+		}
+		apps, err := client.RegisteredABIContracts()
+		if err != nil {
+			reportErrorf("Cannot load the registered contracts: %v", err)
+		}
+		for _, app := range apps {
+			contract := contracts.ByApp[app]
+			fmt.Printf("%d\t%s\t%d methods\n", app, contract.Name, len(contract.Methods))
+		}
+	},
+}
+
+var appABIRemoveCmd = &cobra.Command{
+	Use:   "remove",
+	Short: "Forget the ABI contract registered for an application",
+	Args:  validateNoPosArgsFn,
+	Run: func(cmd *cobra.Command, _ []string) {
+		dataDir := datadir.EnsureSingleDataDir()
+		client := ensureGoalClient(dataDir, libgoal.DynamicClient)
+		err := client.RemoveABIContract(basics.AppIndex(appIdx))
+		if os.IsNotExist(err) {
+			reportErrorf("No contract registered for app %d", appIdx)
+		}
+		if err != nil {
+			reportErrorf("Cannot remove the contract of app %d: %v", appIdx, err)
+		}
+	},
+}
+
+var appABIDecodeCmd = &cobra.Command{
+	Use:   "decode",
+	Short: "Decode the method call, return value and events of a transaction",
+	Long:  `Decode a pending or recently confirmed application call, and its inner application calls, with the registered ABI contracts.`,
+	Args:  validateNoPosArgsFn,
+	Run: func(cmd *cobra.Command, _ []string) {
+		dataDir, client := getDataDirAndClient()
+		contracts := loadABIContracts(dataDir)
+		info, err := client.ParsedPendingTransaction(abiDecodeTxID)
+		if err != nil {
+			reportErrorf(errorRequestFail, err)
+		}
+		call, ok := contracts.DecodeTxInfo(info)
+		if !ok {
+			reportErrorf("Transaction %s is not a call of a method of a known contract", abiDecodeTxID)
+		}
+		writeABICall(os.Stdout, "", call)
+	},
+}
+
+// loadABIContracts returns the contracts registered in the data dir, if
+// there is one, and the contracts of the --abi files
+func loadABIContracts(dataDir string) (contracts libgoal.ABIContracts) {
+	if dataDir != "" {
+		client, err := getGoalClient(dataDir, libgoal.DynamicClient)
+		if err == nil {
+			contracts, err = client.LoadABIContracts()
+		}
+		if err != nil {
+			reportWarnf("Cannot load the registered ABI contracts: %v", err)
+		}
+	}
+	for _, filename := range abiContractFiles {
+		data, err := readFile(filename)
+		if err != nil {
+			reportErrorf(fileReadError, filename, err)
+		}
+		contract, err := libgoal.ParseABIContract(data)
+		if err != nil {
+			reportErrorf("%s: %v", filename, err)
+		}
+		contracts.Fallbacks = append(contracts.Fallbacks, contract)
+	}
+	return
+}
+
+func formatABIValue(value libgoal.DecodedABIValue) string {
+	var formatted string
+	switch {
+	case value.Error != "" && len(value.Raw) > 0:
+		formatted = fmt.Sprintf("<%s, raw %s>", value.Error, hex.EncodeToString(value.Raw))
+	case value.Error != "":
+		formatted = fmt.Sprintf("<%s>", value.Error)
+	case abi.IsTransactionType(value.Type):
+		formatted = fmt.Sprintf("<%s transaction>", value.Type)
+	default:
+		formatted = string(value.Value)
+	}
+	if value.Name != "" {
+		return value.Name + ": " + formatted
+	}
+	return formatted
+}
+
+func formatABIEvent(event libgoal.DecodedABIEvent) string {
+	fields := make([]string, len(event.Args))
+	for i, arg := range event.Args {
+		fields[i] = formatABIValue(arg)
+	}
+	return event.Name + "(" + strings.Join(fields, ", ") + ")"
+}
+
+// writeABICall writes a decoded call, its events and its inner calls, one per
+// line, with arguments and event fields as JSON
+func writeABICall(w io.Writer, indent string, call libgoal.DecodedABICall) {
+	if call.Method != "" {
+		args := make([]string, len(call.Args))
+		for i, arg := range call.Args {
+			args[i] = formatABIValue(arg)
+		}
+		fmt.Fprintf(w, "%sapp %d: %s(%s)\n", indent, call.AppID, call.Method[:strings.IndexByte(call.Method, '(')], strings.Join(args, ", "))
+		if call.Return != nil {
+			fmt.Fprintf(w, "%s  returned %s\n", indent, formatABIValue(*call.Return))
+		}
+	} else {
+		fmt.Fprintf(w, "%sapp %d\n", indent, call.AppID)
+	}
+	for _, event := range call.Events {
+		fmt.Fprintf(w, "%s  event %s\n", indent, formatABIEvent(event))
+	}
+	for _, inner := range call.InnerCalls {
+		writeABICall(w, indent+"  ", inner)
+	}
+}
@@ -125,7 +125,7 @@ func init() {
 	updateAppCmd.Flags().StringVarP(&account, "from", "f", "", "Account to send update transaction from")
 	methodAppCmd.Flags().StringVarP(&account, "from", "f", "", "Account to call method from")
 
-	methodAppCmd.Flags().StringVar(&method, "method", "", "Method to be called")
+	methodAppCmd.Flags().StringVar(&method, "method", "", "Method to be called, by signature, or by name for an app with a registered ABI contract")
 	methodAppCmd.Flags().StringArrayVar(&methodArgs, "arg", nil, "Args to pass in for calling a method")
 	methodAppCmd.Flags().StringVar(&onCompletion, "on-completion", "NoOp", "OnCompletion action for application transaction")
 	methodAppCmd.Flags().BoolVar(&methodCreatesApp, "create", false, "Create an application in this method call")
@@ -1294,6 +1294,27 @@ var methodAppCmd = &cobra.Command{
 			approvalProg, clearProg = mustParseProgArgs()
 		}
 
+		// the registered contract of the app resolves a method given by name,
+		// and decodes the events of the call
+		var contract *libgoal.ABIContract
+		if appIdx != 0 {
+			var contractErr error
+			contract, contractErr = client.ABIContract(basics.AppIndex(appIdx))
+			if contractErr != nil {
+				reportWarnf("Cannot load the registered contract of app %d: %v", appIdx, contractErr)
+			}
+		}
+		if !strings.Contains(method, "(") {
+			if contract == nil {
+				reportErrorf("--method %s is not a method signature, and app %d has no registered contract", method, appIdx)
+			}
+			abiMethod, methodErr := contract.MethodByName(method)
+			if methodErr != nil {
+				reportErrorf("%v", methodErr)
+			}
+			method = abiMethod.Signature()
+		}
+
 		var applicationArgs [][]byte
 
 		// insert the method selector hash
@@ -1484,6 +1505,15 @@ var methodAppCmd = &cobra.Command{
 				reportInfof("Created app with app index %d", *resp.ApplicationIndex)
 			}
 
+			if contract != nil && resp.Logs != nil {
+				call, decodeErr := libgoal.DecodeABICall(contract, appCallTxn, *resp.Logs)
+				if decodeErr == nil {
+					for _, event := range call.Events {
+						reportInfof("method %s emitted event %s", method, formatABIEvent(event))
+					}
+				}
+			}
+
 			if retType == nil {
 				reportInfof("method %s succeeded", method)
 				return
@@ -17,9 +17,12 @@
 package main
 
 import (
+	"encoding/json"
 	"fmt"
+	"strings"
 	"testing"
 
+	"github.com/Quarkonium-chain/go-quarkonium/libgoal"
 	"github.com/Quarkonium-chain/go-quarkonium/test/partitiontest"
 	"github.com/stretchr/testify/require"
 )
@@ -144,3 +147,33 @@ func TestParseMethodArgJSONtoByteSlice(t *testing.T) {
 		})
 	}
 }
+
+func TestWriteABICall(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	call := libgoal.DecodedABICall{
+		AppID:  9,
+		Method: "transfer(pay,account,uint64)bool",
+		Args: []libgoal.DecodedABIValue{
+			{Name: "payment", Type: "pay"},
+			{Name: "to", Type: "account", Error: "account reference 2 is out of range"},
+			{Name: "amount", Type: "uint64", Value: json.RawMessage(`500`)},
+		},
+		Return: &libgoal.DecodedABIValue{Type: "bool", Raw: []byte{0x01, 0x02}, Error: "bad bool"},
+		Events: []libgoal.DecodedABIEvent{
+			{Name: "Burn", Args: []libgoal.DecodedABIValue{{Name: "amount", Type: "uint64", Value: json.RawMessage(`3`)}}},
+		},
+		InnerCalls: []libgoal.DecodedABICall{
+			{AppID: 8, InnerCalls: []libgoal.DecodedABICall{{AppID: 7, Method: "noop()void", Args: []libgoal.DecodedABIValue{}}}},
+		},
+	}
+	var out strings.Builder
+	writeABICall(&out, "", call)
+	require.Equal(t, `app 9: transfer(payment: <pay transaction>, to: <account reference 2 is out of range>, amount: 500)
+  returned <bad bool, raw 0102>
+  event Burn(amount: 3)
+  app 8
+    app 7: noop()
+`, out.String())
+}
@@ -17,12 +17,14 @@
 package main
 
 import (
+	"bytes"
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"io"
 	"os"
 	"path/filepath"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -41,6 +43,7 @@ import (
 	"github.com/Quarkonium-chain/go-quarkonium/libgoal"
 	"github.com/Quarkonium-chain/go-quarkonium/protocol"
 
+	"github.com/google/pprof/profile"
 	"github.com/spf13/cobra"
 )
 
@@ -57,6 +60,7 @@ var (
 	programSource      string
 	argB64Strings      []string
 	disassemble        bool
+	optimizeProgram    bool
 	verbose            bool
 	progByteFile       string
 	msigParams         string
@@ -81,6 +85,14 @@ var (
 	simulateScratchChange         bool
 	simulateAppStateChange        bool
 	simulateAllowUnnamedResources bool
+	simulatePopulateResources     bool
+	simulateProfileFilename       string
+	simulateProfileSources        []string
+
+	analyzeMode   string
+	analyzeBudget int
+	analyzeJSON   bool
+	analyzeIgnore []string
 )
 
 func init() {
@@ -91,9 +103,11 @@ func init() {
 	clerkCmd.AddCommand(groupCmd)
 	clerkCmd.AddCommand(splitCmd)
 	clerkCmd.AddCommand(compileCmd)
+	clerkCmd.AddCommand(analyzeCmd)
 	clerkCmd.AddCommand(dryrunCmd)
 	clerkCmd.AddCommand(dryrunRemoteCmd)
 	clerkCmd.AddCommand(simulateCmd)
+	clerkCmd.AddCommand(populateCmd)
 
 	// Wallet to be used for the clerk operation
 	clerkCmd.PersistentFlags().StringVarP(&walletName, "wallet", "w", "", "Set the wallet to be used for the selected operation")
@@ -133,6 +147,13 @@ func init() {
 
 	groupCmd.Flags().StringVarP(&txFilename, "infile", "i", "", "File storing transactions to be grouped")
 	groupCmd.Flags().StringVarP(&outFilename, "outfile", "o", "", "Filename for writing the grouped transactions")
+
+	// populate flags
+	populateCmd.Flags().StringVarP(&txFilename, "infile", "i", "", "File storing the transaction or transaction group to populate")
+	populateCmd.Flags().StringVarP(&outFilename, "outfile", "o", "", "Filename for writing the populated transactions")
+	populateCmd.Flags().Uint64Var(&simulateStartRound, "round", 0, "Specify the round after which the simulation will take place. If not specified, the simulation will take place after the latest round.")
+	populateCmd.MarkFlagRequired("infile")
+	populateCmd.MarkFlagRequired("outfile")
 	groupCmd.MarkFlagRequired("infile")
 	groupCmd.MarkFlagRequired("outfile")
 
@@ -145,9 +166,16 @@ func init() {
 	compileCmd.Flags().BoolVarP(&noProgramOutput, "no-out", "n", false, "Don't write contract program binary")
 	compileCmd.Flags().BoolVarP(&writeSourceMap, "map", "m", false, "Write out source map")
 	compileCmd.Flags().BoolVarP(&signProgram, "sign", "s", false, "Sign program, output is a binary signed LogicSig record")
+	compileCmd.Flags().BoolVar(&optimizeProgram, "optimize", false, "Optimize the program, as if it had #pragma optimize 1")
 	compileCmd.Flags().StringVarP(&outFilename, "outfile", "o", "", "Filename to write program bytes or signed LogicSig to")
 	compileCmd.Flags().StringVarP(&account, "account", "a", "", "Account address to sign the program (If not specified, uses default account)")
 
+	analyzeCmd.Flags().StringVar(&analyzeMode, "mode", "", "Analyze the program as a logicsig (sig) or an application (app). If not specified, programs that use stateful opcodes are applications")
+	analyzeCmd.Flags().IntVar(&analyzeBudget, "budget", 0, "Opcode budget that the worst-case cost may not exceed (default is the budget of a single logicsig or application call of the consensus protocol)")
+	analyzeCmd.Flags().BoolVar(&analyzeJSON, "json", false, "Write the analysis as JSON")
+	analyzeCmd.Flags().StringSliceVar(&analyzeIgnore, "ignore", nil, "Kinds of findings to ignore, such as unreachable-code or unbounded-cost")
+	analyzeCmd.Flags().StringVarP(&protoVersion, "proto", "P", "", "Consensus protocol version id string")
+
 	dryrunCmd.Flags().StringVarP(&txFilename, "txfile", "t", "", "Transaction or transaction-group to test")
 	dryrunCmd.Flags().StringVarP(&protoVersion, "proto", "P", "", "Consensus protocol version id string")
 	dryrunCmd.Flags().BoolVar(&dumpForDryrun, "dryrun-dump", false, "Dump in dryrun format acceptable by dryrun REST api instead of running")
@@ -177,6 +205,12 @@ func init() {
 	simulateCmd.Flags().BoolVar(&simulateScratchChange, "scratch", false, "Report scratch change during simulation time")
 	simulateCmd.Flags().BoolVar(&simulateAppStateChange, "state", false, "Report application state changes during simulation time")
 	simulateCmd.Flags().BoolVar(&simulateAllowUnnamedResources, "allow-unnamed-resources", false, "Allow access to unnamed resources during simulation")
+	simulateCmd.Flags().BoolVar(&simulatePopulateResources, "populate-resources", false, "Report the references to add to the foreign arrays of the group for the unnamed resources it accessed, implies --allow-unnamed-resources")
+	simulateCmd.Flags().StringVar(&simulateProfileFilename, "profile", "", "Filename for writing a pprof profile of the opcode budget spent during simulation, to open with go tool pprof")
+	simulateCmd.Flags().StringSliceVar(&simulateProfileSources, "profile-source", nil, "TEAL source files of the simulated programs, to report source lines instead of program counters in the --profile output")
+	simulateCmd.Flags().StringArrayVar(&abiContractFiles, "abi", nil, "ARC-4 or ARC-56 contract file to decode the calls of applications with no registered contract")
+
+	inspectCmd.Flags().StringArrayVar(&abiContractFiles, "abi", nil, "ARC-4 or ARC-56 contract file to decode the calls of applications with no registered contract")
 }
 
 var clerkCmd = &cobra.Command{
@@ -698,8 +732,9 @@ var rawsendCmd = &cobra.Command{
 var inspectCmd = &cobra.Command{
 	Use:   "inspect [input file 1] [input file 2]...",
 	Short: "Print a transaction file",
-	Long:  `Loads a transaction file, attempts to decode the transaction, and displays the decoded information.`,
+	Long:  `Loads a transaction file, attempts to decode the transaction, and displays the decoded information. Calls of applications with a registered ABI contract, or of a contract given with --abi, are decoded as method calls.`,
 	Run: func(cmd *cobra.Command, args []string) {
+		contracts := loadABIContracts(datadir.MaybeSingleDataDir())
 		for _, txFilename := range args {
 			data, err := readFile(txFilename)
 			if err != nil {
@@ -722,6 +757,11 @@ var inspectCmd = &cobra.Command{
 					reportErrorf(txDecodeError, txFilename, err)
 				}
 				fmt.Printf("%s[%d]\n%s\n\n", txFilename, count, string(protocol.EncodeJSON(sti)))
+				if call, ok := contracts.DecodeTxn(txn.Txn); ok {
+					fmt.Printf("%s[%d] method call\n", txFilename, count)
+					writeABICall(os.Stdout, "", call)
+					fmt.Printf("\n")
+				}
 				count++
 			}
 		}
@@ -975,7 +1015,12 @@ func assembleFileImpl(fname string, printWarnings bool) *logic.OpStream {
 	if err != nil {
 		reportErrorf("%s: %s", fname, err)
 	}
-	ops, err := logic.AssembleString(string(text))
+	// included files are found relative to the directory of the program
+	opts := logic.AssembleOptions{Loader: logic.DirLoader(filepath.Dir(fname))}
+	if optimizeProgram {
+		opts.Optimize = 1
+	}
+	ops, err := logic.AssembleStringWithOptions(string(text), opts)
 	if err != nil {
 		ops.ReportMultipleErrors(fname, os.Stderr)
 		reportErrorf("%s: %s", fname, err)
@@ -1012,11 +1057,32 @@ func assembleFile(fname string, printWarnings bool) (program []byte) {
 
 func assembleFileWithMap(sourceFile string, outFile string, printWarnings bool) ([]byte, logic.SourceMap, error) {
 	ops := assembleFileImpl(sourceFile, printWarnings)
-	pathToSourceFromSourceMap, err := determinePathToSourceFromSourceMap(sourceFile, outFile)
+	sourceMap, err := getSourceMap(ops, sourceFile, outFile)
 	if err != nil {
 		return nil, logic.SourceMap{}, err
 	}
-	return ops.Program, logic.GetSourceMap([]string{pathToSourceFromSourceMap}, ops.OffsetToSource), nil
+	return ops.Program, sourceMap, nil
+}
+
+// getSourceMap returns the source map of the program assembled from
+// sourceFile, with the paths of its source files relative to outFile.
+func getSourceMap(ops *logic.OpStream, sourceFile string, outFile string) (logic.SourceMap, error) {
+	pathToSourceFromSourceMap, err := determinePathToSourceFromSourceMap(sourceFile, outFile)
+	if err != nil {
+		return logic.SourceMap{}, err
+	}
+	sourceNames := ops.SourceNames(pathToSourceFromSourceMap)
+	for i, included := range sourceNames[1:] {
+		included = filepath.FromSlash(included)
+		if !filepath.IsAbs(included) {
+			included = filepath.Join(filepath.Dir(sourceFile), included)
+		}
+		sourceNames[i+1], err = determinePathToSourceFromSourceMap(included, outFile)
+		if err != nil {
+			return logic.SourceMap{}, err
+		}
+	}
+	return logic.GetSourceMap(sourceNames, ops.OffsetToSource), nil
 }
 
 func determinePathToSourceFromSourceMap(sourceFile string, outFile string) (string, error) {
@@ -1153,6 +1219,134 @@ var compileCmd = &cobra.Command{
 	},
 }
 
+var analyzeCmd = &cobra.Command{
+	Use:   "analyze [input file 1] [input file 2]...",
+	Short: "Statically analyze a contract program",
+	Long:  "Reads TEAL contract programs and checks every path through them for stack underflows and type errors, unreachable code, approvals that never check OnCompletion (applications) or RekeyTo and CloseRemainderTo (logicsigs), and a worst-case opcode cost above the budget. Exits with an error if there are any findings.",
+	Args:  cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		var mode logic.RunMode
+		switch analyzeMode {
+		case "":
+		case "sig":
+			mode = logic.ModeSig
+		case "app":
+			mode = logic.ModeApp
+		default:
+			reportErrorf("unknown mode %#v, use sig or app", analyzeMode)
+		}
+		_, params := getProto(protoVersion)
+
+		var reports []analysisReport
+		findings := 0
+		for _, fname := range args {
+			ops := assembleFileImpl(fname, false)
+			report, err := analyzeOps(ops, fname, mode, analyzeBudget, params, analyzeIgnore)
+			if err != nil {
+				reportErrorf("%s: %s", fname, err)
+			}
+			reports = append(reports, report)
+			findings += len(report.Findings)
+		}
+
+		if analyzeJSON {
+			out, err := json.MarshalIndent(reports, "", "  ")
+			if err != nil {
+				reportErrorf("%s", err)
+			}
+			fmt.Println(string(out))
+		} else {
+			for _, report := range reports {
+				for _, f := range report.Findings {
+					fmt.Printf("%s:%d:%d: %s: %s\n", f.File, f.Line, f.Column, f.Kind, f.Message)
+				}
+				cost := fmt.Sprintf("%d", report.MaxCost)
+				if report.Unbounded {
+					cost += " per iteration of its loops"
+				}
+				fmt.Printf("%s: %s program, worst-case cost %s of %d\n", report.File, report.Mode, cost, report.Budget)
+			}
+		}
+		if findings > 0 {
+			reportErrorf("%d findings", findings)
+		}
+	},
+}
+
+// analysisReport is the output of goal clerk analyze for a single program.
+type analysisReport struct {
+	File      string            `json:"file"`
+	Mode      string            `json:"mode"`
+	MaxCost   int               `json:"max-cost"`
+	Unbounded bool              `json:"unbounded"`
+	Budget    int               `json:"budget"`
+	Findings  []analysisFinding `json:"findings"`
+}
+
+type analysisFinding struct {
+	Kind    logic.FindingKind `json:"kind"`
+	File    string            `json:"file"`
+	Line    int               `json:"line"`
+	Column  int               `json:"column"`
+	PC      int               `json:"pc"`
+	Message string            `json:"message"`
+}
+
+// analyzeOps analyzes the program assembled from fname. If budget is zero,
+// the budget of a single program of the analyzed mode in params is used.
+func analyzeOps(ops *logic.OpStream, fname string, mode logic.RunMode, budget int, params config.ConsensusParams, ignore []string) (analysisReport, error) {
+	if mode == 0 {
+		mode = logic.ModeSig
+		if ops.HasStatefulOps {
+			mode = logic.ModeApp
+		}
+	}
+	if budget == 0 {
+		budget = params.MaxAppProgramCost
+		if mode == logic.ModeSig {
+			budget = int(params.LogicSigMaxCost)
+		}
+	}
+	analysis, err := ops.Analyze(logic.AnalysisParams{Mode: mode, Budget: budget})
+	if err != nil {
+		return analysisReport{}, err
+	}
+
+	report := analysisReport{
+		File:      fname,
+		Mode:      "app",
+		MaxCost:   analysis.MaxCost,
+		Unbounded: analysis.Unbounded,
+		Budget:    budget,
+		Findings:  []analysisFinding{},
+	}
+	if mode == logic.ModeSig {
+		report.Mode = "sig"
+	}
+	// findings in included files name them relative to the directory of fname
+	sourceNames := ops.SourceNames(fname)
+	for i, included := range sourceNames[1:] {
+		included = filepath.FromSlash(included)
+		if !filepath.IsAbs(included) {
+			included = filepath.Join(filepath.Dir(fname), included)
+		}
+		sourceNames[i+1] = included
+	}
+	for _, f := range analysis.Findings {
+		if slices.Contains(ignore, string(f.Kind)) {
+			continue
+		}
+		finding := analysisFinding{Kind: f.Kind, File: fname, PC: f.PC, Message: f.Message}
+		if f.Location != nil {
+			finding.File = sourceNames[f.Location.File]
+			finding.Line = f.Location.Line + 1
+			finding.Column = f.Location.Column
+		}
+		report.Findings = append(report.Findings, finding)
+	}
+	return report, nil
+}
+
 var dryrunCmd = &cobra.Command{
 	Use:   "dryrun",
 	Short: "Test a program offline",
@@ -1297,6 +1491,10 @@ var simulateCmd = &cobra.Command{
 			simulateExtraOpcodeBudget = simulation.MaxExtraOpcodeBudget
 		}
 
+		if len(simulateProfileSources) > 0 && simulateProfileFilename == "" {
+			reportErrorf("--profile-source requires --profile")
+		}
+
 		requestOutProvided := cmd.Flags().Changed("request-only-out")
 		resultOutProvided := cmd.Flags().Changed("result-out")
 		if requestOutProvided && resultOutProvided {
@@ -1316,12 +1514,13 @@ var simulateCmd = &cobra.Command{
 						Txns: txgroup,
 					},
 				},
-				Round:                 basics.Round(simulateStartRound),
-				AllowEmptySignatures:  simulateAllowEmptySignatures,
-				AllowMoreLogging:      simulateAllowMoreLogging,
-				AllowUnnamedResources: simulateAllowUnnamedResources,
-				ExtraOpcodeBudget:     simulateExtraOpcodeBudget,
-				ExecTraceConfig:       traceCmdOptionToSimulateTraceConfigModel(),
+				Round:                  basics.Round(simulateStartRound),
+				AllowEmptySignatures:   simulateAllowEmptySignatures,
+				AllowMoreLogging:       simulateAllowMoreLogging,
+				AllowUnnamedResources:  simulateAllowUnnamedResources || simulatePopulateResources,
+				PopulateResourceArrays: simulatePopulateResources,
+				ExtraOpcodeBudget:      simulateExtraOpcodeBudget,
+				ExecTraceConfig:        traceCmdOptionToSimulateTraceConfigModel(),
 			}
 			err := writeFile(requestOutFilename, protocol.EncodeJSON(simulateRequest), 0600)
 			if err != nil {
@@ -1342,12 +1541,13 @@ var simulateCmd = &cobra.Command{
 						Txns: txgroup,
 					},
 				},
-				Round:                 basics.Round(simulateStartRound),
-				AllowEmptySignatures:  simulateAllowEmptySignatures,
-				AllowMoreLogging:      simulateAllowMoreLogging,
-				AllowUnnamedResources: simulateAllowUnnamedResources,
-				ExtraOpcodeBudget:     simulateExtraOpcodeBudget,
-				ExecTraceConfig:       traceCmdOptionToSimulateTraceConfigModel(),
+				Round:                  basics.Round(simulateStartRound),
+				AllowEmptySignatures:   simulateAllowEmptySignatures,
+				AllowMoreLogging:       simulateAllowMoreLogging,
+				AllowUnnamedResources:  simulateAllowUnnamedResources || simulatePopulateResources,
+				PopulateResourceArrays: simulatePopulateResources,
+				ExtraOpcodeBudget:      simulateExtraOpcodeBudget,
+				ExecTraceConfig:        traceCmdOptionToSimulateTraceConfigModel(),
 			}
 			simulateResponse, responseErr = client.SimulateTransactions(simulateRequest)
 		} else {
@@ -1362,6 +1562,10 @@ var simulateCmd = &cobra.Command{
 			reportErrorf("simulation error: %s", responseErr.Error())
 		}
 
+		if simulateProfileFilename != "" {
+			writeSimulateProfile(simulateResponse.ExecProfile)
+		}
+
 		encodedResponse := protocol.EncodeJSON(&simulateResponse)
 		if outFilename != "" {
 			err := writeFile(outFilename, encodedResponse, 0600)
@@ -1371,9 +1575,119 @@ var simulateCmd = &cobra.Command{
 		} else {
 			fmt.Println(string(encodedResponse))
 		}
+
+		// The decoded method calls go to stderr, to keep the response on
+		// stdout valid JSON
+		contracts := loadABIContracts(dataDir)
+		for i, group := range simulateResponse.TxnGroups {
+			for j, txnResult := range group.Txns {
+				if call, ok := contracts.DecodeTxInfo(txnResult.Txn); ok {
+					fmt.Fprintf(os.Stderr, "Group %d transaction %d method call:\n", i, j)
+					writeABICall(os.Stderr, "  ", call)
+				}
+			}
+		}
 	},
 }
 
+var populateCmd = &cobra.Command{
+	Use:   "populate",
+	Short: "Fill in the foreign arrays of a transaction group with the resources it accesses",
+	Long:  `Simulate a transaction or transaction group allowing access to unnamed resources, and add the accounts, assets, applications and boxes it accessed to the foreign arrays of its application calls, so that the group can be submitted.  The references are packed into the application calls of the group within the per-transaction limits, next to the references they already have.  Transactions that change are written without their signatures, and a group is given a new group ID.`,
+	Args:  validateNoPosArgsFn,
+	Run: func(cmd *cobra.Command, args []string) {
+		txgroup := decodeTxnsFromFile(txFilename)
+		dataDir := datadir.EnsureSingleDataDir()
+		client := ensureFullClient(dataDir)
+		simulateResponse, err := client.SimulateTransactions(v2.PreEncodedSimulateRequest{
+			TxnGroups:              []v2.PreEncodedSimulateRequestTransactionGroup{{Txns: txgroup}},
+			Round:                  basics.Round(simulateStartRound),
+			AllowEmptySignatures:   true,
+			AllowUnnamedResources:  true,
+			PopulateResourceArrays: true,
+		})
+		if err != nil {
+			reportErrorf("simulation error: %s", err.Error())
+		}
+
+		groupResult := simulateResponse.TxnGroups[0]
+		if groupResult.FailureMessage != nil {
+			reportErrorf("simulation failed, the resources it accessed may be incomplete: %s", *groupResult.FailureMessage)
+		}
+		if groupResult.PopulateFailureMessage != nil {
+			reportErrorf("cannot populate the group: %s", *groupResult.PopulateFailureMessage)
+		}
+
+		var changed bool
+		for i := range txgroup {
+			populated := groupResult.Txns[i].PopulatedResourceArrays
+			if populated == nil {
+				continue
+			}
+			arrays, err := convertPopulatedResourceArrays(populated)
+			if err != nil {
+				reportErrorf("bad populated resources of transaction #%d: %v", i, err)
+			}
+			if err = arrays.Apply(&txgroup[i].Txn); err != nil {
+				reportErrorf("cannot populate transaction #%d: %v", i, err)
+			}
+			if !txgroup[i].Sig.Blank() || !txgroup[i].Msig.Blank() {
+				reportWarnf("Transaction #%d changed, and must be signed again", i)
+				txgroup[i].Sig = crypto.Signature{}
+				txgroup[i].Msig = crypto.MultisigSig{}
+			}
+			changed = true
+		}
+
+		if changed && !txgroup[0].Txn.Group.IsZero() {
+			var group transactions.TxGroup
+			for i := range txgroup {
+				txgroup[i].Txn.Group = crypto.Digest{}
+				group.TxGroupHashes = append(group.TxGroupHashes, crypto.Digest(txgroup[i].ID()))
+			}
+			groupHash := crypto.HashObj(group)
+			for i := range txgroup {
+				txgroup[i].Txn.Group = groupHash
+			}
+		}
+		if !changed {
+			reportInfof("No references to add")
+		}
+
+		err = writeSignedTxnsToFile(txgroup, outFilename)
+		if err != nil {
+			reportErrorf(fileWriteError, outFilename, err)
+		}
+	},
+}
+
+func convertPopulatedResourceArrays(populated *model.SimulatePopulatedResourceArrays) (simulation.PopulatedResourceArrays, error) {
+	var arrays simulation.PopulatedResourceArrays
+	var err error
+	if populated.Accounts != nil {
+		arrays.Accounts, err = unmarshalSlice(*populated.Accounts)
+		if err != nil {
+			return arrays, err
+		}
+	}
+	if populated.Assets != nil {
+		for _, aid := range *populated.Assets {
+			arrays.Assets = append(arrays.Assets, basics.AssetIndex(aid))
+		}
+	}
+	if populated.Apps != nil {
+		for _, app := range *populated.Apps {
+			arrays.Apps = append(arrays.Apps, basics.AppIndex(app))
+		}
+	}
+	if populated.Boxes != nil {
+		for _, box := range *populated.Boxes {
+			arrays.Boxes = append(arrays.Boxes, logic.BoxRef{App: basics.AppIndex(box.App), Name: string(box.Name)})
+		}
+	}
+	return arrays, nil
+}
+
 // unmarshalSlice converts string addresses to basics.Address
 func unmarshalSlice(accts []string) ([]basics.Address, error) {
 	result := make([]basics.Address, 0, len(accts))
@@ -1422,6 +1736,48 @@ func traceCmdOptionToSimulateTraceConfigModel() simulation.ExecTraceConfig {
 	traceConfig.Stack = traceConfig.Stack || simulateStackChange
 	traceConfig.Scratch = traceConfig.Scratch || simulateScratchChange
 	traceConfig.State = traceConfig.State || simulateAppStateChange
+	traceConfig.Profile = simulateProfileFilename != ""
 
 	return traceConfig
 }
+
+// writeSimulateProfile writes the pprof profile of a simulation to the --profile
+// file, mapping its program counters to lines of the --profile-source files.
+func writeSimulateProfile(execProfile []byte) {
+	if len(execProfile) == 0 {
+		reportErrorf("simulation returned no execution profile")
+	}
+	if len(simulateProfileSources) == 0 {
+		err := writeFile(simulateProfileFilename, execProfile, 0600)
+		if err != nil {
+			reportErrorf("write file error: %s", err.Error())
+		}
+		return
+	}
+
+	p, err := profile.ParseData(execProfile)
+	if err != nil {
+		reportErrorf("could not parse execution profile: %v", err)
+	}
+	sourceMaps := make(map[crypto.Digest]logic.SourceMap, len(simulateProfileSources))
+	for _, source := range simulateProfileSources {
+		program, sourceMap, err := assembleFileWithMap(source, simulateProfileFilename, false)
+		if err != nil {
+			reportErrorf("%s: %v", source, err)
+		}
+		sourceMaps[crypto.Hash(program)] = sourceMap
+	}
+	err = simulation.ApplyPprofSourceMaps(p, sourceMaps)
+	if err != nil {
+		reportErrorf("could not map execution profile to sources: %v", err)
+	}
+	var buf bytes.Buffer
+	err = p.Write(&buf)
+	if err != nil {
+		reportErrorf("could not encode execution profile: %v", err)
+	}
+	err = writeFile(simulateProfileFilename, buf.Bytes(), 0600)
+	if err != nil {
+		reportErrorf("write file error: %s", err.Error())
+	}
+}
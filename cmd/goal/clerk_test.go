@@ -17,9 +17,13 @@
 package main
 
 import (
+	"os"
 	"path/filepath"
 	"testing"
 
+	"github.com/Quarkonium-chain/go-quarkonium/config"
+	"github.com/Quarkonium-chain/go-quarkonium/data/transactions/logic"
+	"github.com/Quarkonium-chain/go-quarkonium/protocol"
 	"github.com/Quarkonium-chain/go-quarkonium/test/partitiontest"
 	"github.com/stretchr/testify/require"
 )
@@ -98,3 +102,50 @@ func TestDeterminePathToSourceFromSourceMap(t *testing.T) {
 		})
 	}
 }
+
+func TestGetSourceMapIncludes(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "src", "lib"), 0755))
+	source := filepath.Join(dir, "src", "program.teal")
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "src", "lib", "util.teal"), []byte("one:\nint 1\nretsub\n"), 0644))
+	ops, err := logic.AssembleStringWithLoader("#pragma version 8\ncallsub util::one\nreturn\n#include \"lib/util.teal\"\n", logic.DirLoader(filepath.Dir(source)))
+	require.NoError(t, err)
+
+	sourceMap, err := getSourceMap(ops, source, filepath.Join(dir, "out", "program.teal.tok"))
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		filepath.FromSlash("../src/program.teal"),
+		filepath.FromSlash("../src/lib/util.teal"),
+	}, sourceMap.Sources)
+}
+
+func TestAnalyzeOps(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	source := filepath.Join("src", "program.teal")
+	loader := logic.MapLoader{"lib/util.teal": "one:\nint 1\nretsub\nint 2\n"}
+	ops, err := logic.AssembleStringWithLoader("#pragma version 8\ncallsub util::one\nreturn\n#include \"lib/util.teal\"\n", loader)
+	require.NoError(t, err)
+	params := config.Consensus[protocol.ConsensusCurrentVersion]
+
+	report, err := analyzeOps(ops, source, 0, 0, params, nil)
+	require.NoError(t, err)
+	require.Equal(t, "sig", report.Mode)
+	require.Equal(t, int(params.LogicSigMaxCost), report.Budget)
+	require.Equal(t, 4, report.MaxCost)
+	require.Equal(t, []analysisFinding{
+		{logic.FindingUncheckedRekeyTo, source, 3, 0, 4, "program may approve without checking txn RekeyTo"},
+		{logic.FindingUncheckedCloseRemainderTo, source, 3, 0, 4, "program may approve without checking txn CloseRemainderTo"},
+		{logic.FindingUnreachable, filepath.Join("src", "lib", "util.teal"), 4, 0, 8, "1 unreachable instruction"},
+	}, report.Findings)
+
+	report, err = analyzeOps(ops, source, logic.ModeApp, 3, params, []string{"unreachable-code"})
+	require.NoError(t, err)
+	require.Equal(t, "app", report.Mode)
+	require.Equal(t, []logic.FindingKind{logic.FindingBudgetExceeded, logic.FindingUncheckedOnCompletion}, []logic.FindingKind{report.Findings[0].Kind, report.Findings[1].Kind})
+	require.Len(t, report.Findings, 2)
+}
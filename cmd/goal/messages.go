@@ -92,6 +92,11 @@ const (
 	errorCatchpointLabelMissing             = "A catchpoint argument is needed: %s: %s"
 	errorUnableToLookupCatchpointLabel      = "Unable to fetch catchpoint label"
 	errorTooManyCatchpointLabels            = "The catchup command expect a single catchpoint"
+	errorNodePeerReputation                 = "Cannot get or clear peer reputation: %s"
+	infoNodePeerReputationCleared           = "Reputation of peer %s cleared"
+	infoNodeNoPeerReputation                = "No peer misbehaved recently"
+	errorNodeReplayExporter                 = "Cannot replay the exporter: %s"
+	infoNodeExporterReplaying               = "Exporter replaying from round %d"
 
 	// Asset
 	malformedMetadataHash = "Cannot base64-decode metadata hash %s: %s"
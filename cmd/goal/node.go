@@ -30,6 +30,7 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"text/tabwriter"
 	"time"
 
 	"github.com/spf13/cobra"
@@ -62,6 +63,8 @@ var watchMillisecond uint64
 var abortCatchup bool
 var fastCatchupForce bool
 var minCatchupRounds uint64
+var clearPeerReputation string
+var replayExporterRound uint64
 
 const catchpointURL = "https://algorand-catchpoints.s3.us-east-2.amazonaws.com/channel/%s/latest.catchpoint"
 
@@ -77,6 +80,8 @@ func init() {
 	nodeCmd.AddCommand(waitCmd)
 	nodeCmd.AddCommand(createCmd)
 	nodeCmd.AddCommand(catchupCmd)
+	nodeCmd.AddCommand(reputationCmd)
+	nodeCmd.AddCommand(replayExporterCmd)
 	// Once the server-side implementation of the shutdown command is ready, we should enable this one.
 	//nodeCmd.AddCommand(shutdownCmd)
 	nodeCmd.AddCommand(p2pID)
@@ -116,6 +121,11 @@ func init() {
 	waitCmd.Flags().Uint32VarP(&waitSec, "waittime", "w", 5, "Time (in seconds) to wait for node to make progress")
 	statusCmd.Flags().Uint64VarP(&watchMillisecond, "watch", "w", 0, "Time (in milliseconds) between two successive status updates")
 
+	reputationCmd.Flags().StringVarP(&clearPeerReputation, "clear", "c", "", "Forget the reputation of the given peer, lifting its ban")
+
+	replayExporterCmd.Flags().Uint64VarP(&replayExporterRound, "round", "r", 0, "The round to export again from")
+	replayExporterCmd.MarkFlagRequired("round")
+
 	catchupCmd.Flags().BoolVarP(&abortCatchup, "abort", "x", false, "Aborts the current catchup process")
 	catchupCmd.Flags().BoolVar(&fastCatchupForce, "force", false, "Forces fast catchup with implicit catchpoint to start without a consent prompt")
 	catchupCmd.Flags().Uint64VarP(&minCatchupRounds, "min", "m", 0, "Catchup only if the catchpoint would advance the node by the specified minimum number of rounds")
@@ -662,6 +672,68 @@ var waitCmd = &cobra.Command{
 	},
 }
 
+var reputationCmd = &cobra.Command{
+	Use:     "reputation",
+	Short:   "List the peers that misbehaved recently and whether they are banned",
+	Long:    "List the peers (IP addresses or p2p peer IDs) that misbehaved recently along with their decaying misbehavior score. Peers reaching PeerBanThreshold are banned for PeerBanDurationSeconds, doubling on each subsequent ban.",
+	Example: "goal node reputation\t\t\tList the peers reputation\ngoal node reputation --clear 10.0.0.1\tLift the ban of peer 10.0.0.1",
+	Args:    validateNoPosArgsFn,
+	Run: func(cmd *cobra.Command, _ []string) {
+		datadir.OnDataDirs(func(dataDir string) {
+			client := ensureAlgodClient(dataDir)
+			if clearPeerReputation != "" {
+				err := client.ClearPeerReputation(clearPeerReputation)
+				if err != nil {
+					reportErrorf(errorNodePeerReputation, err)
+				}
+				reportInfof(infoNodePeerReputationCleared, clearPeerReputation)
+				return
+			}
+
+			peers, err := client.PeerReputation()
+			if err != nil {
+				reportErrorf(errorNodePeerReputation, err)
+			}
+			if len(peers) == 0 {
+				reportInfof(infoNodeNoPeerReputation)
+				return
+			}
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			fmt.Fprintln(w, "PEER\tSCORE\tBANS\tBANNED UNTIL\tLAST OFFENSE")
+			for _, p := range peers {
+				bannedUntil := "-"
+				if p.BannedUntil != nil {
+					bannedUntil = time.Unix(int64(*p.BannedUntil), 0).Format(time.RFC3339)
+				}
+				lastOffense := "-"
+				if p.LastOffense != nil {
+					lastOffense = *p.LastOffense
+				}
+				fmt.Fprintf(w, "%s\t%.1f\t%d\t%s\t%s\n", p.Peer, p.Score, p.Bans, bannedUntil, lastOffense)
+			}
+			w.Flush()
+		})
+	},
+}
+
+var replayExporterCmd = &cobra.Command{
+	Use:     "replay-exporter",
+	Short:   "Make the exporter export every round from the given one again",
+	Long:    "Make the exporter export every round from the given one again. Rounds that were exported already are written without their group deltas if the node no longer holds them. An exporter that stopped at a round whose group deltas are gone resumes, skipping the rounds before the given one.",
+	Example: "goal node replay-exporter --round 1000",
+	Args:    validateNoPosArgsFn,
+	Run: func(cmd *cobra.Command, _ []string) {
+		datadir.OnDataDirs(func(dataDir string) {
+			client := ensureAlgodClient(dataDir)
+			err := client.ReplayExporter(replayExporterRound)
+			if err != nil {
+				reportErrorf(errorNodeReplayExporter, err)
+			}
+			reportInfof(infoNodeExporterReplaying, replayExporterRound)
+		})
+	},
+}
+
 func isValidIP(userInput string) bool {
 	host, port, err := net.SplitHostPort(userInput)
 	if err != nil {
@@ -117,6 +117,12 @@ func (c *MockDebugControl) StepOut() {
 func (c *MockDebugControl) Resume() {
 }
 
+func (c *MockDebugControl) StepLater() {
+}
+
+func (c *MockDebugControl) ResumeLater() {
+}
+
 func (c *MockDebugControl) SetBreakpoint(line int) error {
 	if c.errOnCall {
 		return errors.New("mock err")
@@ -147,6 +153,14 @@ func (c *MockDebugControl) GetSource() (string, []byte) {
 	return "name", []byte("int 1")
 }
 
+func (c *MockDebugControl) GetSourceLines() map[int]logic.SourceLocation {
+	return nil
+}
+
+func (c *MockDebugControl) GetLine() int {
+	return 0
+}
+
 func (c *MockDebugControl) GetStates(s *logic.DebugState) AppState {
 	return AppState{}
 }
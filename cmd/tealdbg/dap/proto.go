@@ -0,0 +1,272 @@
+// Copyright (C) 2019-2024 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+// Package dap implements the subset of the Debug Adapter Protocol spoken by
+// tealdbg, see https://microsoft.github.io/debug-adapter-protocol/specification
+package dap
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/textproto"
+	"strconv"
+	"strings"
+)
+
+// Message types
+const (
+	RequestType  = "request"
+	ResponseType = "response"
+	EventType    = "event"
+)
+
+// ProtocolMessage is the base of requests, responses and events
+type ProtocolMessage struct {
+	Seq  int    `json:"seq"`
+	Type string `json:"type"`
+}
+
+// Request is a client request
+type Request struct {
+	ProtocolMessage
+	Command   string          `json:"command"`
+	Arguments json.RawMessage `json:"arguments,omitempty"`
+}
+
+// Response is a reply to a request
+type Response struct {
+	ProtocolMessage
+	RequestSeq int         `json:"request_seq"`
+	Success    bool        `json:"success"`
+	Command    string      `json:"command"`
+	Message    string      `json:"message,omitempty"`
+	Body       interface{} `json:"body,omitempty"`
+}
+
+// Event is sent by the debug adapter on its own
+type Event struct {
+	ProtocolMessage
+	Event string      `json:"event"`
+	Body  interface{} `json:"body,omitempty"`
+}
+
+// Capabilities of the debug adapter, returned by the initialize request
+type Capabilities struct {
+	SupportsConfigurationDoneRequest bool `json:"supportsConfigurationDoneRequest,omitempty"`
+	SupportsTerminateRequest         bool `json:"supportsTerminateRequest,omitempty"`
+}
+
+// InitializeRequestArguments type
+type InitializeRequestArguments struct {
+	ClientID        string `json:"clientID,omitempty"`
+	AdapterID       string `json:"adapterID"`
+	LinesStartAt1   *bool  `json:"linesStartAt1,omitempty"`
+	ColumnsStartAt1 *bool  `json:"columnsStartAt1,omitempty"`
+}
+
+// LaunchRequestArguments type, also used for attach requests
+type LaunchRequestArguments struct {
+	NoDebug     bool `json:"noDebug,omitempty"`
+	StopOnEntry bool `json:"stopOnEntry,omitempty"`
+}
+
+// Source is a source file or, when SourceReference is set, content served by
+// the debug adapter
+type Source struct {
+	Name            string `json:"name,omitempty"`
+	Path            string `json:"path,omitempty"`
+	SourceReference int    `json:"sourceReference,omitempty"`
+}
+
+// SourceBreakpoint type
+type SourceBreakpoint struct {
+	Line   int `json:"line"`
+	Column int `json:"column,omitempty"`
+}
+
+// SetBreakpointsArguments type
+type SetBreakpointsArguments struct {
+	Source      Source             `json:"source"`
+	Breakpoints []SourceBreakpoint `json:"breakpoints,omitempty"`
+	Lines       []int              `json:"lines,omitempty"`
+}
+
+// Breakpoint type
+type Breakpoint struct {
+	ID       int     `json:"id,omitempty"`
+	Verified bool    `json:"verified"`
+	Message  string  `json:"message,omitempty"`
+	Source   *Source `json:"source,omitempty"`
+	Line     int     `json:"line,omitempty"`
+}
+
+// SetBreakpointsResponseBody type
+type SetBreakpointsResponseBody struct {
+	Breakpoints []Breakpoint `json:"breakpoints"`
+}
+
+// BreakpointEventBody type
+type BreakpointEventBody struct {
+	Reason     string     `json:"reason"`
+	Breakpoint Breakpoint `json:"breakpoint"`
+}
+
+// Thread type
+type Thread struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// ThreadsResponseBody type
+type ThreadsResponseBody struct {
+	Threads []Thread `json:"threads"`
+}
+
+// StackTraceArguments type
+type StackTraceArguments struct {
+	ThreadID   int `json:"threadId"`
+	StartFrame int `json:"startFrame,omitempty"`
+	Levels     int `json:"levels,omitempty"`
+}
+
+// StackFrame type
+type StackFrame struct {
+	ID     int     `json:"id"`
+	Name   string  `json:"name"`
+	Source *Source `json:"source,omitempty"`
+	Line   int     `json:"line"`
+	Column int     `json:"column"`
+}
+
+// StackTraceResponseBody type
+type StackTraceResponseBody struct {
+	StackFrames []StackFrame `json:"stackFrames"`
+	TotalFrames int          `json:"totalFrames,omitempty"`
+}
+
+// ScopesArguments type
+type ScopesArguments struct {
+	FrameID int `json:"frameId"`
+}
+
+// Scope type
+type Scope struct {
+	Name               string `json:"name"`
+	VariablesReference int    `json:"variablesReference"`
+	Expensive          bool   `json:"expensive"`
+}
+
+// ScopesResponseBody type
+type ScopesResponseBody struct {
+	Scopes []Scope `json:"scopes"`
+}
+
+// VariablesArguments type
+type VariablesArguments struct {
+	VariablesReference int `json:"variablesReference"`
+}
+
+// Variable type
+type Variable struct {
+	Name               string `json:"name"`
+	Value              string `json:"value"`
+	Type               string `json:"type,omitempty"`
+	VariablesReference int    `json:"variablesReference"`
+}
+
+// VariablesResponseBody type
+type VariablesResponseBody struct {
+	Variables []Variable `json:"variables"`
+}
+
+// SourceArguments type
+type SourceArguments struct {
+	Source          *Source `json:"source,omitempty"`
+	SourceReference int     `json:"sourceReference"`
+}
+
+// SourceResponseBody type
+type SourceResponseBody struct {
+	Content  string `json:"content"`
+	MimeType string `json:"mimeType,omitempty"`
+}
+
+// ContinueResponseBody type
+type ContinueResponseBody struct {
+	AllThreadsContinued bool `json:"allThreadsContinued"`
+}
+
+// StoppedEventBody type
+type StoppedEventBody struct {
+	Reason            string `json:"reason"`
+	Description       string `json:"description,omitempty"`
+	ThreadID          int    `json:"threadId,omitempty"`
+	Text              string `json:"text,omitempty"`
+	AllThreadsStopped bool   `json:"allThreadsStopped,omitempty"`
+}
+
+// OutputEventBody type
+type OutputEventBody struct {
+	Category string `json:"category,omitempty"`
+	Output   string `json:"output"`
+}
+
+// ExitedEventBody type
+type ExitedEventBody struct {
+	ExitCode int `json:"exitCode"`
+}
+
+const contentLength = "Content-Length"
+
+// ReadRequest reads a request framed by the base protocol: a header with the
+// length of the JSON content, and the content
+func ReadRequest(r *bufio.Reader) (*Request, error) {
+	header, err := textproto.NewReader(r).ReadMIMEHeader()
+	if err != nil {
+		return nil, err
+	}
+	length, err := strconv.Atoi(strings.TrimSpace(header.Get(contentLength)))
+	if err != nil || length <= 0 {
+		return nil, fmt.Errorf("bad %s header: %#v", contentLength, header.Get(contentLength))
+	}
+	content := make([]byte, length)
+	if _, err = io.ReadFull(r, content); err != nil {
+		return nil, err
+	}
+	var req Request
+	if err = json.Unmarshal(content, &req); err != nil {
+		return nil, err
+	}
+	if req.Type != RequestType {
+		return nil, fmt.Errorf("unexpected message type %#v", req.Type)
+	}
+	return &req, nil
+}
+
+// WriteMessage writes a response or an event framed by the base protocol
+func WriteMessage(w io.Writer, msg interface{}) error {
+	content, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	if _, err = fmt.Fprintf(w, "%s: %d\r\n\r\n", contentLength, len(content)); err != nil {
+		return err
+	}
+	_, err = w.Write(content)
+	return err
+}
@@ -0,0 +1,209 @@
+// Copyright (C) 2019-2024 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"encoding/base64"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/Quarkonium-chain/go-quarkonium/cmd/tealdbg/dap"
+	"github.com/Quarkonium-chain/go-quarkonium/data/basics"
+	"github.com/Quarkonium-chain/go-quarkonium/data/transactions"
+	"github.com/Quarkonium-chain/go-quarkonium/data/transactions/logic"
+)
+
+// dapTypeMap maps fieldDesc types to TEAL types
+var dapTypeMap = map[string]string{
+	"string": "[]byte",
+	"bigint": "uint64",
+}
+
+func fieldsToVariables(fields []fieldDesc) []dap.Variable {
+	variables := make([]dap.Variable, len(fields))
+	for i, field := range fields {
+		variables[i] = dap.Variable{Name: field.Name, Value: field.Value, Type: dapTypeMap[field.Type]}
+	}
+	return variables
+}
+
+func bytesToFieldDesc(name string, data []byte) fieldDesc {
+	tv := basics.TealValue{Type: basics.TealBytesType, Bytes: base64.StdEncoding.EncodeToString(data)}
+	return tealValueToFieldDesc(name, tv)
+}
+
+// makeScopes returns the scopes of a stack frame of the session. Only the
+// stopped execution has up to date state, its callers get the scopes that do
+// not change while they wait. Must be called with f.mu locked.
+func (f *DapFrontend) makeScopes(s *dapSession, stopped bool) []dap.Scope {
+	st := &s.state
+	scope := func(name string, variables func() []dap.Variable) dap.Scope {
+		return dap.Scope{Name: name, VariablesReference: f.variablesReference(variables)}
+	}
+
+	var scopes []dap.Scope
+	if stopped {
+		scopes = append(scopes,
+			scope("Stack", func() []dap.Variable {
+				return fieldsToVariables(prepareArray(st.Stack))
+			}),
+			scope("Scratch", func() []dap.Variable {
+				var fields []fieldDesc
+				for i, tv := range st.Scratch {
+					// only slots that were stored to
+					if tv.Type == basics.TealBytesType || tv.Uint != 0 {
+						fields = append(fields, tealValueToFieldDesc(strconv.Itoa(i), tv))
+					}
+				}
+				return fieldsToVariables(fields)
+			}),
+		)
+	}
+	scopes = append(scopes,
+		scope("Globals", func() []dap.Variable {
+			return fieldsToVariables(prepareGlobals(st.Globals))
+		}),
+		scope("Transaction", func() []dap.Variable {
+			return fieldsToVariables(prepareTxn(&st.TxnGroup[st.GroupIndex].Txn, st.GroupIndex, s.inner))
+		}),
+		scope("Group", func() []dap.Variable {
+			return f.txnsToVariables(st.TxnGroup, st.GroupIndex, s.inner)
+		}),
+	)
+
+	// logic sigs have no application state
+	if !stopped || s.appState.appIdx == 0 && !s.inner {
+		return scopes
+	}
+	if s.appState.appIdx != 0 {
+		appIdx := s.appState.appIdx
+		scopes = append(scopes,
+			scope("Global State", func() []dap.Variable {
+				return fieldsToVariables(tkvToFields(s.appState.global[appIdx]))
+			}),
+			scope("Local State", func() []dap.Variable {
+				return f.localsToVariables(s.appState.locals, appIdx)
+			}),
+		)
+	}
+	scopes = append(scopes,
+		scope("Boxes", func() []dap.Variable {
+			return boxesToVariables(st.Boxes, st.TxnGroup[st.GroupIndex].Txn.ApplicationID)
+		}),
+		scope("Logs", func() []dap.Variable {
+			fields := make([]fieldDesc, len(st.Logs))
+			for i, log := range st.Logs {
+				fields[i] = bytesToFieldDesc(strconv.Itoa(i), []byte(log))
+			}
+			return fieldsToVariables(fields)
+		}),
+		scope("Inner Transactions", func() []dap.Variable {
+			return f.innerTxnsToVariables(st.InnerTxns)
+		}),
+	)
+	return scopes
+}
+
+// must be called with f.mu locked
+func (f *DapFrontend) txnsToVariables(txnGroup []transactions.SignedTxnWithAD, groupIndex int, inner bool) []dap.Variable {
+	variables := make([]dap.Variable, len(txnGroup))
+	for i := range txnGroup {
+		txn := &txnGroup[i].Txn
+		value := string(txn.Type)
+		if i == groupIndex {
+			value += " (current)"
+		}
+		gi := i
+		variables[i] = dap.Variable{
+			Name:  strconv.Itoa(i),
+			Value: value,
+			VariablesReference: f.variablesReference(func() []dap.Variable {
+				return fieldsToVariables(prepareTxn(txn, gi, inner))
+			}),
+		}
+	}
+	return variables
+}
+
+// must be called with f.mu locked
+func (f *DapFrontend) innerTxnsToVariables(stxns []transactions.SignedTxnWithAD) []dap.Variable {
+	variables := make([]dap.Variable, len(stxns))
+	for i := range stxns {
+		stxn := &stxns[i]
+		gi := i
+		variables[i] = dap.Variable{
+			Name:  strconv.Itoa(i),
+			Value: string(stxn.Txn.Type),
+			VariablesReference: f.variablesReference(func() []dap.Variable {
+				variables := fieldsToVariables(prepareTxn(&stxn.Txn, gi, true))
+				if len(stxn.EvalDelta.InnerTxns) > 0 {
+					variables = append(variables, dap.Variable{
+						Name:               "Inner Transactions",
+						Value:              fmt.Sprintf("[%d]", len(stxn.EvalDelta.InnerTxns)),
+						VariablesReference: f.variablesReference(func() []dap.Variable { return f.innerTxnsToVariables(stxn.EvalDelta.InnerTxns) }),
+					})
+				}
+				return variables
+			}),
+		}
+	}
+	return variables
+}
+
+// must be called with f.mu locked
+func (f *DapFrontend) localsToVariables(locals map[basics.Address]map[basics.AppIndex]basics.TealKeyValue, appIdx basics.AppIndex) []dap.Variable {
+	variables := make([]dap.Variable, 0, len(locals))
+	for addr, local := range locals {
+		tkv, ok := local[appIdx]
+		if !ok {
+			continue
+		}
+		variables = append(variables, dap.Variable{
+			Name:  addr.String(),
+			Value: fmt.Sprintf("{%d}", len(tkv)),
+			VariablesReference: f.variablesReference(func() []dap.Variable {
+				return fieldsToVariables(tkvToFields(tkv))
+			}),
+		})
+	}
+	sort.Slice(variables, func(i, j int) bool { return variables[i].Name < variables[j].Name })
+	return variables
+}
+
+func tkvToFields(tkv basics.TealKeyValue) []fieldDesc {
+	fields := make([]fieldDesc, 0, len(tkv))
+	for key, value := range tkv {
+		fields = append(fields, tealValueToFieldDesc(key, value))
+	}
+	sort.Slice(fields, func(i, j int) bool { return fields[i].Name < fields[j].Name })
+	return fields
+}
+
+// boxesToVariables names boxes of other apps than the current one with the app
+func boxesToVariables(boxes []logic.DebugBox, current basics.AppIndex) []dap.Variable {
+	variables := make([]dap.Variable, 0, len(boxes))
+	for _, box := range boxes {
+		name := tealValueToFieldDesc("", basics.TealValue{Type: basics.TealBytesType, Bytes: box.Name}).Value
+		if box.App != current {
+			name = fmt.Sprintf("%s (app %d)", name, box.App)
+		}
+		field := tealValueToFieldDesc(name, basics.TealValue{Type: basics.TealBytesType, Bytes: box.Value})
+		variables = append(variables, fieldsToVariables([]fieldDesc{field})...)
+	}
+	return variables
+}
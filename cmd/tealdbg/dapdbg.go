@@ -0,0 +1,822 @@
+// Copyright (C) 2019-2024 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/Quarkonium-chain/go-quarkonium/cmd/tealdbg/dap"
+	"github.com/Quarkonium-chain/go-quarkonium/data/transactions/logic"
+)
+
+// dapThreadID is the only thread reported to the client. The program of an
+// inner transaction runs while its caller waits, so all executions are shown
+// as the stack frames of a single thread, innermost first.
+const dapThreadID = 1
+
+// how long WaitForCompletion waits for the client to disconnect
+const dapDisconnectTimeout = 5 * time.Second
+
+// DapFrontend is Debug Adapter Protocol frontend, for editors like VS Code
+type DapFrontend struct {
+	mu       deadlock.Mutex
+	listener net.Listener
+	verbose  bool
+
+	client *dapClient
+	// configured is closed when the first client is done configuring, and
+	// executions wait for it before running.
+	configured     chan struct{}
+	configuredOnce sync.Once
+	// detached is set when the client is gone, so executions run freely
+	detached    bool
+	stopOnEntry bool
+	lineBase    int
+	columnBase  int
+
+	sessions []*dapSession // executions in progress, callers first
+	stopped  *dapSession   // execution waiting for the client, if any
+	// last step or continue request and the depth of the execution it was for
+	action      string
+	actionDepth int
+	failed      bool
+
+	breakpoints  map[string][]*dap.Breakpoint // by sourceKey
+	breakpointID int
+	sources      []string       // content by source reference - 1
+	references   map[string]int // source reference by content key
+
+	// handles of the current stop
+	frames    []*dapSession
+	variables []func() []dap.Variable
+}
+
+// DapFrontendParams for Setup
+type DapFrontendParams struct {
+	address string
+	verbose bool
+}
+
+type dapSession struct {
+	sid           string
+	debugger      Control
+	notifications chan Notification
+	done          chan struct{}
+	// proceed is set when the execution failed, to wait for the client
+	proceed chan struct{}
+
+	name        string
+	inner       bool
+	source      *dap.Source // TEAL source, if known
+	sourceLines map[int]logic.SourceLocation
+	disassembly *dap.Source
+	lines       int
+
+	registered  bool
+	entering    bool
+	completed   bool
+	breakpoints map[int]bool // disassembly lines with breakpoints
+
+	state    logic.DebugState
+	appState AppState
+}
+
+type dapClient struct {
+	mu   deadlock.Mutex
+	conn net.Conn
+	seq  int
+	gone chan struct{}
+}
+
+// MakeDapFrontend creates new DapFrontend listening for clients on the address
+func MakeDapFrontend(params *DapFrontendParams) (f *DapFrontend, err error) {
+	listener, err := net.Listen("tcp", params.address)
+	if err != nil {
+		return nil, err
+	}
+
+	f = new(DapFrontend)
+	f.listener = listener
+	f.verbose = params.verbose
+	f.configured = make(chan struct{})
+	f.lineBase = 1
+	f.columnBase = 1
+	f.breakpoints = make(map[string][]*dap.Breakpoint)
+	f.references = make(map[string]int)
+
+	log.Printf("Debug Adapter Protocol server listening on %s", listener.Addr())
+	go f.serve()
+	return f, nil
+}
+
+// SessionStarted registers new session
+func (f *DapFrontend) SessionStarted(sid string, debugger Control, ch chan Notification) {
+	s := &dapSession{
+		sid:           sid,
+		debugger:      debugger,
+		notifications: ch,
+		done:          make(chan struct{}),
+		breakpoints:   make(map[int]bool),
+	}
+
+	f.mu.Lock()
+	f.sessions = append(f.sessions, s)
+	f.mu.Unlock()
+
+	go f.watch(s)
+}
+
+// SessionEnded returns once the client is done with the session
+func (f *DapFrontend) SessionEnded(sid string) {
+	f.mu.Lock()
+	s := f.session(sid)
+	f.mu.Unlock()
+	if s != nil {
+		<-s.done
+	}
+}
+
+// URL returns the address of the DAP server if there are sessions
+func (f *DapFrontend) URL() string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if len(f.sessions) == 0 {
+		return ""
+	}
+	return "tcp://" + f.listener.Addr().String()
+}
+
+// WaitForCompletion tells the client that debugging is over and returns
+// when it disconnects
+func (f *DapFrontend) WaitForCompletion() {
+	for {
+		f.mu.Lock()
+		active := len(f.sessions)
+		f.mu.Unlock()
+		if active == 0 {
+			break
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	f.mu.Lock()
+	c := f.client
+	if c != nil {
+		exitCode := 0
+		if f.failed {
+			exitCode = 1
+		}
+		f.event("exited", dap.ExitedEventBody{ExitCode: exitCode})
+		f.event("terminated", nil)
+	}
+	f.mu.Unlock()
+
+	if c != nil {
+		select {
+		case <-c.gone:
+		case <-time.After(dapDisconnectTimeout):
+		}
+	}
+	f.listener.Close()
+}
+
+// must be called with f.mu locked
+func (f *DapFrontend) session(sid string) *dapSession {
+	for _, s := range f.sessions {
+		if s.sid == sid {
+			return s
+		}
+	}
+	return nil
+}
+
+// must be called with f.mu locked
+func (f *DapFrontend) depth(s *dapSession) int {
+	for i := range f.sessions {
+		if f.sessions[i] == s {
+			return i
+		}
+	}
+	return -1
+}
+
+func (f *DapFrontend) watch(s *dapSession) {
+	for notification := range s.notifications {
+		switch notification.Event {
+		case "registered":
+			f.registered(s, notification.DebugState)
+		case "updated":
+			f.updated(s, notification.DebugState)
+		case "completed":
+			f.completed(s, notification.DebugState)
+
+			f.mu.Lock()
+			if i := f.depth(s); i >= 0 {
+				f.sessions = append(f.sessions[:i], f.sessions[i+1:]...)
+			}
+			f.mu.Unlock()
+			close(s.done)
+			return
+		}
+	}
+}
+
+func (f *DapFrontend) registered(s *dapSession, state logic.DebugState) {
+	// nothing runs until the client has set breakpoints
+	<-f.configured
+
+	f.mu.Lock()
+	f.setup(s, state)
+	for _, bp := range f.applyBreakpoints(s) {
+		f.event("breakpoint", dap.BreakpointEventBody{Reason: "changed", Breakpoint: bp})
+	}
+	s.entering = f.stopOnRegister(s)
+	f.mu.Unlock()
+
+	if s.entering {
+		// break on the first opcode rather than here, before it
+		s.debugger.Step()
+	} else {
+		s.debugger.Resume()
+	}
+}
+
+func (f *DapFrontend) updated(s *dapSession, state logic.DebugState) {
+	f.mu.Lock()
+	if f.detached {
+		f.mu.Unlock()
+		s.debugger.Resume()
+		return
+	}
+	defer f.mu.Unlock()
+
+	s.state = state
+	reason := "step"
+	if s.entering {
+		reason = "entry"
+		s.entering = false
+	} else if s.breakpoints[state.Line] {
+		reason = "breakpoint"
+	}
+	f.stop(s, reason, "")
+}
+
+func (f *DapFrontend) completed(s *dapSession, state logic.DebugState) {
+	f.mu.Lock()
+	s.state = state
+	s.completed = true
+	if len(state.Error) == 0 {
+		f.mu.Unlock()
+		return
+	}
+
+	f.failed = true
+	if f.detached {
+		f.mu.Unlock()
+		return
+	}
+	f.event("output", dap.OutputEventBody{Category: "stderr", Output: fmt.Sprintf("%s: %s\n", s.name, state.Error)})
+	proceed := make(chan struct{})
+	s.proceed = proceed
+	f.stop(s, "exception", state.Error)
+	f.mu.Unlock()
+
+	<-proceed
+}
+
+// setup fills in the session from its registration state.
+// Must be called with f.mu locked.
+func (f *DapFrontend) setup(s *dapSession, state logic.DebugState) {
+	s.registered = true
+	s.state = state
+	s.appState = s.debugger.GetStates(nil)
+
+	hash, counter, inner := strings.Cut(s.sid, "/")
+	name, source := s.debugger.GetSource()
+	if len(name) == 0 {
+		name = hash[:min(len(hash), 8)]
+	}
+	s.name = name
+	s.inner = inner
+	if inner {
+		s.name = fmt.Sprintf("%s (inner %s)", name, counter)
+	}
+
+	s.disassembly = &dap.Source{
+		Name:            name + ".dis",
+		SourceReference: f.reference("dis:"+hash, state.Disassembly),
+	}
+	s.lines = strings.Count(state.Disassembly, "\n") + 1
+
+	if len(source) != 0 {
+		s.sourceLines = s.debugger.GetSourceLines()
+		path, err := filepath.Abs(name)
+		if _, statErr := os.Stat(path); err == nil && statErr == nil {
+			s.source = &dap.Source{Name: filepath.Base(path), Path: path}
+		} else {
+			s.source = &dap.Source{Name: name, SourceReference: f.reference("src:"+hash, string(source))}
+		}
+	}
+}
+
+// reference returns the source reference serving the content.
+// Must be called with f.mu locked.
+func (f *DapFrontend) reference(key string, content string) int {
+	ref, ok := f.references[key]
+	if !ok {
+		f.sources = append(f.sources, content)
+		ref = len(f.sources)
+		f.references[key] = ref
+	}
+	return ref
+}
+
+func sourceKey(src *dap.Source) string {
+	if src.SourceReference != 0 {
+		return fmt.Sprintf("ref:%d", src.SourceReference)
+	}
+	return "path:" + filepath.Clean(src.Path)
+}
+
+// disassemblyLine returns the line of the disassembly to break at for a line
+// of the source, or of the disassembly itself if not mapped, both from 0.
+func (s *dapSession) disassemblyLine(line int, mapped bool) (int, bool) {
+	if !mapped {
+		return line, line >= 0 && line < s.lines
+	}
+	found := -1
+	for dline, loc := range s.sourceLines {
+		if loc.File == 0 && loc.Line == line && (found < 0 || dline < found) {
+			found = dline
+		}
+	}
+	return found, found >= 0
+}
+
+// applyBreakpoints sets the breakpoints of the client in the session and
+// returns the breakpoints that became verified or unverified.
+// Must be called with f.mu locked.
+func (f *DapFrontend) applyBreakpoints(s *dapSession) (changed []dap.Breakpoint) {
+	if !s.registered {
+		return nil
+	}
+	lines := make(map[int]bool)
+	apply := func(src *dap.Source, mapped bool) {
+		if src == nil {
+			return
+		}
+		for _, bp := range f.breakpoints[sourceKey(src)] {
+			line, ok := s.disassemblyLine(bp.Line-f.lineBase, mapped)
+			if ok {
+				lines[line] = true
+			}
+			if bp.Verified != ok {
+				bp.Verified = ok
+				changed = append(changed, *bp)
+			}
+		}
+	}
+	apply(s.source, true)
+	apply(s.disassembly, false)
+
+	for line := range s.breakpoints {
+		if !lines[line] {
+			s.debugger.RemoveBreakpoint(line)
+		}
+	}
+	for line := range lines {
+		if !s.breakpoints[line] {
+			s.debugger.SetBreakpoint(line)
+		}
+	}
+	s.breakpoints = lines
+	return changed
+}
+
+// stopOnRegister tells whether a new execution stops on its first opcode.
+// Must be called with f.mu locked.
+func (f *DapFrontend) stopOnRegister(s *dapSession) bool {
+	if f.detached {
+		return false
+	}
+	switch f.action {
+	case "":
+		return f.stopOnEntry
+	case "stepIn":
+		return true
+	case "next", "stepOut":
+		// stepping off the end of a program moves on to the next one, but
+		// stepping over itxn_submit does not step into its programs
+		return f.depth(s) <= f.actionDepth
+	}
+	return false
+}
+
+// must be called with f.mu locked
+func (f *DapFrontend) stop(s *dapSession, reason string, text string) {
+	s.appState = s.debugger.GetStates(&s.state)
+	f.stopped = s
+	f.frames = nil
+	f.variables = nil
+	f.event("stopped", dap.StoppedEventBody{
+		Reason:            reason,
+		Text:              text,
+		ThreadID:          dapThreadID,
+		AllThreadsStopped: true,
+	})
+}
+
+// proceed lets the stopped execution continue as requested by the action
+func (f *DapFrontend) proceed(action string) error {
+	f.mu.Lock()
+	s := f.stopped
+	if s == nil {
+		f.mu.Unlock()
+		return fmt.Errorf("not stopped")
+	}
+	f.stopped = nil
+	f.frames = nil
+	f.variables = nil
+	f.action = action
+	f.actionDepth = f.depth(s)
+	callers := append([]*dapSession(nil), f.sessions[:max(f.actionDepth, 0)]...)
+	f.mu.Unlock()
+
+	// callers continue after the stopped execution completes
+	for _, caller := range callers {
+		if action == "continue" {
+			caller.debugger.ResumeLater()
+		} else {
+			caller.debugger.StepLater()
+		}
+	}
+
+	if s.completed {
+		close(s.proceed)
+		return nil
+	}
+	switch action {
+	case "continue":
+		s.debugger.Resume()
+	case "next":
+		s.debugger.StepOver()
+	case "stepIn":
+		s.debugger.Step()
+	case "stepOut":
+		s.debugger.StepOut()
+	}
+	return nil
+}
+
+// event sends an event to the client, if any. Must be called with f.mu locked.
+func (f *DapFrontend) event(name string, body interface{}) {
+	if f.client != nil {
+		f.client.send(&dap.Event{Event: name, Body: body}, f.verbose)
+	}
+}
+
+func (c *dapClient) send(msg interface{}, verbose bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.seq++
+	switch m := msg.(type) {
+	case *dap.Event:
+		m.ProtocolMessage = dap.ProtocolMessage{Seq: c.seq, Type: dap.EventType}
+	case *dap.Response:
+		m.ProtocolMessage = dap.ProtocolMessage{Seq: c.seq, Type: dap.ResponseType}
+	}
+	err := dap.WriteMessage(c.conn, msg)
+	if err != nil && verbose {
+		log.Printf("DAP write error: %v", err)
+	}
+}
+
+func (c *dapClient) respond(req *dap.Request, body interface{}, err error, verbose bool) {
+	resp := dap.Response{RequestSeq: req.Seq, Command: req.Command, Success: err == nil, Body: body}
+	if err != nil {
+		resp.Message = err.Error()
+	}
+	c.send(&resp, verbose)
+}
+
+func (f *DapFrontend) serve() {
+	for {
+		conn, err := f.listener.Accept()
+		if err != nil {
+			return
+		}
+
+		f.mu.Lock()
+		busy := f.client != nil
+		c := &dapClient{conn: conn, gone: make(chan struct{})}
+		if !busy {
+			f.client = c
+			f.detached = false
+		}
+		f.mu.Unlock()
+
+		if busy {
+			log.Printf("DAP client %s refused: another client is connected", conn.RemoteAddr())
+			conn.Close()
+			continue
+		}
+		log.Printf("DAP client %s connected", conn.RemoteAddr())
+		go f.handle(c)
+	}
+}
+
+func (f *DapFrontend) handle(c *dapClient) {
+	r := bufio.NewReader(c.conn)
+	for {
+		req, err := dap.ReadRequest(r)
+		if err != nil {
+			if err != io.EOF && f.verbose {
+				log.Printf("DAP read error: %v", err)
+			}
+			break
+		}
+		if f.verbose {
+			log.Printf("DAP request: %s %s", req.Command, string(req.Arguments))
+		}
+		if !f.handleRequest(c, req) {
+			break
+		}
+	}
+	c.conn.Close()
+	f.detach(c)
+	close(c.gone)
+	log.Printf("DAP client %s disconnected", c.conn.RemoteAddr())
+}
+
+// detach lets the executions run to completion once the client is gone
+func (f *DapFrontend) detach(c *dapClient) {
+	f.mu.Lock()
+	if f.client != c {
+		f.mu.Unlock()
+		return
+	}
+	f.client = nil
+	f.detached = true
+	f.breakpoints = make(map[string][]*dap.Breakpoint)
+	for _, s := range f.sessions {
+		s.debugger.SetBreakpointsActive(false)
+		s.breakpoints = make(map[int]bool)
+	}
+	stopped := f.stopped != nil
+	f.mu.Unlock()
+
+	f.configuredOnce.Do(func() { close(f.configured) })
+	if stopped {
+		f.proceed("continue")
+	}
+}
+
+// handleRequest serves a request and tells whether to read more
+func (f *DapFrontend) handleRequest(c *dapClient, req *dap.Request) bool {
+	var body interface{}
+	var err error
+	switch req.Command {
+	case "initialize":
+		var args dap.InitializeRequestArguments
+		if err = unmarshalArguments(req, &args); err == nil {
+			f.mu.Lock()
+			f.lineBase = startsAt1(args.LinesStartAt1)
+			f.columnBase = startsAt1(args.ColumnsStartAt1)
+			f.mu.Unlock()
+			body = dap.Capabilities{
+				SupportsConfigurationDoneRequest: true,
+				SupportsTerminateRequest:         true,
+			}
+		}
+		c.respond(req, body, err, f.verbose)
+		if err == nil {
+			c.send(&dap.Event{Event: "initialized"}, f.verbose)
+		}
+		return true
+	case "launch", "attach":
+		var args dap.LaunchRequestArguments
+		if err = unmarshalArguments(req, &args); err == nil {
+			f.mu.Lock()
+			f.stopOnEntry = args.StopOnEntry
+			f.mu.Unlock()
+		}
+	case "setBreakpoints":
+		var args dap.SetBreakpointsArguments
+		if err = unmarshalArguments(req, &args); err == nil {
+			body = f.setBreakpoints(&args)
+		}
+	case "configurationDone":
+		f.configuredOnce.Do(func() { close(f.configured) })
+	case "threads":
+		body = dap.ThreadsResponseBody{Threads: []dap.Thread{{ID: dapThreadID, Name: "TEAL"}}}
+	case "stackTrace":
+		body, err = f.stackTrace()
+	case "scopes":
+		var args dap.ScopesArguments
+		if err = unmarshalArguments(req, &args); err == nil {
+			body, err = f.scopes(args.FrameID)
+		}
+	case "variables":
+		var args dap.VariablesArguments
+		if err = unmarshalArguments(req, &args); err == nil {
+			body, err = f.getVariables(args.VariablesReference)
+		}
+	case "source":
+		var args dap.SourceArguments
+		if err = unmarshalArguments(req, &args); err == nil {
+			body, err = f.source(&args)
+		}
+	case "continue":
+		body = dap.ContinueResponseBody{AllThreadsContinued: true}
+		fallthrough
+	case "next", "stepIn", "stepOut":
+		// respond first, so that the client sees any stop after the response
+		c.respond(req, body, nil, f.verbose)
+		if err = f.proceed(req.Command); err != nil && f.verbose {
+			log.Printf("DAP %s: %v", req.Command, err)
+		}
+		return true
+	case "terminate":
+		c.respond(req, nil, nil, f.verbose)
+		c.send(&dap.Event{Event: "terminated"}, f.verbose)
+		f.detach(c)
+		return true
+	case "disconnect":
+		c.respond(req, nil, nil, f.verbose)
+		return false
+	default:
+		err = fmt.Errorf("unsupported request %s", req.Command)
+	}
+	c.respond(req, body, err, f.verbose)
+	return true
+}
+
+func unmarshalArguments(req *dap.Request, args interface{}) error {
+	if len(req.Arguments) == 0 {
+		return nil
+	}
+	return json.Unmarshal(req.Arguments, args)
+}
+
+func startsAt1(flag *bool) int {
+	if flag != nil && !*flag {
+		return 0
+	}
+	return 1
+}
+
+func (f *DapFrontend) setBreakpoints(args *dap.SetBreakpointsArguments) dap.SetBreakpointsResponseBody {
+	lines := args.Lines
+	if len(args.Breakpoints) > 0 {
+		lines = make([]int, len(args.Breakpoints))
+		for i, bp := range args.Breakpoints {
+			lines[i] = bp.Line
+		}
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	key := sourceKey(&args.Source)
+	bps := make([]*dap.Breakpoint, len(lines))
+	for i, line := range lines {
+		f.breakpointID++
+		bps[i] = &dap.Breakpoint{ID: f.breakpointID, Line: line, Source: &args.Source}
+	}
+	f.breakpoints[key] = bps
+
+	for _, s := range f.sessions {
+		f.applyBreakpoints(s)
+		if f.stopped == nil && s.registered && !s.entering {
+			// setting breakpoints reconfigures a running execution
+			s.debugger.ResumeLater()
+		}
+	}
+
+	result := dap.SetBreakpointsResponseBody{Breakpoints: make([]dap.Breakpoint, len(bps))}
+	for i, bp := range bps {
+		result.Breakpoints[i] = *bp
+	}
+	return result
+}
+
+func (f *DapFrontend) source(args *dap.SourceArguments) (dap.SourceResponseBody, error) {
+	ref := args.SourceReference
+	if args.Source != nil && args.Source.SourceReference != 0 {
+		ref = args.Source.SourceReference
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if ref <= 0 || ref > len(f.sources) {
+		return dap.SourceResponseBody{}, fmt.Errorf("unknown source reference %d", ref)
+	}
+	return dap.SourceResponseBody{Content: f.sources[ref-1], MimeType: "text/x-teal"}, nil
+}
+
+// location returns where a disassembly line is shown: in the source if the
+// line maps to it, and in the disassembly otherwise, with lines from 0.
+func (s *dapSession) location(line int) (*dap.Source, int, int) {
+	if s.source != nil {
+		if loc, ok := s.sourceLines[line]; ok && loc.File == 0 {
+			return s.source, loc.Line, loc.Column
+		}
+	}
+	return s.disassembly, line, 0
+}
+
+// must be called with f.mu locked
+func (f *DapFrontend) frame(s *dapSession, name string, line int) dap.StackFrame {
+	f.frames = append(f.frames, s)
+	src, line, column := s.location(line)
+	return dap.StackFrame{
+		ID:     len(f.frames),
+		Name:   name,
+		Source: src,
+		Line:   line + f.lineBase,
+		Column: column + f.columnBase,
+	}
+}
+
+func (f *DapFrontend) stackTrace() (dap.StackTraceResponseBody, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.stopped == nil {
+		return dap.StackTraceResponseBody{}, fmt.Errorf("not stopped")
+	}
+
+	f.frames = nil
+	f.variables = nil
+	var frames []dap.StackFrame
+	for i := len(f.sessions) - 1; i >= 0; i-- {
+		s := f.sessions[i]
+		if !s.registered {
+			continue
+		}
+		if s != f.stopped {
+			// callers are in the middle of itxn_submit
+			frames = append(frames, f.frame(s, s.name, s.debugger.GetLine()))
+			continue
+		}
+		// subroutines first, each called from the line of the frame below
+		line := s.state.Line
+		for j := len(s.state.CallStack) - 1; j >= 0; j-- {
+			frames = append(frames, f.frame(s, s.state.CallStack[j].LabelName, line))
+			line = s.state.CallStack[j].FrameLine
+		}
+		frames = append(frames, f.frame(s, s.name, line))
+	}
+	return dap.StackTraceResponseBody{StackFrames: frames, TotalFrames: len(frames)}, nil
+}
+
+func (f *DapFrontend) scopes(frameID int) (dap.ScopesResponseBody, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if frameID <= 0 || frameID > len(f.frames) {
+		return dap.ScopesResponseBody{}, fmt.Errorf("unknown frame %d", frameID)
+	}
+	s := f.frames[frameID-1]
+	return dap.ScopesResponseBody{Scopes: f.makeScopes(s, s == f.stopped)}, nil
+}
+
+func (f *DapFrontend) getVariables(ref int) (dap.VariablesResponseBody, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if ref <= 0 || ref > len(f.variables) {
+		return dap.VariablesResponseBody{}, fmt.Errorf("unknown variables reference %d", ref)
+	}
+	return dap.VariablesResponseBody{Variables: f.variables[ref-1]()}, nil
+}
+
+// variablesReference returns a handle for the variables, valid until the
+// execution continues. Must be called with f.mu locked.
+func (f *DapFrontend) variablesReference(variables func() []dap.Variable) int {
+	f.variables = append(f.variables, variables)
+	return len(f.variables)
+}
@@ -0,0 +1,339 @@
+// Copyright (C) 2019-2024 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"bufio"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net"
+	"net/textproto"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Quarkonium-chain/go-quarkonium/cmd/tealdbg/dap"
+	"github.com/Quarkonium-chain/go-quarkonium/data/basics"
+	"github.com/Quarkonium-chain/go-quarkonium/data/transactions"
+	"github.com/Quarkonium-chain/go-quarkonium/data/transactions/logic"
+	"github.com/Quarkonium-chain/go-quarkonium/protocol"
+	"github.com/Quarkonium-chain/go-quarkonium/test/partitiontest"
+)
+
+type dapTestMessage struct {
+	Type    string          `json:"type"`
+	Command string          `json:"command"`
+	Event   string          `json:"event"`
+	Success bool            `json:"success"`
+	Message string          `json:"message"`
+	Body    json.RawMessage `json:"body"`
+}
+
+type dapTestClient struct {
+	t    *testing.T
+	conn net.Conn
+	r    *bufio.Reader
+	seq  int
+}
+
+func (c *dapTestClient) request(command string, args interface{}) {
+	req := dap.Request{Command: command}
+	c.seq++
+	req.ProtocolMessage = dap.ProtocolMessage{Seq: c.seq, Type: dap.RequestType}
+	if args != nil {
+		data, err := json.Marshal(args)
+		require.NoError(c.t, err)
+		req.Arguments = data
+	}
+	require.NoError(c.t, dap.WriteMessage(c.conn, &req))
+}
+
+func (c *dapTestClient) read() (msg dapTestMessage) {
+	c.conn.SetReadDeadline(time.Now().Add(10 * time.Second))
+	header, err := textproto.NewReader(c.r).ReadMIMEHeader()
+	require.NoError(c.t, err)
+	length, err := strconv.Atoi(header.Get("Content-Length"))
+	require.NoError(c.t, err)
+	content := make([]byte, length)
+	_, err = io.ReadFull(c.r, content)
+	require.NoError(c.t, err)
+	require.NoError(c.t, json.Unmarshal(content, &msg))
+	return
+}
+
+// expect skips messages up to the response or event of the name, and
+// decodes its body
+func (c *dapTestClient) expect(kind string, name string, body interface{}) {
+	for {
+		msg := c.read()
+		if msg.Type != kind || msg.Command+msg.Event != name {
+			continue
+		}
+		if kind == dap.ResponseType {
+			require.True(c.t, msg.Success, "%s failed: %s", name, msg.Message)
+		}
+		if body != nil {
+			require.NoError(c.t, json.Unmarshal(msg.Body, body))
+		}
+		return
+	}
+}
+
+// call sends a request and decodes the body of the response
+func (c *dapTestClient) call(command string, args interface{}, body interface{}) {
+	c.request(command, args)
+	c.expect(dap.ResponseType, command, body)
+}
+
+func (c *dapTestClient) stopped(reason string) dap.StackTraceResponseBody {
+	var stopped dap.StoppedEventBody
+	c.expect(dap.EventType, "stopped", &stopped)
+	require.Equal(c.t, reason, stopped.Reason)
+	var trace dap.StackTraceResponseBody
+	c.call("stackTrace", dap.StackTraceArguments{ThreadID: dapThreadID}, &trace)
+	return trace
+}
+
+func (c *dapTestClient) variables(frameID int, scopeName string) []dap.Variable {
+	var scopes dap.ScopesResponseBody
+	c.call("scopes", dap.ScopesArguments{FrameID: frameID}, &scopes)
+	for _, scope := range scopes.Scopes {
+		if scope.Name == scopeName {
+			var variables dap.VariablesResponseBody
+			c.call("variables", dap.VariablesArguments{VariablesReference: scope.VariablesReference}, &variables)
+			return variables.Variables
+		}
+	}
+	require.Fail(c.t, "no scope", scopeName)
+	return nil
+}
+
+// startDapTest runs the programs with a DAP frontend and connects a client
+// that has started configuring
+func startDapTest(t *testing.T, dp *DebugParams, stopOnEntry bool) (*dapTestClient, chan struct{}) {
+	f, err := MakeDapFrontend(&DapFrontendParams{address: "127.0.0.1:0"})
+	require.NoError(t, err)
+	debugger := MakeDebugger()
+	debugger.AddAdapter(f)
+
+	dp.DebugInners = true
+	local := MakeLocalRunner(debugger)
+	require.NoError(t, local.Setup(dp))
+
+	done := make(chan struct{})
+	go func() {
+		local.RunAll()
+		f.WaitForCompletion()
+		close(done)
+	}()
+
+	conn, err := net.Dial("tcp", f.listener.Addr().String())
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+	c := &dapTestClient{t: t, conn: conn, r: bufio.NewReader(conn)}
+
+	var capabilities dap.Capabilities
+	c.call("initialize", dap.InitializeRequestArguments{AdapterID: "teal"}, &capabilities)
+	require.True(t, capabilities.SupportsConfigurationDoneRequest)
+	c.expect(dap.EventType, "initialized", nil)
+	c.call("launch", dap.LaunchRequestArguments{StopOnEntry: stopOnEntry}, nil)
+	return c, done
+}
+
+func finishDapTest(t *testing.T, c *dapTestClient, done chan struct{}, exitCode int) {
+	var exited dap.ExitedEventBody
+	c.expect(dap.EventType, "exited", &exited)
+	require.Equal(t, exitCode, exited.ExitCode)
+	c.expect(dap.EventType, "terminated", nil)
+	c.call("disconnect", nil, nil)
+	select {
+	case <-done:
+	case <-time.After(10 * time.Second):
+		require.Fail(t, "debugging did not complete")
+	}
+}
+
+func TestDapFrontendSubroutines(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	source := `#pragma version 8
+int 1
+callsub double
+int 2
+==
+return
+double:
+dup
++
+retsub
+`
+	path := filepath.Join(t.TempDir(), "double.teal")
+	require.NoError(t, os.WriteFile(path, []byte(source), 0666))
+
+	c, done := startDapTest(t, &DebugParams{
+		ProgramNames: []string{path},
+		ProgramBlobs: [][]byte{[]byte(source)},
+		TxnBlob:      []byte(txnSample),
+		RunMode:      "signature",
+	}, true)
+
+	var bps dap.SetBreakpointsResponseBody
+	c.call("setBreakpoints", dap.SetBreakpointsArguments{
+		Source:      dap.Source{Path: path},
+		Breakpoints: []dap.SourceBreakpoint{{Line: 8}, {Line: 7}},
+	}, &bps)
+	require.Len(t, bps.Breakpoints, 2)
+	var threads dap.ThreadsResponseBody
+	c.call("threads", nil, &threads)
+	require.Equal(t, []dap.Thread{{ID: dapThreadID, Name: "TEAL"}}, threads.Threads)
+	c.call("configurationDone", nil, nil)
+
+	// the label has no code, so only the breakpoint on dup is verified once
+	// the program is loaded
+	var event dap.BreakpointEventBody
+	c.expect(dap.EventType, "breakpoint", &event)
+	require.Equal(t, bps.Breakpoints[0].ID, event.Breakpoint.ID)
+	require.True(t, event.Breakpoint.Verified)
+
+	trace := c.stopped("entry")
+	require.Len(t, trace.StackFrames, 1)
+	require.Equal(t, path, trace.StackFrames[0].Source.Path)
+	require.Equal(t, 2, trace.StackFrames[0].Line)
+	require.Equal(t, path, trace.StackFrames[0].Name)
+
+	c.call("continue", dap.StackTraceArguments{ThreadID: dapThreadID}, nil)
+	trace = c.stopped("breakpoint")
+	require.Len(t, trace.StackFrames, 2)
+	require.Equal(t, "label1", trace.StackFrames[0].Name) // labels are from the disassembly
+	require.Equal(t, 8, trace.StackFrames[0].Line)
+	require.Equal(t, 3, trace.StackFrames[1].Line)
+	require.Equal(t, []dap.Variable{{Name: "0", Value: "1", Type: "uint64"}}, c.variables(trace.StackFrames[0].ID, "Stack"))
+
+	c.call("next", dap.StackTraceArguments{ThreadID: dapThreadID}, nil)
+	trace = c.stopped("step")
+	require.Equal(t, 9, trace.StackFrames[0].Line)
+	c.call("stepOut", dap.StackTraceArguments{ThreadID: dapThreadID}, nil)
+	trace = c.stopped("step")
+	require.Len(t, trace.StackFrames, 1)
+	require.Equal(t, 4, trace.StackFrames[0].Line)
+	require.Equal(t, []dap.Variable{{Name: "0", Value: "2", Type: "uint64"}}, c.variables(trace.StackFrames[0].ID, "Stack"))
+
+	c.call("continue", dap.StackTraceArguments{ThreadID: dapThreadID}, nil)
+	finishDapTest(t, c, done, 0)
+}
+
+func TestDapFrontendInnerPrograms(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	sender, err := basics.UnmarshalChecksumAddress("47YPQTIGQEO7T4Y4RWDYWEKV6RTR2UNBQXBABEEGM72ESWDQNCQ52OPASU")
+	require.NoError(t, err)
+	appIdx := basics.AppIndex(100)
+	brs := makeSampleBalanceRecord(sender, 50, appIdx)
+	bra := makeSampleBalanceRecord(appIdx.Address(), 50, appIdx)
+	balanceBlob := append(protocol.EncodeMsgp(&brs), protocol.EncodeMsgp(&bra)...)
+
+	txn := transactions.SignedTxn{
+		Txn: transactions.Transaction{
+			Type: protocol.ApplicationCallTx,
+			Header: transactions.Header{
+				Sender: sender,
+				Fee:    basics.MicroAlgos{Raw: 1000},
+			},
+			ApplicationCallTxnFields: transactions.ApplicationCallTxnFields{
+				ApplicationID: appIdx,
+				Boxes:         []transactions.BoxRef{{Name: []byte("box")}},
+			},
+		},
+	}
+
+	inner, err := logic.AssembleString("#pragma version 8\nbyte \"hi\"\nlog\nint 1")
+	require.NoError(t, err)
+	source := `#pragma version 8
+byte "box"
+int 3
+box_create
+pop
+itxn_begin
+int appl
+itxn_field TypeEnum
+byte 0x` + hex.EncodeToString(inner.Program) + `
+dup
+itxn_field ApprovalProgram
+itxn_field ClearStateProgram
+itxn_submit
+int 1`
+
+	c, done := startDapTest(t, &DebugParams{
+		ProgramNames: []string{"outer"},
+		ProgramBlobs: [][]byte{[]byte(source)},
+		BalanceBlob:  balanceBlob,
+		TxnBlob:      protocol.EncodeJSON(&txn),
+		RunMode:      "application",
+	}, false)
+
+	// the program name is not a file, so the source is served by reference
+	var bps dap.SetBreakpointsResponseBody
+	c.call("setBreakpoints", dap.SetBreakpointsArguments{
+		Source: dap.Source{SourceReference: 1},
+		Lines:  []int{13},
+	}, &bps)
+	c.call("configurationDone", nil, nil)
+
+	trace := c.stopped("breakpoint")
+	require.Len(t, trace.StackFrames, 1)
+	require.Equal(t, 13, trace.StackFrames[0].Line)
+	outer := trace.StackFrames[0].Source
+	require.Equal(t, "outer", outer.Name)
+	var content dap.SourceResponseBody
+	c.call("source", dap.SourceArguments{SourceReference: outer.SourceReference}, &content)
+	require.Equal(t, source, content.Content)
+	require.Equal(t, []dap.Variable{{Name: "box", Value: "\\x00\\x00\\x00", Type: "[]byte"}}, c.variables(trace.StackFrames[0].ID, "Boxes"))
+
+	c.call("stepIn", dap.StackTraceArguments{ThreadID: dapThreadID}, nil)
+	trace = c.stopped("entry")
+	require.Len(t, trace.StackFrames, 2)
+	require.True(t, strings.HasSuffix(trace.StackFrames[0].Name, "(inner 1)"), trace.StackFrames[0].Name)
+	require.Equal(t, 2, trace.StackFrames[0].Line)
+	require.Equal(t, 13, trace.StackFrames[1].Line)
+	c.call("source", dap.SourceArguments{Source: trace.StackFrames[0].Source}, &content)
+	require.Contains(t, content.Content, "log")
+
+	// stepping off the end of the inner program stops after itxn_submit
+	c.call("next", dap.StackTraceArguments{ThreadID: dapThreadID}, nil)
+	trace = c.stopped("step")
+	require.Equal(t, 3, trace.StackFrames[0].Line)
+	c.call("next", dap.StackTraceArguments{ThreadID: dapThreadID}, nil)
+	c.stopped("step")
+	c.call("next", dap.StackTraceArguments{ThreadID: dapThreadID}, nil)
+	trace = c.stopped("step")
+	require.Len(t, trace.StackFrames, 1)
+	require.Equal(t, 14, trace.StackFrames[0].Line)
+	itxns := c.variables(trace.StackFrames[0].ID, "Inner Transactions")
+	require.Len(t, itxns, 1)
+	require.Equal(t, "appl", itxns[0].Value)
+
+	c.call("continue", dap.StackTraceArguments{ThreadID: dapThreadID}, nil)
+	finishDapTest(t, c, done, 0)
+}
@@ -57,12 +57,21 @@ type Control interface {
 	StepOver()
 	StepOut()
 	Resume()
+	// StepLater and ResumeLater configure the execution like Step and Resume
+	// but do not let it continue. They are for executions waiting on an inner
+	// program, which continue once the inner program completes.
+	StepLater()
+	ResumeLater()
 	SetBreakpoint(line int) error
 	RemoveBreakpoint(line int) error
 	SetBreakpointsActive(active bool)
 
 	GetSourceMap() ([]byte, error)
 	GetSource() (string, []byte)
+	// GetSourceLines returns the source location of disassembly lines
+	GetSourceLines() map[int]logic.SourceLocation
+	// GetLine returns the disassembly line being executed
+	GetLine() int
 	GetStates(s *logic.DebugState) AppState
 }
 
@@ -219,16 +228,17 @@ func (s *session) resume() {
 }
 
 func (s *session) Step() {
-	func() {
-		s.mu.Lock()
-		defer s.mu.Unlock()
-		s.debugConfig = makeDebugConfig()
-		s.debugConfig.setStepBreak()
-	}()
-
+	s.StepLater()
 	s.resume()
 }
 
+func (s *session) StepLater() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.debugConfig = makeDebugConfig()
+	s.debugConfig.setStepBreak()
+}
+
 func (s *session) StepOver() {
 	func() {
 		s.mu.Lock()
@@ -275,22 +285,23 @@ func (s *session) StepOut() {
 }
 
 func (s *session) Resume() {
-	func() {
-		s.mu.Lock()
-		defer s.mu.Unlock()
-		s.debugConfig = makeDebugConfig()
-		// find any active breakpoints and set break
-		for line, state := range s.breakpoints {
-			if state.set && state.active {
-				err := s.setBreakpoint(line)
-				if err != nil {
-					s.debugConfig.setStepBreak()
-				}
+	s.ResumeLater()
+	s.resume()
+}
+
+func (s *session) ResumeLater() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.debugConfig = makeDebugConfig()
+	// find any active breakpoints and set break
+	for line, state := range s.breakpoints {
+		if state.set && state.active {
+			err := s.setBreakpoint(line)
+			if err != nil {
+				s.debugConfig.setStepBreak()
 			}
 		}
-	}()
-
-	s.resume()
+	}
 }
 
 // setBreakpoint must be called with lock taken
@@ -406,6 +417,20 @@ func (s *session) GetSource() (string, []byte) {
 	return s.programName, []byte(s.source)
 }
 
+func (s *session) GetSourceLines() map[int]logic.SourceLocation {
+	lines := make(map[int]logic.SourceLocation, len(s.pcOffset))
+	for line, pc := range s.pcOffset {
+		if loc, ok := s.offsetToSource[pc]; ok {
+			lines[line] = loc
+		}
+	}
+	return lines
+}
+
+func (s *session) GetLine() int {
+	return s.line.Load()
+}
+
 func (s *session) GetStates(st *logic.DebugState) AppState {
 	if st == nil {
 		return s.states
@@ -484,6 +509,15 @@ func (d *Debugger) createSession(sid string, disassembly string, line int, pcOff
 	s = makeSession(disassembly, line)
 	d.sessions[sid] = s
 	meta, ok := d.programs[sid]
+	if !ok {
+		// inner programs are reported as the program hash and a counter, and
+		// share the source of a saved program but not its application state
+		if hash, _, inner := strings.Cut(sid, "/"); inner {
+			if meta, ok = d.programs[hash]; ok {
+				meta = &programMeta{name: meta.name, program: meta.program, source: meta.source, offsetToSource: meta.offsetToSource}
+			}
+		}
+	}
 	if ok {
 		s.programName = meta.name
 		s.program = meta.program
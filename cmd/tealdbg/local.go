@@ -253,6 +253,7 @@ type LocalRunner struct {
 	protoName string
 	txnGroup  []transactions.SignedTxn
 	runs      []evaluation
+	inners    bool
 }
 
 func makeAppState() (states AppState) {
@@ -324,6 +325,7 @@ func (r *LocalRunner) Setup(dp *DebugParams) (err error) {
 
 	log.Printf("Using proto: %s", r.protoName)
 
+	r.inners = dp.DebugInners
 	r.txnGroup = ddr.Txns
 	if len(dp.TxnBlob) != 0 || len(r.txnGroup) == 0 {
 		r.txnGroup, err = txnGroupFromParams(dp)
@@ -539,6 +541,9 @@ func (r *LocalRunner) RunAll() error {
 	aep := logic.NewAppEvalParams(txngroup, &r.proto, &transactions.SpecialAddresses{})
 	if r.debugger != nil {
 		t := logic.MakeEvalTracerDebuggerAdaptor(r.debugger)
+		if r.inners {
+			t = logic.MakeEvalTracerDebuggerAdaptorWithInners(r.debugger)
+		}
 		sep.Tracer = t
 		aep.Tracer = t
 	}
@@ -326,7 +326,11 @@ func (l *localLedger) LookupApplication(rnd basics.Round, addr basics.Address, a
 }
 
 func (l *localLedger) LookupKv(rnd basics.Round, name string) ([]byte, error) {
-	return nil, fmt.Errorf("boxes not implemented in debugger")
+	// The balance records tealdbg runs with have no place for boxes, so no
+	// box exists until the program creates it. Like the ledger, report a
+	// missing box with a nil value rather than an error, so that box opcodes
+	// can run.
+	return nil, nil
 }
 
 func (l *localLedger) LookupWithoutRewards(rnd basics.Round, addr basics.Address) (ledgercore.AccountData, basics.Round, error) {
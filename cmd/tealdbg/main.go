@@ -17,6 +17,7 @@
 package main
 
 import (
+	"fmt"
 	"log"
 	"os"
 
@@ -49,7 +50,7 @@ var rootCmd = &cobra.Command{
 	Use:   "tealdbg",
 	Short: "Algorand TEAL Debugger",
 	Long: `Debug a local or remote TEAL code in controlled environment
-with Web or Chrome DevTools frontends`,
+with Web, Chrome DevTools or Debug Adapter Protocol frontends`,
 	Run: func(cmd *cobra.Command, args []string) {
 		//If no arguments passed, we should fallback to help
 		cmd.HelpFunc()(cmd, args)
@@ -76,6 +77,19 @@ var remoteCmd = &cobra.Command{
 	},
 }
 
+var replayCmd = &cobra.Command{
+	Use:   "replay trace.json",
+	Short: "Replay an execution trace of simulate",
+	Long: `Step forward and backward through the programs of a transaction group
+as recorded in a simulate response with an execution trace, such as written by
+goal clerk simulate --full-trace. Nothing is evaluated again, so the replay
+shows the state the group ran against on the network.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		replayTrace(args[0])
+	},
+}
+
 type frontendValue struct {
 	*cmdutil.CobraStringValue
 }
@@ -89,6 +103,12 @@ func (f *frontendValue) Make(router *mux.Router, appAddress string) (da DebugAda
 	case "web":
 		wa := MakeWebPageFrontend(&WebPageFrontendParams{router, appAddress})
 		return wa
+	case "dap":
+		dap, err := MakeDapFrontend(&DapFrontendParams{fmt.Sprintf("%s:%d", iface, dapPort), verbose})
+		if err != nil {
+			log.Fatalf("Failed to start DAP frontend: %s", err)
+		}
+		return dap
 	case "cdt":
 		fallthrough
 	default:
@@ -101,7 +121,7 @@ type runModeValue struct {
 	*cmdutil.CobraStringValue
 }
 
-var frontend frontendValue = frontendValue{cmdutil.MakeCobraStringValue("cdt", []string{"web"})}
+var frontend frontendValue = frontendValue{cmdutil.MakeCobraStringValue("cdt", []string{"web", "dap"})}
 var proto string
 var txnFile string
 var groupIndex int
@@ -113,6 +133,7 @@ var roundNumber uint64
 var timestamp int64
 var runMode runModeValue = runModeValue{cmdutil.MakeCobraStringValue("auto", []string{"signature", "application"})}
 var port int
+var dapPort int
 var iface string
 var noFirstRun bool
 var noBrowserCheck bool
@@ -121,10 +142,12 @@ var verbose bool
 var painless bool
 var appID uint64
 var listenForDrReq bool
+var replayPrograms []string
 
 func init() {
 	rootCmd.PersistentFlags().VarP(&frontend, "frontend", "f", "Frontend to use: "+frontend.AllowedString())
 	rootCmd.PersistentFlags().IntVar(&port, "remote-debugging-port", 9392, "Port to listen on")
+	rootCmd.PersistentFlags().IntVar(&dapPort, "dap-port", 9393, "Port to listen on for Debug Adapter Protocol clients with the dap frontend")
 	rootCmd.PersistentFlags().StringVar(&iface, "listen", "127.0.0.1", "Network interface to listen on")
 	rootCmd.PersistentFlags().BoolVar(&noFirstRun, "no-first-run", false, "")
 	rootCmd.PersistentFlags().MarkHidden("no-first-run")
@@ -147,8 +170,32 @@ func init() {
 	debugCmd.Flags().StringVarP(&indexerToken, "indexer-token", "", "", "API token for indexer to fetch Balance records from to evaluate stateful TEAL")
 	debugCmd.Flags().BoolVarP(&listenForDrReq, "listen-dr-req", "q", false, "Listen for upcoming debugging dryrun request objects instead of taking program(s) from command line")
 
+	replayCmd.Flags().StringArrayVar(&replayPrograms, "program", nil, "TEAL source or bytecode of a program in the trace, to step through it by source lines")
+
 	rootCmd.AddCommand(debugCmd)
 	rootCmd.AddCommand(remoteCmd)
+	rootCmd.AddCommand(replayCmd)
+}
+
+func replayTrace(traceFile string) {
+	traceBlob, err := os.ReadFile(traceFile)
+	if err != nil {
+		log.Fatalf("Error trace reading %s: %s", traceFile, err)
+	}
+	programBlobs := make(map[string][]byte, len(replayPrograms))
+	for _, file := range replayPrograms {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			log.Fatalf("Error program reading %s: %s", file, err)
+		}
+		programBlobs[file] = data
+	}
+
+	r, err := loadReplay(traceBlob, programBlobs)
+	if err != nil {
+		log.Fatalln(err.Error())
+	}
+	makeReplayShell(r, os.Stdout).run(os.Stdin)
 }
 
 func debugRemote() {
@@ -255,6 +302,7 @@ func debugLocal(args []string) {
 		AppID:            appID,
 		Painless:         painless,
 		ListenForDrReq:   listenForDrReq,
+		DebugInners:      frontend.value() == "dap",
 	}
 
 	ds := makeDebugServer(iface, port, &frontend, &dp)
@@ -0,0 +1,595 @@
+// Copyright (C) 2019-2024 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/Quarkonium-chain/go-quarkonium/crypto"
+	v2 "github.com/Quarkonium-chain/go-quarkonium/daemon/algod/api/server/v2"
+	"github.com/Quarkonium-chain/go-quarkonium/daemon/algod/api/server/v2/generated/model"
+	"github.com/Quarkonium-chain/go-quarkonium/data/basics"
+	"github.com/Quarkonium-chain/go-quarkonium/data/transactions"
+	"github.com/Quarkonium-chain/go-quarkonium/data/transactions/logic"
+	"github.com/Quarkonium-chain/go-quarkonium/ledger/simulation"
+	"github.com/Quarkonium-chain/go-quarkonium/protocol"
+)
+
+// replay is a simulate response with an execution trace, flattened into the
+// opcodes evaluated in order. Nothing is evaluated again: the state at any
+// step is rebuilt from the recorded effects of the steps before it, so it
+// can be examined going backwards as well as forwards.
+type replay struct {
+	config     simulation.ExecTraceConfig
+	groups     []v2.PreEncodedSimulateTxnGroupResult
+	programs   map[crypto.Digest]*replayProgram
+	executions []*replayExecution
+	steps      []replayStep
+	initial    map[basics.AppIndex]*replayAppState
+}
+
+// replayProgram is a program found in the trace or given by the user
+type replayProgram struct {
+	name        string
+	disassembly logic.DebugState // for PCToLine
+	lines       []string         // of the disassembly
+	source      []string         // if assembled from source
+	sourceMap   map[int]logic.SourceLocation
+}
+
+// replayExecution is a run of a program
+type replayExecution struct {
+	index   int
+	name    string
+	group   int
+	path    []int // of the transaction, from the top-level index
+	parent  *replayExecution
+	depth   int
+	txn     *transactions.SignedTxn
+	app     basics.AppIndex
+	hash    crypto.Digest
+	program *replayProgram // nil if not found
+	trace   []model.SimulationOpcodeTraceUnit
+	first   int // step of the first opcode
+	failure string
+}
+
+// replayStep is a position in the replay: before an opcode of an execution,
+// or at the end of the execution if unit is the length of its trace
+type replayStep struct {
+	exec      *replayExecution
+	unit      int
+	line      int // shown to the user, from 1, or 0 if the program is unknown
+	callDepth int
+}
+
+type replayAppState struct {
+	global basics.TealKeyValue
+	locals map[basics.Address]basics.TealKeyValue
+	boxes  map[string]string
+}
+
+// loadReplay decodes a simulate response and prepares its replay. Programs
+// that are not in the transactions of the response are looked up in the
+// programs given, TEAL sources or bytecode by name, by their hash.
+func loadReplay(traceBlob []byte, programBlobs map[string][]byte) (*replay, error) {
+	var resp v2.PreEncodedSimulateResponse
+	err := protocol.DecodeJSON(traceBlob, &resp)
+	if err != nil {
+		return nil, fmt.Errorf("cannot decode simulate response: %w", err)
+	}
+	if !resp.ExecTraceConfig.Enable {
+		return nil, errors.New("simulate response has no execution trace, simulate with --trace or --full-trace")
+	}
+
+	r := &replay{
+		config:   resp.ExecTraceConfig,
+		groups:   resp.TxnGroups,
+		programs: make(map[crypto.Digest]*replayProgram),
+		initial:  make(map[basics.AppIndex]*replayAppState),
+	}
+	for name, data := range programBlobs {
+		if err = r.addProgram(name, data); err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+	}
+	for gi := range r.groups {
+		for ti := range r.groups[gi].Txns {
+			r.addTxnPrograms(&r.groups[gi].Txns[ti].Txn)
+		}
+	}
+	if err = r.addInitialStates(resp.InitialStates); err != nil {
+		return nil, err
+	}
+
+	for gi := range r.groups {
+		group := &r.groups[gi]
+		// logic sigs are all evaluated before the group is applied
+		for ti := range group.Txns {
+			result := &group.Txns[ti]
+			if result.TransactionTrace == nil || result.TransactionTrace.LogicSigTrace == nil {
+				continue
+			}
+			trace := result.TransactionTrace
+			r.addExecution(gi, []int{ti}, nil, &result.Txn, "logicsig", trace.LogicSigHash, *trace.LogicSigTrace, trace)
+		}
+		for ti := range group.Txns {
+			result := &group.Txns[ti]
+			if result.TransactionTrace != nil {
+				r.addAppExecutions(gi, []int{ti}, nil, &result.Txn, result.TransactionTrace)
+			}
+		}
+		if group.FailureMessage != nil && group.FailedAt != nil {
+			r.setFailure(gi, *group.FailedAt, *group.FailureMessage)
+		}
+	}
+	if len(r.steps) == 0 {
+		return nil, errors.New("simulate response has no program executions")
+	}
+	return r, nil
+}
+
+func (r *replay) addProgram(name string, data []byte) error {
+	p := &replayProgram{name: name}
+	program := data
+	if IsTextFile(data) {
+		ops, err := logic.AssembleString(string(data))
+		if err != nil {
+			return err
+		}
+		program = ops.Program
+		p.source = strings.Split(string(data), "\n")
+		p.sourceMap = ops.OffsetToSource
+	}
+	text, offsets, err := logic.DisassembleWithPCOffset(program)
+	if err != nil {
+		return err
+	}
+	p.disassembly = logic.DebugState{Disassembly: text, PCOffset: offsets}
+	p.lines = strings.Split(text, "\n")
+	hash := crypto.Hash(program)
+	if _, ok := r.programs[hash]; !ok || p.source != nil {
+		r.programs[hash] = p
+	}
+	return nil
+}
+
+// addTxnPrograms adds the programs in the transaction and its inners
+func (r *replay) addTxnPrograms(info *v2.PreEncodedTxInfo) {
+	txn := &info.Txn
+	for _, program := range [][]byte{txn.Lsig.Logic, txn.Txn.ApprovalProgram, txn.Txn.ClearStateProgram} {
+		if len(program) == 0 {
+			continue
+		}
+		if _, ok := r.programs[crypto.Hash(program)]; !ok {
+			// programs that do not disassemble are left out, their
+			// executions are shown by PC
+			r.addProgram("", program)
+		}
+	}
+	if info.Inners != nil {
+		for i := range *info.Inners {
+			r.addTxnPrograms(&(*info.Inners)[i])
+		}
+	}
+}
+
+func (r *replay) appState(app basics.AppIndex) *replayAppState {
+	state, ok := r.initial[app]
+	if !ok {
+		state = &replayAppState{
+			global: make(basics.TealKeyValue),
+			locals: make(map[basics.Address]basics.TealKeyValue),
+			boxes:  make(map[string]string),
+		}
+		r.initial[app] = state
+	}
+	return state
+}
+
+func (r *replay) addInitialStates(states *model.SimulateInitialStates) error {
+	if states == nil || states.AppInitialStates == nil {
+		return nil
+	}
+	for _, app := range *states.AppInitialStates {
+		state := r.appState(basics.AppIndex(app.Id))
+		if app.AppGlobals != nil {
+			for _, kv := range app.AppGlobals.Kvs {
+				state.global[string(kv.Key)] = avmToTealValue(kv.Value)
+			}
+		}
+		if app.AppLocals != nil {
+			for _, local := range *app.AppLocals {
+				if local.Account == nil {
+					continue
+				}
+				addr, err := basics.UnmarshalChecksumAddress(*local.Account)
+				if err != nil {
+					return fmt.Errorf("bad initial local state of app %d: %w", app.Id, err)
+				}
+				tkv := make(basics.TealKeyValue, len(local.Kvs))
+				for _, kv := range local.Kvs {
+					tkv[string(kv.Key)] = avmToTealValue(kv.Value)
+				}
+				state.locals[addr] = tkv
+			}
+		}
+		if app.AppBoxes != nil {
+			for _, kv := range app.AppBoxes.Kvs {
+				state.boxes[string(kv.Key)] = avmToTealValue(kv.Value).Bytes
+			}
+		}
+	}
+	return nil
+}
+
+// addAppExecutions adds the app programs run by the transaction, and the
+// programs of its inner transactions
+func (r *replay) addAppExecutions(group int, path []int, parent *replayExecution, info *v2.PreEncodedTxInfo, trace *model.SimulationTransactionExecTrace) {
+	var inners []v2.PreEncodedTxInfo
+	if info != nil && info.Inners != nil {
+		inners = *info.Inners
+	}
+	var exec *replayExecution
+	if trace.ApprovalProgramTrace != nil {
+		exec = r.addExecution(group, path, parent, info, "approval", trace.ApprovalProgramHash, *trace.ApprovalProgramTrace, trace)
+	}
+	if trace.ClearStateProgramTrace != nil {
+		exec = r.addExecution(group, path, parent, info, "clear state", trace.ClearStateProgramHash, *trace.ClearStateProgramTrace, trace)
+		if trace.ClearStateRollbackError != nil {
+			exec.failure = *trace.ClearStateRollbackError
+		}
+	}
+	if exec == nil || trace.InnerTrace == nil {
+		return
+	}
+
+	// the steps of the inner programs go after the opcode that ran them
+	steps := r.steps[exec.first:]
+	r.steps = r.steps[:exec.first:exec.first]
+	for _, step := range steps {
+		r.steps = append(r.steps, step)
+		if step.unit == len(exec.trace) || exec.trace[step.unit].SpawnedInners == nil {
+			continue
+		}
+		for _, ii := range *exec.trace[step.unit].SpawnedInners {
+			if int(ii) >= len(*trace.InnerTrace) {
+				continue
+			}
+			var innerInfo *v2.PreEncodedTxInfo
+			if int(ii) < len(inners) {
+				innerInfo = &inners[ii]
+			}
+			innerPath := append(append([]int(nil), path...), int(ii))
+			r.addAppExecutions(group, innerPath, exec, innerInfo, &(*trace.InnerTrace)[ii])
+		}
+	}
+}
+
+func (r *replay) addExecution(group int, path []int, parent *replayExecution, info *v2.PreEncodedTxInfo, kind string, hash *[]byte, units []model.SimulationOpcodeTraceUnit, trace *model.SimulationTransactionExecTrace) *replayExecution {
+	exec := &replayExecution{
+		index:  len(r.executions),
+		group:  group,
+		path:   path,
+		parent: parent,
+		trace:  units,
+		first:  len(r.steps),
+	}
+	if parent != nil {
+		exec.depth = parent.depth + 1
+	}
+	if hash != nil {
+		copy(exec.hash[:], *hash)
+		exec.program = r.programs[exec.hash]
+	}
+
+	pathNames := make([]string, len(path))
+	for i, ti := range path {
+		pathNames[i] = strconv.Itoa(ti)
+	}
+	exec.name = fmt.Sprintf("group %d txn %s %s", group, strings.Join(pathNames, "/"), kind)
+	if info != nil {
+		exec.txn = &info.Txn
+		if info.Txn.Txn.Type == protocol.ApplicationCallTx {
+			exec.app = info.Txn.Txn.ApplicationID
+			if exec.app == 0 && info.ApplicationIndex != nil {
+				exec.app = basics.AppIndex(*info.ApplicationIndex)
+			}
+			exec.name = fmt.Sprintf("group %d txn %s app %d %s", group, strings.Join(pathNames, "/"), exec.app, kind)
+		}
+	}
+	r.executions = append(r.executions, exec)
+
+	var frames int
+	for unit := 0; unit <= len(units); unit++ {
+		step := replayStep{exec: exec, unit: unit, callDepth: frames}
+		if unit < len(units) {
+			step.line = exec.line(int(units[unit].Pc))
+			switch exec.opcode(int(units[unit].Pc)) {
+			case "callsub":
+				frames++
+			case "retsub":
+				frames = max(frames-1, 0)
+			}
+		}
+		r.steps = append(r.steps, step)
+	}
+	return exec
+}
+
+// setFailure marks the last execution of the failed transaction
+func (r *replay) setFailure(group int, failedAt []uint64, message string) {
+	for i := len(r.executions) - 1; i >= 0; i-- {
+		exec := r.executions[i]
+		if exec.group != group || len(exec.path) != len(failedAt) {
+			continue
+		}
+		match := true
+		for j := range failedAt {
+			match = match && uint64(exec.path[j]) == failedAt[j]
+		}
+		if match {
+			exec.failure = message
+			return
+		}
+	}
+}
+
+// line returns the line shown for the pc, from 1: in the source if there
+// is one, in the disassembly otherwise. 0 if the program is unknown.
+func (e *replayExecution) line(pc int) int {
+	if e.program == nil {
+		return 0
+	}
+	if loc, ok := e.program.sourceMap[pc]; ok && loc.File == 0 {
+		return loc.Line + 1
+	}
+	return e.program.disassembly.PCToLine(pc) + 1
+}
+
+// text returns the text of a line shown for the program
+func (e *replayExecution) text(line int) string {
+	if e.program == nil {
+		return ""
+	}
+	lines := e.program.lines
+	if e.program.source != nil {
+		lines = e.program.source
+	}
+	if line < 1 || line > len(lines) {
+		return ""
+	}
+	return strings.TrimSpace(lines[line-1])
+}
+
+// opcode returns the name of the opcode at the pc, from the disassembly
+func (e *replayExecution) opcode(pc int) string {
+	if e.program == nil {
+		return ""
+	}
+	line := e.program.disassembly.PCToLine(pc)
+	if line >= len(e.program.lines) {
+		return ""
+	}
+	fields := strings.Fields(e.program.lines[line])
+	if len(fields) == 0 {
+		return ""
+	}
+	return fields[0]
+}
+
+// hasLine tells whether an opcode of the program is shown on the line
+func (e *replayExecution) hasLine(line int) bool {
+	if e.program == nil {
+		return false
+	}
+	for _, offset := range e.program.disassembly.PCOffset {
+		if e.line(offset.PC) == line {
+			return true
+		}
+	}
+	return false
+}
+
+// descends tells whether the execution is the ancestor or runs under it
+func (e *replayExecution) descends(ancestor *replayExecution) bool {
+	for ; e != nil; e = e.parent {
+		if e == ancestor {
+			return true
+		}
+	}
+	return false
+}
+
+func avmToTealValue(v model.AvmValue) basics.TealValue {
+	if v.Type == uint64(basics.TealBytesType) {
+		tv := basics.TealValue{Type: basics.TealBytesType}
+		if v.Bytes != nil {
+			tv.Bytes = string(*v.Bytes)
+		}
+		return tv
+	}
+	tv := basics.TealValue{Type: basics.TealUintType}
+	if v.Uint != nil {
+		tv.Uint = *v.Uint
+	}
+	return tv
+}
+
+// stack returns the stack of the execution at the step
+func (r *replay) stack(step int) []basics.TealValue {
+	st := r.steps[step]
+	var stack []basics.TealValue
+	for _, unit := range st.exec.trace[:st.unit] {
+		if unit.StackPopCount != nil {
+			stack = stack[:len(stack)-min(int(*unit.StackPopCount), len(stack))]
+		}
+		if unit.StackAdditions != nil {
+			for _, v := range *unit.StackAdditions {
+				stack = append(stack, avmToTealValue(v))
+			}
+		}
+	}
+	return stack
+}
+
+// scratch returns the scratch slots of the execution written before the step
+func (r *replay) scratch(step int) map[uint64]basics.TealValue {
+	st := r.steps[step]
+	scratch := make(map[uint64]basics.TealValue)
+	for _, unit := range st.exec.trace[:st.unit] {
+		if unit.ScratchChanges != nil {
+			for _, change := range *unit.ScratchChanges {
+				scratch[change.Slot] = avmToTealValue(change.NewValue)
+			}
+		}
+	}
+	return scratch
+}
+
+// appStateAt returns the state of the app at the step, from its initial state
+// and the changes of all the steps before
+func (r *replay) appStateAt(app basics.AppIndex, step int) (*replayAppState, error) {
+	state := &replayAppState{
+		global: make(basics.TealKeyValue),
+		locals: make(map[basics.Address]basics.TealKeyValue),
+		boxes:  make(map[string]string),
+	}
+	if initial, ok := r.initial[app]; ok {
+		state.global = initial.global.Clone()
+		for addr, tkv := range initial.locals {
+			state.locals[addr] = tkv.Clone()
+		}
+		for name, value := range initial.boxes {
+			state.boxes[name] = value
+		}
+	}
+
+	for _, st := range r.steps[:step] {
+		if st.exec.app != app || st.unit == len(st.exec.trace) || st.exec.trace[st.unit].StateChanges == nil {
+			continue
+		}
+		for _, change := range *st.exec.trace[st.unit].StateChanges {
+			key := string(change.Key)
+			var value basics.TealValue
+			if change.NewValue != nil {
+				value = avmToTealValue(*change.NewValue)
+			}
+			write := change.Operation == "w"
+			switch change.AppStateType {
+			case "g":
+				if write {
+					state.global[key] = value
+				} else {
+					delete(state.global, key)
+				}
+			case "l":
+				if change.Account == nil {
+					continue
+				}
+				addr, err := basics.UnmarshalChecksumAddress(*change.Account)
+				if err != nil {
+					return nil, err
+				}
+				tkv := state.locals[addr]
+				if tkv == nil {
+					tkv = make(basics.TealKeyValue)
+					state.locals[addr] = tkv
+				}
+				if write {
+					tkv[key] = value
+				} else {
+					delete(tkv, key)
+				}
+			case "b":
+				if write {
+					state.boxes[key] = value.Bytes
+				} else {
+					delete(state.boxes, key)
+				}
+			}
+		}
+	}
+	return state, nil
+}
+
+// callStack returns the steps of the callsub opcodes of the subroutines
+// running at the step, outermost first
+func (r *replay) callStack(step int) []int {
+	st := r.steps[step]
+	var calls []int
+	for q := st.exec.first; q < step; q++ {
+		if r.steps[q].exec != st.exec {
+			continue // an inner program
+		}
+		switch st.exec.opcode(int(st.exec.trace[r.steps[q].unit].Pc)) {
+		case "callsub":
+			calls = append(calls, q)
+		case "retsub":
+			if len(calls) > 0 {
+				calls = calls[:len(calls)-1]
+			}
+		}
+	}
+	return calls
+}
+
+// caller returns the step of the opcode of the parent execution that ran
+// the execution of the step, or -1
+func (r *replay) caller(step int) int {
+	exec := r.steps[step].exec
+	if exec.parent == nil {
+		return -1
+	}
+	return exec.first - 1
+}
+
+// search returns the first step from the step in the direction, 1 or -1,
+// that the condition holds for, or the last step in that direction
+func (r *replay) search(step int, direction int, cond func(q int) bool) int {
+	q := step + direction
+	for ; q >= 0 && q < len(r.steps); q += direction {
+		if cond(q) {
+			return q
+		}
+	}
+	return min(max(q-direction, 0), len(r.steps)-1)
+}
+
+// over returns the step the next opcode at the same depth or shallower, past
+// subroutine calls and inner programs
+func (r *replay) over(step int, direction int) int {
+	exec := r.steps[step].exec
+	depth := r.steps[step].callDepth
+	return r.search(step, direction, func(q int) bool {
+		st := r.steps[q]
+		return st.exec == exec && st.callDepth <= depth || !st.exec.descends(exec)
+	})
+}
+
+// out returns the step out of the subroutine or program of the step
+func (r *replay) out(step int, direction int) int {
+	exec := r.steps[step].exec
+	depth := r.steps[step].callDepth
+	return r.search(step, direction, func(q int) bool {
+		st := r.steps[q]
+		return st.exec == exec && st.callDepth < depth || !st.exec.descends(exec)
+	})
+}
@@ -0,0 +1,457 @@
+// Copyright (C) 2019-2024 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"bufio"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/Quarkonium-chain/go-quarkonium/crypto"
+	"github.com/Quarkonium-chain/go-quarkonium/data/basics"
+	"github.com/Quarkonium-chain/go-quarkonium/protocol"
+)
+
+const replayHelp = `Commands:
+  step, s [n]          step n opcodes forward, into subroutines and inner programs
+  next, n [n]          step n opcodes forward, over subroutines and inner programs
+  out, o               step out of the subroutine or program
+  continue, c          run forward to a breakpoint or the end
+  rstep, rs [n]        step n opcodes backward
+  rnext, rn [n]        step n opcodes backward, over subroutines and inner programs
+  rout, ro             step backward out of the subroutine or program
+  rcontinue, rc        run backward to a breakpoint or the beginning
+  goto, g N            go to step N
+  break, b [exec:]line set a breakpoint on a line of the program of an execution
+  delete, d [id]       delete a breakpoint, or all of them
+  breaks               list breakpoints
+  where, w             show the subroutines and programs running
+  list, l              show the program around the current line
+  stack                show the stack
+  scratch              show the scratch space
+  state [app]          show global, local and box state of the app
+  txn                  show the transaction
+  executions, info     list the program executions
+  help, h              show this help
+  quit, q              exit
+An empty line repeats the last command.`
+
+type replayBreakpoint struct {
+	id   int
+	hash crypto.Digest
+	line int
+	name string
+}
+
+// replayShell is a line oriented frontend for a replay
+type replayShell struct {
+	r      *replay
+	out    io.Writer
+	pos    int
+	breaks []replayBreakpoint
+	nextID int
+}
+
+func makeReplayShell(r *replay, out io.Writer) *replayShell {
+	return &replayShell{r: r, out: out, nextID: 1}
+}
+
+// run reads commands until quit or the end of the input
+func (s *replayShell) run(in io.Reader) {
+	fmt.Fprintf(s.out, "%d executions, %d steps. Type help for commands.\n", len(s.r.executions), len(s.r.steps))
+	s.printLocation()
+	scanner := bufio.NewScanner(in)
+	var last string
+	for {
+		fmt.Fprint(s.out, "(replay) ")
+		if !scanner.Scan() {
+			fmt.Fprintln(s.out)
+			return
+		}
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			line = last
+		}
+		if line == "" {
+			continue
+		}
+		last = line
+		if !s.execute(strings.Fields(line)) {
+			return
+		}
+	}
+}
+
+// execute runs a command, and returns false to exit
+func (s *replayShell) execute(args []string) bool {
+	count := 1
+	if len(args) > 1 {
+		n, err := strconv.Atoi(args[1])
+		if err == nil && n > 0 {
+			count = n
+		}
+	}
+
+	switch args[0] {
+	case "step", "s":
+		s.move(func(p int) int { return min(p+1, len(s.r.steps)-1) }, count)
+	case "rstep", "rs":
+		s.move(func(p int) int { return max(p-1, 0) }, count)
+	case "next", "n":
+		s.move(func(p int) int { return s.r.over(p, 1) }, count)
+	case "rnext", "rn":
+		s.move(func(p int) int { return s.r.over(p, -1) }, count)
+	case "out", "o":
+		s.move(func(p int) int { return s.r.out(p, 1) }, 1)
+	case "rout", "ro":
+		s.move(func(p int) int { return s.r.out(p, -1) }, 1)
+	case "continue", "c":
+		s.move(func(p int) int { return s.r.search(p, 1, s.atBreakpoint) }, 1)
+	case "rcontinue", "rc":
+		s.move(func(p int) int { return s.r.search(p, -1, s.atBreakpoint) }, 1)
+	case "goto", "g":
+		if len(args) < 2 {
+			fmt.Fprintln(s.out, "usage: goto N")
+			break
+		}
+		n, err := strconv.Atoi(args[1])
+		if err != nil || n < 1 || n > len(s.r.steps) {
+			fmt.Fprintf(s.out, "step must be from 1 to %d\n", len(s.r.steps))
+			break
+		}
+		s.pos = n - 1
+		s.printLocation()
+	case "break", "b":
+		s.setBreakpoint(args[1:])
+	case "delete", "d":
+		s.deleteBreakpoint(args[1:])
+	case "breaks":
+		if len(s.breaks) == 0 {
+			fmt.Fprintln(s.out, "no breakpoints")
+		}
+		for _, b := range s.breaks {
+			fmt.Fprintf(s.out, "%d: %s line %d\n", b.id, b.name, b.line)
+		}
+	case "where", "w":
+		s.printWhere()
+	case "list", "l":
+		s.printList()
+	case "stack":
+		s.printStack()
+	case "scratch":
+		s.printScratch()
+	case "state":
+		s.printState(args[1:])
+	case "txn":
+		s.printTxn()
+	case "executions", "info":
+		s.printExecutions()
+	case "help", "h":
+		fmt.Fprintln(s.out, replayHelp)
+	case "quit", "q":
+		return false
+	default:
+		fmt.Fprintf(s.out, "unknown command %s, type help for commands\n", args[0])
+	}
+	return true
+}
+
+func (s *replayShell) move(next func(p int) int, count int) {
+	for i := 0; i < count; i++ {
+		s.pos = next(s.pos)
+	}
+	s.printLocation()
+}
+
+func (s *replayShell) atBreakpoint(p int) bool {
+	st := s.r.steps[p]
+	if st.unit == len(st.exec.trace) {
+		return false
+	}
+	for _, b := range s.breaks {
+		if b.hash == st.exec.hash && b.line == st.line {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *replayShell) setBreakpoint(args []string) {
+	if len(args) != 1 {
+		fmt.Fprintln(s.out, "usage: break [exec:]line")
+		return
+	}
+	exec := s.r.steps[s.pos].exec
+	spec := args[0]
+	if before, after, found := strings.Cut(spec, ":"); found {
+		n, err := strconv.Atoi(before)
+		if err != nil || n < 0 || n >= len(s.r.executions) {
+			fmt.Fprintf(s.out, "execution must be from 0 to %d\n", len(s.r.executions)-1)
+			return
+		}
+		exec = s.r.executions[n]
+		spec = after
+	}
+	line, err := strconv.Atoi(spec)
+	if err != nil {
+		fmt.Fprintf(s.out, "bad line %s\n", spec)
+		return
+	}
+	if !exec.hasLine(line) {
+		fmt.Fprintf(s.out, "no opcode on line %d of %s\n", line, s.programName(exec))
+		return
+	}
+	b := replayBreakpoint{id: s.nextID, hash: exec.hash, line: line, name: s.programName(exec)}
+	s.nextID++
+	s.breaks = append(s.breaks, b)
+	fmt.Fprintf(s.out, "breakpoint %d at %s line %d\n", b.id, b.name, b.line)
+}
+
+func (s *replayShell) deleteBreakpoint(args []string) {
+	if len(args) == 0 {
+		s.breaks = nil
+		fmt.Fprintln(s.out, "deleted all breakpoints")
+		return
+	}
+	id, err := strconv.Atoi(args[0])
+	if err == nil {
+		for i, b := range s.breaks {
+			if b.id == id {
+				s.breaks = append(s.breaks[:i], s.breaks[i+1:]...)
+				fmt.Fprintf(s.out, "deleted breakpoint %d\n", id)
+				return
+			}
+		}
+	}
+	fmt.Fprintf(s.out, "no breakpoint %s\n", args[0])
+}
+
+// programName returns the name of the program given by the user, or its hash
+func (s *replayShell) programName(exec *replayExecution) string {
+	if exec.program != nil && exec.program.name != "" {
+		return exec.program.name
+	}
+	return "program " + exec.hash.String()
+}
+
+func (s *replayShell) printLocation() {
+	st := s.r.steps[s.pos]
+	exec := st.exec
+	fmt.Fprintf(s.out, "[%d/%d] %s\n", s.pos+1, len(s.r.steps), exec.name)
+	if st.unit == len(exec.trace) {
+		if exec.failure != "" {
+			fmt.Fprintf(s.out, "  end of program: %s\n", exec.failure)
+		} else {
+			fmt.Fprintln(s.out, "  end of program")
+		}
+		return
+	}
+	pc := exec.trace[st.unit].Pc
+	if st.line == 0 {
+		fmt.Fprintf(s.out, "  pc %d\n", pc)
+		return
+	}
+	fmt.Fprintf(s.out, "  %d: %s\n", st.line, exec.text(st.line))
+}
+
+func (s *replayShell) printWhere() {
+	var frames []string
+	for p := s.pos; p >= 0; p = s.r.caller(p) {
+		frames = append(frames, s.frameText(p))
+		for _, call := range reverse(s.r.callStack(p)) {
+			frames = append(frames, "  called from "+s.frameText(call))
+		}
+	}
+	for _, frame := range frames {
+		fmt.Fprintln(s.out, frame)
+	}
+}
+
+func reverse(steps []int) []int {
+	for i, j := 0, len(steps)-1; i < j; i, j = i+1, j-1 {
+		steps[i], steps[j] = steps[j], steps[i]
+	}
+	return steps
+}
+
+func (s *replayShell) frameText(p int) string {
+	st := s.r.steps[p]
+	if st.unit == len(st.exec.trace) {
+		return fmt.Sprintf("%s at the end", st.exec.name)
+	}
+	if st.line == 0 {
+		return fmt.Sprintf("%s pc %d", st.exec.name, st.exec.trace[st.unit].Pc)
+	}
+	return fmt.Sprintf("%s line %d: %s", st.exec.name, st.line, st.exec.text(st.line))
+}
+
+func (s *replayShell) printList() {
+	st := s.r.steps[s.pos]
+	exec := st.exec
+	if exec.program == nil {
+		fmt.Fprintf(s.out, "program %s is not known, give it with --program\n", exec.hash)
+		return
+	}
+	lines := exec.program.lines
+	if exec.program.source != nil {
+		lines = exec.program.source
+	}
+	current := st.line
+	if st.unit == len(exec.trace) && len(exec.trace) > 0 {
+		current = s.r.steps[s.pos-1].line
+	}
+	from := max(current-5, 1)
+	to := min(current+5, len(lines))
+	for line := from; line <= to; line++ {
+		marker := "   "
+		if line == st.line && st.unit < len(exec.trace) {
+			marker = "=> "
+		}
+		fmt.Fprintf(s.out, "%s%4d  %s\n", marker, line, lines[line-1])
+	}
+}
+
+func (s *replayShell) printStack() {
+	if !s.r.config.Stack {
+		fmt.Fprintln(s.out, "stack changes were not recorded, simulate with --stack or --full-trace")
+		return
+	}
+	stack := s.r.stack(s.pos)
+	if len(stack) == 0 {
+		fmt.Fprintln(s.out, "stack is empty")
+	}
+	for i := len(stack) - 1; i >= 0; i-- {
+		fmt.Fprintf(s.out, "%d: %s\n", i, tealValueText(stack[i]))
+	}
+}
+
+func (s *replayShell) printScratch() {
+	if !s.r.config.Scratch {
+		fmt.Fprintln(s.out, "scratch changes were not recorded, simulate with --scratch or --full-trace")
+		return
+	}
+	scratch := s.r.scratch(s.pos)
+	if len(scratch) == 0 {
+		fmt.Fprintln(s.out, "scratch space is empty")
+	}
+	slots := make([]uint64, 0, len(scratch))
+	for slot := range scratch {
+		slots = append(slots, slot)
+	}
+	sort.Slice(slots, func(i, j int) bool { return slots[i] < slots[j] })
+	for _, slot := range slots {
+		fmt.Fprintf(s.out, "%d: %s\n", slot, tealValueText(scratch[slot]))
+	}
+}
+
+func (s *replayShell) printState(args []string) {
+	if !s.r.config.State {
+		fmt.Fprintln(s.out, "state changes were not recorded, simulate with --state or --full-trace")
+		return
+	}
+	app := s.r.steps[s.pos].exec.app
+	if len(args) > 0 {
+		n, err := strconv.ParseUint(args[0], 10, 64)
+		if err != nil {
+			fmt.Fprintf(s.out, "bad app %s\n", args[0])
+			return
+		}
+		app = basics.AppIndex(n)
+	}
+	if app == 0 {
+		fmt.Fprintln(s.out, "not an app call, give an app")
+		return
+	}
+	state, err := s.r.appStateAt(app, s.pos)
+	if err != nil {
+		fmt.Fprintf(s.out, "bad state change: %s\n", err)
+		return
+	}
+	fmt.Fprintf(s.out, "app %d global state:\n", app)
+	printKeyValues(s.out, state.global)
+	addrs := make([]basics.Address, 0, len(state.locals))
+	for addr := range state.locals {
+		addrs = append(addrs, addr)
+	}
+	sort.Slice(addrs, func(i, j int) bool { return addrs[i].String() < addrs[j].String() })
+	for _, addr := range addrs {
+		fmt.Fprintf(s.out, "app %d local state of %s:\n", app, addr)
+		printKeyValues(s.out, state.locals[addr])
+	}
+	if len(state.boxes) > 0 {
+		fmt.Fprintf(s.out, "app %d boxes:\n", app)
+		names := make([]string, 0, len(state.boxes))
+		for name := range state.boxes {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			fmt.Fprintf(s.out, "  %s: %s\n", bytesText(name), bytesText(state.boxes[name]))
+		}
+	}
+}
+
+func printKeyValues(out io.Writer, tkv basics.TealKeyValue) {
+	keys := make([]string, 0, len(tkv))
+	for key := range tkv {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		fmt.Fprintf(out, "  %s: %s\n", bytesText(key), tealValueText(tkv[key]))
+	}
+}
+
+func (s *replayShell) printTxn() {
+	exec := s.r.steps[s.pos].exec
+	if exec.txn == nil {
+		fmt.Fprintln(s.out, "transaction is not in the simulate response")
+		return
+	}
+	fmt.Fprintln(s.out, string(protocol.EncodeJSON(exec.txn)))
+}
+
+func (s *replayShell) printExecutions() {
+	current := s.r.steps[s.pos].exec
+	for _, exec := range s.r.executions {
+		marker := "   "
+		if exec == current {
+			marker = "=> "
+		}
+		fmt.Fprintf(s.out, "%s%d: %s%s, steps %d-%d, %s\n", marker, exec.index, strings.Repeat("  ", exec.depth),
+			exec.name, exec.first+1, exec.first+len(exec.trace)+1, s.programName(exec))
+	}
+}
+
+func tealValueText(tv basics.TealValue) string {
+	if tv.Type == basics.TealUintType {
+		return strconv.FormatUint(tv.Uint, 10)
+	}
+	return bytesText(tv.Bytes)
+}
+
+// bytesText shows printable bytes as a string and others in hex
+func bytesText(b string) string {
+	if IsText([]byte(b)) {
+		return strconv.Quote(b)
+	}
+	return "0x" + hex.EncodeToString([]byte(b))
+}
@@ -0,0 +1,216 @@
+// Copyright (C) 2019-2024 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Quarkonium-chain/go-quarkonium/crypto"
+	v2 "github.com/Quarkonium-chain/go-quarkonium/daemon/algod/api/server/v2"
+	"github.com/Quarkonium-chain/go-quarkonium/daemon/algod/api/server/v2/generated/model"
+	"github.com/Quarkonium-chain/go-quarkonium/data/basics"
+	"github.com/Quarkonium-chain/go-quarkonium/data/transactions"
+	"github.com/Quarkonium-chain/go-quarkonium/data/transactions/logic"
+	"github.com/Quarkonium-chain/go-quarkonium/ledger/simulation"
+	"github.com/Quarkonium-chain/go-quarkonium/protocol"
+	"github.com/Quarkonium-chain/go-quarkonium/test/partitiontest"
+)
+
+const replayApprovalSource = `#pragma version 8
+int 2
+callsub double
+pop
+int 1
+return
+double:
+dup
++
+retsub`
+
+const replayInnerSource = `#pragma version 8
+int 1`
+
+func uintValue(v uint64) model.AvmValue {
+	return model.AvmValue{Type: uint64(basics.TealUintType), Uint: &v}
+}
+
+// makeReplayTrace returns a simulate response of an app call that calls a
+// subroutine, and runs an inner app call that is pretended to be spawned by
+// the pop opcode, which also writes global state
+func makeReplayTrace(t *testing.T) []byte {
+	approval, err := logic.AssembleString(replayApprovalSource)
+	require.NoError(t, err)
+	inner, err := logic.AssembleString(replayInnerSource)
+	require.NoError(t, err)
+
+	// the pc of the opcode on a line of the source, from 1
+	pcOf := func(ops *logic.OpStream, line int) uint64 {
+		for pc, loc := range ops.OffsetToSource {
+			if loc.Line == line-1 {
+				return uint64(pc)
+			}
+		}
+		require.Failf(t, "no opcode", "line %d", line)
+		return 0
+	}
+	one := uint64(1)
+	pops := func(n uint64) *uint64 { return &n }
+	values := func(vs ...uint64) *[]model.AvmValue {
+		result := make([]model.AvmValue, len(vs))
+		for i, v := range vs {
+			result[i] = uintValue(v)
+		}
+		return &result
+	}
+	seven := uintValue(7)
+	approvalTrace := []model.SimulationOpcodeTraceUnit{
+		{Pc: pcOf(approval, 2), StackAdditions: values(2)},
+		{Pc: pcOf(approval, 3)},
+		{Pc: pcOf(approval, 8), StackAdditions: values(2)},
+		{Pc: pcOf(approval, 9), StackPopCount: pops(2), StackAdditions: values(4)},
+		{Pc: pcOf(approval, 10)},
+		{Pc: pcOf(approval, 4), StackPopCount: &one, SpawnedInners: &[]uint64{0},
+			StateChanges: &[]model.ApplicationStateOperation{{AppStateType: "g", Key: []byte("k"), NewValue: &seven, Operation: "w"}}},
+		{Pc: pcOf(approval, 5), StackAdditions: values(1)},
+		{Pc: pcOf(approval, 6), StackPopCount: &one},
+	}
+	innerTrace := []model.SimulationOpcodeTraceUnit{
+		{Pc: pcOf(inner, 2), StackAdditions: values(1)},
+	}
+
+	approvalHash := crypto.Hash(approval.Program)
+	innerHash := crypto.Hash(inner.Program)
+	approvalHashBytes := approvalHash[:]
+	innerHashBytes := innerHash[:]
+
+	var innerTxn transactions.SignedTxn
+	innerTxn.Txn.Type = protocol.ApplicationCallTx
+	innerTxn.Txn.ApplicationID = 6
+	innerTxn.Txn.ApprovalProgram = inner.Program
+	var txn transactions.SignedTxn
+	txn.Txn.Type = protocol.ApplicationCallTx
+	txn.Txn.ApplicationID = 5
+	inners := []v2.PreEncodedTxInfo{{Txn: innerTxn}}
+
+	resp := v2.PreEncodedSimulateResponse{
+		TxnGroups: []v2.PreEncodedSimulateTxnGroupResult{{
+			Txns: []v2.PreEncodedSimulateTxnResult{{
+				Txn: v2.PreEncodedTxInfo{Txn: txn, Inners: &inners},
+				TransactionTrace: &model.SimulationTransactionExecTrace{
+					ApprovalProgramHash:  &approvalHashBytes,
+					ApprovalProgramTrace: &approvalTrace,
+					InnerTrace: &[]model.SimulationTransactionExecTrace{{
+						ApprovalProgramHash:  &innerHashBytes,
+						ApprovalProgramTrace: &innerTrace,
+					}},
+				},
+			}},
+		}},
+		ExecTraceConfig: simulation.ExecTraceConfig{Enable: true, Stack: true, Scratch: true, State: true},
+		InitialStates: &model.SimulateInitialStates{AppInitialStates: &[]model.ApplicationInitialStates{{
+			Id:         5,
+			AppGlobals: &model.ApplicationKVStorage{Kvs: []model.AvmKeyValue{{Key: []byte("k"), Value: uintValue(1)}}},
+		}}},
+	}
+	return protocol.EncodeJSON(&resp)
+}
+
+func TestReplayNavigation(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+	a := require.New(t)
+
+	r, err := loadReplay(makeReplayTrace(t), map[string][]byte{"approval.teal": []byte(replayApprovalSource)})
+	a.NoError(err)
+	a.Len(r.executions, 2)
+	// 8 opcodes and the end of the approval program, 1 and the end of the inner
+	a.Len(r.steps, 11)
+
+	lines := make([]int, len(r.steps))
+	for i, st := range r.steps {
+		lines[i] = st.line
+	}
+	a.Equal([]int{2, 3, 8, 9, 10, 4, 2, 0, 5, 6, 0}, lines)
+	a.Equal(r.executions[1], r.steps[6].exec)
+	a.Equal(r.executions[0], r.executions[1].parent)
+
+	a.Equal(5, r.over(1, 1))  // over the subroutine
+	a.Equal(8, r.over(5, 1))  // over the inner program
+	a.Equal(5, r.out(3, 1))   // out of the subroutine
+	a.Equal(8, r.out(6, 1))   // out of the inner program
+	a.Equal(5, r.over(8, -1)) // back over the inner program
+	a.Equal(1, r.over(5, -1)) // back over the subroutine
+	a.Equal(1, r.out(3, -1))  // back out of the subroutine
+	a.Equal(10, r.search(0, 1, func(int) bool { return false }))
+	a.Equal(0, r.search(10, -1, func(int) bool { return false }))
+
+	a.Equal([]basics.TealValue{{Type: basics.TealUintType, Uint: 4}}, r.stack(5))
+	a.Equal([]int{1}, r.callStack(3))
+	a.Equal(5, r.caller(6))
+
+	state, err := r.appStateAt(5, 5)
+	a.NoError(err)
+	a.Equal(uint64(1), state.global["k"].Uint)
+	state, err = r.appStateAt(5, 6)
+	a.NoError(err)
+	a.Equal(uint64(7), state.global["k"].Uint)
+}
+
+func TestReplayShell(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+	a := require.New(t)
+
+	r, err := loadReplay(makeReplayTrace(t), map[string][]byte{"approval.teal": []byte(replayApprovalSource)})
+	a.NoError(err)
+
+	var out strings.Builder
+	shell := makeReplayShell(r, &out)
+	input := strings.Join([]string{
+		"break 9", "continue", "where", "stack", "state",
+		"break 0:5", "c", "state", "rc", "rn", "", "goto 7", "where", "quit",
+	}, "\n")
+	shell.run(strings.NewReader(input))
+	text := out.String()
+
+	a.Contains(text, "breakpoint 1 at approval.teal line 9")
+	a.Contains(text, "[4/11] group 0 txn 0 app 5 approval\n  9: +\n")
+	a.Contains(text, "app 5 approval line 9: +\n  called from group 0 txn 0 app 5 approval line 3: callsub double\n")
+	a.Contains(text, "1: 2\n0: 2\n")
+	a.Contains(text, "app 5 global state:\n  \"k\": 1\n")
+	a.Contains(text, "[9/11] group 0 txn 0 app 5 approval\n  5: int 1\n")
+	a.Contains(text, "app 5 global state:\n  \"k\": 7\n")
+	// back to the first breakpoint, then the empty line steps back again
+	a.Contains(text, "k\": 7\n(replay) [4/11] group 0 txn 0 app 5 approval\n  9: +\n")
+	a.Contains(text, "[3/11] group 0 txn 0 app 5 approval\n  8: dup\n")
+	a.Contains(text, "[2/11] group 0 txn 0 app 5 approval\n  3: callsub double\n")
+	// the inner program is not given, so it is shown disassembled
+	a.Contains(text, "[7/11] group 0 txn 0/0 app 6 approval\n  2: pushint 1\n")
+	a.Contains(text, "app 6 approval line 2: pushint 1\ngroup 0 txn 0 app 5 approval line 4: pop\n")
+}
+
+func TestReplayNoTrace(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	resp := v2.PreEncodedSimulateResponse{TxnGroups: []v2.PreEncodedSimulateTxnGroupResult{{}}}
+	_, err := loadReplay(protocol.EncodeJSON(&resp), nil)
+	require.ErrorContains(t, err, "--trace")
+}
@@ -530,6 +530,10 @@ type ConsensusParams struct {
 	// dynamic filter, it will be calculated and logged (but not used).
 	DynamicFilterTimeout bool
 
+	// Heartbeat enables heartbeat transactions, which allow an online account
+	// to show that its participation keys are live without having to propose.
+	Heartbeat bool
+
 	// Payouts contains parameters for amounts and eligibility for block proposer
 	// payouts. It excludes information about the "unsustainable" payouts
 	// described in BonusPlan.
@@ -1522,6 +1526,8 @@ func initConsensusProtocols() {
 	vFuture.Payouts.ChallengeGracePeriod = 200
 	vFuture.Payouts.ChallengeBits = 5
 
+	vFuture.Heartbeat = true
+
 	vFuture.Bonus.BaseAmount = 10_000_000 // 10 Algos
 	// 2.9 sec rounds gives about 10.8M rounds per year.
 	vFuture.Bonus.DecayInterval = 250_000 // .99^(10.8/0.25) ~ .648. So 35% decay per year
@@ -561,6 +561,11 @@ type Local struct {
 	// i.e. the block DB can return transaction IDs for questions for the range Latest-MaxBlockHistoryLookback...Latest
 	MaxBlockHistoryLookback uint64 `version[31]:"0"`
 
+	// EnableStateHistory makes archival nodes persist the state changes of every committed round, so that
+	// account, asset, application and box state can be queried at any round since the history was enabled,
+	// rather than only within the Latest-MaxAcctLookback...Latest range.
+	EnableStateHistory bool `version[34]:"false"`
+
 	// EnableUsageLog enables 10Hz log of CPU and RAM usage.
 	// Also adds 'algod_ram_usage` (number of bytes in use) to /metrics
 	EnableUsageLog bool `version[24]:"false"`
@@ -594,6 +599,44 @@ type Local struct {
 	// It will store txn deltas created during block evaluation, potentially consuming much larger amounts of memory,
 	EnableTxnEvalTracer bool `version[27]:"false"`
 
+	// EnableExporter turns on the exporter, which writes every committed block along with the state deltas of its
+	// transaction groups to the sink selected by ExporterSink. It implies EnableTxnEvalTracer, as the group deltas
+	// are collected during block evaluation. On follower nodes, the exporter holds the sync round at the next round
+	// it exports, so that the node does not drop the deltas it still needs.
+	EnableExporter bool `version[34]:"false"`
+
+	// ExporterSink selects where the exporter writes its records. Available options are:
+	// - file: rotating files in the ExporterPath directory, or in an "export" directory under the genesis directory
+	// - unix: the unix domain socket listening at ExporterPath
+	ExporterSink string `version[34]:"file"`
+
+	// ExporterPath is the directory of the file sink, or the socket path of the unix sink.
+	ExporterPath string `version[34]:""`
+
+	// ExporterFormat is the encoding of the exported records, either "json" for newline-delimited JSON
+	// or "msgp" for consecutive msgpack objects.
+	ExporterFormat string `version[34]:"json"`
+
+	// ExporterFileMaxSize is the size in bytes after which the file sink starts a new file.
+	ExporterFileMaxSize uint64 `version[34]:"134217728"`
+
+	// ExporterStartRound is the first round exported when the exporter has not exported anything yet.
+	// When it is 0, the exporter starts with the next round to be committed. Once started, the exporter
+	// resumes from where it left off.
+	ExporterStartRound uint64 `version[34]:"0"`
+
+	// EnableAVMMetrics turns on the collection of AVM execution metrics during block evaluation, published on the
+	// metrics endpoint: evaluations, budget consumed and failures by class for application programs and logicsigs,
+	// per-opcode execution counts and costs, and per-application counters for the applications that consumed the
+	// most budget. Only the blocks added to the ledger are counted, not the proposals that were validated but not
+	// chosen. Logicsigs are only counted when block validation evaluates them, which it does not do for
+	// transactions that were already verified on their way into the transaction pool.
+	EnableAVMMetrics bool `version[34]:"false"`
+
+	// AVMMetricsTopApps is the number of applications, ranked by the budget they consumed, that are reported
+	// individually when EnableAVMMetrics is set.
+	AVMMetricsTopApps uint64 `version[34]:"20"`
+
 	// StorageEngine allows to control which type of storage to use for the ledger.
 	// Available options are:
 	// - sqlite (default)
@@ -608,6 +651,35 @@ type Local struct {
 	// When it exceeds this capacity, it redirects the block requests to a different node
 	BlockServiceMemCap uint64 `version[28]:"500000000"`
 
+	// EnableVoteCompression advertises support for compressing agreement votes on websocket connections.
+	// Votes are compressed only between peers that both advertise it. It is off by default until enough relays
+	// run a version that supports it.
+	EnableVoteCompression bool `version[34]:"false"`
+
+	// EnableTxInventory advertises support for transaction inventory announcements. Transaction groups larger than
+	// a few hundred bytes are announced by their digest to peers that advertise it as well, and are only sent to
+	// the peers that request them. On P2P transactions are still gossiped over the GossipSub topic to the peers
+	// that do not advertise it, and messages are published to the GossipSub mesh peers only.
+	EnableTxInventory bool `version[34]:"false"`
+
+	// PeerBanThreshold is the misbehavior score at which a peer is banned. Peers are scored on invalid messages,
+	// rate limit violations and slow connections, and the score halves every 15 minutes. 0 disables banning.
+	PeerBanThreshold uint64 `version[34]:"100"`
+
+	// PeerBanDurationSeconds is the duration of a first ban. It doubles on every ban of the same peer, up to a day.
+	PeerBanDurationSeconds int `version[34]:"600"`
+
+	// EnableMessageRecorder records every gossip message sent or received by the node, with its tag, peer and
+	// timestamp, to the network.rec file of the hot data directory. Recordings can be replayed by tools/debug/msgreplay.
+	EnableMessageRecorder bool `version[34]:"false"`
+
+	// MessageRecorderFileSizeLimit is the size in bytes at which the message recording is rotated.
+	MessageRecorderFileSizeLimit uint64 `version[34]:"268435456"`
+
+	// MessageRecorderArchives is the number of rotated message recordings kept, as network.rec.1 (the most recent)
+	// to network.rec.N.
+	MessageRecorderArchives uint64 `version[34]:"4"`
+
 	// EnableP2P turns on the peer to peer network.
 	// When both EnableP2P and EnableP2PHybridMode (below) are set, EnableP2PHybridMode takes precedence.
 	EnableP2P bool `version[31]:"false"`
@@ -21,6 +21,7 @@ package config
 
 var defaultLocal = Local{
 	Version:                                    34,
+	AVMMetricsTopApps:                          20,
 	AccountUpdatesStatsInterval:                5000000000,
 	AccountsRebuildSynchronousMode:             1,
 	AgreementIncomingBundlesQueueLength:        15,
@@ -59,6 +60,7 @@ var defaultLocal = Local{
 	DisableLocalhostConnectionRateLimit:        true,
 	DisableNetworking:                          false,
 	DisableOutgoingConnectionThrottling:        false,
+	EnableAVMMetrics:                           false,
 	EnableAccountUpdatesStats:                  false,
 	EnableAgreementReporting:                   false,
 	EnableAgreementTimeMetrics:                 false,
@@ -67,11 +69,13 @@ var defaultLocal = Local{
 	EnableDHTProviders:                         false,
 	EnableDeveloperAPI:                         false,
 	EnableExperimentalAPI:                      false,
+	EnableExporter:                             false,
 	EnableFollowMode:                           false,
 	EnableGossipBlockService:                   true,
 	EnableGossipService:                        true,
 	EnableIncomingMessageFilter:                false,
 	EnableLedgerService:                        false,
+	EnableMessageRecorder:                      false,
 	EnableMetricReporting:                      false,
 	EnableNetDevMetrics:                        false,
 	EnableOutgoingNetworkMessageFiltering:      true,
@@ -83,13 +87,21 @@ var defaultLocal = Local{
 	EnableProfiler:                             false,
 	EnableRequestLogger:                        false,
 	EnableRuntimeMetrics:                       false,
+	EnableStateHistory:                         false,
 	EnableTopAccountsReporting:                 false,
 	EnableTxBacklogAppRateLimiting:             true,
 	EnableTxBacklogRateLimiting:                true,
+	EnableTxInventory:                          false,
 	EnableTxnEvalTracer:                        false,
 	EnableUsageLog:                             false,
 	EnableVerbosedTransactionSyncLogging:       false,
+	EnableVoteCompression:                      false,
 	EndpointAddress:                            "127.0.0.1:0",
+	ExporterFileMaxSize:                        134217728,
+	ExporterFormat:                             "json",
+	ExporterPath:                               "",
+	ExporterSink:                               "file",
+	ExporterStartRound:                         0,
 	FallbackDNSResolverAddress:                 "",
 	ForceFetchTransactions:                     false,
 	ForceRelayMessages:                         false,
@@ -112,6 +124,8 @@ var defaultLocal = Local{
 	MaxBlockHistoryLookback:                    0,
 	MaxCatchpointDownloadDuration:              43200000000000,
 	MaxConnectionsPerIP:                        15,
+	MessageRecorderArchives:                    4,
+	MessageRecorderFileSizeLimit:               268435456,
 	MinCatchpointFileDownloadBytesPerSecond:    20480,
 	NetAddress:                                 "",
 	NetworkMessageTraceServer:                  "",
@@ -126,6 +140,8 @@ var defaultLocal = Local{
 	P2PPersistPeerID:                           false,
 	P2PPrivateKeyLocation:                      "",
 	ParticipationKeysRefreshInterval:           60000000000,
+	PeerBanDurationSeconds:                     600,
+	PeerBanThreshold:                           100,
 	PeerConnectionsUpdateInterval:              3600,
 	PeerPingPeriodSeconds:                      0,
 	PriorityPeers:                              map[string]bool{},
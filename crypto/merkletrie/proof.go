@@ -0,0 +1,168 @@
+// Copyright (C) 2019-2024 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package merkletrie
+
+import (
+	"bytes"
+	"errors"
+
+	"github.com/Quarkonium-chain/go-quarkonium/crypto"
+)
+
+// ErrProofRootMismatch is returned when a proof doesn't hash to the expected root.
+var ErrProofRootMismatch = errors.New("proof doesn't match the trie root")
+
+// ErrProofMalformed is returned when a proof is structurally invalid for the proven element.
+var ErrProofMalformed = errors.New("malformed proof")
+
+// ErrUnableToProvePendingCommits is returned when proving over a trie with uncommitted changes.
+var ErrUnableToProvePendingCommits = errors.New("unable to prove as pending commits available")
+
+// ProofChild is a single child entry of a non-leaf node along a proof path.
+type ProofChild struct {
+	// HashIndex is the first byte of the child path, relative to its parent.
+	HashIndex byte
+	// Leaf is set if the child is a leaf node.
+	Leaf bool
+	// Hash is the hash of a non-leaf child, or the remainder of the element
+	// for a leaf child. It's empty for the child on the path to the proven
+	// element, as the verifier recomputes it.
+	Hash []byte
+}
+
+// ProofNode lists the children of a non-leaf node along a proof path, ordered by HashIndex.
+type ProofNode struct {
+	Children []ProofChild
+}
+
+// Proof is the list of non-leaf nodes from the root of the trie down to the
+// parent of the proven element. A trie with a single element has an empty path.
+type Proof struct {
+	Path []ProofNode
+}
+
+// Prove returns an inclusion proof for the given element, or nil if the
+// element isn't in the trie. Prove only reads the trie, so pending changes must
+// be committed first.
+func (mt *Trie) Prove(d []byte) (*Proof, error) {
+	if mt.root == storedNodeIdentifierNull {
+		return nil, nil
+	}
+	if len(d) != mt.elementLength {
+		return nil, ErrMismatchingElementLength
+	}
+	if mt.cache.modified {
+		// non-leaf hashes are only calculated during commit.
+		return nil, ErrUnableToProvePendingCommits
+	}
+	pnode, err := mt.cache.getNode(mt.root)
+	if err != nil {
+		return nil, err
+	}
+	proof := &Proof{}
+	for depth := 0; ; depth++ {
+		if pnode.leaf() {
+			if !bytes.Equal(pnode.hash, d[depth:]) {
+				return nil, nil
+			}
+			return proof, nil
+		}
+		if !pnode.childrenMask.Bit(d[depth]) {
+			return nil, nil
+		}
+		var next *node
+		proofNode := ProofNode{Children: make([]ProofChild, len(pnode.children))}
+		for i, child := range pnode.children {
+			childNode, err := mt.cache.getNode(child.id)
+			if err != nil {
+				return nil, err
+			}
+			proofNode.Children[i] = ProofChild{
+				HashIndex: child.hashIndex,
+				Leaf:      childNode.leaf(),
+			}
+			if child.hashIndex == d[depth] {
+				next = childNode
+			} else {
+				proofNode.Children[i].Hash = append([]byte{}, childNode.hash...)
+			}
+		}
+		proof.Path = append(proof.Path, proofNode)
+		pnode = next
+	}
+}
+
+// VerifyProof checks that the proof shows the inclusion of the element d in a
+// trie with the given root hash.
+func VerifyProof(root crypto.Digest, d []byte, proof *Proof) error {
+	if proof == nil || len(proof.Path) > len(d) {
+		return ErrProofMalformed
+	}
+	if len(proof.Path) == 0 {
+		if crypto.Hash(append([]byte{0}, d...)) != root {
+			return ErrProofRootMismatch
+		}
+		return nil
+	}
+
+	var childHash []byte
+	for depth := len(proof.Path) - 1; depth >= 0; depth-- {
+		path := d[:depth]
+		// mirror node.calculateHash
+		hashAccumulator := make([]byte, 0, 1+len(path)+len(proof.Path[depth].Children)*(3+crypto.DigestSize))
+		hashAccumulator = append(hashAccumulator, byte(len(path)))
+		hashAccumulator = append(hashAccumulator, path...)
+		onPath := 0
+		for i, child := range proof.Path[depth].Children {
+			if i > 0 && child.HashIndex <= proof.Path[depth].Children[i-1].HashIndex {
+				return ErrProofMalformed
+			}
+			hash := child.Hash
+			if child.HashIndex == d[depth] {
+				onPath++
+				// the last node holds the element as a leaf, any other one
+				// holds the next node of the path.
+				if child.Leaf != (depth == len(proof.Path)-1) || len(child.Hash) != 0 {
+					return ErrProofMalformed
+				}
+				if child.Leaf {
+					hash = d[depth+1:]
+				} else {
+					hash = childHash
+				}
+			}
+			if child.Leaf {
+				hashAccumulator = append(hashAccumulator, byte(0))
+			} else {
+				hashAccumulator = append(hashAccumulator, byte(1))
+			}
+			hashAccumulator = append(hashAccumulator, byte(len(hash)))
+			hashAccumulator = append(hashAccumulator, child.HashIndex)
+			hashAccumulator = append(hashAccumulator, hash...)
+		}
+		if onPath != 1 {
+			return ErrProofMalformed
+		}
+		hash := crypto.Hash(hashAccumulator)
+		childHash = hash[:]
+	}
+
+	if crypto.Hash(append([]byte{1}, childHash...)) != root {
+		return ErrProofRootMismatch
+	}
+	return nil
+}
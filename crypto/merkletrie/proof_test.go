@@ -0,0 +1,135 @@
+// Copyright (C) 2019-2024 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package merkletrie
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Quarkonium-chain/go-quarkonium/crypto"
+	"github.com/Quarkonium-chain/go-quarkonium/test/partitiontest"
+)
+
+func TestProofSingleElement(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	mt, err := MakeTrie(nil, defaultTestMemoryConfig)
+	require.NoError(t, err)
+	hashes := makeHashes(2)
+
+	proof, err := mt.Prove(hashes[0])
+	require.NoError(t, err)
+	require.Nil(t, proof)
+
+	mt.Add(hashes[0])
+	root, err := mt.RootHash()
+	require.NoError(t, err)
+
+	proof, err = mt.Prove(hashes[0])
+	require.NoError(t, err)
+	require.NotNil(t, proof)
+	require.Empty(t, proof.Path)
+	require.NoError(t, VerifyProof(root, hashes[0], proof))
+	require.ErrorIs(t, VerifyProof(root, hashes[1], proof), ErrProofRootMismatch)
+
+	proof, err = mt.Prove(hashes[1])
+	require.NoError(t, err)
+	require.Nil(t, proof)
+}
+
+func TestProofs(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	mt, err := MakeTrie(&InMemoryCommitter{}, defaultTestMemoryConfig)
+	require.NoError(t, err)
+	hashes := makeHashes(6000)
+	for _, h := range hashes[:5000] {
+		added, err := mt.Add(h)
+		require.NoError(t, err)
+		require.True(t, added)
+	}
+	// non-leaf hashes are stale until the pending changes are committed
+	_, err = mt.Prove(hashes[0])
+	require.ErrorIs(t, err, ErrUnableToProvePendingCommits)
+	_, err = mt.Evict(true)
+	require.NoError(t, err)
+	root, err := mt.RootHash()
+	require.NoError(t, err)
+
+	for i, h := range hashes {
+		proof, err := mt.Prove(h)
+		require.NoError(t, err)
+		if i >= 5000 {
+			require.Nil(t, proof)
+			continue
+		}
+		require.NotNil(t, proof)
+		require.NoError(t, VerifyProof(root, h, proof))
+	}
+
+	_, err = mt.Prove(hashes[0][:4])
+	require.ErrorIs(t, err, ErrMismatchingElementLength)
+
+	proof, err := mt.Prove(hashes[1])
+	require.NoError(t, err)
+	require.Greater(t, len(proof.Path), 1)
+
+	// a proof doesn't hold for another element, nor for another root.
+	require.Error(t, VerifyProof(root, hashes[2], proof))
+	require.ErrorIs(t, VerifyProof(crypto.Hash([]byte("other")), hashes[1], proof), ErrProofRootMismatch)
+
+	// tampering with a sibling breaks the proof.
+	for i, n := range proof.Path {
+		for j, child := range n.Children {
+			if len(child.Hash) == 0 {
+				continue
+			}
+			tampered := cloneProof(proof)
+			tampered.Path[i].Children[j].Hash[0]++
+			require.ErrorIs(t, VerifyProof(root, hashes[1], tampered), ErrProofRootMismatch)
+		}
+	}
+
+	// so does dropping, duplicating or reordering nodes.
+	tampered := cloneProof(proof)
+	tampered.Path = tampered.Path[1:]
+	require.Error(t, VerifyProof(root, hashes[1], tampered))
+
+	tampered = cloneProof(proof)
+	tampered.Path = append(tampered.Path, tampered.Path[len(tampered.Path)-1])
+	require.Error(t, VerifyProof(root, hashes[1], tampered))
+
+	tampered = cloneProof(proof)
+	children := tampered.Path[0].Children
+	children[0], children[1] = children[1], children[0]
+	require.ErrorIs(t, VerifyProof(root, hashes[1], tampered), ErrProofMalformed)
+
+	require.ErrorIs(t, VerifyProof(root, hashes[1], nil), ErrProofMalformed)
+}
+
+func cloneProof(proof *Proof) *Proof {
+	clone := &Proof{Path: make([]ProofNode, len(proof.Path))}
+	for i, n := range proof.Path {
+		clone.Path[i].Children = make([]ProofChild, len(n.Children))
+		for j, child := range n.Children {
+			clone.Path[i].Children[j] = child
+			clone.Path[i].Children[j].Hash = append([]byte{}, child.Hash...)
+		}
+	}
+	return clone
+}
@@ -434,6 +434,66 @@ func BenchmarkUnmarshalHashFactory(b *testing.B) {
 	}
 }
 
+func TestMarshalUnmarshalHeartbeatProof(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	v := HeartbeatProof{}
+	bts := v.MarshalMsg(nil)
+	left, err := v.UnmarshalMsg(bts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(left) > 0 {
+		t.Errorf("%d bytes left over after UnmarshalMsg(): %q", len(left), left)
+	}
+
+	left, err = msgp.Skip(bts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(left) > 0 {
+		t.Errorf("%d bytes left over after Skip(): %q", len(left), left)
+	}
+}
+
+func TestRandomizedEncodingHeartbeatProof(t *testing.T) {
+	protocol.RunEncodingTest(t, &HeartbeatProof{})
+}
+
+func BenchmarkMarshalMsgHeartbeatProof(b *testing.B) {
+	v := HeartbeatProof{}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		v.MarshalMsg(nil)
+	}
+}
+
+func BenchmarkAppendMsgHeartbeatProof(b *testing.B) {
+	v := HeartbeatProof{}
+	bts := make([]byte, 0, v.Msgsize())
+	bts = v.MarshalMsg(bts[0:0])
+	b.SetBytes(int64(len(bts)))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		bts = v.MarshalMsg(bts[0:0])
+	}
+}
+
+func BenchmarkUnmarshalHeartbeatProof(b *testing.B) {
+	v := HeartbeatProof{}
+	bts := v.MarshalMsg(nil)
+	b.ReportAllocs()
+	b.SetBytes(int64(len(bts)))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, err := v.UnmarshalMsg(bts)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
 func TestMarshalUnmarshalMasterDerivationKey(t *testing.T) {
 	partitiontest.PartitionTest(t)
 	v := MasterDerivationKey{}
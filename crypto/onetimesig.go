@@ -57,6 +57,45 @@ type OneTimeSignature struct {
 	PK2Sig ed25519Signature `codec:"p2s"`
 }
 
+// A HeartbeatProof is a OneTimeSignature without the unused PKSigOld field,
+// marked omitempty so that it can be carried in heartbeat transactions.
+type HeartbeatProof struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	// Sig is a signature of msg under the key PK.
+	Sig ed25519Signature `codec:"s"`
+	PK  ed25519PublicKey `codec:"p"`
+
+	// PK2 is used to verify a two-level ephemeral signature.
+	PK2 ed25519PublicKey `codec:"p2"`
+	// PK1Sig is a signature of OneTimeSignatureSubkeyOffsetID(PK, Batch, Offset) under the key PK2.
+	PK1Sig ed25519Signature `codec:"p1s"`
+	// PK2Sig is a signature of OneTimeSignatureSubkeyBatchID(PK2, Batch) under the master key (OneTimeSignatureVerifier).
+	PK2Sig ed25519Signature `codec:"p2s"`
+}
+
+// ToOneTimeSignature converts a HeartbeatProof to a OneTimeSignature.
+func (hbp HeartbeatProof) ToOneTimeSignature() OneTimeSignature {
+	return OneTimeSignature{
+		Sig:    hbp.Sig,
+		PK:     hbp.PK,
+		PK2:    hbp.PK2,
+		PK1Sig: hbp.PK1Sig,
+		PK2Sig: hbp.PK2Sig,
+	}
+}
+
+// ToHeartbeatProof converts a OneTimeSignature to a HeartbeatProof.
+func (ots OneTimeSignature) ToHeartbeatProof() HeartbeatProof {
+	return HeartbeatProof{
+		Sig:    ots.Sig,
+		PK:     ots.PK,
+		PK2:    ots.PK2,
+		PK1Sig: ots.PK1Sig,
+		PK2Sig: ots.PK2Sig,
+	}
+}
+
 // A OneTimeSignatureSubkeyBatchID identifies an ephemeralSubkey of a batch
 // for the purposes of signing it with the top-level master key.
 type OneTimeSignatureSubkeyBatchID struct {
@@ -0,0 +1,97 @@
+// Copyright (C) 2019-2024 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package statetrie
+
+// BackingStore is the interface supporting the persistence of trie nodes.
+// Nodes are keyed by the serialized nibble path leading to them from the root.
+type BackingStore interface {
+	// Get returns the content stored for key, or nil if no such key exists.
+	Get(key []byte) ([]byte, error)
+	// BatchStart begins a batch of modifications; Set and Delete calls are
+	// only guaranteed to be visible once BatchEnd returns.
+	BatchStart()
+	// Set stores content under key as part of the current batch.
+	Set(key []byte, content []byte) error
+	// Delete removes key as part of the current batch.
+	Delete(key []byte) error
+	// BatchEnd applies the current batch.
+	BatchEnd() error
+	// BatchAbort discards the current batch without applying any of it.
+	BatchAbort()
+}
+
+// memoryBackingStore is a fully functional in-memory backing store.
+type memoryBackingStore struct {
+	store   map[string][]byte
+	pending map[string][]byte
+}
+
+// MakeMemoryBackingStore creates an in-memory backing store.
+func MakeMemoryBackingStore() BackingStore {
+	return &memoryBackingStore{
+		store: make(map[string][]byte),
+	}
+}
+
+// Get returns the content stored for key.
+func (mb *memoryBackingStore) Get(key []byte) ([]byte, error) {
+	return mb.store[string(key)], nil
+}
+
+// BatchStart begins a batch of modifications.
+func (mb *memoryBackingStore) BatchStart() {
+	mb.pending = make(map[string][]byte)
+}
+
+// Set stores content under key once the batch ends.
+func (mb *memoryBackingStore) Set(key []byte, content []byte) error {
+	if mb.pending == nil {
+		return ErrNoBatchInProgress
+	}
+	mb.pending[string(key)] = append([]byte{}, content...)
+	return nil
+}
+
+// Delete removes key once the batch ends.
+func (mb *memoryBackingStore) Delete(key []byte) error {
+	if mb.pending == nil {
+		return ErrNoBatchInProgress
+	}
+	mb.pending[string(key)] = nil
+	return nil
+}
+
+// BatchEnd applies the pending modifications.
+func (mb *memoryBackingStore) BatchEnd() error {
+	if mb.pending == nil {
+		return ErrNoBatchInProgress
+	}
+	for k, content := range mb.pending {
+		if content == nil {
+			delete(mb.store, k)
+		} else {
+			mb.store[k] = content
+		}
+	}
+	mb.pending = nil
+	return nil
+}
+
+// BatchAbort discards the pending modifications.
+func (mb *memoryBackingStore) BatchAbort() {
+	mb.pending = nil
+}
@@ -0,0 +1,238 @@
+// Copyright (C) 2019-2024 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package statetrie
+
+import (
+	"github.com/Quarkonium-chain/go-quarkonium/crypto"
+	"github.com/Quarkonium-chain/go-quarkonium/crypto/statetrie/nibbles"
+)
+
+// branchNode has a child per nibble value, and optionally holds the value of
+// the key that ends at the branch itself.
+type branchNode struct {
+	nodeState
+	children [branchWidth]node
+	value    []byte
+	hasValue bool
+}
+
+// makeBranchNode creates a new, modified, empty branch node.
+func makeBranchNode() *branchNode {
+	bn := &branchNode{}
+	bn.touch()
+	return bn
+}
+
+// setValue sets the value of the key ending at the branch.
+func (bn *branchNode) setValue(value []byte) {
+	bn.value = append([]byte{}, value...)
+	bn.hasValue = true
+}
+
+func (bn *branchNode) add(mt *Trie, pathKey nibbles.Nibbles, remainingKey nibbles.Nibbles, value []byte) (node, error) {
+	bn.touch()
+	if len(remainingKey) == 0 {
+		bn.setValue(value)
+		return bn, nil
+	}
+	idx := remainingKey[0]
+	if bn.children[idx] == nil {
+		bn.children[idx] = makeLeafNode(remainingKey[1:], value)
+		return bn, nil
+	}
+	childPath := extendPath(pathKey, idx)
+	child, err := mt.resolve(bn.children[idx], childPath)
+	if err != nil {
+		return nil, err
+	}
+	bn.children[idx], err = child.add(mt, childPath, remainingKey[1:], value)
+	if err != nil {
+		return nil, err
+	}
+	return bn, nil
+}
+
+func (bn *branchNode) delete(mt *Trie, pathKey nibbles.Nibbles, remainingKey nibbles.Nibbles) (node, bool, error) {
+	if len(remainingKey) == 0 {
+		if !bn.hasValue {
+			return bn, false, nil
+		}
+		bn.value = nil
+		bn.hasValue = false
+	} else {
+		idx := remainingKey[0]
+		if bn.children[idx] == nil {
+			return bn, false, nil
+		}
+		childPath := extendPath(pathKey, idx)
+		child, err := mt.resolve(bn.children[idx], childPath)
+		if err != nil {
+			return nil, false, err
+		}
+		bn.children[idx] = child
+		newChild, found, err := child.delete(mt, childPath, remainingKey[1:])
+		if err != nil || !found {
+			return bn, false, err
+		}
+		bn.children[idx] = newChild
+	}
+	bn.touch()
+	n, err := bn.collapse(mt, pathKey)
+	return n, true, err
+}
+
+// collapse replaces a branch which is left with a single entry by an
+// equivalent leaf or extension node.
+func (bn *branchNode) collapse(mt *Trie, pathKey nibbles.Nibbles) (node, error) {
+	entries := 0
+	lastIdx := 0
+	for i, child := range bn.children {
+		if child != nil {
+			entries++
+			lastIdx = i
+		}
+	}
+	if bn.hasValue {
+		if entries == 0 {
+			return makeLeafNode(nil, bn.value), nil
+		}
+		return bn, nil
+	}
+	switch entries {
+	case 0:
+		mt.vacate(pathKey)
+		return nil, nil
+	case 1:
+	default:
+		return bn, nil
+	}
+
+	idx := byte(lastIdx)
+	childPath := extendPath(pathKey, idx)
+	child, err := mt.resolve(bn.children[idx], childPath)
+	if err != nil {
+		return nil, err
+	}
+	switch c := child.(type) {
+	case *leafNode:
+		// the leaf moves up to take the place of the branch.
+		mt.vacate(childPath)
+		return makeLeafNode(extendPath(nibbles.Nibbles{idx}, c.keyEnd...), c.value), nil
+	case *extensionNode:
+		// the extension absorbs the branch nibble; its child stays in place.
+		mt.vacate(childPath)
+		return makeExtensionNode(extendPath(nibbles.Nibbles{idx}, c.sharedKey...), c.next), nil
+	default:
+		return makeExtensionNode(nibbles.Nibbles{idx}, child), nil
+	}
+}
+
+func (bn *branchNode) get(mt *Trie, pathKey nibbles.Nibbles, remainingKey nibbles.Nibbles) ([]byte, bool, error) {
+	if len(remainingKey) == 0 {
+		return bn.value, bn.hasValue, nil
+	}
+	idx := remainingKey[0]
+	if bn.children[idx] == nil {
+		return nil, false, nil
+	}
+	childPath := extendPath(pathKey, idx)
+	child, err := mt.resolve(bn.children[idx], childPath)
+	if err != nil {
+		return nil, false, err
+	}
+	bn.children[idx] = child
+	return child.get(mt, childPath, remainingKey[1:])
+}
+
+func (bn *branchNode) prove(mt *Trie, pathKey nibbles.Nibbles, remainingKey nibbles.Nibbles, proof *Proof) error {
+	proof.Nodes = append(proof.Nodes, bn.serialize())
+	if len(remainingKey) == 0 || bn.children[remainingKey[0]] == nil {
+		return nil
+	}
+	idx := remainingKey[0]
+	childPath := extendPath(pathKey, idx)
+	child, err := mt.resolve(bn.children[idx], childPath)
+	if err != nil {
+		return err
+	}
+	bn.children[idx] = child
+	return child.prove(mt, childPath, remainingKey[1:], proof)
+}
+
+func (bn *branchNode) commit(pathKey nibbles.Nibbles, c *commitSet) {
+	if !bn.dirty {
+		return
+	}
+	for i, child := range bn.children {
+		if child != nil {
+			child.commit(extendPath(pathKey, byte(i)), c)
+		}
+	}
+	c.add(pathKey, &bn.nodeState, bn.serialize())
+}
+
+func (bn *branchNode) hash() crypto.Digest {
+	if !bn.hashValid {
+		bn.cachedHash = hashNode(bn.serialize())
+		bn.hashValid = true
+	}
+	return bn.cachedHash
+}
+
+// serialize encodes the branch as its type, the hashes of its children (a
+// zero hash standing for a missing child), a value indicator and the value.
+func (bn *branchNode) serialize() []byte {
+	buf := make([]byte, 0, 2+branchWidth*crypto.DigestSize+len(bn.value))
+	buf = append(buf, branchNodeType)
+	for _, child := range bn.children {
+		var h crypto.Digest
+		if child != nil {
+			h = child.hash()
+		}
+		buf = append(buf, h[:]...)
+	}
+	if !bn.hasValue {
+		return append(buf, 0)
+	}
+	buf = append(buf, 1)
+	return append(buf, bn.value...)
+}
+
+func deserializeBranchNode(data []byte) (node, error) {
+	bn := &branchNode{}
+	var err error
+	for i := range bn.children {
+		bn.children[i], data, err = readHash(data)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if len(data) == 0 {
+		return nil, ErrNodeDecodingFailure
+	}
+	switch data[0] {
+	case 0:
+		if len(data) != 1 {
+			return nil, ErrNodeDecodingFailure
+		}
+	case 1:
+		bn.setValue(data[1:])
+	default:
+		return nil, ErrNodeDecodingFailure
+	}
+	return bn, nil
+}
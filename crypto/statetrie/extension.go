@@ -0,0 +1,184 @@
+// Copyright (C) 2019-2024 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package statetrie
+
+import (
+	"github.com/Quarkonium-chain/go-quarkonium/crypto"
+	"github.com/Quarkonium-chain/go-quarkonium/crypto/statetrie/nibbles"
+)
+
+// extensionNode compresses a run of nibbles shared by every key below it.
+// Its child is always a branch node.
+type extensionNode struct {
+	nodeState
+	sharedKey nibbles.Nibbles
+	next      node
+}
+
+// makeExtensionNode creates a new, modified, extension node.
+func makeExtensionNode(sharedKey nibbles.Nibbles, next node) *extensionNode {
+	en := &extensionNode{
+		sharedKey: cloneNibbles(sharedKey),
+		next:      next,
+	}
+	en.touch()
+	return en
+}
+
+func (en *extensionNode) add(mt *Trie, pathKey nibbles.Nibbles, remainingKey nibbles.Nibbles, value []byte) (node, error) {
+	shared := nibbles.SharedPrefix(en.sharedKey, remainingKey)
+	if len(shared) == len(en.sharedKey) {
+		childPath := extendPath(pathKey, en.sharedKey...)
+		next, err := mt.resolve(en.next, childPath)
+		if err != nil {
+			return nil, err
+		}
+		en.next, err = next.add(mt, childPath, remainingKey[len(shared):], value)
+		if err != nil {
+			return nil, err
+		}
+		en.touch()
+		return en, nil
+	}
+
+	// split the extension at the first diverging nibble. The existing
+	// branch below the extension stays at the same path.
+	bn := makeBranchNode()
+	existingRest := nibbles.ShiftLeft(en.sharedKey, len(shared))
+	if len(existingRest) == 1 {
+		bn.children[existingRest[0]] = en.next
+	} else {
+		bn.children[existingRest[0]] = makeExtensionNode(existingRest[1:], en.next)
+	}
+	addedRest := nibbles.ShiftLeft(remainingKey, len(shared))
+	if len(addedRest) == 0 {
+		bn.setValue(value)
+	} else {
+		bn.children[addedRest[0]] = makeLeafNode(addedRest[1:], value)
+	}
+	if len(shared) == 0 {
+		return bn, nil
+	}
+	return makeExtensionNode(shared, bn), nil
+}
+
+func (en *extensionNode) delete(mt *Trie, pathKey nibbles.Nibbles, remainingKey nibbles.Nibbles) (node, bool, error) {
+	if !nibbles.HasPrefix(remainingKey, en.sharedKey) {
+		return en, false, nil
+	}
+	childPath := extendPath(pathKey, en.sharedKey...)
+	next, err := mt.resolve(en.next, childPath)
+	if err != nil {
+		return nil, false, err
+	}
+	en.next = next
+	newNext, found, err := next.delete(mt, childPath, remainingKey[len(en.sharedKey):])
+	if err != nil || !found {
+		return en, false, err
+	}
+	switch n := newNext.(type) {
+	case nil:
+		mt.vacate(pathKey)
+		return nil, true, nil
+	case *leafNode:
+		// the branch below collapsed into a leaf, which absorbs the extension.
+		mt.vacate(childPath)
+		return makeLeafNode(extendPath(en.sharedKey, n.keyEnd...), n.value), true, nil
+	case *extensionNode:
+		// the branch below collapsed into an extension; merge the two.
+		mt.vacate(childPath)
+		return makeExtensionNode(extendPath(en.sharedKey, n.sharedKey...), n.next), true, nil
+	default:
+		en.next = newNext
+		en.touch()
+		return en, true, nil
+	}
+}
+
+func (en *extensionNode) get(mt *Trie, pathKey nibbles.Nibbles, remainingKey nibbles.Nibbles) ([]byte, bool, error) {
+	if !nibbles.HasPrefix(remainingKey, en.sharedKey) {
+		return nil, false, nil
+	}
+	childPath := extendPath(pathKey, en.sharedKey...)
+	next, err := mt.resolve(en.next, childPath)
+	if err != nil {
+		return nil, false, err
+	}
+	en.next = next
+	return next.get(mt, childPath, remainingKey[len(en.sharedKey):])
+}
+
+func (en *extensionNode) prove(mt *Trie, pathKey nibbles.Nibbles, remainingKey nibbles.Nibbles, proof *Proof) error {
+	proof.Nodes = append(proof.Nodes, en.serialize())
+	if !nibbles.HasPrefix(remainingKey, en.sharedKey) {
+		return nil
+	}
+	childPath := extendPath(pathKey, en.sharedKey...)
+	next, err := mt.resolve(en.next, childPath)
+	if err != nil {
+		return err
+	}
+	en.next = next
+	return next.prove(mt, childPath, remainingKey[len(en.sharedKey):], proof)
+}
+
+func (en *extensionNode) commit(pathKey nibbles.Nibbles, c *commitSet) {
+	if !en.dirty {
+		return
+	}
+	en.next.commit(extendPath(pathKey, en.sharedKey...), c)
+	c.add(pathKey, &en.nodeState, en.serialize())
+}
+
+func (en *extensionNode) hash() crypto.Digest {
+	if !en.hashValid {
+		en.cachedHash = hashNode(en.serialize())
+		en.hashValid = true
+	}
+	return en.cachedHash
+}
+
+// serialize encodes the extension as its type, the length prefixed shared
+// key and the hash of its child.
+func (en *extensionNode) serialize() []byte {
+	buf := make([]byte, 0, len(en.sharedKey)/2+crypto.DigestSize+4)
+	buf = append(buf, extensionNodeType)
+	buf = appendNibbles(buf, en.sharedKey)
+	h := en.next.hash()
+	return append(buf, h[:]...)
+}
+
+func deserializeExtensionNode(data []byte) (node, error) {
+	sharedKey, data, err := readNibbles(data)
+	if err != nil {
+		return nil, err
+	}
+	if len(sharedKey) == 0 {
+		return nil, ErrNodeDecodingFailure
+	}
+	next, data, err := readHash(data)
+	if err != nil {
+		return nil, err
+	}
+	if next == nil || len(data) != 0 {
+		return nil, ErrNodeDecodingFailure
+	}
+	return &extensionNode{
+		sharedKey: sharedKey,
+		next:      next,
+	}, nil
+}
@@ -0,0 +1,123 @@
+// Copyright (C) 2019-2024 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package statetrie
+
+import (
+	"github.com/Quarkonium-chain/go-quarkonium/crypto"
+	"github.com/Quarkonium-chain/go-quarkonium/crypto/statetrie/nibbles"
+)
+
+// leafNode holds a value, along with the part of its key that isn't
+// consumed by the path leading to the leaf.
+type leafNode struct {
+	nodeState
+	keyEnd nibbles.Nibbles
+	value  []byte
+}
+
+// makeLeafNode creates a new, modified, leaf node.
+func makeLeafNode(keyEnd nibbles.Nibbles, value []byte) *leafNode {
+	ln := &leafNode{
+		keyEnd: cloneNibbles(keyEnd),
+		value:  append([]byte{}, value...),
+	}
+	ln.touch()
+	return ln
+}
+
+func (ln *leafNode) add(mt *Trie, pathKey nibbles.Nibbles, remainingKey nibbles.Nibbles, value []byte) (node, error) {
+	if nibbles.Equal(ln.keyEnd, remainingKey) {
+		ln.value = append([]byte{}, value...)
+		ln.touch()
+		return ln, nil
+	}
+
+	// split the leaf into a branch, preceded by an extension if the keys
+	// share a prefix.
+	shared := nibbles.SharedPrefix(ln.keyEnd, remainingKey)
+	bn := makeBranchNode()
+	existingRest := nibbles.ShiftLeft(ln.keyEnd, len(shared))
+	if len(existingRest) == 0 {
+		bn.setValue(ln.value)
+	} else {
+		bn.children[existingRest[0]] = makeLeafNode(existingRest[1:], ln.value)
+	}
+	addedRest := nibbles.ShiftLeft(remainingKey, len(shared))
+	if len(addedRest) == 0 {
+		bn.setValue(value)
+	} else {
+		bn.children[addedRest[0]] = makeLeafNode(addedRest[1:], value)
+	}
+	if len(shared) == 0 {
+		return bn, nil
+	}
+	return makeExtensionNode(shared, bn), nil
+}
+
+func (ln *leafNode) delete(mt *Trie, pathKey nibbles.Nibbles, remainingKey nibbles.Nibbles) (node, bool, error) {
+	if !nibbles.Equal(ln.keyEnd, remainingKey) {
+		return ln, false, nil
+	}
+	mt.vacate(pathKey)
+	return nil, true, nil
+}
+
+func (ln *leafNode) get(mt *Trie, pathKey nibbles.Nibbles, remainingKey nibbles.Nibbles) ([]byte, bool, error) {
+	if !nibbles.Equal(ln.keyEnd, remainingKey) {
+		return nil, false, nil
+	}
+	return ln.value, true, nil
+}
+
+func (ln *leafNode) prove(mt *Trie, pathKey nibbles.Nibbles, remainingKey nibbles.Nibbles, proof *Proof) error {
+	proof.Nodes = append(proof.Nodes, ln.serialize())
+	return nil
+}
+
+func (ln *leafNode) commit(pathKey nibbles.Nibbles, c *commitSet) {
+	if !ln.dirty {
+		return
+	}
+	c.add(pathKey, &ln.nodeState, ln.serialize())
+}
+
+func (ln *leafNode) hash() crypto.Digest {
+	if !ln.hashValid {
+		ln.cachedHash = hashNode(ln.serialize())
+		ln.hashValid = true
+	}
+	return ln.cachedHash
+}
+
+// serialize encodes the leaf as its type, the length prefixed key end and the value.
+func (ln *leafNode) serialize() []byte {
+	buf := make([]byte, 0, len(ln.keyEnd)/2+len(ln.value)+4)
+	buf = append(buf, leafNodeType)
+	buf = appendNibbles(buf, ln.keyEnd)
+	return append(buf, ln.value...)
+}
+
+func deserializeLeafNode(data []byte) (node, error) {
+	keyEnd, data, err := readNibbles(data)
+	if err != nil {
+		return nil, err
+	}
+	return &leafNode{
+		keyEnd: keyEnd,
+		value:  append([]byte{}, data...),
+	}, nil
+}
@@ -95,6 +95,14 @@ func SharedPrefix(nyb1 Nibbles, nyb2 Nibbles) Nibbles {
 	return nyb1[:minLength]
 }
 
+// HasPrefix returns true if nyb begins with prefix
+// [0x1, 0x2, 0x3], [0x1, 0x2] -> true
+// [0x1, 0x2, 0x3], [0x2] -> false
+// [0x1, 0x2, 0x3], [] -> true
+func HasPrefix(nyb Nibbles, prefix Nibbles) bool {
+	return bytes.HasPrefix(nyb, prefix)
+}
+
 // Serialize returns a byte array that represents the Nibbles
 // an empty nibble array is serialized as a single byte with value 0x3
 // as the empty nibble is considered to be full width
@@ -123,16 +131,16 @@ func Deserialize(encoding []byte) (Nibbles, error) {
 		if length == 1 {
 			return nil, errors.New("invalid encoding")
 		}
-		ns = makeNibbles(encoding[:length-1], true)
+		ns = MakeNibbles(encoding[:length-1], true)
 	} else if encoding[length-1] == evenIndicator {
-		ns = makeNibbles(encoding[:length-1], false)
+		ns = MakeNibbles(encoding[:length-1], false)
 	} else {
 		return nil, errors.New("invalid encoding")
 	}
 	return ns, nil
 }
 
-// makeNibbles returns a nibble array from the byte array.  If oddLength is true,
+// MakeNibbles returns a nibble array from the byte array.  If oddLength is true,
 // the last 4 bits of the last byte of the array are ignored.
 //
 // [0x12, 0x30], true -> [0x1, 0x2, 0x3]
@@ -141,7 +149,7 @@ func Deserialize(encoding []byte) (Nibbles, error) {
 // [], false -> []
 // never to be called with [], true
 // Allocates a new byte slice.
-func makeNibbles(data []byte, oddLength bool) Nibbles {
+func MakeNibbles(data []byte, oddLength bool) Nibbles {
 	length := len(data) * 2
 	if oddLength {
 		length = length - 1
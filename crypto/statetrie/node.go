@@ -0,0 +1,249 @@
+// Copyright (C) 2019-2024 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package statetrie
+
+import (
+	"encoding/binary"
+	"errors"
+
+	"github.com/Quarkonium-chain/go-quarkonium/crypto"
+	"github.com/Quarkonium-chain/go-quarkonium/crypto/statetrie/nibbles"
+	"github.com/Quarkonium-chain/go-quarkonium/protocol"
+)
+
+const (
+	// leafNodeType prefixes the encoding of a leaf node.
+	leafNodeType = byte(0x01)
+	// extensionNodeType prefixes the encoding of an extension node.
+	extensionNodeType = byte(0x02)
+	// branchNodeType prefixes the encoding of a branch node.
+	branchNodeType = byte(0x03)
+
+	// branchWidth is the number of children of a branch node, one per nibble value.
+	branchWidth = 16
+)
+
+// ErrNodeDecodingFailure is returned if a serialized node could not be decoded.
+var ErrNodeDecodingFailure = errors.New("error encountered while decoding node")
+
+// ErrNodeHashMismatch is returned when a node loaded from the backing store doesn't hash to the value its parent expects.
+var ErrNodeHashMismatch = errors.New("loaded node hash mismatch")
+
+// ErrNodeMissing is returned when a node referenced by its parent doesn't exist in the backing store.
+var ErrNodeMissing = errors.New("referenced node is missing from the backing store")
+
+// node is implemented by every node kind of the trie. pathKey is always the
+// nibble path from the root to the node, which is also the key the node is
+// persisted under, and remainingKey is the part of the searched key which
+// wasn't consumed by the node's ancestors.
+type node interface {
+	// add sets the value of remainingKey in the subtree rooted at this node,
+	// and returns the node that replaces it.
+	add(mt *Trie, pathKey nibbles.Nibbles, remainingKey nibbles.Nibbles, value []byte) (node, error)
+	// delete removes remainingKey from the subtree rooted at this node, and
+	// returns the node that replaces it (nil if the subtree became empty) and
+	// whether the key was found.
+	delete(mt *Trie, pathKey nibbles.Nibbles, remainingKey nibbles.Nibbles) (node, bool, error)
+	// get returns the value of remainingKey in the subtree rooted at this node.
+	get(mt *Trie, pathKey nibbles.Nibbles, remainingKey nibbles.Nibbles) ([]byte, bool, error)
+	// prove appends the encoding of the nodes on the path to remainingKey to proof.
+	prove(mt *Trie, pathKey nibbles.Nibbles, remainingKey nibbles.Nibbles, proof *Proof) error
+	// commit records the encoding of every modified node of the subtree in c.
+	commit(pathKey nibbles.Nibbles, c *commitSet)
+	// hash returns the hash of the node encoding, calculating it if needed.
+	hash() crypto.Digest
+	// serialize returns the node encoding.
+	serialize() []byte
+	// isDirty returns true if the node was modified since it was last committed.
+	isDirty() bool
+}
+
+// nodeState holds the bookkeeping shared by the in-memory node kinds.
+type nodeState struct {
+	// cachedHash is the node hash, valid only if hashValid is set.
+	cachedHash crypto.Digest
+	hashValid  bool
+	// dirty is set when the node was modified since it was last committed.
+	dirty bool
+}
+
+// touch marks the node as modified.
+func (ns *nodeState) touch() {
+	ns.hashValid = false
+	ns.dirty = true
+}
+
+func (ns *nodeState) isDirty() bool {
+	return ns.dirty
+}
+
+// setHash marks the node as clean, with the given hash.
+func (ns *nodeState) setHash(h crypto.Digest) {
+	ns.cachedHash = h
+	ns.hashValid = true
+	ns.dirty = false
+}
+
+// commitSet gathers the node encodings written by a commit, keyed by their
+// store key, and the nodes to mark as clean once the writes are persisted.
+type commitSet struct {
+	writes  map[string][]byte
+	written []*nodeState
+}
+
+// add records the encoding of the node located at pathKey.
+func (c *commitSet) add(pathKey nibbles.Nibbles, ns *nodeState, data []byte) {
+	c.writes[string(storeKey(pathKey))] = data
+	c.written = append(c.written, ns)
+}
+
+// nodeEncoding is a serialized node, hashed with its own domain separator.
+type nodeEncoding []byte
+
+// ToBeHashed implements the crypto.Hashable interface.
+func (e nodeEncoding) ToBeHashed() (protocol.HashID, []byte) {
+	return protocol.StateTrieNode, e
+}
+
+// hashNode returns the hash of a serialized node.
+func hashNode(data []byte) crypto.Digest {
+	return crypto.HashObj(nodeEncoding(data))
+}
+
+// backNode is a placeholder for a node that is persisted in the backing store
+// but wasn't loaded into memory yet.
+type backNode struct {
+	h crypto.Digest
+}
+
+func (bn *backNode) add(mt *Trie, pathKey nibbles.Nibbles, remainingKey nibbles.Nibbles, value []byte) (node, error) {
+	n, err := mt.resolve(bn, pathKey)
+	if err != nil {
+		return nil, err
+	}
+	return n.add(mt, pathKey, remainingKey, value)
+}
+
+func (bn *backNode) delete(mt *Trie, pathKey nibbles.Nibbles, remainingKey nibbles.Nibbles) (node, bool, error) {
+	n, err := mt.resolve(bn, pathKey)
+	if err != nil {
+		return nil, false, err
+	}
+	return n.delete(mt, pathKey, remainingKey)
+}
+
+func (bn *backNode) get(mt *Trie, pathKey nibbles.Nibbles, remainingKey nibbles.Nibbles) ([]byte, bool, error) {
+	n, err := mt.resolve(bn, pathKey)
+	if err != nil {
+		return nil, false, err
+	}
+	return n.get(mt, pathKey, remainingKey)
+}
+
+func (bn *backNode) prove(mt *Trie, pathKey nibbles.Nibbles, remainingKey nibbles.Nibbles, proof *Proof) error {
+	n, err := mt.resolve(bn, pathKey)
+	if err != nil {
+		return err
+	}
+	return n.prove(mt, pathKey, remainingKey, proof)
+}
+
+// commit is a no-op, as a back node is already persisted.
+func (bn *backNode) commit(pathKey nibbles.Nibbles, c *commitSet) {}
+
+func (bn *backNode) hash() crypto.Digest {
+	return bn.h
+}
+
+// serialize is never called on a back node, as its encoding lives in the backing store.
+func (bn *backNode) serialize() []byte {
+	return nil
+}
+
+func (bn *backNode) isDirty() bool {
+	return false
+}
+
+// storeKey returns the backing store key of the node located at pathKey.
+func storeKey(pathKey nibbles.Nibbles) []byte {
+	return nibbles.Serialize(pathKey)
+}
+
+// extendPath returns a newly allocated path made of pathKey followed by suffix.
+func extendPath(pathKey nibbles.Nibbles, suffix ...byte) nibbles.Nibbles {
+	p := make(nibbles.Nibbles, 0, len(pathKey)+len(suffix))
+	p = append(p, pathKey...)
+	return append(p, suffix...)
+}
+
+// cloneNibbles returns a copy of nyb which doesn't share its backing array.
+func cloneNibbles(nyb nibbles.Nibbles) nibbles.Nibbles {
+	return extendPath(nil, nyb...)
+}
+
+// appendNibbles appends the length prefixed serialization of nyb to buf.
+func appendNibbles(buf []byte, nyb nibbles.Nibbles) []byte {
+	s := nibbles.Serialize(nyb)
+	buf = binary.AppendUvarint(buf, uint64(len(s)))
+	return append(buf, s...)
+}
+
+// readNibbles reads a length prefixed nibble serialization from data, and
+// returns the nibbles and the remaining data.
+func readNibbles(data []byte) (nibbles.Nibbles, []byte, error) {
+	length, lengthLen := binary.Uvarint(data)
+	if lengthLen <= 0 || length > uint64(len(data)-lengthLen) {
+		return nil, nil, ErrNodeDecodingFailure
+	}
+	data = data[lengthLen:]
+	nyb, err := nibbles.Deserialize(data[:length])
+	if err != nil {
+		return nil, nil, ErrNodeDecodingFailure
+	}
+	return nyb, data[length:], nil
+}
+
+// readHash reads a node hash from data. A zero hash stands for no node.
+func readHash(data []byte) (node, []byte, error) {
+	if len(data) < crypto.DigestSize {
+		return nil, nil, ErrNodeDecodingFailure
+	}
+	var h crypto.Digest
+	copy(h[:], data[:crypto.DigestSize])
+	if h.IsZero() {
+		return nil, data[crypto.DigestSize:], nil
+	}
+	return &backNode{h: h}, data[crypto.DigestSize:], nil
+}
+
+// deserializeNode decodes a node from its encoding. The children of the
+// returned node are back nodes.
+func deserializeNode(data []byte) (node, error) {
+	if len(data) == 0 {
+		return nil, ErrNodeDecodingFailure
+	}
+	switch data[0] {
+	case leafNodeType:
+		return deserializeLeafNode(data[1:])
+	case extensionNodeType:
+		return deserializeExtensionNode(data[1:])
+	case branchNodeType:
+		return deserializeBranchNode(data[1:])
+	default:
+		return nil, ErrNodeDecodingFailure
+	}
+}
@@ -0,0 +1,103 @@
+// Copyright (C) 2019-2024 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package statetrie
+
+import (
+	"errors"
+
+	"github.com/Quarkonium-chain/go-quarkonium/crypto"
+	"github.com/Quarkonium-chain/go-quarkonium/crypto/statetrie/nibbles"
+)
+
+// ErrProofHashMismatch is returned when a proof node doesn't hash to the value referenced by its parent, or by the root.
+var ErrProofHashMismatch = errors.New("proof node hash mismatch")
+
+// ErrProofIncomplete is returned when a proof ends before reaching the node which decides whether the key is included.
+var ErrProofIncomplete = errors.New("proof is incomplete")
+
+// ErrProofTrailingNodes is returned when a proof contains nodes past the node which decides whether the key is included.
+var ErrProofTrailingNodes = errors.New("proof has trailing nodes")
+
+// Proof is the list of node encodings on the path from the root of a trie
+// towards a key. The last node either holds the key, which proves its
+// inclusion, or shows that the path to the key doesn't exist, which proves
+// its non-inclusion.
+type Proof struct {
+	Nodes [][]byte
+}
+
+// VerifyProof checks the proof for key against the given root hash. It
+// returns the value of the key and true if the proof shows the key is
+// included, or false if the proof shows it isn't. An error is returned if the
+// proof doesn't match the root. A nil proof has no nodes.
+func VerifyProof(root crypto.Digest, key []byte, proof *Proof) ([]byte, bool, error) {
+	var nodes [][]byte
+	if proof != nil {
+		nodes = proof.Nodes
+	}
+	if root.IsZero() {
+		// an empty trie contains no key.
+		if len(nodes) != 0 {
+			return nil, false, ErrProofTrailingNodes
+		}
+		return nil, false, nil
+	}
+
+	remainingKey := nibbles.MakeNibbles(key, false)
+	expected := root
+	for i, data := range nodes {
+		if hashNode(data) != expected {
+			return nil, false, ErrProofHashMismatch
+		}
+		n, err := deserializeNode(data)
+		if err != nil {
+			return nil, false, err
+		}
+
+		var next node
+		var value []byte
+		var found bool
+		switch nd := n.(type) {
+		case *leafNode:
+			if nibbles.Equal(nd.keyEnd, remainingKey) {
+				value, found = nd.value, true
+			}
+		case *extensionNode:
+			if nibbles.HasPrefix(remainingKey, nd.sharedKey) {
+				next = nd.next
+				remainingKey = remainingKey[len(nd.sharedKey):]
+			}
+		case *branchNode:
+			if len(remainingKey) == 0 {
+				value, found = nd.value, nd.hasValue
+			} else {
+				next = nd.children[remainingKey[0]]
+				remainingKey = remainingKey[1:]
+			}
+		}
+
+		if next == nil {
+			// this node decides whether the key is included.
+			if i != len(nodes)-1 {
+				return nil, false, ErrProofTrailingNodes
+			}
+			return value, found, nil
+		}
+		expected = next.hash()
+	}
+	return nil, false, ErrProofIncomplete
+}
@@ -0,0 +1,238 @@
+// Copyright (C) 2019-2024 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package statetrie
+
+import (
+	"errors"
+
+	"github.com/Quarkonium-chain/go-quarkonium/crypto"
+	"github.com/Quarkonium-chain/go-quarkonium/crypto/statetrie/nibbles"
+)
+
+// ErrUnableToEvictPendingCommits is returned if the trie was modified and Evict was called before Commit
+var ErrUnableToEvictPendingCommits = errors.New("unable to evict as pending commits available")
+
+// ErrNoBatchInProgress is returned by a backing store when a modification is attempted outside of a batch.
+var ErrNoBatchInProgress = errors.New("no batch in progress")
+
+// Trie is a Merkle-Patricia trie mapping arbitrary byte keys to values. Keys
+// are split into nibbles, which are consumed by branch, extension and leaf
+// nodes on the path from the root. Modifications are kept in memory until
+// Commit writes them to the backing store in a single batch.
+type Trie struct {
+	root  node
+	store BackingStore
+	// vacated holds the store keys of nodes removed since the last commit.
+	vacated map[string]struct{}
+}
+
+// MakeTrie creates a trie over the given backing store, loading its root if
+// the store already contains one. A nil store creates an in-memory trie.
+func MakeTrie(store BackingStore) (*Trie, error) {
+	if store == nil {
+		store = MakeMemoryBackingStore()
+	}
+	mt := &Trie{
+		store:   store,
+		vacated: make(map[string]struct{}),
+	}
+	data, err := store.Get(storeKey(nil))
+	if err != nil {
+		return nil, err
+	}
+	if data != nil {
+		mt.root, err = mt.loadNode(data, hashNode(data))
+		if err != nil {
+			return nil, err
+		}
+	}
+	return mt, nil
+}
+
+// Add sets the value of the given key, replacing any existing value.
+func (mt *Trie) Add(key []byte, value []byte) error {
+	k := nibbles.MakeNibbles(key, false)
+	if mt.root == nil {
+		mt.root = makeLeafNode(k, value)
+		return nil
+	}
+	root, err := mt.resolve(mt.root, nil)
+	if err != nil {
+		return err
+	}
+	root, err = root.add(mt, nil, k, value)
+	if err != nil {
+		return err
+	}
+	mt.root = root
+	return nil
+}
+
+// Delete removes the given key from the trie.
+// returns false if no such key exists.
+func (mt *Trie) Delete(key []byte) (bool, error) {
+	if mt.root == nil {
+		return false, nil
+	}
+	root, err := mt.resolve(mt.root, nil)
+	if err != nil {
+		return false, err
+	}
+	mt.root = root
+	root, found, err := root.delete(mt, nil, nibbles.MakeNibbles(key, false))
+	if err != nil || !found {
+		return false, err
+	}
+	mt.root = root
+	return true, nil
+}
+
+// Get returns the value of the given key, and whether the key exists.
+func (mt *Trie) Get(key []byte) ([]byte, bool, error) {
+	if mt.root == nil {
+		return nil, false, nil
+	}
+	root, err := mt.resolve(mt.root, nil)
+	if err != nil {
+		return nil, false, err
+	}
+	mt.root = root
+	return root.get(mt, nil, nibbles.MakeNibbles(key, false))
+}
+
+// RootHash returns the hash of the root node, or an empty digest if the trie
+// is empty. Pending modifications are included even if they weren't committed.
+func (mt *Trie) RootHash() crypto.Digest {
+	if mt.root == nil {
+		return crypto.Digest{}
+	}
+	return mt.root.hash()
+}
+
+// Commit writes every node modified since the last commit to the backing
+// store, and removes the nodes that were deleted, in a single batch. If the
+// commit fails, the batch is discarded, the modifications are kept pending,
+// and a later Commit writes them all again.
+func (mt *Trie) Commit() error {
+	c := commitSet{writes: make(map[string][]byte)}
+	if mt.root != nil {
+		mt.root.commit(nil, &c)
+	}
+	if len(c.writes) == 0 && len(mt.vacated) == 0 {
+		return nil
+	}
+
+	mt.store.BatchStart()
+	if err := mt.writeBatch(c.writes); err != nil {
+		// none of the batch is applied, so that the stored trie stays consistent with its root
+		mt.store.BatchAbort()
+		return err
+	}
+	if err := mt.store.BatchEnd(); err != nil {
+		return err
+	}
+	for _, ns := range c.written {
+		ns.dirty = false
+	}
+	mt.vacated = make(map[string]struct{})
+	return nil
+}
+
+// writeBatch deletes the vacated nodes that weren't rewritten, and sets the written ones.
+func (mt *Trie) writeBatch(writes map[string][]byte) error {
+	for key := range mt.vacated {
+		if _, rewritten := writes[key]; rewritten {
+			continue
+		}
+		if err := mt.store.Delete([]byte(key)); err != nil {
+			return err
+		}
+	}
+	for key, data := range writes {
+		if err := mt.store.Set([]byte(key), data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Evict releases the in-memory nodes, which would be reloaded from the
+// backing store on demand.
+func (mt *Trie) Evict() error {
+	if len(mt.vacated) != 0 || (mt.root != nil && mt.root.isDirty()) {
+		return ErrUnableToEvictPendingCommits
+	}
+	if mt.root == nil {
+		return nil
+	}
+	mt.root = &backNode{h: mt.root.hash()}
+	return nil
+}
+
+// Prove returns a proof of the inclusion, or the non-inclusion, of the given
+// key. The proof is checked against the root hash by VerifyProof.
+func (mt *Trie) Prove(key []byte) (*Proof, error) {
+	proof := &Proof{}
+	if mt.root == nil {
+		return proof, nil
+	}
+	root, err := mt.resolve(mt.root, nil)
+	if err != nil {
+		return nil, err
+	}
+	mt.root = root
+	err = root.prove(mt, nil, nibbles.MakeNibbles(key, false), proof)
+	if err != nil {
+		return nil, err
+	}
+	return proof, nil
+}
+
+// resolve loads n from the backing store if it is a back node located at pathKey.
+func (mt *Trie) resolve(n node, pathKey nibbles.Nibbles) (node, error) {
+	bn, ok := n.(*backNode)
+	if !ok {
+		return n, nil
+	}
+	data, err := mt.store.Get(storeKey(pathKey))
+	if err != nil {
+		return nil, err
+	}
+	if data == nil {
+		return nil, ErrNodeMissing
+	}
+	return mt.loadNode(data, bn.h)
+}
+
+// loadNode decodes a persisted node, and verifies it has the expected hash.
+func (mt *Trie) loadNode(data []byte, expected crypto.Digest) (node, error) {
+	if hashNode(data) != expected {
+		return nil, ErrNodeHashMismatch
+	}
+	n, err := deserializeNode(data)
+	if err != nil {
+		return nil, err
+	}
+	// every decoded node kind embeds nodeState
+	n.(interface{ setHash(crypto.Digest) }).setHash(expected)
+	return n, nil
+}
+
+// vacate records that the node located at pathKey was removed.
+func (mt *Trie) vacate(pathKey nibbles.Nibbles) {
+	mt.vacated[string(storeKey(pathKey))] = struct{}{}
+}
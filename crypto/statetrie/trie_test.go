@@ -0,0 +1,511 @@
+// Copyright (C) 2019-2024 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package statetrie
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Quarkonium-chain/go-quarkonium/crypto"
+	"github.com/Quarkonium-chain/go-quarkonium/crypto/merkletrie"
+	"github.com/Quarkonium-chain/go-quarkonium/test/partitiontest"
+)
+
+func makeHashes(n int) [][]byte {
+	hashes := make([][]byte, n)
+	for i := 0; i < len(hashes); i++ {
+		h := crypto.Hash([]byte{byte(i % 256), byte((i / 256) % 256), byte(i / 65536)})
+		hashes[i] = h[:]
+	}
+	return hashes
+}
+
+func TestAddingAndRemoving(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	mt, err := MakeTrie(nil)
+	require.NoError(t, err)
+	hashes := makeHashes(10000)
+
+	rootsWhileAdding := make([]crypto.Digest, len(hashes))
+	for i := 0; i < len(hashes); i++ {
+		require.NoError(t, mt.Add(hashes[i], hashes[i][:8]))
+		rootsWhileAdding[i] = mt.RootHash()
+	}
+	allHashesAddedRoot := mt.RootHash()
+
+	for i := 0; i < len(hashes); i++ {
+		value, found, err := mt.Get(hashes[i])
+		require.NoError(t, err)
+		require.True(t, found)
+		require.Equal(t, hashes[i][:8], value)
+	}
+
+	for i := len(hashes) - 1; i >= 0; i-- {
+		require.Equalf(t, rootsWhileAdding[i], mt.RootHash(), "i=%d", i)
+		deleted, err := mt.Delete(hashes[i])
+		require.NoError(t, err)
+		require.Truef(t, deleted, "number %d", i)
+	}
+	require.Equal(t, crypto.Digest{}, mt.RootHash())
+
+	// add the items in a different order.
+	hashesOrder := rand.New(rand.NewSource(1234567)).Perm(len(hashes))
+	for i := 0; i < len(hashes); i++ {
+		require.NoError(t, mt.Add(hashes[hashesOrder[i]], hashes[hashesOrder[i]][:8]))
+	}
+	require.Equal(t, allHashesAddedRoot, mt.RootHash())
+}
+
+func TestRandomAddingAndRemoving(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	store := MakeMemoryBackingStore()
+	mt, err := MakeTrie(store)
+	require.NoError(t, err)
+
+	rnd := rand.New(rand.NewSource(42))
+	hashes := makeHashes(2000)
+	expected := make(map[string][]byte)
+	for i := 0; i < 20000; i++ {
+		key := hashes[rnd.Intn(len(hashes))]
+		if rnd.Intn(3) == 0 {
+			deleted, err := mt.Delete(key)
+			require.NoError(t, err)
+			_, exists := expected[string(key)]
+			require.Equal(t, exists, deleted)
+			delete(expected, string(key))
+		} else {
+			value := make([]byte, rnd.Intn(40))
+			rnd.Read(value)
+			require.NoError(t, mt.Add(key, value))
+			expected[string(key)] = value
+		}
+
+		switch rnd.Intn(500) {
+		case 0:
+			require.NoError(t, mt.Commit())
+		case 1:
+			require.NoError(t, mt.Commit())
+			require.NoError(t, mt.Evict())
+		case 2:
+			// reload the trie from the backing store.
+			require.NoError(t, mt.Commit())
+			root := mt.RootHash()
+			mt, err = MakeTrie(store)
+			require.NoError(t, err)
+			require.Equal(t, root, mt.RootHash())
+		}
+	}
+
+	for _, key := range hashes {
+		value, found, err := mt.Get(key)
+		require.NoError(t, err)
+		expectedValue, exists := expected[string(key)]
+		require.Equal(t, exists, found)
+		if exists {
+			require.Equal(t, expectedValue, value)
+		}
+	}
+
+	// a trie built from scratch with the same content has the same root.
+	mt2, err := MakeTrie(nil)
+	require.NoError(t, err)
+	for k, v := range expected {
+		require.NoError(t, mt2.Add([]byte(k), v))
+	}
+	require.Equal(t, mt2.RootHash(), mt.RootHash())
+
+	// removing everything leaves the backing store empty.
+	for k := range expected {
+		deleted, err := mt.Delete([]byte(k))
+		require.NoError(t, err)
+		require.True(t, deleted)
+	}
+	require.NoError(t, mt.Commit())
+	require.Equal(t, crypto.Digest{}, mt.RootHash())
+	require.Empty(t, store.(*memoryBackingStore).store)
+}
+
+func TestVariableLengthKeys(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	mt, err := MakeTrie(nil)
+	require.NoError(t, err)
+
+	keys := [][]byte{
+		{},
+		{0x12},
+		{0x12, 0x34},
+		{0x12, 0x34, 0x56},
+		{0x12, 0x35},
+		{0x13},
+		{0x21, 0x34, 0x56},
+	}
+	for i, k := range keys {
+		require.NoError(t, mt.Add(k, []byte{byte(i)}))
+	}
+	for i, k := range keys {
+		value, found, err := mt.Get(k)
+		require.NoError(t, err)
+		require.True(t, found)
+		require.Equal(t, []byte{byte(i)}, value)
+
+		proof, err := mt.Prove(k)
+		require.NoError(t, err)
+		value, found, err = VerifyProof(mt.RootHash(), k, proof)
+		require.NoError(t, err)
+		require.True(t, found)
+		require.Equal(t, []byte{byte(i)}, value)
+	}
+
+	_, found, err := mt.Get([]byte{0x12, 0x34, 0x57})
+	require.NoError(t, err)
+	require.False(t, found)
+
+	// removing the keys in any order keeps the trie canonical.
+	for _, i := range rand.New(rand.NewSource(1)).Perm(len(keys)) {
+		deleted, err := mt.Delete(keys[i])
+		require.NoError(t, err)
+		require.True(t, deleted)
+		deleted, err = mt.Delete(keys[i])
+		require.NoError(t, err)
+		require.False(t, deleted)
+	}
+	require.Equal(t, crypto.Digest{}, mt.RootHash())
+}
+
+func TestCommitAndEvict(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	store := MakeMemoryBackingStore()
+	mt, err := MakeTrie(store)
+	require.NoError(t, err)
+	hashes := makeHashes(1000)
+	for _, h := range hashes {
+		require.NoError(t, mt.Add(h, h))
+	}
+	require.ErrorIs(t, mt.Evict(), ErrUnableToEvictPendingCommits)
+	require.NoError(t, mt.Commit())
+	root := mt.RootHash()
+	require.NoError(t, mt.Evict())
+	require.Equal(t, root, mt.RootHash())
+
+	value, found, err := mt.Get(hashes[10])
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, hashes[10], value)
+
+	_, err = mt.Delete(hashes[10])
+	require.NoError(t, err)
+	require.ErrorIs(t, mt.Evict(), ErrUnableToEvictPendingCommits)
+	require.NoError(t, mt.Commit())
+	require.NoError(t, mt.Evict())
+
+	// a corrupted node is detected when it's loaded.
+	for k, v := range store.(*memoryBackingStore).store {
+		if k == string(storeKey(nil)) {
+			continue
+		}
+		v[len(v)-1]++
+		break
+	}
+	var loadErr error
+	for _, h := range hashes {
+		if _, _, loadErr = mt.Get(h); loadErr != nil {
+			break
+		}
+	}
+	require.ErrorIs(t, loadErr, ErrNodeHashMismatch)
+}
+
+// failingBackingStore fails its Set calls once failAfter of them succeeded, and tracks whether a batch is open.
+type failingBackingStore struct {
+	BackingStore
+	failAfter int
+	inBatch   bool
+}
+
+var errStoreFailure = errors.New("store failure")
+
+func (fs *failingBackingStore) BatchStart() {
+	fs.inBatch = true
+	fs.BackingStore.BatchStart()
+}
+
+func (fs *failingBackingStore) Set(key []byte, content []byte) error {
+	if fs.failAfter == 0 {
+		return errStoreFailure
+	}
+	fs.failAfter--
+	return fs.BackingStore.Set(key, content)
+}
+
+func (fs *failingBackingStore) BatchEnd() error {
+	fs.inBatch = false
+	return fs.BackingStore.BatchEnd()
+}
+
+func (fs *failingBackingStore) BatchAbort() {
+	fs.inBatch = false
+	fs.BackingStore.BatchAbort()
+}
+
+// storedContent returns a copy of the content of the in-memory store under fs.
+func (fs *failingBackingStore) storedContent() map[string][]byte {
+	stored := make(map[string][]byte)
+	for k, v := range fs.BackingStore.(*memoryBackingStore).store {
+		stored[k] = append([]byte{}, v...)
+	}
+	return stored
+}
+
+func TestCommitFailure(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	store := &failingBackingStore{BackingStore: MakeMemoryBackingStore(), failAfter: -1}
+	mt, err := MakeTrie(store)
+	require.NoError(t, err)
+	hashes := makeHashes(100)
+	for _, h := range hashes[:50] {
+		require.NoError(t, mt.Add(h, h))
+	}
+	require.NoError(t, mt.Commit())
+	committed := store.storedContent()
+
+	for _, h := range hashes[50:] {
+		require.NoError(t, mt.Add(h, h))
+	}
+	deleted, err := mt.Delete(hashes[0])
+	require.NoError(t, err)
+	require.True(t, deleted)
+	require.NoError(t, mt.Add(hashes[0], hashes[0]))
+	root := mt.RootHash()
+
+	// the batch is discarded, the store is left unchanged, and the modifications are still pending
+	store.failAfter = 10
+	require.ErrorIs(t, mt.Commit(), errStoreFailure)
+	require.False(t, store.inBatch)
+	require.Equal(t, committed, store.storedContent())
+	require.ErrorIs(t, mt.Evict(), ErrUnableToEvictPendingCommits)
+
+	store.failAfter = -1
+	require.NoError(t, mt.Commit())
+	require.NoError(t, mt.Evict())
+	reloaded, err := MakeTrie(store)
+	require.NoError(t, err)
+	require.Equal(t, root, reloaded.RootHash())
+	for _, h := range hashes {
+		value, found, err := reloaded.Get(h)
+		require.NoError(t, err)
+		require.True(t, found)
+		require.Equal(t, h, value)
+	}
+}
+
+func TestProofs(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	mt, err := MakeTrie(nil)
+	require.NoError(t, err)
+
+	// an empty trie proves nothing is included.
+	proof, err := mt.Prove([]byte{1, 2, 3})
+	require.NoError(t, err)
+	_, found, err := VerifyProof(mt.RootHash(), []byte{1, 2, 3}, proof)
+	require.NoError(t, err)
+	require.False(t, found)
+	_, found, err = VerifyProof(mt.RootHash(), []byte{1, 2, 3}, nil)
+	require.NoError(t, err)
+	require.False(t, found)
+
+	hashes := makeHashes(3000)
+	for _, h := range hashes[:2000] {
+		require.NoError(t, mt.Add(h, h[:4]))
+	}
+	require.NoError(t, mt.Commit())
+	require.NoError(t, mt.Evict())
+	root := mt.RootHash()
+
+	for i, h := range hashes {
+		proof, err := mt.Prove(h)
+		require.NoError(t, err)
+		value, found, err := VerifyProof(root, h, proof)
+		require.NoError(t, err)
+		require.Equal(t, i < 2000, found)
+		if found {
+			require.Equal(t, h[:4], value)
+		}
+	}
+
+	proof, err = mt.Prove(hashes[0])
+	require.NoError(t, err)
+	require.Greater(t, len(proof.Nodes), 1)
+
+	// the proof doesn't hold against another root.
+	_, _, err = VerifyProof(crypto.Hash([]byte("other")), hashes[0], proof)
+	require.ErrorIs(t, err, ErrProofHashMismatch)
+
+	// nor for another key.
+	_, found, err = VerifyProof(root, hashes[1], proof)
+	require.False(t, found && err == nil)
+
+	// tampering with any node breaks the proof.
+	for i := range proof.Nodes {
+		tampered := &Proof{Nodes: append([][]byte{}, proof.Nodes...)}
+		tampered.Nodes[i] = append([]byte{}, proof.Nodes[i]...)
+		tampered.Nodes[i][len(tampered.Nodes[i])-1] ^= 0x01
+		_, _, err = VerifyProof(root, hashes[0], tampered)
+		require.ErrorIs(t, err, ErrProofHashMismatch)
+	}
+
+	truncated := &Proof{Nodes: proof.Nodes[:len(proof.Nodes)-1]}
+	_, _, err = VerifyProof(root, hashes[0], truncated)
+	require.ErrorIs(t, err, ErrProofIncomplete)
+
+	extended := &Proof{Nodes: append(append([][]byte{}, proof.Nodes...), proof.Nodes[0])}
+	_, _, err = VerifyProof(root, hashes[0], extended)
+	require.ErrorIs(t, err, ErrProofTrailingNodes)
+
+	// a nil proof proves nothing.
+	_, found, err = VerifyProof(root, hashes[0], nil)
+	require.ErrorIs(t, err, ErrProofIncomplete)
+	require.False(t, found)
+}
+
+func TestNodeSerialization(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	mt, err := MakeTrie(nil)
+	require.NoError(t, err)
+	for _, h := range makeHashes(100) {
+		require.NoError(t, mt.Add(h[:3], h))
+	}
+	require.NoError(t, mt.Add([]byte{}, []byte("root value")))
+
+	var check func(n node)
+	check = func(n node) {
+		data := n.serialize()
+		decoded, err := deserializeNode(data)
+		require.NoError(t, err)
+		require.Equal(t, data, decoded.serialize())
+		require.Equal(t, n.hash(), hashNode(data))
+		// nodes are hashed with their own domain separator
+		require.NotEqual(t, n.hash(), crypto.Hash(data))
+		switch nd := n.(type) {
+		case *extensionNode:
+			check(nd.next)
+		case *branchNode:
+			for _, child := range nd.children {
+				if child != nil {
+					check(child)
+				}
+			}
+		}
+	}
+	check(mt.root)
+
+	for _, data := range [][]byte{
+		nil,
+		{0x7f},
+		{leafNodeType},
+		{extensionNodeType, 0x02, 0x12, 0x03},
+		{branchNodeType, 0x00},
+	} {
+		_, err := deserializeNode(data)
+		require.ErrorIs(t, err, ErrNodeDecodingFailure)
+	}
+}
+
+func BenchmarkAdd(b *testing.B) {
+	b.ReportAllocs()
+
+	mt, _ := MakeTrie(nil)
+	hashes := makeHashes(b.N)
+
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		mt.Add(hashes[i], hashes[i])
+		if i%1000 == 999 {
+			mt.Commit()
+		}
+	}
+}
+
+func BenchmarkMerkleTrieAdd(b *testing.B) {
+	b.ReportAllocs()
+
+	mt, _ := merkletrie.MakeTrie(&merkletrie.InMemoryCommitter{}, merkletrie.MemoryConfig{
+		NodesCountPerPage:         512,
+		CachedNodesCount:          10000,
+		PageFillFactor:            0.90,
+		MaxChildrenPagesThreshold: 32,
+	})
+	hashes := makeHashes(b.N)
+
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		mt.Add(hashes[i])
+		if i%1000 == 999 {
+			mt.Commit()
+		}
+	}
+}
+
+func BenchmarkDelete(b *testing.B) {
+	b.ReportAllocs()
+
+	mt, _ := MakeTrie(nil)
+	hashes := makeHashes(b.N)
+	for i := 0; i < b.N; i++ {
+		mt.Add(hashes[i], hashes[i])
+	}
+
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		mt.Delete(hashes[i])
+		if i%1000 == 999 {
+			mt.Commit()
+		}
+	}
+}
+
+func BenchmarkProve(b *testing.B) {
+	b.ReportAllocs()
+
+	mt, _ := MakeTrie(nil)
+	hashes := makeHashes(100000)
+	for _, h := range hashes {
+		mt.Add(h, h)
+	}
+	mt.Commit()
+	root := mt.RootHash()
+
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		h := hashes[i%len(hashes)]
+		proof, _ := mt.Prove(h)
+		VerifyProof(root, h, proof)
+	}
+}
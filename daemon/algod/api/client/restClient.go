@@ -789,6 +789,24 @@ func (client RestClient) GetBlockTimestampOffset() (response model.GetBlockTimeS
 	return
 }
 
+// PeerReputation returns the reputation of the peers that misbehaved recently
+func (client RestClient) PeerReputation() (response model.PeerReputationResponse, err error) {
+	err = client.get(&response, "/v2/peers/reputation", nil)
+	return
+}
+
+// ClearPeerReputation forgets the reputation of a peer, lifting its ban
+func (client RestClient) ClearPeerReputation(peer string) (err error) {
+	err = client.delete(nil, fmt.Sprintf("/v2/peers/reputation/%s", url.PathEscape(peer)), nil, true)
+	return
+}
+
+// ReplayExporter makes the exporter export every round from round again
+func (client RestClient) ReplayExporter(round uint64) (err error) {
+	err = client.post(nil, fmt.Sprintf("/v2/exporter/replay/%d", round), nil, nil, true)
+	return
+}
+
 // BlockLogs returns all the logs in a block for a given round
 func (client RestClient) BlockLogs(round uint64) (response model.BlockLogsResponse, err error) {
 	err = client.get(&response, fmt.Sprintf("/v2/blocks/%d/logs", round), nil)
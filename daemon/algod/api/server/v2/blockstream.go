@@ -0,0 +1,251 @@
+// Copyright (C) 2019-2024 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package v2
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/algorand/go-codec/codec"
+	"github.com/labstack/echo/v4"
+
+	"github.com/Quarkonium-chain/go-quarkonium/data/basics"
+	"github.com/Quarkonium-chain/go-quarkonium/data/bookkeeping"
+	"github.com/Quarkonium-chain/go-quarkonium/ledger/ledgercore"
+	"github.com/Quarkonium-chain/go-quarkonium/protocol"
+)
+
+// BlockStreamBufferSize is the number of blocks buffered for each StreamBlocks
+// client. A client falling further behind is disconnected, so that it cannot
+// hold back the ledger block notifications.
+var BlockStreamBufferSize = 32
+
+// BlockStreamWriteTimeout is the time allowed for writing a single frame to a
+// StreamBlocks client.
+var BlockStreamWriteTimeout = 30 * time.Second
+
+var errBlockStreamOverflow = errors.New("client is too slow, block stream buffer is full")
+
+// BlockStreamFrame is a single frame of the StreamBlocks endpoint. A frame
+// either holds a block, and optionally its state delta, or an error ending the
+// stream; Round is then the round to resume the stream from.
+type BlockStreamFrame struct {
+	Round basics.Round           `codec:"round"`
+	Block *bookkeeping.Block     `codec:"block,omitempty"`
+	Delta *ledgercore.StateDelta `codec:"delta,omitempty"`
+	Error string                 `codec:"error,omitempty"`
+}
+
+// blockStreamListener is a ledgercore.BlockListener buffering new blocks for
+// a single stream. It never blocks the ledger notifier: once its buffer is
+// full it gives up and closes the frames channel.
+type blockStreamListener struct {
+	mu         sync.Mutex
+	frames     chan BlockStreamFrame
+	withDeltas bool
+	closed     bool
+	overflow   bool
+}
+
+func makeBlockStreamListener(withDeltas bool) *blockStreamListener {
+	return &blockStreamListener{
+		frames:     make(chan BlockStreamFrame, BlockStreamBufferSize),
+		withDeltas: withDeltas,
+	}
+}
+
+// OnNewBlock implements ledgercore.BlockListener.
+func (l *blockStreamListener) OnNewBlock(block bookkeeping.Block, delta ledgercore.StateDelta) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.closed {
+		return
+	}
+	frame := BlockStreamFrame{Round: block.Round(), Block: &block}
+	if l.withDeltas {
+		frame.Delta = &delta
+	}
+	select {
+	case l.frames <- frame:
+	default:
+		l.overflow = true
+		l.closed = true
+		close(l.frames)
+	}
+}
+
+// close stops buffering blocks. It's safe to call it along with OnNewBlock.
+func (l *blockStreamListener) close() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if !l.closed {
+		l.closed = true
+		close(l.frames)
+	}
+}
+
+func (l *blockStreamListener) overflowed() bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.overflow
+}
+
+// blockStreamWriter writes frames to a StreamBlocks response, either as
+// server-sent events for JSON or as consecutive objects for msgpack.
+type blockStreamWriter struct {
+	ctx    echo.Context
+	rc     *http.ResponseController
+	handle codec.Handle
+}
+
+func makeBlockStreamWriter(ctx echo.Context, handle codec.Handle, contentType string) *blockStreamWriter {
+	if handle == protocol.JSONStrictHandle {
+		contentType = "text/event-stream"
+	}
+	header := ctx.Response().Header()
+	header.Set(echo.HeaderContentType, contentType)
+	header.Set("Cache-Control", "no-cache")
+	ctx.Response().WriteHeader(http.StatusOK)
+	return &blockStreamWriter{
+		ctx:    ctx,
+		rc:     http.NewResponseController(ctx.Response().Writer),
+		handle: handle,
+	}
+}
+
+func (w *blockStreamWriter) write(frame BlockStreamFrame) error {
+	if frame.Delta != nil && w.handle == protocol.JSONStrictHandle {
+		// The delta is shared with other listeners, zero out the Txleases map
+		// of a copy since it cannot be represented in JSON.
+		delta := *frame.Delta
+		delta.Txleases = nil
+		frame.Delta = &delta
+	}
+	data, err := encode(w.handle, frame)
+	if err != nil {
+		return err
+	}
+	if w.handle == protocol.JSONStrictHandle {
+		event := "block"
+		if frame.Error != "" {
+			event = "error"
+		}
+		var buf bytes.Buffer
+		fmt.Fprintf(&buf, "id: %d\nevent: %s\n", frame.Round, event)
+		for _, line := range bytes.Split(data, []byte("\n")) {
+			buf.WriteString("data: ")
+			buf.Write(line)
+			buf.WriteByte('\n')
+		}
+		buf.WriteByte('\n')
+		data = buf.Bytes()
+	}
+
+	// the server write timeout applies to the whole response, extend it for
+	// every frame. Writers not supporting deadlines are left as is.
+	err = w.rc.SetWriteDeadline(time.Now().Add(BlockStreamWriteTimeout))
+	if err != nil && !errors.Is(err, http.ErrNotSupported) {
+		return err
+	}
+	_, err = w.ctx.Response().Write(data)
+	if err != nil {
+		return err
+	}
+	w.ctx.Response().Flush()
+	return nil
+}
+
+// streamBlocks writes the blocks from round next onward until the client
+// goes away, the server shuts down or the stream can't keep up.
+func (v2 *Handlers) streamBlocks(ctx echo.Context, w *blockStreamWriter, next basics.Round, withDeltas bool) error {
+	ledger := v2.Node.LedgerForAPI()
+
+	// writeFromLedger sends the blocks up to round last from the ledger.
+	writeFromLedger := func(last basics.Round) error {
+		for ; next <= last; next++ {
+			block, err := ledger.Block(next)
+			if err != nil {
+				return err
+			}
+			frame := BlockStreamFrame{Round: next, Block: &block}
+			if withDeltas {
+				delta, err := ledger.GetStateDeltaForRound(next)
+				if err != nil {
+					return fmt.Errorf(errFailedRetrievingStateDelta, err)
+				}
+				frame.Delta = &delta
+			}
+			if err := w.write(frame); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	// stop ends the stream with a frame holding the round to resume from.
+	stop := func(err error) error {
+		v2.Log.Infof("StreamBlocks: stopping at round %d: %v", next, err)
+		return w.write(BlockStreamFrame{Round: next, Error: err.Error()})
+	}
+
+	// catch up from the ledger before listening, as the ledger might be far
+	// ahead of next.
+	if err := writeFromLedger(ledger.Latest()); err != nil {
+		return stop(err)
+	}
+
+	listener := makeBlockStreamListener(withDeltas)
+	ledger.RegisterBlockListeners([]ledgercore.BlockListener{listener})
+	defer func() {
+		ledger.UnregisterBlockListener(listener)
+		listener.close()
+	}()
+
+	// blocks added before the listener got registered.
+	if err := writeFromLedger(ledger.Latest()); err != nil {
+		return stop(err)
+	}
+
+	for {
+		select {
+		case <-v2.Shutdown:
+			return stop(errors.New(errServiceShuttingDown))
+		case <-ctx.Request().Context().Done():
+			return nil
+		case frame, ok := <-listener.frames:
+			if !ok {
+				if listener.overflowed() {
+					return stop(errBlockStreamOverflow)
+				}
+				return nil
+			}
+			if frame.Round < next {
+				continue
+			}
+			if err := writeFromLedger(frame.Round - 1); err != nil {
+				return stop(err)
+			}
+			if err := w.write(frame); err != nil {
+				return err
+			}
+			next++
+		}
+	}
+}
@@ -0,0 +1,55 @@
+// Copyright (C) 2019-2024 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package v2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Quarkonium-chain/go-quarkonium/data/basics"
+	"github.com/Quarkonium-chain/go-quarkonium/data/bookkeeping"
+	"github.com/Quarkonium-chain/go-quarkonium/ledger/ledgercore"
+	"github.com/Quarkonium-chain/go-quarkonium/test/partitiontest"
+)
+
+func TestBlockStreamListenerOverflow(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	listener := makeBlockStreamListener(false)
+	for i := 0; i <= BlockStreamBufferSize; i++ {
+		var blk bookkeeping.Block
+		blk.BlockHeader.Round = basics.Round(i + 1)
+		// must never block, even once the buffer is full
+		listener.OnNewBlock(blk, ledgercore.StateDelta{})
+	}
+	require.True(t, listener.overflowed())
+
+	rnd := basics.Round(1)
+	for frame := range listener.frames {
+		require.Equal(t, rnd, frame.Round)
+		require.Equal(t, rnd, frame.Block.Round())
+		require.Nil(t, frame.Delta)
+		rnd++
+	}
+	require.Equal(t, basics.Round(BlockStreamBufferSize+1), rnd)
+
+	// closing after an overflow, or receiving more blocks, is harmless.
+	listener.OnNewBlock(bookkeeping.Block{}, ledgercore.StateDelta{})
+	listener.close()
+}
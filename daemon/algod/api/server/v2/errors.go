@@ -22,6 +22,8 @@ var (
 	errAccountAppDoesNotExist                  = "account application info not found"
 	errAccountAssetDoesNotExist                = "account asset info not found"
 	errBoxDoesNotExist                         = "box not found"
+	errBalancesTrieEntryDoesNotExist           = "no balances trie entry found"
+	errBalancesTrieUnavailable                 = "balances trie is unavailable, catchpoint tracking is disabled"
 	errFailedLookingUpLedger                   = "failed to retrieve information from the ledger"
 	errFailedLookingUpTransactionPool          = "failed to retrieve information from the transaction pool"
 	errFailedRetrievingStateDelta              = "failed retrieving State Delta: %v"
@@ -48,5 +50,8 @@ var (
 	errOperationNotAvailableDuringCatchup      = "operation not available during catchup"
 	errRESTPayloadZeroLength                   = "payload was of zero length"
 	errRoundGreaterThanTheLatest               = "given round is greater than the latest round"
+	errStateRoundNotAvailable                  = "the ledger state of the given round is not available"
 	errFailedRetrievingTracer                  = "failed retrieving the expected tracer from ledger"
+	errPeerReputationNotFound                  = "peer reputation not found"
+	errExporterNotEnabled                      = "the exporter was not enabled in the configuration file by setting EnableExporter to true"
 )
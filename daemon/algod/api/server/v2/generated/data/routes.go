@@ -114,227 +114,259 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+y9/5PbNrIg/q+g9F6VY3/EGdtx8jb+1Na7iZ1k5+IkLo+TvfdsXwKRLQk7FMAFwBkp",
-	"Pv/vV+gGSJAEJWpm4iRX+5M9Ir40Go1Gf0P3+1muNpWSIK2ZPX0/q7jmG7Cg8S+e56qWNhOF+6sAk2tR",
-	"WaHk7Gn4xozVQq5m85lwv1bcrmfzmeQbaNu4/vOZhn/WQkMxe2p1DfOZydew4W5gu6tc62akbbZSmR/i",
-	"jIY4fz77sOcDLwoNxgyh/EGWOyZkXtYFMKu5NDx3nwy7FnbN7FoY5jszIZmSwNSS2XWnMVsKKAtzEhb5",
-	"zxr0Llqln3x8SR9aEDOtShjC+UxtFkJCgAoaoJoNYVaxApbYaM0tczM4WENDq5gBrvM1Wyp9AFQCIoYX",
-	"ZL2ZPX0zMyAL0LhbOYgr/O9SA/wKmeV6BXb2bp5a3NKCzqzYJJZ27rGvwdSlNQzb4hpX4gokc71O2He1",
-	"sWwBjEv26utn7NNPP/3CLWTDrYXCE9noqtrZ4zVR99nTWcEthM9DWuPlSmkui6xp/+rrZzj/hV/g1Fbc",
-	"GEgfljP3hZ0/H1tA6JggISEtrHAfOtTveiQORfvzApZKw8Q9ocZ3uinx/L/rruTc5utKCWkT+8LwK6PP",
-	"SR4Wdd/HwxoAOu0rhyntBn3zMPvi3ftH80cPP/zbm7Psv/2fn336YeLynzXjHsBAsmFeaw0y32UrDRxP",
-	"y5rLIT5eeXowa1WXBVvzK9x8vkFW7/sy15dY5xUva0cnItfqrFwpw7gnowKWvC4tCxOzWpaOTbnRPLUz",
-	"YVil1ZUooJg77nu9Fvma5dzQENiOXYuydDRYGyjGaC29uj2H6UOMEgfXjfCBC/rjIqNd1wFMwBa5QZaX",
-	"ykBm1YHrKdw4XBYsvlDau8ocd1mx12tgOLn7QJct4k46mi7LHbO4rwXjhnEWrqY5E0u2UzW7xs0pxSX2",
-	"96txWNswhzTcnM496g7vGPoGyEggb6FUCVwi8sK5G6JMLsWq1mDY9Rrs2t95GkylpAGmFv+A3Lpt/58X",
-	"P3zPlGbfgTF8BS95fslA5qqA4oSdL5lUNiINT0uIQ9dzbB0ertQl/w+jHE1szKri+WX6Ri/FRiRW9R3f",
-	"ik29YbLeLEC7LQ1XiFVMg621HAOIRjxAihu+HU76Wtcyx/1vp+3Ico7ahKlKvkOEbfj2rw/nHhzDeFmy",
-	"CmQh5IrZrRyV49zch8HLtKplMUHMsW5Po4vVVJCLpYCCNaPsgcRPcwgeIY+DpxW+InDCIKPgNLMcAEfC",
-	"NkEz7nS7L6ziK4hI5oT96JkbfrXqEmRD6Gyxw0+VhiuhatN0GoERp94vgUtlIas0LEWCxi48OhyDoTae",
-	"A2+8DJQrabmQUDjmjEArC8SsRmGKJtyv7wxv8QU38PmTsTu+/Tpx95eqv+t7d3zSbmOjjI5k4up0X/2B",
-	"TUtWnf4T9MN4biNWGf082Eixeu1um6Uo8Sb6h9u/gIbaIBPoICLcTUasJLe1hqdv5QP3F8vYheWy4Lpw",
-	"v2zop+/q0ooLsXI/lfTTC7US+YVYjSCzgTWpcGG3Df3jxkuzY7tN6hUvlLqsq3hBeUdxXezY+fOxTaYx",
-	"jyXMs0bbjRWP19ugjBzbw26bjRwBchR3FXcNL2GnwUHL8yX+s10iPfGl/tX9U1Wl622rZQq1jo79lYzm",
-	"A29WOKuqUuTcIfGV/+y+OiYApEjwtsUpXqhP30cgVlpVoK2gQXlVZaXKeZkZyy2O9O8alrOns387be0v",
-	"p9TdnEaTv3C9LrCTE1lJDMp4VR0xxksn+pg9zMIxaPyEbILYHgpNQtImOlISjgWXcMWlPWlVlg4/aA7w",
-	"Gz9Ti2+SdgjfPRVsFOGMGi7AkARMDe8ZFqGeIVoZohUF0lWpFs0Pn5xVVYtB/H5WVYQPlB5BoGAGW2Gs",
-	"uY/L5+1Jiuc5f37CvonHRlFcyXLnLgcSNdzdsPS3lr/FGtuSX0M74j3DcDuVPnFbE9DgxPy7oDhUK9aq",
-	"dFLPQVpxjf/m28Zk5n6f1PnPQWIxbseJCxUtjznScfCXSLn5pEc5Q8Lx5p4TdtbvezOycaPsIRhz3mLx",
-	"rokHfxEWNuYgJUQQRdTkt4drzXczLyRmKOwNyeRHA0QhFV8JidDOnfok2YZf0n4oxLsjBDCNXkS0RBJk",
-	"Y0L1MqdH/cnAzvInoNbUxgZJ1EmqpTAW9WpszNZQouDMZSDomFRuRBkTNnzPIhqYrzWviJb9FxK7hER9",
-	"nhoRrLe8eCfeiUmYI3YfbTRCdWO2fJB1JiFBrtGD4ctS5Zd/42Z9Byd8EcYa0j5Ow9bAC9Bszc06cXB6",
-	"tN2ONoW+XUOkWbaIpjpplvhCrcwdLLFUx7CuqnrGy9JNPWRZvdXiwJMOclky15jBRqDB3CuOZGEn/Yt9",
-	"xfO1EwtYzsty3pqKVJWVcAWlU9qFlKDnzK65bQ8/jhz0GjxHBhyzs8Ci1XgzE5rYdGOL0MA2HG+gjdNm",
-	"qrLbp+Gghm+gJwXhjahqtCJEisb587A6uAKJPKkZGsFv1ojWmnjwEze3/4QzS0WLIwugDe67Bn8Nv+gA",
-	"7Vq396lsp1C6IJu1db8JzXKlaQi64f3k7j/AdduZqPOTSkPmh9D8CrThpVtdb1H3G/K9q9N54GQW3PLo",
-	"ZHoqTCtgxDmwH4p3oBNWmh/wP7xk7rOTYhwltdQjUBhRkTu1oIvZoYpmcg3Q3qrYhkyZrOL55VFQPmsn",
-	"T7OZSSfvK7Ke+i30i2h26PVWFOautgkHG9ur7gkh21VgRwNZZC/TieaagoDXqmLEPnogEKfA0Qghanvn",
-	"19qXapuC6Uu1HVxpagt3shNunMnM/ku1fe4hU/ow5nHsKUh3C5R8AwZvNxkzTjdL65c7Wyh9M2mid8FI",
-	"1nobGXejRsLUvIckbFpXmT+bCY8FNegN1AZ47BcC+sOnMNbBwoXlvwEWjBv1LrDQHeiusaA2lSjhDkh/",
-	"nRTiFtzAp4/Zxd/OPnv0+OfHn33uSLLSaqX5hi12Fgz7xJvlmLG7Eu4ntSOULtKjf/4k+Ki646bGMarW",
-	"OWx4NRyKfF+k/VIz5toNsdZFM666AXASRwR3tRHaGbl1HWjPYVGvLsBap+m+1Gp559xwMEMKOmz0stJO",
-	"sDBdP6GXlk4L1+QUtlbz0wpbgiwozsCtQxinA24Wd0JUYxtftLMUzGO0gIOH4thtaqfZxVuld7q+C/MG",
-	"aK108gqutLIqV2Xm5DyhEgaKl74F8y3CdlX93wlads0Nc3Oj97KWxYgdwm7l9PuLhn69lS1u9t5gtN7E",
-	"6vy8U/ali/xWC6lAZ3YrGVJnxzyy1GrDOCuwI8oa34Al+Uts4MLyTfXDcnk31k6FAyXsOGIDxs3EqIWT",
-	"fgzkSlIw3wGTjR91Cnr6iAleJjsOgMfIxU7m6Cq7i2M7bs3aCIl+e7OTeWTacjCWUKw6ZHl7E9YYOmiq",
-	"eyYBjkPHC/yMtvrnUFr+tdKvW/H1G63q6s7Zc3/OqcvhfjHeG1C4vsEMLOSq7AaQrhzsJ6k1/i4LetYY",
-	"EWgNCD1S5AuxWttIX3yp1W9wJyZnSQGKH8hYVLo+Q5PR96pwzMTW5g5EyXawlsM5uo35Gl+o2jLOpCoA",
-	"N782aSFzJOQQY50wRMvGcivaJ4RhC3DUlfParbauGAYgDe6LtmPGczqhGaLGjIRfNHEz1Iqmo3C2UgMv",
-	"dmwBIJla+BgHH32Bi+QYPWWDmOZF3AS/6MBVaZWDMVBk3hR9ELTQjq4OuwdPCDgC3MzCjGJLrm8N7OXV",
-	"QTgvYZdhrJ9hn3z7k7n/O8BrleXlAcRimxR6+/a0IdTTpt9HcP3JY7IjSx1RrRNvHYMowcIYCo/Cyej+",
-	"9SEa7OLt0XIFGkNKflOKD5PcjoAaUH9jer8ttHU1EsHu1XQn4bkNk1yqIFilBiu5sdkhtuwadWwJbgUR",
-	"J0xxYhx4RPB6wY2lMCghC7Rp0nWC85AQ5qYYB3hUDXEj/xQ0kOHYubsHpalNo46YuqqUtlCk1oAe2dG5",
-	"vodtM5daRmM3Oo9VrDZwaOQxLEXje2R5DRj/4Lbxv3qP7nBx6FN39/wuicoOEC0i9gFyEVpF2I2jeEcA",
-	"EaZFNBGOMD3KaUKH5zNjVVU5bmGzWjb9xtB0Qa3P7I9t2yFxkZOD7u1CgUEHim/vIb8mzFL89pob5uEI",
-	"LnY051C81hBmdxgzI2QO2T7KRxXPtYqPwMFDWlcrzQvICij5LhEcQJ8Zfd43AO54q+4qCxkF4qY3vaXk",
-	"EPe4Z2iF45mU8MjwC8vdEXSqQEsgvveBkQvAsVPMydPRvWYonCu5RWE8XDZtdWJEvA2vlHU77ukBQfYc",
-	"fQrAI3hohr45KrBz1uqe/Sn+C4yfoJEjjp9kB2ZsCe34Ry1gxBbs3zhF56XH3nscOMk2R9nYAT4ydmRH",
-	"DNMvubYiFxXqOt/C7s5Vv/4EScc5K8ByUULBog+kBlZxf0YhpP0xb6YKTrK9DcEfGN8SywlhOl3gL2GH",
-	"OvdLepsQmTruQpdNjOruJy4ZAhoinp0IHjeBLc9tuXOCml3Djl2DBmbqBYUwDP0pVlVZPEDSP7NnRu+d",
-	"TfpG97qLL3CoaHmpWDPSCfbD97qnGHTQ4XWBSqlygoVsgIwkBJNiR1il3K4L//wpPIAJlNQB0jNtdM03",
-	"1/8900EzroD9l6pZziWqXLWFRqZRGgUFFCDdDE4Ea+b0wYkthqCEDZAmiV8ePOgv/MEDv+fCsCVchzeD",
-	"rmEfHQ8eoB3npTK2c7juwB7qjtt54vpAx5W7+LwW0ucphyOe/MhTdvJlb/DG2+XOlDGecN3yb80Aeidz",
-	"O2XtMY1Mi/bCcSf5crrxQYN1475fiE1dcnsXXiu44mWmrkBrUcBBTu4nFkp+dcXLH5pu+B4SckejOWQ5",
-	"vuKbOBa8dn3o4Z8bR0jhDjAF/U8FCM6p1wV1OqBitpGqYrOBQnAL5Y5VGnKg925OcjTNUk8YRcLnay5X",
-	"qDBoVa98cCuNgwy/NmSa0bUcDJEUquxWZmjkTl0APkwtPHl04hRwp9L1LeSkwFzzZj7/ynXKzRztQd9j",
-	"kHSSzWejGq9D6lWr8RJyuu82J1wGHXkvwk878URXCqLOyT5DfMXb4g6T29zfxmTfDp2CcjhxFPHbfhwL",
-	"+nXqdrm7A6GHBmIaKg0Gr6jYTGXoq1rGb7RDqODOWNgMLfnU9eeR4/dqVF9UshQSso2SsEumJRESvsOP",
-	"yeOE1+RIZxRYxvr2dZAO/D2wuvNMocbb4hd3u39C+x4r87XSd+USpQEni/cTPJAH3e1+ypv6SXlZJlyL",
-	"/gVnnwGYeROsKzTjxqhcoMx2Xpi5jwomb6R/7tlF/8vmXcodnL3+uD0fWpwcAG3EUFaMs7wUaEFW0lhd",
-	"5/at5GijipaaCOIKyvi41fJZaJI2kyasmH6ot5JjAF9juUoGbCwhYab5GiAYL029WoGxPV1nCfBW+lZC",
-	"sloKi3Nt3HHJ6LxUoDGS6oRabviOLR1NWMV+Ba3YorZd6R8fKBsrytI79Nw0TC3fSm5ZCdxY9p2Qr7c4",
-	"XHD6hyMrwV4rfdlgIX27r0CCESZLB5t9Q18xrt8vf+1j/DHcnT6HoNM2Y8LMLbOTJOV/f/KfT9+cZf/N",
-	"s18fZl/8f6fv3j/5cP/B4MfHH/761//T/enTD3+9/5//ntqpAHvq+ayH/Py514zPn6P6E4Xq92H/aPb/",
-	"jZBZksjiaI4ebbFPMFWEJ6D7XeOYXcNbabfSEdIVL0XheMtNyKF/wwzOIp2OHtV0NqJnDAtrPVKpuAWX",
-	"YQkm02ONN5aihvGZ6Yfq6JT0b8/xvCxrSVsZpG96hxniy9Ry3iQjoDxlTxm+VF/zEOTp/3z82eezefvC",
-	"vPk+m8/813cJShbFNpVHoIBtSleMH0ncM6ziOwM2zT0Q9mQoHcV2xMNuYLMAbdai+vicwlixSHO48GTJ",
-	"25y28lxSgL87P+ji3HnPiVp+fLitBiigsutU/qKOoIat2t0E6IWdVFpdgZwzcQInfZtP4fRFH9RXAl+G",
-	"wFSt1BRtqDkHRGiBKiKsxwuZZFhJ0U/veYO//M2dq0N+4BRc/TlTEb33vvnqNTv1DNPco5QWNHSUhCCh",
-	"SvvHk52AJMfN4jdlb+Vb+RyWaH1Q8ulbWXDLTxfciNyc1gb0l7zkMoeTlWJPw3vM59zyt3IgaY0mVowe",
-	"TbOqXpQiZ5exQtKSJyXLGo7w9u0bXq7U27fvBrEZQ/XBT5XkLzRB5gRhVdvMp/rJNFxznfJ9mSbVC45M",
-	"ubz2zUpCtqrJQBpSCfnx0zyPV5Xpp3wYLr+qSrf8iAyNT2jgtowZq5r3aE5A8U963f5+r/zFoPl1sKvU",
-	"Bgz7ZcOrN0Ladyx7Wz98+Cm+7GtzIPzir3xHk7sKJltXRlNS9I0quHBSKzFWPav4KuVie/v2jQVe4e6j",
-	"vLxBG0dZMuzWeXUYHhjgUO0CmifOoxtAcBz9OBgXd0G9QlrH9BLwE25h9wH2rfYrej9/4+068Aaf13ad",
-	"ubOdXJVxJB52psn2tnJCVojGMGKF2qpPjLcAlq8hv/QZy2BT2d280z0E/HhBM7AOYSiXHb0wxGxK6KBY",
-	"AKurgntRnMtdP62NoRcVOOgruITda9UmYzomj003rYoZO6hIqZF06Yg1PrZ+jP7m+6iy8NDUZyfBx5uB",
-	"LJ42dBH6jB9kEnnv4BCniKKT9mMMEVwnEEHEP4KCGyzUjXcr0k8tT8gcpBVXkEEpVmKRSsP796E/LMDq",
-	"qNJnHvRRyM2Ahoklc6r8gi5Wr95rLlfgrmd3pSrDS8qqmgzaQH1oDVzbBXC7184v44QUATpUKa/x5TVa",
-	"+OZuCbB1+y0sWuwkXDutAg1F1MZHL5+Mx58R4FDcEJ7QvdUUTkZ1XY+6RMbBcCs32G3UWh+aF9MZwkXf",
-	"N4ApS9W12xcHhfLZNimpS3S/1IavYER3ib13E/NhdDx+OMghiSQpg6hlX9QYSAJJkKlx5tacPMPgvrhD",
-	"jGpmLyAzzEQOYu8zwiTaHmGLEgXYJnKV9p7rjheVsgKPgZZmLaBlKwoGMLoYiY/jmptwHDFfauCyk6Sz",
-	"3zDty77UdOdRLGGUFLVJPBduwz4HHej9PkFdyEoXUtHFSv+EtHJO98LnC6ntUBJF0wJKWNHCqXEglDZh",
-	"UrtBDo4flkvkLVkqLDEyUEcCgJ8DnObygDHyjbDJI6TIOAIbAx9wYPa9is+mXB0DpPQJn3gYG6+I6G9I",
-	"P+yjQH0njKrKXa5ixN+YBw7gU1G0kkUvohqHYULOmWNzV7x0bM7r4u0ggwxpqFD08qH50Jv7Y4rGHtcU",
-	"XflHrYmEhJusJpZmA9BpUXsPxAu1zeiFclIXWWwXjt6TbxfwvXTqYFIuunuGLdQWw7nwaqFY+QOwjMMR",
-	"wIhsL1thkF6x35icRcDsm3a/nJuiQoMk4w2tDbmMCXpTph6RLcfI5ZMovdyNAOiZodpaDd4scdB80BVP",
-	"hpd5e6vN27Sp4VlY6viPHaHkLo3gb2gf6yaE+1ub+G88uVg4UR8lE97QsnSbDIXUuaKsg8ckKOyTQweI",
-	"PVh92ZcDk2jtxnp18RphLcVKHPMdOiWHaDNQAirBWUc0zS5TkQJOlwe8xy9Ct8hYh7vH5e5+FECoYSWM",
-	"hdZpFOKCfg9zPMf0yUotx1dnK71063ulVHP5k9scO3aW+dFXgBH4S6GNzdDjllyCa/S1QSPS165pWgLt",
-	"hihSsQFRpDkuTnsJu6wQZZ2mVz/vt8/dtN83F42pF3iLCUkBWgssjpEMXN4zNcW2713wC1rwC35n6512",
-	"GlxTN7F25NKd409yLnoMbB87SBBgijiGuzaK0j0MMnpwPuSOkTQaxbSc7PM2DA5TEcY+GKUWnr2P3fw0",
-	"UnItURrA9AtBtVpBEdKbBX+YjJLIlUquoipOVbUvZ94Jo9R1mHluT9I6H4YPY0H4kbifCVnANg19rBUg",
-	"5O3LOky4h5OsQFK6krRZKImaOMQfW0S2uo/sC+0/AEgGQb/uObPb6GTapWY7cQNK4IXXSQyE9e0/lsMN",
-	"8aibj4VPdzKf7j9COCDSlLBRYZNhGoIRBsyrShTbnuOJRh01gvGjrMsj0hayFj/YAQx0g6CTBNdJpe1D",
-	"rb2B/RR13lOnlVHstQ8sdvTNc/8Av6g1ejA6kc3DvO2NrjZx7d/+dGGV5ivwXqiMQLrVELicY9AQZUU3",
-	"zAoKJynEcgmx98XcxHPQAW5gYy8mkG6CyNIumlpI+/mTFBkdoJ4WxsMoS1NMghbGfPKvh16uINNHpqTm",
-	"Soi25gauquRz/W9hl/3Ey9opGUKbNjzXu526l+8Ru361+RZ2OPLBqFcH2IFdQcvTK0AaTFn6m08mSmB9",
-	"z3RS/KN62dnCI3bqLL1Ld7Q1vijDOPG3t0ynaEF3Kbc5GG2QhINlym5cpGMT3OmBLuL7pHxoE0RxWAaJ",
-	"5P14KmFCCcvhVdTkojhEu6+Bl4F4cTmzD/PZ7SIBUreZH/EArl82F2gSzxhpSp7hTmDPkSjnVaXVFS8z",
-	"Hy8xdvlrdeUvf2wewis+siaTpuzXX529eOnB/zCf5SVwnTWWgNFVYbvqT7MqKuOw/yqhbN/e0EmWomjz",
-	"m4zMcYzFNWb27hmbBkVR2viZ6Cj6mItlOuD9IO/zoT60xD0hP1A1ET+tz5MCfrpBPvyKizI4GwO0I8Hp",
-	"uLhplXWSXCEe4NbBQlHMV3an7GZwutOno6WuAzwJ5/oBU1OmNQ7pE1ciK/LBP/zOpaevle4wf/8yMRk8",
-	"9NuJVU7IJjyOxGqH+pV9YeqEkeD1y+oXdxofPIiP2oMHc/ZL6T9EAOLvC/876hcPHiS9h0kzlmMSaKWS",
-	"fAP3m1cWoxvxcRVwCdfTLuizq00jWapxMmwolKKAArqvPfautfD4LPwvBZTgfjqZoqTHm07ojoGZcoIu",
-	"xl4iNkGmGyqZaZiS/ZhqfATrSAuZvS/JQM7Y4RGS9QYdmJkpRZ4O7ZAL49irpGBK15hh4xFrrRuxFiOx",
-	"ubIW0Viu2ZScqT0gozmSyDTJtK0t7hbKH+9ain/WwEThtJqlAI33Wu+qC8oBjjoQSNN2MT8w+ana4W9j",
-	"B9njbwq2oH1GkL3+u+eNTyksNFX058gI8HjGAePeE73t6cNTM71mW3dDMKfpMVNKpwdG5511I3MkS6EL",
-	"ky21+hXSjhD0HyUSYQTHp0Az768gU5F7fZbSOJXbiu7t7Ie2e7puPLbxt9aFw6KbqmM3uUzTp/q4jbyJ",
-	"0mvS6Zo9kseUsDjCoPs0YIS14PGKgmGxDEqIPuKSzhNlgei8MEufyvgt5ymN355KD/Pg/WvJrxc8VSPG",
-	"6UIOpmh7O3FSVrHQOWyAaXIc0OwsiuBu2grKJFeBbn0Qw6y0N9RraNrJGk2rwCBFxarLnMIUSqMSw9Ty",
-	"mkuqIu76Eb/yvQ2QC971ulYa80CadEhXAbnYJM2xb9++KfJh+E4hVoIKZNcGogrMfiBGySaRinwV6yZz",
-	"h0fN+ZI9nEdl4P1uFOJKGLEoAVs8ohYLbvC6bNzhTRe3PJB2bbD54wnN17UsNBR2bQixRrFG90QhrwlM",
-	"XIC9BpDsIbZ79AX7BEMyjbiC+w6LXgiaPX30BQbU0B8PU7esL3C+j2UXyLNDsHaajjEmlcZwTNKPmo6+",
-	"XmqAX2H8dthzmqjrlLOELf2FcvgsbbjkK0i/z9gcgIn64m6iO7+HF0neADBWqx0TNj0/WO7408ibb8f+",
-	"CAyWq81G2I0P3DNq4+ipLa9Mk4bhqNa/rxcV4AofMf61CuF/PVvXR1Zj+GbkzRZGKX+PPtoYrXPGKfln",
-	"KdrI9FCvk52H3MJYQKupm0W4cXO5paMsiYHqS1ZpIS3aP2q7zP7i1GLNc8f+TsbAzRafP0kUourWapHH",
-	"Af7R8a7BgL5Ko16PkH2QWXxf9olUMts4jlLcb3MsRKdyNFA3HZI5Fhe6f+ipkq8bJRslt7pDbjzi1Lci",
-	"PLlnwFuSYrOeo+jx6JV9dMqsdZo8eO126MdXL7yUsVE6VTCgPe5e4tBgtYArfDGX3iQ35i33QpeTduE2",
-	"0P++8U9B5IzEsnCWk4pA5NHc91jeSfE/fddmPkfHKr1E7NkAlU5YO73d7iNHGx5ndev7bylgDL+NYG4y",
-	"2nCUIVZGou8pvL7p83vEC/VBoj3vGBwf/cK008FRjn/wAIF+8GDuxeBfHnc/E3t/8CCdgDhpcnO/tli4",
-	"jUaMfVN7+KVKGMBC1cImoMjnR0gYIMcuKffBMcGFH2rOuhXiPr4UcTfvu9LRpulT8PbtG/wS8IB/9BHx",
-	"OzNL3MD2lcL4Ye9WyEySTNF8j+LcOftSbacSTu8OCsTzB0DRCEommudwJYMKoEl3/cF4kYhG3agLKJVT",
-	"MuOiQLE9/8+DZ7f4+R5s16Isfmpzu/UuEs1lvk5GCS9cx59JRu9cwcQqk3VG1lxKKJPDkW77c9CBE1r6",
-	"P9TUeTZCTmzbr0BLy+0trgW8C2YAKkzo0Cts6SaIsdpNm9WkZShXqmA4T1vUomWOw1LOqRKaiffNOOym",
-	"tj5uFd+C+4RDS1FiGGbab4wtM83tSAItrHce6gu5cbD8uCEzA40OmnGxwYvZ8E1VAp7MK9B8hV2VhF53",
-	"TKGGI0cVK5ip3CdsiQkrFLO1lkwtl9EyQFqhodzNWcWNoUEeumXBFueePX308GHS7IXYmbBSwmJY5g/t",
-	"Uh6dYhP64ossUSmAo4A9DOuHlqKO2dgh4fiakv+swdgUT8UP9HIVvaTu1qZ6kk3t0xP2DWY+ckTcSXWP",
-	"5sqQRLibULOuSsWLOSY3fv3V2QtGs1IfKiFP9SxXaK3rkn/SvTI9wWjI7DSSOWf6OPtTebhVG5s15SdT",
-	"uQldi7ZApujF3KAdL8bOCXtOJtSmgD9NwjBFtt5AEVW7JCUeicP9x1qer9E22ZGAxnnl9EKsgZ21npvo",
-	"9WFT/QgZtoPb12KlUqxzpuwa9LUwgC/y4Qq66RCb3KDeNh7SI3aXp2spiVJOjhBGm1pHx6I9AEeSbAgq",
-	"SELWQ/yRlimqx3xsXdoL7JV+i9Erctvz+ofkeiHFNvvOOxdyLpUUOZZCSEnSmLptmptyQtWItH/RzPwJ",
-	"TRyuZGnd5i2wx+Josd3ACD3ihi7/6KvbVKIO+tPC1pdcW4E1nrNBMQ+Vrr1DTEgDvpqVI6KYTyqdCGpK",
-	"PoRoAiiOJCPMyjRi4fzaffve278xKcalkGjp8mjz+hm5rEoj0DMtmbBspcD49XRf85g3rs8JZmksYPvu",
-	"5IVaifxCrHAMCqNzy6aY0eFQZyGC1EdsurbPXFufO7/5uRMORpOeVZWfdLwOelKQtFs5iuBU3FIIJImQ",
-	"24wfj7aH3PaGfuN96ggNrjBqDSq8hweE0dTS7o7yldMtiaKwBaMXlckEukImwHghZHChpi+IPHkl4Mbg",
-	"eR3pZ3LNLekOk3jaa+DlyAMIfKFMPvjbDtWvHOBQgmsMc4xvY1sGfIRxNA1aiZ/LHQuHwlF3JEw842UT",
-	"Op0o6o1SlReiCnxc1CvznWIcjnFn4clkB10Hn+813bEax7E30ViOwkVdrMBmvChSqa2+xK8Mv4ZHYrCF",
-	"vG6KUDWvA7s5yofU5ifKlTT1Zs9cocEtp4vq5ieoIa7dH3YYM+0sdvhvqgLT+M74oOmjX+WGCOniuMT8",
-	"w1fGKanX0XRmxCqbjgm8U26PjnbqmxF62/9OKT081/1DvMbtcbl4j1L87St3ccSJewfx6XS1NHl1MRZc",
-	"4feQ8KjJCNnlSniVDeqMYdQDbl5iy3rAh4ZJwK94OfISPvaV0P1K/oOx9/D5aPoGbn16LsvZXhY0mvKI",
-	"YoV73pehC3EsPpjCg+/Oa+HXuheh4767bzueOooRa5nFqIfuZk60doOP9aJ9ezWWIiHU6cDvcT0QH8Uz",
-	"92ng4UqoOkRfhRjooBLSrz4FT6fux8j6ky8Lfm+vxaiP5bWvX0vL9Dr5tz+RF5aBtHr3B/C4DDa9X1Qm",
-	"Ie2SeaptwprSh5NKIXZuxSk1bFLlUrxsGGxlxFo6tDQoPzMgq+dTxIEBPj7MZ+fFURdmquTOjEZJHbsX",
-	"YrW2mLH/b8AL0C8PVCRoqxDgEauUEW0F0tIN5lPArnG4k6mPDRwBi7iiwnCsEIR6BbnFsrNtcJ0GOKa+",
-	"gpssOH3+VZlgXJ1u3mT4ggT7qhAMa80euOMHiZOi5F9Up/Nkes79syaEml6AXXPTpmvpvZme/HJzuYQc",
-	"syLvTVT19zXIKAnSPNhlEJZllLdKNO+YMK/38VbHFqB9eaT2whPV17k1OGPv2C9hd8+wDjUkC4c2j/hu",
-	"kjgYMUAusJBDesyQ7KPGhGkoA7EQQoJ9Kua2OMZozuco7doN5wok6S6ONhXbninTRc8nzeW6HpX2EZ/k",
-	"jOWyGtZMHtc/nmOJauMD5HiTeDjW0tn5sHDOtU9cjGnFGt9JSGEMJvwWcgjSLKW49PUDECvkqbrmuggt",
-	"7iQpFN1NIg30splZtA84hkEOiVIM+BYqL5UTI7KxB2XdNxNNwOE9Q5GhbQIfhGsJWkPRuERKZSCzKjz4",
-	"2AfHPlRQ+OuNkGBGyx8RcKOpr1+1ub2xDBzHVNfcR73GC2QaNtxBp6MM3ONz7kP2M/oeHuGHMmAHLUwN",
-	"vR6uRxue7ggzQGJM9Uvmb8vDj/tvYmwSUoLOguepn45bdjOyYd7Nos7pgo4PRmOQm5w7Zw8rSdpp8uEq",
-	"ezpC9Ej+EnanpASFQr5hB2OgSXIi0KOEo71NvlPzm0nBvboT8H7fPHKVUmU24uw4H+YQ71P8pcgvAXMA",
-	"NiHuIzXa2SdoY2+82dfrXciZXVUgobh/wtiZpEdFwbHdLS/Ym1zes/vm3+KsRU1p/b1R7eStTL/OwIT7",
-	"+pbcLAyzn4cZcKzullPRIAcyVG/lWMjNNSbn71bxPJmqlQ9dzf0q8i1RERQpmeSCPFbP8KCnDEeYAiHK",
-	"1YGOTM68p4uZUqVieW+SpsENlcZUPBkCZEFOyRbQQOEHTyIgWRc9cQop9Z1PeqeWTEPrRL5p9r9hCfeU",
-	"Rt+fuZmly++WSkOnGLvrTZk+m4cvmEYT/7MQVnO9u0mOvkEJ+YH1ZBTLB8OxmkisdiFtNNYQh2WprjNk",
-	"VllT5yKl2rp2pnsZh6JrbT93qhcQxXVx4wW1HVvzguVKa8jjHun3ngTVRmnISoVhXikP9NI6uXuDj7wk",
-	"K9WKqSpXBVC9mDQFjc1VS8lRbIIoqiaJAqIdfC1MfSI6njilu1PJj5ShqLU6onZ+DvRyvc3qRIvOyJc5",
-	"ErEMxmdx8hiixkN499T+T/Pmpdgi3YBOHfkls7qGOfMt+jWy/cHnGthGGEOgNLR0LcoSH46LbeR5bQIX",
-	"0qgdEXvPMazySmDsTTeJAEnDlbvzmswKMQ+4iNMeMbvWql6towTTDZxB5dW1V4jjUX40NYZH4QsyN8UT",
-	"tlHGek2TRmqX3IacfZIrabUqy65RikT0lbe0f8e3Z3luXyh1ueD55X3Ua6WyzUqLeXhf3Q8ObGfSvdRi",
-	"3Qs4o3Lmh1P1UjsMlfNEO5lB9ljc0YXdIzDfHeagh23uZ8OF9dfVZaZpNeZMMm7VRuTpM/XnirYbjZFL",
-	"sahkzjKqrUhZJrAZHvb4smqCK5BFDtEMkieLw50xzwi8kxnZjfsvSuD9cdkSPKMZuSiHzMVLUVk+Kuv1",
-	"AEBI6emzrTUVZIwlsYarqBWlSkAXeR/QibcKRiLdDjY3wp0DZeFWQA2iHxsAPyHjw5xyy1Ek5UJtw/f7",
-	"bfK5GwH/YT+Vd5jHWIjXRUtamoK8QqKaEY6QTnG9Nx7qNT57X0yNimqK50684SMAxuOkOjBMipY6Fowl",
-	"FyUUWar24nljo5pHmrZ/mtUviS6M5+Q5r0PpQzd2rcEnTiERX3f9XxV3pKSa5kNLsixgC/Su41fQimoa",
-	"ziP/C5RU8rBnDFBVVsIVdMLHfDaXGkVNcQWhr2k6swKgQm9k30aWiouK7/Ke4cSvPYsia6ZgN2lJIcTS",
-	"TrEDZpKkUWcrMzomZupRchBdiaLmHfyZY0WOrhnQHeUEqgY6Qhb0yKnT/EgjvAoDnIX+KVEmYOLdND50",
-	"NAtKo24fAzoYJ1mbsVMv02GScaqixsGCsxWNI5ZIvOUbpuLXctwgOST5Vt2auE9CyQixX20hR6nG6ztQ",
-	"eI1nxEnhs54gtUuAgrQC1yVhbV+DZFJFJSavuWlUlTaHYviBJsZGQnpt+gZO5Taa8fY7y3AwZnrJ1EYV",
-	"Cd3Q6c3N87/LSdx7EEfHS9GIAf/8b4/9K1C3VzuwAZbylm4/neyPRRr9Lea5+Jwt6jBQWaprqhkZ66HP",
-	"IfhBifqCC8iL5aK5lkPU5tyn9+ybOkQUr77hO6Y0/uO0zn/WvBTLHfIZAj90Y2bNHQl5xytFBPgoUDfx",
-	"fvFqHgAL1hYVpqJ1i6ljRsPt3CgR0O4iD8V9FNvwS4i3AYMdiH/m1jFOUy/QcuGu7N52DrHgFx9StGx4",
-	"EWv6mCiyW0Y9pA52vf//9i1cPFXI71aVPA8VQn2Joi6fwSrAgbjsGjb7H0sO+VoggaaycEu0OryuL25g",
-	"Mj2SdaVeIIyVX+mAPai4Oqg8c6tlTLT89mps7HlmOmkpd70LU6NuBkDHdRoPgR+Xrfw4+E/mcB1bxhTw",
-	"/yh4HylUG8NLNWk/ApY7GTgSsJK1eqG2mYalORRgQuZqp87rNndHMLEKmWvghiJuzn/wimebolRIpwhT",
-	"TGjj02xGKWApZMsshaxqm9BjMFOp3EUIi43+iNYRF9qYlOCEySte/nAFWotibOPc6aCSjnGJiODo8H0T",
-	"JozmTh0OIEyrw+H7zNaMHjdzFzgVoaJwTWO5LLgu4uZCshy0u/fZNd+Zm3uUGufAIZ8Sj6SZbtaAyLuE",
-	"pE2AlDvvFL6lv6cBkN+h42eCwwbjghPOGjLtWDXinxnC8Kdw2Gz4NivVCl8RjhwIn5sWPXykAiqJZnCS",
-	"z6atO8xjxK+wfxpMy+8ZkVU465Qp9p/7H3ArUY38UQq79+STjbL/rJPibulgBqTKVRv8T8QyPI+pl7g+",
-	"+Ur8GjcIm+GpSqA9iDYRRvxDXbv4yC5iGIR/xh0bwaeXO+tGWqTe+5JlIEOLgdkT3g+mDWXnuQ/PGprS",
-	"BqYGQsrcv5Y+0tJG9vlwL42AR7Xp/VnvTtuEzLhxjqkRt/99dFapKsunxHxS5Y7Cuwk8pF0YR+gjcgKM",
-	"rLsJjzFNLZtO3qNOUZtjy+SNFtU55O2q8n1K/5iZaISjd10Qaom8jCq3o3ULX/I0xpR5/41Z1wzWMAnG",
-	"mYa81mgmvua7w2XHRjJGX/zt7LNHj39+/NnnzDVghViBabOO98p2tXGBQvbtPh83EnCwPJvehJB9gBAX",
-	"/I/hUVWzKf6sEbc1bUrRQdGyY+zLiQsgcRwT5aJutFc4Thva/8fartQi73zHUij47fdMq7JMV31o5KqE",
-	"AyW1W5ELxWkgFWgjjHWMsOsBFbaNiDZrNA9i7t8ryiajZA7BfuypQNiRkKvUQsYCapGf4dtu7zVisK1K",
-	"z6vI07NvXV5PIwsdCo0YFbMAVqnKi/ZiyVIQ4QsiHb2s9YZPtIhHMbINs6Vo2RQh+sjzNOnFBbP3c/tu",
-	"MVeb5vRuExPiRTiUNyDNMf/EeN6Cm3CS1rT/h+EfiUQMd8Y1muX+FrwiqR/crCj/JNCGj/IT5IEAjLy2",
-	"7byTjB6KRYmINXkJ0J8QHMh98eO71rF88FkIQhI6HAAvfj7btmteMnhwfueMvt81SImW8m6MEjrLP/Qi",
-	"N7De5iKJtsgbTawFQ2xJDcXC6Lm1eda8Yh7RSgaPnbVSljnNtCwTj6TJjoNnKiYcpxLoK15+fK7xtdDG",
-	"niE+oHg1/jQqfikbI5lQaW6Wp+8FnzR39Cr27qaWL/Fh9t/B7VHynvNDeSf84DZD4w5WrF+FW4HeerNr",
-	"HJOCrB59zha+2EalIRem79y/DsJJ8zAUtFj6gFbY2gMvUQ+t8ydlb0HGyxCJw76P3FuNz95D2B7R35mp",
-	"jJzcJJWnqG9AFgn8pXhUXJz3wHVxy8IMN0v7EiVwOzLty7Ds8NTlUWoTd+nUBobrnHxbd3CbuKjbtU3N",
-	"WTS5vsPbt2/sYkqqoXQtBtcdcx3dSVGGo0oy/AZZjghHfgw/b4pifhrLe0u5XUdyc/f2oxblwYCVTqb1",
-	"D/PZCiQYYTCX+M++dszHvUsDBJR5YXhUCdbbpIshxCTW2pk8mirKoT4hfbrvlsh5ja8a81oLu8O6wcGA",
-	"Jn5O5mP6psnt4XPDNL40f/dZdQlN7fY2E0htwu36jeIl3kfk4pPuFlLlCfuKMnz7g/LXe4v/gE//8qR4",
-	"+Omj/1j85eFnD3N48tkXDx/yL57wR198+gge/+WzJw/h0fLzLxaPi8dPHi+ePH7y+Wdf5J8+ebR48vkX",
-	"/3HP8SEHMgEaUvs/nf2v7Kxcqezs5Xn22gHb4oRX4ltwe4O68lJhXUuH1BxPImy4KGdPw0//I5ywk1xt",
-	"2uHDrzNfn2m2trYyT09Pr6+vT+Iupyt8+p9ZVefr0zAPVhvsyCsvz5sYfYrDwR1trce4qZ4UzvDbq68u",
-	"XrOzl+cnLcHMns4enjw8eeRLW0teidnT2af4E56eNe77KebXPDU+df5p81brw3zwraoosb775GnU/7UG",
-	"XmKCHffHBqwWefikgRc7/39zzVcr0Cf4eoN+unp8GqSR0/c+c8KHfd9O48iQ0/edBBPFgZ4h8uFQk9P3",
-	"oXTu/gE7ZVN9zFnUYSKg+5qdLrBcztSmEK9ufCmoxpjT9yiIj/5+6q0p6Y+oENFJOw2JWkZa0pP89McO",
-	"Ct/brVvI/uFcm2i8nNt8XVen7/E/eGiiFVGGz1O7lafoQD5930GE/zxARPf3tnvc4mqjCgjAqeWS6g3v",
-	"+3z6nv6NJoJtBVo4aRSz6vhfKfvZKZad2w1/3knv7iwhlbPmR2mAtOVQcWAn8/bpW8NHzovQ+GIn8yA2",
-	"h5hI5A6PHz6k6Z/gf2a+LFMvs8upP88z09Sh32u06eTURN7bs9c18NIDP7AnM4Th0ceD4VxSHKRjxnRp",
-	"fJjPPvuYWDiXTr7hJcOWNP2nH3ETQF+JHNhr2FRKcy3KHftRNqGcUZHcFAVeSnUtA+RO4qg3G653KMlv",
-	"1BUY5uvvRsTJNDjZicI9MASgpWG88rjjI29mVb0oRT6bUwbVdyit2ZTgEoxIw5mCAa0dvHsqvjl4Jqbv",
-	"Qlce3pOyZhKcB5IZ0PBDYX64v2Hv+y5YmupeaoNm/2IE/2IEd8gIbK3l6BGN7i/MuwaVf+Ka83wN+/jB",
-	"8LaMLvhZpVKJJS72MAtf3WSMV1x0eUUbajh7+mZa8T/v9SCDdgHGHeaToMw4Sb3VNXTDkcKZR59rtNf7",
-	"6pp/ePeHuN+fcRnOc2fHya3JdSlAN1TA5bDgzL+4wP8zXIAqZ3Ha1zmzUJYmPvtW4dknD5BPpynJMzeR",
-	"D3Syn7bCdOfn02C3SOmg3ZbvO3929Sqzrm2hrqNZ0OJP7qqhluE+1qb/9+k1FzZbKu2TbvKlBT3sbIGX",
-	"p77CTu/XNqn94Atm6o9+jJ+TJn895V7dSH1DXjfWcaAPp756lW+kUYiCDp9bq1tsxUI+29iv3rxzXA6L",
-	"sHsW3Bplnp6e4rOYtTL2dPZh/r5nsIk/vmsIK9QOnVVaXGGNg3fz2TZTWqyE5GXmrRptmbDZ45OHsw//",
-	"NwAA//+q4vIh1ggBAA==",
+	"H4sIAAAAAAAC/+y9/XPcNrIo+q+g5pwqx34zku04ORu/2jpPsfOhFydxWUrOPTfO3cWQPTNYcQAuAEoz",
+	"8fX/fqsbAAmSIIcjKXZya3+yNcRHo9FoNPrz3SxT21JJkNbMnr+blVzzLVjQ9BfPMlVJuxA5/pWDybQo",
+	"rVBy9jx8Y8ZqIdez+UzgryW3m9l8JvkWZs/j/vOZhn9WQkM+e251BfOZyTaw5Tiw3ZfYuh5pt1irhR/i",
+	"zA1x/nL2fuQDz3MNxvSh/FEWeyZkVlQ5MKu5NDzDT4bdCLthdiMM852ZkExJYGrF7KbVmK0EFLk5CYv8",
+	"ZwV6H63STz68pPcNiAutCujD+UJtl0JCgApqoOoNYVaxHFbUaMMtwxkQ1tDQKmaA62zDVkofANUBEcML",
+	"strOnv8yMyBz0LRbGYhr+u9KA/wGC8v1Guzs13lqcSsLemHFNrG0c499DaYqrGHUlta4FtcgGfY6Yd9X",
+	"xrIlMC7Zm69fsE8//fQLXMiWWwu5J7LBVTWzx2ty3WfPZzm3ED73aY0Xa6W5zBd1+zdfv6D5L/wCp7bi",
+	"xkD6sJzhF3b+cmgBoWOChIS0sKZ9aFE/9kgciubnJayUhol74hrf66bE83/UXcm4zTalEtIm9oXRV+Y+",
+	"J3lY1H2Mh9UAtNqXiCmNg/7yePHFr++ezJ88fv9vv5wt/qf/87NP309c/ot63AMYSDbMKq1BZvvFWgOn",
+	"07Lhso+PN54ezEZVRc42/Jo2n2+J1fu+DPs61nnNiwrpRGRanRVrZRj3ZJTDileFZWFiVskCjKHRPLUz",
+	"YVip1bXIIZ8zIdnNRmQblnHjhqB27EYUBdJgZSAforX06kYO0/sYJQjXrfBBC/rjIqNZ1wFMwI64wSIr",
+	"lIGFVQeup3DjcJmz+EJp7ipz3GXFLjfAaHL84C5bwp1Emi6KPbO0rznjhnEWrqY5Eyu2VxW7oc0pxBX1",
+	"96tBrG0ZIo02p3WP4uEdQl8PGQnkLZUqgEtCXjh3fZTJlVhXGgy72YDd+DtPgymVNMDU8h+QWdz2///i",
+	"xx+Y0ux7MIav4TXPrhjITOWQn7DzFZPKRqThaYlwiD2H1uHhSl3y/zAKaWJr1iXPrtI3eiG2IrGq7/lO",
+	"bKstk9V2CRq3NFwhVjENttJyCCA34gFS3PJdf9JLXcmM9r+ZtiXLIbUJUxZ8Twjb8t1fH889OIbxomAl",
+	"yFzINbM7OSjH4dyHwVtoVcl8gphjcU+ji9WUkImVgJzVo4xA4qc5BI+Qx8HTCF8ROEIeAEfIaeBI2CVo",
+	"Bk83fmElX0NEMifsJ8/c6KtVVyBrQmfLPX0qNVwLVZm60wCMNPW4BC6VhUWpYSUSNHbh0WEYZ66N58Bb",
+	"LwNlSlouJORMSAe0suCY1SBM0YTj753+Lb7kBj5/Nnt/6OvE3V+p7q6P7vik3aZGC3ckE1cnfvUHNi1Z",
+	"tfpPeB/GcxuxXrifexsp1pd426xEQTfRP3D/AhoqQ0yghYhwNxmxltxWGp6/lY/wL7ZgF5bLnOscf9m6",
+	"n76vCisuxBp/KtxPr9RaZBdiPYDMGtbkg4u6bd0/OF6aHdtd8l3xSqmrqowXlLUerss9O385tMluzGMJ",
+	"86x+7cYPj8tdeIwc28Pu6o0cAHIQdyXHhlew14DQ8mxF/+xWRE98pX/Df8qywN62XKVQi3Tsr2RSH3i1",
+	"wllZFiLjiMQ3/jN+RSYA7iHBmxandKE+fxeBWGpVgrbCDcrLclGojBcLY7mlkf5dw2r2fPZvp43+5dR1",
+	"N6fR5K+w1wV1QpHViUELXpZHjPEaRR8zwiyQQdMnYhOO7ZHQJKTbRCQlgSy4gGsu7clsnjqTzQH+xc/U",
+	"4NtJOw7fnSfYIMKZa7gE4yRg1/CBYRHqGaGVEVpJIF0Xaln/8MlZWTYYpO9nZenwQdIjCBLMYCeMNQ9p",
+	"+bw5SfE85y9P2Dfx2CSKK1QvLcGLGng3rPyt5W+xWrfk19CM+MAw2k5U1ryf12gwBux9UBw9KzaqQKnn",
+	"IK1g429925jM8PdJnf8cJBbjdpi4sBXzmHNvHPoletx80qGcPuF4dc8JO+v2vR3Z4CgjBGPOGyzeN/HQ",
+	"L8LC1hykhAiiiJr89nCt+X7mhcQFCXt9MvnJgKOQkq+FJGjn+HySbMuv3H4owjsSApj6XeRoiQZtVKhe",
+	"5vSoP+npWf4E1Jra2CCJGsZZIYyldzU1ZhsoSHDmMhB0TCq3oowJGz6yiBrmG81LR8v+ixO7hKT3vGvk",
+	"YL3jxTvxTkzC3HyON5qgujVbPsg6k5Dghy4MX/KCywzMpRbwWiu1uoeTvixUdrXYcLNJHwL8Egwi1JZt",
+	"gOf+Be4YFr7nwlOieZ/sLbTUn//rk/98jmpPvvjt8eKL/+f013fP3j981Pvx6fu//vV/t3/69P1fH/7n",
+	"v6cOb6a2W2G3IJPal/CN9D10npWdR9prUhdx1qhrWcGXUDzvrmvOHvzbgzldBPilkiXP6X3KDXz61Clq",
+	"kDN5jFx8e7b47MnT06effd7CXqZIheF4Gv7YoH7uYbPK8oLUaiSblBoMSDt38saCQFpcg8Ybhkahzifs",
+	"RSGQqljGJdOAZFZZYMKylVZbnNv4Z6pxq8g2kF1F0zO+5kIaG21yend7G1AAX6XpBtkySLb0JMusFsCg",
+	"ANyQD08o9OxMwimVXOAqmFQ5mBpjtCGNIVAAy9WNDJRUcg2y/ozdcUmTrsjeGf5B5ZC6JBGAoXtJ2fa5",
+	"jJH84ZF78Ar1YDb2Ym6hDzgThi0rUVimrkGnLtT5bPQgHGZgbmbqzuLuLOeWM277Jx97Nwqfht8EeS1T",
+	"0oA0FanurcpUkTg6wfxs8EwK2eM45sPvmeM0aYx5rXCPsYX9C1wqgS5uBjH2odfYkb6ie651b3geNg+a",
+	"KTp281r95fE0RWqj04y4ot02nsk3ghiqo42qdAakFla7GkcNOXROMt75CPe33Gzu667/NnlSvoyvdrpV",
+	"pmGURpuCnW/9MeQtKaJZ4iu1NvewxEId81wpyxe8KHDqPgfurJYGniS8FwXDxgy2gsQMISOrurtK2Vc8",
+	"2zBelizjRTFvzEOqXBRwDQVSiJASLVx2w20j8NPIQZdJsrMBfOBYYNFqvGmJzGq6tj9oYFtOr84tajDL",
+	"ot2nfjUZvoWO5oPEBlWR5SBSLp6/DKuDa38h1kMT+PUaTbg6w+An7Kz+RDNL5RbnrH428Mwaf/UboQU0",
+	"tm7e0LKZQunc2amJSwnNMqXdEO5V7yfH/wDXTWdHnZ+UGhZ+CM2vQRteuKPdWtTDmnzv63QeOJl4T0Un",
+	"01NhWunqOAf1I1kZdEKe+JH+wwuGn919CBH1CFJAqMiFKnePcUSVmwkbkI1Vsa0zXzK8PY6C8kUzeZrN",
+	"TDp5XzmLaZBg3SLqHbrcidzc1zbRYEN71T4hpiUa9i/iMaYTzTUFAZeqZI59dEBwnIJGcwhRu3t/yn6p",
+	"dimYvqR7rv2MVTu4l51QO/efaYK32r30kCl9GPM09hSk4wIl34IJt32sYphHvjhnS6Vvp0HoXDAtAZLj",
+	"qA1znM07SKKmVbnwZzPxTnYNOgM1Tp3jQkB3+BTGWli4sPx3wIKxPAL+DlhoD3TfWFDbUhRwD6Sffu54",
+	"hcTFt2efPXn6N9Q/KFQiqLXmW4Yit2GfeFMcM3ZfwMPkc46ki/Tonz8LfintcVPjOFl3y8v+UM7fxb/J",
+	"qBnDdn2stdHspXcP4CSOCHi1ObQz58qFoL2EZbW+AGtRu/1a31KRNsZtejOkoKNGr0uNgoVp+wZ5aek0",
+	"xyansLOan5bUEmRONE/rEIYbA9vlvRDV0MbnzSw58xjN4eChOHabmmn28Vbpva7uw6QBWiudvILDyx01",
+	"CkaohFHitW/BfIuwXWX3dwctu+GG4dzksVTJfMD2gK5Ik+8vN/TlTja4Gb3B3HoTq/PzTtmXNvKbV0gJ",
+	"emF3khF1tkwipD/jLKeOJGt8A9bJX2ILF5Zvyx9Xq/uxcCoaKKHDEFswOBNzLZiQzECmpHPgP2Cm8aNO",
+	"QU8XMcGzxA4D4DFysZcZucfcx7EdVr9thSRfPbOXWWTOckrLfA16Aj6mm62G0OGmemAS4CA6XtFnss+/",
+	"hMLyr5W+bMTXb7Sqyntnz905py6H+8V4TWKOfYPpV8h10Q4aWSPsJ6k1fpQFvaiVCG4NBD1R5Cux3tjo",
+	"vXh749IojKlZRjVpnBXYp68yQqU5LrYy9yBKNoM1HA7pNuZrfKkqyzhZCWjzK5MWMgfCDMi/mdyybSy3",
+	"kn4C9d6A1JXxCldblYycjvvmrrrjgmfuhC4INQOK3MZX1rVy0zkX9kIDz1EZBJKppfdr9B6XtEhOHtO1",
+	"kcOLuEmtfARXqVUGxqDriNN6HgQttGtML0N4IsAJ4HoWZhRbcX1nYK+uD8J5BfsF+fcb9sl3P5uHHwFe",
+	"UkYfQCy1SaG3q0/rQz1t+jGC604ek53T1DmqZVaRVF6AhQFgjsPJ4P51Iert4t3R4gxJvzPFh0nuRkA1",
+	"qL8zvd8V2qociFrzz3SU8HDDJJcqCFapwQpu7OIQW8ZG8VoMriDihEm7Nw48IHi94sa+8Wa/nHSa7jqh",
+	"eagPTTEM8OAzBEf+ObxA+mM3tkg/AjNVWSptIU+tgbywBuf6AXb1XGqVsnNaxSoDh0YewlI0vkeWfwHT",
+	"H9zWPlfei6u/OPKjw3t+n0RlC4gGEWOAXIRWEXbjyJ0BQIRpEO0IR5gO5dThQvOZsaoskVvYRSXrfkNo",
+	"unCtz+xPTds+cXlTLM7JcgWGDCi+vYf8xmHWxWxtuGEejuBWR+oc56PdhxkP48IImcFijPLpiYet4iNw",
+	"8JBW5VrzHBY5FHyfcAh0n5n7PDYA7Xjz3FUWFi74Jr3pDSXXxt7hoRWNl2CaPyhGX1iGRxCfAg2B+N4H",
+	"Rs6Bxk4xJ09HD+qhaK7kFoXxaNluqxMj0m14rVArFeiBQPYcfQrAA3ioh749Kqjzonl7dqf4bzB+gtDm",
+	"FpPswQwtoRn/qAUM6IJ9XHN0XjrsvcOBk2xzkI0d4CNDR3ZAMf2aaysyUdJb5zvY3/vTrztB0nDOcrBc",
+	"oJIx+uCegWXcn7mwke6Yt3sKTtK99cHvKd8SywmuuW3gr2BPb+7XAPoNlJW9vafrNNhb80yBnBy36h61",
+	"PxOA9nfNVpgloLSXUySutMXerYhs65Hy5j5e54lRmXCB07iAELeFj4q4Cex4Zos94wgz7NkNaGCmWjqn",
+	"jL6FyKpyEQ+QtDiNzOjtzUlr76gB/IKGipaXcgZ0r5xx+C47T50WOvzrplSqmKDz6yEjCcEkbxhWKtx1",
+	"4YO4QxhvOBstIP01VOwDuP7yi9FMK2D/rSrydg2+rkFKU5pEH+xLMwgTzeld9hoMeXfUGjuPHnUX/uiR",
+	"33Nh2ApuQuaDR4/66Hj0iDRTr5WxLXZxDxpeZCDniQux66DX5ZKHfbj8yJPc2zqDh0npTBnjCReXf2cG",
+	"0DmZuylrj2lkmv+a3U1c+WXb46m3btr3C7GtCm7vww4H17xYoA+sFjkc5O9+YqHkV9e8+LHuRlkdIEOB",
+	"eCUKSL99sUXlzpVrVq+uHnVeO7D9JujB4MyQJDwvq5X3AvIxOj4ox72DaHqreQaLjFIhfHg31x4IE7EJ",
+	"l9jHJXDAcYQUVoTgzalbAueu14XrdEBt0LhLi+0WcsEtFHtW4gWbO0uKMNG2nDAalmUbLtf0CNSqWvsg",
+	"JTcOXXmVceo2NEt2h0gKynYnF2S4SF2B3vXQX3YkIgPHZ3rX6uEEhRtezwd562acuAddK1DS8DmfDWox",
+	"EKnXjRbDIaedf2PCddiS4SP8NBNPNI8R6lCe7eMr3paGnaBSAojJ3Adf2ZVCw5CiVGxhHhkqGb0d6OBD",
+	"qbLNnP5rHDBMGJYLk3FNoYo2ZLMhYnNv7jRxjdA+7nitIFOreLp5hyWZznfSLSPXrHjkcEvaGiUHIPFd",
+	"F2IAnIjR1/OG+ZLGfPIcWkyNhfAmuHgRGPuQGzZ4LoNReXj/gs2Z2XF8TiD5QCuNJ3yEsPZiY9CmnAPH",
+	"t+gYpFD7fu5a/D42yGboQdBaE0dhi83HochF1B8W+3t487iB8BHmIsBMS+9u3Fe1ihNNeaI3e2Nh2zdN",
+	"uq5/GyDPN4MKMCULIWGxVRL2ydyKQsL39DHV20nJA53pvTLUt6tUacHfAas9zyQSvCN+abe711PXBG++",
+	"Vvq+fDzcgJPf/BNcKg76D/kpb+v4gb71fV8Jn4ame/uZeR19IDTjxqhMECs/x5BFIRv3Ch+K2Eb/6zq4",
+	"/h7OXnfcjlNAnOGMjF5QlIyzjEIwsamxusrsW8lJ6R4tNeGVGrSLw2aYF6FJ2u6TMMv4od5KTtdkrYpP",
+	"XlorSDwMvgYI1hhTrddOnm8lQwV4K30rIVklhaW5tnhcFu68lKDJNfTEtcTAkxXShFXsN9CKLSvbfvxT",
+	"liVj0ajjPBRwGqZWbyW3rABuLPteoP8bDhe8mMKRlWBvlL6qsZC+QtcgwQgzECz4jftKgUp++XHsoO8c",
+	"vOg/9EsmwC7yQcjPX3rF2PlL0n5EsUdd2D+YQXMr5CJJZLF7Woe22CeU784T0MO2tt9u4K1E30OrMN2i",
+	"yLm9HTl0b5jeWXSno0M1rY3oaPfDWo/UKdyBy7AEk+mwxntKE4CLT2fbIuHTJ9DCVmxVSbeV4enpksk0",
+	"waLzOqOaS7b8nFG6rQ0PXuv+z6effT6bN2my6u+z+cx//TVBySLfpZKh5bBLqYriqK8HhpV8b8CmuQfB",
+	"nvQNds5q8bBbQB2j2Yjyw3MKY8UyzeFCDKZXOe/kuXQRS3h+yGdj703BavXh4bYaIIcyFaj/pi2oUatm",
+	"NwE6fnQu98CciRM46ap883WI8ucUuB8nh5jAJupz4AgtUEWE9XghR4UNd8gyjtfyl7+59+eQHzgFV3fO",
+	"VIjCg2++umSnnmGaB4QtP3SUSS2hR3If2h6WlvFWkOxb+Va+hBWp3pR8/lbm3PLTJTciM6eVAe1TKZys",
+	"FXseksq85Ja/lT1JazA7fBzXXlbLQmRooEuRp8v42x/h7dtf0Kjz9u2vPWez/vPBT5XkL26CBQrCqrIL",
+	"n690oeGG65Qx39T5Kmlk6j06qxOyVeXj9t34zI+f5nm8LE03b11/+WVZ4PIjMjQ+KxtuGTNW1QG2wtR5",
+	"iXB/f1D+YtD8JigVKwOG/X3Ly1+EtL+yxdvq8eNPgbUSuf3dX/lIk/sSJqsWB/PqdTWKtHD3rKTgm0XJ",
+	"1ynV2du3v1jgJe0+yctb3AIUdKlbjJM6YoqGahYQ8DG8AQ6OozMc0eIuXK+Qmz69BPpEW9jOInWn/YqS",
+	"gN16uw4kEuOV3SzwbCdXZZDEw87UKat9qhvnXoZ2XDwEPrv3ElxuHJ92Gbal3c9b3dWqJWgG1iGc7tOH",
+	"TFOuHbJPYqLuMg9aSS733dycxoWI0aBv4Ar2l6rJKHtMMs52bkgzdFCJUiPpEok1PrZ+jO7mezfZEDnv",
+	"UyxSNHogi+c1XYQ+wwfZibz3cIhTRNHKXTiECK4TiKAOQyi4xUJxvDuRfmp5QmYgrbiGBRRiLZYp095/",
+	"9c3hAVafIwrEdch1UA9o0EIurAlZSPzzXqOBiXHylyuV4YUrDZH0QqP30Aa4tkvgdtTIJeOsegE67M9u",
+	"8GQ5Dd8clwA73G9hSWMn4QZyryhybXw4xsmwQ60DHPJbwhO6Ny+Fk8G3rkddIm16uJVr7NbPWu9rHNPZ",
+	"5ab+TtmG1lrdGEo4ljPlSwa4zJTR/VIZvh6wdrQ8AyYm9WsZ/GmQQxJJUgZBW3Fb1OhJAkmQXeMFrjl5",
+	"hgG/4CGmZ2bHwzzM5PxDvMGUKgF5hC0LEmBrV3y391y3nCjkegy0NGsBLRtRMIDRxkh8HDfchOOYzyMu",
+	"O0k6+x1zV47l1z6PnKOjyg519uxwG3Y5aO/d77Nsh9TaIZ92/OifkBvbZQWr0tuhJImmORSwdgt3jTs5",
+	"rR6YaIMQjh9XK+Iti5SfdaSgjgQAPwfgy+URY842wiaPkCLjCGzye6KB2Q8qPptyfQyQ0met5WFsuiKi",
+	"v+FkMF0YCRYLVeLlKgaM7VngAD63TiNZdEJEaBgm5Jwhm7vmRZSosRmkl+aZHhSdpM7e8+7h0ENjxDTl",
+	"rvyj1kQ9brWaWJoNQKdF7RGIl2q3cCkXkm+R5W6J9J4MxsJeyYPpEmo/MJSUDP1T6WpxwT8HYBmGI4DR",
+	"AECZknHt1G9IznLAjE07LuemqNCwT2qpsyGXIUFvytQDsuUQuXwS5ci+FQAdNVRTcM6rJQ6qD9riSf8y",
+	"b261yOQf4lxTx3/oCCV3aQB/ff1YO6v1t0328uEMyb7Rh0nn3dcs3SXNuutMgJijsqx3yaEFxAhWX3fl",
+	"wCRaW606eI2wlmIllN6yZ5Tso81AAfQIXrRE08UV7NNveaB7/CJ0i5R1tHtc7h9G/sMa1sJYaIxGwSnu",
+	"Y6jjm5Slg6uzpV7h+t5EWWfjZKXxMj/4CiikaCU0xq6gxS25BGz0tSEl0tfYNC2BtjabuYppIk9zXJoW",
+	"o1BzUVRpevXzfvcSp/2hvmhMtaRbTEjnnbikCn/JSIyRqV2wzuiCX7kFv+L3tt5ppwGb4sSUCbg9x5/k",
+	"XHQY2Bg7SBBgijj6uzaI0hEGGWXQ6HPHSBqNfFpOxqwNvcOUh7EPeqmFPB5DN78bKbmWKK9p2tdSrdcY",
+	"+unSlQV7mIyyYhZKrqNStGU5lgT0BOsfGZ9KcyQLp4/CgaEYnEjcXwi02Kahj5o5yJtQYcogSpOgmZ7y",
+	"L6XVQmp9IMKHWkS6ug9sC+3G/yRjIC47xuzGZ9XtUr2dtAEF8Ny/SQyE9Y0fy/6GeNTNh6InWuUbxo8Q",
+	"DUg0JWxUnbGfV2WAAfOyFPmuY3hyow4qwfhR2uUBaYtYix/sAAbaEQBJgmvVA/JxBl7Bfkpv3lN8lbnA",
+	"A+9Vj/TNM59RJK80WTBabv394lP1W23i2r/7+cIqzdfgrVALB9KdhqDlHIOGqLSTYVY4d5JcrFYQW1/M",
+	"bSwHLeB6OvZ8AukmiCxtoqmEtJ8/S5HRAeppYDyMsjTFJGhhyCZ/2bdy+baxKqm+EqKtuYWpKpl/5DvY",
+	"L35GpQMrudCmcc/1Zqf25XvErl9vv4M9jXzQ6xUBO7ArpHl6A0SDKU1//clEyd8fmBhj7nnZ2sIjduos",
+	"vUv3tDW+stww8Te3TLyizlLucjAaJwmEZcpuXKR9E/D0QBvxXVI+tAlD4SFRp1jej6cSJtTh719FdXKd",
+	"Q7SLmTED8dJyZu/ns7t5AqRuMz/iAVy/ri/QJJ7J09RZhluOPUeinJfov8WLhfeXGLr8tbr2lz81D+4V",
+	"H/glk6bsy6/OXr324KNJugCuF7UmYHBV1K7806zK1aIbv0pc+QKv6HSaomjz6xTzsY/FDZUq6CibepUd",
+	"G/+ZZrzgc7FKO7wf5H3e1cctccTlB8ra46exeVLnjpMPv+aiCMbGAO2Aczotblp50CRXiAe4s7NQ5PO1",
+	"uFd20zvd6dPRUNcBnkRz/Ui5dtMvDukz8RIr8s4//N6lp6+VbjF/H5abdB76/cQqFLIdHgd8tUMR/q4w",
+	"dcKc4PX39d/xND56FB+1R4/m7O+F/xABSL8v/e/0vnj0qA+0u+3STIK0VJJv4WEdZTG4ER/2AS7hZtoF",
+	"fXa9rSVLNUyGNYU6L6CA7huPvRstPD5z/wuaY/GnkymP9HjTHbpjYKacoIuhSMTayXTr6v4bpmTXp5oi",
+	"wJG0XHYYV2PGGWP7R0hWWzJgLkwhsrRrh1waZK/SOVNiY0aNB7S1OGIlBnxzZSWisbDZlCTQHSCjOZLI",
+	"NMk81A3ulsof70qKf1bARA7S4iddVxWMrrrwOKBRewJpWi/mB6Y+0fB30YOM2JuCLmhMCTJqv3tZ25TC",
+	"QlOVS4/0AI9n7DHuEe9tTx+eml0026btgjntHRMMekn1gbcgBkbnjXUDczRF0qmfS3glzGKl1W+QNoSQ",
+	"/SiRB8dPRM8R6p3y3OuylNqoHNYTz35ou6e/jYc2/s5v4bDounTybS7T9Kk+biNv8+g16fzz81l8JNNw",
+	"uY+sHRowwFroeEXOsJTAJXgfcenOk0uB0oowS5/KqIU5deM3p9LD3N3VrOA3S55dpd9CCFO0vS0/KatY",
+	"6Bw2wNQJPtzsLPLgrtsKlxqzBN3YIPpptm/5rnHTTn7RNA8Y7Nh6urgyrbwwKjFMJW+4tBDcGBy/8r0N",
+	"OBM89rpRmhLbmrRLVw6Z2CbVsW/f/pJnffedXKxxJpf2lfGV9fkr/EDMZc8lKsqFKQu+r9PWeNScr9jj",
+	"eXMmw27k4loYdGSmFk/mvjSooeuyNofXXXB5IO3GUPOnE5pvKplryO3G1781itVvTxLyasfEJdgbAMke",
+	"U7snX7BPfDHHa3iIWPRC0Oz5ky/Iocb98Th1y+aw4lVhx1h2Tjw7OGun6Zh8Ut0YyCT9qGnv65UG+A2G",
+	"b4eR0+S6TjlL1NJfKIfP0pZLjghJwbQ9AJPrS7tJ5vwOXiQ1ysFYrfZM2PT8YDnyp4GYb2R/Doy4tqtV",
+	"zKgt0lNgpOGwheFO6Gw4nl7DFT6S/2sZ3P86uq4P/Izh2zQ9cPJS/oFstDFaqYQrJcAQjWe6Z4gn7Dwk",
+	"S6eKgHUhQIcbnAuXTrIkbiFlCRPSkv6jsqvFX/BZrHmG7O9kCNzF8vNnicp67eJT8jjAPzjeNRjQ12nU",
+	"6wGyDzKL74tR8HKxFcjqHzY5FqJTOeiom5zWDvmFjg89VfLFURaD5Fa1yI1HnPpOhCdHBrwjKdbrOYoe",
+	"j17ZB6fMSqfJg1e4Qz+9eeWljK3SqQoozXH3EocGqwVcQz64STjmHfdCF5N24S7Qf1z/pyByRmJZOMvJ",
+	"h0Bk0RwLlkcp/ufvm1IOZFh1kYgdHaDP2NaWz73e7gN7Gx6ndevab53DGH0bwNxktNEofawMeN/Tz02f",
+	"j+Ev1AXJ7XlL4fjk70zjG5zk+EePCGjUO7qmf3/a/uzY+6NH6YzqSZUb/tpg4S4vYuqb2kOv9TGXWrh8",
+	"bC82okipXFiGHxxfptoIXnVZchtqkcf11pvEF1OKbV5G+YFweLmgdBI0JYUtuji0LRcydxct/uBTDnv/",
+	"8qbHCfvRlwuvc9k42COIvUDpsluEkebe/EzxXD4dcn3L+DosH+GaGXHfI0dOp9VVq2ipuMY501BwDEbF",
+	"1Xq/MBiKyUD0DQe/NiMLE3CNVDBB/1X7uuEEk0gQa4ilKLCmizvQHy1Cw1B0kv8akIkTzZ3jpSOE2llp",
+	"Wpnk9OE65DdTw5jElkqQQqibXHsA+oQm/fUPSpX4AaWWpR9qzto1aj+82H8/AZlp9/D0tYXe4Pgl4IH+",
+	"6CLiI0s3tIFNWNHw7dyu0Z0kmbz+HgWmcPal2k0lnI7QGIjnD4CiAZRM1KfTSno1yJP+NQcdvCIaxVGX",
+	"gP7gplWWMDbA/XnwjIufj2Abc/D+3CRj7Eh+mstsk3Trp+S9f3OP6pbM7GSbFNayDZcSiuRwThn1tyB5",
+	"JNRq/1BT59kKObFttwa+W25ncQ3gbTADUGFCRK+wBU4QY7Wd567Oo1KsVe4yIDdltRrmeDJL7FW/xHaP",
+	"BN2w28p6R3NK3uAzhK1Egf8bcPSglgvN7UDGO+1zGNcjwjWgaZk0LG500IyLLUnShmOtQzqZ14AOvdhV",
+	"Seh0p5yHNHJUM4uZEj9RS8owo5itNF73q2gZIK3QUOznrOTGuEEe47JgR3PPnj95/DippybsTFipw2JY",
+	"5o/NUp6cUhP3xZd5dMWIjgL2MKzvG4o6ZmP7hOOrWlOpghRPpQ8u1Bw7063tKlrX1ddP2DeUqgyJuFWa",
+	"BqGpU963M+BWZaF4PqdU/OhKx9ysro8GQhRV1F4j/B3yT9pDp2cEDqnYBlJdTR9nPPeOSzq+GMlV/opa",
+	"NCW6RcdJjhTvMXZO2Etn8zDhAeQmYVTQQW8hj3KfO60bEQf+x1qebbCBaklAw7xyein4wM4aU2sULnwd",
+	"PhLDRrh9NXhXDH7OFL5QbgTmF99wC9fQzl8awKhlep/PtL08XUnpKOXkCGG0rrZ4LNoDcDRu7QWUhKyD",
+	"+CNVyUZVOoNjK+NfUK908FSnzH7HTSdkwwwFIdj33hqYcamkyKh0UUqSplyL0/wKJlR5SjsEmJk/oYnD",
+	"lSzuXwfveywOlvufz1qI6/voRF9xUx11uD8t7HzR1zVY4zkb5HPS8ooCvAVbSAO+niYSUcwnlU54ISYj",
+	"l2pVwpFkRGnUBkwSX+O3H7zBCo8guxKuTIJHm3+fORszJp5BapdMWLZWYPx62uF35hfsc0JpVXPY/Xry",
+	"Sq1FdiHWNIbze8VlOyfv/lBnweXbu1hj2xfY1ld6qX9u+W+6Sc/K0k+aDEGvd7j3CauZDCE45WgYNCMR",
+	"cuvx49FGyG00VoPuUyQ0LOfBjIWS7uEeYYDWqRfiV64ICFIUtWAuBDqFlELIBBivhAw+D+kLIkteCbQx",
+	"dF4H+plMc5ttWmzokIf3QMQSpRTIru5jqM4GE0pojWGO4W283Elfj2eAcdQNGomfyz0LhwKpOxImMF65",
+	"9p0nIahtvkGpygtROUUD+hS+TixLMw5k3IsQ49xC18F427o71Y469iYaSiq6rPI1WExYmcpF9yV9ZfQ1",
+	"RHU29bnUKgrnbRcV6FObnyhT0lTbkblCgztOlwvDjYHtskj4eb+sP0Je7zBSGuom8d9UxcThnfFRDkeH",
+	"0YeQhvy4Shr9tAApqRdpeoEJ06Zjgu6Uu6Ojmfp2hN70v1dKD/H1f4jw+Q6Xi/coxd++wosjzrTdCyhx",
+	"V0udCJuCNxR9DxnK6hSuba6E3/p1QclNiTYvsWUd4EPDJODXvBhIXREbN9396gx+QwksssF8K9z6fHqW",
+	"s1EWNJijzDn3d8ylfZv/kEO/8+e/PzOjX+soQoeN7d+1TOvOqbNhFoMm9dtZvZsNPtbs/d31UE6TUFiH",
+	"vscFfLzb3dzbHOFaqMpvWB20EJ6E7lefM6tVqGdg/clQoI9ttRi0sVz6Cvpumf5N/t3Pzm2CgbR6/wew",
+	"uPQ2vVsFKiHtUouIYP0TuKc1G3jUtm7FKUWnUvWNvGwYdGWOtbRoqVcvqkdWL6eIAz18vJ/PzvOjLsxU",
+	"jayZGyV17F6J9cZSiY1vgeegXx8oIdKUDaEjViojmhroBQ7mczZvaLiTqdFBSMAiLoHSHyt4jV9DZqnw",
+	"feMNqwGOKYiCkwWjz79KiQw/p+sgKl9BZKxsSL/a/YE7vpfpLMrWB7UbxMQiGWd1zIML2cSyrnV+pU6S",
+	"g8mh1qsVZJTGfDSz3H+5qsIha9k86GUIllWUaE7UgYeUiP94rWMDUMFvCU/B7w+cocQTV7B/YFiLGpKF",
+	"vuuo29tk+iYMOBNYSPo+pEj2bp7C1JRBWAg+/K47NNVsBpO0R3kSbzlXIEnG49yJI1NeKwu3nAu7HpWn",
+	"lWLohpLPvQbQbyBkKj/ItHTd1N0MJYQka1thloBpiXPKKo45/xK2TS4l5ItKWpHY1p+k2EUWlagiMHWI",
+	"kq3RtMgpabw5U96DTaxan6WyvsnkY1CfgiWXCX7UZHqkVKOossBEzFyauQtGzhVmQfRCbF7pGlcH6gIf",
+	"OpRENWq1guTL8DKwgLALQukGE0hCJlN6iGPihQowEHVGI5y/bjIJaFY+Lf3P6eNPc6XqEDSwUZM5yyFz",
+	"sVqK7FBY3IFd9vbXnQhhmUYUN/UnV2Jd4aKQhr/k8nKjwWAoQwSU16d2DwctNwDq9zp9OihVcSRMDr/O",
+	"X4LlojDe35vXefRjHRaq47t14G64qU9MY1kMGfnBhN9CSlw3SyGufDkc4hnOjotZlEOLe8lxSM2YSAO9",
+	"qmcWTTxi3wWozwFdaG9WKBSyF0Px0e0QwNp//oFxgQ5NPjqCawVaQ14bDAtlYGFVoNoxOMZQYSia41ZI",
+	"MIPV/Bxwg5Uc3jSlKqiqKafKDdwHccQLjHx8m4ISw3OOIfuF+x5yygRP4IP615peD5cfD5GowvSQGFP9",
+	"ivkL53CumtuoYoWUoBfBLtutLiHbCUYpjXReZU58jQ9Gra6e7N06wkqSWsysv8rOCzrK+XIF+1OnIvDZ",
+	"X+odjIF27woHepQ/u7PJ96qcNim41/cC3sdNi1oqVSwGTIHn/ZIYXYq/EuhSxfCmCBFb+DJ60D4bOAn7",
+	"hCxQta/HzWYfSkCUJUjIH54wdiZdjGxw+2hXy+1MLh/Ysfl3NGteuSo1XuV88lamgw2pfoy+IzcLw4zz",
+	"MAMyv/NUbpDxiexODjmk3VCtmXZR6pOpOqu+I0ZXLGmIykGRkkkunD33BR30lFqVMvpEqafIzM+ZtwMz",
+	"U6iUp/ttsg7hUGlMxZMRQBbklOQ3NRR+8CQCvI9bVHcz+W4peOYPABlGvH+y33gXRY22EvdMCPHu5CJB",
+	"IYXWhBIanWpXztPwCko7d6ml8U8UYLXIc5A+1WCra1zIMiWZjYhAPk5j3ibrAYFmuChhO4EExIV5NBb/",
+	"oxMy904Lt1CqHFMbrjN/6ECXQj2OcVK/K7SWTjpwL6XFhio4XW6go8pKlm+a1/WaxCoQ0vN7KeP0ozyq",
+	"/9QiTh+8hFM6wc+Eg/2jO1IJQgpfTFSton28p2sYLzetw9u/GI7JgBcf8xGLnWHuXdHNu9cnrlDdiagL",
+	"wJt3JoloNTbjilU1TlOeJwOVhc7iKja/D4hRCqcxCIMRe9KY0e0wpBsbJcIk2vrOY2BNE+VsEps6MTBm",
+	"MSHf7/1n9E352eWzeMwDODoaM61Mqr83au6Y2XQIO61hRxGUIuw0puKEaR4tQYUwXVhw34ZSyI1mibtM",
+	"5CuLOo5kgIuv9VulfAswjWHyS7WbgEAfyOkjXNVu7lQyBJFtM6hbURlTN7WHwlINFHtI+/1eRklDou5/",
+	"GC+DCHUfCbyh0+ZdfQ+zowMlL/zn6KrV0Phc37a6hS8YMXjpOwN4d+Z6lrYCZKU0xDPSK8VVsqkTu+Bl",
+	"yOg/S2E11/vb1KBooyrlbDCI5deqpH/zNx57Z9hnYOW6iRJ12tjwDseVirWveuNQEr+p58wobw+3xnle",
+	"UOIUhzef0BQJI482UdgGr0K28HjWfb5ZhRZS5/Hq8U55hENSAzShEvAe4O14JFR/5eFrZ931z7TwEY/L",
+	"g/LmUDDVKAMb3IayHAFpqpFqqkRZ3zWD4LiP9w7QQNnQy43f/Q44GDjcUDBREXscTO7ooNw+wC7yxA0U",
+	"6g04tZxj/JibSEi8kQzU45//yJzP9PQ8CXFc9lFH92DgYR1z2JydJu6wfwCKQt0saIWLugRz6gGB7Uxb",
+	"se6LhTalm40/kk0EIzfe6LJnG56zTGkNWdwjrRVwUG2VhgUWG0smAX4lVtawQmyFNYwq/K6ZKjOVgytl",
+	"nmb+Q3N5TrSoOdEgCgL7UgnuNXFK1I87j+mFM4FOfRBdYh+XVLUpOOAWvXAUOBCbD8YXGPAYco378BLh",
+	"uIzcXa+5tKC0EjuiG9Cp23rFrK5gznwLGr1FQnQ9IC/fCmMcKDUt3YiioJymYtdc5VCH6Azok/zNVm/k",
+	"gg9cba95djV0Cw0LEE3AVf/2C7XukKs0byXCHXvjpCLDBsgtvZoBg9w5hUNfC4qZa2frpR6s1JBBncI4",
+	"vkQv4voCzG60qtabqJJjjfXgqqIr78gSj/KTqSiskdghTvGMbZWx3gbuRmo2sAkV/SRT0mpVFME25JzJ",
+	"vCOA95D9nu/Ossy+UuoKs+4+JIs7qeX8SvN5SGTaDeptZtKdGh6xDYIsciron6aevZYSwYToN9pec7i4",
+	"nmuH4AbmeLSGxTP4nlfsITfTCMxfD18sh51uz/oL666rfcekLbVnknGrtiJLs5o/V7jtYJDsAPX0jffh",
+	"RHp6jiP/22eY1O2OC0IeapLQK8OfR3IiCsbZrvtSM47PEq3Bna2qdFkX2lI3M2CMUNIcIzrXy2zXIm6S",
+	"lZrjtYsddfJk2bkHS093FQnWt9HLjoE0IKr2YIre7f4BE7xQbq+PjZUtRwmYsYyROrSuh89gHhy4TEva",
+	"rOMAScbpkw5InjQunTF/9/l4KKJiVTrdT29ctgJue3NHkm5COnCpVSbMTCC6fLq20qQw4jEEfqSwg22x",
+	"yudc2YcIvTkr20G+Ib5dgnZBkhQvG0kJXxGGXsI1FIi4s9fn6QV5G+0iG7QkH15Xy85bSwZq7TSC9Ajq",
+	"Yn6inEuruhtsOMK9A2XhTkD1Mg/UAH7iuMvc2Q7qt2T4/rCp1HQr4A8c29a9PRRefdGcFU1N6qoOA5dx",
+	"uh7saCzyJeWIXk6NSK5l5YlvjgiA4RjlFgyTIpWPBWPFRQH5gtsBAZ084OaRZdb7PkejCy9bB+WYE7rx",
+	"ccFFUWnwVQacvlC33bjjpJ7YvO+nij6P4FRlv4FWFGOWz6PYh5BOtONqpMpFgZynrcxDWjYVPYzQX9r3",
+	"NXVnlgOUoD1Xi7qaMe1P4tb0a19EUa1TsJv003KIdTvFDjhhJX2qw3PyrkCNvTEVSWArUZuT009LWsu8",
+	"v4p0JvedXLjzbabyAIT6WuQVb228OVbwaHtHIg9K7HHv/bsI6Jg6zU9uhKDANmehf+r5EzDx6zQGejTv",
+	"TKNujHMeTK5QmSF2JdO5FeKCJLXfOc2W19Fb7mw2DM+U/EYO+2n2z2qjuZq4T0LJCLFf7SAj+dKrjiD3",
+	"yqNR/bs7RU4Odi/NtUw4IW9AMqkaDRI5aQY9SVMpLfzgJqZGQnrF5C1cRpoUCHffWUaDMdMpmZTcicCZ",
+	"8pSma8oBGrIBtZjH3TyiP8opHz3kg+Ol6M+Az0c4YmEMJ8erQaiBqoqcSaQV1EVgPFW42v0tMmfLKgyE",
+	"6sC+reslhNATR9nB696tKNQxIuOcQ/d82J5WJ9BBe5jS9I9Ulv2z4oVY7YmHOfBDN2Y2HMnTx7q4EEWf",
+	"lgInHpc55wGwoBRXYSq3bjF1zGi4fbgl/Ugo3XijKpX6uYJ4Gyj60vHmzCJTNtWSFMwox3S2s48Fv/hQ",
+	"5GHL81iFSaXm9i3OE9/P/2+TnC+eKlSIIofWPGye4duOZzdJiDVx3cZmGUigsV3WRFubwfJbGKXvbtl0",
+	"nnWHwI7eVi2vuntaxkTbeqdK/1Gm2sRS7nsX7mTMXQQ3wAPgt10GPwT+k1Ugj7RJt8D/o+B9xGYd4PW2",
+	"698fy+Om52BUXKrdQsPKHIrpo9YdI3ttO2oZyhsjeV3kUEjG89wlqajDSOpRclgJ2TBLIcvKJh53pMWW",
+	"+whhsW2W0DoQtTAkJaCges2LEW39JUWhkMK2U2Q+2KN934Rep75T+wMI07whKWFkY+2Mm+EFnovVCrTL",
+	"H2EslznXedxcSJaBxnuf3fC9ub3hv7bhHjL980iaaacxjpwAiLQdIMXex+Hc0SxfA8jv0T4/wa5+uQFP",
+	"/W3lr9N3WTVgRu/D8Kewq2/5Dl0xKK3hwIHw1S3JEYOaMSXJLOfks2nrDvMY8RuMT0MlYDwjsopmnTLF",
+	"+Ln/kbaSnqg/SWFHT75T3HbzTLpEIO5gBqTKdZONyBFL/zyWWXqyjuWgNhn53FmB9iDaRBgyfLeMBQO7",
+	"SJFnPq9sbBk4wjjWCm5L3DBe67AgbYQZyTfUWMgI18arqXoRvl01hkNKHAl1hPrRGS3CvTQAnveH976H",
+	"rWnrKEUy6UyWfaKQvDREpSoX2ZQwe1f7P3cABEjbMI45RoxSRx2RaILluUWNkcj7wLAmVO5Y8dtZy8Nc",
+	"B63vZTb26B9SQQ1w9LZdRq2Il3n7Hy9LpnSsqJl3k961VWw1k2CcacgqTbrzG75POiJTAueFP/EDNWcv",
+	"vj377MnTvz397HNXPywXazA2cj+iQWq2UYdiC9nVKX1Yt/Xe8mx6E0I6ZPpcW5lDlrd6U/xZc9zWNEUJ",
+	"W6s/1mieuAASx5HCS5uEQ7feKxqnyTX0x9qu1CLvfcdSKPj99wz9z9J142u5KmHASe1WZFfCF0gJ2ghD",
+	"nhxts7CwTRIKsyH1IFUPvXbp7ZXMIOimPRUIO+ARmFrIUA4D4mf4iXmrFYNdWXhe5cxfY+vy7zSnoSOh",
+	"kdyLUIsVVMd4w6YgIv9qHaX69IpP0rZHaQlqZusSFKRLAVKyjzTpnXkbGdLXOLdvrKeBUSc4PW5iQrwI",
+	"h/IWpDlk+xhOpHwbTtKYDf4w/CORGfreuEa93N+DVyTfByNJUM96ziB1VuRJoPWzBCfIgwAYSP/ZStwY",
+	"Za6LKiNqZyUge0IwYHfFj+8bw/bBTDwESehwALw4n2fTrvZP9OB85Di172ukREv5dYgSWss/lG0vsN76",
+	"Iom2yCtNrAXj2JLqi4VR/lfzok6rOvAq6WVf1UpZpiTqRhJZW50eh85UTDhCWtDXvPjwXONroY09I3xA",
+	"/mY4G1WcujNGskOluV3hoFd80twF/x2mlq8pU+x/Ae5R8p7zQ3kDf+82I+UOL1wUzCqu9HtDY9JOsyef",
+	"s6Uv119qyITpOg7cBOGkzlQJGq1jdebD8dSYh9b5s7J3IONVcE9iP7RC87w/gIewOaIfmakMnNwklaeo",
+	"r0cWCfyleFQcBH/gurhjaffb5aGPKsocmYe+H94/dXm0Drp0KgP9dU6+rVu4TVzUzdqmFlGYXCH+7dtf",
+	"7HJK7YN0NXfsTsUX7qWs+1FF3X+HsgsOR34MP2+KYn4eKsTnis0NFAvt7Efl68OPWtXi0q+YtQIkGGGo",
+	"uOnflp8/+/DZ7gIELiVC/6g6WO+Sv94hJrHW1uTRVFFR1wn1XH23RBFOSiSXVVrY/QXiPyjQxN+SBSK+",
+	"qZON+2T1tS3N331WXYEM/h5NavLKhNv1G8ULuo+ciU8Cs5iLiX3lSo76g/LXB8v/gE//8ix//OmT/1j+",
+	"5fFnjzN49tkXjx/zL57xJ198+gSe/uWzZ4/hyerzL5ZP86fPni6fPX32+WdfZJ8+e7J89vkX//EA+RCC",
+	"7AANiROez/7H4qxYq8XZ6/PFJQLb4ISXAvO5v39Pb+WVwuUTUjM6ibDlopg9Dz/9f+GEnWRq2wwffsWj",
+	"pLH5xtrSPD89vbm5OYm7nK4p2+rCqirbnIZ53s87GD97fV5HYjg/HNrRRnt8MmtI4Yy+vfnq4pL5OIe6",
+	"fObs8cnjkyc4vipB8lLMns8+pZ/o9Gxo30+p4Nep8bV8T+uQ2vfz3reydJV+8ZOnUf/XBnhhN/6PLVgt",
+	"svBJA8/3/v/mhq/XoE8omsz9dP30NEgjp+98WqL3Y99OY8+Q03etnL75HXqehvTnI/2D58ShJqfvQkqX",
+	"98e1ngDE4Rax+uXUe8JFHSaibxRXS7U7omkP5kMdIEbyMEbpNWZO39F7YvD3U68USn+kd51jGF0wuy1d",
+	"Mtf0xxbO39ldYi+7PXYij8bL0OpXlafv6D909qMVucppp3YnT8kOfvpO5P3PPUS0f2+6xy2utyqHAJxa",
+	"rQzYA59P37l/o4lgV4IWKFTzovWr0hb0qYay4Ps+fC5Q8xTDJ4t9/+e99GbdAlLFAn6SBmwr4HMvsyZ2",
+	"ueaX53lofLGXWXgeBN9P4oJPHz920z+j/8x8TGEnafip51szJ7ccVE61ipnRHdPRS9bwhsSJJzOC4cmH",
+	"g+FcOn9PvHTc5fh+PvvsQ2LhXFrQkheMWrrpP/2AmwD6WmTALmFbKs21KPbsJ1m7rLrrmeLlUxR4JdWN",
+	"DJCjZFVtt1zv6cWyVddg2FZI8rhoiJNpMHhDuiBNrbYRDdPVzpHR/DIrsZBCNvNZ/34lqdSmBLSgLOvP",
+	"FBSFzeDtU/HNwTMxfRfacv9INvRJcB7Ik+uG7z9a+vsb9r5ranZTPUht0OxfjOBfjOAeGYGttBw8otH9",
+	"RQVvoPQB2xnPNjDGD/q3ZXTDzkpl7EiiwAQkvqz8EK+4aPOKxqVy9vyX4aIHeLKbsjVOICHFfQ4GD/NJ",
+	"eLThi6R5U+maI4UzT7blaK/9AmbPHyeYxa9/iPv9BZfhPLd23JlvuS4E6JoKuOxX+v8XF/i/hgt8I9Cc",
+	"wN2+zpkFdPGMzr5VdPadpYsaUUZhaybzgW4y8NTPp0E/k3prt1u+a/3ZfniVANqc6rhq1sCX03f4S9TV",
+	"bCqbq5uoCxlFnEWv/0Kok5O3/j694cKimtMXSuMrCzrVWQPf+sdL87MFXhBtuGQW8a9NfeLeFyq6HP0Y",
+	"B/kmfz3l/gGT+kbcc6hj782e+upfmQONgv/4gc+nIUPN1Han7/z/FofnTnc65fk1l1kNWaNJjTWTdKfU",
+	"OslffkWObkBfh+umUbQ9Pz2lUKeNMvZ09n7+rqOEiz/+Wh+id+GiKbW4Rjzht91CabEWEpOZOk3VolGm",
+	"PT15PHv/fwYA6dIw3bEzAQA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -30,6 +30,21 @@ type ServerInterface interface {
 	// Fast track for broadcasting a raw transaction or transaction group to the network through the tx handler without performing most of the checks and reporting detailed errors. Should be only used for development and performance testing.
 	// (POST /v2/transactions/async)
 	RawTransactionAsync(ctx echo.Context) error
+	// Starts a simulation session.
+	// (POST /v2/transactions/simulate/sessions)
+	StartSimulationSession(ctx echo.Context, params StartSimulationSessionParams) error
+	// Deletes a simulation session.
+	// (DELETE /v2/transactions/simulate/sessions/{session-id})
+	DeleteSimulationSession(ctx echo.Context, sessionId string) error
+	// Gets a simulation session.
+	// (GET /v2/transactions/simulate/sessions/{session-id})
+	GetSimulationSession(ctx echo.Context, sessionId string) error
+	// Simulates a transaction group within a simulation session.
+	// (POST /v2/transactions/simulate/sessions/{session-id})
+	SimulateTransactionInSession(ctx echo.Context, sessionId string, params SimulateTransactionInSessionParams) error
+	// Advances a simulation session by a number of rounds and seconds.
+	// (POST /v2/transactions/simulate/sessions/{session-id}/advance)
+	AdvanceSimulationSession(ctx echo.Context, sessionId string, params AdvanceSimulationSessionParams) error
 }
 
 // ServerInterfaceWrapper converts echo contexts to parameters.
@@ -93,6 +108,130 @@ func (w *ServerInterfaceWrapper) RawTransactionAsync(ctx echo.Context) error {
 	return err
 }
 
+// StartSimulationSession converts echo context to params.
+func (w *ServerInterfaceWrapper) StartSimulationSession(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params StartSimulationSessionParams
+	// ------------- Optional query parameter "round" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "round", ctx.QueryParams(), &params.Round)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter round: %s", err))
+	}
+
+	// ------------- Optional query parameter "ttl" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "ttl", ctx.QueryParams(), &params.Ttl)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ttl: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.StartSimulationSession(ctx, params)
+	return err
+}
+
+// DeleteSimulationSession converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteSimulationSession(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "session-id" -------------
+	var sessionId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "session-id", runtime.ParamLocationPath, ctx.Param("session-id"), &sessionId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter session-id: %s", err))
+	}
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.DeleteSimulationSession(ctx, sessionId)
+	return err
+}
+
+// GetSimulationSession converts echo context to params.
+func (w *ServerInterfaceWrapper) GetSimulationSession(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "session-id" -------------
+	var sessionId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "session-id", runtime.ParamLocationPath, ctx.Param("session-id"), &sessionId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter session-id: %s", err))
+	}
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetSimulationSession(ctx, sessionId)
+	return err
+}
+
+// SimulateTransactionInSession converts echo context to params.
+func (w *ServerInterfaceWrapper) SimulateTransactionInSession(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "session-id" -------------
+	var sessionId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "session-id", runtime.ParamLocationPath, ctx.Param("session-id"), &sessionId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter session-id: %s", err))
+	}
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SimulateTransactionInSessionParams
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", ctx.QueryParams(), &params.Format)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.SimulateTransactionInSession(ctx, sessionId, params)
+	return err
+}
+
+// AdvanceSimulationSession converts echo context to params.
+func (w *ServerInterfaceWrapper) AdvanceSimulationSession(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "session-id" -------------
+	var sessionId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "session-id", runtime.ParamLocationPath, ctx.Param("session-id"), &sessionId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter session-id: %s", err))
+	}
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AdvanceSimulationSessionParams
+	// ------------- Required query parameter "rounds" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "rounds", ctx.QueryParams(), &params.Rounds)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter rounds: %s", err))
+	}
+
+	// ------------- Optional query parameter "seconds" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "seconds", ctx.QueryParams(), &params.Seconds)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter seconds: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.AdvanceSimulationSession(ctx, sessionId, params)
+	return err
+}
+
 // This is a simple interface which specifies echo.Route addition functions which
 // are present on both echo.Echo and echo.Group, since we want to allow using
 // either of them for path registration
@@ -124,238 +263,292 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.GET(baseURL+"/v2/accounts/:address/assets", wrapper.AccountAssetsInformation, m...)
 	router.GET(baseURL+"/v2/experimental", wrapper.ExperimentalCheck, m...)
 	router.POST(baseURL+"/v2/transactions/async", wrapper.RawTransactionAsync, m...)
+	router.POST(baseURL+"/v2/transactions/simulate/sessions", wrapper.StartSimulationSession, m...)
+	router.DELETE(baseURL+"/v2/transactions/simulate/sessions/:session-id", wrapper.DeleteSimulationSession, m...)
+	router.GET(baseURL+"/v2/transactions/simulate/sessions/:session-id", wrapper.GetSimulationSession, m...)
+	router.POST(baseURL+"/v2/transactions/simulate/sessions/:session-id", wrapper.SimulateTransactionInSession, m...)
+	router.POST(baseURL+"/v2/transactions/simulate/sessions/:session-id/advance", wrapper.AdvanceSimulationSession, m...)
 
 }
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+y9f5PbtpIo+lVQ2q1y7CfO2I6TPfGrU/smdpIzL07i8jjZt2v7JRDZknCGAngAcEaK",
-	"r7/7LXQDJEiCEjUzsZOq+5c9In40Go1Go3++n+VqUykJ0prZ0/ezimu+AQsa/+J5rmppM1G4vwowuRaV",
-	"FUrOnoZvzFgt5Go2nwn3a8XtejafSb6Bto3rP59p+FctNBSzp1bXMJ+ZfA0b7ga2u8q1bkbaZiuV+SHO",
-	"aIjz57MPez7wotBgzBDKn2S5Y0LmZV0As5pLw3P3ybBrYdfMroVhvjMTkikJTC2ZXXcas6WAsjAnYZH/",
-	"qkHvolX6yceX9KEFMdOqhCGcz9RmISQEqKABqtkQZhUrYImN1twyN4ODNTS0ihngOl+zpdIHQCUgYnhB",
-	"1pvZ0zczA7IAjbuVg7jC/y41wO+QWa5XYGfv5qnFLS3ozIpNYmnnHvsaTF1aw7AtrnElrkAy1+uE/VAb",
-	"yxbAuGSvvn3GPv/886/cQjbcWig8kY2uqp09XhN1nz2dFdxC+DykNV6ulOayyJr2r759hvNf+AVObcWN",
-	"gfRhOXNf2PnzsQWEjgkSEtLCCvehQ/2uR+JQtD8vYKk0TNwTanynmxLP/0l3Jec2X1dKSJvYF4ZfGX1O",
-	"8rCo+z4e1gDQaV85TGk36JuH2Vfv3j+aP3r44d/enGX/4//84vMPE5f/rBn3AAaSDfNaa5D5Lltp4Hha",
-	"1lwO8fHK04NZq7os2Jpf4ebzDbJ635e5vsQ6r3hZOzoRuVZn5UoZxj0ZFbDkdWlZmJjVsnRsyo3mqZ0J",
-	"wyqtrkQBxdxx3+u1yNcs54aGwHbsWpSlo8HaQDFGa+nV7TlMH2KUOLhuhA9c0J8XGe26DmACtsgNsrxU",
-	"BjKrDlxP4cbhsmDxhdLeVea4y4q9XgPDyd0HumwRd9LRdFnumMV9LRg3jLNwNc2ZWLKdqtk1bk4pLrG/",
-	"X43D2oY5pOHmdO5Rd3jH0DdARgJ5C6VK4BKRF87dEGVyKVa1BsOu12DX/s7TYColDTC1+Cfk1m37/3vx",
-	"049MafYDGMNX8JLnlwxkrgooTtj5kkllI9LwtIQ4dD3H1uHhSl3y/zTK0cTGrCqeX6Zv9FJsRGJVP/Ct",
-	"2NQbJuvNArTb0nCFWMU02FrLMYBoxAOkuOHb4aSvdS1z3P922o4s56hNmKrkO0TYhm///nDuwTGMlyWr",
-	"QBZCrpjdylE5zs19GLxMq1oWE8Qc6/Y0ulhNBblYCihYM8oeSPw0h+AR8jh4WuErAicMMgpOM8sBcCRs",
-	"EzTjTrf7wiq+gohkTtjPnrnhV6suQTaEzhY7/FRpuBKqNk2nERhx6v0SuFQWskrDUiRo7MKjwzEYauM5",
-	"8MbLQLmSlgsJhWPOCLSyQMxqFKZowv3vneEtvuAGvnwydse3Xyfu/lL1d33vjk/abWyU0ZFMXJ3uqz+w",
-	"acmq03/C+zCe24hVRj8PNlKsXrvbZilKvIn+6fYvoKE2yAQ6iAh3kxEryW2t4elb+cD9xTJ2YbksuC7c",
-	"Lxv66Ye6tOJCrNxPJf30Qq1EfiFWI8hsYE0+uLDbhv5x46XZsd0m3xUvlLqsq3hBeefhutix8+djm0xj",
-	"HkuYZ81rN354vN6Gx8ixPey22cgRIEdxV3HX8BJ2Ghy0PF/iP9sl0hNf6t/dP1VVut62WqZQ6+jYX8mo",
-	"PvBqhbOqKkXOHRJf+c/uq2MCQA8J3rY4xQv16fsIxEqrCrQVNCivqqxUOS8zY7nFkf5dw3L2dPZvp63+",
-	"5ZS6m9No8heu1wV2ciIriUEZr6ojxnjpRB+zh1k4Bo2fkE0Q20OhSUjaREdKwrHgEq64tCftk6XDD5oD",
-	"/MbP1OKbpB3Cd+8JNopwRg0XYEgCpob3DItQzxCtDNGKAumqVIvmh8/OqqrFIH4/qyrCB0qPIFAwg60w",
-	"1tzH5fP2JMXznD8/Yd/FY6MormS5c5cDiRrublj6W8vfYo1uya+hHfGeYbidSp+4rQlocGL+XVAcPivW",
-	"qnRSz0FacY3/4dvGZOZ+n9T5r0FiMW7HiQsfWh5z9MbBX6LHzWc9yhkSjlf3nLCzft+bkY0bZQ/BmPMW",
-	"i3dNPPiLsLAxBykhgiiiJr89XGu+m3khMUNhb0gmPxsgCqn4SkiEdu6eT5Jt+CXth0K8O0IA07yLiJZI",
-	"gmxUqF7m9Kg/GehZ/gLUmtrYIIk6SbUUxuK7GhuzNZQoOHMZCDomlRtRxoQN37OIBuZrzSuiZf+FxC4h",
-	"8T1PjQjWW168E+/EJMwRu482GqG6MVs+yDqTkCDX6MHwdanyy39ws76DE74IYw1pH6dha+AFaLbmZp04",
-	"OD3abkebQt+uIdIsW0RTnTRLfKFW5g6WWKpjWFdVPeNl6aYesqzeanHgSQe5LJlrzGAjUGHuH46kYaf3",
-	"F/uG52snFrCcl+W8VRWpKivhCkr3aBdSgp4zu+a2Pfw4cnjX4Dky4JidBRatxquZUMWmG12EBrbheANt",
-	"3GumKrt9Gg5q+AZ6UhDeiKpGLUL00Dh/HlYHVyCRJzVDI/jNGlFbEw9+4ub2n3BmqWhxpAG0wXzX4K/h",
-	"Fx2gXev2PpXtFEoXpLO27jehWa40DUE3vJ/c/Qe4bjsTdX5Wacj8EJpfgTa8dKvrLep+Q753dToPnMyC",
-	"Wx6dTE+F6QcYcQ7sh+Id6ISW5if8Dy+Z++ykGEdJLfUIFEZUZE4t6GJ2qKKZXAPUtyq2IVUmq3h+eRSU",
-	"z9rJ02xm0sn7hrSnfgv9Ipoder0VhbmrbcLBxvaqe0JIdxXY0UAW2ct0ormmIOC1qhixjx4IxClwNEKI",
-	"2t75tfa12qZg+lptB1ea2sKd7IQbZzKz/1ptn3vIlD6MeRx7CtLdAiXfgMHbTcaM083S2uXOFkrfTJro",
-	"XTCStdZGxt2okTA17yEJm9ZV5s9mwmJBDXoDtQ4e+4WA/vApjHWwcGH5H4AF40a9Cyx0B7prLKhNJUq4",
-	"A9JfJ4W4BTfw+WN28Y+zLx49/vXxF186kqy0Wmm+YYudBcM+82o5ZuyuhPvJ1xFKF+nRv3wSbFTdcVPj",
-	"GFXrHDa8Gg5Fti96/VIz5toNsdZFM666AXASRwR3tRHaGZl1HWjPYVGvLsBa99J9qdXyzrnhYIYUdNjo",
-	"ZaWdYGG6dkIvLZ0WrskpbK3mpxW2BFmQn4FbhzDuDbhZ3AlRjW180c5SMI/RAg4eimO3qZ1mF2+V3un6",
-	"LtQboLXSySu40sqqXJWZk/OESigoXvoWzLcI21X1fydo2TU3zM2N1staFiN6CLuV0+8vGvr1Vra42XuD",
-	"0XoTq/PzTtmXLvLbV0gFOrNbyZA6O+qRpVYbxlmBHVHW+A4syV9iAxeWb6qflsu70XYqHCihxxEbMG4m",
-	"Ri2c9GMgV5Kc+Q6obPyoU9DTR0ywMtlxADxGLnYyR1PZXRzbcW3WRki025udzCPVloOxhGLVIcvbq7DG",
-	"0EFT3TMJcBw6XuBn1NU/h9Lyb5V+3Yqv32lVV3fOnvtzTl0O94vx1oDC9Q1qYCFXZdeBdOVgP0mt8ZMs",
-	"6FmjRKA1IPRIkS/Eam2j9+JLrf6AOzE5SwpQ/EDKotL1GaqMflSFYya2NncgSraDtRzO0W3M1/hC1ZZx",
-	"JlUBuPm1SQuZIy6H6OuELlo2lltRPyEMW4CjrpzXbrV1xdABaXBftB0zntMJzRA1ZsT9ovGboVY0Hbmz",
-	"lRp4sWMLAMnUwvs4eO8LXCRH7ykbxDQv4ib4RQeuSqscjIEi86rog6CFdnR12D14QsAR4GYWZhRbcn1r",
-	"YC+vDsJ5CbsMff0M++z7X8z9TwCvVZaXBxCLbVLo7evThlBPm34fwfUnj8mONHVEtU68dQyiBAtjKDwK",
-	"J6P714dosIu3R8sVaHQp+UMpPkxyOwJqQP2D6f220NbViAe7f6Y7Cc9tmORSBcEqNVjJjc0OsWXXqKNL",
-	"cCuIOGGKE+PAI4LXC24suUEJWaBOk64TnIeEMDfFOMCjzxA38i/hBTIcO3f3oDS1aZ4jpq4qpS0UqTWg",
-	"RXZ0rh9h28ylltHYzZvHKlYbODTyGJai8T2y/AsY/+C2sb96i+5wcWhTd/f8LonKDhAtIvYBchFaRdiN",
-	"vXhHABGmRTQRjjA9ymlch+czY1VVOW5hs1o2/cbQdEGtz+zPbdshcZGRg+7tQoFBA4pv7yG/JsyS//aa",
-	"G+bhCCZ2VOeQv9YQZncYMyNkDtk+yscnnmsVH4GDh7SuVpoXkBVQ8l3COYA+M/q8bwDc8fa5qyxk5Iib",
-	"3vSWkoPf456hFY5nUsIjwy8sd0fQPQVaAvG9D4xcAI6dYk6eju41Q+FcyS0K4+GyaasTI+JteKWs23FP",
-	"Dwiy5+hTAB7BQzP0zVGBnbP27dmf4r/B+AkaOeL4SXZgxpbQjn/UAkZ0wT7GKTovPfbe48BJtjnKxg7w",
-	"kbEjO6KYfsm1Fbmo8K3zPezu/OnXnyBpOGcFWC5KKFj0gZ6BVdyfkQtpf8ybPQUn6d6G4A+Ub4nlBDed",
-	"LvCXsMM390uKTYhUHXfxlk2M6u4nLhkCGjyenQgeN4Etz225c4KaXcOOXYMGZuoFuTAM7SlWVVk8QNI+",
-	"s2dGb51N2kb3mosvcKhoeSlfM3oT7Ifvde9h0EGHfwtUSpUTNGQDZCQhmOQ7wirldl348KcQABMoqQOk",
-	"Z9pomm+u/3umg2ZcAftvVbOcS3xy1RYamUZpFBRQgHQzOBGsmdM7J7YYghI2QC9J/PLgQX/hDx74PReG",
-	"LeE6xAy6hn10PHiAepyXytjO4boDfag7bueJ6wMNV+7i86+QPk857PHkR56yky97gzfWLnemjPGE65Z/",
-	"awbQO5nbKWuPaWSatxeOO8mW0/UPGqwb9/1CbOqS27uwWsEVLzN1BVqLAg5ycj+xUPKbK17+1HTDeEjI",
-	"HY3mkOUYxTdxLHjt+lDgnxtHSOEOMDn9TwUIzqnXBXU68MRsPVXFZgOF4BbKHas05EDxbk5yNM1STxh5",
-	"wudrLlf4YNCqXnnnVhoHGX5tSDWjazkYIilU2a3MUMmdugC8m1oIeXTiFHD3pOtryOkBc82b+XyU65Sb",
-	"OdqDvsUgaSSbz0ZfvA6pV+2Ll5DTjduccBl05L0IP+3EE00piDon+wzxFW+LO0xuc/8YlX07dArK4cSR",
-	"x2/7cczp1z23y90dCD00ENNQaTB4RcVqKkNf1TKO0Q6ugjtjYTPU5FPXX0eO36vR96KSpZCQbZSEXTIt",
-	"iZDwA35MHie8Jkc6o8Ay1rf/BunA3wOrO88UarwtfnG3+ye0b7Ey3yp9VyZRGnCyeD/BAnnQ3O6nvKmd",
-	"lJdlwrToIzj7DMDMG2ddoRk3RuUCZbbzwsy9VzBZI324Zxf9L5u4lDs4e/1xeza0ODkA6oihrBhneSlQ",
-	"g6yksbrO7VvJUUcVLTXhxBUe4+Nay2ehSVpNmtBi+qHeSo4OfI3mKumwsYSEmuZbgKC8NPVqBcb23jpL",
-	"gLfStxKS1VJYnGvjjktG56UCjZ5UJ9Ryw3ds6WjCKvY7aMUWte1K/xigbKwoS2/Qc9MwtXwruWUlcGPZ",
-	"D0K+3uJwwegfjqwEe630ZYOF9O2+AglGmCztbPYdfUW/fr/8tffxR3d3+hycTtuMCTO3zE6SlP//s/98",
-	"+uYs+x+e/f4w++r/On33/smH+w8GPz7+8Pe//6/uT59/+Pv9//z31E4F2FPhsx7y8+f+ZXz+HJ8/kat+",
-	"H/aPpv/fCJkliSz25ujRFvsMU0V4ArrfVY7ZNbyVdisdIV3xUhSOt9yEHPo3zOAs0unoUU1nI3rKsLDW",
-	"Ix8Vt+AyLMFkeqzxxlLU0D8zHaiORkkfe47nZVlL2sogfVMcZvAvU8t5k4yA8pQ9ZRipvubBydP/+fiL",
-	"L2fzNsK8+T6bz/zXdwlKFsU2lUeggG3qrRgHSdwzrOI7AzbNPRD2pCsd+XbEw25gswBt1qL6+JzCWLFI",
-	"c7gQsuR1Tlt5LsnB350fNHHuvOVELT8+3FYDFFDZdSp/UUdQw1btbgL03E4qra5Azpk4gZO+zqdw70Xv",
-	"1FcCXwbHVK3UlNdQcw6I0AJVRFiPFzJJsZKin154g7/8zZ0/h/zAKbj6c6Y8eu99981rduoZprlHKS1o",
-	"6CgJQeIp7YMnOw5JjpvFMWVv5Vv5HJaofVDy6VtZcMtPF9yI3JzWBvTXvOQyh5OVYk9DPOZzbvlbOZC0",
-	"RhMrRkHTrKoXpcjZZfwgacmTkmUNR3j79g0vV+rt23cD34zh88FPleQvNEHmBGFV28yn+sk0XHOdsn2Z",
-	"JtULjky5vPbNSkK2qklBGlIJ+fHTPI9XlemnfBguv6pKt/yIDI1PaOC2jBmrmng0J6D4kF63vz8qfzFo",
-	"fh30KrUBw37b8OqNkPYdy97WDx9+jpF9bQ6E3/yV72hyV8Fk7cpoSoq+UgUXTs9K9FXPKr5Kmdjevn1j",
-	"gVe4+ygvb1DHUZYMu3WiDkOAAQ7VLqAJcR7dAILj6OBgXNwF9QppHdNLwE+4hd0A7FvtVxQ/f+PtOhCD",
-	"z2u7ztzZTq7KOBIPO9Nke1s5ISt4YxixwteqT4y3AJavIb/0GctgU9ndvNM9OPx4QTOwDmEolx1FGGI2",
-	"JTRQLIDVVcG9KM7lrp/WxlBEBQ76Ci5h91q1yZiOyWPTTatixg4qUmokXTpijY+tH6O/+d6rLASa+uwk",
-	"GLwZyOJpQxehz/hBJpH3Dg5xiig6aT/GEMF1AhFE/CMouMFC3Xi3Iv3U8oTMQVpxBRmUYiUWqTS8/zW0",
-	"hwVYHVX6zIPeC7kZ0DCxZO4pv6CL1T/vNZcrcNezu1KV4SVlVU06beB7aA1c2wVwu1fPL+OEFAE6fFJe",
-	"Y+Q1avjmbgmwdfstLGrsJFy7VwUqiqiN914+Gfc/I8ChuCE8oXv7UjgZfet61CUyDoZbucFu86z1rnkx",
-	"nSFc9H0DmLJUXbt9cVAon22TkrpE90tt+ApG3i6x9W5iPoyOxQ8HOSSRJGUQteyLGgNJIAkyNc7cmpNn",
-	"GNwXd4jxmdlzyAwzkYHY24wwibZH2KJEAbbxXKW957pjRaWswGOgpVkLaNmKggGMLkbi47jmJhxHzJca",
-	"uOwk6ewPTPuyLzXdeeRLGCVFbRLPhduwz0EH736foC5kpQup6OJH/4S0cu7theELqe1QEkXTAkpY0cKp",
-	"cSCUNmFSu0EOjp+WS+QtWcotMVJQRwKAnwPcy+UBY2QbYZNHSJFxBDY6PuDA7EcVn025OgZI6RM+8TA2",
-	"XhHR35AO7CNHfSeMqspdrmLE3pgHDuBTUbSSRc+jGodhQs6ZY3NXvHRszr/F20EGGdLwQdHLh+Zdb+6P",
-	"PTT2mKboyj9qTSQk3GQ1sTQbgE6L2nsgXqhtRhHKybfIYrtw9J6MXcB46dTBpFx09wxbqC26c+HVQr7y",
-	"B2AZhyOAEeletsIgvWK/MTmLgNk37X45N0WFBknGK1obchkT9KZMPSJbjpHLZ1F6uRsB0FNDtbUavFri",
-	"oPqgK54ML/P2Vpu3aVNDWFjq+I8doeQujeBvqB/rJoT7R5v4bzy5WDhRHyUT3lCzdJsMhdS5oqyDxyQo",
-	"7JNDB4g9WH3ZlwOTaO36enXxGmEtxUoc8x0aJYdoM1ACPoKzjmiaXaY8BdxbHvAevwjdImUd7h6Xu/uR",
-	"A6GGlTAWWqNR8Av6FOp4jumTlVqOr85WeunW90qp5vInszl27Czzo68APfCXQhubocUtuQTX6FuDSqRv",
-	"XdO0BNp1UaRiA6JIc1yc9hJ2WSHKOk2vft7vn7tpf2wuGlMv8BYTkhy0FlgcI+m4vGdq8m3fu+AXtOAX",
-	"/M7WO+00uKZuYu3IpTvHX+Rc9BjYPnaQIMAUcQx3bRSlexhkFHA+5I6RNBr5tJzsszYMDlMRxj7opRbC",
-	"3sdufhopuZYoDWA6QlCtVlCE9GbBHiajJHKlkquoilNV7cuZd8IodR1mntuTtM674cOYE34k7mdCFrBN",
-	"Qx+/ChDyNrIOE+7hJCuQlK4krRZKoiZ28ccWka7uI9tC+wEASSfo1z1jduudTLvUbCduQAm88G8SA2F9",
-	"+4/lcEM86uZj7tOdzKf7jxAOiDQlbFTYZJiGYIQB86oSxbZneKJRR5Vg/Cjt8oi0hazFD3YAA10n6CTB",
-	"dVJpe1drr2A/xTfvqXuVke+1dyx29M1zH4Bf1BotGB3P5mHe9uatNnHt3/9yYZXmK/BWqIxAutUQuJxj",
-	"0BBlRTfMCnInKcRyCbH1xdzEctABbqBjLyaQboLI0iaaWkj75ZMUGR2gnhbGwyhLU0yCFsZs8q+HVq4g",
-	"00eqpOZKiLbmBqaqZLj+97DLfuFl7R4ZQpvWPdebnbqX7xG7frX5HnY48kGvVwfYgV1BzdMrQBpMafqb",
-	"TyZKYH3PdFL84/Oys4VH7NRZepfuaGt8UYZx4m9vmU7Rgu5SbnMwWicJB8uU3bhI+ya40wNdxPdJ+dAm",
-	"iOKwDBLJ+/FUwoQSlsOrqMlFcYh2XwMvA/HicmYf5rPbeQKkbjM/4gFcv2wu0CSe0dOULMMdx54jUc6r",
-	"SqsrXmbeX2Ls8tfqyl/+2Dy4V3zkl0yasl9/c/bipQf/w3yWl8B11mgCRleF7aq/zKqojMP+q4SyfXtF",
-	"J2mKos1vMjLHPhbXmNm7p2waFEVp/Weio+h9LpZph/eDvM+7+tAS97j8QNV4/LQ2T3L46Tr58CsuymBs",
-	"DNCOOKfj4qZV1klyhXiAWzsLRT5f2Z2ym8HpTp+OlroO8CSc6ydMTZl+cUifuBJZkXf+4XcuPX2rdIf5",
-	"+8jEpPPQHydWOSGb8Djiqx3qV/aFqRNGgtdvq9/caXzwID5qDx7M2W+l/xABiL8v/O/4vnjwIGk9TKqx",
-	"HJNALZXkG7jfRFmMbsTHfYBLuJ52QZ9dbRrJUo2TYUOh5AUU0H3tsXethcdn4X8poAT308mUR3q86YTu",
-	"GJgpJ+hiLBKxcTLdUMlMw5Ts+1RjEKwjLWT2viQDGWOHR0jWGzRgZqYUedq1Qy6MY6+SnCldY4aNR7S1",
-	"bsRajPjmylpEY7lmU3Km9oCM5kgi0yTTtra4Wyh/vGsp/lUDE4V71SwFaLzXelddeBzgqAOBNK0X8wOT",
-	"naod/jZ6kD32pqAL2qcE2Wu/e97YlMJCU0V/jvQAj2ccMO493tuePjw1UzTbuuuCOe0dM6V0emB03lg3",
-	"MkeyFLow2VKr3yFtCEH7USIRRjB8ClTz/g4y5bnXZymNUbmt6N7Ofmi7p7+Nxzb+1m/hsOim6thNLtP0",
-	"qT5uI2/y6DXpdM0eyWOPsNjDoBsaMMJa8HhFzrBYBiV4H3FJ54myQHQizNKnMo7lPKXx21PpYR7Ev5b8",
-	"esFTNWLcW8jBFG1vx0/KKhY6hw0wTY4Dmp1FHtxNW0GZ5CrQrQ1imJX2hu8amnbyi6Z9wCBFxU+XObkp",
-	"lEYlhqnlNZdURdz1I37lexsgE7zrda005oE0aZeuAnKxSapj3759U+RD951CrAQVyK4NRBWY/UCMkk0i",
-	"Ffkq1k3mDo+a8yV7OI/KwPvdKMSVMGJRArZ4RC0W3OB12ZjDmy5ueSDt2mDzxxOar2tZaCjs2hBijWLN",
-	"2xOFvMYxcQH2GkCyh9ju0VfsM3TJNOIK7jsseiFo9vTRV+hQQ388TN2yvsD5PpZdIM8OztppOkafVBrD",
-	"MUk/atr7eqkBfofx22HPaaKuU84StvQXyuGztOGSryAdn7E5ABP1xd1Ec34PL5KsAWCsVjsmbHp+sNzx",
-	"p5GYb8f+CAyWq81G2I133DNq4+ipLa9Mk4bhqNa/rxcV4Aof0f+1Cu5/PV3XR37G8M1IzBZ6Kf+INtoY",
-	"rXPGKflnKVrP9FCvk52H3MJYQKupm0W4cXO5paMsiY7qS1ZpIS3qP2q7zP7mnsWa5479nYyBmy2+fJIo",
-	"RNWt1SKPA/yj412DAX2VRr0eIfsgs/i+7DOpZLZxHKW43+ZYiE7lqKNu2iVzzC90/9BTJV83SjZKbnWH",
-	"3HjEqW9FeHLPgLckxWY9R9Hj0Sv76JRZ6zR58Nrt0M+vXngpY6N0qmBAe9y9xKHBagFXGDGX3iQ35i33",
-	"QpeTduE20H9a/6cgckZiWTjLyYdAZNHcFyzvpPhffmgzn6NhlSIRezpApRPaTq+3+8jehsdp3fr2W3IY",
-	"w28jmJuMNhxliJUR73tyr2/6fAp/oT5ItOcdheOj35h2b3CU4x88QKAfPJh7Mfi3x93PxN4fPEgnIE6q",
-	"3NyvLRZu8yLGvqk9/FolFGChamHjUOTzIyQUkGOXlPvgmODCDzVn3QpxH1+KuJv4rrS3afoUvH37Br8E",
-	"POAffUR8YmaJG9hGKYwf9m6FzCTJFM33yM+ds6/Vdirh9O6gQDx/AhSNoGSieg5XMqgAmjTXH/QXiWjU",
-	"jbqAUrlHZlwUKNbn/3Xw7BY/34PtWpTFL21ut95FornM10kv4YXr+CvJ6J0rmFhlss7ImksJZXI4etv+",
-	"Gt7AiVf6P9XUeTZCTmzbr0BLy+0trgW8C2YAKkzo0Cts6SaIsdpNm9WkZShXqmA4T1vUomWOw1LOqRKa",
-	"ifhmHHZTW++3irHgPuHQUpTohpm2G2PLTHM7kkAL652H+kJuHCw/bkjNQKODZlxs8GI2fFOVgCfzCjRf",
-	"YVclodcdU6jhyFHFCmYq9wlbYsIKxWytJVPLZbQMkFZoKHdzVnFjaJCHblmwxblnTx89fJhUeyF2JqyU",
-	"sBiW+VO7lEen2IS++CJLVArgKGAPw/qhpahjNnZIOL6m5L9qMDbFU/EDRa6ildTd2lRPsql9esK+w8xH",
-	"jog7qe5RXRmSCHcTatZVqXgxx+TGr785e8FoVupDJeSpnuUKtXVd8k+aV6YnGA2ZnUYy50wfZ38qD7dq",
-	"Y7Om/GQqN6Fr0RbIFD2fG9Tjxdg5Yc9JhdoU8KdJGKbI1hsoomqX9IhH4nD/sZbna9RNdiSgcV45vRBr",
-	"YGet5SaKPmyqHyHDdnD7WqxUinXOlF2DvhYGMCIfrqCbDrHJDep14yE9Ynd5upaSKOXkCGG0qXV0LNoD",
-	"cCTJBqeCJGQ9xB+pmaJ6zMfWpb3AXulYjF6R257VPyTXCym22Q/euJBzqaTIsRRCSpLG1G3TzJQTqkak",
-	"7Ytm5k9o4nAlS+s2scAei6PFdgMj9Igbmvyjr25TiTroTwtbX3JtBdZ4zgbFPFS69gYxIQ34alaOiGI+",
-	"qXTCqSkZCNE4UBxJRpiVaUTD+a379qPXf2NSjEshUdPl0ebfZ2SyKo1Ay7RkwrKVAuPX043mMW9cnxPM",
-	"0ljA9t3JC7US+YVY4RjkRueWTT6jw6HOggep99h0bZ+5tj53fvNzxx2MJj2rKj/peB30pCBpt3IUwSm/",
-	"peBIEiG3GT8ebQ+57XX9xvvUERpcodcaVHgPDwijqaXdHeUb97YkisIWjCIqkwl0hUyA8ULIYEJNXxB5",
-	"8krAjcHzOtLP5JpbejtM4mmvgZcjARAYoUw2+NsO1a8c4FCCawxzjG9jWwZ8hHE0DVqJn8sdC4fCUXck",
-	"TDzjZeM6nSjqjVKVF6IKDC7qlflOMQ7HuLMQMtlB18HwvaY7VuM49iYay1G4qIsV2IwXRSq11df4leHX",
-	"ECQGW8jrpghVEx3YzVE+pDY/Ua6kqTd75goNbjldVDc/QQ1x7f6ww5hpZ7HDf1MVmMZ3xjtNHx2VGzyk",
-	"i+MS8w+jjFNSr6PpzIhVNh0TeKfcHh3t1Dcj9Lb/nVJ6CNf9U0Tj9rhcvEcp/vaNuzjixL0D/3S6Wpq8",
-	"uugLrvB7SHjUZITsciW8ygZ1xtDrATcvsWU94EPDJOBXvByJhI9tJXS/kv1gLB4+H03fwK1Pz2U528uC",
-	"RlMeka9wz/oyNCGO+QeTe/DdWS38WvcidNx2933HUkc+Yi2zGLXQ3cyI1m7wsVa076/GUiSEOh34Pa4H",
-	"4r145j4NPFwJVQfvq+ADHZ6E9KtPwdOp+zGy/mRkwae2WozaWF77+rW0TP8m//4XssIykFbv/gQWl8Gm",
-	"94vKJKRdUk+1TVhT+nBSKcTOrTilhk2qXIqXDYOujFhLh5YG5WcGZPV8ijgwwMeH+ey8OOrCTJXcmdEo",
-	"qWP3QqzWFjP2/wN4AfrlgYoEbRUCPGKVMqKtQFq6wXwK2DUOdzI12MARsIgrKgzHCk6oV5BbLDvbOtdp",
-	"gGPqK7jJgtHn/1QmGH9ONzEZviDBvioEw1qzB+74QeKkKPkX1ek8mZ5z/6xxoaYIsGtu2nQtvZjpyZGb",
-	"yyXkmBV5b6Kq/1qDjJIgzYNeBmFZRnmrRBPHhHm9j9c6tgDtyyO1F56ovs6twRmLY7+E3T3DOtSQLBza",
-	"BPHdJHEwYoBMYCGH9Jgi2XuNCdNQBmIhuAT7VMxtcYzRnM9R2rUbzhVI0l0cbSq2PVOmi55Pmst1PSrt",
-	"I4bkjOWyGtZMHn9/PMcS1cY7yPEm8XD8Smfnw8I51z5xMaYVa2wnIYUxmPBbyCFIs5Ti0tcPQKyQpeqa",
-	"6yK0uJOkUHQ3iTTQy2Zm0QZwDJ0cEqUYMBYqL5UTI7KxgLJuzETjcHjPkGdom8AH4VqC1lA0JpFSGcis",
-	"CgEf++DYhwpyf70REsxo+SMCbjT19as2tzeWgeOY6pp7r9d4gUzDhjvodJSBe3zOfch+Rt9DEH4oA3ZQ",
-	"w9TQ6+F6tCF0R5gBEmOqXzJ/Wx4O7r+JsklICToLlqd+Om7ZzciGeTeLOqcLOj4YjUJucu6cPawkqafJ",
-	"h6vsvRGiIPlL2J3SIygU8g07GANNkhOBHiUc7W3ynarfTAru1Z2A92nzyFVKldmIseN8mEO8T/GXIr8E",
-	"zAHYuLiP1Ghnn6GOvbFmX693IWd2VYGE4v4JY2eSgoqCYbtbXrA3ubxn982/xVmLmtL6e6XayVuZjs7A",
-	"hPv6ltwsDLOfhxlwrO6WU9EgBzJUb+WYy801JufvVvE8mfoqH5qa+1XkW6IiKFIyyQVZrJ7hQU8pjjAF",
-	"QpSrAw2ZnHlLFzOlSvny3iRNgxsqjal4MgTIgpySLaCBwg+eRECyLnriFFLqO5/0Ti2ZhtaIfNPsf8MS",
-	"7qkXfX/mZpYuv1sqDZ1i7K43ZfpsAl8wjSb+ZyGs5np3kxx9gxLyA+3JKJYPumM1nljtQlpvrCEOy1Jd",
-	"Z8issqbORepp69qZ7mUciq61/dypXkDk18WNF9R2bM0LliutIY97pOM9CaqN0pCVCt28UhbopXVy9waD",
-	"vCQr1YqpKlcFUL2YNAWNzVVLyVFsgsirJokCoh2MFqY+ER1PnNLdqWRHylDUWh1ROz8HilxvszrRojOy",
-	"ZY54LIPxWZw8hqjxEN49tf/TvHkptkg3oFNHfsmsrmHOfIt+jWx/8LkGthHGECgNLV2LssTAcbGNLK+N",
-	"40IatSNi7zm6VV4J9L3pJhEgabhyd16TWSHmARdx2iNm11rVq3WUYLqBMzx5de0fxPEoP5sa3aMwgsxN",
-	"8YRtlLH+pUkjtUtuXc4+y5W0WpVlVylFIvrKa9p/4NuzPLcvlLpc8PzyPr5rpbLNSot5iK/uOwe2M+le",
-	"arHuBZxROfPDqXqpHbrKeaKdzCB7LO7owu4RmO8Oc9DDOvez4cL66+oy0/Qz5kwybtVG5Okz9dfythv1",
-	"kUuxqGTOMqqtSFkmsBke9viyapwrkEUO0QySJ4vDnTHPCLyRGdmN+y9K4P1x2RI8oxm5KIfMxUtRWT4q",
-	"6/UAQEgp9NnWmgoyxpJYw1XUilIloIm8D+jEWwU9kW4HmxvhzoGycCugBt6PDYCfkfJhTrnlyJNyobbh",
-	"+/02+dyNgP+wn8o7zGPMxeuiJS1NTl4hUc0IR0inuN7rD/Uaw94XU72imuK5E2/4CIBxP6kODJO8pY4F",
-	"Y8lFCUWWqr143uio5tFL24dm9UuiC+M5ec7rUPrQjV1r8IlTSMTXXftXxR0pqab5UJMsC9gCxXX8DlpR",
-	"TcN5ZH+Bkkoe9pQBqspKuIKO+5jP5lKjqCmuIPQ1TWdWAFRojezryFJ+UfFd3lOc+LVnkWfNFOwmNSmE",
-	"WNopdkBNklTqbGVGx8RMPUoOoitR1LyDP3OsyNFVA7qjnEDV4I2QhXfk1Gl+phFehQHOQv+UKBMw8W4a",
-	"HzqaBaVRt48BHfSTrM3YqZdpN8k4VVFjYMHZisYQSyTe8g1T8Ws5rpAcknz73Jq4T0LJCLHfbCFHqca/",
-	"d6DwL54RI4XPeoLULgEKehW4Lglt+xokkyoqMXnNTfNUaXMohh9oYmwkpH9N38Co3Hoz3n5nGQ7GTC+Z",
-	"2uhDQjd0enP1/Cc5iXsP4uh4KRox4MP/9ui/AnX7Zwc2wFLe0u2nk/2xSKO/xTwXn7NFHQYqS3VNNSPj",
-	"d+hzCHZQor5gAvJiuWiu5eC1OffpPfuqDhH5q2/4jimN/7hX579qXorlDvkMgR+6MbPmjoS84ZU8ArwX",
-	"qJt4v3g1D4AFbYsKU9G6xdQxo+F2bpQIaHeRh+I+im34JcTbgM4OxD9z6xinqReouXBXdm87h1jwiw8p",
-	"Wja8iF/6mCiyW0Y9pA52vf/vNhYunirkd6tKnocKob5EUZfPYBXgQFx2DZv9wZJDvhZIoKks3BKtDtH1",
-	"xQ1UpkeyrlQEwlj5lQ7Yg4qrg8ozt1rGRM1vr8bGnjDTSUu5612Y6nUzADqu03gI/Lhs5cfBfzKH69gy",
-	"poD/Z8H7SKHaGF6qSfsRsNzJwJGAlbTVC7XNNCzNIQcTUle757xuc3cEFauQuQZuyOPm/Cf/8GxTlArp",
-	"HsLkE9rYNJtRClgK2TJLIavaJt4xmKlU7iKExUp/ROuICW1MSnDC5BUvf7oCrUUxtnHudFBJx7hERDB0",
-	"+L4JFUZzpw4HEKZ9w2F8ZqtGj5u5C5yKUJG7prFcFlwXcXMhWQ7a3fvsmu/MzS1KjXHgkE2JR9JMN2tA",
-	"ZF1C0iZAyp03Ct/S3tMAyO/Q8DPBYIN+wQljDal2rBqxzwxh+EsYbDZ8m5VqhVGEIwfC56ZFCx89AZVE",
-	"NTjJZ9PWHeYx4nfYPw2m5feMyCqcdcoU+8/9T7iV+Iz8WQq79+STjrIf1kl+t3QwA1LlqnX+J2IZnsdU",
-	"JK5PvhJH4wZhM4SqBNqDaBNhxD7U1YuP7CK6Qfgw7lgJPr3cWdfTIhXvS5qBDDUGZo97P5jWlZ3n3j1r",
-	"qEobqBoIKXMfLX2kpo308+FeGgGPatP7s96dtnGZceMcUyNuf3x0Vqkqy6f4fFLljsKbCTykXRhH6CMy",
-	"Aoysu3GPMU0tm07eo05Rm2PL5I0W1Tlk7aryfY/+MTXRCEfvmiDUEnkZVW5H7RZG8jTKlHk/xqyrBmuY",
-	"BONMQ15rVBNf893hsmMjGaMv/nH2xaPHvz7+4kvmGrBCrMC0Wcd7Zbtav0Ah+3qfj+sJOFieTW9CyD5A",
-	"iAv2xxBU1WyKP2vEbU2bUnRQtOwY/XLiAkgcx0S5qBvtFY7Tuvb/ubYrtcg737EUCv74PdOqLNNVHxq5",
-	"KmFASe1WZEJxL5AKtBHGOkbYtYAK23pEmzWqBzH37xVlk1Eyh6A/9lQg7IjLVWohYw61yM8wtttbjRhs",
-	"q9LzKrL07FuXf6eRhg6FRvSKWQCrVOVFe7FkKYgwgkhHkbVe8Yka8chHtmG25C2bIkTveZ4mvbhg9n5u",
-	"3y3matOc3m1iQrwIh/IGpDlmnxjPW3ATTtKq9v80/CORiOHOuEaz3D+CVyTfBzcryj8JtGFQfoI8EICR",
-	"aNtOnGQUKBYlItZkJUB7QjAg98WPH1rD8sGwEIQkdDgAXhw+27ZrIhk8OJ84o+8PDVKipbwbo4TO8g9F",
-	"5AbW21wk0RZ5pYm1YIgtqaFYGIVbm2dNFPPIq2QQ7KyVssy9TMsyESRNehw8UzHhuCeBvuLlx+ca3wpt",
-	"7BniA4pX46FRcaRsjGRCpblZnr4XfNLcUVTs3U0tX2Jg9n+B26PkPeeH8kb4wW2Gyh2sWL8KtwLFerNr",
-	"HJOcrB59yRa+2EalIRemb9y/DsJJExgKWiy9Qyts7YFI1EPr/EXZW5DxMnjisB8j81Zjs/cQtkf0EzOV",
-	"kZObpPIU9Q3IIoG/FI+Ki/MeuC5uWZjhZmlfogRuR6Z9GZYdnro8Sm3iLp3awHCdk2/rDm4TF3W7tqk5",
-	"iybXd3j79o1dTEk1lK7F4LpjrqM7KcpwVEmGPyDLEeHIj+HnTVHML2N5bym360hu7t5+1KI86LDSybT+",
-	"YT5bgQQjDOYS/9XXjvm4d2mAgDIvDI8qwXqbdDGEmMRaO5NHU0U51CekT/fdEjmvMaoxr7WwO6wbHBRo",
-	"4tdkPqbvmtwePjdMY0vzd59Vl9DUbm8zgdQm3K7fKV7ifUQmPuluIVWesG8ow7c/KH+/t/gP+PxvT4qH",
-	"nz/6j8XfHn7xMIcnX3z18CH/6gl/9NXnj+Dx37548hAeLb/8avG4ePzk8eLJ4ydffvFV/vmTR4snX371",
-	"H/ccH3IgE6Ahtf/T2f+XnZUrlZ29PM9eO2BbnPBKfA9ub/CtvFRY19IhNceTCBsuytnT8NP/E07YSa42",
-	"7fDh15mvzzRbW1uZp6en19fXJ3GX0xWG/mdW1fn6NMyD1QY78srL88ZHn/xwcEdb7TFuqieFM/z26puL",
-	"1+zs5flJSzCzp7OHJw9PHvnS1pJXYvZ09jn+hKdnjft+ivk1T41PnX/axGp9mA++VRUl1nefPI36v9bA",
-	"S0yw4/7YgNUiD5808GLn/2+u+WoF+gSjN+inq8enQRo5fe8zJ3zY9+009gw5fd9JMFEc6Nl4PiRtki+U",
-	"ukSTeJCP7pmeH8dJXJn7vHDop5bofGHOW0YYyiujzXn29E1K9+J9KKt6UYqc0fWN9Os2JyKvJm1Iyz5Q",
-	"0TZrS/u3zNAxuIfZV+/ef/G3Dykhqw/ID94g2FpAvEsuRnlhgMJJgOtfNehdCxha62cxGENzYTp72tay",
-	"yhc+8LOdsJ+9pwN+JZ7SeIT6oLAm8VzoNAKYGyIFV4OFd1jjD13/kBweP3wYTr6XqyOyOvXUGqO7a3sY",
-	"+AUdk86gU/g6IRS5xWSIjyHF/mwo5ZLDppCcvOrR3XbDL8nqgg51TPu4WY9R76OLSG7iR/y2BOb+B5Y0",
-	"mhCUTTMNhZIPQ245cgKDK22sGCsFqf28e1OqdvWH+ezJkdSwV0HVyR+aAP8HXjqQoQhpYwiCRx8PgnNJ",
-	"Hp/u2qHr8cN89sXHxMG5dMyLlwxbRuV3ExQvL6W6lqGlk2XqzYbrHUoqdsoe+yxHaEsM7Yju6WLl7gy/",
-	"mRFbxkIkFWjhHoy8nL37cOh6OX0fyq7vv4w6Jbe9v3LUYeIlt6/Z6QJLrU1tCiZqPL4UVIGZ0/d4Qkd/",
-	"P/Wa+PRHVKaRlHYaknyNtKR0LumPHRS+t1u3kP3DuTbReDm3+bquTt/jf1DgilZE2aFP7VaeovPR6fsO",
-	"IvznASK6v7fd4xZXG1VAAE4tl1Srft/n0/f0bzRRhzBboaYroHwTNXq2hvxylr77eqnzo16M5FG+KKEg",
-	"5vRkQgepbNzpRgf6FYofhv30PRNLBv0phAkzHHFuKbHoKVZ03bW4DD/vZJ78cbjNnaSKIz+fhudQSrTt",
-	"tnzf+bN75My6toW6jmZBRSJpwYeQuY+16f99es2FzZZK+1x+WAJ+2NkCL0994Y7er22u7MEXTAAe/RhH",
-	"qSV/PeUe1bNKmQTZvuLXkfXvDBuThADGfq3wRTF2O22zhZBIQfEN1eoP6ONQNh7cS06uQUe5YIIZ5uHB",
-	"ZCBa8SLnBkuP+xo4A2n9Q/LYfWxp42tesJBDJWOt7HHmX6mdpf05JJEku3kOV1A6imFKs0O85xPLMl88",
-	"/PzjTX8B+krkwF7DplKaa1Hu2M+yCcC5MSv+Fslb8/wSZfyG5Mk7U/PrbkyPTmeV6BaJCklGgNktW3NZ",
-	"lD4OX9VY/c7RJhpdVeT2466wUCStUhoBoOyTUJAjhDlhF42bCDpd1OGZVBDZoFUEcyrTJBxdSMiMOOEq",
-	"mc+2meMHK5CZ50jZQhU7X15opvm13VJs/YDtkZw5whMHUmDqqxd0RhoFv/HwudVTxno/VEg0Gr8379yD",
-	"GMvWe11Fq8Z6enqKgURrZezpzL3nuyqu+OO7BnOh2uqs0uIKq0Ig0pQW7plaZl4P1BZWmz0+eTj78L8D",
-	"AAD//6UnopQICgEA",
+	"H4sIAAAAAAAC/+y9e3PctrI4+FVQc39Vjr1DSXac3BNvnbqrxHlo4yQuS8ndu7H3HAyJmcERB+ABQGkm",
+	"Xn33rW48CJIghyPJcrLlv2wN8Wg0Go1GP9/PcrmppGDC6NmL97OKKrphhin8i+a5rIXJeAF/FUznileG",
+	"SzF74b8RbRQXq9l8xuHXipr1bD4TdMNmL+L+85li/665YsXshVE1m890vmYbCgObXQWtw0jbbCUzN8Sp",
+	"HeLs5exm5AMtCsW07kP5iyh3hIu8rAtGjKJC0xw+aXLNzZqYNdfEdSZcECkYkUti1q3GZMlZWegjv8h/",
+	"10ztolW6yYeXdNOAmClZsj6c38jNggvmoWIBqLAhxEhSsCU2WlNDYAaA1Tc0kmhGVb4mS6n2gGqBiOFl",
+	"ot7MXvw+00wUTOFu5Yxf4X+XirE/WGaoWjEzezdPLW5pmMoM3ySWduawr5iuS6MJtsU1rvgVEwR6HZGf",
+	"am3IghEqyJvvviGff/75V7CQDTWGFY7IBlfVzB6vyXafvZgV1DD/uU9rtFxJRUWRhfZvvvsG5z93C5za",
+	"imrN0oflFL6Qs5dDC/AdEyTEhWEr3IcW9UOPxKFofl6wpVRs4p7Yxve6KfH8H3VXcmrydSW5MIl9IfiV",
+	"2M9JHhZ1H+NhAYBW+wowpWDQ30+yr969fzp/enLzH7+fZv+3+/OLz28mLv+bMO4eDCQb5rVSTOS7bKUY",
+	"xdOypqKPjzeOHvRa1mVB1vQKN59ukNW7vgT6WtZ5Rcsa6ITnSp6WK6kJdWRUsCWtS0P8xKQWJdMaR3PU",
+	"TrgmlZJXvGDFnHBBrtc8X5OcajsEtiPXvCyBBmvNiiFaS69u5DDdxCgBuG6FD1zQnxcZzbr2YIJtkRtk",
+	"eSk1y4zccz35G4eKgsQXSnNX6cMuK3KxZgQnhw/2skXcCaDpstwRg/taEKoJJf5qmhO+JDtZk2vcnJJf",
+	"Yn+3GsDahgDScHNa9ygc3iH09ZCRQN5CypJRgcjz566PMrHkq1oxTa7XzKzdnaeYrqTQjMjFv1huYNv/",
+	"z/NffiZSkZ+Y1nTFXtP8kjCRy4IVR+RsSYQ0EWk4WkIcQs+hdTi4Upf8v7QEmtjoVUXzy/SNXvINT6zq",
+	"J7rlm3pDRL1ZMAVb6q8QI4liplZiCCA74h5S3NBtf9ILVYsc97+ZtiXLAbVxXZV0hwjb0O3fT+YOHE1o",
+	"WZKKiYKLFTFbMSjHwdz7wcuUrEUxQcwxsKfRxaorlvMlZwUJo4xA4qbZBw8Xh8HTCF8ROFzsAYeLaeAI",
+	"tk3QDJxu+EIqumIRyRyRXx1zw69GXjIRCJ0sdvipUuyKy1qHTgMw4tTjEriQhmWVYkueoLFzhw5NKLFt",
+	"HAfeOBkol8JQLlhBuLBAS8MssxqEKZpw/L3Tv8UXVLMvn89u9n2duPtL2d310R2ftNvYKLNHMnF1wld3",
+	"YNOSVav/hPdhPLfmq8z+3NtIvrqA22bJS7yJ/gX759FQa2QCLUT4u0nzlaCmVuzFW/EE/iIZOTdUFFQV",
+	"8MvG/vRTXRp+zlfwU2l/eiVXPD/nqwFkBliTDy7strH/wHhpdmy2yXfFKykv6ypeUN56uC525Ozl0Cbb",
+	"MQ8lzNPw2o0fHhdb/xg5tIfZho0cAHIQdxWFhpdspxhAS/Ml/rNdIj3RpfoD/qmqEnqbaplCLdCxu5JR",
+	"feDUCqdVVfKcAhLfuM/wFZgAsw8J2rQ4xgv1xfsIxErJiinD7aC0qrJS5rTMtKEGR/pfii1nL2b/cdzo",
+	"X45td30cTf4Kep1jJxBZrRiU0ao6YIzXIProEWYBDBo/IZuwbA+FJi7sJgIpcWDBJbuiwhzN5qkz2Rzg",
+	"391MDb6ttGPx3XmCDSKc2IYLpq0EbBs+0iRCPUG0EkQrCqSrUi7CD5+dVlWDQfx+WlUWHyg9Mo6CGdty",
+	"bfRjXD5tTlI8z9nLI/J9PDaK4hLUSwvmRA24G5bu1nK3WNAtuTU0Iz7SBLcTlDU384AGrZm5D4rDZ8Va",
+	"liD17KUVaPyDaxuTGfw+qfNfg8Ri3A4TF7QiDnP2jYO/RI+bzzqU0yccp+45IqfdvrcjGxhlhGD0WYPF",
+	"+yYe/IUbttF7KSGCKKImtz1UKbqbOSExQ2GvTya/amYppKIrLhDaOTyfBNnQS7sfEvEOhMB0eBdZWsJB",
+	"GxWqkzkd6o96epa/ALWmNtZLoppQUnJt8F2NjcmalSg4U+EJOiaVW1HGhA0fWUSA+VrRytKy+2LFLi7w",
+	"PW8bWVjvePFOvBOTMDef441GqG7NlveyziQk8KELw9e0pCJn+kJx9lpJubyHk74oZX6Zralepw8BfPEG",
+	"EWxL1owW7gVuGRa85/xTonmf7AxrqT//n8/+6wWoPWn2x0n21f92/O7985vHT3o/Prv5+9//3/ZPn9/8",
+	"/fF//a/U4c3lZsPNhomk9sV/Q30Pnmdp5pH2GtVFlDTqWlLSBStfdNc1J4/+49EcLwL4UouKFvg+pZp9",
+	"/swqaoAzOYyc/3CaffH02fGzL75sYS+XqMKwPA1+bFA/d7AZaWiJajWUTSrFNBNmbuWNDEHKrpiCGwZH",
+	"wc5H5JuSA1WRnAqiGJBZbRjhhiyV3MDc2j1TtV1Fvmb5ZTQ9oSvKhTbRJqd3t7cBJaPLNN0AW2aCLBzJ",
+	"EqM4I6xksCEPTyj47EzCKaTIYBVEyILpgDHckMYQyBkp5LXwlFRRxUT4DN1hSZOuyN4Z/lkWLHVJAgBD",
+	"95I07XMZI/nhkbv3CnVgNvZialgfcMI1WdS8NEReMZW6UOez0YOwn4HZmbE7ibuTghpKqOmffOjdKHwa",
+	"fuPltVwKzYSuUXVvZC7LxNHx5mcNZ5KLHsfRD79nltOkMea0wj3G5vfPc6kEuqgexNhDr7EjfUX3XOve",
+	"cDxs7jVTeOzmQf3l8DRFasPTDLjC3daOyTeCGKijtaxVzlAtLLcBRw05dE4y3PkA9w9Ur+/rrv8heVK+",
+	"jq92vFWmYRRHm4KdH9wxpC0polniK7nS97DEUh7yXKmqb2hZwtR9DtxZLQ48SXgvSwKNCdtwFDO4iKzq",
+	"9iol39J8TWhVkZyW5bwxD8kqK9kVK4FCuBBg4TJrahqBH0f2ukyUnTWDB45hJFqNMy2hWU0F+4NiZEPx",
+	"1bkBDWZVtvuEV5OmG9bRfKDYIGu0HETKxbOXfnXsyl2IYWgEP6xR+6vTD35ETsMnnFlIuzhr9TOeZwb8",
+	"hTdCC2ho3byhRTOFVIW1UyOX4orkUtkh7KveTQ7/YVQ1nS11flYplrkhFL1iStPSHu3Woh4H8r2v07nn",
+	"ZMI9FZ1MR4VppavlHNgPZWWmEvLEL/gfWhL4bO9DFlEPRwWEjFyoCvsYB1TZmaAB2lgl2VjzJYHb4yAo",
+	"v2kmT7OZSSfvW2sx9RKsXUTYoYstL/R9bRMONrRX7ROiW6Jh/yIeYzrRXFMQcCErYtlHBwTLKXA0ixC5",
+	"vfen7Ndym4Lpa7zn2s9YuWX3shNya/8zTfCW25cOMqn2Yx7HnoJ0WKCgG6b9bR+rGOaRL87pQqrbaRA6",
+	"F0xLgKQwasMcZ/MOkrBpXWXubCbeybZBZ6DGqXNcCOgOn8JYCwvnhn4ALGhDI+DvgIX2QPeNBbmpeMnu",
+	"gfTTzx2nkDj/4fSLp8/+AfoHCUoEuVJ0Q0Dk1uQzZ4oj2uxK9jj5nEPpIj36l8+9X0p73NQ4Vtbd0Ko/",
+	"lPV3cW8ybEagXR9rbTQ76d0BOIkjMrjaLNqJdeUC0F6yRb06Z8aAdvu1uqUibYzb9GZIQYeNXlcKBAvd",
+	"9g1y0tJxAU2O2dYoelxhSyYKpHlcB9dUa7ZZ3AtRDW180cxSEIfRgu09FIduUzPNLt4qtVP1fZg0mFJS",
+	"Ja9g/3IHjYLmMmGUeO1aENfCb1fV/d1CS66pJjA3eizVohiwPYAr0uT7yw59sRUNbkZvMLvexOrcvFP2",
+	"pY385hVSMZWZrSBInS2TCOrPKCmwI8oa3zNj5S++YeeGbqpflsv7sXBKHCihw+AbpmEmYlsQLohmuRTW",
+	"gX+PmcaNOgU9XcR4zxIzDIDDyPlO5Ogecx/Hdlj9tuECffX0TuSROcsqLYsVUxPwMd1sNYQOO9UjnQAH",
+	"0PEKP6N9/iUrDf1OqotGfP1eybq6d/bcnXPqcqhbjNMkFtDXm365WJXtoJEVwH6UWuNHWdA3QYlg14DQ",
+	"I0W+4qu1id6LtzcujcKYmmVUk0ZJCX36KiNQmsNia30PomQzWMPhgG5jvkYXsjaEopUAN7/WaSFzIMwA",
+	"/ZvRLdvEcivqJ0DvzYC6clrDauuKoNNx39wVOmY0tyc0Q9QMKHIbX1nbyk5nXdhLxWgByiAmiFw4v0bn",
+	"cYmLpOgxHYwcTsRNauUjuColc6Y1uI5Yrede0Hy7xvQyhCcEHAEOsxAtyZKqOwN7ebUXzku2y9C/X5PP",
+	"fvxNP/4I8KIyeg9isU0KvV19Wh/qadOPEVx38pjsrKbOUi0xEqXykhk2AMxhOBncvy5EvV28O1qsIekD",
+	"U7yf5G4EFED9wPR+V2jraiBqzT3TQcKDDRNUSC9YpQYrqTbZPrYMjeK1aFhBxAmTdm8YeEDwekW1eePM",
+	"fgXqNO11gvNgH5xiGODBZwiM/Jt/gfTHbmyRbgSi66qSyrAitQb0whqc62e2DXPJZcrOaSSpNds38hCW",
+	"ovEdstwLGP+gJvhcOS+u/uLQjw7u+V0SlS0gGkSMAXLuW0XYjSN3BgDhukG0JRyuO5QTwoXmM21kVQG3",
+	"MFktQr8hNJ3b1qfm16Ztn7icKRbmJIVkGg0orr2D/Npi1sZsrakmDg7vVofqHOuj3YcZDmOmuchZNkb5",
+	"+MSDVvER2HtI62qlaMGygpV0l3AItJ+J/Tw2AO5489yVhmU2+Ca96Q0lB2Pv8NASx0swzZ8lwS8khyMI",
+	"T4GGQFzvPSMXDMdOMSdHR4/CUDhXcov8eLhsu9WJEfE2vJKglfL0gCA7jj4F4AE8hKFvjwrsnDVvz+4U",
+	"/8O0m8C3ucUkO6aHltCMf9ACBnTBLq45Oi8d9t7hwEm2OcjG9vCRoSM7oJh+TZXhOa/wrfMj29370687",
+	"QdJwTgpmKAclY/TBPgOruD+xYSPdMW/3FJyke+uD31O+JZbjXXPbwF+yHb65XzOm3rCqNrf3dJ0Ge2ue",
+	"KZCj41boEfyZGFPurtlwvWAg7RUYiStMubMrQtt6pLy5j9d5YlTCbeA0LMDHbcGjIm7CtjQ35Y5QgJnt",
+	"yDVTjOh6YZ0y+hYiI6ssHiBpcRqZ0dmbk9beUQP4OQ4VLS/lDGhfOePwXXSeOi10uNdNJWU5QefXQ0YS",
+	"gkneMKSSsOvcBXH7MF5/NlpAumuo3Hlw3eUXoxlXQP5H1ujt6n1dvZQmFYo+0Bdn4Dqa07nsNRhy7qgB",
+	"O0+edBf+5Inbc67Jkl37zAdPnvTR8eQJaqZeS21a7OIeNLzAQM4SF2LXQa/LJff7cLmRJ7m3dQb3k+KZ",
+	"0toRLiz/zgygczK3U9Ye08g0/zWznbjyi7bHU2/duO/nfFOX1NyHHY5d0TIDH1jFC7aXv7uJuRTfXtHy",
+	"l9ANszqwHATiJS9Z+u0LLWp7rmyzsLow6jw4sP3B8cFgzZAoPC/qpfMCcjE6LijHvoNweqNozrIcUyE8",
+	"vJtrD4SJ2GQX0McmcIBxuOCG++DNqVvCzmyvc9tpj9qgcZfmmw0rODWs3JEKLtjCWlK4jrbliOCwJF9T",
+	"scJHoJL1ygUp2XHwyqu1VbeBWbI7RFJQNluRoeEidQU610N32aGIzCg807tWDysoXNMwHytaN+PEPeha",
+	"gZKGz/lsUIsBSL1qtBgWOe38GxOuw5YMH+GnmXiieQxRB/JsH1/xtjTsBJQSDJnMffCVbcUVG1KU8g2b",
+	"R4ZKgm8HPPiskvl6jv/VFhjCNSm4zqnCUEXjs9kgsdk3d5q4RmgfdjwoyOQynm7eYUm68x11y8A1axo5",
+	"3KK2RooBSFzXjA+AEzH6MK+fL2nMR8+hbGoshDPBxYuA2IdCk8Fz6Y3Kw/vnbc7EjONzAsl7Wmk84SOE",
+	"tRcbgzblHFi+hccghdqbuW3xYWyQzdCDoLUmjsIWm49DkYugPyx39/DmsQPBI8xGgOmW3l3br3IZJ5py",
+	"RK932rBN3zRpu/5jgDzfDCrApCi5YNlGCrZL5lbkgv2EH1O9rZQ80BnfK0N9u0qVFvwdsNrzTCLBO+IX",
+	"d7t7PXVN8Po7qe7Lx8MOOPnNP8GlYq//kJvyto4f4Fvf95VwaWi6t5+eh+gDrgjVWuYcWfkZhCxy0bhX",
+	"uFDENvpfh+D6ezh73XE7TgFxhjM0erGyIpTkGIIJTbVRdW7eCopK92ipCa9Ur10cNsN845uk7T4Js4wb",
+	"6q2geE0GVXzy0lqyxMPgO8a8NUbXq5WV51vJUBl7K1wrLkgtuMG5NnBcMnteKqbQNfTItoTAkyXQhJHk",
+	"D6YkWdSm/fjHLEvagFHHeijANEQu3wpqSMmoNuQnDv5vMJz3YvJHVjBzLdVlwEL6Cl0xwTTXA8GC39uv",
+	"GKjklh/HDrrO3ov+oV8yHnZeDEJ+9tIpxs5eovYjij3qwv5gBs0NF1mSyGL3tA5tkc8w350joMdtbb9Z",
+	"s7cCfA+NhHSLvKDmduTQvWF6Z9Gejg7VtDaio933az1Qp3AHLkMSTKbDGu8pTQAsPp1tC4VPl0ALWpFl",
+	"LexW+qenTSbTBIvOQ0Y1m2z5BcF0W2vqvdbdn8+++HI2b9Jkhe+z+cx9fZegZF5sU8nQCrZNqYriqK9H",
+	"mlR0p5lJcw+EPekbbJ3V4mE3DHSMes2rh+cU2vBFmsP5GEynct6KM2EjluD8oM/GzpmC5fLh4TaKsYJV",
+	"qUD9N21BDVs1u8lYx4/O5h6YE37Ejroq32Llo/wpBu7HySEmsIlwDiyheaqIsB4v5KCw4Q5ZxvFa7vLX",
+	"9/4ccgOn4OrOmQpRePT9txfk2DFM/Qix5YaOMqkl9Ej2Q9vD0hDaCpJ9K96Kl2yJqjcpXrwVBTX0eEE1",
+	"z/VxrZlyqRSOVpK88EllXlJD34qepDWYHT6Oa6/qRclzMNClyNNm/O2P8Pbt72DUefv2Xc/ZrP98cFMl",
+	"+YudIANBWNYmc/lKM8WuqUoZ83XIV4kjY+/RWa2QLWsXt2/HJ278NM+jVaW7eev6y6+qEpYfkaF2Wdlg",
+	"y4g2MgTYch3yEsH+/izdxaDotVcq1ppp8s8NrX7nwrwj2dv65ORzRlqJ3P7prnygyV3FJqsWB/PqdTWK",
+	"uHD7rMTgm6yiq5Tq7O3b3w2jFe4+yssb2AIQdLFbjJMQMYVDNQvw+BjeAAvHwRmOcHHntpfPTZ9eAn7C",
+	"LWxnkbrTfkVJwG69XXsSidHarDM428lVaSBxvzMhZbVLdWPdy8COC4fAZfdeMJsbx6VdZpvK7Oat7nLZ",
+	"EjQ96+BW9+lCpjHXDtonIVF3VXitJBW7bm5ObUPEcNA37JLtLmSTUfaQZJzt3JB66KAipUbSJRBrfGzd",
+	"GN3Nd26yPnLepVjEaHRPFi8CXfg+wwfZirz3cIhTRNHKXTiECKoSiMAOQyi4xUJhvDuRfmp5XORMGH7F",
+	"MlbyFV+kTHv/3TeHe1hdjijGr3yugzCgBgs5N9pnIXHPewUGJkLRX66Smpa2NETSCw3fQ2tGlVkwakaN",
+	"XCLOquehg/7kGk6W1fDNYQlsC/vNDWrsBLtmhVMU2TYuHONo2KHWAs6KW8LjuzcvhaPBt65DXSJtur+V",
+	"A3bDs9b5Gsd0drEO3zHb0ErJa40JxwoiXckAm5kyul9qTVcD1o6WZ8DEpH4tgz8Osk8iScogYCtuixo9",
+	"SSAJsm2cwZqTZ5jBFzjE+MzseJj7max/iDOYYiUgh7BFiQJscMW3e09Vy4lCrMZAS7MWpkQjCnow2hiJ",
+	"j+Oaan8ci3nEZSdJZx8wd+VYfu2zyDk6quwQsmf727DLQXvvfpdl26fW9vm040f/hNzYNitYnd4OKVA0",
+	"LVjJVnbhtnEnp9UjHW0QwPHLcom8JUv5WUcK6kgAcHMweLk8IcTaRsjkEVJkHIGNfk84MPlZxmdTrA4B",
+	"UristdSPjVdE9Dc7GkwXhoJFJiu4XPmAsT33HMDl1mkki06ICA5DuJgTYHNXtIwSNTaD9NI844Oik9TZ",
+	"ed49HnpojJim7JV/0Jqwx61WE0uzHui0qD0C8UJuM5tyIfkWWWwXQO/JYCzolTyYNqH2I41JycA/Fa8W",
+	"G/yzB5ZhODwYDQCYKRnWjv2G5CwLzNi043Juigo1+SxInQ25DAl6U6YekC2HyOWzKEf2rQDoqKGagnNO",
+	"LbFXfdAWT/qXeXOrRSZ/H+eaOv5DRyi5SwP46+vH2lmtf2iylw9nSHaNHiadd1+zdJc067YzAqIPyrLe",
+	"JYcWECNYfd2VA5NobbXq4DXCWoqVYHrLnlGyjzbNSoaP4KwlmmaXbJd+yzO8x899t0hZh7tHxe5x5D+s",
+	"2IprwxqjkXeK+xjq+CZl6eDqTKWWsL43UdbZOFlpvMwHXwGGFC25gtgVsLgllwCNvtOoRPoOmqYl0NZm",
+	"E1sxjRdpjovTQhRqwcs6Ta9u3h9fwrQ/h4tG1wu8xbiw3okLrPCXjMQYmdoG64wu+JVd8Ct6b+uddhqg",
+	"KUyMmYDbc/xFzkWHgY2xgwQBpoijv2uDKB1hkFEGjT53jKTRyKflaMza0DtMhR97r5eaz+MxdPPbkZJr",
+	"ifKapn0t5WoFoZ82XZm3h4koK2YpxSoqRVtVY0lAj6D+kXapNEeycLooHDYUgxOJ+xkHi20a+qiZhbwJ",
+	"FcYMojgJmOkx/1JaLSRXeyJ8sEWkq3tgW2g3/icZA3HRMWY3Pqt2l8J24gaUjBbuTaKZX9/4sexviEPd",
+	"fCh6olW+YfwI4YBIU9xE1Rn7eVUGGDCtKl5sO4YnO+qgEowepF0ekLaQtbjB9mCgHQGQJLhWPSAXZ+AU",
+	"7Mf45j2GV5kNPHBe9UDfNHcZRYpaoQWj5dbfLz4V3moT1/7jb+dGKrpizgqVWZDuNAQu5xA0RKWdNDHc",
+	"upMUfLlksfVF38Zy0AKup2MvJpBugsjSJpqaC/Pl8xQZ7aGeBsb9KEtTTIIWhmzyF30rl2sbq5LClRBt",
+	"zS1MVcn8Iz+yXfYbKB1IRbnSjXuuMzu1L98Ddv1q8yPb4ch7vV4BsD27gpqnNwxpMKXpD590lPz9kY4x",
+	"Zp+XrS08YKdO07t0T1vjKssNE39zy8Qr6izlLgejcZIAWKbsxnnaNwFOD2sjvkvK+zZhKDwk6hTL+/FU",
+	"XPs6/P2rKCTX2Ue7kBnTEy8uZ3Yzn93NEyB1m7kR9+D6dbhAk3hGT1NrGW459hyIclqB/xYtM+cvMXT5",
+	"K3nlLn9s7t0rHvglk6bsi29PX7124INJumRUZUETMLgqbFf9ZVZla9GNXyW2fIFTdFpNUbT5IcV87GNx",
+	"jaUKOsqmXmXHxn+mGc/7XCzTDu97eZ9z9bFLHHH5YVXw+Glsnti54+RDrygvvbHRQzvgnI6Lm1YeNMkV",
+	"4gHu7CwU+Xxl98pueqc7fToa6trDk3CuXzDXbvrFIVwmXmRFzvmH3rv09J1ULebvwnKTzkMfTqwCIdvi",
+	"ccBX2xfh7wpTR8QKXv9c/RNO45Mn8VF78mRO/lm6DxGA+PvC/Y7viydP+kDb2y7NJFBLJeiGPQ5RFoMb",
+	"8bAPcMGup13Qp1ebIFnKYTIMFGq9gDy6rx32rhV3+CzcL2COhZ+OpjzS40236I6BmXKCzociEYOT6cbW",
+	"/ddEiq5PNUaAA2nZ7DC2xow1xvaPkKg3aMDMdMnztGuHWGhgr8I6U0Jjgo0HtLUwYs0HfHNFzaOxoNmU",
+	"JNAdIKM5ksjUyTzUDe4W0h3vWvB/14zwggkDn1SoKhhddf5xgKP2BNK0XswNjH2i4e+iBxmxN3ld0JgS",
+	"ZNR+9zLYlPxCU5VLD/QAj2fsMe4R721HH46abTTbuu2COe0d4w16SfWBsyB6RueMdQNzNEXSsZ9NeMV1",
+	"tlTyD5Y2hKD9KJEHx02EzxHsnfLc67KUYFT264ln37fd09/GQxt/57ewX3QonXybyzR9qg/byNs8enU6",
+	"//x8Fh/JNFz2I2mHBgywFjxekTMsJnDx3kdU2PNkU6C0IszSpzJqoY/t+M2pdDB3dzUv6fWC5pfptxDA",
+	"FG1vy0/KSOI7+w3QIcGHnZ1EHtyhLbepMSumGhtEP832Ld81dtrJL5rmAQMdW08XW6aVllomhqnFNRWG",
+	"eTcGy69cb82sCR56XUuFiW112qWrYDnfJNWxb9/+XuR9952Cr2Amm/aV0KVx+SvcQMRmz0UqKriuSroL",
+	"aWscas6W5GTenEm/GwW/4hocmbHF07krDarxugzm8NAFlseEWWts/mxC83UtCsUKs3b1b7Uk4e2JQl5w",
+	"TFwwc82YICfY7ulX5DNXzPGKPQYsOiFo9uLpV+hQY/84Sd2yBVvSujRjLLtAnu2dtdN0jD6pdgxgkm7U",
+	"tPf1UjH2Bxu+HUZOk+065SxhS3eh7D9LGyooICQF02YPTLYv7iaa8zt4EdioYNoouSPcpOdnhgJ/Goj5",
+	"BvZnwYhruxpJtNwAPXlG6g+bH+4Iz4bl6QEu/xH9Xyvv/tfRdT3wM4Zu0vRA0Uv5Z7TRxmjFEq6YAIM3",
+	"numOIR6RM58sHSsChkKAFjcwFywdZUnYQswSxoVB/Udtltnf4FmsaA7s72gI3Gzx5fNEZb128SlxGOAP",
+	"jnfFNFNXadSrAbL3MovrC1HwIttwYPWPmxwL0akcdNRNTmuG/ELHh54q+cIo2SC51S1yoxGnvhPhiZEB",
+	"70iKYT0H0ePBK3twyqxVmjxoDTv065tXTsrYSJWqgNIcdydxKGYUZ1esGNwkGPOOe6HKSbtwF+g/rv+T",
+	"Fzkjscyf5eRDILJojgXLgxT/209NKQc0rNpIxI4O0GVsa8vnTm/3wN6Gh2nduvZb6zCG3wYwNxltOEof",
+	"KwPe9/hz0+dj+At1QbJ73lI4Pv0nUfAGRzn+yRMEGvSOtuk/n7U/W/b+5Ek6o3pS5Qa/Nli4y4sY+6b2",
+	"0Gl99IXiNh/bN2teplQuJIcPli9jbQSnuqyo8bXI43rrTeKLKcU2L6L8QDC8yDCdBE6JYYs2Dm1DuSjs",
+	"RQs/uJTDzr+86XFEfnHlwkMuGwt7BLETKG12Cz/S3JmfMZ7LpUMOt4yrw/IRrpkR9z105LRaXbmMlgpr",
+	"nBPFSgrBqLBa5xfGhmIyAH3Dwa/NyFx7XAMVTNB/BV83mGASCUINsRQFBrq4A/3hIhQbik5yXz0yYaK5",
+	"dby0hBCclaaVSU4frn1+MwHGJLZkghR83eTgAegSmvTXPyhVwgeQWhZuqDlp16h9eLH/fgIy0+7h6WsL",
+	"vMHhi8cD/tFFxEeWbnADm7Ci4du5XaM7STJF+B4FplDytdxOJZyO0OiJ50+AogGUTNSn40p6NciT/jV7",
+	"HbwiGoVRFwz8wXWrLGFsgPvr4BkWPx/BNuTg/a1JxtiR/BQV+Trp1o/Je/9hH9UtmdnKNims5WsqBCuT",
+	"w1ll1D+85JFQq/1LTp1nw8XEtt0a+Ha5ncU1gLfB9ED5CQG93JQwQYzVdp67kEelXMnCZkBuymo1zPFo",
+	"ltirfontHgnaYTe1cY7mmLzBZQhb8hL+N+DogS0zRc1AxjvlchiHEdkVA9Myaljs6EwRyjcoSWsKtQ7x",
+	"ZF4xcOiFrlKwTnfMeYgjRzWziK7gE7bEDDOSmFrBdb+MlsGE4YqVuzmpqNZ2kBNYFtvi3LMXT09Oknpq",
+	"xM6ElVos+mX+0izl6TE2sV9cmUdbjOggYPfDetNQ1CEb2yccV9UaSxWkeCp+sKHm0BlvbVvROlRfPyLf",
+	"Y6oyIOJWaRqAJqS8b2fAratS0mKOqfjBlY7YWW0fxRBRWFF7BfB3yD9pD52eEdinYhtIdTV9nPHcOzbp",
+	"eDaSq/wVtmhKdPOOkxwq3mPsHJGX1uah/QPITkKwoIPasCLKfW61bkgc8B9jaL6GBrIlAQ3zyuml4D07",
+	"a0ytUbjwlf+IDBvgdtXgbTH4OZHwQrnmkF98TQ27Yu38pR6MINO7fKbt5alaCEspRwcIo6Ha4qFo98Dh",
+	"uMELKAlZB/EHqpK1rFXODq2Mf4690sFTnTL7HTcdnw3TF4QgPzlrYE6FFDzH0kUpSRpzLU7zK5hQ5Snt",
+	"EKBn7oQmDleyuH8I3ndYHCz3P5+1ENf30Ym+wqZa6rB/GrZ1RV9XzGjH2VgxRy0vL5mzYHOhmaunCUQU",
+	"80mpEl6IyciloEo4kIwwjdqASeI7+PazM1jBESSX3JZJcGhz7zNrY4bEM0DtgnBDVpJpt552+J3+Hfoc",
+	"YVrVgm3fHb2SK56f8xWOYf1eYdnWybs/1Kl3+XYu1tD2G2jrKr2En1v+m3bS06pykyZD0MMO9z5BNZMh",
+	"BKccDb1mJEJuGD8ebYTcRmM18D4FQoNyHkQbVuE93CMMplTqhfitLQICFIUtiA2BTiGl5CIBxisuvM9D",
+	"+oLIk1cCbgye14F+OlfU5OsWG9rn4T0QsYQpBfLL+xiqs8GIElyjn2N4Gy+2wtXjGWAcoUEj8VOxI/5Q",
+	"AHVHwgTEKwffeRSC2uYbkKqcEFVgNKBL4WvFsjTjAMad+RjnFrr2xtuG7lg76tCbaCip6KIuVsxAwspU",
+	"Lrqv8SvBrz6qs6nPJZdROG+7qECf2txEuRS63ozM5RvccbqCa6o12yzKhJ/3y/CRFWGHgdJANwn/piom",
+	"Du+Mi3I4OIzehzQUh1XS6KcFSEm9QNMZJEybjgm8U+6Ojmbq2xF60/9eKd3H1/8pwuc7XC7eoxR/+xYu",
+	"jjjTdi+gxF4tIRE2Bm9I/O4zlIUUrm2uBN/6dUHRTQk3L7FlHeB9wyTgV7QcSF0RGzft/WoNfkMJLPLB",
+	"fCvUuHx6hpJRFjSYo8w693fMpX2b/5BDv/Xnvz8zo1vrKEKHje0/tkzr1qmzYRaDJvXbWb2bDT7U7P3j",
+	"1VBOE19YB7/HBXyc293c2RzZFZe127AQtOCfhPZXlzOrVahnYP3JUKCPbbUYtLFcuAr6dpnuTf7jb9Zt",
+	"gjBh1O5PYHHpbXq3ClRC2sUWEcG6J3BPazbwqG3dilOKTqXqGznZ0OvKLGtp0VKvXlSPrF5OEQd6+LiZ",
+	"z86Kgy7MVI2smR0ldexe8dXaYImNHxgtmHq9p4RIUzYEj1glNW9qoJcwmMvZvMbhjqZGBwEB87gESn8s",
+	"7zV+xXKDhe8bb1jF2CEFUWAyb/T5VEpk+DkdgqhcBZGxsiH9avd77vheprMoWx8LbhATi2SchpgHG7IJ",
+	"ZV1DfqVOkoPJodbLJcsxjfloZrn/tlWFfdayudfLICzLKNEcD4GHmIj/cK1jA1BJbwlPSe8PnKHEE5ds",
+	"90iTFjUkC32HqNvbZPpGDFgTmE/6PqRIdm6eXAfKQCx4H37bnTXVbAaTtEd5Em85lydJQuPciSNTXknD",
+	"bjkXdD0oTyvG0A0ln3vNmHrDfKbyvUxLhab2ZqiYT7K24XrBIC1xgVnFIedfwrZJhWBFVgvDE9v6q+Db",
+	"yKISVQTGDlGyNZwWOCWONyfSebDxZeuzkMY1mXwMwilYUJHgR02mR0w1CioLSMRMhZ7bYORCQhZEJ8QW",
+	"tQq42lMXeN+hRKqRyyVLvgwvPAvwu8ClajABJKRzqYY4JlyojA1EneEIZ6+bTAKKVM8q93P6+ONcqToE",
+	"DWzYZE4KlttYLYl2KCjuQC56+2tPBDdEAYqb+pNLvqphUUDDX1NxsVZMQyhDBJTTp3YPBy7XA+r2On06",
+	"MFVxJEwOv85fMkN5qZ2/Nw159GMdFqjju3XgrqkOJ6axLPqM/Ez733xKXDtLyS9dORzkGdaOC1mUfYt7",
+	"yXGIzQhPA70MM/MmHrHvAtTngDa0Ny8lCNnZUHx0OwQw+M8/0jbQoclHh3AtmVKsCAbDUmqWGempdgyO",
+	"MVRojOa4FRL0YDU/C9xgJYc3TakKrGpKsXIDdUEc8QIjH9+moMTwnGPI/sZ+9zllvCfwXv1roNf95cd9",
+	"JCrXPSTGVL8k7sLZn6vmNqpYLgRTmbfLdqtLiHaCUUwjXdS5FV/jgxHU1ZO9W0dYSVKLmfdX2XlBRzlf",
+	"Ltnu2KoIXPaXsIMx0PZdYUGP8md3NvleldM6BffqXsD7uGlRKynLbMAUeNYvidGl+EsOLlUEbgofsQUv",
+	"o0ftswGTkM/QAhV8Pa7XO18CoqqYYMXjI0JOhY2R9W4f7Wq5ncnFIzM2/xZnLWpbpcapnI/einSwIdaP",
+	"UXfkZn6YcR6mmSjuPJUdZHwisxVDDmnXWGumXZT6aKrOqu+I0RVLGqKyUKRkknNrz/0GD3pKrYoZfaLU",
+	"U2jmp8TZgYkuZcrT/TZZh2CoNKbiyRAgw8SU5DcBCjd4EgHOxy2qu5l8t5Q0dwcADSPOP9ltvI2iBluJ",
+	"fSb4eHd0kcCQQqN9CY1OtSvraXjJKjO3qaXhTxBgFS8KJlyqwVbXuJBlSjIbEYFcnMa8TdYDAs1wUcJ2",
+	"AgkWF+ZRUPwPT8jcOS3cQqlySG24zvy+A14KYRxtpX5baC2ddOBeSosNVXC6WLOOKitZvmke6jXxpSek",
+	"F/dSxukXcVD/qUWcHryEUzrBz4SD/Ys9UglC8l90VK2ifbynaxgv1q3D278YDsmAFx/zEYudJvZd0c27",
+	"1ycuX90JqYsxZ96ZJKIFbMYVqwJOU54nA5WFTuMqNh8GxCiF0xiE3og9aczodhjSjY0SYRJtfecxZnQT",
+	"5awTmzoxMCabkO/3/jP6pvzsilk85h4cHYyZVibVD42aO2Y2HcJOa9hRBKUIO42pOGGaQ4tXIUwXFuy3",
+	"oRRyo1niLhL5yqKOIxng4mv9VinfPExjmPxabicg0AVyughXuZ1blQxCZNoM6lZURuR18FBYyIFiD2m/",
+	"34soaUjU/U/jZRCh7iOBN3TanKvvfna0p+SF+xxdtYo1Pte3rW7hCkYMXvrWAN6dOczSVoAspWLxjPhK",
+	"sZVsQmIXuAwJ/mfBjaJqd5saFG1UpZwNBrH8Wlb4b/HGYe8U+gysXDVRolYb69/hsFK+clVvLEriN/Wc",
+	"aOns4UZbzwtMnGLx5hKaAmEU0SZy0+CVixYeT7vPNyPBQmo9Xh3eMY+wT2oAJlQE3gG8GY+E6q/cf+2s",
+	"O/yMCx/xuNwrbw4FU40ysMFtqKoRkKYaqaZKlOGuGQTHfrx3gAbKhl6s3e53wIHA4YaCkYrIiTe5g4Ny",
+	"+wDbyBM7kK83YNVylvFDbiIu4EbSLIx/9guxPtPT8yTEcdkHHd29gYch5rA5O03cYf8AlKW8znCFWSjB",
+	"nHpAQDvdVqy7YqFN6WbtjmQTwUi1M7rsyJoWJJdKsTzukdYKWKg2UrEMio0lkwC/4kujSck33GiCFX5X",
+	"RFa5LJgtZZ5m/kNzOU6UBU40iALPvmSCe02cEvTj1mM6sybQqQ+iC+hjk6o2BQfsojNLgQOx+Uy7AgMO",
+	"Q7ZxH14kHJuRu+s1lxaUlnyLdMNU6rZeEqNqNieuBY7eIiG8HoCXb7jWFpRAS9e8LDGnKd82VzkLIToD",
+	"+iR3s4WNzOjA1faa5pdDt9CwANEEXPVvP1/rDrhK81ZC3JE3VirSZIDc0qsZMMidYTj0FceYuXa2XuxB",
+	"KsVyFlIYx5foeVxfgJi1kvVqHVVyDFj3riqqdo4s8Si/6hrDGpEdwhTPyUZq42zgdqRmA5tQ0c9yKYyS",
+	"ZeltQ9aZzDkCOA/Zn+j2NM/NKykvIevuY7S4o1rOrbSY+0Sm3aDeZibVqeER2yDQIie9/mnq2WspEbSP",
+	"fsPt1fuL69l2AK5njgdrWByD73nF7nMzjcB8t/9i2e90e9pfWHdd7Tsmbak9FYQaueF5mtX8tcJtB4Nk",
+	"B6inb7z3J9LRcxz53z7DqG63XJAVviYJvjLceUQnIm+c7bovNeO4LNGK2bNVVzbrQlvqJpppzaXQh4jO",
+	"YZntWsRNslJ9uHaxo06eLDv3YOnpriLB+jZ62TGQBkTVHkzRu909YLwXyu31sbGy5SABM5YxUofW9nAZ",
+	"zL0Dl25JmyEOEGWcPukwQZPGpVPi7j4XD4VULCur++mNS5aMmt7ckaSbkA5sapUJMyOINp+uqRUqjGgM",
+	"gRvJ72BbrHI5V3Y+Qm9OqnaQr49vF0zZIEmMl42khG8RQy/ZFSsBcaevz9ILcjbaLB+0JO9fV8vOGyQD",
+	"ubIaQXwEdTE/Uc7FVd0NNhjh3oEy7E5A9TIPBAA/s9xlbm0H4S3pvz9uKjXdCvg9x7Z1bw+FV583Z0Vh",
+	"k1DVYeAyTteDHY1FvsAc0YupEclBVp745ogAGI5RbsEwKVL5UDCWlJesyKgZENDRA24eWWad73M0Oney",
+	"tVeOWaEbHheUl7VirsqA1Reqtht3nNQTmvf9VMHnkVlV2R9MSYwxK+ZR7INPJ9pxNZJVVgLnaSvzgJZ1",
+	"jQ8j8Jd2fXXoTArGKqYcV4u66jHtT+LWdGvPoqjWKdhN+mlZxNqdInucsJI+1f45eVegxt6YEiWwJQ/m",
+	"5PTTEtcy768incl9KzJ7vvVUHgBQX/Gipq2N14cKHm3vSOBBiT3uvX8zj46p0/xqR/AKbH3q+6eePx4T",
+	"76Yx0IN5Zxp1Y5xzb3KFWg+xK5HOrRAXJAl+5zhbEaK37NlsGJ6u6LUY9tPsn9VGczVxn7gUEWK/3bIc",
+	"5UunOmKFUx6N6t/tKbJysH1prkTCCXnNBBGy0SChk6bXkzSV0vwPdmJsxIVTTN7CZaRJgXD3nSU4GNGd",
+	"kknJnfCcqUhpuqYcoCEbUIt53M0j+qOc8tFDPjheiv40c/kIRyyM/uQ4NQg2kHVZEAG0AroIiKfyV7u7",
+	"ReZkUfuBQB3Yt3W9ZD70xFK297q3K/J1jNA4Z9E9H7anhQQ6YA+TCv8R0pB/17Tkyx3yMAu+70b0mgJ5",
+	"ulgXG6Lo0lLAxOMy59wD5pXi0k9l182njhkNt/O3pBsJpBtnVMVSP5cs3gaMvrS8OTfAlHW9QAUzyDGd",
+	"7exjwS3eF3nY0CJWYWKpuV2L88T38//eJOeLp/IVotChtfCbp+mm49mNEmIgrtvYLD0JNLbLQLTBDFbc",
+	"wih9d8um9azbB3b0tmp51d3TMiba1jtV+g8y1SaWct+7cCdjbubdAPeA33YZfAj8J6tAHmiTboH/Z8H7",
+	"iM3aw+ts1x8ey+OmZ29UXMhtpthS74vpw9YdI3uwHbUM5Y2RPBQ55AK0AzZJRQgjCaMUbMlFwyy5qGqT",
+	"eNyhFlvsIoTFtllE60DUwpCUAILqFS1HtPUXGIWCCttOkXlvj3Z9E3qdcKf2B+C6eUNiwsjG2hk3gwu8",
+	"4MslUzZ/hDZUFFQVcXMuSM4U3Pvkmu707Q3/wYa7z/RPI2mmncY4cgJA0raAlDsXh3NHs3wAkN6jfX6C",
+	"Xf1izRz1t5W/Vt9l5IAZvQ/DX8KuvqFbcMXAtIYDB8JVt0RHDGxGpECznJXPpq3bz6P5H2x8GiwB4xiR",
+	"kTjrlCnGz/0vuJX4RP1VcDN68q3itptn0iYCsQfTI1WsmmxEllj657HK05N1LAfBZORyZ3naY9EmsiHD",
+	"d8tYMLCLGHnm8srGloEDjGOt4LbEDeO0DhlqI/RIvqHGQoa41k5N1Yvw7aoxLFLiSKgD1I/WaOHvpQHw",
+	"nD+88z1sTRuiFNGkM1n2iULy0hBVssryKWH2tvZ/YQHwkLZhHHOMGKWOEJGoveW5RY2RyPtIkyZU7lDx",
+	"21rL/Vx7re9VPvboH1JBDXD0tl1GLpGXOfsfaM6kihU1827Su7aKLTAJQoliea1Qd35Nd0lHZEzgnLkT",
+	"P1Bz9vyH0y+ePvvHsy++tPXDCr5i2kTuRzhIYBshFJuLrk7pYd3We8sz6U3w6ZDxc7Ay+yxvYVPcWbPc",
+	"VjdFCVurP9RonrgAEscRw0ubhEO33iscp8k19OfartQi733HUij48HsG/mfpuvFBrkoYcFK7FdmV4AVS",
+	"MaW5Rk+OtlmYmyYJhV6jehCrh17Z9PZS5Mzrph0VcDPgEZhayFAOA+Rn8Ik4qxVh26p0vMqav8bW5d5p",
+	"VkOHQiO6F4EWy6uO4YZNQYT+1SpK9ekUn6htj9ISBGZrExSkSwFiso806Z06GxnQ1zi3b6ynnlEnOD1s",
+	"YkK88IfyFqQ5ZPsYTqR8G07SmA3+NPwjkRn63rhGWO6H4BXJ98FIEtTTnjNIyIo8CbR+luAEeSAAA+k/",
+	"W4kbo8x1UWVEZa0EaE/wBuyu+PFTY9jem4kHIfEd9oAX5/Ns2gX/RAfOR45T+ykgJVrKuyFKaC1/X7Y9",
+	"z3rDRRJtkVOaGMO0ZUuyLxZG+V/1NyGt6sCrpJd9VUlpiBSgG0lkbbV6HDxTMeFwYZi6ouXDc43vuNLm",
+	"FPHBijfD2aji1J0xki0q9e0KB72ik+Yu6QeYWrzGTLH/zWCPkvecG8oZ+Hu3GSp3aGmjYJZxpd9rHBN3",
+	"mjz9kixcuf5KsZzrruPAtRdOQqZKpsA6FjIfjqfG3LfO36S5AxkvvXsS+bkVmuf8ARyEzRH9yExl4OQm",
+	"qTxFfT2ySOAvxaPiIPg918UdS7vfLg99VFHmwDz0/fD+qcvDdeClU2vWX+fk27qF28RF3axtahGFyRXi",
+	"37793Sym1D5IV3OH7lh84V7Kuh9U1P0DlF2wOHJjuHlTFPPbUCE+W2xuoFhoZz9qVx9+1KoWl36FrBVM",
+	"MM01Fjf9x+LL5w+f7c5DYFMi9I+qhfUu+estYhJrbU0eTRUVdZ1Qz9V1SxThxERyea242Z0D/r0Cjf8j",
+	"WSDi+5Bs3CWrD7Y0d/cZecmE9/doUpPX2t+u30ta4n1kTXyCEQO5mMi3tuSoOyh/f7T4T/b5354XJ58/",
+	"/c/F306+OMnZ8y++OjmhXz2nT7/6/Cl79rcvnp+wp8svv1o8K549f7Z4/uz5l198lX/+/Oni+Zdf/eej",
+	"2XzGAWQLqE+c8GL2f2Wn5Upmp6/PsgsAtsEJrTjkc7+5wbfyUsLyEak5nkS2obycvfA//R/+hB3lctMM",
+	"73+Fo6Sg+dqYSr84Pr6+vj6KuxyvMNtqZmSdr4/9PDfzDsZPX5+FSAzrh4M72miPj2YNKZzitzffnl8Q",
+	"F+cQymfOTo5Ojp7C+LJiglZ89mL2Of6Ep2eN+36MBb+OtavlexxCam/mvW9VZSv9widHo+6vNaOlWbs/",
+	"NswonvtPitFi5/6vr+lqxdQRRpPZn66eHXtp5Pi9S0t0M/btOPYMOX4f/ZXx4g49j33685H+wXMiadOE",
+	"mEs0qXv56pHu+IHA9oRtPCtg+2xLdN7QZw0jxS3yNuvZi99TuhvblVSQbzsn9vpH+ofNjcgz5EFv2A8q",
+	"6maW/cJCGmYKDPIk++rd+y/+dpMS0no5rZ1BsbGgOHdhDH/FqI8jD9e/a6Z2DWBo7Z/FYPTNjelyMFtD",
+	"KlfJ2c0GUbWsEWMtTwreqotdu5KO7zQAGAyRgitg4d18ZpUC2jLPZycnnnM4uTwirmNH7TG627aLnl/R",
+	"IRloY7+flFAFi8kQH4l889pmRAdscuECG9EVeEMvrdUGHfKIcukRHEad/zAiOQTluG3xl8MBZXGbFMkA",
+	"S1T/PjYPc9i2kl1RMaXAh52pL9Tc9LntwAn0rrixYq3kVm3o3KMgy5R1aWyy5t3MZ88PpIZRBVerIFoC",
+	"/J9oCSCDIr3xH3x+8vThIDgT1mMUri17vd7MZ188JA7OhGFK0JJgS3uhYoR7guLFpZDXwrcEWajebKja",
+	"oaRjpuyxS0yPtkjfztK9vZgpnOHfZ5YtY2X1iikOD05azt7d7Ltejt/7dGA3Ey6jqPWEC2x/i1h1f+y8",
+	"qKMOE6/e0Xt2IbcHNO3BvK8D01HjYYyiJk8fv0dGMfj7sTMopD+iTtAKm10wuy1tIvD0xxbO35ttYi+7",
+	"Pba8iMbLwWOkro7f439QboxWZKtuHputOEYfquP3vOh/7iGi/XvTPW5xtZEF88DJ5VIzs+fz8Xv7bzRR",
+	"63w0slVbTvo2avTNmuWXs/QV3ClJHPUiVqwGN/TC8sjnEzoIaeJOt+Irb1AK0uSXH8Hix7pTcO1nOIB9",
+	"sC0mHFDHilUl3fW3z+ZAONZ1VZW7/s87kSd/7A/UzTGc+vnYP/tSIny75fvWn+0zWTGm9LGKi/EMfDl+",
+	"D79EXfW6NoW8jrqgrtUaCvqLCjmPW38fX1NuQHvi6i/RJWA40VkxunF03fxsGC2PXQ32zq9N2dPeF6zl",
+	"Gv0Y8YL0r8fUbd6skjpxUt7Q68hueoqNrWzEtPlaFruRe3mbLbhAoo3v5kbzYj/2XwU384REhy6G3njV",
+	"TxqP2a6UpEVOtYE/BDPXUl323ik3yZP+0HLW17QgPklYRhqp69S971tL+3PIYEkOF7IiEKnIPnb3kaW4",
+	"L04+f7jpz5m64jkjF2xTSUUVL3fkVxFCl27N/b9D8lbg1wGvm0Dy1q8VCirElCNVwunZ+US6AxLlnWLE",
+	"bMmaiqJkKniVV0wBbcL4mFbKO0zBraldnSS4PKCBLZXECutCoo/IeXCwQXeV2j8QC0s2aE+CIdwkFJ1v",
+	"rAF2wu0FWmrgBysmMseRsoUsdpl7eCt6bbY2VUOP7VkJe4An9iTV1FcnWw008h73ez4f+5w+MQfuUJKh",
+	"yvoT9DIBEeR7VQh642BjtNm2ME6l6eEygvp+WJlhTTkWe0PfeCnAaZ6XPs6xsY6XbGl6Kg8pGGyvAx7H",
+	"K7jOqSq8DxV2q0XYcrNmXJGLi1dH5FdR+qSjmHEMQ2fyNQdvQXz/24wraDz8gWsj1Y5oZuYefB/foGV3",
+	"Uge18kbhpXV9rwPZuqIsXtlVurxm5JqLQl6Tzzr5zuwwmizYmjsbs8tuhh8ez4NTlceskrXL3SCcvopQ",
+	"m1IAAcKDQbQt0qZlX4OHu924yDgM79PfNSoPIx0CYqCkmPdgD/5KNrvVYufzuA2psZQzhB6qXzN8w+ZR",
+	"8cF5CzSsyliZwG6s87yvtJmCw5iyBYWLQZi9+PzLk5P5bMOF/fNpQqtzv5o2tq24GvLO7i6baO4plFUy",
+	"X/fQ0JAyhYODR6TWIZ1u2k1cjflG9DfbTTcnps8dYnDgfDV5zbjYV20RjUDYdTDr99nLftozP1+66iGQ",
+	"8f4yaG0yjheB7EyToaD9UBhzeP/wc5hhGJ8TNIieVubNOWoQ1l5sDNoUleN5SJ+WRO1HFG3/VILrA8l9",
+	"/Us6cP/5JyH5YYTkEZHpAKXIuMB2/L45wDcWvJKlihC/tHx9GJy2APASh7mVBLCPxSaseS0mNGzQm2ix",
+	"2nsUXDjUJ570iSd9aPOLPUe3ZwLztFX+e18aRY/fut1j/T0zf80z/Uk2/iQbf5KNP91Dn+6hW7sB3OUS",
+	"GtAJ2tGYJjSh4G1rBbtnMwmLL6RvB+DaZxdd1pjC3bO7NmPeyKsmSzpyQF+2wKwx2slFiiW0XP0EY2d/",
+	"zltxnqaiBsJjZ8+y9+cUs9hhdNqtNXNzM2+NttGrygXQ3nbApL2tncKkKSkAuV2kWNlNhiBN69KFGYFw",
+	"p7u43aOjb7jhfUofENx+aFWJXqqhe6wO069qNmWQXlWxkuoJ1z7fbFjBqWHlrlV9pFM4ZH/5EabGa48M",
+	"VfserMRx6nO12ht7pMKNy75FdZO76OgO2W/jNNwJr8arodAEGwKFH0O+L0+nvurFfgEn2rYWfpqJ36X8",
+	"6vce809E/4no//9F9L2r6I1D3TIp6cTb8oFl+Tveun+mp8GHXsqDvzQ+9IL+1A+XD7+b9/IOGn+yNLHv",
+	"D6SxP6bFFRU2GUb6jXVaFNqVm3ReAEamASQDOpHgLbMJ+YvllXWHuKaq8OmLm1iXYCFfsJ10jgU57IvQ",
+	"tSuviI+7MBUm9QQspGKB7AL/5FrH+Xj+tAjzTRnRLiApR4XxAKVRJ4E9ILlNAmBgOxGi3uZ7NtFs9RC0",
+	"brhZEryTTz4Mn/S0n/S0n/S0n/S0H1o+cdflgKMlXNX9awlTK1vWOFlKaUL949B5vIdD0Pzv74DLa6au",
+	"/BXdRIK/OD7GXPxrqc3x7GYef9Odj+8CTO/9dVMpfkUNw2/bTCoOkZpl5kKpsyba+9nRyezm/xsAZq6r",
+	"IFJeAQA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
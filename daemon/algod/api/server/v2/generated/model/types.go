@@ -76,18 +76,42 @@ const (
 	AccountApplicationInformationParamsFormatMsgpack AccountApplicationInformationParamsFormat = "msgpack"
 )
 
+// Defines values for GetAccountApplicationBalancesTrieProofParamsFormat.
+const (
+	GetAccountApplicationBalancesTrieProofParamsFormatJson    GetAccountApplicationBalancesTrieProofParamsFormat = "json"
+	GetAccountApplicationBalancesTrieProofParamsFormatMsgpack GetAccountApplicationBalancesTrieProofParamsFormat = "msgpack"
+)
+
 // Defines values for AccountAssetInformationParamsFormat.
 const (
 	AccountAssetInformationParamsFormatJson    AccountAssetInformationParamsFormat = "json"
 	AccountAssetInformationParamsFormatMsgpack AccountAssetInformationParamsFormat = "msgpack"
 )
 
+// Defines values for GetAccountAssetBalancesTrieProofParamsFormat.
+const (
+	GetAccountAssetBalancesTrieProofParamsFormatJson    GetAccountAssetBalancesTrieProofParamsFormat = "json"
+	GetAccountAssetBalancesTrieProofParamsFormatMsgpack GetAccountAssetBalancesTrieProofParamsFormat = "msgpack"
+)
+
+// Defines values for GetAccountBalancesTrieProofParamsFormat.
+const (
+	GetAccountBalancesTrieProofParamsFormatJson    GetAccountBalancesTrieProofParamsFormat = "json"
+	GetAccountBalancesTrieProofParamsFormatMsgpack GetAccountBalancesTrieProofParamsFormat = "msgpack"
+)
+
 // Defines values for GetPendingTransactionsByAddressParamsFormat.
 const (
 	GetPendingTransactionsByAddressParamsFormatJson    GetPendingTransactionsByAddressParamsFormat = "json"
 	GetPendingTransactionsByAddressParamsFormatMsgpack GetPendingTransactionsByAddressParamsFormat = "msgpack"
 )
 
+// Defines values for GetApplicationBoxBalancesTrieProofParamsFormat.
+const (
+	GetApplicationBoxBalancesTrieProofParamsFormatJson    GetApplicationBoxBalancesTrieProofParamsFormat = "json"
+	GetApplicationBoxBalancesTrieProofParamsFormatMsgpack GetApplicationBoxBalancesTrieProofParamsFormat = "msgpack"
+)
+
 // Defines values for GetBlockParamsFormat.
 const (
 	GetBlockParamsFormatJson    GetBlockParamsFormat = "json"
@@ -124,6 +148,12 @@ const (
 	GetTransactionGroupLedgerStateDeltasForRoundParamsFormatMsgpack GetTransactionGroupLedgerStateDeltasForRoundParamsFormat = "msgpack"
 )
 
+// Defines values for StreamBlocksParamsFormat.
+const (
+	StreamBlocksParamsFormatJson    StreamBlocksParamsFormat = "json"
+	StreamBlocksParamsFormatMsgpack StreamBlocksParamsFormat = "msgpack"
+)
+
 // Defines values for GetPendingTransactionsParamsFormat.
 const (
 	GetPendingTransactionsParamsFormatJson    GetPendingTransactionsParamsFormat = "json"
@@ -142,6 +172,12 @@ const (
 	SimulateTransactionParamsFormatMsgpack SimulateTransactionParamsFormat = "msgpack"
 )
 
+// Defines values for SimulateTransactionInSessionParamsFormat.
+const (
+	SimulateTransactionInSessionParamsFormatJson    SimulateTransactionInSessionParamsFormat = "json"
+	SimulateTransactionInSessionParamsFormatMsgpack SimulateTransactionInSessionParamsFormat = "msgpack"
+)
+
 // Account Account information at a given round.
 //
 // Definition:
@@ -525,6 +561,24 @@ type AvmValue struct {
 	Uint *uint64 `json:"uint,omitempty"`
 }
 
+// BalancesTrieProofChild A child of a node on the path of a balances trie proof.
+type BalancesTrieProofChild struct {
+	// Hash The hash of a non-leaf child, or the remainder of the element for a leaf child. Omitted for the child on the path to the proven element, which is recomputed by the verifier.
+	Hash *[]byte `json:"hash,omitempty"`
+
+	// Index The first byte of the child path, relative to its parent.
+	Index uint64 `json:"index"`
+
+	// Leaf Whether the child is a leaf node.
+	Leaf bool `json:"leaf"`
+}
+
+// BalancesTrieProofNode A non-leaf node on the path of a balances trie proof.
+type BalancesTrieProofNode struct {
+	// Children The children of the node, ordered by index.
+	Children []BalancesTrieProofChild `json:"children"`
+}
+
 // Box Box name and its content.
 type Box struct {
 	// Name \[name\] box name, base64 encoded
@@ -721,6 +775,24 @@ type ParticipationKey struct {
 	LastVote *uint64 `json:"last-vote,omitempty"`
 }
 
+// PeerReputation Represents the reputation of a peer that misbehaved recently.
+type PeerReputation struct {
+	// BannedUntil Unix timestamp in seconds until which the peer is banned, omitted if the peer is not banned.
+	BannedUntil *uint64 `json:"banned-until,omitempty"`
+
+	// Bans Number of consecutive bans, each doubling the duration of the next one.
+	Bans uint64 `json:"bans"`
+
+	// LastOffense The last misbehavior the peer was scored for.
+	LastOffense *string `json:"last-offense,omitempty"`
+
+	// Peer The peer IP address or p2p peer ID.
+	Peer string `json:"peer"`
+
+	// Score Misbehavior score, decaying over time. The peer is banned when it reaches the configured PeerBanThreshold.
+	Score float32 `json:"score"`
+}
+
 // PendingTransactionResponse Details about a pending transaction. If the transaction was recently confirmed, includes confirmation details like the round and reward details.
 type PendingTransactionResponse struct {
 	// ApplicationIndex The application index if the transaction was found and it created an application.
@@ -775,12 +847,105 @@ type ScratchChange struct {
 	Slot uint64 `json:"slot"`
 }
 
+// SimulateAccountData Replaces the data of an account. The fields not set are cleared. Its assets and applications are kept, they are overridden with assets and app-local-states.
+type SimulateAccountData struct {
+	// Amount The balance, in microalgos.
+	Amount uint64 `json:"amount"`
+
+	// AuthAddr The address the account is rekeyed to, if any.
+	AuthAddr *string `json:"auth-addr,omitempty"`
+
+	// IncentiveEligible Whether the account is eligible for incentives when online.
+	IncentiveEligible *bool `json:"incentive-eligible,omitempty"`
+
+	// Participation AccountParticipation describes the parameters used by this account in consensus protocol.
+	Participation *AccountParticipation `json:"participation,omitempty"`
+
+	// Status The participation status of the account, Offline if not set:
+	// * Offline - indicates that the associated account is delegated.
+	// * Online - indicates that the associated account used as part of the delegation pool.
+	// * NotParticipating - indicates that the associated account is neither a delegator nor a delegate.
+	Status *string `json:"status,omitempty"`
+}
+
+// SimulateAccountOverride Overrides the state of an account.
+type SimulateAccountOverride struct {
+	// Address The overridden account.
+	Address string `json:"address"`
+
+	// AppLocalStates Key-values set in the local states of the account, opted in if needed.
+	AppLocalStates *[]SimulateAppLocalStateOverride `json:"app-local-states,omitempty"`
+
+	// Assets Asset holdings of the account, opted in if needed.
+	Assets *[]SimulateAssetHoldingOverride `json:"assets,omitempty"`
+
+	// Data Replaces the data of an account. The fields not set are cleared. Its assets and applications are kept, they are overridden with assets and app-local-states.
+	Data *SimulateAccountData `json:"data,omitempty"`
+}
+
+// SimulateAppLocalStateOverride Sets key-values in the local state of an application.
+type SimulateAppLocalStateOverride struct {
+	// AppId The application.
+	AppId uint64 `json:"app-id"`
+
+	// KeyValue Represents a key-value store for use in an application.
+	KeyValue TealKeyValueStore `json:"key-value"`
+}
+
+// SimulateAppOverride Sets key-values in the global state of an application.
+type SimulateAppOverride struct {
+	// AppId The application.
+	AppId uint64 `json:"app-id"`
+
+	// GlobalState Represents a key-value store for use in an application.
+	GlobalState TealKeyValueStore `json:"global-state"`
+}
+
+// SimulateAssetHoldingOverride Sets the holding of an asset.
+type SimulateAssetHoldingOverride struct {
+	// Amount The amount held.
+	Amount *uint64 `json:"amount,omitempty"`
+
+	// AssetId The asset.
+	AssetId uint64 `json:"asset-id"`
+
+	// IsFrozen Whether the holding is frozen.
+	IsFrozen *bool `json:"is-frozen,omitempty"`
+}
+
+// SimulateBoxOverride Sets the content of a box, creating it if needed.
+type SimulateBoxOverride struct {
+	// AppId The application owning the box.
+	AppId uint64 `json:"app-id"`
+
+	// Name The name of the box.
+	Name []byte `json:"name"`
+
+	// Value The content of the box.
+	Value []byte `json:"value"`
+}
+
 // SimulateInitialStates Initial states of resources that were accessed during simulation.
 type SimulateInitialStates struct {
 	// AppInitialStates The initial states of accessed application before simulation. The order of this array is arbitrary.
 	AppInitialStates *[]ApplicationInitialStates `json:"app-initial-states,omitempty"`
 }
 
+// SimulatePopulatedResourceArrays The references to add to the foreign arrays of a transaction, so that its group can access the unnamed resources it accessed in simulation. Applications are to be added before boxes, which may refer to them.
+type SimulatePopulatedResourceArrays struct {
+	// Accounts The accounts to add to the accounts array.
+	Accounts *[]string `json:"accounts,omitempty"`
+
+	// Apps The applications to add to the foreign apps array.
+	Apps *[]uint64 `json:"apps,omitempty"`
+
+	// Assets The assets to add to the foreign assets array.
+	Assets *[]uint64 `json:"assets,omitempty"`
+
+	// Boxes The boxes to add to the box references. App 0 is the called application, and boxes with an empty name only increase the box IO budget.
+	Boxes *[]BoxReference `json:"boxes,omitempty"`
+}
+
 // SimulateRequest Request type for simulation endpoint.
 type SimulateRequest struct {
 	// AllowEmptySignatures Allows transactions without signatures to be simulated as if they had correct signatures.
@@ -801,9 +966,15 @@ type SimulateRequest struct {
 	// FixSigners If true, signers for transactions that are missing signatures will be fixed during evaluation.
 	FixSigners *bool `json:"fix-signers,omitempty"`
 
+	// PopulateResourceArrays Packs the unnamed resources accessed during simulation into the foreign arrays of the app calls in the group. Requires allow-unnamed-resources.
+	PopulateResourceArrays *bool `json:"populate-resource-arrays,omitempty"`
+
 	// Round If provided, specifies the round preceding the simulation. State changes through this round will be used to run this simulation. Usually only the 4 most recent rounds will be available (controlled by the node config value MaxAcctLookback). If not specified, defaults to the latest available round.
 	Round *uint64 `json:"round,omitempty"`
 
+	// StateOverrides Changes to the ledger state the simulation is evaluated against. They only last for the duration of the simulation, and are not supported in simulation sessions.
+	StateOverrides *SimulateStateOverrides `json:"state-overrides,omitempty"`
+
 	// TxnGroups The transaction groups to simulate.
 	TxnGroups []SimulateRequestTransactionGroup `json:"txn-groups"`
 }
@@ -814,11 +985,26 @@ type SimulateRequestTransactionGroup struct {
 	Txns []json.RawMessage `json:"txns"`
 }
 
+// SimulateStateOverrides Changes to the ledger state the simulation is evaluated against. They only last for the duration of the simulation, and are not supported in simulation sessions.
+type SimulateStateOverrides struct {
+	// Accounts Overrides of the state of accounts.
+	Accounts *[]SimulateAccountOverride `json:"accounts,omitempty"`
+
+	// Apps Overrides of the global state of applications.
+	Apps *[]SimulateAppOverride `json:"apps,omitempty"`
+
+	// Boxes Overrides of the content of boxes, created if needed.
+	Boxes *[]SimulateBoxOverride `json:"boxes,omitempty"`
+}
+
 // SimulateTraceConfig An object that configures simulation execution trace.
 type SimulateTraceConfig struct {
 	// Enable A boolean option for opting in execution trace features simulation endpoint.
 	Enable *bool `json:"enable,omitempty"`
 
+	// Profile A boolean option enabling returning an execution profile of the opcode budget spent by program, program counter, and inner call stack. Requires EnableDeveloperAPI.
+	Profile *bool `json:"profile,omitempty"`
+
 	// ScratchChange A boolean option enabling returning scratch slot changes together with execution trace during simulation.
 	ScratchChange *bool `json:"scratch-change,omitempty"`
 
@@ -843,6 +1029,9 @@ type SimulateTransactionGroupResult struct {
 	// FailureMessage If present, indicates that the transaction group failed and specifies why that happened
 	FailureMessage *string `json:"failure-message,omitempty"`
 
+	// PopulateFailureMessage If present, indicates that the unnamed resources accessed do not fit in the foreign arrays of the group, and specifies why.
+	PopulateFailureMessage *string `json:"populate-failure-message,omitempty"`
+
 	// TxnResults Simulation result for individual transactions
 	TxnResults []SimulateTransactionResult `json:"txn-results"`
 
@@ -864,6 +1053,9 @@ type SimulateTransactionResult struct {
 	// LogicSigBudgetConsumed Budget used during execution of a logic sig transaction.
 	LogicSigBudgetConsumed *uint64 `json:"logic-sig-budget-consumed,omitempty"`
 
+	// PopulatedResourceArrays The references to add to the foreign arrays of a transaction, so that its group can access the unnamed resources it accessed in simulation. Applications are to be added before boxes, which may refer to them.
+	PopulatedResourceArrays *SimulatePopulatedResourceArrays `json:"populated-resource-arrays,omitempty"`
+
 	// TxnResult Details about a pending transaction. If the transaction was recently confirmed, includes confirmation details like the round and reward details.
 	TxnResult PendingTransactionResponse `json:"txn-result"`
 
@@ -1149,6 +1341,33 @@ type ApplicationResponse = Application
 // AssetResponse Specifies both the unique identifier and the parameters for an asset
 type AssetResponse = Asset
 
+// BalancesTrieProofResponse defines model for BalancesTrieProofResponse.
+type BalancesTrieProofResponse struct {
+	// BlockHash The hash of the block header of the proof round.
+	BlockHash []byte `json:"block-hash"`
+
+	// Commitment Commitment to the root, formatted like a catchpoint label: the proof round, '#', and the unpadded base32 encoding of the SHA-512/256 hash of the concatenation of block-hash, root, totals and, if present, state-proof-verification-hash. Clients can recompute it from these fields, and check block-hash against the block of the proof round.
+	Commitment string `json:"commitment"`
+
+	// Leaf The proven balances trie element.
+	Leaf []byte `json:"leaf"`
+
+	// Path The non-leaf nodes from the root of the trie down to the parent of the leaf.
+	Path []BalancesTrieProofNode `json:"path"`
+
+	// Root The root hash of the balances trie.
+	Root []byte `json:"root"`
+
+	// Round The round of the account state the balances trie is built over.
+	Round uint64 `json:"round"`
+
+	// StateProofVerificationHash The hash of the state proof verification data at the proof round, hashed in the commitment if the consensus protocol of the proof round includes it in catchpoint labels.
+	StateProofVerificationHash *[]byte `json:"state-proof-verification-hash,omitempty"`
+
+	// Totals The msgpack encoding of the account totals at the proof round, as hashed in the commitment.
+	Totals []byte `json:"totals"`
+}
+
 // BlockHashResponse defines model for BlockHashResponse.
 type BlockHashResponse struct {
 	// BlockHash Block header hash.
@@ -1333,6 +1552,9 @@ type ParticipationKeyResponse = ParticipationKey
 // ParticipationKeysResponse defines model for ParticipationKeysResponse.
 type ParticipationKeysResponse = []ParticipationKey
 
+// PeerReputationResponse defines model for PeerReputationResponse.
+type PeerReputationResponse = []PeerReputation
+
 // PendingTransactionsResponse PendingTransactions is an array of signed transactions exactly as they were submitted.
 type PendingTransactionsResponse struct {
 	// TopTransactions An array of signed transaction objects.
@@ -1359,6 +1581,9 @@ type SimulateResponse struct {
 	// EvalOverrides The set of parameters and limits override during simulation. If this set of parameters is present, then evaluation parameters may differ from standard evaluation in certain ways.
 	EvalOverrides *SimulationEvalOverrides `json:"eval-overrides,omitempty"`
 
+	// ExecProfile The execution profile of the simulation, in the gzipped pprof protobuf format, when requested by exec-trace-config.
+	ExecProfile *[]byte `json:"exec-profile,omitempty"`
+
 	// ExecTraceConfig An object that configures simulation execution trace.
 	ExecTraceConfig *SimulateTraceConfig `json:"exec-trace-config,omitempty"`
 
@@ -1375,6 +1600,24 @@ type SimulateResponse struct {
 	Version uint64 `json:"version"`
 }
 
+// SimulationSessionResponse defines model for SimulationSessionResponse.
+type SimulationSessionResponse struct {
+	// Expires The time, in seconds since the epoch, the session is discarded at unless used before.
+	Expires uint64 `json:"expires"`
+
+	// Round The latest round of the session, the simulations of the session are evaluated in the next one.
+	Round uint64 `json:"round"`
+
+	// SessionId The ID of the simulation session.
+	SessionId string `json:"session-id"`
+
+	// StartRound The round of the ledger the session builds on.
+	StartRound uint64 `json:"start-round"`
+
+	// Timestamp The timestamp of the latest round of the session.
+	Timestamp uint64 `json:"timestamp"`
+}
+
 // StateProofResponse Represents a state proof and its corresponding message
 type StateProofResponse = StateProof
 
@@ -1457,6 +1700,9 @@ type AccountInformationParams struct {
 
 	// Exclude When set to `all` will exclude asset holdings, application local state, created asset parameters, any created application parameters. Defaults to `none`.
 	Exclude *AccountInformationParamsExclude `form:"exclude,omitempty" json:"exclude,omitempty"`
+
+	// Round Round at which to look up the state. Defaults to the latest round. Rounds before the ones the node keeps in memory are only available on archival nodes with EnableStateHistory set.
+	Round *uint64 `form:"round,omitempty" json:"round,omitempty"`
 }
 
 // AccountInformationParamsFormat defines parameters for AccountInformation.
@@ -1469,11 +1715,23 @@ type AccountInformationParamsExclude string
 type AccountApplicationInformationParams struct {
 	// Format Configures whether the response object is JSON or MessagePack encoded. If not provided, defaults to JSON.
 	Format *AccountApplicationInformationParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+
+	// Round Round at which to look up the state. Defaults to the latest round. Rounds before the ones the node keeps in memory are only available on archival nodes with EnableStateHistory set.
+	Round *uint64 `form:"round,omitempty" json:"round,omitempty"`
 }
 
 // AccountApplicationInformationParamsFormat defines parameters for AccountApplicationInformation.
 type AccountApplicationInformationParamsFormat string
 
+// GetAccountApplicationBalancesTrieProofParams defines parameters for GetAccountApplicationBalancesTrieProof.
+type GetAccountApplicationBalancesTrieProofParams struct {
+	// Format Configures whether the response object is JSON or MessagePack encoded. If not provided, defaults to JSON.
+	Format *GetAccountApplicationBalancesTrieProofParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// GetAccountApplicationBalancesTrieProofParamsFormat defines parameters for GetAccountApplicationBalancesTrieProof.
+type GetAccountApplicationBalancesTrieProofParamsFormat string
+
 // AccountAssetsInformationParams defines parameters for AccountAssetsInformation.
 type AccountAssetsInformationParams struct {
 	// Limit Maximum number of results to return.
@@ -1487,11 +1745,32 @@ type AccountAssetsInformationParams struct {
 type AccountAssetInformationParams struct {
 	// Format Configures whether the response object is JSON or MessagePack encoded. If not provided, defaults to JSON.
 	Format *AccountAssetInformationParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+
+	// Round Round at which to look up the state. Defaults to the latest round. Rounds before the ones the node keeps in memory are only available on archival nodes with EnableStateHistory set.
+	Round *uint64 `form:"round,omitempty" json:"round,omitempty"`
 }
 
 // AccountAssetInformationParamsFormat defines parameters for AccountAssetInformation.
 type AccountAssetInformationParamsFormat string
 
+// GetAccountAssetBalancesTrieProofParams defines parameters for GetAccountAssetBalancesTrieProof.
+type GetAccountAssetBalancesTrieProofParams struct {
+	// Format Configures whether the response object is JSON or MessagePack encoded. If not provided, defaults to JSON.
+	Format *GetAccountAssetBalancesTrieProofParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// GetAccountAssetBalancesTrieProofParamsFormat defines parameters for GetAccountAssetBalancesTrieProof.
+type GetAccountAssetBalancesTrieProofParamsFormat string
+
+// GetAccountBalancesTrieProofParams defines parameters for GetAccountBalancesTrieProof.
+type GetAccountBalancesTrieProofParams struct {
+	// Format Configures whether the response object is JSON or MessagePack encoded. If not provided, defaults to JSON.
+	Format *GetAccountBalancesTrieProofParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// GetAccountBalancesTrieProofParamsFormat defines parameters for GetAccountBalancesTrieProof.
+type GetAccountBalancesTrieProofParamsFormat string
+
 // GetPendingTransactionsByAddressParams defines parameters for GetPendingTransactionsByAddress.
 type GetPendingTransactionsByAddressParams struct {
 	// Max Truncated number of transactions to display. If max=0, returns all pending txns.
@@ -1504,18 +1783,45 @@ type GetPendingTransactionsByAddressParams struct {
 // GetPendingTransactionsByAddressParamsFormat defines parameters for GetPendingTransactionsByAddress.
 type GetPendingTransactionsByAddressParamsFormat string
 
+// GetApplicationByIDParams defines parameters for GetApplicationByID.
+type GetApplicationByIDParams struct {
+	// Round Round at which to look up the state. Defaults to the latest round. Rounds before the ones the node keeps in memory are only available on archival nodes with EnableStateHistory set.
+	Round *uint64 `form:"round,omitempty" json:"round,omitempty"`
+}
+
 // GetApplicationBoxByNameParams defines parameters for GetApplicationBoxByName.
 type GetApplicationBoxByNameParams struct {
 	// Name A box name, in the goal app call arg form 'encoding:value'. For ints, use the form 'int:1234'. For raw bytes, use the form 'b64:A=='. For printable strings, use the form 'str:hello'. For addresses, use the form 'addr:XYZ...'.
 	Name string `form:"name" json:"name"`
+
+	// Round Round at which to look up the state. Defaults to the latest round. Rounds before the ones the node keeps in memory are only available on archival nodes with EnableStateHistory set.
+	Round *uint64 `form:"round,omitempty" json:"round,omitempty"`
 }
 
+// GetApplicationBoxBalancesTrieProofParams defines parameters for GetApplicationBoxBalancesTrieProof.
+type GetApplicationBoxBalancesTrieProofParams struct {
+	// Name A box name, in the goal app call arg form 'encoding:value'. For ints, use the form 'int:1234'. For raw bytes, use the form 'b64:A=='. For printable strings, use the form 'str:hello'. For addresses, use the form 'addr:XYZ...'.
+	Name string `form:"name" json:"name"`
+
+	// Format Configures whether the response object is JSON or MessagePack encoded. If not provided, defaults to JSON.
+	Format *GetApplicationBoxBalancesTrieProofParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// GetApplicationBoxBalancesTrieProofParamsFormat defines parameters for GetApplicationBoxBalancesTrieProof.
+type GetApplicationBoxBalancesTrieProofParamsFormat string
+
 // GetApplicationBoxesParams defines parameters for GetApplicationBoxes.
 type GetApplicationBoxesParams struct {
 	// Max Max number of box names to return. If max is not set, or max == 0, returns all box-names.
 	Max *uint64 `form:"max,omitempty" json:"max,omitempty"`
 }
 
+// GetAssetByIDParams defines parameters for GetAssetByID.
+type GetAssetByIDParams struct {
+	// Round Round at which to look up the state. Defaults to the latest round. Rounds before the ones the node keeps in memory are only available on archival nodes with EnableStateHistory set.
+	Round *uint64 `form:"round,omitempty" json:"round,omitempty"`
+}
+
 // GetBlockParams defines parameters for GetBlock.
 type GetBlockParams struct {
 	// Format Configures whether the response object is JSON or MessagePack encoded. If not provided, defaults to JSON.
@@ -1592,6 +1898,21 @@ type ShutdownNodeParams struct {
 	Timeout *uint64 `form:"timeout,omitempty" json:"timeout,omitempty"`
 }
 
+// StreamBlocksParams defines parameters for StreamBlocks.
+type StreamBlocksParams struct {
+	// From The round of the first block to stream. Defaults to the round after the latest round.
+	From *uint64 `form:"from,omitempty" json:"from,omitempty"`
+
+	// Deltas When set to `true`, each frame includes the state delta of its round. Defaults to `false`.
+	Deltas *bool `form:"deltas,omitempty" json:"deltas,omitempty"`
+
+	// Format Configures whether the response object is JSON or MessagePack encoded. If not provided, defaults to JSON.
+	Format *StreamBlocksParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// StreamBlocksParamsFormat defines parameters for StreamBlocks.
+type StreamBlocksParamsFormat string
+
 // TealCompileTextBody defines parameters for TealCompile.
 type TealCompileTextBody = openapi_types.File
 
@@ -1599,6 +1920,9 @@ type TealCompileTextBody = openapi_types.File
 type TealCompileParams struct {
 	// Sourcemap When set to `true`, returns the source map of the program as a JSON. Defaults to `false`.
 	Sourcemap *bool `form:"sourcemap,omitempty" json:"sourcemap,omitempty"`
+
+	// Bundle When set to `true`, the body is a JSON object with the TEAL source code in `source` and the files it includes in `files`, keyed by path, rather than the TEAL source code itself. Defaults to `false`.
+	Bundle *bool `form:"bundle,omitempty" json:"bundle,omitempty"`
 }
 
 // GetPendingTransactionsParams defines parameters for GetPendingTransactions.
@@ -1631,6 +1955,33 @@ type SimulateTransactionParams struct {
 // SimulateTransactionParamsFormat defines parameters for SimulateTransaction.
 type SimulateTransactionParamsFormat string
 
+// StartSimulationSessionParams defines parameters for StartSimulationSession.
+type StartSimulationSessionParams struct {
+	// Round The round to start the session on, the latest round of the ledger by default.
+	Round *uint64 `form:"round,omitempty" json:"round,omitempty"`
+
+	// Ttl The time, in seconds, the session is kept without being used.
+	Ttl *uint64 `form:"ttl,omitempty" json:"ttl,omitempty"`
+}
+
+// SimulateTransactionInSessionParams defines parameters for SimulateTransactionInSession.
+type SimulateTransactionInSessionParams struct {
+	// Format Configures whether the response object is JSON or MessagePack encoded. If not provided, defaults to JSON.
+	Format *SimulateTransactionInSessionParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// SimulateTransactionInSessionParamsFormat defines parameters for SimulateTransactionInSession.
+type SimulateTransactionInSessionParamsFormat string
+
+// AdvanceSimulationSessionParams defines parameters for AdvanceSimulationSession.
+type AdvanceSimulationSessionParams struct {
+	// Rounds The number of rounds to add to the session.
+	Rounds uint64 `form:"rounds" json:"rounds"`
+
+	// Seconds The number of seconds to move the timestamp of the session forward by.
+	Seconds *uint64 `form:"seconds,omitempty" json:"seconds,omitempty"`
+}
+
 // TealCompileTextRequestBody defines body for TealCompile for text/plain ContentType.
 type TealCompileTextRequestBody = TealCompileTextBody
 
@@ -1639,3 +1990,6 @@ type TealDryrunJSONRequestBody = DryrunRequest
 
 // SimulateTransactionJSONRequestBody defines body for SimulateTransaction for application/json ContentType.
 type SimulateTransactionJSONRequestBody = SimulateRequest
+
+// SimulateTransactionInSessionJSONRequestBody defines body for SimulateTransactionInSession for application/json ContentType.
+type SimulateTransactionInSessionJSONRequestBody = SimulateRequest
@@ -36,6 +36,15 @@ type ServerInterface interface {
 	// Starts a catchpoint catchup.
 	// (POST /v2/catchup/{catchpoint})
 	StartCatchup(ctx echo.Context, catchpoint string, params StartCatchupParams) error
+	// Replays the exporter from a round.
+	// (POST /v2/exporter/replay/{round})
+	ReplayExporter(ctx echo.Context, round uint64) error
+	// Returns the reputation of misbehaving peers.
+	// (GET /v2/peers/reputation)
+	GetPeerReputation(ctx echo.Context) error
+	// Clears the reputation of a peer.
+	// (DELETE /v2/peers/reputation/{peer})
+	ClearPeerReputation(ctx echo.Context, peer string) error
 
 	// (POST /v2/shutdown)
 	ShutdownNode(ctx echo.Context, params ShutdownNodeParams) error
@@ -124,6 +133,53 @@ func (w *ServerInterfaceWrapper) StartCatchup(ctx echo.Context) error {
 	return err
 }
 
+// ReplayExporter converts echo context to params.
+func (w *ServerInterfaceWrapper) ReplayExporter(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "round" -------------
+	var round uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "round", runtime.ParamLocationPath, ctx.Param("round"), &round)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter round: %s", err))
+	}
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.ReplayExporter(ctx, round)
+	return err
+}
+
+// GetPeerReputation converts echo context to params.
+func (w *ServerInterfaceWrapper) GetPeerReputation(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetPeerReputation(ctx)
+	return err
+}
+
+// ClearPeerReputation converts echo context to params.
+func (w *ServerInterfaceWrapper) ClearPeerReputation(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "peer" -------------
+	var peer string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "peer", runtime.ParamLocationPath, ctx.Param("peer"), &peer)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter peer: %s", err))
+	}
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.ClearPeerReputation(ctx, peer)
+	return err
+}
+
 // ShutdownNode converts echo context to params.
 func (w *ServerInterfaceWrapper) ShutdownNode(ctx echo.Context) error {
 	var err error
@@ -177,6 +233,9 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.PUT(baseURL+"/debug/settings/pprof", wrapper.PutDebugSettingsProf, m...)
 	router.DELETE(baseURL+"/v2/catchup/:catchpoint", wrapper.AbortCatchup, m...)
 	router.POST(baseURL+"/v2/catchup/:catchpoint", wrapper.StartCatchup, m...)
+	router.POST(baseURL+"/v2/exporter/replay/:round", wrapper.ReplayExporter, m...)
+	router.GET(baseURL+"/v2/peers/reputation", wrapper.GetPeerReputation, m...)
+	router.DELETE(baseURL+"/v2/peers/reputation/:peer", wrapper.ClearPeerReputation, m...)
 	router.POST(baseURL+"/v2/shutdown", wrapper.ShutdownNode, m...)
 
 }
@@ -184,232 +243,270 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+y9e5PbtpI4+lVQ2q3yY8UZ23GyJ751au/ETnLmxklcHid7d23fE4hsSThDATwAOCPF",
-	"19/9V+gGSJAEJWpm4iS1+5c9Ih6NRqPR6OeHWa42lZIgrZk9+zCruOYbsKDxL57nqpY2E4X7qwCTa1FZ",
-	"oeTsWfjGjNVCrmbzmXC/VtyuZ/OZ5Bto27j+85mGf9ZCQzF7ZnUN85nJ17DhbmC7q1zrZqRttlKZH+KM",
-	"hjh/Mfu45wMvCg3GDKH8UZY7JmRe1gUwq7k0PHefDLsWds3sWhjmOzMhmZLA1JLZdacxWwooC3MSFvnP",
-	"GvQuWqWffHxJH1sQM61KGML5XG0WQkKAChqgmg1hVrEClthozS1zMzhYQ0OrmAGu8zVbKn0AVAIihhdk",
-	"vZk9ezszIAvQuFs5iCv871ID/AqZ5XoFdvZ+nlrc0oLOrNgklnbusa/B1KU1DNviGlfiCiRzvU7Y97Wx",
-	"bAGMS/b6m+fss88++9ItZMOthcIT2eiq2tnjNVH32bNZwS2Ez0Na4+VKaS6LrGn/+pvnOP+FX+DUVtwY",
-	"SB+WM/eFnb8YW0DomCAhIS2scB861O96JA5F+/MClkrDxD2hxne6KfH8v+uu5Nzm60oJaRP7wvAro89J",
-	"HhZ138fDGgA67SuHKe0Gffso+/L9h8fzx48+/svbs+y//Z+ff/Zx4vKfN+MewECyYV5rDTLfZSsNHE/L",
-	"msshPl57ejBrVZcFW/Mr3Hy+QVbv+zLXl1jnFS9rRyci1+qsXCnDuCejApa8Li0LE7Nalo5NudE8tTNh",
-	"WKXVlSigmDvue70W+Zrl3NAQ2I5di7J0NFgbKMZoLb26PYfpY4wSB9eN8IEL+uMio13XAUzAFrlBlpfK",
-	"QGbVgesp3DhcFiy+UNq7yhx3WbE3a2A4uftAly3iTjqaLssds7ivBeOGcRaupjkTS7ZTNbvGzSnFJfb3",
-	"q3FY2zCHNNyczj3qDu8Y+gbISCBvoVQJXCLywrkbokwuxarWYNj1Guza33kaTKWkAaYW/4Dcum3/fy5+",
-	"/IEpzb4HY/gKXvH8koHMVQHFCTtfMqlsRBqelhCHrufYOjxcqUv+H0Y5mtiYVcXzy/SNXoqNSKzqe74V",
-	"m3rDZL1ZgHZbGq4Qq5gGW2s5BhCNeIAUN3w7nPSNrmWO+99O25HlHLUJU5V8hwjb8O1fH809OIbxsmQV",
-	"yELIFbNbOSrHubkPg5dpVctigphj3Z5GF6upIBdLAQVrRtkDiZ/mEDxCHgdPK3xF4IRBRsFpZjkAjoRt",
-	"gmbc6XZfWMVXEJHMCfvJMzf8atUlyIbQ2WKHnyoNV0LVpuk0AiNOvV8Cl8pCVmlYigSNXXh0OAZDbTwH",
-	"3ngZKFfSciGhcMwZgVYWiFmNwhRNuP+9M7zFF9zAF0/H7vj268TdX6r+ru/d8Um7jY0yOpKJq9N99Qc2",
-	"LVl1+k94H8ZzG7HK6OfBRorVG3fbLEWJN9E/3P4FNNQGmUAHEeFuMmIlua01PHsnH7q/WMYuLJcF14X7",
-	"ZUM/fV+XVlyIlfuppJ9eqpXIL8RqBJkNrMkHF3bb0D9uvDQ7ttvku+KlUpd1FS8o7zxcFzt2/mJsk2nM",
-	"YwnzrHntxg+PN9vwGDm2h902GzkC5CjuKu4aXsJOg4OW50v8Z7tEeuJL/av7p6pK19tWyxRqHR37KxnV",
-	"B16tcFZVpci5Q+Jr/9l9dUwA6CHB2xaneKE++xCBWGlVgbaCBuVVlZUq52VmLLc40r9qWM6ezf7ltNW/",
-	"nFJ3cxpN/tL1usBOTmQlMSjjVXXEGK+c6GP2MAvHoPETsglieyg0CUmb6EhJOBZcwhWX9qR9snT4QXOA",
-	"3/qZWnyTtEP47j3BRhHOqOECDEnA1PCeYRHqGaKVIVpRIF2VatH8cP+sqloM4vezqiJ8oPQIAgUz2Apj",
-	"zQNcPm9PUjzP+YsT9m08NoriSpY7dzmQqOHuhqW/tfwt1uiW/BraEe8Zhtup9InbmoAGJ+bfBcXhs2Kt",
-	"Sif1HKQV1/hvvm1MZu73SZ3/HCQW43acuPCh5TFHbxz8JXrc3O9RzpBwvLrnhJ31+96MbNwoewjGnLdY",
-	"vGviwV+EhY05SAkRRBE1+e3hWvPdzAuJGQp7QzL5yQBRSMVXQiK0c/d8kmzDL2k/FOLdEQKY5l1EtEQS",
-	"ZKNC9TKnR/3JQM/yJ6DW1MYGSdRJqqUwFt/V2JitoUTBmctA0DGp3IgyJmz4nkU0MF9rXhEt+y8kdgmJ",
-	"73lqRLDe8uKdeCcmYY7YfbTRCNWN2fJB1pmEBLlGD4avSpVf/o2b9R2c8EUYa0j7OA1bAy9AszU368TB",
-	"6dF2O9oU+nYNkWbZIprqpFniS7Uyd7DEUh3DuqrqOS9LN/WQZfVWiwNPOshlyVxjBhuBCnP/cCQNO72/",
-	"2Nc8XzuxgOW8LOetqkhVWQlXULpHu5AS9JzZNbft4ceRw7sGz5EBx+wssGg1Xs2EKjbd6CI0sA3HG2jj",
-	"XjNV2e3TcFDDN9CTgvBGVDVqEaKHxvmLsDq4Aok8qRkawW/WiNqaePATN7f/hDNLRYsjDaAN5rsGfw2/",
-	"6ADtWrf3qWynULognbV1vwnNcqVpCLrh/eTuP8B125mo836lIfNDaH4F2vDSra63qAcN+d7V6TxwMgtu",
-	"eXQyPRWmH2DEObAfinegE1qaH/E/vGTus5NiHCW11CNQGFGRObWgi9mhimZyDVDfqtiGVJms4vnlUVA+",
-	"bydPs5lJJ+9r0p76LfSLaHbozVYU5q62CQcb26vuCSHdVWBHA1lkL9OJ5pqCgDeqYsQ+eiAQp8DRCCFq",
-	"e+fX2ldqm4LpK7UdXGlqC3eyE26cycz+K7V94SFT+jDmcewpSHcLlHwDBm83GTNON0trlztbKH0zaaJ3",
-	"wUjWWhsZd6NGwtS8hyRsWleZP5sJiwU16A3UOnjsFwL6w6cw1sHCheW/ARaMG/UusNAd6K6xoDaVKOEO",
-	"SH+dFOIW3MBnT9jF384+f/zk708+/8KRZKXVSvMNW+wsGHbfq+WYsbsSHiRfRyhdpEf/4mmwUXXHTY1j",
-	"VK1z2PBqOBTZvuj1S82YazfEWhfNuOoGwEkcEdzVRmhnZNZ1oL2ARb26AGvdS/eVVss754aDGVLQYaNX",
-	"lXaChenaCb20dFq4JqewtZqfVtgSZEF+Bm4dwrg34GZxJ0Q1tvFFO0vBPEYLOHgojt2mdppdvFV6p+u7",
-	"UG+A1konr+BKK6tyVWZOzhMqoaB45Vsw3yJsV9X/naBl19wwNzdaL2tZjOgh7FZOv79o6Ddb2eJm7w1G",
-	"602szs87ZV+6yG9fIRXozG4lQ+rsqEeWWm0YZwV2RFnjW7Akf4kNXFi+qX5cLu9G26lwoIQeR2zAuJkY",
-	"tXDSj4FcSXLmO6Cy8aNOQU8fMcHKZMcB8Bi52MkcTWV3cWzHtVkbIdFub3Yyj1RbDsYSilWHLG+vwhpD",
-	"B011zyTAceh4iZ9RV/8CSsu/UfpNK75+q1Vd3Tl77s85dTncL8ZbAwrXN6iBhVyVXQfSlYP9JLXG32VB",
-	"zxslAq0BoUeKfClWaxu9F19p9RvciclZUoDiB1IWla7PUGX0gyocM7G1uQNRsh2s5XCObmO+xheqtowz",
-	"qQrAza9NWsgccTlEXyd00bKx3Ir6CWHYAhx15bx2q60rhg5Ig/ui7ZjxnE5ohqgxI+4Xjd8MtaLpyJ2t",
-	"1MCLHVsASKYW3sfBe1/gIjl6T9kgpnkRN8EvOnBVWuVgDBSZV0UfBC20o6vD7sETAo4AN7Mwo9iS61sD",
-	"e3l1EM5L2GXo62fY/e9+Ng9+B3itsrw8gFhsk0JvX582hHra9PsIrj95THakqSOqdeKtYxAlWBhD4VE4",
-	"Gd2/PkSDXbw9Wq5Ao0vJb0rxYZLbEVAD6m9M77eFtq5GPNj9M91JeG7DJJcqCFapwUpubHaILbtGHV2C",
-	"W0HECVOcGAceEbxecmPJDUrIAnWadJ3gPCSEuSnGAR59hriRfw4vkOHYubsHpalN8xwxdVUpbaFIrQEt",
-	"sqNz/QDbZi61jMZu3jxWsdrAoZHHsBSN75HlX8D4B7eN/dVbdIeLQ5u6u+d3SVR2gGgRsQ+Qi9Aqwm7s",
-	"xTsCiDAtoolwhOlRTuM6PJ8Zq6rKcQub1bLpN4amC2p9Zn9q2w6Ji4wcdG8XCgwaUHx7D/k1YZb8t9fc",
-	"MA9HMLGjOof8tYYwu8OYGSFzyPZRPj7xXKv4CBw8pHW10ryArICS7xLOAfSZ0ed9A+COt89dZSEjR9z0",
-	"preUHPwe9wytcDyTEh4ZfmG5O4LuKdASiO99YOQCcOwUc/J0dK8ZCudKblEYD5dNW50YEW/DK2Xdjnt6",
-	"QJA9R58C8AgemqFvjgrsnLVvz/4U/wXGT9DIEcdPsgMztoR2/KMWMKIL9jFO0XnpsfceB06yzVE2doCP",
-	"jB3ZEcX0K66tyEWFb53vYHfnT7/+BEnDOSvAclFCwaIP9Ays4v6MXEj7Y97sKThJ9zYEf6B8SywnuOl0",
-	"gb+EHb65X1FsQqTquIu3bGJUdz9xyRDQ4PHsRPC4CWx5bsudE9TsGnbsGjQwUy/IhWFoT7GqyuIBkvaZ",
-	"PTN662zSNrrXXHyBQ0XLS/ma0ZtgP3xveg+DDjr8W6BSqpygIRsgIwnBJN8RVim368KHP4UAmEBJHSA9",
-	"00bTfHP93zMdNOMK2H+pmuVc4pOrttDINEqjoIACpJvBiWDNnN45scUQlLABeknil4cP+wt/+NDvuTBs",
-	"CdchZtA17KPj4UPU47xSxnYO1x3oQ91xO09cH2i4cheff4X0ecphjyc/8pSdfNUbvLF2uTNljCdct/xb",
-	"M4DeydxOWXtMI9O8vXDcSbacrn/QYN247xdiU5fc3oXVCq54makr0FoUcJCT+4mFkl9f8fLHphvGQ0Lu",
-	"aDSHLMcovoljwRvXhwL/3DhCCneAyel/KkBwTr0uqNOBJ2brqSo2GygEt1DuWKUhB4p3c5KjaZZ6wsgT",
-	"Pl9zucIHg1b1yju30jjI8GtDqhldy8EQSaHKbmWGSu7UBeDd1ELIoxOngLsnXV9DTg+Ya97M56Ncp9zM",
-	"0R70LQZJI9l8NvridUi9al+8hJxu3OaEy6Aj70X4aSeeaEpB1DnZZ4iveFvcYXKb+9uo7NuhU1AOJ448",
-	"ftuPY06/7rld7u5A6KGBmIZKg8ErKlZTGfqqlnGMdnAV3BkLm6Emn7r+feT4vR59LypZCgnZRknYJdOS",
-	"CAnf48fkccJrcqQzCixjfftvkA78PbC680yhxtviF3e7f0L7FivzjdJ3ZRKlASeL9xMskAfN7X7Km9pJ",
-	"eVkmTIs+grPPAMy8cdYVmnFjVC5QZjsvzNx7BZM10od7dtH/qolLuYOz1x+3Z0OLkwOgjhjKinGWlwI1",
-	"yEoaq+vcvpMcdVTRUhNOXOExPq61fB6apNWkCS2mH+qd5OjA12iukg4bS0ioab4BCMpLU69WYGzvrbME",
-	"eCd9KyFZLYXFuTbuuGR0XirQ6El1Qi03fMeWjiasYr+CVmxR2670jwHKxoqy9AY9Nw1Ty3eSW1YCN5Z9",
-	"L+SbLQ4XjP7hyEqw10pfNlhI3+4rkGCEydLOZt/SV/Tr98tfex9/dHenz8HptM2YMHPL7CRJ+f/u/8ez",
-	"t2fZf/Ps10fZl/92+v7D048PHg5+fPLxr3/9/7s/ffbxrw/+419TOxVgT4XPesjPX/iX8fkLfP5Ervp9",
-	"2D+Z/n8jZJYkstibo0db7D6mivAE9KCrHLNreCftVjpCuuKlKBxvuQk59G+YwVmk09Gjms5G9JRhYa1H",
-	"PipuwWVYgsn0WOONpaihf2Y6UB2Nkj72HM/Lspa0lUH6pjjM4F+mlvMmGQHlKXvGMFJ9zYOTp//zyedf",
-	"zOZthHnzfTaf+a/vE5Qsim0qj0AB29RbMQ6SuGdYxXcGbJp7IOxJVzry7YiH3cBmAdqsRfXpOYWxYpHm",
-	"cCFkyeuctvJckoO/Oz9o4tx5y4lafnq4rQYooLLrVP6ijqCGrdrdBOi5nVRaXYGcM3ECJ32dT+Hei96p",
-	"rwS+DI6pWqkpr6HmHBChBaqIsB4vZJJiJUU/vfAGf/mbO38O+YFTcPXnTHn03vv26zfs1DNMc49SWtDQ",
-	"URKCxFPaB092HJIcN4tjyt7Jd/IFLFH7oOSzd7Lglp8uuBG5Oa0N6K94yWUOJyvFnoV4zBfc8ndyIGmN",
-	"JlaMgqZZVS9KkbPL+EHSkiclyxqO8O7dW16u1Lt37we+GcPng58qyV9ogswJwqq2mU/1k2m45jpl+zJN",
-	"qhccmXJ57ZuVhGxVk4I0pBLy46d5Hq8q00/5MFx+VZVu+REZGp/QwG0ZM1Y18WhOQPEhvW5/f1D+YtD8",
-	"OuhVagOG/bLh1Vsh7XuWvasfPfoMI/vaHAi/+Cvf0eSugsnaldGUFH2lCi6cnpXoq55VfJUysb1799YC",
-	"r3D3UV7eoI6jLBl260QdhgADHKpdQBPiPLoBBMfRwcG4uAvqFdI6ppeAn3ALuwHYt9qvKH7+xtt1IAaf",
-	"13adubOdXJVxJB52psn2tnJCVvDGMGKFr1WfGG8BLF9DfukzlsGmsrt5p3tw+PGCZmAdwlAuO4owxGxK",
-	"aKBYAKurgntRnMtdP62NoYgKHPQ1XMLujWqTMR2Tx6abVsWMHVSk1Ei6dMQaH1s/Rn/zvVdZCDT12Ukw",
-	"eDOQxbOGLkKf8YNMIu8dHOIUUXTSfowhgusEIoj4R1Bwg4W68W5F+qnlCZmDtOIKMijFSixSaXj/c2gP",
-	"C7A6qvSZB70XcjOgYWLJ3FN+QRerf95rLlfgrmd3pSrDS8qqmnTawPfQGri2C+B2r55fxgkpAnT4pLzG",
-	"yGvU8M3dEmDr9ltY1NhJuHavClQUURvvvXwy7n9GgENxQ3hC9/alcDL61vWoS2QcDLdyg93mWetd82I6",
-	"Q7jo+wYwZam6dvvioFA+2yYldYnul9rwFYy8XWLr3cR8GB2LHw5ySCJJyiBq2Rc1BpJAEmRqnLk1J88w",
-	"uC/uEOMzs+eQGWYiA7G3GWESbY+wRYkCbOO5SnvPdceKSlmBx0BLsxbQshUFAxhdjMTHcc1NOI6YLzVw",
-	"2UnS2W+Y9mVfarrzyJcwSoraJJ4Lt2Gfgw7e/T5BXchKF1LRxY/+CWnl3NsLwxdS26EkiqYFlLCihVPj",
-	"QChtwqR2gxwcPy6XyFuylFtipKCOBAA/B7iXy0PGyDbCJo+QIuMIbHR8wIHZDyo+m3J1DJDSJ3ziYWy8",
-	"IqK/IR3YR476ThhVlbtcxYi9MQ8cwKeiaCWLnkc1DsOEnDPH5q546dicf4u3gwwypOGDopcPzbvePBh7",
-	"aOwxTdGVf9SaSEi4yWpiaTYAnRa190C8UNuMIpSTb5HFduHoPRm7gPHSqYNJuejuGbZQW3TnwquFfOUP",
-	"wDIORwAj0r1shUF6xX5jchYBs2/a/XJuigoNkoxXtDbkMiboTZl6RLYcI5f7UXq5GwHQU0O1tRq8WuKg",
-	"+qArngwv8/ZWm7dpU0NYWOr4jx2h5C6N4G+oH+smhPtbm/hvPLlYOFGfJBPeULN0mwyF1LmirIPHJCjs",
-	"k0MHiD1YfdWXA5No7fp6dfEaYS3FShzzHRolh2gzUAI+grOOaJpdpjwF3Fse8B6/CN0iZR3uHpe7B5ED",
-	"oYaVMBZao1HwC/o91PEc0ycrtRxfna300q3vtVLN5U9mc+zYWeYnXwF64C+FNjZDi1tyCa7RNwaVSN+4",
-	"pmkJtOuiSMUGRJHmuDjtJeyyQpR1ml79vN+9cNP+0Fw0pl7gLSYkOWgtsDhG0nF5z9Tk2753wS9pwS/5",
-	"na132mlwTd3E2pFLd44/ybnoMbB97CBBgCniGO7aKEr3MMgo4HzIHSNpNPJpOdlnbRgcpiKMfdBLLYS9",
-	"j938NFJyLVEawHSEoFqtoAjpzYI9TEZJ5EolV1EVp6ralzPvhFHqOsw8tydpnXfDhzEn/Ejcz4QsYJuG",
-	"Pn4VIORtZB0m3MNJViApXUlaLZRETezijy0iXd0ntoX2AwCSTtBvesbs1juZdqnZTtyAEnjh3yQGwvr2",
-	"H8vhhnjUzcfcpzuZT/cfIRwQaUrYqLDJMA3BCAPmVSWKbc/wRKOOKsH4UdrlEWkLWYsf7AAGuk7QSYLr",
-	"pNL2rtZewX6Kb95T9yoj32vvWOzom+c+AL+oNVowOp7Nw7ztzVtt4tq/+/nCKs1X4K1QGYF0qyFwOceg",
-	"IcqKbpgV5E5SiOUSYuuLuYnloAPcQMdeTCDdBJGlTTS1kPaLpykyOkA9LYyHUZammAQtjNnk3wytXEGm",
-	"j1RJzZUQbc0NTFXJcP3vYJf9zMvaPTKENq17rjc7dS/fI3b9avMd7HDkg16vDrADu4Kap9eANJjS9Def",
-	"TJTA+p7ppPjH52VnC4/YqbP0Lt3R1viiDOPE394ynaIF3aXc5mC0ThIOlim7cZH2TXCnB7qI75PyoU0Q",
-	"xWEZJJL346mECSUsh1dRk4viEO2+AV4G4sXlzD7OZ7fzBEjdZn7EA7h+1VygSTyjpylZhjuOPUeinFeV",
-	"Vle8zLy/xNjlr9WVv/yxeXCv+MQvmTRlv/n67OUrD/7H+Swvgeus0QSMrgrbVX+aVVEZh/1XCWX79opO",
-	"0hRFm99kZI59LK4xs3dP2TQoitL6z0RH0ftcLNMO7wd5n3f1oSXucfmBqvH4aW2e5PDTdfLhV1yUwdgY",
-	"oB1xTsfFTausk+QK8QC3dhaKfL6yO2U3g9OdPh0tdR3gSTjXj5iaMv3ikD5xJbIi7/zD71x6+kbpDvP3",
-	"kYlJ56HfTqxyQjbhccRXO9Sv7AtTJ4wEr19Wv7jT+PBhfNQePpyzX0r/IQIQf1/43/F98fBh0nqYVGM5",
-	"JoFaKsk38KCJshjdiE/7AJdwPe2CPrvaNJKlGifDhkLJCyig+9pj71oLj8/C/1JACe6nkymP9HjTCd0x",
-	"MFNO0MVYJGLjZLqhkpmGKdn3qcYgWEdayOx9SQYyxg6PkKw3aMDMTCnytGuHXBjHXiU5U7rGDBuPaGvd",
-	"iLUY8c2VtYjGcs2m5EztARnNkUSmSaZtbXG3UP5411L8swYmCveqWQrQeK/1rrrwOMBRBwJpWi/mByY7",
-	"VTv8bfQge+xNQRe0Twmy1373orEphYWmiv4c6QEezzhg3Hu8tz19eGqmaLZ11wVz2jtmSun0wOi8sW5k",
-	"jmQpdGGypVa/QtoQgvajRCKMYPgUqOb9FWTKc6/PUhqjclvRvZ390HZPfxuPbfyt38Jh0U3VsZtcpulT",
-	"fdxG3uTRa9Lpmj2Sxx5hsYdBNzRghLXg8YqcYbEMSvA+4pLOE2WB6ESYpU9lHMt5SuO3p9LDPIh/Lfn1",
-	"gqdqxLi3kIMp2t6On5RVLHQOG2CaHAc0O4s8uJu2gjLJVaBbG8QwK+0N3zU07eQXTfuAQYqKny5zclMo",
-	"jUoMU8trLqmKuOtH/Mr3NkAmeNfrWmnMA2nSLl0F5GKTVMe+e/e2yIfuO4VYCSqQXRuIKjD7gRglm0Qq",
-	"8lWsm8wdHjXnS/ZoHpWB97tRiCthxKIEbPGYWiy4weuyMYc3XdzyQNq1weZPJjRf17LQUNi1IcQaxZq3",
-	"Jwp5jWPiAuw1gGSPsN3jL9l9dMk04goeOCx6IWj27PGX6FBDfzxK3bK+wPk+ll0gzw7O2mk6Rp9UGsMx",
-	"ST9q2vt6qQF+hfHbYc9poq5TzhK29BfK4bO04ZKvIB2fsTkAE/XF3URzfg8vkqwBYKxWOyZsen6w3PGn",
-	"kZhvx/4IDJarzUbYjXfcM2rj6Kktr0yThuGo1r+vFxXgCh/R/7UK7n89XdcnfsbwzUjMFnop/4A22hit",
-	"c8Yp+WcpWs/0UK+TnYfcwlhAq6mbRbhxc7mloyyJjupLVmkhLeo/arvM/uKexZrnjv2djIGbLb54mihE",
-	"1a3VIo8D/JPjXYMBfZVGvR4h+yCz+L7svlQy2ziOUjxocyxEp3LUUTftkjnmF7p/6KmSrxslGyW3ukNu",
-	"POLUtyI8uWfAW5Jis56j6PHolX1yyqx1mjx47Xbop9cvvZSxUTpVMKA97l7i0GC1gCuMmEtvkhvzlnuh",
-	"y0m7cBvof1//pyByRmJZOMvJh0Bk0dwXLO+k+J+/bzOfo2GVIhF7OkClE9pOr7f7xN6Gx2nd+vZbchjD",
-	"byOYm4w2HGWIlRHve3Kvb/r8Hv5CfZBozzsKx8e/MO3e4CjHP3yIQD98OPdi8C9Pup+JvT98mE5AnFS5",
-	"uV9bLNzmRYx9U3v4lUoowELVwsahyOdHSCggxy4p98ExwYUfas66FeI+vRRxN/FdaW/T9Cl49+4tfgl4",
-	"wD/6iPidmSVuYBulMH7YuxUykyRTNN8jP3fOvlLbqYTTu4MC8fwBUDSCkonqOVzJoAJo0lx/0F8kolE3",
-	"6gJK5R6ZcVGgWJ//58GzW/x8D7ZrURY/t7ndeheJ5jJfJ72EF67j30lG71zBxCqTdUbWXEook8PR2/bv",
-	"4Q2ceKX/Q02dZyPkxLb9CrS03N7iWsC7YAagwoQOvcKWboIYq920WU1ahnKlCobztEUtWuY4LOWcKqGZ",
-	"iG/GYTe19X6rGAvuEw4tRYlumGm7MbbMNLcjCbSw3nmoL+TGwfLjhtQMNDpoxsUGL2bDN1UJeDKvQPMV",
-	"dlUSet0xhRqOHFWsYKZyn7AlJqxQzNZaMrVcRssAaYWGcjdnFTeGBnnklgVbnHv27PGjR0m1F2JnwkoJ",
-	"i2GZP7ZLeXyKTeiLL7JEpQCOAvYwrB9bijpmY4eE42tK/rMGY1M8FT9Q5CpaSd2tTfUkm9qnJ+xbzHzk",
-	"iLiT6h7VlSGJcDehZl2VihdzTG785uuzl4xmpT5UQp7qWa5QW9cl/6R5ZXqC0ZDZaSRzzvRx9qfycKs2",
-	"NmvKT6ZyE7oWbYFM0fO5QT1ejJ0T9oJUqE0Bf5qEYYpsvYEiqnZJj3gkDvcfa3m+Rt1kRwIa55XTC7EG",
-	"dtZabqLow6b6ETJsB7evxUqlWOdM2TXoa2EAI/LhCrrpEJvcoF43HtIjdpenaymJUk6OEEabWkfHoj0A",
-	"R5JscCpIQtZD/JGaKarHfGxd2gvslY7F6BW57Vn9Q3K9kGKbfe+NCzmXSoocSyGkJGlM3TbNTDmhakTa",
-	"vmhm/oQmDleytG4TC+yxOFpsNzBCj7ihyT/66jaVqIP+tLD1JddWYI3nbFDMQ6VrbxAT0oCvZuWIKOaT",
-	"SiecmpKBEI0DxZFkhFmZRjSc37hvP3j9NybFuBQSNV0ebf59Riar0gi0TEsmLFspMH493Wge89b1OcEs",
-	"jQVs35+8VCuRX4gVjkFudG7Z5DM6HOoseJB6j03X9rlr63PnNz933MFo0rOq8pOO10FPCpJ2K0cRnPJb",
-	"Co4kEXKb8ePR9pDbXtdvvE8docEVeq1BhffwgDCaWtrdUb52b0uiKGzBKKIymUBXyAQYL4UMJtT0BZEn",
-	"rwTcGDyvI/1Mrrmlt8MknvYGeDkSAIERymSDv+1Q/coBDiW4xjDH+Da2ZcBHGEfToJX4udyxcCgcdUfC",
-	"xHNeNq7TiaLeKFV5IarA4KJeme8U43CMOwshkx10HQzfa7pjNY5jb6KxHIWLuliBzXhRpFJbfYVfGX4N",
-	"QWKwhbxuilA10YHdHOVDavMT5UqaerNnrtDgltNFdfMT1BDX7g87jJl2Fjv8N1WBaXxnvNP00VG5wUO6",
-	"OC4x/zDKOCX1OprOjFhl0zGBd8rt0dFOfTNCb/vfKaWHcN0/RDRuj8vFe5Tib1+7iyNO3DvwT6erpcmr",
-	"i77gCr+HhEdNRsguV8KrbFBnDL0ecPMSW9YDPjRMAn7Fy5FI+NhWQvcr2Q/G4uHz0fQN3Pr0XJazvSxo",
-	"NOUR+Qr3rC9DE+KYfzC5B9+d1cKvdS9Cx21333UsdeQj1jKLUQvdzYxo7QYfa0X77mosRUKo04Hf43og",
-	"3otn7tPAw5VQdfC+Cj7Q4UlIv/oUPJ26HyPrT0YW/N5Wi1Ebyxtfv5aW6d/k3/1MVlgG0urdH8DiMtj0",
-	"flGZhLRL6qm2CWtKH04qhdi5FafUsEmVS/GyYdCVEWvp0NKg/MyArF5MEQcG+Pg4n50XR12YqZI7Mxol",
-	"dexeitXaYsb+vwEvQL86UJGgrUKAR6xSRrQVSEs3mE8Bu8bhTqYGGzgCFnFFheFYwQn1CnKLZWdb5zoN",
-	"cEx9BTdZMPr8b2WC8ed0E5PhCxLsq0IwrDV74I4fJE6Kkn9Rnc6T6Tn3zxoXaooAu+amTdfSi5meHLm5",
-	"XEKOWZH3Jqr6zzXIKAnSPOhlEJZllLdKNHFMmNf7eK1jC9C+PFJ74Ynq69wanLE49kvY3TOsQw3JwqFN",
-	"EN9NEgcjBsgEFnJIjymSvdeYMA1lIBaCS7BPxdwWxxjN+RylXbvhXIEk3cXRpmLbM2W66PmkuVzXo9I+",
-	"YkjOWC6rYc3k8ffHCyxRbbyDHG8SD8evdHY+LJxz7RMXY1qxxnYSUhiDCb+FHII0Sykuff0AxApZqq65",
-	"LkKLO0kKRXeTSAO9bGYWbQDH0MkhUYoBY6HyUjkxIhsLKOvGTDQOh/cMeYa2CXwQriVoDUVjEimVgcyq",
-	"EPCxD459qCD31xshwYyWPyLgRlNfv25ze2MZOI6prrn3eo0XyDRsuINORxm4x+fch+zn9D0E4YcyYAc1",
-	"TA29Hq5HG0J3hBkgMab6JfO35eHg/psom4SUoLNgeeqn45bdjGyYd7Ooc7qg44PRKOQm587Zw0qSepp8",
-	"uMreGyEKkr+E3Sk9gkIh37CDMdAkORHoUcLR3ibfqfrNpOBe3Ql4v28euUqpMhsxdpwPc4j3Kf5S5JeA",
-	"OQAbF/eRGu3sPurYG2v29XoXcmZXFUgoHpwwdiYpqCgYtrvlBXuTy3t23/xbnLWoKa2/V6qdvJPp6AxM",
-	"uK9vyc3CMPt5mAHH6m45FQ1yIEP1Vo653Fxjcv5uFc+Tqa/yoam5X0W+JSqCIiWTXJDF6jke9JTiCFMg",
-	"RLk60JDJmbd0MVOqlC/vTdI0uKHSmIonQ4AsyCnZAhoo/OBJBCTroidOIaW+80nv1JJpaI3IN83+Nyzh",
-	"nnrR92duZunyu6XS0CnG7npTps8m8AXTaOJ/FsJqrnc3ydE3KCE/0J6MYvmgO1bjidUupPXGGuKwLNV1",
-	"hswqa+pcpJ62rp3pXsah6Frbz53qBUR+Xdx4QW3H1rxgudIa8rhHOt6ToNooDVmp0M0rZYFeWid3bzDI",
-	"S7JSrZiqclUA1YtJU9DYXLWUHMUmiLxqkigg2sFoYeoT0fHEKd2dSnakDEWt1RG183OgyPU2qxMtOiNb",
-	"5ojHMhifxcljiBoP4d1T+z/Nm5dii3QDOnXkl8zqGubMt+jXyPYHn2tgG2EMgdLQ0rUoSwwcF9vI8to4",
-	"LqRROyL2nqNb5ZVA35tuEgGShit35zWZFWIecBGnPWJ2rVW9WkcJphs4w5NX1/5BHI/yk6nRPQojyNwU",
-	"T9lGGetfmjRSu+TW5ex+rqTVqiy7SikS0Vde0/49357luX2p1OWC55cP8F0rlW1WWsxDfHXfObCdSfdS",
-	"i3Uv4IzKmR9O1Uvt0FXOE+1kBtljcUcXdo/AfH+Ygx7WuZ8NF9ZfV5eZpp8xZ5JxqzYiT5+pP5e33aiP",
-	"XIpFJXOWUW1FyjKBzfCwx5dV41yBLHKIZpA8WRzujHlG4I3MyG7cf1EC74/LluAZzchFOWQuXorK8lFZ",
-	"rwcAQkqhz7bWVJAxlsQarqJWlCoBTeR9QCfeKuiJdDvY3Ah3DpSFWwE18H5sALxPyoc55ZYjT8qF2obv",
-	"D9rkczcC/uN+Ku8wjzEXr4uWtDQ5eYVENSMcIZ3ieq8/1BsMe19M9YpqiudOvOEjAMb9pDowTPKWOhaM",
-	"JRclFFmq9uJ5o6OaRy9tH5rVL4kujOfkOa9D6UM3dq3BJ04hEV937V8Vd6SkmuZDTbIsYAsU1/EraEU1",
-	"DeeR/QVKKnnYUwaoKivhCjruYz6bS42ipriC0Nc0nVkBUKE1sq8jS/lFxXd5T3Hi155FnjVTsJvUpBBi",
-	"aafYATVJUqmzlRkdEzP1KDmIrkRR8w7+zLEiR1cN6I5yAlWDN0IW3pFTp/mJRngdBjgL/VOiTMDE+2l8",
-	"6GgWlEbdPgZ00E+yNmOnXqbdJONURY2BBWcrGkMskXjLN0zFr+W4QnJI8u1za+I+CSUjxH69hRylGv/e",
-	"gcK/eEaMFD7rCVK7BCjoVeC6JLTta5BMqqjE5DU3zVOlzaEYfqCJsZGQ/jV9A6Ny6814+51lOBgzvWRq",
-	"ow8J3dDpzdXzv8tJ3HsQR8dL0YgBH/63R/8VqNs/O7ABlvKWbj+d7I9FGv0t5rn4nC3qMFBZqmuqGRm/",
-	"Q19AsIMS9QUTkBfLRXMtB6/NuU/v2Vd1iMhffcN3TGn8x706/1nzUix3yGcI/NCNmTV3JOQNr+QR4L1A",
-	"3cT7xat5ACxoW1SYitYtpo4ZDbdzo0RAu4s8FPdRbMMvId4GdHYg/plbxzhNvUDNhbuye9s5xIJffEjR",
-	"suFF/NLHRJHdMuohdbDr/X+1sXDxVCG/W1XyPFQI9SWKunwGqwAH4rJr2OwPlhzytUACTWXhlmh1iK4v",
-	"bqAyPZJ1pSIQxsqvdMAeVFwdVJ651TIman57NTb2hJlOWspd78JUr5sB0HGdxkPgx2UrPw3+kzlcx5Yx",
-	"Bfw/Ct5HCtXG8FJN2k+A5U4GjgSspK1eqG2mYWkOOZiQuto953WbuyOoWIXMNXBDHjfnP/qHZ5uiVEj3",
-	"ECaf0Mam2YxSwFLIllkKWdU28Y7BTKVyFyEsVvojWkdMaGNSghMmr3j54xVoLYqxjXOng0o6xiUigqHD",
-	"902oMJo7dTiAMO0bDuMzWzV63Mxd4FSEitw1jeWy4LqImwvJctDu3mfXfGdublFqjAOHbEo8kma6WQMi",
-	"6xKSNgFS7rxR+Jb2ngZAfoeGnwkGG/QLThhrSLVj1Yh9ZgjDn8Jgs+HbrFQrjCIcORA+Ny1a+OgJqCSq",
-	"wUk+m7buMI8Rv8L+aTAtv2dEVuGsU6bYf+5/xK3EZ+RPUti9J590lP2wTvK7pYMZkCpXrfM/EcvwPKYi",
-	"cX3ylTgaNwibIVQl0B5Emwgj9qGuXnxkF9ENwodxx0rw6eXOup4WqXhf0gxkqDEwe9z7wbSu7Dz37llD",
-	"VdpA1UBImfto6SM1baSfD/fSCHhUm96f9e60jcuMG+eYGnH746OzSlVZPsXnkyp3FN5M4CHtwjhCH5ER",
-	"YGTdjXuMaWrZdPIedYraHFsmb7SoziFrV5Xve/SPqYlGOHrXBKGWyMuocjtqtzCSp1GmzPsxZl01WMMk",
-	"GGca8lqjmvia7w6XHRvJGH3xt7PPHz/5+5PPv2CuASvECkybdbxXtqv1CxSyr/f5tJ6Ag+XZ9CaE7AOE",
-	"uGB/DEFVzab4s0bc1rQpRQdFy47RLycugMRxTJSLutFe4Tita/8fa7tSi7zzHUuh4LffM63KMl31oZGr",
-	"EgaU1G5FJhT3AqlAG2GsY4RdC6iwrUe0WaN6EHP/XlE2GSVzCPpjTwXCjrhcpRYy5lCL/Axju73ViMG2",
-	"Kj2vIkvPvnX5dxpp6FBoRK+YBbBKVV60F0uWgggjiHQUWesVn6gRj3xkG2ZL3rIpQvSe52nSiwtm7+f2",
-	"3WKuNs3p3SYmxItwKG9AmmP2ifG8BTfhJK1q/w/DPxKJGO6MazTL/S14RfJ9cLOi/JNAGwblJ8gDARiJ",
-	"tu3ESUaBYlEiYk1WArQnBANyX/z4vjUsHwwLQUhChwPgxeGzbbsmksGD8ztn9P2+QUq0lPdjlNBZ/qGI",
-	"3MB6m4sk2iKvNLEWDLElNRQLo3Br87yJYh55lQyCnbVSlrmXaVkmgqRJj4NnKiYc9yTQV7z89FzjG6GN",
-	"PUN8QPF6PDQqjpSNkUyoNDfL0/eST5o7ioq9u6nlKwzM/k9we5S85/xQ3gg/uM1QuYMV61fhVqBYb3aN",
-	"Y5KT1eMv2MIX26g05ML0jfvXQThpAkNBi6V3aIWtPRCJemidPyt7CzJeBk8c9kNk3mps9h7C9oj+zkxl",
-	"5OQmqTxFfQOySOAvxaPi4rwHrotbFma4WdqXKIHbkWlfhmWHpy6PUpu4S6c2MFzn5Nu6g9vERd2ubWrO",
-	"osn1Hd69e2sXU1INpWsxuO6Y6+hOijIcVZLhN8hyRDjyY/h5UxTz81jeW8rtOpKbu7cftSgPOqx0Mq1/",
-	"nM9WIMEIg7nE/+5rx3zauzRAQJkXhkeVYL1NuhhCTGKtncmjqaIc6hPSp/tuiZzXGNWY11rYHdYNDgo0",
-	"8fdkPqZvm9wePjdMY0vzd59Vl9DUbm8zgdQm3K7fKl7ifUQmPuluIVWesK8pw7c/KH+9t/h3+OwvT4tH",
-	"nz3+98VfHn3+KIenn3/56BH/8il//OVnj+HJXz5/+ggeL7/4cvGkePL0yeLpk6dffP5l/tnTx4unX3z5",
-	"7/ccH3IgE6Ahtf+z2f+bnZUrlZ29Os/eOGBbnPBKfAdub/CtvFRY19IhNceTCBsuytmz8NP/HU7YSa42",
-	"7fDh15mvzzRbW1uZZ6en19fXJ3GX0xWG/mdW1fn6NMyD1QY78sqr88ZHn/xwcEdb7TFuqieFM/z2+uuL",
-	"N+zs1flJSzCzZ7NHJ49OHvvS1pJXYvZs9hn+hKdnjft+ivk1T41PnX/axmol7Xav0WU9COd6BQW730Td",
-	"/FtjuTUPQvDOUpR4ZfzDEDE2qzgvkLh8jdIZVl1DZywE68mjR2EvvKQTXTinGP3x7MOsrW3fFyYGSH3T",
-	"ApyErK35OFz0T/JSqmvJMBkgHaB6s+F6RyvoYCMaHLeJrwwq2bW44hZm713vPs6ryhcsGEM5VrnqnvLQ",
-	"GQmkyXjvThglwvdlB0wK5cNiCbfE/t7kkIPJEruDjV45mEP6nCahojcIeZyhzZgQ1pwRUjsMED2fVXUC",
-	"nV9jYI3Zh7N5lISfoFFl0WB8gNFX9f8QjDrS9XfT7NkH99caeImJtdwfG0eoefikgRc7/39zzVcr0Cd+",
-	"ne6nqyen4RVy+sFnTPm479tp7BF2+qGTWKY40DN4PB1qcvohlMzeP2CnXLL3NY06TAR0X7PTBZbJmtoU",
-	"4tWNLwVp3px+wAf46O+nXoua/oiKELphT0OCppGWlIoj/bGDwg926xayfzjXJhov5zZf19XpB/wPku1H",
-	"Ou0lpDI5UYkOztrmcyYs4wulsQKzzdeOG4TSr8JELQdH/sz1ek4QhEr66F40e/Z2GP+FA7EwEooo7v5t",
-	"JYjOTK2QiOaUiCk0InCnfSsIv32Uffn+w+P540cf/8UJuv7Pzz/7ONF7/nkzLrtopNiJDd/fkuMNdDbt",
-	"ImmTGgY2fGR4WhiP7/Fb1RuINcg4UN+xN/zwrYQM+Okd8vhu3uEEf/+KFyykScC5H3+6uc8l+Yg7QZUE",
-	"6o/z2eefcvXn0pE8L4NIdkPh7YwOf8wUmN/slPA2n0klo2SKckVihkqlqhjhN8byG/CbC9frf/lNp+HA",
-	"yodxeKRt9cXdI78eukyaWnYQMsyG2AJeXHGZh2CsNjoC94skb08YjQNubWBZlyENSVWKJdXMV6oME5m6",
-	"qhzHWXLTUJYPyXAPZsqi0AzNapkrSa5TGP0SDMCYDQGNyOZSVJ0uYumoyldzp0isk7Dp/6xB79pd3wj3",
-	"8h28mVrnvt+ShRMe74CFdwe6Yxb+5Eg2+udf8f/sS+vpo798OghC8qI3YgOqtn/WS/OCbrBbXZpehqf6",
-	"G6d2K0/Rvfv0Q+e54j8Pnivd39vucYurjSogPCHUcmlQtbLv8+kH+jeaCLYVaLEBSWX5/a90c5xiUfjd",
-	"8OedzJM/DtfRycs88vNp0KimXsndlh86f3ZffmZd20JdUx3TpLyC1ycv2YZLvqIg/kYJ6e5BP0CbMpr9",
-	"WDUXlY/dZRzL76natlpiCmXxAf2NHR9vtMabayUkToAGWZyFL11XHl3gvgLmUId44SH7QRUwlI1SF6GH",
-	"sXMZNkchVWvy/d1oJyPG+/G4g4KGY/J6GJKR+1ib/t+n11xYJ0H53M2I0WFnC7w89YXaer+2tVEGX7Dg",
-	"S/RjnJUg+esp756LrgbFbdlYx4F6JfXVaxBGGoVgmvC5Nd7ExhAkl8YM8va923UD+ipQUqvbf3Z6itGV",
-	"a2XsKUqiXb1//PF9s9GhBHWz4e7bNlNarITkZeaVZG21ydmTk0ezj/8nAAD//1Xaw+sdDwEA",
+	"H4sIAAAAAAAC/+y9a3McN5Io+lcQPRshS9tNSrLsHevGxF5a8kPXsq0Qac/dY/mM0VXobgyrgRoARXZb",
+	"R//9RCYehaoCqqtJmrZj95PELjwSiUQikc/3s0JuaymYMHr2/P2spopumWEK/6JFIRthFryEv0qmC8Vr",
+	"w6WYPfffiDaKi/VsPuPwa03NZjafCbpls+dx//lMsX81XLFy9tyohs1nutiwLYWBzb6G1mGk3WItF26I",
+	"MzvEq5ezDyMfaFkqpvUQyu9FtSdcFFVTMmIUFZoW8EmTa242xGy4Jq4z4YJIwYhcEbPpNCYrzqpSn/hF",
+	"/qthah+t0k2eX9KHFsSFkhUbwvlCbpdcMA8VC0CFDSFGkpKtsNGGGgIzAKy+oZFEM6qKDVlJdQBUC0QM",
+	"LxPNdvb8p5lmomQKd6tg/Ar/u1KM/coWhqo1M7Of56nFrQxTC8O3iaW9cthXTDeV0QTb4hrX/IoJAr1O",
+	"yLeNNmTJCBXk7ZcvyMcff/wZLGRLjWGlI7LsqtrZ4zXZ7rPns5Ia5j8PaY1Wa6moKBeh/dsvX+D8526B",
+	"U1tRrVn6sJzBF/LqZW4BvmOChLgwbI370KF+6JE4FO3PS7aSik3cE9v4Tjclnv933ZWCmmJTSy5MYl8I",
+	"fiX2c5KHRd3HeFgAoNO+BkwpGPSnx4vPfn7/ZP7k8Ye//HS2+F/uz08+/jBx+S/CuAcwkGxYNEoxUewX",
+	"a8UonpYNFUN8vHX0oDeyqUqyoVe4+XSLrN71JdDXss4rWjVAJ7xQ8qxaS02oI6OSrWhTGeInJo2omNY4",
+	"mqN2wjWplbziJSvnhAtyveHFhhRU2yGwHbnmVQU02GhW5mgtvbqRw/QhRgnAdSN84IL+uMho13UAE2yH",
+	"3GBRVFKzhZEHrid/41BRkvhCae8qfdxlRS42jODk8MFetog7ATRdVXticF9LQjWhxF9Nc8JXZC8bco2b",
+	"U/FL7O9WA1jbEkAabk7nHoXDm0PfABkJ5C2lrBgViDx/7oYoEyu+bhTT5HrDzMbdeYrpWgrNiFz+kxUG",
+	"tv3/O//+OyIV+ZZpTdfsDS0uCROFLFl5Ql6tiJAmIg1HS4hD6Jlbh4Mrdcn/U0ugia1e17S4TN/oFd/y",
+	"xKq+pTu+bbZENNslU7Cl/goxkihmGiVyANkRD5Dilu6Gk16oRhS4/+20HVkOqI3ruqJ7RNiW7v72eO7A",
+	"0YRWFamZKLlYE7MTWTkO5j4M3kLJRpQTxBwDexpdrLpmBV9xVpIwyggkbppD8HBxHDyt8BWBw8UBcLiY",
+	"Bo5guwTNwOmGL6SmaxaRzAn5wTE3/GrkJROB0Mlyj59qxa64bHTolIERpx6XwIU0bFErtuIJGjt36NCE",
+	"EtvGceCtk4EKKQzlgpWECwu0NMwyqyxM0YTj753hLb6kmn36bPbh0NeJu7+S/V0f3fFJu42NFvZIJq5O",
+	"+OoObFqy6vSf8D6M59Z8vbA/DzaSry/gtlnxCm+if8L+eTQ0GplABxH+btJ8LahpFHv+TjyCv8iCnBsq",
+	"SqpK+GVrf/q2qQw/52v4qbI/vZZrXpzzdQaZAdbkgwu7be0/MF6aHZtd8l3xWsrLpo4XVHQerss9efUy",
+	"t8l2zGMJ8yy8duOHx8XOP0aO7WF2YSMzQGZxV1NoeMn2igG0tFjhP7sV0hNdqV/hn7quoLepVynUAh27",
+	"KxnVB06tcFbXFS8oIPGt+wxfgQkw+5CgbYtTvFCfv49ArJWsmTLcDkrrelHJglYLbajBkf5NsdXs+ewv",
+	"p63+5dR216fR5K+h1zl2ApHVikELWtdHjPEGRB89wiyAQeMnZBOW7aHQxIXdRCAlDiy4YldUmJPZPHUm",
+	"2wP8k5upxbeVdiy+e0+wLMKJbbhk2krAtuEDTSLUE0QrQbSiQLqu5DL88NFZXbcYxO9ndW3xgdIj4yiY",
+	"sR3XRj/E5dP2JMXzvHp5Qr6Kx0ZRXIJ6acmcqAF3w8rdWu4WC7olt4Z2xAea4HaCsubDPKBBa2buguLw",
+	"WbGRFUg9B2kFGn/t2sZkBr9P6vznILEYt3niglbEYc6+cfCX6HHzUY9yhoTj1D0n5Kzf92ZkA6OMEIx+",
+	"1WLxrokHf+GGbfVBSoggiqjJbQ9Viu5nTkhcoLA3JJMfNLMUUtM1FwjtHJ5Pgmzppd0PiXgHQmA6vIss",
+	"LeGgrQrVyZwO9ScDPcufgFpTG+slUU0oqbg2+K7GxmTDKhScqfAEHZPKjShjwoaPLCLAfK1obWnZfbFi",
+	"Fxf4nreNLKy3vHgn3olJmNvP8UYjVDdmywdZZxIS+NCH4XNaUVEwfaE4e6OkXN3BSV9WsrhcbKjepA8B",
+	"fPEGEWxLNoyW7gVuGRa85/xTon2f7A3rqD//90f/+RzUnnTx6+PFZ/9++vP7Zx8ePhr8+PTD3/72f7o/",
+	"ffzhbw//899Sh7eQ2y03WyaS2hf/DfU9eJ6lmUfaa1QXUdKqa0lFl6x63l/XnDz4y4M5XgTwpRE1LfF9",
+	"SjX7+KlV1ABnchg5//ps8cmTp6dPP/m0g71CogrD8jT4sUX93MFmpKEVqtVQNqkV00yYuZU3FgjS4oop",
+	"uGFwFOx8Ql5UHKiKFFQQxYDMGsMIN2Sl5Bbm1u6Zqu0qig0rLqPpCV1TLrSJNjm9u4MNqBhdpekG2DIT",
+	"ZOlIlhjFGWEVgw25f0LBZ2cSTiHFAlZBhCyZDhjDDWkNgZyRUl4LT0k1VUyEz9AdljTpihyc4e9kyVKX",
+	"JACQu5ek6Z7LGMn3j9yDV6gDs7UXU8OGgBOuybLhlSHyiqnUhTqfjR6EwwzMzozdSdydlNRQQs3w5EPv",
+	"VuHT8hsvrxVSaCZ0g6p7IwtZJY6ONz9rOJNcDDiOvv89s5wmjTGnFR4wNr9/nksl0EV1FmP3vcae9BXd",
+	"c517w/GwuddM4bGbB/WXw9MUqQ1PM+AKd1s7Jt8KYqCO1rJRBUO1sNwFHLXk0DvJcOcD3F9Tvbmru/7r",
+	"5En5PL7a8VaZhlEcbQp2vnbHkHakiHaJr+Va38ESK3nMc6WuX9CqgqmHHLi3Whx4kvBeVQQaE7blKGZw",
+	"EVnV7VVKvqDFhtC6JgWtqnlrHpL1omJXrAIK4UKAhctsqGkFfhzZ6zJRdtYMHjiGkWg1zrSEZjUV7A+K",
+	"kS3FV+cWNJh11e0TXk2abllP84Fig2zQchApF1+99KtjV+5CDEMj+GGN2l+dfvATchY+4cxC2sVZq5/x",
+	"PDPgL7wROkBD6/YNLdoppCqtnRq5FFekkMoOYV/1bnL4D6Oq7Wyp86NasYUbQtErpjSt7NHuLOphIN+7",
+	"Op0HTibcU9HJdFSYVrpazoH9UFZmKiFPfI//oRWBz/Y+ZBH1cFRAyMiFqrSPcUCVnQkaoI1Vkq01XxK4",
+	"PY6C8kU7eZrNTDp5X1iLqZdg7SLCDl3seKnvaptwsNxedU+I7oiGw4t4jOlEc01BwIWsiWUfPRAsp8DR",
+	"LELk7s6fsp/LXQqmz/Ge6z5j5Y7dyU7Inf3PNMFb7l46yKQ6jHkcewrSYYGCbpn2t32sYphHvjhnS6lu",
+	"pkHoXTAdAZLCqC1znM17SMKmTb1wZzPxTrYNegO1Tp3jQkB/+BTGOlg4N/Q3wII2NAL+FljoDnTXWJDb",
+	"mlfsDkg//dxxConzr88+efL0H6B/kKBEkGtFtwREbk0+cqY4os2+Yg+TzzmULtKjf/rM+6V0x02NY2Xd",
+	"La2HQ1l/F/cmw2YE2g2x1kWzk94dgJM4IoOrzaKdWFcuAO0lWzbrc2YMaLffqBsq0sa4zWCGFHTY6E2t",
+	"QLDQXd8gJy2dltDklO2Moqc1tmSiRJrHdXBNtWbb5Z0QVW7jy3aWkjiMluzgoTh2m9pp9vFWqb1q7sKk",
+	"wZSSKnkF+5c7aBQ0lwmjxBvXgrgWfrvq/u8WWnJNNYG50WOpEWXG9gCuSJPvLzv0xU60uBm9wex6E6tz",
+	"807Zly7y21dIzdTC7ARB6uyYRFB/RkmJHVHW+IoZK3/xLTs3dFt/v1rdjYVT4kAJHQbfMg0zEduCcEE0",
+	"K6SwDvwHzDRu1Cno6SPGe5aYPAAOI+d7UaB7zF0c27z6bcsF+urpvSgic5ZVWpZrpibgY7rZKocOO9UD",
+	"nQAH0PEaP6N9/iWrDP1SqotWfP1Kyaa+c/bcn3PqcqhbjNMkltDXm365WFfdoJE1wH6SWuPvsqAXQYlg",
+	"14DQI0W+5uuNid6LNzcujcKYmmVUk0ZJBX2GKiNQmsNiG30HomQ7WMvhgG5jvkaXsjGEopUAN7/RaSEz",
+	"E2aA/s3olm1iuRX1E6D3ZkBdBW1gtU1N0Ol4aO4KHRe0sCd0gajJKHJbX1nbyk5nXdgrxWgJyiAmiFw6",
+	"v0bncYmLpOgxHYwcTsRNauUjuGolC6Y1uI5YredB0Hy71vSSwxMCjgCHWYiWZEXVrYG9vDoI5yXbL9C/",
+	"X5OPvvlRP/wd4EVl9AHEYpsUevv6tCHU06YfI7j+5DHZWU2dpVpiJErlFTMsA8xxOMnuXx+iwS7eHi3W",
+	"kPQbU7yf5HYEFED9jen9ttA2dSZqzT3TQcKDDRNUSC9YpQarqDaLQ2wZGsVr0bCCiBMm7d4wcEbwek21",
+	"eevMfiXqNO11gvNgH5wiD3D2GQIj/+hfIMOxW1ukG4Hopq6lMqxMrQG9sLJzfcd2YS65Stk5jSSNZodG",
+	"zmEpGt8hy72A8Q9qgs+V8+IaLg796OCe3ydR2QGiRcQYIOe+VYTdOHInAwjXLaIt4XDdo5wQLjSfaSPr",
+	"GriFWTQi9Muh6dy2PjM/tG2HxOVMsTAnKSXTaEBx7R3k1xazNmZrQzVxcHi3OlTnWB/tIcxwGBeai4It",
+	"xigfn3jQKj4CBw9pU68VLdmiZBXdJxwC7WdiP48NgDvePnelYQsbfJPe9JaSg7E3P7TE8RJM8ztJ8Asp",
+	"4AjCU6AlENf7wMglw7FTzMnR0YMwFM6V3CI/Hi7bbnViRLwNryRopTw9IMiOo08BOIOHMPTNUYGdF+3b",
+	"sz/FfzHtJvBtbjDJnuncEtrxj1pARhfs4pqj89Jj7z0OnGSbWTZ2gI/kjmxGMf2GKsMLXuNb5xu2v/On",
+	"X3+CpOGclMxQDkrG6IN9BtZxf2LDRvpj3uwpOEn3NgR/oHxLLMe75naBv2R7fHO/YUy9ZXVjbu7pOg32",
+	"zjxTIEfHrdAj+DMxptxds+V6yUDaKzESV5hqb1eEtvVIeXMXr/PEqITbwGlYgI/bgkdF3ITtaGGqPaEA",
+	"M9uTa6YY0c3SOmUMLURG1ot4gKTFaWRGZ29OWntHDeDnOFS0vJQzoH3ljMN30XvqdNDhXje1lNUEnd8A",
+	"GUkIJnnDkFrCrnMXxO3DeP3Z6ADprqFq78F1l1+MZlwB+S/ZoLer93X1UppUKPpAX5yB62hO57LXYsi5",
+	"owbsPHrUX/ijR27PuSYrdu0zHzx6NETHo0eomXojtemwizvQ8AIDeZW4EPsOen0uediHy408yb2tN7if",
+	"FM+U1o5wYfm3ZgC9k7mbsvaYRqb5r5ndxJVfdD2eBuvGfT/n26ai5i7scOyKVgvwgVW8ZAf5u5uYS/HF",
+	"Fa2+D90wqwMrQCBe8Yql377QorHnyjYLqwujzoMD268cHwzWDInC87JZOS8gF6PjgnLsOwinN4oWbFFg",
+	"KoT7d3MdgDARm+wC+tgEDjAOF9xwH7w5dUvYK9vr3HY6oDZo3aX5dstKTg2r9qSGC7a0lhSuo205ITgs",
+	"KTZUrPERqGSzdkFKdhy88hpt1W1gluwPkRSUzU4s0HCRugKd66G77FBEZhSe6X2rhxUUrmmYj5Wdm3Hi",
+	"HvStQEnD53yW1WIAUq9aLYZFTjf/xoTrsCPDR/hpJ55oHkPUgTw7xFe8LS07AaUEQyZzF3xlV3PFcopS",
+	"vmXzyFBJ8O2AB5/VstjM8b/aAkO4JiXXBVUYqmh8NhskNvvmThPXCO3DjgcFmVzF0817LEn3vqNuGbhm",
+	"QyOHW9TWSJGBxHVd8Aw4EaMP8/r5ksZ89BxaTI2FcCa4eBEQ+1Bqkj2X3qic3z9vcyZmHJ8TSN7TSusJ",
+	"HyGsu9gYtCnnwPItPAYp1H6Y2xa/jQ2yHToLWmfiKGyx/ZiLXAT9YbW/gzePHQgeYTYCTHf07tp+las4",
+	"0ZQjer3Xhm2Hpknb9R8Z8nybVYBJUXHBFlsp2D6ZW5EL9i1+TPW2UnKmM75Xcn37SpUO/D2wuvNMIsFb",
+	"4hd3u3899U3w+kup7srHww44+c0/waXioP+Qm/Kmjh/gWz/0lXBpaPq3n56H6AOuCNVaFhxZ+SsIWeSi",
+	"da9woYhd9L8JwfV3cPb64/acAuIMZ2j0YlVNKCkwBBOaaqOawrwTFJXu0VITXqleu5g3w7zwTdJ2n4RZ",
+	"xg31TlC8JoMqPnlprVjiYfAlY94ao5v12srznWSojL0TrhUXpBHc4FxbOC4Le15qptA19MS2hMCTFdCE",
+	"keRXpiRZNqb7+McsS9qAUcd6KMA0RK7eCWpIxag25FsO/m8wnPdi8kdWMHMt1WXAQvoKXTPBNNeZYMGv",
+	"7FcMVHLLj2MHXWfvRX/fLxkPOy+zkL966RRjr16i9iOKPerDfm8GzS0XiySRxe5pPdoiH2G+O0dAD7va",
+	"frNh7wT4HhoJ6RZ5Sc3NyKF/wwzOoj0dParpbERPu+/XeqRO4RZchiSYTI813lGaAFh8OtsWCp8ugRa0",
+	"IqtG2K30T0+bTKYNFp2HjGo22fJzgum2NtR7rbs/n37y6WzepskK32fzmfv6c4KSeblLJUMr2S6lKoqj",
+	"vh5oUtO9ZibNPRD2pG+wdVaLh90y0DHqDa/vn1Now5dpDudjMJ3KeSdeCRuxBOcHfTb2zhQsV/cPt1GM",
+	"laxOBeq/7Qpq2KrdTcZ6fnQ298Cc8BN20lf5lmsf5U8xcD9ODjGBTYRzYAnNU0WE9XghR4UN98gyjtdy",
+	"l7++8+eQGzgFV3/OVIjCg6++uCCnjmHqB4gtN3SUSS2hR7Ifuh6WhtBOkOw78U68ZCtUvUnx/J0oqaGn",
+	"S6p5oU8bzZRLpXCyluS5Tyrzkhr6TgwkrWx2+DiuvW6WFS/AQJciT5vxdzjCu3c/gVHn3bufB85mw+eD",
+	"myrJX+wECxCEZWMWLl/pQrFrqlLGfB3yVeLI2Ht0Vitky8bF7dvxiRs/zfNoXet+3rrh8uu6guVHZKhd",
+	"VjbYMqKNDAG2XIe8RLC/30l3MSh67ZWKjWaa/LKl9U9cmJ/J4l3z+PHHjHQSuf3irnygyX3NJqsWs3n1",
+	"+hpFXLh9VmLwzaKm65Tq7N27nwyjNe4+ystb2AIQdLFbjJMQMYVDtQvw+MhvgIXj6AxHuLhz28vnpk8v",
+	"AT/hFnazSN1qv6IkYDfergOJxGhjNgs428lVaSBxvzMhZbVLdWPdy8COC4fAZfdeMpsbx6VdZtva7Oed",
+	"7nLVETQ96+BW9+lCpjHXDtonIVF3XXqtJBX7fm5ObUPEcNC37JLtL2SbUfaYZJzd3JA6d1CRUiPpEog1",
+	"PrZujP7mOzdZHznvUixiNLoni+eBLnyf/EG2Iu8dHOIUUXRyF+YQQVUCEdghh4IbLBTGuxXpp5bHRcGE",
+	"4VdswSq+5suUae/vQ3O4h9XliGL8yuc6CANqsJBzo30WEve8V2BgIhT95WqpaWVLQyS90PA9tGFUmSWj",
+	"ZtTIJeKseh466E+u4WRZDd8clsB2sN/coMZOsGtWOkWRbePCMU7yDrUWcFbeEB7fvX0pnGTfug51ibTp",
+	"/lYO2A3PWudrHNPZxSZ8x2xDayWvNSYcK4l0JQNsZsrofmk0XWesHR3PgIlJ/ToGfxzkkESSlEHAVtwV",
+	"NQaSQBJk23gBa06eYQZf4BDjM7PnYe5nsv4hzmCKlYAcwpYVCrDBFd/uPVUdJwqxHgMtzVqYEq0o6MHo",
+	"YiQ+jhuq/XEs5xGXnSSd/Ya5K8fya7+KnKOjyg4he7a/DfscdPDud1m2fWptn087fvRPyI1ts4I16e2Q",
+	"AkXTklVsbRduG/dyWj3Q0QYBHN+vVshbFik/60hBHQkAbg4GL5dHhFjbCJk8QoqMI7DR7wkHJt/J+GyK",
+	"9TFACpe1lvqx8YqI/mYn2XRhKFgsZA2XK88Y2wvPAVxunVay6IWI4DCEizkBNndFqyhRYzvIIM0zPih6",
+	"SZ2d593D3ENjxDRlr/yj1oQ9brSaWJr1QKdF7RGIl3K3sCkXkm+R5W4J9J4MxoJeyYNpE2o/0JiUDPxT",
+	"8WqxwT8HYMnD4cFoAcBMybB27JeTsywwY9OOy7kpKtTkoyB1tuSSE/SmTJ2RLXPk8lGUI/tGAPTUUG3B",
+	"OaeWOKg+6Ionw8u8vdUik7+Pc00d/9wRSu5SBn9D/Vg3q/XXbfbyfIZk1+h+0nkPNUu3SbNuOyMg+qgs",
+	"631y6AAxgtU3fTkwidZOqx5eI6ylWAmmtxwYJYdo06xi+AhedETTxSXbp9/yDO/xc98tUtbh7lGxfxj5",
+	"Dyu25tqw1mjkneJ+D3V8m7I0uzpTqxWs722UdTZOVhov895XgCFFK64gdgUsbsklQKMvNSqRvoSmaQm0",
+	"s9nEVkzjZZrj4rQQhVryqknTq5v3m5cw7XfhotHNEm8xLqx34hIr/CUjMUamtsE6owt+bRf8mt7Zeqed",
+	"BmgKE2Mm4O4cf5Jz0WNgY+wgQYAp4hjuWhalIwwyyqAx5I6RNBr5tJyMWRsGh6n0Yx/0UvN5PHI3vx0p",
+	"uZYor2na11Ku1xD6adOVeXuYiLJiVlKso1K0dT2WBPQE6h9pl0pzJAuni8JhuRicSNxfcLDYpqGPmlnI",
+	"21BhzCCKk4CZHvMvpdVCcn0gwgdbRLq6e7aF9uN/kjEQFz1jduuzancpbCduQMVo6d4kmvn1jR/L4YY4",
+	"1M1z0ROd8g3jRwgHRJriJqrOOMyrkmHAtK55uesZnuyoWSUYPUq7nJG2kLW4wQ5goBsBkCS4Tj0gF2fg",
+	"FOyn+OY9hVeZDTxwXvVA37RwGUXKRqEFo+PWPyw+Fd5qE9f+zY/nRiq6Zs4KtbAg3WoIXM4xaIhKO2li",
+	"uHUnKflqxWLri76J5aAD3EDHXk4g3QSRpU00DRfm02cpMjpAPS2Mh1GWppgELeRs8hdDK5drG6uSwpUQ",
+	"bc0NTFXJ/CPfsP3iR1A6kJpypVv3XGd26l6+R+z61fYbtseRD3q9AmAHdgU1T28Z0mBK0x8+6Sj5+wMd",
+	"Y8w+LztbeMROnaV36Y62xlWWyxN/e8vEK+ot5TYHo3WSAFim7MZ52jcBTg/rIr5Pyoc2IRceEnWK5f14",
+	"Kq59Hf7hVRSS6xyiXciM6YkXlzP7MJ/dzhMgdZu5EQ/g+k24QJN4Rk9TaxnuOPYciXJag/8WrRbOXyJ3",
+	"+St55S5/bO7dK+75JZOm7Isvzl6/ceCDSbpiVC2CJiC7KmxX/2lWZWvRjV8ltnyBU3RaTVG0+SHFfOxj",
+	"cY2lCnrKpkFlx9Z/ph3P+1ys0g7vB3mfc/WxSxxx+WF18PhpbZ7YuefkQ68or7yx0UObcU7HxU0rD5rk",
+	"CvEAt3YWiny+FnfKbganO306Wuo6wJNwru8x1276xSFcJl5kRc75h9659PSlVB3m78Jyk85Dv51YBUK2",
+	"xWPGV9sX4e8LUyfECl6/rH+B0/joUXzUHj2ak18q9yECEH9fut/xffHo0RBoe9ulmQRqqQTdsochyiK7",
+	"Eff7ABfsetoFfXa1DZKlzJNhoFDrBeTRfe2wd624w2fpfgFzLPx0MuWRHm+6RXcMzJQTdJ6LRAxOpltb",
+	"918TKfo+1RgBDqRls8PYGjPWGDs8QqLZogFzoStepF07xFIDexXWmRIaE2yc0dbCiA3P+OaKhkdjQbMp",
+	"SaB7QEZzJJGpk3moW9wtpTvejeD/ahjhJRMGPqlQVTC66vzjAEcdCKRpvZgbGPtEw99GDzJib/K6oDEl",
+	"yKj97mWwKfmFpiqXHukBHs84YNwj3tuOPhw122i2TdcFc9o7xhv0kuoDZ0H0jM4Z6zJztEXSsZ9NeMX1",
+	"YqXkryxtCEH7USIPjpsInyPYO+W512cpwajs1xPPfmi7p7+Ncxt/67ewX3QonXyTyzR9qo/byJs8enU6",
+	"//x8Fh/JNFz2I+mGBmRYCx6vyBkWE7h47yMq7HmyKVA6EWbpUxm10Kd2/PZUOpj7u1pU9HpJi8v0Wwhg",
+	"ira34ydlJPGd/QbokODDzk4iD+7QltvUmDVTrQ1imGb7hu8aO+3kF037gIGOnaeLLdNKKy0TwzTimgrD",
+	"vBuD5Veut2bWBA+9rqXCxLY67dJVsoJvk+rYd+9+Kouh+07J1zCTTftK6Mq4/BVuIGKz5yIVlVzXFd2H",
+	"tDUONa9W5PG8PZN+N0p+xTU4MmOLJ3NXGlTjdRnM4aELLI8Js9HY/OmE5ptGlIqVZuPq32pJwtsThbzg",
+	"mLhk5poxQR5juyefkY9cMccr9hCw6ISg2fMnn6FDjf3jceqWLdmKNpUZY9kl8mzvrJ2mY/RJtWMAk3Sj",
+	"pr2vV4qxX1n+dhg5TbbrlLOELd2FcvgsbamggJAUTNsDMNm+uJtozu/hRWCjkmmj5J5wk56fGQr8KRPz",
+	"DezPghHXdjWSaLkFevKM1B82P9wJng3L0wNc/iP6v9be/a+n67rnZwzdpumBopfyd2ijjdGKJVwxAQZv",
+	"PdMdQzwhr3yydKwIGAoBWtzAXLB0lCVhCzFLGBcG9R+NWS3+Cs9iRQtgfyc5cBfLT58lKut1i0+J4wC/",
+	"d7wrppm6SqNeZcjeyyyuL0TBi8WWA6t/2OZYiE5l1lE3Oa3J+YWODz1V8oVRFllyazrkRiNOfSvCEyMD",
+	"3pIUw3qOosejV3bvlNmoNHnQBnboh7evnZSxlSpVAaU97k7iUMwozq5Ymd0kGPOWe6GqSbtwG+h/X/8n",
+	"L3JGYpk/y8mHQGTRHAuWByn+x2/bUg5oWLWRiD0doMvY1pXPnd7unr0Nj9O69e231mEMv2UwNxltOMoQ",
+	"Kxnve/y57fN7+Av1QbJ73lE4PvmFKHiDoxz/6BECDXpH2/SXp93Plr0/epTOqJ5UucGvLRZu8yLGvqk9",
+	"dFoffaG4zcf2YsOrlMqFFPDB8mWsjeBUlzU1vhZ5XG+9TXwxpdjmRZQfCIYXC0wngVNi2KKNQ9tSLkp7",
+	"0cIPLuWw8y9ve5yQ71258JDLxsIeQewESpvdwo80d+ZnjOdy6ZDDLePqsPwO18yI+x46clqtrlxFS4U1",
+	"zoliFYVgVFit8wtjuZgMQF8++LUdmWuPa6CCCfqv4OsGE0wiQaghlqLAQBe3oD9chGK56CT31SMTJppb",
+	"x0tLCMFZaVqZ5PThOuQ3E2BMYksmSMHXTQ4egC6hyXD9WakSPoDUsnRDzUm3Ru39i/13E5CZdg9PX1vg",
+	"DQ5fPB7wjz4ifmfpBjewDSvK387dGt1JkinD9ygwhZLP5W4q4fSERk88fwAUZVAyUZ+OKxnUIE/61xx0",
+	"8IpoFEZdMvAH152yhLEB7s+DZ1j8fATbkIP3xzYZY0/yU1QUm6RbPybv/Yd9VHdkZivbpLBWbKgQrEoO",
+	"Z5VR//CSR0Kt9k85dZ4tFxPb9mvg2+X2FtcC3gXTA+UnBPRyU8EEMVa7ee5CHpVqLUubAbktq9Uyx5NZ",
+	"Yq+GJbYHJGiH3TbGOZpj8gaXIWzFK/hfxtEDWy4UNZmMd8rlMA4jsisGpmXUsNjRmSKUb1GS1hRqHeLJ",
+	"vGLg0AtdpWC97pjzEEeOamYRXcMnbIkZZiQxjYLrfhUtgwnDFav2c1JTre0gj2FZbIdzz54/efw4qadG",
+	"7ExYqcWiX+b37VKenGIT+8WVebTFiI4C9jCsH1qKOmZjh4TjqlpjqYIUT8UPNtQcOuOtbStah+rrJ+Qr",
+	"TFUGRNwpTQPQhJT33Qy4TV1JWs4xFT+40hE7q+2jGCIKK2qvAf4e+SftodMzAvtUbJlUV9PHGc+9Y5OO",
+	"L0Zylb/GFm2Jbt5zkkPFe4ydE/LS2jy0fwDZSQgWdFBbVka5z63WDYkD/mMMLTbQQHYkoDyvnF4K3rOz",
+	"1tQahQtf+Y/IsAFuVw3eFoOfEwkvlGsO+cU31LAr1s1f6sEIMr3LZ9pdnmqEsJRycoQwGqotHot2DxyO",
+	"G7yAkpD1EH+kKlnLRhXs2Mr459grHTzVK7Pfc9Px2TB9QQjyrbMGFlRIwQssXZSSpDHX4jS/gglVntIO",
+	"AXrmTmjicCWL+4fgfYfFbLn/+ayDuKGPTvQVNtVSh/3TsJ0r+rpmRjvOxso5anl5xZwFmwvNXD1NIKKY",
+	"T0qV8EJMRi4FVcKRZIRp1DImiS/h23fOYAVHkFxyWybBoc29z6yNGRLPALULwg1ZS6bderrhd/on6HOC",
+	"aVVLtvv55LVc8+Kcr3EM6/cKy7ZO3sOhzrzLt3OxhrYvoK2r9BJ+7vhv2knP6tpNmgxBDzs8+ATVTHII",
+	"Tjkaes1IhNwwfjzaCLmNxmrgfQqEBuU8iDasxnt4QBhMqdQL8QtbBAQoClsQGwKdQkrFRQKM11x4n4f0",
+	"BVEkrwTcGDyvmX66UNQUmw4bOuThnYlYwpQCxeVdDNXbYEQJrtHPkd/Gi51w9XgyjCM0aCV+KvbEHwqg",
+	"7kiYgHjl4DuPQlDXfANSlROiSowGdCl8rViWZhzAuBc+xrmDroPxtqE71o469ibKJRVdNuWaGUhYmcpF",
+	"9zl+JfjVR3W29bnkKgrn7RYVGFKbm6iQQjfbkbl8g1tOV3JNtWbbZZXw834ZPrIy7DBQGugm4d9UxcT8",
+	"zrgoh6PD6H1IQ3lcJY1hWoCU1As0vYCEadMxgXfK7dHRTn0zQm/73yml+/j6P0T4fI/LxXuU4m9fwMUR",
+	"Z9oeBJTYqyUkwsbgDYnffYaykMK1y5Xg27AuKLop4eYltqwHvG+YBPyKVpnUFbFx096v1uCXS2BRZPOt",
+	"UOPy6RlKRllQNkeZde7vmUuHNv+cQ7/15787M6Nb6yhC88b2bzqmdevU2TKLrEn9ZlbvdoOPNXt/c5XL",
+	"aeIL6+D3uICPc7ubO5sju+KycRsWghb8k9D+6nJmdQr1ZNafDAX6va0WWRvLhaugb5fp3uTf/GjdJggT",
+	"Ru3/ABaXwab3q0AlpF1sERGsewIPtGaZR23nVpxSdCpV38jJhl5XZllLh5YG9aIGZPVyijgwwMeH+exV",
+	"edSFmaqRNbOjpI7da77eGCyx8TWjJVNvDpQQacuG4BGrpeZtDfQKBnM5mzc43MnU6CAgYB6XQBmO5b3G",
+	"r1hhsPB96w2rGDumIApM5o0+/1NKJP+cDkFUroLIWNmQYbX7A3f8INNZlK2PBTeIiUUyzkLMgw3ZhLKu",
+	"Ib9SL8nB5FDr1YoVmMZ8NLPc321VYZ+1bO71MgjLKko0x0PgISbiP17r2AJU0RvCU9G7AyeXeOKS7R9o",
+	"0qGGZKHvEHV7k0zfiAFrAvNJ33OKZOfmyXWgDMSC9+G33VlbzSabpD3Kk3jDuTxJEhrnThyZ8koadsO5",
+	"oOtReVoxhi6XfO4NY+ot85nKDzItFZram6FmPsnaluslg7TEJWYVh5x/CdsmFYKVi0YYntjWHwTfRRaV",
+	"qCIwdoiSreG0wClxvDmRzoONrzqfhTSuyeRjEE7BkooEP2ozPWKqUVBZQCJmKvTcBiOXErIgOiG2bFTA",
+	"1YG6wIcOJVKNXK1Y8mV44VmA3wUuVYsJICFdSJXjmHChMpaJOsMRXr1pMwkoUj+t3c/p449zpeoQtLBh",
+	"kzkpWWFjtSTaoaC4A7kY7K89EdwQBShu60+u+LqBRQENf07FxUYxDaEMEVBOn9o/HLhcD6jb6/TpwFTF",
+	"kTCZf52/ZIbySjt/bxry6Mc6LFDH9+vAXVMdTkxrWfQZ+Zn2v/mUuHaWil+6cjjIM6wdF7Io+xZ3kuMQ",
+	"mxGeBnoVZuZtPOLQBWjIAW1ob1FJELIXufjobghg8J9/oG2gQ5uPDuFaMaVYGQyGldRsYaSn2jE4xlCh",
+	"MZrjRkjQ2Wp+FrhsJYe3bakKrGpKsXIDdUEc8QIjH9+2oER+zjFkv7DffU4Z7wl8UP8a6PVw+XEficr1",
+	"AIkx1a+Iu3AO56q5iSqWC8HUwttl+9UlRDfBKKaRLpvCiq/xwQjq6snerSOsJKnFLIar7L2go5wvl2x/",
+	"alUELvtL2MEYaPuusKBH+bN7m3ynymmdgnt9J+D9vmlRaymrRcYU+GpYEqNP8ZccXKoI3BQ+YgteRg+6",
+	"ZwMmIR+hBSr4elxv9r4ERF0zwcqHJ4ScCRsj690+utVye5OLB2Zs/h3OWja2So1TOZ+8E+lgQ6wfo27J",
+	"zfww4zxMM1Heeio7yPhEZidyDmnXWGumW5T6ZKrOauiI0RdLWqKyUKRkknNrz32BBz2lVsWMPlHqKTTz",
+	"U+LswERXMuXpfpOsQzBUGlPxZAiQYWJK8psAhRs8iQDn4xbV3Uy+WypauAOAhhHnn+w23kZRg63EPhN8",
+	"vDu6SGBIodG+hEav2pX1NLxktZnb1NLwJwiwipclEy7VYKdrXMgyJZmNiEAuTmPeJeuMQJMvSthNIMHi",
+	"wjwKiv/hCZk7p4UbKFWOqQ3Xm993wEshjKOt1G8LraWTDtxJabFcBaeLDeupspLlm+ahXhNfeUJ6fidl",
+	"nL4XR/WfWsTp3ks4pRP8TDjY39sjlSAk/0VH1Sq6x3u6hvFi0zm8w4vhmAx48TEfsdhpYt8V/bx7Q+Ly",
+	"1Z2Quhhz5p1JIlrAZlyxKuA05XmSqSx0Flex+W1AjFI4jUHojdiTxoxuh5xubJQIk2gbOo8xo9soZ53Y",
+	"1ImBMYsJ+X7vPqNvys+unMVjHsDR0ZjpZFL9rVFzy8ymOex0hh1FUIqw05iKE6Y5tHgVwnRhwX7LpZAb",
+	"zRJ3kchXFnUcyQAXX+s3SvnmYRrD5OdyNwGBLpDTRbjK3dyqZBAi02VQN6IyIq+Dh8JSZoo9pP1+L6Kk",
+	"IVH3P4yXQYS63wm83Glzrr6H2dGBkhfuc3TVKtb6XN+0uoUrGJG99K0BvD9zmKWrAFlJxeIZ8ZViK9mE",
+	"xC5wGRL8z5IbRdX+JjUouqhKORtksfxG1vhv+dZh7wz6ZFau2ihRq43173BYKV+7qjcWJfGbek60dPZw",
+	"o63nBSZOsXhzCU2BMMpoE7lp8cpFB49n/eebkWAhtR6vDu+YR9gnNQATKgLvAN6OR0INV+6/9tYdfsaF",
+	"j3hcHpQ3c8FUowwsuw11PQLSVCPVVIky3DVZcOzHOwcoUzb0YuN2vwcOBA63FIxURB57kzs4KHcPsI08",
+	"sQP5egNWLWcZP+Qm4gJuJM3C+K++J9ZnenqehDgu+6ijezDwMMQctmenjTscHoCqktcLXOEilGBOPSCg",
+	"ne4q1l2x0LZ0s3ZHso1gpNoZXfZkQ0tSSKVYEfdIawUsVFup2AKKjSWTAL/mK6NJxbfcaIIVftdE1oUs",
+	"mS1lnmb+ubkcJ1oETpRFgWdfMsG9Jk4J+nHrMb2wJtCpD6IL6GOTqrYFB+yiF5YCM7H5TLsCAw5DtvEQ",
+	"XiQcm5G77zWXFpRWfId0w1Tqtl4Roxo2J64Fjt4hIbwegJdvudYWlEBL17yqMKcp37VXOQshOhl9krvZ",
+	"wkYuaOZqe0OLy9wtlBcg2oCr4e3na90BV2nfSog78tZKRZpkyC29moxB7hWGQ19xjJnrZuvFHqRWrGAh",
+	"hXF8iZ7H9QWI2SjZrDdRJceAde+qohrnyBKP8oNuMKwR2SFM8YxspTbOBm5HajewDRX9qJDCKFlV3jZk",
+	"ncmcI4DzkP2W7s6KwryW8hKy7j5Eizuq5dxKy7lPZNoP6m1nUr0aHrENAi1y0uufpp69jhJB++g33F59",
+	"uLiebQfgeuZ4tIbFMfiBV+whN9MIzJ8PXyyHnW7Phgvrr6t7x6QttWeCUCO3vEizmj9XuG02SDZDPUPj",
+	"vT+Rjp7jyP/uGUZ1u+WCrPQ1SfCV4c4jOhF542zffakdx2WJVsyeraa2WRe6UjfRTGsuhT5GdA7L7NYi",
+	"bpOV6uO1iz118mTZeQDLQHcVCdY30cuOgZQRVQcwRe9294DxXig318fGypajBMxYxkgdWtvDZTD3Dly6",
+	"I22GOECUcYakwwRNGpfOiLv7XDwUUrGsre5nMC5ZMWoGc0eSbkI6sKlVJsyMINp8uqZRqDCiMQRuJL+D",
+	"XbHK5VzZ+wi9Oam7Qb4+vl0wZYMkMV42khK+QAy9ZFesAsSdvXmVXpCz0S6KrCX58Lo6dt4gGci11Qji",
+	"I6iP+YlyLq7qdrDBCHcOlGG3AmqQeSAA+JHlLnNrOwhvSf/9YVup6UbAHzi2nXs7F1593p4VhU1CVYfM",
+	"ZZyuBzsai3yBOaKXUyOSg6w88c0RAZCPUe7AMClS+VgwVpRXrFxQkxHQ0QNuHllmne9zNDp3srVXjlmh",
+	"Gx4XlFeNYq7KgNUXqq4bd5zUE5oP/VTB55FZVdmvTEmMMSvnUeyDTyfaczWS9aICztNV5gEt6wYfRuAv",
+	"7frq0JmUjNVMOa4WddVj2p/ErenWvoiiWqdgN+mnZRFrd4occMJK+lT75+RtgRp7Y0qUwFY8mJPTT0tc",
+	"y3y4inQm951Y2POtp/IAgPqKlw3tbLw+VvDoekcCD0rs8eD9u/DomDrND3YEr8DWZ75/6vnjMfHzNAZ6",
+	"NO9Mo26Mcx5MrtDoHLsS6dwKcUGS4HeOs5UhesuezZbh6Zpei7yf5vCstpqrifvEpYgQ+8WOFShfOtUR",
+	"K53yaFT/bk+RlYPtS3MtEk7IGyaIkK0GCZ00vZ6krZTmf7ATYyMunGLyBi4jbQqE2+8swcGI7pVMSu6E",
+	"50xlStM15QDlbEAd5nE7j+jf5ZSPHvLseCn608zlIxyxMPqT49Qg2EA2VUkE0AroIiCeyl/t7haZk2Xj",
+	"BwJ14NDW9ZL50BNL2d7r3q7I1zFC45xF9zxvTwsJdMAeJhX+I6Qh/2poxVd75GEWfN+N6A0F8nSxLjZE",
+	"0aWlgInHZc65B8wrxaWfyq6bTx0zGm7vb0k3Ekg3zqiKpX4uWbwNGH1peXNhgCnrZokKZpBjets5xIJb",
+	"vC/ysKVlrMLEUnP7DueJ7+f/p03OF0/lK0ShQ2vpN0/Tbc+zGyXEQFw3sVl6Emhtl4FogxmsvIFR+vaW",
+	"TetZdwjs6G3V8aq7o2VMtK33qvQfZapNLOWud+FWxtyFdwM8AH7XZfA+8J+sAnmkTboD/h8F7yM2aw+v",
+	"s13/9lgeNz17o+JS7haKrfShmD5s3TOyB9tRx1DeGslDkUMuQDtgk1SEMJIwSslWXLTMkou6MYnHHWqx",
+	"xT5CWGybRbRmohZyUgIIqle0GtHWX2AUCipse0XmvT3a9U3odcKdOhyA6/YNiQkjW2tn3Awu8JKvVkzZ",
+	"/BHaUFFSVcbNuSAFU3Dvk2u61zc3/Acb7iHTP42kmW4a48gJAEnbAlLtXRzOLc3yAUB6h/b5CXb1iw1z",
+	"1N9V/lp9l5EZM/oQhj+FXX1Ld+CKgWkNMwfCVbdERwxshjnKQYpC+Wzauv08mv/KxqfBEjCOERmJs06Z",
+	"Yvzcf49biU/UHwQ3oyffKm77eSZtIhB7MD1SxbrNRmSJZXge6yI9Wc9yEExGLneWpz0WbSLLGb47xoLM",
+	"LmLkmcsrG1sGjjCOdYLbEjeM0zosUBuhR/INtRYyxLV2aqpBhG9fjWGREkdCHaF+tEYLfy9lwHP+8M73",
+	"sDNtiFJEk85k2ScKyUtDVMt6UUwJs7e1/0sLgIe0C+OYY8QodYSIRO0tzx1qjETeB5q0oXLHit/WWu7n",
+	"Omh9r4uxR39OBZXh6F27jFwhL3P2P9CcSRUraub9pHddFVtgEoQSxYpGoe78mu6TjsiYwHnhTnym5uz5",
+	"12efPHn6j6effGrrh5V8zbSJ3I9wkMA2Qig2F32d0v26rQ+WZ9Kb4NMhW8R5K7PP8hY2xZ01y211W5Sw",
+	"s/pjjeaJCyBxHDG8tE04dOO9wnHaXEN/rO1KLfLOdyyFgt9+z8D/LF03PshVCQNOarciuxK8QGqmNNfo",
+	"ydE1C3PTJqHQG1QPYvXQK5veXoqCed20owJuMh6BqYXkchggP4NPxFmtCNvVleNV1vw1ti73TrMaOhQa",
+	"0b0ItFhedQw3bAoi9K9WUapPp/hEbXuUliAwW5ugIF0KEJN9pEnvzNnIgL7GuX1rPfWMOsHpYRMT4oU/",
+	"lDcgzZztI59I+SacpDUb/GH4RyIz9J1xjbDc34JXJN8HI0lQzwbOICEr8iTQhlmCE+SBAGTSf3YSN0aZ",
+	"66LKiMpaCdCe4A3YffHj29awfTATD0LiOxwAL87n2bYL/okOnN85Tu3bgJRoKT/nKKGz/EPZ9jzrDRdJ",
+	"tEVOaWIM05YtyaFYGOV/1S9CWtXMq2SQfVVJaYgUoBtJZG21ehw8UzHhcGGYuqLV/XONL7nS5gzxwcq3",
+	"+WxUcerOGMkWlfpmhYNe00lzV/Q3mFq8wUyxf2ewR8l7zg3lDPyD2wyVO7SyUTCruNLvNY6JO02efEqW",
+	"rlx/rVjBdd9x4NoLJyFTJVNgHQuZD8dTYx5a54/S3IKMV949iXzXCc1z/gAOwvaI/s5MJXNyk1Seor4B",
+	"WSTwl+JRcRD8gevilqXdb5aHPqooc2Qe+mF4/9Tl4Trw0mk0G65z8m3dwW3iom7XNrWIwuQK8e/e/WSW",
+	"U2ofpKu5Q3csvnAnZd2PKur+G5RdsDhyY7h5UxTzY64Qny02lykW2tuPxtWHH7WqxaVfIWsFE0xzjcVN",
+	"/7H89Nn9Z7vzENiUCMOjamG9Tf56i5jEWjuTR1NFRV0n1HN13RJFODGRXNEobvbngH+vQOP/SBaI+Cok",
+	"G3fJ6oMtzd19Rl4y4f092tTkjfa361eSVngfWROfgFtIVifkC1ty1B2Uvz1Y/gf7+K/PyscfP/mP5V8f",
+	"f/K4YM8++ezxY/rZM/rks4+fsKd//eTZY/Zk9elny6fl02dPl8+ePvv0k8+Kj589WT779LP/eAB8CEC2",
+	"gPrECc9n///irFrLxdmbV4sLALbFCa055HP/8AHfyisJy0ekFngS2Zbyavbc//T/+hN2UshtO7z/FY6S",
+	"guYbY2r9/PT0+vr6JO5yusZsqwsjm2Jz6uf5MO9h/OzNqxCJYf1wcEdb7fHJrCWFM/z29ovzC+LiHEL5",
+	"zNnjk8cnT2B8WTNBaz57PvsYf8LTs8F9P8WCX6fa1fI9bUNqk3a7t+jH74VzBe6RH4Vwwn8Pllv90Ecl",
+	"YpwHFwQCyAC6sIpXJRKXccEy85l9ZmlLjk8fP/Z74SSd6MI5hcHgN8s/UpV7PswTopEDOAkZdsB1pNJy",
+	"Xwp5LQhWJ7IHqNluqdrbFXSwEQ2O20TXGpXsil9hrhzo3cd5XbsKyjmUK86uWPeU+85IIKEELxW+Mq8L",
+	"sdEplA+rN98S+6PVqgaTJXYHG70BmH1yAQ+PNwg5nKHN2CIsnBHckSGi57O6SaDTBgfpMZzNo6rAFhpZ",
+	"lQHjA4y+af6bYBRI191Ns+fv4a8No5XZuD+2QKiF/6QYLffu//qartdMnbh1wk9XT0/9K+T0vUtH9mHs",
+	"22mEMPi5/WvBy1v0PPVlD0b6e4+pQ01O3/tUTh+Oaz0BiMMtYrXrqfOAjTpMRN8orpZyd0TTAcyHOrAY",
+	"yXmM4tHVp+9Rj5D9/dQpg9MfUZ9jBYU+mP2WNolz+mMH5+/NLrGX/R47XkbjFWDtb+rT9/gfPH0fLNOq",
+	"WKpChi19TknbfI5pf5ZSGW1/BaZmo8rRaN22HHCuM+j1wkKAQoH3kpo9/2kY24cDET8SSlogRrSCUGem",
+	"VtZFq1DE24Ik32nfyvM/PV589vP7J/Mnjz/8BeR19+cnH3+YGGDwIoxLzoMwPrHhz7dk3APVU7tIu0mB",
+	"Dw/fSo4W8mFSbqt6A5GAjHGVSn/44ZMP75Fnd3hVdes5Jq6pz2lJfFIenPvJ/c39SlhXd5C37bvgw3z2",
+	"yX2u/pUAkqeVlyxvKIOe2cMfMwXiNjslg85nQoo6ToFrpSWpzWR+ow29Ab85h17/w286DQfGSoxOtErj",
+	"LRforde6J9nLJCoE5HJd+BAJWl5RUfh4tTbIA/cLO3jCCH7EjWarpvJJr2qI57DmFFn5iVx+C7KiOlCW",
+	"iyyBd7/NchOGJo0owF5mi7JW+2DHxmw1aAvXl7zudOErwm2GZWnipA2AkX81TO3bXd9yMZsPn36tj+Jv",
+	"ycItHu+AhXcHumMW/vRINvrnX/F/70vr2eO/3h8EbuXkgm+ZbMyf9dI8tzfYrS5NJ8PbuuanZidO0Uv9",
+	"9H3nueI+D54r3d/b7nGLq60smX9CyNVKM3Pg8+l7+280EdvVTPEtE4ZWnV+lMkydKlZXdB/BlxEFvqWX",
+	"Tgnlu5K1JOix5FQKaxQW2oJcth1hV0zt3c/o2m0wJhN9YKHOBKGVlhgUvmXauja58dHur42sa1YSaggN",
+	"pZSkZj4kANHocjGRSgq4D0PI4RzvmtrrorG79glPW5hdTbquuIIlNPZfOGAOCSxtoScj/cpxibjmk7QI",
+	"o5yxMi+9OBlg9vzxfPJVl/LMdRjFchewLMCI3wwL9x+Flz27Pwj6qBHS2Jw1rPyz8jVLtL1z6irltnn9",
+	"juBuVsI8BRmw2rfsw/+8F0XyxyG/61crSf186g1IKaVgt+X7zp9dDVHNmNKnqlPW87A9o1+e0WYgov3C",
+	"Jzh4tubn3NdstM3Q2eaE/J0ttSwumXE/A7fiJRMGUzKCtB5VmIRpfYlJDD6Iak0OlPm9+qVphpAizNDu",
+	"tDtEnIPgf97jNzuCLVF168UGEhNru7vHHsY+YZ++h19GNYZfSrUORqqIxEP52jmp+MqVCMCKo+1rrK0d",
+	"26W7FxWjakB5B6/Hw6VUE1ekK1WavyH7D4hJlyIAH2+OK3s1wMV/w0sRUfOdNORLLxX8GQ8hkmi+ZvOx",
+	"B09vGlPKa5EXj1FxQyuypYKubWqwYMU3kvgB2iLw5Ps6qEhcYh1CsQawbEzrZmFjwV2asOAICyO04RBr",
+	"LnACpFycha4M1qlqVUeulPTwMJ87yL6TJRue4pQKxsHYUcOETTpCTj3WvB89+T8cuX2GGmbdhoeCSaiA",
+	"1vn79JpyA7o7V40dMZrqrBjdujdY+7NhtELytxkz419LrqnWbLscflF71USiUSeTWPLXU9oVwDrfcCdz",
+	"HQcGwtRXZ9LKNPJB6gc+n/o0uFPbnb53/1scnjvd6dTpQX3n1l0rdn9C+g6OTz/9DGSqmbrypN968zw/",
+	"PcV8KhupzSkqbbuePvHHnwNlvg9XmKNQ+LZbSMXXXEDFFGsWX7QeO09PHs8+/N8BAGwubzgWRAEA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -27,21 +27,33 @@ type ServerInterface interface {
 	// Get account information about a given app.
 	// (GET /v2/accounts/{address}/applications/{application-id})
 	AccountApplicationInformation(ctx echo.Context, address string, applicationId uint64, params AccountApplicationInformationParams) error
+	// Get a balances trie proof for an account application resource.
+	// (GET /v2/accounts/{address}/applications/{application-id}/proof)
+	GetAccountApplicationBalancesTrieProof(ctx echo.Context, address string, applicationId uint64, params GetAccountApplicationBalancesTrieProofParams) error
 	// Get account information about a given asset.
 	// (GET /v2/accounts/{address}/assets/{asset-id})
 	AccountAssetInformation(ctx echo.Context, address string, assetId uint64, params AccountAssetInformationParams) error
+	// Get a balances trie proof for an account asset resource.
+	// (GET /v2/accounts/{address}/assets/{asset-id}/proof)
+	GetAccountAssetBalancesTrieProof(ctx echo.Context, address string, assetId uint64, params GetAccountAssetBalancesTrieProofParams) error
+	// Get a balances trie proof for an account.
+	// (GET /v2/accounts/{address}/proof)
+	GetAccountBalancesTrieProof(ctx echo.Context, address string, params GetAccountBalancesTrieProofParams) error
 	// Get application information.
 	// (GET /v2/applications/{application-id})
-	GetApplicationByID(ctx echo.Context, applicationId uint64) error
+	GetApplicationByID(ctx echo.Context, applicationId uint64, params GetApplicationByIDParams) error
 	// Get box information for a given application.
 	// (GET /v2/applications/{application-id}/box)
 	GetApplicationBoxByName(ctx echo.Context, applicationId uint64, params GetApplicationBoxByNameParams) error
+	// Get a balances trie proof for an application box.
+	// (GET /v2/applications/{application-id}/box/proof)
+	GetApplicationBoxBalancesTrieProof(ctx echo.Context, applicationId uint64, params GetApplicationBoxBalancesTrieProofParams) error
 	// Get all box names for a given application.
 	// (GET /v2/applications/{application-id}/boxes)
 	GetApplicationBoxes(ctx echo.Context, applicationId uint64, params GetApplicationBoxesParams) error
 	// Get asset information.
 	// (GET /v2/assets/{asset-id})
-	GetAssetByID(ctx echo.Context, assetId uint64) error
+	GetAssetByID(ctx echo.Context, assetId uint64, params GetAssetByIDParams) error
 	// Get the block for the given round.
 	// (GET /v2/blocks/{round})
 	GetBlock(ctx echo.Context, round uint64, params GetBlockParams) error
@@ -87,6 +99,9 @@ type ServerInterface interface {
 	// Gets the node status after waiting for a round after the given round.
 	// (GET /v2/status/wait-for-block-after/{round})
 	WaitForBlock(ctx echo.Context, round uint64) error
+	// Streams blocks and optionally their state deltas, starting at the given round.
+	// (GET /v2/stream/blocks)
+	StreamBlocks(ctx echo.Context, params StreamBlocksParams) error
 	// Compile TEAL source code to binary, produce its hash
 	// (POST /v2/teal/compile)
 	TealCompile(ctx echo.Context, params TealCompileParams) error
@@ -138,6 +153,13 @@ func (w *ServerInterfaceWrapper) AccountInformation(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter exclude: %s", err))
 	}
 
+	// ------------- Optional query parameter "round" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "round", ctx.QueryParams(), &params.Round)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter round: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshalled arguments
 	err = w.Handler.AccountInformation(ctx, address, params)
 	return err
@@ -173,11 +195,53 @@ func (w *ServerInterfaceWrapper) AccountApplicationInformation(ctx echo.Context)
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
 	}
 
+	// ------------- Optional query parameter "round" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "round", ctx.QueryParams(), &params.Round)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter round: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshalled arguments
 	err = w.Handler.AccountApplicationInformation(ctx, address, applicationId, params)
 	return err
 }
 
+// GetAccountApplicationBalancesTrieProof converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAccountApplicationBalancesTrieProof(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "address" -------------
+	var address string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "address", runtime.ParamLocationPath, ctx.Param("address"), &address)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter address: %s", err))
+	}
+
+	// ------------- Path parameter "application-id" -------------
+	var applicationId uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "application-id", runtime.ParamLocationPath, ctx.Param("application-id"), &applicationId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter application-id: %s", err))
+	}
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAccountApplicationBalancesTrieProofParams
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", ctx.QueryParams(), &params.Format)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetAccountApplicationBalancesTrieProof(ctx, address, applicationId, params)
+	return err
+}
+
 // AccountAssetInformation converts echo context to params.
 func (w *ServerInterfaceWrapper) AccountAssetInformation(ctx echo.Context) error {
 	var err error
@@ -208,11 +272,80 @@ func (w *ServerInterfaceWrapper) AccountAssetInformation(ctx echo.Context) error
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
 	}
 
+	// ------------- Optional query parameter "round" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "round", ctx.QueryParams(), &params.Round)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter round: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshalled arguments
 	err = w.Handler.AccountAssetInformation(ctx, address, assetId, params)
 	return err
 }
 
+// GetAccountAssetBalancesTrieProof converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAccountAssetBalancesTrieProof(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "address" -------------
+	var address string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "address", runtime.ParamLocationPath, ctx.Param("address"), &address)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter address: %s", err))
+	}
+
+	// ------------- Path parameter "asset-id" -------------
+	var assetId uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "asset-id", runtime.ParamLocationPath, ctx.Param("asset-id"), &assetId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter asset-id: %s", err))
+	}
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAccountAssetBalancesTrieProofParams
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", ctx.QueryParams(), &params.Format)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetAccountAssetBalancesTrieProof(ctx, address, assetId, params)
+	return err
+}
+
+// GetAccountBalancesTrieProof converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAccountBalancesTrieProof(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "address" -------------
+	var address string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "address", runtime.ParamLocationPath, ctx.Param("address"), &address)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter address: %s", err))
+	}
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAccountBalancesTrieProofParams
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", ctx.QueryParams(), &params.Format)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetAccountBalancesTrieProof(ctx, address, params)
+	return err
+}
+
 // GetApplicationByID converts echo context to params.
 func (w *ServerInterfaceWrapper) GetApplicationByID(ctx echo.Context) error {
 	var err error
@@ -226,8 +359,17 @@ func (w *ServerInterfaceWrapper) GetApplicationByID(ctx echo.Context) error {
 
 	ctx.Set(Api_keyScopes, []string{""})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApplicationByIDParams
+	// ------------- Optional query parameter "round" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "round", ctx.QueryParams(), &params.Round)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter round: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.GetApplicationByID(ctx, applicationId)
+	err = w.Handler.GetApplicationByID(ctx, applicationId, params)
 	return err
 }
 
@@ -253,11 +395,52 @@ func (w *ServerInterfaceWrapper) GetApplicationBoxByName(ctx echo.Context) error
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
 	}
 
+	// ------------- Optional query parameter "round" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "round", ctx.QueryParams(), &params.Round)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter round: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshalled arguments
 	err = w.Handler.GetApplicationBoxByName(ctx, applicationId, params)
 	return err
 }
 
+// GetApplicationBoxBalancesTrieProof converts echo context to params.
+func (w *ServerInterfaceWrapper) GetApplicationBoxBalancesTrieProof(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "application-id" -------------
+	var applicationId uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "application-id", runtime.ParamLocationPath, ctx.Param("application-id"), &applicationId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter application-id: %s", err))
+	}
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApplicationBoxBalancesTrieProofParams
+	// ------------- Required query parameter "name" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "name", ctx.QueryParams(), &params.Name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", ctx.QueryParams(), &params.Format)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetApplicationBoxBalancesTrieProof(ctx, applicationId, params)
+	return err
+}
+
 // GetApplicationBoxes converts echo context to params.
 func (w *ServerInterfaceWrapper) GetApplicationBoxes(ctx echo.Context) error {
 	var err error
@@ -298,8 +481,17 @@ func (w *ServerInterfaceWrapper) GetAssetByID(ctx echo.Context) error {
 
 	ctx.Set(Api_keyScopes, []string{""})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAssetByIDParams
+	// ------------- Optional query parameter "round" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "round", ctx.QueryParams(), &params.Round)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter round: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.GetAssetByID(ctx, assetId)
+	err = w.Handler.GetAssetByID(ctx, assetId, params)
 	return err
 }
 
@@ -612,6 +804,40 @@ func (w *ServerInterfaceWrapper) WaitForBlock(ctx echo.Context) error {
 	return err
 }
 
+// StreamBlocks converts echo context to params.
+func (w *ServerInterfaceWrapper) StreamBlocks(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params StreamBlocksParams
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", ctx.QueryParams(), &params.From)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter from: %s", err))
+	}
+
+	// ------------- Optional query parameter "deltas" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "deltas", ctx.QueryParams(), &params.Deltas)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter deltas: %s", err))
+	}
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", ctx.QueryParams(), &params.Format)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.StreamBlocks(ctx, params)
+	return err
+}
+
 // TealCompile converts echo context to params.
 func (w *ServerInterfaceWrapper) TealCompile(ctx echo.Context) error {
 	var err error
@@ -627,6 +853,13 @@ func (w *ServerInterfaceWrapper) TealCompile(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sourcemap: %s", err))
 	}
 
+	// ------------- Optional query parameter "bundle" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "bundle", ctx.QueryParams(), &params.Bundle)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter bundle: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshalled arguments
 	err = w.Handler.TealCompile(ctx, params)
 	return err
@@ -715,9 +948,13 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 
 	router.GET(baseURL+"/v2/accounts/:address", wrapper.AccountInformation, m...)
 	router.GET(baseURL+"/v2/accounts/:address/applications/:application-id", wrapper.AccountApplicationInformation, m...)
+	router.GET(baseURL+"/v2/accounts/:address/applications/:application-id/proof", wrapper.GetAccountApplicationBalancesTrieProof, m...)
 	router.GET(baseURL+"/v2/accounts/:address/assets/:asset-id", wrapper.AccountAssetInformation, m...)
+	router.GET(baseURL+"/v2/accounts/:address/assets/:asset-id/proof", wrapper.GetAccountAssetBalancesTrieProof, m...)
+	router.GET(baseURL+"/v2/accounts/:address/proof", wrapper.GetAccountBalancesTrieProof, m...)
 	router.GET(baseURL+"/v2/applications/:application-id", wrapper.GetApplicationByID, m...)
 	router.GET(baseURL+"/v2/applications/:application-id/box", wrapper.GetApplicationBoxByName, m...)
+	router.GET(baseURL+"/v2/applications/:application-id/box/proof", wrapper.GetApplicationBoxBalancesTrieProof, m...)
 	router.GET(baseURL+"/v2/applications/:application-id/boxes", wrapper.GetApplicationBoxes, m...)
 	router.GET(baseURL+"/v2/assets/:asset-id", wrapper.GetAssetByID, m...)
 	router.GET(baseURL+"/v2/blocks/:round", wrapper.GetBlock, m...)
@@ -735,6 +972,7 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.GET(baseURL+"/v2/stateproofs/:round", wrapper.GetStateProof, m...)
 	router.GET(baseURL+"/v2/status", wrapper.GetStatus, m...)
 	router.GET(baseURL+"/v2/status/wait-for-block-after/:round", wrapper.WaitForBlock, m...)
+	router.GET(baseURL+"/v2/stream/blocks", wrapper.StreamBlocks, m...)
 	router.POST(baseURL+"/v2/teal/compile", wrapper.TealCompile, m...)
 	router.POST(baseURL+"/v2/teal/disassemble", wrapper.TealDisassemble, m...)
 	router.POST(baseURL+"/v2/teal/dryrun", wrapper.TealDryrun, m...)
@@ -746,308 +984,359 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+z9e3fbNrMojH8VLJ+zVi5HlJM07X6a3+o6PzfpxadpmhW7fc7eTd8WIkcStimADwDa",
-	"Uvvmu78LgwtBEpQoW3GSp/4rsUgCg8FgMPf56ygXq0pw4FodPfvrqKKSrkCDxL9onoua64wV5q8CVC5Z",
-	"pZngR8/8M6K0ZHxxNDli5teK6uXR5IjTFTTvmO8nRxL+VTMJxdEzLWuYHKl8CStqBtabyrwdRlpnC5G5",
-	"IU7sEKcvjt5teUCLQoJSfSh/4uWGMJ6XdQFES8oVzc0jRa6YXhK9ZIq4jwnjRHAgYk70svUymTMoCzX1",
-	"i/xXDXITrdJNPrykdw2ImRQl9OF8LlYzxsFDBQGosCFEC1LAHF9aUk3MDAZW/6IWRAGV+ZLMhdwBqgUi",
-	"hhd4vTp69uuRAl6AxN3KgV3if+cS4E/INJUL0Ee/TVKLm2uQmWarxNJOHfYlqLrUiuC7uMYFuwROzFdT",
-	"8mOtNJkBoZy8+fY5+eyzz740C1lRraFwRDa4qmb2eE3286NnRwXV4B/3aY2WCyEpL7Lw/ptvn+P8Z26B",
-	"Y9+iSkH6sJyYJ+T0xdAC/IcJEmJcwwL3oUX95ovEoWh+nsFcSBi5J/blg25KPP8H3ZWc6nxZCcZ1Yl8I",
-	"PiX2cZKHRZ9v42EBgNb7lcGUNIP++ij78re/Hk8eP3r3P349yf7L/fn5Z+9GLv95GHcHBpIv5rWUwPNN",
-	"tpBA8bQsKe/j442jB7UUdVmQJb3EzacrZPXuW2K+tazzkpa1oROWS3FSLoQi1JFRAXNal5r4iUnNS8Om",
-	"zGiO2glTpJLikhVQTAz3vVqyfElyquwQ+B65YmVpaLBWUAzRWnp1Ww7TuxglBq5r4QMX9PEio1nXDkzA",
-	"GrlBlpdCQabFjuvJ3ziUFyS+UJq7Su13WZHzJRCc3Dywly3ijhuaLssN0bivBaGKUOKvpglhc7IRNbnC",
-	"zSnZBX7vVmOwtiIGabg5rXvUHN4h9PWQkUDeTIgSKEfk+XPXRxmfs0UtQZGrJeilu/MkqEpwBUTM/hty",
-	"bbb9/5z99IoISX4EpegCXtP8ggDPRQHFlJzOCRc6Ig1HS4hD8+XQOhxcqUv+v5UwNLFSi4rmF+kbvWQr",
-	"lljVj3TNVvWK8Ho1A2m21F8hWhAJupZ8CCA74g5SXNF1f9JzWfMc97+ZtiXLGWpjqirpBhG2ouuvHk0c",
-	"OIrQsiQV8ILxBdFrPijHmbl3g5dJUfNihJijzZ5GF6uqIGdzBgUJo2yBxE2zCx7G94OnEb4icPwgg+CE",
-	"WXaAw2GdoBlzus0TUtEFRCQzJT875oZPtbgAHgidzDb4qJJwyUStwkcDMOLU2yVwLjRklYQ5S9DYmUOH",
-	"YTD2HceBV04GygXXlHEoDHNGoIUGy6wGYYom3K7v9G/xGVXwxdOhO755OnL356K761t3fNRu40uZPZKJ",
-	"q9M8dQc2LVm1vh+hH8ZzK7bI7M+9jWSLc3PbzFmJN9F/m/3zaKgVMoEWIvzdpNiCU11LePaWPzR/kYyc",
-	"acoLKgvzy8r+9GNdanbGFuan0v70UixYfsYWA8gMsCYVLvxsZf8x46XZsV4n9YqXQlzUVbygvKW4zjbk",
-	"9MXQJtsx9yXMk6DtxorH+dorI/t+oddhIweAHMRdRc2LF7CRYKCl+Rz/Wc+Rnuhc/mn+qarSfK2reQq1",
-	"ho7dlYzmA2dWOKmqkuXUIPGNe2yeGiYAVpGgzRvHeKE++ysCsZKiAqmZHZRWVVaKnJaZ0lTjSP9Twvzo",
-	"2dH/OG7sL8f2c3UcTf7SfHWGHxmR1YpBGa2qPcZ4bUQftYVZGAaNj5BNWLaHQhPjdhMNKTHDgku4pFxP",
-	"G5WlxQ/CAf7VzdTg20o7Ft8dFWwQ4cS+OANlJWD74j1FItQTRCtBtKJAuijFLPxw/6SqGgzi85OqsvhA",
-	"6REYCmawZkqrB7h82pykeJ7TF1PyXTw2iuKClxtzOVhRw9wNc3druVss2JbcGpoR7ymC2ynk1GyNR4MR",
-	"8w9BcahWLEVppJ6dtGJe/t69G5OZ+X3Ux58GicW4HSYuVLQc5qyOg79Eys39DuX0CceZe6bkpPvt9cjG",
-	"jLKFYNRpg8VDEw/+wjSs1E5KiCCKqMltD5WSbo6ckJihsNcnk58VWAqp6IJxhHZi1CdOVvTC7odAvBtC",
-	"ABX0IktLVoIMJlQnczrUT3t2lk+AWlMb6yVRI6mWTGnUq/FlsoQSBWfKPUHHpHItyhix4VsWEWC+krSy",
-	"tOyeWLGLcdTn7UsW1htevCPvxCTMEbuPNhqhujZb3sk6k5Ag1+jA8HUp8ovvqVoe4ITP/Fh92sdpyBJo",
-	"AZIsqVomDk6HtpvRxtC3eRFplsyiqaZhiS/FQh1giaXYh3VV1XNalmbqPsvqrBYHHnWQy5KYlwmsGBrM",
-	"neJoLexW/yLf0HxpxAKS07KcNKYiUWUlXEJplHbGOcgJ0Uuqm8OPI3u9Bs+RAsPsNJBoNc7MhCY2GWwR",
-	"EsiK4g20MtpMVba/CRxU0RV0pCC8EUWNVoRI0Th94VcHl8CRJ4WhEfywRrTWxINPzdzuEc7MhV2ctQBq",
-	"774L+Av8ogW0ebu5T3kzhZCFtVlr8xuTJBfSDmFveDe5+Q9Q2XxsqfN+JSFzQ0h6CVLR0qyus6gHgXwP",
-	"dTp3nMyCahqdTEeFaQXMcg78DsU7kAkrzU/4H1oS89hIMYaSGuphKIyIyJ1a2IvZoMrOZF5Ae6sgK2vK",
-	"JBXNL/aC8nkzeZrNjDp531jrqdtCt4iwQ+drVqhDbRMONrRX7RNibVeeHfVkka1MJ5prDALORUUs++iA",
-	"YDkFjmYRItYHv9a+FusUTF+Lde9KE2s4yE6YcUYz+6/F+oWDTMjdmMexxyDdLJDTFSi83XjMOM0sjV/u",
-	"ZCbk9aSJzgXDSeNtJNSMGglTkw6S8NW6ytzZTHgs7AudgZoAj+1CQHf4FMZaWDjT9D1gQZlRD4GF9kCH",
-	"xoJYVayEA5D+MinEzaiCz56Qs+9PPn/85Pcnn39hSLKSYiHpisw2GhS578xyROlNCQ+S2hFKF+nRv3jq",
-	"fVTtcVPjKFHLHFa06g9lfV9W+7WvEfNeH2ttNOOqA4CjOCKYq82inVi3rgHtBczqxRlobTTd11LMD84N",
-	"ezOkoMOXXlfSCBaq7Sd00tJxYV45hrWW9LjCN4EXNs7ArIMpowOuZgchqqGNL5pZCuIwWsDOQ7HvNjXT",
-	"bOKtkhtZH8K8AVIKmbyCKym0yEWZGTmPiYSB4rV7g7g3/HZV3d8ttOSKKmLmRu9lzYsBO4Re8/H3lx36",
-	"fM0b3Gy9wex6E6tz847ZlzbyGy2kApnpNSdInS3zyFyKFaGkwA9R1vgOtJW/2ArONF1VP83nh7F2Chwo",
-	"YcdhK1BmJmLfMNKPglxwG8y3w2TjRh2Dni5ivJdJDwPgMHK24Tm6yg5xbIetWSvG0W+vNjyPTFsGxhKK",
-	"RYssb27CGkKHneqeSoBj0PESH6Ot/gWUmn4r5Hkjvn4nRV0dnD135xy7HOoW47wBhfnWm4EZX5TtANKF",
-	"gX2aWuMHWdDzYESwa0DokSJfssVSR/riaynew52YnCUFKD6wxqLSfNM3Gb0ShWEmulYHECWbwRoOZ+g2",
-	"5mt0JmpNKOGiANz8WqWFzIGQQ4x1whAtHcutaJ9giszAUFdOa7PauiIYgNS7L5oPM5rbE5ohatRA+EWI",
-	"m7Fv2elsOFspgRYbMgPgRMxcjIOLvsBFUoye0l5McyJugl+04KqkyEEpKDJnit4Jmn/PXh16C54QcAQ4",
-	"zEKUIHMqbwzsxeVOOC9gk2GsnyL3f/hFPfgA8GqhabkDsfhOCr1de1of6nHTbyO47uQx2VlLnaVaI94a",
-	"BlGChiEU7oWTwf3rQtTbxZuj5RIkhpS8V4r3k9yMgAKo75nebwptXQ1EsDs13Uh4ZsM45cILVqnBSqp0",
-	"tostm5datgSzgogTpjgxDjwgeL2kStswKMYLtGna6wTnsUKYmWIY4EE1xIz8i9dA+mPn5h7kqlZBHVF1",
-	"VQmpoUitAT2yg3O9gnWYS8yjsYPOowWpFewaeQhL0fgOWU4Dxj+oDv5X59HtLw596uae3yRR2QKiQcQ2",
-	"QM78WxF24yjeAUCYahBtCYepDuWE0OHJkdKiqgy30FnNw3dDaDqzb5/on5t3+8RlnRz23i4EKHSguPcd",
-	"5FcWszZ+e0kVcXB4Fzuac2y8Vh9mcxgzxXgO2TbKRxXPvBUfgZ2HtK4WkhaQFVDSTSI4wD4m9vG2AXDH",
-	"G3VXaMhsIG560xtK9nGPW4YWOJ5KCY8En5DcHEGjCjQE4r7eMXIBOHaKOTk6uheGwrmSW+THw2XbrU6M",
-	"iLfhpdBmxx09IMiOo48BeAAPYejrowI/zhrdszvFf4JyEwQ5Yv9JNqCGltCMv9cCBmzBLscpOi8d9t7h",
-	"wEm2OcjGdvCRoSM7YJh+TaVmOatQ1/kBNgdX/boTJB3npABNWQkFiR5YNbCKvyc2hLQ75vVUwVG2tz74",
-	"PeNbYjk+TKcN/AVsUOd+bXMTIlPHIXTZxKjmfqKcIKA+4tmI4PErsKa5LjdGUNNL2JArkEBUPbMhDH1/",
-	"ihZVFg+Q9M9smdF5Z5O+0a3u4jMcKlpeKtbM6gTb4TvvKAYtdDhdoBKiHGEh6yEjCcGo2BFSCbPrzKU/",
-	"+QQYT0ktIB3TRtd8uP7vqRaacQXkP0VNcspR5ao1BJlGSBQUUIA0MxgRLMzpghMbDEEJK7CaJD55+LC7",
-	"8IcP3Z4zReZw5XMGzYtddDx8iHac10Lp1uE6gD3UHLfTxPWBjitz8TktpMtTdkc8uZHH7OTrzuDB22XO",
-	"lFKOcM3yb8wAOidzPWbtMY2Mi/bCcUf5ctrxQb11476fsVVdUn0IrxVc0jITlyAlK2AnJ3cTM8G/uaTl",
-	"T+EzzIeE3NBoDlmOWXwjx4Jz841N/DPjMM7MAbZB/2MBglP71Zn9aIeK2USqstUKCkY1lBtSScjB5rsZ",
-	"yVGFpU6JjYTPl5QvUGGQol644FY7DjL8WlnTjKx5b4ikUKXXPEMjd+oCcGFqPuXRiFNAjUrXtZBbBeaK",
-	"hvlcluuYmznag67HIOkkmxwNarwGqZeNxmuR087bHHEZtOS9CD/NxCNdKYg6I/v08RVvizlMZnPfj8m+",
-	"GToFZX/iKOK3eTgU9GvU7XJzAKHHDkQkVBIUXlGxmUrZp2Ie52j7UMGN0rDqW/Ltp78PHL83g/qi4CXj",
-	"kK0Eh02yLAnj8CM+TB4nvCYHPkaBZejbrg7Sgr8DVnueMdR4U/zibndPaNdjpb4V8lAuUTvgaPF+hAdy",
-	"p7vdTXldPykty4Rr0WVwdhmAmoRgXSYJVUrkDGW200JNXFSw9Ua6dM82+l+HvJQDnL3uuB0fWlwcAG3E",
-	"UFaEkrxkaEEWXGlZ5/otp2ijipaaCOLyyviw1fK5fyVtJk1YMd1QbznFAL5guUoGbMwhYab5FsAbL1W9",
-	"WIDSHV1nDvCWu7cYJzVnGudameOS2fNSgcRIqql9c0U3ZG5oQgvyJ0hBZrVuS/+YoKw0K0vn0DPTEDF/",
-	"y6kmJVClyY+Mn69xOO/090eWg74S8iJgIX27L4CDYipLB5t9Z59iXL9b/tLF+GO4u33sg06biglHZpmt",
-	"Iin/z/3//ezXk+y/aPbno+zL/3X8219P3z142Pvxybuvvvp/2z999u6rB//7f6Z2ysOeSp91kJ++cJrx",
-	"6QtUf6JQ/S7st2b/XzGeJYksjubo0Ba5j6UiHAE9aBvH9BLecr3mhpAuackKw1uuQw7dG6Z3Fu3p6FBN",
-	"ayM6xjC/1j2VihtwGZJgMh3WeG0pqh+fmU5UR6ekyz3H8zKvud1KL33bPEwfXybmk1CMwNYpe0YwU31J",
-	"fZCn+/PJ518cTZoM8/D8aHLknv6WoGRWrFN1BApYp3TFOEniniIV3SjQae6BsCdD6WxsRzzsClYzkGrJ",
-	"qtvnFEqzWZrD+ZQlZ3Na81NuA/zN+UEX58Z5TsT89uHWEqCASi9T9Ytaghq+1ewmQCfspJLiEviEsClM",
-	"uzafwuiLLqivBDr3galSiDHaUDgHltA8VURYjxcyyrCSop9OeoO7/NXB1SE3cAqu7pypiN57331zTo4d",
-	"w1T3bEkLO3RUhCChSrvkyVZAkuFmcU7ZW/6Wv4A5Wh8Ef/aWF1TT4xlVLFfHtQL5NS0pz2G6EOSZz8d8",
-	"QTV9y3uS1mBhxShpmlT1rGQ5uYgVkoY8bbGs/ghv3/5Ky4V4+/a3XmxGX31wUyX5i50gM4KwqHXmSv1k",
-	"Eq6oTPm+VCj1giPbWl7bZrVCtqitgdSXEnLjp3kerSrVLfnQX35VlWb5ERkqV9DAbBlRWoR8NCOguJRe",
-	"s7+vhLsYJL3ydpVagSJ/rGj1K+P6N5K9rR89+gwz+5oaCH+4K9/Q5KaC0daVwZIUXaMKLtyqlRirnlV0",
-	"kXKxvX37qwZa4e6jvLxCG0dZEvyslXXoEwxwqGYBIcV5cAMsHHsnB+PizuxXvqxjegn4CLewnYB9o/2K",
-	"8uevvV07cvBprZeZOdvJVSlD4n5nQrW3hRGyfDSGYgvUVl1hvBmQfAn5hatYBqtKbyatz33AjxM0Petg",
-	"ytaysxmGWE0JHRQzIHVVUCeKU77plrVRNqMCB30DF7A5F00xpn3q2LTLqqihg4qUGkmXhljjY+vG6G6+",
-	"iyrziaauOgkmb3qyeBbown8zfJCtyHuAQ5wiilbZjyFEUJlAhCX+ARRcY6FmvBuRfmp5jOfANbuEDEq2",
-	"YLNUGd5/9v1hHlZDla7yoItCDgMqwubEqPIze7E69V5SvgBzPZsrVSha2qqqyaAN1IeWQKWeAdVb7fw8",
-	"LkjhoUOV8gozr9HCNzFLgLXZb6bRYsfhymgVaCiy77jo5elw/JkFHIprwuM/bzSF6aCu61CXqDjob+WA",
-	"3aDWutC8mM4QLvt8BViyVFyZfTFQCFdt0xZ1ie6XWtEFDOgusfduZD2MlscPB9klkSRlEDHviho9SSAJ",
-	"sn05M2tOnmEwT8whRjWzE5DpZ7IOYuczwiLaDmGzEgXYELlq957KlhfVVgUeAi3NWkDyRhT0YLQxEh/H",
-	"JVX+OGK9VM9lR0ln77Hsy7bSdKdRLGFUFDUUnvO3YZeD9vR+V6DOV6XzpehipX9EWTmje2H6Qmo7BEfR",
-	"tIASFnbh9mVPKE3BpGaDDBw/zefIW7JUWGJkoI4EADcHGM3lISHWN0JGj5Ai4whsDHzAgckrEZ9NvtgH",
-	"SO4KPlE/Nl4R0d+QTuyzgfpGGBWVuVzZgL8x9xzAlaJoJItORDUOQxifEMPmLmlp2JzTxZtBehXSUKHo",
-	"1ENzoTcPhhSNLa4pe+XvtSYrJFxnNbE064FOi9pbIJ6JdWYzlJO6yGw9M/SezF3AfOnUwbS16O4pMhNr",
-	"DOfCq8XGyu+AZRgOD0Zke1kzhfSK3w3JWRaYbdNul3NTVKiQZJyhNZDLkKA3ZuoB2XKIXO5H5eWuBUDH",
-	"DNX0anBmiZ3mg7Z40r/Mm1tt0pRN9WlhqeM/dISSuzSAv759rF0Q7vum8N9wcTF/om6lEl7fsnSTCoX2",
-	"48pWHdynQGGXHFpAbMHq664cmERrO9arjdcIaylWYphv3ynZR5uCElAJzlqiaXaRihQwujzgPX7mP4uM",
-	"dbh7lG8eRAGEEhZMaWicRj4u6EOY4ymWTxZiPrw6Xcm5Wd8bIcLlb93m+GFrmbe+AozAnzOpdIYet+QS",
-	"zEvfKjQifWteTUug7RBF22yAFWmOi9NewCYrWFmn6dXN+8MLM+2rcNGoeoa3GOM2QGuGzTGSgctbprax",
-	"7VsX/NIu+CU92HrHnQbzqplYGnJpz/GJnIsOA9vGDhIEmCKO/q4NonQLg4wSzvvcMZJGo5iW6TZvQ+8w",
-	"FX7snVFqPu196Oa3IyXXEpUBTGcIisUCCl/ezPvDeFRErhR8EXVxqqptNfOmxJauw8pzW4rWuTB8GArC",
-	"j8T9jPEC1mnoY60AIW8y67DgHk6yAG7LlaTNQknUxCH++EZkq7tlX2g3ASAZBH3ecWY30cl2l8J24gaU",
-	"QAunkyjw69t+LPsb4lA3GQqfblU+3X6EcECkKaajxib9MgQDDJhWFSvWHceTHXXQCEb3si4PSFvIWtxg",
-	"OzDQDoJOElyrlLYLtXYG9mPUeY+NVmZjr11gsaFvmrsE/KKW6MFoRTb367YHXW3k2n/45UwLSRfgvFCZ",
-	"BelGQ+By9kFDVBVdEc1sOEnB5nOIvS/qOp6DFnA9G3sxgnQTRJZ20dSM6y+epshoB/U0MO5GWZpiErQw",
-	"5JM/73u5vEwfmZLClRBtzTVcVcl0/R9gk/1Cy9ooGUyqJjzXuZ3al+8eu365+gE2OPLOqFcD2I5dQcvT",
-	"G0AaTFn6wyMVFbC+p1ol/lG9bG3hHjt1kt6lA22Na8owTPzNLdNqWtBeyk0ORhMkYWAZsxtn6dgEc3qg",
-	"jfguKe/aBFbslkEieT+eiinfwrJ/FYVaFLto9xxo6YkXl3P0bnJ0s0iA1G3mRtyB69fhAk3iGSNNrWe4",
-	"FdizJ8ppVUlxScvMxUsMXf5SXLrLH1/34RW3rMmkKfv8m5OXrx347yZHeQlUZsESMLgqfK/6ZFZl2zhs",
-	"v0pstW9n6LSWomjzQ0XmOMbiCit7d4xNvaYoTfxMdBRdzMU8HfC+k/e5UB+7xC0hP1CFiJ/G52kDftpB",
-	"PvSSstI7Gz20A8HpuLhxnXWSXCEe4MbBQlHMV3ZQdtM73enT0VDXDp6Ec/2EpSnTGgd3hSuRFbngH3pw",
-	"6elbIVvM32UmJoOH3p9YZYRsi8eBWG3fv7IrTE2JFbz+WPxhTuPDh/FRe/hwQv4o3YMIQPx95n5H/eLh",
-	"w6T3MGnGMkwCrVScruBByLIY3IjbVcA5XI27oE8uV0GyFMNkGCjURgF5dF857F1J5vBZuF8KKMH8NB2j",
-	"pMebbtEdAzPmBJ0NZSKGINOVbZmpiODdmGpMgjWkhczetWSwztj+EeL1Ch2YmSpZng7t4DNl2Cu3wZTm",
-	"ZYIvD1hrzYg1G4jN5TWLxjKvjamZ2gEymiOJTJUs29rgbibc8a45+1cNhBVGq5kzkHivda46rxzgqD2B",
-	"NG0XcwNbP1Uz/E3sIFv8Td4WtM0IstV/9yL4lPxCU01/9owAj2fsMe4t0duOPhw122y2ZTsEc5weM6Z1",
-	"umd0zlk3MEeyFTpT2VyKPyHtCEH/UaIQhnd8MjTz/gk8FbnXZSnBqdx0dG9m37Xd43XjoY2/sS7sFx26",
-	"jl3nMk2f6v028jpKr0qXa3ZIHlLC4giDdmrAAGvB4xUFw2IbFB99RLk9T7YKRCvDLH0q41zOYzt+cyod",
-	"zL3815JezWiqR4zRhQxM0fa24qS0IP5jvwEq1Diws5Mogju8y2wluQpk44PoV6W9pl5jpx2t0TQKDFJU",
-	"rLpMbJhCqURimJpfUW67iJvvLL9yXyuwLnjz1ZWQWAdSpUO6CsjZKmmOffv21yLvh+8UbMFsg+xaQdSB",
-	"2Q1EbLFJpCLXxTpU7nCoOZ2TR5OoDbzbjYJdMsVmJeAbj+0bM6rwugzu8PCJWR5wvVT4+pMRry9rXkgo",
-	"9FJZxCpBgu6JQl4ITJyBvgLg5BG+9/hLch9DMhW7hAcGi04IOnr2+EsMqLF/PErdsq7B+TaWXSDP9sHa",
-	"aTrGmFQ7hmGSbtR09PVcAvwJw7fDltNkPx1zlvBNd6HsPksryukC0vkZqx0w2W9xN9Gd38ELt94AUFqK",
-	"DWE6PT9oavjTQM63YX8WDJKL1YrplQvcU2Jl6Klpr2wn9cPZXv+uX5SHyz/E+NfKh/91bF23rMbQ1UDO",
-	"FkYpv0IfbYzWCaG2+GfJmsh036+TnPrawthAK/TNsrgxc5mloyyJgepzUknGNdo/aj3P/mHUYklzw/6m",
-	"Q+Bmsy+eJhpRtXu18P0Av3W8S1AgL9OolwNk72UW9y25zwXPVoajFA+aGgvRqRwM1E2HZA7FhW4feqzk",
-	"a0bJBsmtbpEbjTj1jQiPbxnwhqQY1rMXPe69slunzFqmyYPWZod+fvPSSRkrIVMNA5rj7iQOCVoyuMSM",
-	"ufQmmTFvuBeyHLULN4H+w8Y/eZEzEsv8WU4qApFHc1uyvJHif/mxqXyOjlWbidixAQqZsHY6u90tRxvu",
-	"Z3Xr+m9twBg+G8DcaLThKH2sDETf2/D68M2HiBfqgmT3vGVwfPwHkUYHRzn+4UME+uHDiROD/3jSfmzZ",
-	"+8OH6QLESZOb+bXBwk00Yvw2tYdfi4QBzHctDAFFrj5CwgA5dEmZB4YJztxQE9LuEHf7UsRh8rvS0abp",
-	"U/D27a/4xOMB/+gi4gMzS9zAJkth+LC3O2QmSaYIz6M4d0q+FuuxhNO5gzzxfAQoGkDJSPMcrqTXATTp",
-	"rt8ZLxLRqBl1BqUwSmbcFCi25386eDaLn2zBds3K4pemtlvnIpGU58tklPDMfPi7ldFbV7Bllck+I0vK",
-	"OZTJ4axu+7vXgRNa+n+LsfOsGB/5brcDrV1uZ3EN4G0wPVB+QoNepkszQYzVdtmsUJahXIiC4DxNU4uG",
-	"OfZbOadaaCbym3HYVa1d3CrmgruCQ3NWYhhm2m+Mb2aS6oECWtjv3PcXMuNg+3FlzQx2dJCEshVezIqu",
-	"qhLwZF6CpAv8VHDofI4l1HDkqGMFUZV5hG9iwQpBdC05EfN5tAzgmkkoNxNSUaXsII/MsmCNcx89e/zo",
-	"UdLshdgZsVKLRb/Mn5qlPD7GV+wT12TJtgLYC9jdsL5rKGqfje0Tjusp+a8alE7xVHxgM1fRS2pubdtP",
-	"MvQ+nZLvsPKRIeJWqXs0V/oiwu2CmnVVClpMsLjx+TcnL4md1X5jW8jbfpYLtNa1yT/pXhlfYNRXdhqo",
-	"nDN+nO2lPMyqlc5C+8lUbULzRtMgk3VibtCOF2NnSl5YE2po4G8nIVgiW66giLpdWiUeicP8R2uaL9E2",
-	"2ZKAhnnl+Easnp01npso+zB0P0KGbeB2vVhtK9YJEXoJ8oopwIx8uIR2OcRQG9TZxn15xPbyZM25pZTp",
-	"HsJo6HW0L9o9cFaS9UEFScg6iN/TMmX7Me/bl/YMv0rnYnSa3Ha8/r64ni+xTX50zoWccsFZjq0QUpI0",
-	"lm4b56Yc0TUi7V9UR+6EJg5XsrVuyAV2WBxstusZoUNc3+UfPTWbaqnD/qlh7VquLUArx9mgmPhO184h",
-	"xrgC183KEFHMJ4VMBDUlEyFCAMWeZIRVmQYsnN+aZ6+c/RuLYlwwjpYuhzann1mXVakYeqY5YZosBCi3",
-	"nnY2j/rVfDPFKo0FrH+bvhQLlp+xBY5hw+jMsm3MaH+oEx9B6iI2zbvPzbuudn74uRUOZic9qSo36XAf",
-	"9KQgqdd8EMGpuCUfSBIhN4wfj7aF3LaGfuN9aggNLjFqDSq8h3uEEXppt0f5xuiWlqLwDWIzKpMFdBlP",
-	"gPGSce9CTV8QefJKwI3B8zrwncol1VZ3GMXTzoGWAwkQmKFsffA3HarbOcCgBNfo5xjexqYN+ADjCC80",
-	"Ej/lG+IPhaHuSJh4TssQOp1o6o1SlROiCkwu6rT5TjEOw7gznzLZQtfO9L3wOXbj2PcmGqpROKuLBeiM",
-	"FkWqtNXX+JTgU58kBmvI69CEKmQHtmuU96nNTZQLrurVlrn8CzecLuqbn6CGuHe/32GstDPb4L+pDkzD",
-	"O+OCpvfOyvUR0sV+hfn7WcYpqdfQdKbYIhuPCbxTbo6OZurrEXrz/UEp3afrfhTZuB0uF+9Rir99Yy6O",
-	"uHBvLz7dXi2hri7Gggt87gsehYqQba6EV1mvzxhGPeDmJbasA7x/MQn4JS0HMuFjX4m9X63/YCgfPh8s",
-	"30C1K8+lKdnKggZLHtlY4Y73pe9CHIoPtuHBh/NauLVuReiw7+6HlqfOxog1zGLQQ3c9J1qzwft60X64",
-	"HCqR4Pt04PO4H4iL4pm4MvBwyUTto698DLRXCe2vrgRPq+/HwPqTmQUf2msx6GM5d/1r7TKdTv7DL9YL",
-	"S4BrufkIPC69Te82lUlIu9Y81bxCQuvDUa0QW7fimB42qXYpTjb0tjLLWlq01Gs/0yOrF2PEgR4+3k2O",
-	"Tou9LsxUy50jO0rq2L1ki6XGiv3fAy1Avt7RkaDpQoBHrBKKNR1ISzOYKwG7xOGmY5MNDAGzuKNCfywf",
-	"hHoJuca2s01wnQTYp7+Cmcw7fe46Ewyr0yEnwzUk2NaFoN9rdscd3yucFBX/sn06p+Nr7p+EEGqbAXZF",
-	"VVOupZMzPTpzcz6HHKsiby1U9c8l8KgI0sTbZRCWeVS3ioU8Jqzrvb/VsQFoWx2prfBE/XVuDM5QHvsF",
-	"bO4p0qKGZOPQkMR3ncLBiAHrAvM1pIcMyS5qjKlAGYgFHxLsSjE3zTEGaz5HZdeuOZcnSXNxNKXYtkyZ",
-	"bno+ai7z6V5lHzElZ6iWVb9n8rD+8QJbVCsXIEdD4eFYSyen/cY5V65wMZYVC74TX8IYlP/N1xC0s5Ts",
-	"wvUPQKxYT9UVlYV/4yBFoezdxNJAz8PMrEng6Ac5JFoxYC5UXgojRmRDCWXtnIkQcHhP2cjQpoAPwjUH",
-	"KaEILpFSKMi08Akf2+DYhgob/notJKjB9kcWuMHS12+a2t7YBo5iqWvqol7jBRIJK2qgk1EF7uE5tyH7",
-	"uX3uk/B9G7CdFqZAr7v70frUHaZ6SIypfk7cbbk7uf86xibGOcjMe5665bh5uyIb1t0s6txe0PHBCAa5",
-	"0bVztrCSpJ0m76+yoyNESfIXsDm2SpBv5Ot3MAbaSk4W9KjgaGeTD2p+Uym4FwcB78PWkauEKLMBZ8dp",
-	"v4Z4l+IvWH4BWAMwhLgP9Ggn99HGHrzZV8uNr5ldVcCheDAl5ITbpCLv2G63F+xMzu/pbfOvcdaitmX9",
-	"nVFt+panszOw4L68ITfzw2znYQoMq7vhVHaQHRWq13wo5OYKi/O3u3hOx2rlfVdzt4t8Q1QWipRMcmY9",
-	"Vs/xoKcMR1gCIarVgY5MSpyni6hSpGJ5r1OmwQyVxlQ8GQKkgY+pFhCgcIMnEZDsi544hbb0nSt6J+ZE",
-	"QuNEvm71v34L95RG3505zNLmd3MhodWM3XxtK32GxBcso4n/mTEtqdxcp0Zfr4V8z3oyiOWd4VghEqtZ",
-	"SBON1cdhWYqrDJlVFvpcpFRb855qX8a+6VrznTnVM4jiuqhygtqGLGlBciEl5PEX6XxPC9VKSMhKgWFe",
-	"KQ/0XBu5e4VJXpyUYkFElYsCbL+YNAUNzVVzTlFsgiiqJokCSzuYLWy/ieh45JTmTrV+pAxFrcUevfNz",
-	"sJnrTVUnu+jM+jIHIpZBuSpODkP25T68W3r/p3nznK2RbkCmjvycaFnDhLg3uj2y3cGnEsiKKWVBCbR0",
-	"xcoSE8fZOvK8hsCFNGoHxN5TDKu8ZBh70y4iYKXhytx5obJCzAPO4rJHRC+lqBfLqMB0gNOrvLJ2CnE8",
-	"ys+qxvAozCAzUzwlK6G00zTtSM2Sm5Cz+7ngWoqybBulrIi+cJb2H+n6JM/1SyEuZjS/eIB6LRc6rLSY",
-	"+PzqbnBgM5PslBZrX8CZbWe+u1SvfQ9D5RzRjmaQHRa3d2P3CMzfdnPQ3Tb3k/7CuutqM9O0GnPCCdVi",
-	"xfL0mfq0ou0GY+RSLCpZs8z2VrRVJvA1POzxZRWCK5BF9tEMnCabw50QxwickxnZjfkvSuDdcckcHKMZ",
-	"uCj7zMVJUVk+KOt1AEBIbeqzrqVtyBhLYoGriIUtlYAu8i6gI28VjES6GWxmhIMDpeFGQPWiHwOA963x",
-	"YWJry9lIyplY++cPmuJz1wL+3XYqbzGPoRCvs4a0pA3y8oVqBjhCusT11nioc0x7n42NigrNc0fe8BEA",
-	"w3FSLRhGRUvtC8acshKKLNV78TTYqCaRpu1Ss7ot0ZlynDyntW99aMauJbjCKVbEl23/V0UNKYnwet+S",
-	"zAtYg83r+BOksD0NJ5H/BUrb8rBjDBBVVsIltMLHXDWXGkVNdgn+WxU+JgVAhd7Iro0sFRcV3+Udw4lb",
-	"exZF1ozBbtKSYhFrd4rsMJMkjTprntljosYeJQPRJStq2sKf2lfkaJsBzVFOoKqnI2Rejxw7zc92hDd+",
-	"gBP/fUqU8Zj4bRwf2psFpVG3jQHtjJOs1dCp5+kwybhUUXCw4GxFcMRaEm/4hqroFR82SPZJvlG3Ru4T",
-	"EzxC7DdryFGqcfoOFE7jGXBSuKonSO0coLBagfkkYW1fAidcRC0mr6gKqkpTQ9H/YCfGlxh32vQ1nMpN",
-	"NOPNd5bgYER1iqkNKhIy0On1zfMf5CRuPYiD46VoRIFL/9ti//LU7dQOfAFbeXOzn0b2xyaN7hZzXHxC",
-	"ZrUfqCzFle0ZGeuhL8D7QS31eReQE8tZuJZ91ObElffsmjpYFK++ohsiJP5jtM5/1bRk8w3yGQu+/4yo",
-	"JTUk5ByvNiLARYGaibeLVxMPmLe2CD+VXTcbO2Y03MaMEgFtLnLf3EeQFb2AeBsw2MHyz1wbxqnqGVou",
-	"zJXd2c4+FtzifYmWFS1iTR8LRbbbqPvSwebr/1+TCxdP5eu7VSXNfYdQ16KozWewC7AnLr2E1fZkyT5f",
-	"8yQQOgs3RCt9dn1xDZPpnqwrlYEw1H6lBXav42qv88yNljHS8tvpsbElzXTUUg69C2OjbnpAx30ad4Ef",
-	"t628Hfwna7gOLWMM+B8L3gca1cbw2p60t4DlVgWOBKzWWj0T60zCXO0KMLHmaqPOy6Z2hzexMp5LoMpG",
-	"3Jz+5BTPpkQp40YRtjGhwacZRilgznjDLBmvap3QY7BSKd9ECIuN/ojWARfakJRghMlLWv50CVKyYmjj",
-	"zOmwLR3jFhHe0eG+TZgwwp3aH4CpRofD/MzGjB6/Zi5w24TKhmsqTXlBZRG/zjjJQZp7n1zRjbq+Ryk4",
-	"B3b5lGgkzbSrBkTeJSRtC0i5cU7hG/p7AoD0gI6fEQ4bjAtOOGusaUeLAf9MH4ZPwmGzouusFAvMIhw4",
-	"EK42LXr4rAooOJrBrXw2bt1+HsX+hO3TYFl+x4i0wFnHTLH93P+EW4lq5M+c6a0n39oou2mdNu7WHkyP",
-	"VL5ogv8tsfTPYyoT1xVfibNxvbDpU1U87UG0iTDgH2rbxQd2EcMgXBp3bAQf3+6sHWmRyve1loEMLQZq",
-	"S3g/qCaUneYuPKtvSuuZGixSJi5bek9Lm7XP+3tpADzbm96d9fa0IWTGjLNPj7jt+dFZJaosHxPzaTt3",
-	"FM5N4CBtwzhAH5ETYGDdITxGhV42rbpHraY2+7bJG2yqs8vbVeXblP4hM9EAR2+7IMQceZnt3I7WLczk",
-	"CcaUSTfHrG0GC0yCUCIhryWaia/oZnfbsYGK0Wffn3z++MnvTz7/gpgXSMEWoJqq4522XU1cIONdu8/t",
-	"RgL2lqfTm+CrD1jEef+jT6oKm+LOmuW2qikp2mtato99OXEBJI5jol3UtfYKx2lC+z+u7Uot8uA7lkLB",
-	"+98zKcoy3fUhyFUJB0pqtyIXitFAKpCKKW0YYdsDynQTEa2WaB7E2r+XtpqM4Dl4+7GjAqYHQq5SCxkK",
-	"qEV+hrndzmtEYF2VjldZT8+2dTk9zVroUGjEqJgZkEpUTrRnc5KCCDOIZJRZ6wyfaBGPYmQDs7XRsilC",
-	"dJHnadKLG2Zv5/btZq46zenNJibEC38or0GaQ/6J4boF1+EkjWn/o+EfiUIMB+MaYbnvg1ck9YPrNeUf",
-	"BVo/KT9BHgjAQLZtK08yShSLChFL6yVAf4J3IHfFjx8bx/LOtBCExH+wA7w4fbZ5L2QyOHA+cEXfHwNS",
-	"oqX8NkQJreXvysj1rDdcJNEWOaOJ1qAsWxJ9sTBKt1bPQxbzgFbSS3aWQmhiNNOyTCRJWzsOnqmYcIxK",
-	"IC9peftc41smlT5BfEDxZjg1Ks6UjZFsUamuV6fvJR01d5QVe7ip+WtMzP4nmD1K3nNuKOeE791maNzB",
-	"jvULfyvYXG9yhWPaIKvHX5CZa7ZRSciZ6jr3r7xwEhJDQbK5C2iFtd6Ribprnb8IfQMynvtIHPIqcm8F",
-	"n72DsDmiH5ipDJzcJJWnqK9HFgn8pXhU3Jx3x3Vxw8YM1yv7EhVw27PsS7/t8Njl2dIm5tKpFfTXOfq2",
-	"buE2cVE3axtbs2h0f4e3b3/VszGlhtK9GMznWOvoIE0Z9mrJ8B6qHFkcuTHcvCmK+WWo7q2t7TpQm7uz",
-	"HzUrdwastCqtv5scLYCDYgprif/uesfc7l3qIbCVF/pH1cJ6k3IxFjGJtbYmj6aKaqiPKJ/uPkvUvMas",
-	"xryWTG+wb7A3oLHfk/WYvgu1PVxtmOBLc3efFhcQerc3lUBq5W/X7wQt8T6yLj5ubiFRTsk3tsK3Oyhf",
-	"3Zv9B3z2j6fFo88e/8fsH48+f5TD08+/fPSIfvmUPv7ys8fw5B+fP30Ej+dffDl7Ujx5+mT29MnTLz7/",
-	"Mv/s6ePZ0y++/I97hg8ZkC2gvrT/s6P/m52UC5GdvD7Nzg2wDU5oxX4AszeoK88F9rU0SM3xJMKKsvLo",
-	"mf/p/+9P2DQXq2Z4/+uR6890tNS6Us+Oj6+urqbxJ8cLTP3PtKjz5bGfB7sNtuSV16chRt/G4eCONtZj",
-	"3FRHCif47M03Z+fk5PXptCGYo2dHj6aPpo9da2tOK3b07Ogz/AlPzxL3/Rjrax4rVzr/OORqvZv0nlWV",
-	"LaxvHjkadX8tgZZYYMf8sQItWe4fSaDFxv1fXdHFAuQUszfsT5dPjr00cvyXq5zwzgCWdBvaOutRcW0f",
-	"iFjVs5LlvkYZU9Z+bAPsVdxc1hrWazUhM9t+2Mfw8gIjlGwxAhW34D4tDJ7t56cNr/MdlNGtfPTs10Q1",
-	"K5/44Rv7xjFnUTTa/zn76RURkjit6DXNL0LSi89yajK74iQn8+XUk/2/apCbhiwdw5wcqdAdHHi9MrzH",
-	"Zc+s1KJqF3ZthLGUsaiHaz+zoaboPIQ6Jw2/Q8tgBEnDvQ1HfpR9+dtfn//j3dEIQLDojgLs8/gHLcs/",
-	"rHUN1hhY2wm8mQyFRE2auhn4QbOTEzRkhafR58077Xrof3DB4Y+hbXCAJfeBlqV5UXBI7cFv2IkQiQWP",
-	"6pNHjzx/ctJ/BN2xO1PRLKNaAFjnQhjFk8Q1BurzMfvoTSiNKWllz+KJDx/eVMG9Y1+aGnb19IALbRfw",
-	"vPFyu8P1Fv01LYh06cu4lMef7FJOuQ0FNfeRvTffTY4+/4T35pQbnkNLgm9GbX77F83P/IKLK+7fNDJT",
-	"vVpRuUGJSAde2O1LQxcKfarIIu3Zjqqv8cXRb+8Gb73jOObx+K9W6aTiRneidbK0ujrtuCbvqSHOiWPZ",
-	"pDT3w/2TqsKQz7Pw/KSqbNdwDCMAhrcfrJnS6sGUfBd/3fKNWEisa6SVE+CbaPvW3C1XedSOM3lpt4oS",
-	"3N3fH/b+PmnbSFgBXLM5Q3k9BUzrFGyFqResdNMLtJ8jFJVI2jceOpTHdqJF5lqvjRzDNeE/XF/BEZVR",
-	"7Ey/pTTInYz6DncDuBsSkyJ4g8TUNDW8HdbsK+2Gm6R1ZbxHxv2JC30/0tLQSbTcTkeb0xd3wuDfShgM",
-	"FTkXVjqrqgOIhz5xY9crx3+5KpOHkBpRPR4lL8aad/RtFHt/v8NxHkzJSfed67EVV6VzpyRo3ruTAT8G",
-	"GdCWOd0l/Tk6/qByX5z2tU8WVktgMb+P+vgTF/T+xsgalOwMpLtlumuwz5685pj1e2Or/5ZymkPanYT2",
-	"t5bQQu3sG8locejrsatCEElsNzLwdQ14TAdJrF0/PeJsWG4E8/HtEZ40Yf6Gxdj4ZRe5rCZeeURHrdUr",
-	"7WZNeqplX8T6DmId9uvN6Ytd0tUnZAoa3QY5cQuk9+Z989KkZ+LN7XgmxvGmp4+e3h4E8S68Epp8i7f4",
-	"e+aQ75WlpclqXxa2jSMdz8R6F1fiHbYUCtSZQ9viUaEO6SR6bt628R/3MeO33TjrwZR87V5tqoC4jPaF",
-	"MIzKZ4pRubAfGV5nkEHu+T+f4fj3puRbzH/UaoJhbJhYgS8yrp89fvLZU/eKpFc2Sqz73uyLp89OvvrK",
-	"vVZJxjWGDFg9p/e60vLZEspSuA/cHdEf1zx49n//87+m0+m9nWxVrL/evLKddj8W3jpJVTwMBDC0W5/4",
-	"JqW0ddcBeSfqbsXD/7VYJ28Bsb67hT7YLWSw/29x+8zaZOQU0WDsbPXiOeBtZI/JPvfRxN0/mMQRLpMp",
-	"eSVcW7S6pNLWh8ESuoosaiop1wDF1FMqZuApW8guLxmWDpBEgbwEmSkWSlXXEkIRk0rCJUbfN0VeWxDs",
-	"ZvQYo/vRMvkf6TpKm5+Fa1oLt2Q0e67ommCfD00U6ImtoLYmX31FHk0a7aUszQBZQEyKua7o+ugWrX6B",
-	"2MaWBXrhsCPk7tBfHHuMBamRfkJ9yUbV+Ltz7k9Wcrfk7jb2QJxzb8dP49iJ7Qiu+dhWC4IV7DRWQ1Z1",
-	"VZWbpg6ukfK8CJVmcWaGscaBj9hHsNM0nVRCu+i9O8R3RoAbsZIuQe3JNjCfVR3/hXp5zDN65xbz8f5e",
-	"7tLIdyTFyjuPBJmDzpcuFbiD+gR7ki4dcZg3rRhnKwPlo8l7l2pwF/v1j+PezwW1Cfhj2otFWZrowAOZ",
-	"IOKf8D+0xJp6bG5Lu/uGH76aIbqmXHXs0HDVKt+2BbML+fcZwxVtNZDdDeXzZvK+QIZoOYT/8w7B+yG4",
-	"xxy/cdUO7PFyi/h3SArwqmRGXokmId1qUP+Wrsf3ebO/7wW9Ehysj91IvpYW79ypQewwjMMixVcisfpL",
-	"017ruiLIsa/gs1UO+d68tEMWGXN7YzWgT/EK/z5Z56h1y5i1TXeWWWhGG8OczYu2H0JcCGX6IbWYD8JP",
-	"P0LV5kNwrNthMXhIPZ9xYgE/LNPB4j6WmI8rX4lpiAO9NC9HcpmtdzSaG2kRwtAgUVWIzKAUfKE+Tla0",
-	"jTrSeElQia1hZduq9NY//Rue3eeu54lv5u8qSSnGcyBKrABVBiOju4LUFsJ/3B6Emq18524ep7d+YO7y",
-	"+aPPbm/6M5CXLAdyDqtKSCpZuSE/89Db5CbcThHq9jy2BieYA+PobWpXHMvj8kg3YIKuc37aauzs1k3N",
-	"RGXlKlFrkLZaXqeFFesx6ZQ9GBnGSzP1AeS5Uiw+NXHOY31skefntCwRXbucTDjwqCjlsrT7CSumddMy",
-	"Ir5dyTc0X4a9nTTWvdDYz9cWn3SqUeLIrsubTfVXYPZZA4lWE1krQNoW5Rr7Nq0oBiyv6lKzqmx/Ezpf",
-	"YiegRBiSpc24icDpC78665wV82boLv36SuRu8KmZ2z3Cmbmwi6MSkHcH20qnudS0BbTtieXDr6NORq4f",
-	"kyt0yGSn8mQTO1NVQGXzsaX8+5WEzA0h6SVIRfGwdhb14E5U/zhE9bUrdfyRCOpJH+VNef31r6JWFPVf",
-	"es2Kd7vl8qha8J4iOeORSB6zC3vWri+L746i6HYWP30RJ6qIUE/LCwgDoBgU7Zmr9b+ORrpAsEiLmDs9",
-	"rOYWUF/i0kmsLotEzCchTtMopGL+jLzlD4laUl+B2f355PMvBpw4Zh5Xma7vxmkGMo/tMGN8OZ+0Z+qw",
-	"EkfA77Pb3u39NnFyxIp1osw8L2AddTZpdz529+E9RSq68RkdvUqLVbraclBM42FXYK4ptWTV7Vf0VZrN",
-	"0iXNvSUudNA/5V8Hg6wtO2ukhupDVHKdHGkJUECllzsLPONbzW6CK/XMlGvKY8vwTgibwtRWqW2apxUL",
-	"cBcTJSXQeeiCJsSYPL6IzxhC81QRYT1eyBhJOkk/KPMiUd6+nbTJd7MXnUdeVyj+oEKY/lBCWNaRwtpo",
-	"+XAyGbZzmESRV5UUWuSitGGUdVUJqcPpVtNRlgcYEvRahochwr2RMLdmhdrp0jnHtw5gA2hTtvpkXDrn",
-	"Hk0pn05qUdcsO9vMNYalnYuK9Dr3GxA+KF+7UypT/Kzj/vnUvT96kPQO7AzKqc6XdXX8F/4Hy+6+a3J2",
-	"sSGJOtZrfowtKI//2hpdiyy1NLKJtL1MWibdXkPLZIzsS/y86ZvyrZDdZuE7o2c7SJt0L33bThPDcBPs",
-	"8f1ok39rJWyr66yz4TePBkmM2DuvoSRF1IQv0G7UjcdXmbAtOBMkfBe99HEtqPEnzhkvCI22sWNrCm37",
-	"vQ7wj0920R/CRXn7IVuff8Ln7JXQ5HRVlbACrqG4WeA76XI4f3tsvW73Ewzc1d+Pju/f+fGN73N6giyy",
-	"84LfQ++JqhiBn45KLCtk7ur3o+7c3eQf903+PHhbYzK8u5c/nXtZ+kykuyv447+CP/tkV/MeY5hGXsnX",
-	"cA63r+FGE9/zQu4JA86G1TEcbPMro+rdXaX6Vkjfc+7uFv9EnaJ2J0cHYo2x0OyyxLopD5F19lFBP87O",
-	"UJYJS8PQQZ2EWC+G9RpFzrA7z2mhJi6ozBon3Cm+E3w+asEn2us7uefO9PCJmR4GpByn9ZflGEFjXwHo",
-	"ciUK8I5VMZ+7+shD0k+7IaQhT6XpqiL2y+lgHPY5W8GZefMnO8VBr9gG7I5Y1AHPIEtBLnihRkRxuFGv",
-	"ew+ho2kYgFv3bIYd8LC4yknTa5Psm6j8Yo8SSBf5Cht5+jrRDhkFXBJDgNMDkO3xX/ZfNKdVQiVWc+YJ",
-	"uLcx99222MLXdtwWgOQ1CqG2grb/SszJI1v/uuaY5N507Ka8IFpujKDqy/1JoCXJW8mtAY7+yTkbPDk7",
-	"VYHe6gbWlNYFRHNCDxnB0Cks8MOtH4DnlDuS7yNIC0IJhwXV7BK8y396V4zq2reZKwW1hQFOCC0Kexqb",
-	"TYBLkBui6pkysg5v5yjdU+3zsgfDgHUFkpkrmpaNA96qCce20tS2OKIz+8YNL60OL7L1rWQ7atHfrK76",
-	"lZiTH1kuxUm5ECEWXm2UhlWvH7b79PeBfgXekNCPWRW8ZByyleCpLs0/4dMf8WHqa6zWNfTxuXk49G3n",
-	"vm3D3wGrPc+YO/mm+P1ITv+NAl06q5VQCWm029nG5l8g/e95lPyh2fC8f5I2PI+cWu5hNFDcnLn187FP",
-	"R2i1ak6++VfrT1eRzr2plrUuxFU0C9oAbDjjmGJUKHzvmeTR2Nza2ZNMvV+r2/v0NkV4SJ2t8DTRgrd5",
-	"ONyF92+ahO2cMzGRuJzGS5Cqo8jdZWL/W2Vij973vbixbTm/i6PV6rCyyytRgB23Scc1Rz/VBIWLAlxn",
-	"/L7IEsIi0ylD/v5q3uskceS0Xiw1qSuiRSpdpPkwo7llsplVhNITRmWHrbqE0y3pJRBaSqCFUV6BEzEz",
-	"i25uUlwkVVj42eecuODPpNAUwVVJkYNSUGS+6csu0Px7NlRdb8ETAo4Ah1mIEmRO5Y2BvbjcCecFbDJU",
-	"hhW5/8MvRrW+dXit0LgdsbbcbAK93bTrPtTjpt9GcN3JY7KzCd2WajFFTqyqElySXAKFe+FkcP+6EPV2",
-	"8eZowSwy9p4p3k9yMwIKoL5ner8ptHWVmfu7D+Jz+/ScrVAS45QLb4FMDVZSpbNdbNm8FK9FmRVEnDDF",
-	"iXHgAdX0JVX6jcuXLrDMo71OcB4rY5sphgE2t6jVLRIj/2IfpsbOzX3IVa2IG8HnQEGRWgOH9Za5XsE6",
-	"zIW1U/zYIcnK2gJ3jTyEpWh8h6yo8w2hOvL7m+ESi0NLJXWmjD4qW0A0iNgGyJl/K8Ju7PAfAISpBtGW",
-	"cLCSf0w5MyFKoNzmqoqqMtxCZzUP3w2h6cy+faJ/bt7tE5ethWHv7UKAihPgHORXFrMKTblLqoiDg6zo",
-	"hcuRW7hOpn2YzWHMsMxSto3y0bhr3oqPwM5DWlcLSQvICihpwujys31M7ONtA+COe/LMLoWGbIY1UtKb",
-	"3lCyHDQmhaEFjqdSwiPBJyQ3R9Aozw2BuK93jFwAjp1iTo6O7oWhcK7kFvnxcNl2qwcMWGYMs+OOHhBk",
-	"x9HHADyAhzD09VGBH2eN+aA7xX+CchMEOWL/STaghpbQjL/XArqGv/gCa90UHfbe4cBJtjnIxnbwkaEj",
-	"mzI1fpJugW6U03tMsmubWiMFcHod5fb4ijKdzYW0gnRG5xrkztD5f1LmHec+fVe4qisER3D3phsHmXzc",
-	"T85xEQsCcdeFIRFXScrcYZQ8JivGa22fiFpPbPlrCTRfGqE9tsHakbAjsCvSJGFBZVFit9h5uDeFtEWf",
-	"dOeCR6AT+Yhtjd+s+1shRxXVb5eOpEyTmmtWRo2Fgt7+8Vkv7ywSdxaJO4vEnUXiziJxZ5G4s0jcWSTu",
-	"LBJ3Fok7i8SdReLva5H4UGWSMi9x+IqNXPCsG0x5F0v5b1VVPlxV3kCC1okryrRrk++rFAzbLfYwBGmg",
-	"JeKAlTAc3W2DTs+/OXlJlKhlDiQ3EDJOqpIa1QDWOjRtnlEFXzz1qYb26qQr2/kd71fzwmdPyNn3J77i",
-	"6NJVxmy/e//ExqsRpTclPHBt0YAXVhL1/dGAG6S79mjUXwm+ubNrdc1KjIxX5Bt8+wVcQikqkLaYIdGy",
-	"hr7F5xxo+dzhZofB559mchdq+4cZ7Y9Jy+jl0LailRfz/VqpItRmXJIXUQ7mH3NaKvhjKA3TjreiVaq/",
-	"crj4rCkImcnXoth0TojZtWPcwPbZaOqOMk7lJlElqp8C0SUNLQy7coTVt2W9O3h13D7R9slsF4WlpHVb",
-	"Bj89+hCVJ8vChg3rDWUTdecdOjlK5Zh2a6EeBQBHFQbENAm7J+SN/e7DlgFEiNwRa5j5RxPF2H4zMA18",
-	"1ygRjvV8qrkEHvHJ04tnf2IIu6hzIEwr4gvs7r5eJkfrzIy0AJ45BpTNRLHJWuzrqHULFUxRpWA1230T",
-	"xfwTT1y4fMyT7ffUh7lGXkSL28aTY6JZZ44BD3DnjYbRvDlgC0d07DnC+Ptm0UNsNAaBOP6UMip1eN++",
-	"TK+ZZnPH+O4YX3QaOxIB464geZeJTN8j45MbWfNhnvfNGvLaABef5PtonUeXHKx1y8lawKxeLIy20PfR",
-	"YRsdHI8J/oFYoV3uWC64HwXZwUO3/JsmqXeH63OXKG/8vq/M+AC3g/INOjNWFeUb7/KFTLFVXVoc2qbS",
-	"h2W0tmZ4qsR0Y/sbsmq/9ia/yHbrrtr27xYt5IoqYvcXClLzwmU89Wpbr/n4Oid26PM1b9j01pomdr2J",
-	"1bl5x1wRfpfbqeaKVCAzveb2QLUOk+tgYE/uB62lfXdt3N61YRPVYYDB9qvxNwzhQLeHjPgaXh9Rz6Um",
-	"Ma/ViYm20wlbz9CiMZziEjdnsm8eNLCkN3w7vqQxtzj/KZQVoSQvGXpXBVda1rl+yyn6b6KFTfuxJ95Q",
-	"Pcz7nvtX0i7EhIfPDfWWUwwyCl6dJA+cQ8KF8S2AZ7GqXixAGT4aE9Ac4C13bzFOam60MDEnK5ZLkdnU",
-	"WnO+jOwytW+u6IbMsaKJIH+CFGRmbv1o160tWWlWli7YxUxDxPwtp5qUQJUmPzLDgc1wvpxCCDkDfSXk",
-	"RcBCulfPAjgoprK0YeY7+xTb4bjlewMgGjPt46aNxe32wfGws2IQ8tMXGKOG1ZhLpuL+i13Yb803vmI8",
-	"SxLZ+RKICxfr0ha5jzXgHAE9aDuO9BLecnP7aUGQ41N9PXLoeoB6Z9Gejg7VtDai4yjyax2l/h2Ey5AE",
-	"k7lzu/wbpZBGdOA9m7jxtr5+Z+/3dLG0rlzA1qBDF7J96tonDrzkFIiWkaxT4Ma9cd4Ceav/4tMvK3l4",
-	"XdKj8WDaZH/APrtqN8hDvPkNnxBaCr6wdRWNdilwnxivao0B4O/TgAeXtMzEJUjJClAjV8oE/+aSlj+F",
-	"z95NjmANeaYlzSGzFoWxWDs331g6xUaDnGlGywy16rEAwan96sx+tOM+jrqNrlZQMKqh3JBKQg6FLUTG",
-	"FGn0+akt0EDyJeULvLqlqBdL+5od5wokhMaMRoXuDpEuBLPmmS1K14fxxDVqjuv2As2XicYxeMEZnd0T",
-	"VNHqSTVyD1olR4eU9MnRoKBtkHrZhM5Z5LTZzAgpoiUPRPhpJj5EjdY7or8j+k+d6FMlFRF18461wuIr",
-	"3pb3bNZ63wVEb9FK9kGqC9+V6P93L9HvOZAilEja0kHSveGoIkyTKyyLNANi7q8arfOu4Z7T1zHTLjrq",
-	"rtKmcu358iVl3NXUCXkNCIdRiVcrprVvT/teDJuWmaFF06AD8loyvUGthVbs9wsw///NiP0K5KVXaGpZ",
-	"Hj07WmpdPTs+LkVOy6VQ+vjo3SR+pjoPfwvw/+V1kUqyS6NfvUOwhWQLxs2de0UXC5CNCfHoyfTR0bv/",
-	"LwAA///BlGwvCb8BAA==",
+	"H4sIAAAAAAAC/+z9e3Mbt7Ioin8VlPap8uOQku042Sv+1arzU+w8fOIkLkvJPvvEucvgTJPE9hCYDWAk",
+	"ceX6u9/qBjCDmcGQQ4mS7Sz+ZYuDR6PRaDT6+edRplalkiCtOXr251HJNV+BBU1/8SxTlbRTkeNfOZhM",
+	"i9IKJY+ehW/MWC3k4mhyJPDXktvl0eRI8hUcPYv7T440/HclNORHz6yuYHJksiWsOA5s1yW2rke6mi7U",
+	"1A9x6oZ4+eLow4YPPM81GNOH8hdZrJmQWVHlwKzm0vAMPxl2KeyS2aUwzHdmQjIlgak5s8tWYzYXUOTm",
+	"OCzyvyvQ62iVfvLhJX1oQJxqVUAfzudqNRMSAlRQA1VvCLOK5TCnRktuGc6AsIaGVjEDXGdLNld6C6gO",
+	"iBhekNXq6NnvRwZkDpp2KwNxQf+da4B/wtRyvQB79Mcktbi5BT21YpVY2kuPfQ2mKqxh1JbWuBAXIBn2",
+	"OmY/VcayGTAu2ZvvnrMvvvjia1zIilsLuSeywVU1s8drct2Pnh3l3EL43Kc1XiyU5jKf1u3ffPec5j/z",
+	"CxzbihsD6cNyil/YyxdDCwgdEyQkpIUF7UOL+rFH4lA0P89grjSM3BPXeK+bEs//UXcl4zZblkpIm9gX",
+	"Rl+Z+5zkYVH3TTysBqDVvkRMaRz090fTr//48/Hk8aMP//b76fT/+j+//OLDyOU/r8fdgoFkw6zSGmS2",
+	"ni40cDotSy77+Hjj6cEsVVXkbMkvaPP5ili978uwr2OdF7yokE5EptVpsVCGcU9GOcx5VVgWJmaVLMAY",
+	"Gs1TOxOGlVpdiBzyCROSXS5FtmQZN24IascuRVEgDVYG8iFaS69uw2H6EKME4boWPmhBny4ymnVtwQRc",
+	"ETeYZoUyMLVqy/UUbhwucxZfKM1dZXa7rNj5EhhNjh/cZUu4k0jTRbFmlvY1Z9wwzsLVNGFiztaqYpe0",
+	"OYV4T/39ahBrK4ZIo81p3aN4eIfQ10NGAnkzpQrgkpAXzl0fZXIuFpUGwy6XYJf+ztNgSiUNMDX7L8gs",
+	"bvv/PvvlZ6Y0+wmM4Qt4zbP3DGSmcsiP2cs5k8pGpOFpiXCIPYfW4eFKXfL/ZRTSxMosSp69T9/ohViJ",
+	"xKp+4ldiVa2YrFYz0Lil4QqximmwlZZDALkRt5Diil/1Jz3Xlcxo/5tpW7IcUpswZcHXhLAVv/r7o4kH",
+	"xzBeFKwEmQu5YPZKDspxOPd28KZaVTIfIeZY3NPoYjUlZGIuIGf1KBsg8dNsg0fI3eBphK8IHCG3gCPk",
+	"OHAkXCVoBk83fmElX0BEMsfsV8/c6KtV70HWhM5ma/pUargQqjJ1pwEYaerNErhUFqalhrlI0NiZR4dh",
+	"nLk2ngOvvAyUKWm5kJAzIR3QyoJjVoMwRRNufu/0b/EZN/DV06MP276O3P256u76xh0ftdvUaOqOZOLq",
+	"xK/+wKYlq1b/Ee/DeG4jFlP3c28jxeIcb5u5KOgm+i/cv4CGyhATaCEi3E1GLCS3lYZnb+VD/ItN2Znl",
+	"Muc6x19W7qefqsKKM7HAnwr30yu1ENmZWAwgs4Y1+eCibiv3D46XZsf2KvmueKXU+6qMF5S1Hq6zNXv5",
+	"YmiT3Zi7EuZp/dqNHx7nV+ExsmsPe1Vv5ACQg7grOTZ8D2sNCC3P5vTP1Zzoic/1P/Gfsiywty3nKdQi",
+	"HfsrmdQHXq1wWpaFyDgi8Y3/jF+RCYB7SPCmxQldqM/+jEAstSpBW+EG5WU5LVTGi6mx3NJI/0PD/OjZ",
+	"0b+dNPqXE9fdnESTv8JeZ9QJRVYnBk15We4wxmsUfcwGZoEMmj4Rm3Bsj4QmId0mIikJZMEFXHBpj48m",
+	"qTPZHODf/UwNvp204/DdeYINIpy5hjMwTgJ2De8ZFqGeEVoZoZUE0kWhZvUP90/LssEgfT8tS4cPkh5B",
+	"kGAGV8JY84CWz5uTFM/z8sUx+z4em0RxheqlGXhRA++Gub+1/C1W65b8GpoR7xlG24nKmg+TGg3GgN0H",
+	"xdGzYqkKlHq20go2/sG3jckMfx/V+fMgsRi3w8SFrZjHnHvj0C/R4+Z+h3L6hOPVPcfstNv3emSDo2wg",
+	"GPOyweK+iYd+ERZWZislRBBF1OS3h2vN10deSJySsNcnk18NOAop+UJIgnaCzyfJVvy92w9FeEdCAFO/",
+	"ixwt0aCNCtXLnB71xz09y2dAramNDZKoYZwVwlh6V1NjtoSCBGcuA0HHpHItyhix4RsWUcN8qXnpaNl/",
+	"cWKXkPSed40crDe8eEfeiUmYm8/xRhNU12bLW1lnEhL80IXhG15wmYE51wJea6Xmezjps0Jl76dLbpbp",
+	"Q4BfgkGE2rIl8Ny/wB3DwvdceEo075O1hZb68/+5/7+eodqTT//5aPr1/zz548+nHx487P345MPf//7/",
+	"tn/64sPfH/yv/5E6vJlarYRdgUxqX8I30vfQeVZ2EmmvSV3EWaOuZQWfQfGsu64Ju/dv9yZ0EeCXSpY8",
+	"p/cpN/DFE6eoQc7kMXL2w+n0y8dPTp58+VULe5kiFYbjafhjg/qJh80qywtSq5FsUmowIO3EyRtTAml6",
+	"ARpvGBqFOh+z54VAqmIZl0wDklllgQnL5lqtcG7jn6nGrSJbQvY+mp7xBRfS2GiT07vb24AC+DxNN8iW",
+	"QbKZJ1lmtQAGBeCG3D2h0LMzCadUcoqrYFLlYGqM0YY0hkABLFeXMlBSyTXI+jN2xyWNuiJ7Z/hnlUPq",
+	"kkQAhu4lZdvnMkby3SN36xXqwWzsxdxCH3AmDJtVorBMXYBOXaiTo40HYTsDczNTdxZ3Zzm3nHHbP/nY",
+	"u1H4NPwmyGuZkgakqUh1b1WmisTRCeZng2dSyB7HMXe/Z47TpDHmtcI9xhb2L3CpBLq4GcTYXa+xI31F",
+	"91zr3vA8bBI0U3TsJrX6y+NpjNRGpxlxRbttPJNvBDFURxtV6QxILayuahw15NA5yXjnI9w/cLPc113/",
+	"Q/KkfBNf7XSrjMMojTYGOz/4Y8hbUkSzxFdqYfawxELt8lwpy+e8KHDqPgfurJYGHiW8FwXDxgxWgsQM",
+	"ISOrurtK2bc8WzJelizjRTFpzEOqnBZwAQVSiJASLVx2yW0j8NPIQZdJsrMBfOBYYNFqvGmJzGq6tj9o",
+	"YCtOr84VajDLot2nfjUZvoKO5oPEBlWR5SBSLr58EVYHF/5CrIcm8Os1mnB1hsGP2Wn9iWaWyi3OWf1s",
+	"4Jk1/uo3QgtobN28oWUzhdK5s1MTlxKaZUq7Idyr3k+O/wGum86OOu+XGqZ+CM0vQBteuKPdWtSDmnz3",
+	"dTq3nEy8p6KT6akwrXR1nIP6kawMOiFP/EL/4QXDz+4+hIh6BCkgVORClbvHOKLKzYQNyMaq2MqZLxne",
+	"HjtB+byZPM1mRp28b53FNEiwbhH1Dp1fidzsa5tosKG9ap8Q0xIN+xfxJqYTzTUGAeeqZI59dEBwnIJG",
+	"cwhRV3t/yn6jrlIwfUP3XPsZq65gLzuhrtx/xgne6uqFh0zp7ZinsccgHRco+QpMuO1jFcMk8sU5nSl9",
+	"PQ1C54JpCZAcR22Y49GkgyRqWpVTfzYT72TXoDNQ49S5WQjoDp/CWAsLZ5bfAhaM5RHwN8BCe6B9Y0Gt",
+	"SlHAHkg//dzxComzH06/fPzkH6h/UKhEUAvNVwxFbsPue1McM3ZdwIPkc46ki/ToXz0NfintcVPjOFl3",
+	"xcv+UM7fxb/JqBnDdn2stdHspXcP4CiOCHi1ObQz58qFoL2AWbU4A2tRu/1aX1ORtonb9GZIQUeNXpca",
+	"BQvT9g3y0tJJjk1O4MpqflJSS5A50TytQxhuDKxmeyGqoY3Pm1ly5jGaw9ZDses2NdOs463Sa13tw6QB",
+	"WiudvILDyx01CkaohFHitW/BfIuwXWX3dwctu+SG4dzksVTJfMD2gK5Io+8vN/T5lWxws/EGc+tNrM7P",
+	"O2Zf2shvXiEl6Km9koyos2USIf0ZZzl1JFnje7BO/hIrOLN8Vf4yn+/HwqlooIQOQ6zA4EzMtWBCMgOZ",
+	"ks6Bf4uZxo86Bj1dxATPEjsMgMfI2Vpm5B6zj2M7rH5bCUm+emYts8ic5ZSW+QL0CHyMN1sNocNNdc8k",
+	"wEF0vKLPZJ9/AYXl3yl93oiv32tVlXtnz905xy6H+8V4TWKOfYPpV8hF0Q4aWSDsx6k1fpQFPa+VCG4N",
+	"BD1R5CuxWNrovXh949JGGFOzbNSkcVZgn77KCJXmuNjK7EGUbAZrOBzSbczX+ExVlnGyEtDmVyYtZA6E",
+	"GZB/M7ll21huJf0E6r0BqSvjFa62Khk5HffNXXXHKc/cCZ0SagYUuY2vrGvlpnMu7IUGnqMyCCRTM+/X",
+	"6D0uaZGcPKZrI4cXcZNa+QiuUqsMjEHXEaf13ApaaNeYXobwRIATwPUszCg25/rGwL6/2Arne1hPyb/f",
+	"sPs//mYefAR4SRm9BbHUJoXerj6tD/W46TcRXHfymOycps5RLbOKpPICLAwAsxtOBvevC1FvF2+OFmdI",
+	"umWKD5PcjIBqUG+Z3m8KbVUORK35ZzpKeLhhkksVBKvUYAU3drqNLWOjeC0GVxBxwqTdGwceELxecWPf",
+	"eLNfTjpNd53QPNSHphgGePAZgiP/Fl4g/bEbW6QfgZmqLJW2kKfWQF5Yg3P9DFf1XGqesnNaxSoD20Ye",
+	"wlI0vkeWfwHTH9zWPlfei6u/OPKjw3t+nURlC4gGEZsAOQutIuzGkTsDgAjTINoRjjAdyqnDhSZHxqqy",
+	"RG5hp5Ws+w2h6cy1PrW/Nm37xOVNsTgnyxUYMqD49h7yS4dZF7O15IZ5OIJbHalznI92H2Y8jFMjZAbT",
+	"TZRPTzxsFR+BrYe0Khea5zDNoeDrhEOg+8zc500D0I43z11lYeqCb9Kb3lBybewdHlrReAmm+bNi9IVl",
+	"eATxKdAQiO+9ZeQcaOwUc/J0dK8eiuZKblEYj5bttjoxIt2GFwq1UoEeCGTP0ccAPICHeujro4I6T5u3",
+	"Z3eK/wTjJwhtrjHJGszQEprxd1rAgC7YxzVH56XD3jscOMk2B9nYFj4ydGQHFNOvubYiEyW9dX6E9d6f",
+	"ft0JkoZzloPlApWM0Qf3DCzj/syFjXTHvN5TcJTurQ9+T/mWWE5wzW0D/x7W9OZ+DaDfQFnZ63u6joO9",
+	"Nc8YyMlxq+5R+zMBaH/XrISZAUp7OUXiSlus3YrIth4pb/bxOk+MyoQLnMYFhLgtfFTETeCKZ7ZYM44w",
+	"w5pdggZmqplzyuhbiKwqp/EASYvThhm9vTlp7d1oAD+joaLlpZwB3StnM3znnadOCx3+dVMqVYzQ+fWQ",
+	"kYRglDcMKxXuuvBB3CGMN5yNFpD+GirWAVx/+cVophWw/1QVebsGX9cgpSlNog/2pRmEieb0LnsNhrw7",
+	"ao2dhw+7C3/40O+5MGwOlyHzwcOHfXQ8fEiaqdfK2Ba72IOGFxnIy8SF2HXQ63LJ7T5cfuRR7m2dwcOk",
+	"dKaM8YSLy78xA+iczKsxa49pZJz/mr0aufLztsdTb92072diVRXc7sMOBxe8mKIPrBY5bOXvfmKh5LcX",
+	"vPil7kZZHSBDgXguCki/fbFF5c6Va1avrh51Ujuw/VPQg8GZIUl4nlVz7wXkY3R8UI57B9H0VvMMphml",
+	"Qrh7N9ceCCOxCefYxyVwwHGEFFaE4M2xWwIvXa8z12mL2qBxlxarFeSCWyjWrMQLNneWFGGibTlmNCzL",
+	"llwu6BGoVbXwQUpuHLryKuPUbWiW7A6RFJTtlZyS4SJ1BXrXQ3/ZkYgMHJ/pXauHExQueT0f5K2bceQe",
+	"dK1AScPn5GhQi4FIvWi0GA457fwbI67Dlgwf4aeZeKR5jFCH8mwfX/G2NOwElRJATGYffOWqFBqGFKVi",
+	"BZPIUMno7UAHH0qVLSf0X+OAYcKwXJiMawpVtCGbDRGbe3OniWsD7eOO1woyNY+nm3RYkul8J90ycs2K",
+	"Rw63pK1RcgAS33UqBsCJGH09b5gvacwnz6Hp2FgIb4KLF4GxD7lhg+cyGJWH9y/YnJndjM8RJB9opfGE",
+	"jxDWXmwM2phz4PgWHYMUaj9MXIvbsUE2Qw+C1po4CltsPg5FLqL+sFjv4c3jBsJHmIsAMy29u3Ff1TxO",
+	"NOWJ3qyNhVXfNOm6/mOAPN8MKsCULISE6UpJWCdzKwoJP9HHVG8nJQ90pvfKUN+uUqUFfwes9jyjSPCG",
+	"+KXd7l5PXRO8+U7pffl4uAFHv/lHuFRs9R/yU17X8QN96/u+Ej4NTff2M5M6+kBoxo1RmSBW/hJDFoVs",
+	"3Ct8KGIb/a/r4Po9nL3uuB2ngDjDGRm9oCgZZxmFYGJTY3WV2beSk9I9WmrCKzVoF4fNMM9Dk7TdJ2GW",
+	"8UO9lZyuyVoVn7y05pB4GHwHEKwxplosnDzfSoYK8Fb6VkKySgpLc63wuEzdeSlBk2vosWuJgSdzpAmr",
+	"2D9BKzarbPvxT1mWjEWjjvNQwGmYmr+V3LICuLHsJ4H+bzhc8GIKR1aCvVT6fY2F9BW6AAlGmIFgwe/d",
+	"VwpU8suPYwd95+BFf9cvmQC7yAchf/nCK8ZeviDtRxR71IX9zgyaKyGnSSKL3dM6tMXuU747T0AP2tp+",
+	"u4S3En0PrcJ0iyLn9nrk0L1hemfRnY4O1bQ2oqPdD2vdUadwAy7DEkymwxr3lCYAF5/OtkXCp0+gha3Y",
+	"vJJuK8PT0yWTaYJFJ3VGNZds+RmjdFtLHrzW/Z9PvvzqaNKkyaq/H02O/Nc/EpQs8qtUMrQcrlKqojjq",
+	"655hJV8bsGnuQbAnfYOds1o87ApQx2iWorx7TmGsmKU5XIjB9CrnK/lSuoglPD/ks7H2pmA1v3u4rQbI",
+	"oUwF6r9pC2rUqtlNgI4fncs9MGHiGI67Kt98EaL8OQXux8khRrCJ+hw4QgtUEWE9XshOYcMdsozjtfzl",
+	"b/b+HPIDp+DqzpkKUbj3/bfn7MQzTHOPsOWHjjKpJfRI7kPbw9Iy3gqSfSvfyhcwJ9Wbks/eypxbfjLj",
+	"RmTmpDKgfSqF44Viz0JSmRfc8reyJ2kNZoeP49rLalaIDA10KfJ0GX/7I7x9+zsadd6+/aPnbNZ/Pvip",
+	"kvzFTTBFQVhVdurzlU41XHKdMuabOl8ljUy9N87qhGxV+bh9Nz7z46d5Hi9L081b119+WRa4/IgMjc/K",
+	"hlvGjFV1gK0wdV4i3N+flb8YNL8MSsXKgGHvVrz8XUj7B5u+rR49+gJYK5HbO3/lI02uSxitWhzMq9fV",
+	"KNLC3bOSgm+mJV+kVGdv3/5ugZe0+yQvr3ALUNClbjFO6ogpGqpZQMDH8AY4OHbOcESLO3O9Qm769BLo",
+	"E21hO4vUjfYrSgJ27e3akkiMV3Y5xbOdXJVBEg87U6es9qlunHsZ2nHxEPjs3jNwuXF82mVYlXY9aXVX",
+	"85agGViHcLpPHzJNuXbIPomJuss8aCW5XHdzcxoXIkaDvoH3sD5XTUbZXZJxtnNDmqGDSpQaSZdIrPGx",
+	"9WN0N9+7yYbIeZ9ikaLRA1k8q+ki9Bk+yE7k3cMhThFFK3fhECK4TiCCOgyh4BoLxfFuRPqp5QmZgbTi",
+	"AqZQiIWYpUx7/9E3hwdYfY4oEBch10E9oEELubAmZCHxz3uNBibGyV+uVIYXrjRE0guN3kNL4NrOgNuN",
+	"Ri4ZZ9UL0GF/dokny2n4JrgEuML9FpY0dhIuIfeKItfGh2McDzvUOsAhvyY8oXvzUjgefOt61CXSpodb",
+	"ucZu/az1vsYxnZ0v6++UbWih1aWhhGM5U75kgMtMGd0vleGLAWtHyzNgZFK/lsGfBtkmkSRlELQVt0WN",
+	"niSQBNk1nuKak2cY8AseYnpmdjzMw0zOP8QbTKkSkEfYrCABtnbFd3vPdcuJQi42gZZmLaBlIwoGMNoY",
+	"iY/jkptwHPNJxGVHSWe3mLtyU37tl5FzdFTZoc6eHW7DLgftvft9lu2QWjvk044f/SNyY7usYFV6O5Qk",
+	"0TSHAhZu4a5xJ6fVPRNtEMLxy3xOvGWa8rOOFNSRAODnAHy5PGTM2UbY6BFSZByBTX5PNDD7WcVnUy52",
+	"AVL6rLU8jE1XRPQ3HA+mCyPBYqpKvFzFgLE9CxzA59ZpJItOiAgNw4ScMGRzF7yIEjU2g/TSPNODopPU",
+	"2XvePRh6aGwwTbkrf6c1UY9rrSaWZgPQaVF7A8QzdTV1KReSb5HZ1QzpPRmMhb2SB9Ml1L5nKCkZ+qfS",
+	"1eKCf7bAMgxHAKMBgDIl49qp35Cc5YDZNO1mOTdFhYbdr6XOhlyGBL0xUw/IlkPkcj/KkX0tADpqqKbg",
+	"nFdLbFUftMWT/mXe3GqRyT/EuaaO/9ARSu7SAP76+rF2VusfmuzlwxmSfaO7Sefd1yzdJM2660yAmJ2y",
+	"rHfJoQXEBqy+7sqBSbS2WnXwGmEtxUoovWXPKNlHm4EC6BE8bYmm0/ewTr/lge7xs9AtUtbR7nG5fhD5",
+	"D2tYCGOhMRoFp7iPoY5vUpYOrs6Weo7rexNlnY2TlcbLvPMVUEjRXGiMXUGLW3IJ2Og7Q0qk77BpWgJt",
+	"bTZzFdNEnua4NC1GoeaiqNL06uf98QVO+3N90ZhqRreYkM47cUYV/pKRGBumdsE6Gxf8yi34Fd/besed",
+	"BmyKE1Mm4PYcn8m56DCwTewgQYAp4ujv2iBKNzDIKINGnztG0mjk03K8ydrQO0x5GHurl1rI4zF087uR",
+	"kmuJ8pqmfS3VYoGhny5dWbCHySgrZqHkIipFW5abkoAeY/0j41NpbsjC6aNwYCgGJxL3pwIttmnoo2YO",
+	"8iZUmDKI0iRopqf8S2m1kFpsifChFpGu7o5tod34n2QMxHnHmN34rLpdqreTNqAAnvs3iYGwvs3Hsr8h",
+	"HnWToeiJVvmGzUeIBiSaEjaqztjPqzLAgHlZivyqY3hyow4qwfhO2uUBaYtYix9sCwbaEQBJgmvVA/Jx",
+	"Bl7BfkJv3hN8lbnAA+9Vj/TNM59RJK80WTBabv394lP1W23k2n/87cwqzRfgrVBTB9KNhqDl7IKGqLST",
+	"YVY4d5JczOcQW1/MdSwHLeB6OvZ8BOkmiCxtoqmEtF89TZHRFuppYNyOsjTFJGhhyCZ/3rdy+baxKqm+",
+	"EqKtuYapKpl/5EdYT39DpQMrudCmcc/1Zqf25bvDrl+sfoQ1jbzV6xUB27IrpHl6A0SDKU1//clEyd/v",
+	"mRhj7nnZ2sIdduo0vUt72hpfWW6Y+JtbJl5RZyk3ORiNkwTCMmY3ztK+CXh6oI34Lilv24Sh8JCoUyzv",
+	"x1MJE+rw96+iOrnONtrFzJiBeGk5Rx8mRzfzBEjdZn7ELbh+XV+gSTyTp6mzDLcce3ZEOS/Rf4sXU+8v",
+	"MXT5a3XhL39qHtwr7vglk6bs829PX7324KNJugCup7UmYHBV1K78bFblatFtvkpc+QKv6HSaomjz6xTz",
+	"sY/FJZUq6CibepUdG/+ZZrzgczFPO7xv5X3e1cctcYPLD5S1x09j86TOHScffsFFEYyNAdoB53Ra3Ljy",
+	"oEmuEA9wY2ehyOdruld20zvd6dPRUNcWnkRz/UK5dtMvDukz8RIr8s4/fO/S03dKt5i/D8tNOg/dnliF",
+	"QrbD44CvdijC3xWmjpkTvN4t3uFpfPgwPmoPH07Yu8J/iACk32f+d3pfPHzYB9rddmkmQVoqyVfwoI6y",
+	"GNyIu32AS7gcd0GfXqxqyVINk2FNoc4LKKD70mPvUguPz9z/guZY/Ol4zCM93nSH7hiYMSfobCgSsXYy",
+	"Xbm6/4Yp2fWppghwJC2XHcbVmHHG2P4RktWKDJhTU4gs7dohZwbZq3TOlNiYUeMBbS2OWIkB31xZiWgs",
+	"bDYmCXQHyGiOJDJNMg91g7uZ8se7kuK/K2AiB2nxk66rCkZXXXgc0Kg9gTStF/MDU59o+JvoQTbYm4Iu",
+	"aJMSZKP97kVtUwoLTVUu3dEDPJ6xx7g3eG97+vDU7KLZlm0XzHHvmGDQS6oPvAUxMDpvrBuYoymSTv1c",
+	"withpnOt/glpQwjZjxJ5cPxE9Byh3inPvS5LqY3KYT3x7Nu2e/zbeGjjb/wWDouuSydf5zJNn+rdNvI6",
+	"j16Tzj8/OYqPZBou95G1QwMGWAsdr8gZlhK4BO8jLt15cilQWhFm6VMZtTAnbvzmVHqYu7uaFfxyxrP3",
+	"6bcQwhRtb8tPyioWOocNMHWCDzc7izy467bCpcYsQTc2iH6a7Wu+a9y0o180zQMGO7aeLq5MKy+MSgxT",
+	"yUsuLQQ3BsevfG8DzgSPvS6VpsS2Ju3SlUMmVkl17Nu3v+dZ330nFwucyaV9ZXxuff4KPxBz2XOJinJh",
+	"yoKv67Q1HjUv5+zRpDmTYTdycSEMOjJTi8cTXxrU0HVZm8PrLrg8kHZpqPmTEc2Xlcw15Hbp698axeq3",
+	"Jwl5tWPiDOwlgGSPqN3jr9l9X8zxAh4gFr0QdPTs8dfkUOP+eJS6ZXOY86qwm1h2Tjw7OGun6Zh8Ut0Y",
+	"yCT9qGnv67kG+CcM3w4bTpPrOuYsUUt/oWw/SysuOSIkBdNqC0yuL+0mmfM7eJHUKAdjtVozYdPzg+XI",
+	"nwZivpH9OTDi2q5WMaNWSE+BkYbDFoY7prPheHoNV/hI/q9lcP/r6Lru+BnDV2l64OSl/DPZaGO0UglX",
+	"SoAhGs90zxCP2cuQLJ0qAtaFAB1ucC5cOsmSuIWUJUxIS/qPys6nf8NnseYZsr/jIXCns6+eJirrtYtP",
+	"yd0Av3O8azCgL9Ko1wNkH2QW3xej4OV0JZDVP2hyLESnctBRNzmtHfIL3Tz0WMkXR5kOklvVIjceceob",
+	"EZ7cMOANSbFez070uPPK7pwyK50mD17hDv365pWXMlZKpyqgNMfdSxwarBZwAfngJuGYN9wLXYzahZtA",
+	"/3H9n4LIGYll4SwnHwKRRXNTsDxK8b/91JRyIMOqi0Ts6AB9xra2fO71dnfsbbib1q1rv3UOY/RtAHOj",
+	"0Uaj9LEy4H1PPzd9Poa/UBckt+cthePjd0zjG5zk+IcPCWjUO7qm7560Pzv2/vBhOqN6UuWGvzZYuMmL",
+	"mPqm9tBrfcy5Fi4f2/OlKFIqF5bhB8eXqTaCV12W3IZa5HG99SbxxZhim+dRfiAcXk4pnQRNSWGLLg5t",
+	"xYXM3UWLP/iUw96/vOlxzH7x5cLrXDYO9ghiL1C67BZhpIk3P1M8l0+HXN8yvg7LR7hmNrjvkSOn0+qq",
+	"ebRUXOOEaSg4BqPiar1fGAzFZCD6hoNfm5GFCbhGKhih/6p93XCCUSSINcRSFFjTxQ3ojxahYSg6yX8N",
+	"yMSJJs7x0hFC7aw0rkxy+nBt85upYUxiSyVIIdRNrj0AfUKT/voHpUr8gFLLzA81Ye0atXcv9u8nIDPt",
+	"Hp6+ttAbHL8EPNAfXUR8ZOmGNrAJKxq+nds1upMkk9ffo8AUzr5RV2MJpyM0BuL5BFA0gJKR+nRaSa8G",
+	"edK/ZquDV0SjOOoM0B/ctMoSxga4zwfPuPjJBmxjDt7fmmSMHclPc5ktk279lLz3H+5R3ZKZnWyTwlq2",
+	"5FJCkRzOKaP+ESSPhFrtv9TYeVZCjmzbrYHvlttZXAN4G8wAVJgQ0StsgRPEWG3nuavzqBQLlbsMyE1Z",
+	"rYY5Hh8l9qpfYrtHgm7YVWW9ozklb/AZwuaiwP8NOHpQy6nmdiDjnfY5jOsR4QLQtEwaFjc6aMbFiiRp",
+	"w7HWIZ3MC0CHXuyqJHS6U85DGjmqmcVMiZ+oJWWYUcxWGq/7ebQMkFZoKNYTVnJj3CCPcFlwRXMfPXv8",
+	"6FFST03YGbFSh8WwzF+apTw+oSbuiy/z6IoR7QTsdlg/NBS1y8b2CcdXtaZSBSmeSh9cqDl2plvbVbSu",
+	"q68fs+8pVRkScas0DUJTp7xvZ8CtykLxfEKp+NGVjrlZXR8NhCiqqL1A+Dvkn7SHjs8IHFKxDaS6Gj/O",
+	"5tw7Lun4dEOu8lfUoinRLTpOcqR4j7FzzF44m4cJDyA3CaOCDnoFeZT73GndiDjwP9bybIkNVEsCGuaV",
+	"40vBB3bWmFqjcOGL8JEYNsLtq8G7YvATpvCFcikwv/iSW7iAdv7SAEYt0/t8pu3l6UpKRynHOwijdbXF",
+	"XdEegKNxay+gJGQdxO+oSjaq0hnsWhn/jHqlg6c6ZfY7bjohG2YoCMF+8tbAjEslRUali1KSNOVaHOdX",
+	"MKLKU9ohwBz5E5o4XMni/nXwvsfiYLn/yVELcX0fnegrbqqjDvenhStf9HUB1njOBvmEtLyiAG/BFtKA",
+	"r6eJRBTzSaUTXojJyKValbAjGVEatQGTxHf47WdvsMIjyN4LVybBo82/z5yNGRPPILVLJixbKDB+Pe3w",
+	"O/M79jmmtKo5XP1x/EotRHYmFjSG83vFZTsn7/5Qp8Hl27tYY9vn2NZXeql/bvlvuklPy9JPmgxBr3e4",
+	"9wmrmQwhOOVoGDQjEXLr8ePRNpDbxlgNuk+R0LCcBzMWSrqHe4QBWqdeiN+6IiBIUdSCuRDoFFIKIRNg",
+	"vBIy+DykL4gseSXQxtB5HehnMs1ttmyxoW0e3gMRS5RSIHu/j6E6G0wooTWGOYa38fxK+no8A4yjbtBI",
+	"/FyuWTgUSN2RMIHxyrXvPAlBbfMNSlVeiMopGtCn8HViWZpxIOOehhjnFrq2xtvW3al21K430VBS0VmV",
+	"L8BiwspULrpv6CujryGqs6nPpeZROG+7qECf2vxEmZKmWm2YKzS44XS5MNwYWM2KhJ/3i/oj5PUOI6Wh",
+	"bhL/TVVMHN4ZH+Wwcxh9CGnId6uk0U8LkJJ6kaanmDBtPCboTrk5Opqpr0foTf+9UnqIr/8kwuc7XC7e",
+	"oxR/+xYvjjjTdi+gxF0tdSJsCt5Q9D1kKKtTuLa5En7r1wUlNyXavMSWdYAPDZOAX/BiIHVFbNx096sz",
+	"+A0lsMgG861w6/PpWc42sqDBHGXOub9jLu3b/Icc+p0///7MjH6tGxE6bGz/sWVad06dDbMYNKlfz+rd",
+	"bPCuZu8fL4ZymoTCOvQ9LuDj3e4m3uYIF0JVfsPqoIXwJHS/+pxZrUI9A+tPhgJ9bKvFoI3l3FfQd8v0",
+	"b/Iff3NuEwyk1etPwOLS2/RuFaiEtEstIoL1T+Ce1mzgUdu6FccUnUrVN/KyYdCVOdbSoqVevageWb0Y",
+	"Iw708PFhcvQy3+nCTNXIOnKjpI7dK7FYWiqx8QPwHPTrLSVEmrIhdMRKZURTA73AwXzO5iUNdzw2OggJ",
+	"WMQlUPpjBa/xC8gsFb5vvGE1wC4FUXCyYPQ5lBIZfk7XQVS+gsimsiH9avdb7vheprMoWx/UbhAji2Sc",
+	"1jEPLmQTy7rW+ZU6SQ5Gh1rP55BRGvONmeX+w1UVDlnLJkEvQ7DMo0Rzog48pET8u2sdG4AKfk14Cr4/",
+	"cIYST7yH9T3DWtSQLPRdR91eJ9M3YcCZwELS9yFFsnfzFKamDMJC8OF33aGpZjOYpD3Kk3jNuQJJMh7n",
+	"Ttww5YWycM25sOtOeVophm4o+dxrAP0GQqbyrUxL103dzVBCSLK2EmYGmJY4p6zimPMvYdvkUkI+raQV",
+	"iW39VYqryKISVQSmDlGyNZoWOSWNN2HKe7CJeeuzVNY3GX0M6lMw4zLBj5pMj5RqFFUWmIiZSzNxwci5",
+	"wiyIXojNK13jaktd4G2HkqhGzeeQfBmeBxYQdkEo3WACSchkSg9xTLxQAQaizmiEl6+bTAKalU9K/3P6",
+	"+NNcqToEDWzUZMJyyFysliI7FBZ3YOe9/XUnQlimEcVN/cm5WFS4KKThb7g8X2owGMoQAeX1qd3DQcsN",
+	"gPq9Tp8OSlUcCZPDr/MXYLkojPf35nUe/ViHher4bh24S27qE9NYFkNGfjDht5AS181SiPe+HA7xDGfH",
+	"xSzKocVechxSMybSQM/rmUUTj9h3AepzQBfamxUKhezpUHx0OwSw9p+/Z1ygQ5OPjuCag9aQ1wbDQhmY",
+	"WhWodhMcm1BhKJrjWkgwg9X8HHCDlRzeNKUqqKopp8oN3AdxxAuMfHybghLDc25C9nP3PeSUCZ7AW/Wv",
+	"Nb1uLz8eIlGF6SExpvo58xfO9lw111HFCilBT4NdtltdQrYTjFIa6bzKnPgaH4xaXT3au3UDK0lqMbP+",
+	"Kjsv6Cjny3tYnzgVgc/+Uu9gDLR7VzjQo/zZnU3eq3LapOBe7AW8j5sWtVSqmA6YAl/2S2J0Kf69QJcq",
+	"hjdFiNjCl9G99tnASdh9skDVvh6Xy3UoAVGWICF/cMzYqXQxssHto10ttzO5vGc3zX9Fs+aVq1LjVc7H",
+	"b2U62JDqx+gbcrMwzGYeZkDmN57KDbJ5InslhxzSLqnWTLso9fFYnVXfEaMrljRE5aBIySRnzp77nA56",
+	"Sq1KGX2i1FNk5ufM24GZKVTK0/06WYdwqDSm4skIIAtyTPKbGgo/eBIB3sctqruZfLcUPPMHgAwj3j/Z",
+	"b7yLokZbiXsmhHh3cpGgkEJrQgmNTrUr52n4Hko7caml8U8UYLXIc5A+1WCra1zIMiWZbRCBfJzGpE3W",
+	"AwLNcFHCdgIJiAvzaCz+Rydk4p0WrqFU2aU2XGf+0IEuhXoc46R+V2gtnXRgL6XFhio4nS+ho8pKlm+a",
+	"1PWaxDwQ0rO9lHH6Re7Uf2wRpzsv4ZRO8DPiYP/ijlSCkMIXE1WraB/v8RrG82Xr8PYvhl0y4MXHfIPF",
+	"zjD3rujm3esTV6juRNQF4M07o0S0GptxxaoapynPk4HKQqdxFZvbATFK4bQJwmDEHjVmdDsM6cY2EmES",
+	"bX3nMbCmiXI2iU0dGRgzHZHvd/8ZfVN+dvlRPOYWHO2MmVYm1dtGzQ0zmw5hpzXsRgSlCDuNqThhmkdL",
+	"UCGMFxbct6EUchuzxJ0n8pVFHTdkgIuv9WulfAswbcLkN+pqBAJ9IKePcFVXE6eSIYhsm0Fdi8qYuqw9",
+	"FGZqoNhD2u/3PEoaEnX/ZLwMItR9JPCGTpt39d3OjraUvPCfo6tWQ+Nzfd3qFr5gxOCl7wzg3ZnrWdoK",
+	"kLnSEM9IrxRXyaZO7IKXIaP/zITVXK+vU4OijaqUs8Egll+rkv7N33jsnWKfgZXrJkrUaWPDOxxXKha+",
+	"6o1DSfymnjCjvD3cGud5QYlTHN58QlMkjDzaRGEbvArZwuNp9/lmFVpIncerxzvlEQ5JDdCESsB7gFeb",
+	"I6H6Kw9fO+uuf6aFb/C43CpvDgVTbWRgg9tQlhtAGmukGitR1nfNIDju494BGigber70u98BBwOHGwom",
+	"KmKPgskdHZTbB9hFnriBQr0Bp5ZzjB9zEwmJN5KBevyXvzDnMz0+T0Icl73T0d0aeFjHHDZnp4k77B+A",
+	"olCXU1rhtC7BnHpAYDvTVqz7YqFN6Wbjj2QTwciNN7qs2ZLnLFNaQxb3SGsFHFQrpWGKxcaSSYBfibk1",
+	"rBArYQ2jCr8LpspM5eBKmaeZ/9BcnhNNa040iILAvlSCe42cEvXjzmN66kygYx9E59jHJVVtCg64RU8d",
+	"BQ7E5oPxBQY8hlzjPrxEOC4jd9drLi0ozcUV0Q3o1G09Z1ZXMGG+BY3eIiG6HpCXr4QxDpSali5FUVBO",
+	"U3HVXOVQh+gM6JP8zVZv5JQPXG2vefZ+6BYaFiCagKv+7Rdq3SFXad5KhDv2xklFhg2QW3o1Awa5lxQO",
+	"fSEoZq6drZd6sFJDBnUK4/gSPYvrCzC71KpaLKNKjjXWg6uKrrwjSzzKr6aisEZihzjFU7ZSxnobuBup",
+	"2cAmVPR+pqTVqiiCbcg5k3lHAO8h+xO/Os0y+0qp95h19wFZ3Ekt51eaT0Ii025QbzOT7tTwiG0QZJFT",
+	"Qf809uy1lAgmRL/R9prtxfVcOwQ3MMedNSyewfe8Yre5mUZg/rH9YtnudHvaX1h3Xe07Jm2pPZWMW7US",
+	"WZrVfF7htoNBsgPU0zfehxPp6TmO/G+fYVK3Oy4IeahJQq8Mfx7JiSgYZ7vuS804Pku0Bne2qtJlXWhL",
+	"3cyAMUJJs4voXC+zXYu4SVZqdtcudtTJo2XnHiw93VUkWF9HL7sJpAFRtQdT9G73D5jghXJ9fWysbNlJ",
+	"wIxljNShdT18BvPgwGVa0mYdB0gyTp90QPKkcemU+bvPx0MRFavS6X5647I5cNubO5J0E9KBS60yYmYC",
+	"0eXTtZUmhRGPIfAjhR1si1U+58o6ROhNWNkO8g3x7RK0C5KkeNlISviWMPQCLqBAxJ2+fplekLfRTrNB",
+	"S/L2dbXsvLVkoBZOI0iPoC7mR8q5tKqbwYYj7B0oCzcCqpd5oAbwvuMuE2c7qN+S4fuDplLTtYDfcmxb",
+	"9/ZQePVZc1Y0NamrOgxcxul6sBtjkc8pR/RsbERyLSuPfHNEAAzHKLdgGBWpvCsYcy4KyKfcDgjo5AE3",
+	"iSyz3vc5Gl142Toox5zQjY8LLopKg68y4PSFuu3GHSf1xOZ9P1X0eQSnKvsnaEUxZvkkin0I6UQ7rkaq",
+	"nBbIedrKPKRlU9HDCP2lfV9Td2Y5QAnac7Woq9mk/Uncmn7t0yiqdQx2k35aDrFup9gWJ6ykT3V4Tt4U",
+	"qE1vTEUS2FzU5uT005LWMumvIp3J/UpO3fk2Y3kAQn0h8oq3Nt7sKni0vSORByX2uPf+nQZ0jJ3mVzdC",
+	"UGCb09A/9fwJmPhjHAPdmXemUbeJc25NrlCZIXYl07kV4oIktd85zZbX0VvubDYMz5T8Ug77afbPaqO5",
+	"GrlPQskIsd9eQUbypVcdQe6VRxv17+4UOTnYvTQXMuGEvATJpGo0SOSkGfQkTaW08IObmBoJ6RWT13AZ",
+	"aVIg3HxnGQ3GTKdkUnInAmfKU5quMQdoyAbUYh4384j+KKd84yEfHC9FfwZ8PsINFsZwcrwahBqoqsiZ",
+	"RFpBXQTGU4Wr3d8iEzarwkCoDuzbul5ACD1xlB287t2KQh0jMs45dE+G7Wl1Ah20hylN/0hl2X9XvBDz",
+	"NfEwB37oxsySI3n6WBcXoujTUuDEm2XOSQAsKMVVmMqtW4wdMxpuHW5JPxJKN96oSqV+3kO8DRR96Xhz",
+	"ZpEpm2pGCmaUYzrb2ceCX3wo8rDieazCpFJz6xbnie/n/1+TnC+eKlSIIofWPGye4auOZzdJiDVxXcdm",
+	"GUigsV3WRFubwfJrGKVvbtl0nnXbwI7eVi2vuj0tY6RtvVOlfydTbWIp+96FGxlzp8ENcAv4bZfBu8B/",
+	"sgrkjjbpFvifCt432KwDvN52fftY3mx6DkbFmbqaapibbTF91LpjZK9tRy1DeWMkr4scConaAZekog4j",
+	"qUfJYS5kwyyFLCubeNyRFluuI4TFtllC60DUwpCUgILqBS82aOvPKQqFFLadIvPBHu37JvQ69Z3aH0CY",
+	"5g1JCSMba2fcDC/wXMznoF3+CGO5zLnO4+ZCsgw03vvskq/N9Q3/tQ13m+mfR9JMO41x5ARApO0AKdY+",
+	"DueGZvkaQL5H+/wIu/r5Ejz1t5W/Tt9l1YAZvQ/DZ2FXX/ErdMWgtIYDB8JXtyRHDGrGlCSznJPPxq07",
+	"zGPEP2HzNFQCxjMiq2jWMVNsPve/0FbSE/VXKezGk+8Ut908ky4RiDuYAaly0WQjcsTSP49llp6sYzmo",
+	"TUY+d1agPYg2EYYM3y1jwcAuUuSZzysbWwZ2MI61gttSCUid1mFK2gizId9QYyEjXBuvpupF+HbVGA4p",
+	"cSTUDupHZ7QI99IAeN4f3vsetqatoxTJpDNa9olC8tIQlaqcZmPC7F3t/9wBECBtw7jJMWIjddQRiSZY",
+	"nlvUGIm89wxrQuV2Fb+dtTzMtdX6XmabHv1DKqgBjt62y6g58TJv/+NlyZSOFTWTbtK7toqtZhKMMw1Z",
+	"pUl3fsnXSUdkSuA89Sd+oObs2Q+nXz5+8o8nX37l6oflYgHGRu5HNEjNNupQbCG7OqW7dVvvLc+mNyGk",
+	"Q6bPtZU5ZHmrN8WfNcdtTVOUsLX6XY3miQsgcRwpvLRJOHTtvaJxmlxDn9Z2pRa59x1LoeD29wz9z9J1",
+	"42u5KmHASe1WZFfCF0gJ2ghDnhxts7CwTRIKsyT1IFUPvXDp7ZXMIOimPRUIO+ARmFrIUA4D4mf4iXmr",
+	"FYOrsvC8ypm/Nq3Lv9Ocho6ERnIvQi1WUB3jDZuCiPyrdZTq0ys+SdsepSWomS2BeZwuBUjJPtKkd+pt",
+	"ZEhfm7l9Yz0NjDrB6XETE+JFOJTXIM0h28dwIuXrcJLGbPDJ8I9EZui9cY16ubfBK5Lvgw1JUE97ziB1",
+	"VuRRoPWzBCfIgwAYSP/ZStwYZa6LKiNqZyUge0IwYHfFj58aw/bWTDwESeiwBbw4n2fTrvZP9OB85Di1",
+	"n2qkREv5Y4gSWsvflm0vsN76Iom2yCtNrAXj2JLqi4VR/lfzvE6rOvAq6WVf1UpZpiTqRhJZW50eh85U",
+	"TDhCWtAXvLh7rvGd0MaeEj4gfzOcjSpO3Rkj2aHSXK9w0Cs+au6C38LU8jVliv0PwD1K3nN+KG/g791m",
+	"pNzhhYuCmceVfi9pTNpp9vgrNvPl+ksNmTBdx4HLIJzUmSpBo3Wszny4OTXmtnX+puwNyHge3JPYz63Q",
+	"PO8P4CFsjuhHZioDJzdJ5Snq65FFAn8pHhUHwW+5Lm5Y2v16eeijijI75qHvh/ePXR6tgy6dykB/naNv",
+	"6xZuExd1s7axRRRGV4h/+/Z3OxtT+yBdzR27U/GFvZR136mo+y2UXXA48mP4eVMU89tQIT5XbG6gWGhn",
+	"PypfH36jVS0u/YpZK0CCEYaKm/5j9tXTu892FyBwKRH6R9XBepP89Q4xibW2Jo+mioq6jqjn6rslinBS",
+	"Irms0sKuzxD/QYEm/pEsEPF9nWzcJ6uvbWn+7rPqPcjg79GkJq9MuF2/V7yg+8iZ+CQwi7mY2Leu5Kg/",
+	"KH+/N/t3+OJvT/NHXzz+99nfHn35KIOnX3796BH/+il//PUXj+HJ3758+ggez7/6evYkf/L0yezpk6df",
+	"ffl19sXTx7OnX3397/eQDyHIDtCQOOHZ0f+ZnhYLNT19/XJ6jsA2OOGlwHzuHz7QW3mucPmE1IxOIqy4",
+	"KI6ehZ/+/+GEHWdq1QwffsWjpLH50trSPDs5uby8PI67nCwo2+rUqipbnoR5Pkw6GD99/bKOxHB+OLSj",
+	"jfb4+KghhVP69ubbs3Pm4xzq8plHj44fHT/G8VUJkpfi6NnRF/QTnZ4l7fsJFfw6Mb6W70kdUvth0vtW",
+	"lq7SL37yNOr/WgIv7NL/sQKrRRY+aeD52v/fXPLFAvQxRZO5ny6enARp5ORPn5boAwKWNBu6wq9RtU/f",
+	"l5WYdDoLRVOEcfpjF3Vg2nkHXBazScgrF1yCZU4eSi6BDHLHGt8vc8Sz6/6y4XWExWBWPnr2e6K8Rgjv",
+	"uYwSxNSlixpvtP999svPTGnmX0UYW1sH4YXwzSZkNY7exJ7Hgez/uwK9bsjSAUpJnpHLEj3LaoW8x0fz",
+	"rcyibFeaa4SxlLKoh+swM1JTM3GTeL3hd6QZjCBpuDdy5EfTr//488u/fTgaAQhVATBgcfnveFG8c9o1",
+	"uCKn3Y7jzWTIJaoJEnMdmp2ckCKr/hp1b9q0C7S+k0rCu6Ft8IAl94EXBTZUEkbtgcuOz633dCO7rXrP",
+	"yHXSP40HK/a6kF5GQ5iQ8AO/KwmmiSV+D1C6BKKwUtpndkTFZePDpyTjOlsKNBVgH5/qwQVd0fvhB2Es",
+	"dva5lFJYqSul1jjpmZr/mByFs0Kc6smjR4E9+8dPtDknnqVEA44qyfxh0holnIhrDNRn4+7Tm7pUmeal",
+	"w7T/4pJNeOuWa3SM3PrpHhfaLqh24+V2h+st+hueM+2TbNBSHn+2S3kpnScsXsdObPgwOfryM96bl9KC",
+	"lrxg1NLJHcQrUoUo3kt1KUNLFBmr1YrrNQmEtkmJ2Rb9LUe/yN+P3A3hWFsZ59o8+uPD4KV/Eq0ef27+",
+	"mor8RiJBN38te/lii5RwzwxdHDRWKwz6fitRIn0/LcvXeFkY8qLwOUPhShhrHhyz7+PeLdOQgyQq41HH",
+	"Xzgchcw7bU8BuqucASgps7SyXh3El48rvpy2VUQiB2kxGE0PANM6BRth6vtqHeSHm8gP/eizKLntrt7w",
+	"dbVWL1hOeVnuMIbjJhuS2jRVJvDB6PeXHIWbE+9SXRdwweWYGmlupj9S+oOt99QBdwO4G5ISI3hrgdE1",
+	"nMFd3Uyh8GN9kbZuzFu8tz5zmfcnXiCdRMtVuoO8gyz8LyUL1yWwFk44Lctblo5P6tp9tywjc2/lbvLk",
+	"j2JLaTUKSQc+2rqOVMm4zZZO9+k1dIZZLaAv3n4Pts89v/F9zrXwnghb5Nx/UckwdXoaPJ146XvPQlSz",
+	"t0PeATMoag/PunFkVfBU54iQbtnhkh3oSAV8PhgZgGeiRWIhLcfdu27Q1ibhlEpOcRVeVKbIiFDotvHv",
+	"F8By5FFeZi+5jjJZY/fxAW7d8/OzSqfnQgCGZCllnaOdB6B3ju8WuVvFvnZBhyhvXJs6sBxjJQpL8Wkj",
+	"hEAivkk4toSvyR6l6sNhOhymw2Ea+cyqy7yTuGGibDChikudvkFpisjdKo78ZV4tf2V9/dNHTz/bBX0r",
+	"rXbJRlyNVU+RLTL8673Dvnz0xWe7mucNs7CaZ+99IZhcGNRt5kzJptLkzd6cHW5a1m7hDUtrvSYCd9vD",
+	"OzSkj9jW5OTPUNdmD8YbHGmc2Sa2/0d9o1fn/Y7m68ExO+22uZ56y5dn3mqQwXYHU8yn8OCmfd/61PZ0",
+	"fDC/fDzzS5x7Z5dUOC27Af4+qvNnbm/5F0bWoIEFId1uWrnG7dEzm/i76tZulb+kucQj7WAo+Zc2lNTl",
+	"F/cgokby577MIxuk0E2Gke1MZW+GEBz48zaB3K5EdjB7HDS1B03twexxOEyHw3QwexzMHgezx8HscTB7",
+	"/LXMHvSC2J/B48Zvx12eiyznlrvszuNegxPG6zyfrZsIkX3PtMadcbPx9fg5PRwPD7mD7HmQPQ8PucNh",
+	"Ohymw0Pu8JA7POQOD7nDQ+6v9JC7+cstzkN84kvCRY5rN0o30A2VErZ520WfWh4O9KRDnHpT/qTJuc5l",
+	"7pNJ+zTSZhJiOfGTj6dy+zjpRXqmH3VRUNT65Ysxb7lDYPpnn9im6Zl0hkmT5m3LAsk0MW/u5tr++Pfs",
+	"xl34WVn2HdHMLd+Et+rZkSarXTn4xgjXmbraxpRlhyvXFeTxbLZYdK0tm0TfsbXLRXifqk+huuyrp8F/",
+	"9sEx+8Y3bSpSepFmoXjRVC3heuE6IatHZLB74c9nNP69Y/Yd1eKxZkIpVX3Z6hW7J6R99vjJF099E80v",
+	"XcbSbrvZV0+fnf79775ZqYW0xMPcQ6fX3Fj9bAlFoXwHf0X2x8UPz/7Pf/7f4+Pje1tvFXX1zfpnZHuf",
+	"8NVyGhPA0G595puUuoek25etqDtkm7v9S/kbdZW8BOmVfriEP84ljNj/S1y+szYZeXfkOvNEnKV7n5fx",
+	"OLvYlit5hGGMljfeHna4pRO39HXseocL+9O/sA9G0IPd5mC3ORhBD4fpcJgORtCDEfRgBD0YQQ9G0L+G",
+	"EbRZI3Kyfb/ewez6cp/4JzpVQ6zf2MfsZ8UcEFXBNVM6B+0xtqi45tIC5p7weiYqZUtZLCTLCkFXsmYG",
+	"9AXoqRE5sCzkusBSQSthDSs1XGBDNz2O3YZg+/sXzKf84P2JX0X158PjlzSqbsmUuWPFSQ0ilWUG7ATR",
+	"hj/9/e/s0aRRnhQFDjCtEZN6aK741V1mbqiJbZywpa5eeOw4TrK5hhaNPUZ0aJRCXmKI7aT/6nrXz9bs",
+	"6Mjdb+ye9J475y5qosJjHxD6cYv3h9eBKssLZqqyLNahGFUmeNGok9IsjkK9Rzp2HNLcfJbOHLghSQeC",
+	"LnUdeNjBgeNGnLRLUDtyTaqLbU7+pAMTs8we26K6vv9aCc+i9EdarRq2OAeLXiaIkC7qE9w58KJh1rwS",
+	"UqwQykeTWxfqaBd7ZPZNVB6dIs765UfThWqjas9IxxnoBBH/Qv/hBcPPGGvHbSAFX4pUGHcN1MZCemgE",
+	"OwwnivGlA0PlcdzFnaB83kzel0cL1aKJ6+uFDwjeDcEJvQsxAX+8/CL+CtX1wkt6yn5WTWF794A8aP8+",
+	"sQX9rCS4NHEo+DtaPGQEq8UO0oQSUvDxhn+555t7BtxEBDlBa8tWOeQHbLRFFhlze+Nkn+UV/oPH0oZb",
+	"Btd2nIrB7jNmGm0Mc/7Bm8I4m0VTHX/MV8xH4aef4NPmY3Csu2ExdEgDn3E/KblfplOIxdI6Yu65x/U4",
+	"0CtsHMllozyzGm5kVZ1JFRhN3DpMbAaFkgvzabKiTdSRxssmczBPrP/4X/DsPldVkdOT1yXx9RYlI2QG",
+	"zKgV1NlFVsIY7wvw9NHf7g5CK9BWrSqLRy8yWX9k7nKb5sre9GegL0QG7BxWpdJci2LNfpW15vIm3K5x",
+	"n42V4QnmICQZ27j37HB9MrVaCbtCDNyICarFBuOiV9sHRxy18B48qrKgSf0tpARdu3ua2gm0YdIpdTgx",
+	"jFc49R7kuUItPjdxLmB9lJXttCyf86IgdG2zsdHAoxJtF4XbT1gJayFPbNwx+5Zny3pvJ412T5XTAi6g",
+	"YOTBK0FPnAc4YoMLadzIGgyp76hmvgHcZwssWk2krQBdWw80sBWnnNurqrCiLNp9yDCAkBq+gpS/uqPN",
+	"KJoYP/jVOdu0mjdDd+nXqtbgx+y0/kQzS+UWxzUQ7+56ugciOW4Bja2bDOKymYIs8LX7vNAsU9oN4dIP",
+	"127UZQlcN50d5d8vNUz9EJpfgDa8cB5XrUU9OIjqn4aojoI6EuAnIqgnTbQ35fXXv4paCQD+tFepVOC9",
+	"m+S86bSjSC5kJJJHc/uzdn1ZfLvR9bwz48sXca0FVfsLBwFhABRE0Y4p2v7n0UgTCDZCWnDvsEo6QCsD",
+	"9JbxEmvtyjypo3eUxG7P2Fv5kJkl//Lxk388+fKr8OeTL78aMOLgPARYyozTDISf3TBjbDmftWVqvxJH",
+	"jd9nd73bu23i5EjkV30gX8ocrhp/8eboxPfhPcNKvg5FCTqncHJU85KBh2k87ArwmjJLUd69v7exYrZM",
+	"qvqCJu5MLCTk51fypfymVshegBbzNUoNNc+4W7itBsihTEUCvIFSgwFpnVsItWp2E8BJQOigDn4BFyAn",
+	"TBzDMbVpPO4gX4QwAk6RAUFi00qNKUUT8RkktEAVEdbjhezk594hS68uvXs9aeRmThddQF5XKP6oQpj9",
+	"WELYtCOFtdHy8WQywJaTyPGs1MqqTBXOi7QqS6VtfbrN8SjNAww6M8eKhyHCvZEwdyVys9Wkc06t9qAD",
+	"aFO2+WxMOucBTSmbTmpRQWHQ574bXW+bucawtHNVMvfA74DwUfna4VGZ4mcd88/nbv2xg6S3Z2MQRZlV",
+	"5cmfTbjZhybdXA6F5ebEXsmThVbYbKNzMbHUAmUTzahrS6Ubr4RGS7oIv6Lu5Oz6Aof4Tunocfs99tvq",
+	"PNxB2qR76dPs7OWLNHu8ndfkv/QjbKPprLPhN/cGSYzYO6/hLAdlLakZA+06A0NMwWh4KiBFwgfvpU8s",
+	"Eq62J86FzBmPtrGja1K6YQS3bFO87UV/DBPlxwjQfPwZO9VZ9nJVuswEkN8wBrPL4cLtsfG63U0w8Fd/",
+	"3zu+f+fHN34Iaaplka0X/A7vnqgQL4TpuMb/Gryrb+e5c7jJP+2b/HltbY3J8HAvfz73sg6RSIcr+JAj",
+	"4fZWc4s+TCOv5GsYh9vXcPMS3/FC7gkDXofVURxssivT07u7SvOd0m/8qg63+GdqFHU7OdoRa4yGZpsm",
+	"1k+5j6izTwr6cXoGdDrraRqGDuqk9vUSmnFjVCYoh9rL3EzcIfbKCX+KD4LPJy34RHt9kHsOqofPTPUw",
+	"IOX4V39RjBE0dhWALlYqh2BYVfO5AbtJ+nG+FVmlNUjLkDyN5auSuZ7Hg37Y52KFOTZW5S9uir1esQ3Y",
+	"HbGoAx4iy0CmZG5GeHH4Ua97DyGe7DAAd27ZrHcgwOITRx1fm2TfRKUzepTAusg3LOPSxYrPgHlk5HDB",
+	"kACP90C2J3+6f0mdViqTWM0Z2DS47L7flgd01ty4LQDZaxJCScKQoZeas0fsUhQFq6Qh46IwvmAIlzmz",
+	"es2sqjM/a+AFy1rBrTUc/ZNzNnhytj4FeqsbWFP6LaCaE7pPD4ZOYoEf7/wAPOfSk3wfQVYxziQsuBUX",
+	"EEz+x4dcXNe+zXwmrA0McMJ4nrvT2GwCXIBeM1PNDMo6sh2jdM+0z8sODAOuStACr2heNAZ4uCqVtqBP",
+	"NJQFX0fqeN/AvSNOXCauTY5GZ67FDW+1DrOiMZluuzWGq9fBhBzoJ5FpdVosVO0sb9bGwupo0rkmfdd/",
+	"DOT6DZqGvlOrkoWQMF0pCevEUaavP9HHVG/KZjbU+Rw/DvXtXMht+DtgtecZc2nfFL+fCHu4kSdMZ7Ua",
+	"6EDkbLamz47+dzxr4dCsZdY/SWuZ9Y9ZNJCSAz+fhHiFpprjUMs/W3/6jH2hJYA2eN4r252t8+XkT/wl",
+	"6mqWlcUE4tEvlltwrpJjEl2RYL9jAEmjz2tHZgpzuxq927RkRXhIHcv6ay1VX2peutPZfHThBPT6qcPh",
+	"/qUDvL3hJyYSHy+JMXudR+IhyvsvFeU9et93YuQ4ZGW2cbTK7FfswVoKbtwm1BePfpw5lc+QlLhL/WkC",
+	"ELvV0QhXX9OuEyCS8Qqj5KuSWZUKRWk6TnnmmOzUPbLSE0YZnamVm27JL4DxQgPP8WEMkqkZLrq5hGmR",
+	"3FBO7Vbdj6pswIrkrQiuUqsMjIF8GmohbwMttGuKaAzhiQAngOtZmFFszvWNgX1/sRXO97Ce0kPbsPs/",
+	"/mYefAR4nby5GbHUJoXeXvGyHtTjpt9EcN3JY7JzweKOain8TqEO08IAMLvhZHD/uhD1dvHmaKEINXHL",
+	"FB8muRkB1aDeMr3fFNqqnOL93QfxufuKGircMMmlCtrN1GAFN3a6vbyRaa3F4AoiTpisYIQDD7xqX3Fj",
+	"3/hY7BzvIJ81muahPjTFMMB4i7qHQmLk39zH1NiZkgakqQzzI4T4KshTa5BwtWGun+GqnkvNo7HrAC6n",
+	"Z9w28hCWovE9sqKKyFHmbtwEuEotjrSg3GtB+qhsAdEgYhMgZ6FVhN3YmWAAEGEaRDvCCZU5arhmShXA",
+	"pYuDVWWJ3MJOK1n3G0LTmWt9an9t2vaJy9ffwjlZrsDEwXUe8kuHWUNq4iU3zMPBVvy9j79baDAmCTMe",
+	"ximlcJpuonxSHGOr+AhsPaRVudA8h2kOBU/oa351n5n7vGkA2vFAntMLZWHqsrenN72hZD2oh6qHVjRe",
+	"gmn+rBh9YRkeQXw8NwTie28ZOQcaO8WcPB3dq4eiuZJbFMajZbutHtB94Ri4466RA9lz9DEAD+ChHvr6",
+	"qKDO00Z90J3iP8H4CUKba0yyBjO0hGb8nRbQ1RnGF1jrpuiw9w4HTrLNQTa2hY8MHdmUlvKzNDl0Pahu",
+	"MYCvraWNHoDH13ncnlxyYTHbtBOkp3xuQW91y/8PLoJRPoQGK5/RhdEI/t704xCT15Gx1HMRB0Io14gk",
+	"4rNU4R3G2WO2ErKy7ouq7MSl1tbAsyXkLTT4kYRpEkBpWHCdF2Covk24N5V2CaVs54InoBOxju0XP677",
+	"O6VHJexvp6XkwrJKWlFENZvqd/unp708aCQOGomDRuKgkThoJA4aiYNG4qCROGgkDhqJg0bioJH419VI",
+	"fKwUTNMgcYRskFLJaddR8+Cn+ZfKWF9fVUFBQtoJ1CEgW4oyIAzrLXZSBGngq5Pm2ZJU+ZxRK9MkhyL1",
+	"jUuWx4PY9ieKwB8obowSZk8Ypx6uHCnPc/eyiTzrXPbzueYrYEtV4PXrxpowYY2bCW/kCRPz4DcD/mMU",
+	"3XbswihpHBfuaUBanD0U0sY/KTE5qYPwjLN3NPq7SSv0MhriUgtrUdTlTsGF/9aFWIN+QMhoOc3Mc6GN",
+	"y7nOHH4ZYC+KteM+ZWC07BAvUSuMNJhqBSEXH8iGJjLnxY31XlERUmdqd7XDJwyOF8dNF/crQ1gNs0ox",
+	"U6hLdOskGgr4jFHpSmozrJSCCwoUnfDPp3V948hmtDLMv3AIP43u0OGoXwO3S+WtmrhDoa9arY6uH7n7",
+	"H4g67439zuoK3k0YNETqn8Omqdzr0YY+idZ40FoLeTfnhYF3Q/BSd5MqqVtLLx8maXbV4PzEB/yOCz44",
+	"DUQZVBCe7EekG7xxANnkyMKVPaGzOHVgfKwivJ/4Wq5xyX7yK9rt0v7El3NbQkC4a/09S/U2fB3SYu1D",
+	"tePQ7knrKr6ZNGCBF4QMUcBwHJkLbzn/9vQVM6rSGbAM7yYhWVlwvBLhyk68qYPNuIGvnoakBu4hzVcM",
+	"02W7xWGDL56wsx9OQ27zpc/B3W57/9Q5vjNj1wU8oJA0/Oxf+P/meTNTmv2bWNHbXpFuYi6KWCyo78x3",
+	"s0rmBbxjxJJZzU67/J8bxn2iBpe3oR4hhYJ37u93tDZnJ/EqVAcHNqH/vZugEtHpRND84yvKgsydoi2U",
+	"lgWq0O4ry/Lw4s18iglnf8EBGUUZunruLzC9J17YLg80w5X07/Bz4MVzv9lbrvDUvRjb9DwSVrwMd3zY",
+	"vBp7O12LbrwVL7fejNvhJKFVobR2s430+2cbKWBwLydMc68X43JgBmugmO+EFEevmzHyh3tug7HfqHzd",
+	"4a7EDumMtvlgk8ReSK7XiZSj/Xja7npQRAbmeUffePlh76UW+nypz0m2MZGUetbVVEqPPsTIUuM0JNwb",
+	"ytHgvHNyjlIJS7qJ9Y9qAEdlmaaYW7cn7I3r93FzShNEnuk0gsAnE7bSblmzUWorlQ3M+HMNTA2IT55e",
+	"OvsTJOy8yohBMU9xIyQIrF+OIy1ATj0DmiLTnbYY+lFL0MiF4cbAarZd2IhvFDpxtXwxxL4bUeTjXKwv",
+	"osVt4skx0VxNPQMe4M5rC6N5c40tGtGz5wjjt82ih9hoDALz/CllRezwvl2ZXjPN+sD4DowvOo0diUBI",
+	"r23qMpHjW2R8eq0rOczzvr2CrELg4pN8n9wxyAcLzXOxV10Os2qxwBdg3ykLlwY0Hhbu/Dis0C13LBfc",
+	"jYLc4LXW56YZj7rD9blLlITofkjz/YC2g8s1ea+sSi7XwccPzUyrqnA4zLnlx0f7ZbSuAE2qXklj7B1y",
+	"Y3jtW8TGen/Vtn93aGGX3DC3v5CzSuY+Or47sb2S45PmuaHPr2TDpjcmyHPrTazOzzvmigi73M5bZFgJ",
+	"emqvpDtQrcPky2G5k/tRC7Mcro27uzZc1iMYYLD90k4NQ9jT7aEjvkbXRzOZaTIxxL+e8HbqidY30vEM",
+	"xzTHlT5dy716EveGbzsUNwoo7zAHRcl4MGJlShqrq8y+lZwcdqKFHfedjYNnwjDvex6apH3GEi5dfqi3",
+	"kpMZsXbjSfLAOSR8Vr4DCCzWVIuFM7/FBDQHeCt9KyFZJYUzWa5EptXUpWHB84Wyy7FriZWc55QeT7F/",
+	"glZsVtl4TOOcB4xFhzDn3YzTMDV/K7llBXBj2U8COTAOF3Jz1TEGYC+Vfl9jIV34cQESjDDTtGLme/eV",
+	"aiv65QcdL/7fd25qot1tUcUAu8gHIcfy1oZxKu1RCBMX8+7CfmfOkCshp0kiQ3Ort3p2aYvdp4TCnoAe",
+	"tD2F7BLeSrz9rGLE8bm9Hjl0XX56Z9Gdjg7VtDai4xkU1jrq+bcXLsMSTObgZ/MXyhkS0UFwZaONd8Wa",
+	"Onu/oxWtdeUC1ZkfupDdV1+Le6CRf0C0lGQdbwzf4rwF8kaLzuefo3z/b8mAxr29JvsDfpikwjDi29oq",
+	"FjZ8wjh64gTHobU3aApZVpYi/m5TgQcXvJiqC9Ba5GBGrlQo+e0FL36pu32YHKH2YWo1z2DqNApjsXaO",
+	"fRyd4jhCCit4MaVX9ViA4KXrdeY6bbmPo9L1qxXkglso1qzUkIH30hKGNe/5Y5eRi2VLLhd0dWtVLZau",
+	"mRvnEjTUVb7xCd0dInm32ys5dRmOU+47ThcaF4EgF6V+FUK64C55PZ9PlzbmVZ7gKJS/fuiRPjkaFLQR",
+	"qRdNrIRDTpvNjJAiWvJAhJ9m4n0k/D8Q/YHoP3eiT+XnJtTNO9oKh694W25ZrXXb2ejvUEv2UUpVHOo9",
+	"/dXrPQUORJ7wvPUGSRca5uSRdEl5MGfA8P6qSDvvqzf797p3Rm8sES5tu/Hu/NmSC+m9CutAVu9unanV",
+	"SlgcchePwpsoNusHz4kBYzaoOnvtTv70/5tuf02lO53w/ILLDEJn0DUAuFGQVVrYNb2neCn+8R7w/3/g",
+	"g8TFGrinVqWLo2dHS2vLZycnhcp4sVTGnhx9mMTfTOfjHzVm/wyvpFKLC26Bvl1NlRYLIVEauOSLBehG",
+	"uXn05PjR0Yf/bwCevOb3PzMCAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -248,236 +248,267 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+y9/5PbNpIo/q+gdFflLyfO2I6T2/hTW/eZ2El2Lk7i8ji5d2f77UJkS8IOBXABcEaK",
-	"n//3V+gGSJAEJWpm4mzq5Sd7RHxpNBqN/obuD7NcbSolQVoze/ZhVnHNN2BB4188z1UtbSYK91cBJtei",
-	"skLJ2bPwjRmrhVzN5jPhfq24Xc/mM8k30LZx/eczDf+ohYZi9szqGuYzk69hw93Adle51s1I22ylMj/E",
-	"GQ1x/mL2cc8HXhQajBlC+aMsd0zIvKwLYFZzaXjuPhl2Leya2bUwzHdmQjIlgakls+tOY7YUUBbmJCzy",
-	"HzXoXbRKP/n4kj62IGZalTCE87naLISEABU0QDUbwqxiBSyx0Zpb5mZwsIaGVjEDXOdrtlT6AKgERAwv",
-	"yHoze/Z2ZkAWoHG3chBX+N+lBvgFMsv1Cuzs/Ty1uKUFnVmxSSzt3GNfg6lLaxi2xTWuxBVI5nqdsO9r",
-	"Y9kCGJfs9TfP2WefffalW8iGWwuFJ7LRVbWzx2ui7rNns4JbCJ+HtMbLldJcFlnT/vU3z3H+C7/Aqa24",
-	"MZA+LGfuCzt/MbaA0DFBQkJaWOE+dKjf9UgcivbnBSyVhol7Qo3vdFPi+X/TXcm5zdeVEtIm9oXhV0af",
-	"kzws6r6PhzUAdNpXDlPaDfr2Ufbl+w+P548fffyXt2fZ//g/P//s48TlP2/GPYCBZMO81hpkvstWGjie",
-	"ljWXQ3y89vRg1qouC7bmV7j5fIOs3vdlri+xzite1o5ORK7VWblShnFPRgUseV1aFiZmtSwdm3KjeWpn",
-	"wrBKqytRQDF33Pd6LfI1y7mhIbAduxZl6WiwNlCM0Vp6dXsO08cYJQ6uG+EDF/TPi4x2XQcwAVvkBlle",
-	"KgOZVQeup3DjcFmw+EJp7ypz3GXF3qyB4eTuA122iDvpaLosd8zivhaMG8ZZuJrmTCzZTtXsGjenFJfY",
-	"36/GYW3DHNJwczr3qDu8Y+gbICOBvIVSJXCJyAvnbogyuRSrWoNh12uwa3/naTCVkgaYWvwdcuu2/T8v",
-	"fvyBKc2+B2P4Cl7x/JKBzFUBxQk7XzKpbEQanpYQh67n2Do8XKlL/u9GOZrYmFXF88v0jV6KjUis6nu+",
-	"FZt6w2S9WYB2WxquEKuYBltrOQYQjXiAFDd8O5z0ja5ljvvfTtuR5Ry1CVOVfIcI2/Dtnx/NPTiG8bJk",
-	"FchCyBWzWzkqx7m5D4OXaVXLYoKYY92eRherqSAXSwEFa0bZA4mf5hA8Qh4HTyt8ReCEQUbBaWY5AI6E",
-	"bYJm3Ol2X1jFVxCRzAn7yTM3/GrVJciG0Nlih58qDVdC1abpNAIjTr1fApfKQlZpWIoEjV14dDgGQ208",
-	"B954GShX0nIhoXDMGYFWFohZjcIUTbhf3xne4gtu4IunY3d8+3Xi7i9Vf9f37vik3cZGGR3JxNXpvvoD",
-	"m5asOv0n6Ifx3EasMvp5sJFi9cbdNktR4k30d7d/AQ21QSbQQUS4m4xYSW5rDc/eyYfuL5axC8tlwXXh",
-	"ftnQT9/XpRUXYuV+Kumnl2ol8guxGkFmA2tS4cJuG/rHjZdmx3ab1CteKnVZV/GC8o7iutix8xdjm0xj",
-	"HkuYZ422Gyseb7ZBGTm2h902GzkC5CjuKu4aXsJOg4OW50v8Z7tEeuJL/Yv7p6pK19tWyxRqHR37KxnN",
-	"B96scFZVpci5Q+Jr/9l9dUwASJHgbYtTvFCffYhArLSqQFtBg/KqykqV8zIzllsc6V81LGfPZv9y2tpf",
-	"Tqm7OY0mf+l6XWAnJ7KSGJTxqjpijFdO9DF7mIVj0PgJ2QSxPRSahKRNdKQkHAsu4YpLe9KqLB1+0Bzg",
-	"t36mFt8k7RC+eyrYKMIZNVyAIQmYGt4zLEI9Q7QyRCsKpKtSLZof7p9VVYtB/H5WVYQPlB5BoGAGW2Gs",
-	"eYDL5+1Jiuc5f3HCvo3HRlFcyXLnLgcSNdzdsPS3lr/FGtuSX0M74j3DcDuVPnFbE9DgxPy7oDhUK9aq",
-	"dFLPQVpxjf/i28Zk5n6f1Pn3QWIxbseJCxUtjznScfCXSLm536OcIeF4c88JO+v3vRnZuFH2EIw5b7F4",
-	"18SDvwgLG3OQEiKIImry28O15ruZFxIzFPaGZPKTAaKQiq+ERGjnTn2SbMMvaT8U4t0RAphGLyJaIgmy",
-	"MaF6mdOj/mRgZ/kdUGtqY4Mk6iTVUhiLejU2ZmsoUXDmMhB0TCo3oowJG75nEQ3M15pXRMv+C4ldQqI+",
-	"T40I1ltevBPvxCTMEbuPNhqhujFbPsg6k5Ag1+jB8FWp8su/cLO+gxO+CGMNaR+nYWvgBWi25madODg9",
-	"2m5Hm0LfriHSLFtEU500S3ypVuYOlliqY1hXVT3nZemmHrKs3mpx4EkHuSyZa8xgI9Bg7hVHsrCT/sW+",
-	"5vnaiQUs52U5b01FqspKuILSKe1CStBzZtfctocfRw56DZ4jA47ZWWDRaryZCU1surFFaGAbjjfQxmkz",
-	"Vdnt03BQwzfQk4LwRlQ1WhEiReP8RVgdXIFEntQMjeA3a0RrTTz4iZvbf8KZpaLFkQXQBvddg7+GX3SA",
-	"dq3b+1S2UyhdkM3aut+EZrnSNATd8H5y9x/guu1M1Hm/0pD5ITS/Am146VbXW9SDhnzv6nQeOJkFtzw6",
-	"mZ4K0woYcQ7sh+Id6ISV5kf8Dy+Z++ykGEdJLfUIFEZU5E4t6GJ2qKKZXAO0tyq2IVMmq3h+eRSUz9vJ",
-	"02xm0sn7mqynfgv9IpoderMVhbmrbcLBxvaqe0LIdhXY0UAW2ct0ormmIOCNqhixjx4IxClwNEKI2t75",
-	"tfaV2qZg+kptB1ea2sKd7IQbZzKz/0ptX3jIlD6MeRx7CtLdAiXfgMHbTcaM083S+uXOFkrfTJroXTCS",
-	"td5Gxt2okTA17yEJm9ZV5s9mwmNBDXoDtQEe+4WA/vApjHWwcGH5r4AF40a9Cyx0B7prLKhNJUq4A9Jf",
-	"J4W4BTfw2RN28Zezzx8/+euTz79wJFlptdJ8wxY7C4bd92Y5ZuyuhAdJ7Qili/ToXzwNPqruuKlxjKp1",
-	"DhteDYci3xdpv9SMuXZDrHXRjKtuAJzEEcFdbYR2Rm5dB9oLWNSrC7DWabqvtFreOTcczJCCDhu9qrQT",
-	"LEzXT+ilpdPCNTmFrdX8tMKWIAuKM3DrEMbpgJvFnRDV2MYX7SwF8xgt4OChOHab2ml28Vbpna7vwrwB",
-	"WiudvIIrrazKVZk5OU+ohIHilW/BfIuwXVX/d4KWXXPD3NzovaxlMWKHsFs5/f6iod9sZYubvTcYrTex",
-	"Oj/vlH3pIr/VQirQmd1KhtTZMY8stdowzgrsiLLGt2BJ/hIbuLB8U/24XN6NtVPhQAk7jtiAcTMxauGk",
-	"HwO5khTMd8Bk40edgp4+YoKXyY4D4DFysZM5usru4tiOW7M2QqLf3uxkHpm2HIwlFKsOWd7ehDWGDprq",
-	"nkmA49DxEj+jrf4FlJZ/o/SbVnz9Vqu6unP23J9z6nK4X4z3BhSubzADC7kquwGkKwf7SWqNv8mCnjdG",
-	"BFoDQo8U+VKs1jbSF19p9SvciclZUoDiBzIWla7P0GT0gyocM7G1uQNRsh2s5XCObmO+xheqtowzqQrA",
-	"za9NWsgcCTnEWCcM0bKx3Ir2CWHYAhx15bx2q60rhgFIg/ui7ZjxnE5ohqgxI+EXTdwMtaLpKJyt1MCL",
-	"HVsASKYWPsbBR1/gIjlGT9kgpnkRN8EvOnBVWuVgDBSZN0UfBC20o6vD7sETAo4AN7Mwo9iS61sDe3l1",
-	"EM5L2GUY62fY/e9+Ng9+A3itsrw8gFhsk0Jv3542hHra9PsIrj95THZkqSOqdeKtYxAlWBhD4VE4Gd2/",
-	"PkSDXbw9Wq5AY0jJr0rxYZLbEVAD6q9M77eFtq5GIti9mu4kPLdhkksVBKvUYCU3NjvEll2jji3BrSDi",
-	"hClOjAOPCF4vubEUBiVkgTZNuk5wHhLC3BTjAI+qIW7kn4MGMhw7d/egNLVp1BFTV5XSForUGtAjOzrX",
-	"D7Bt5lLLaOxG57GK1QYOjTyGpWh8jyyvAeMf3Db+V+/RHS4Oferunt8lUdkBokXEPkAuQqsIu3EU7wgg",
-	"wrSIJsIRpkc5TejwfGasqirHLWxWy6bfGJouqPWZ/altOyQucnLQvV0oMOhA8e095NeEWYrfXnPDPBzB",
-	"xY7mHIrXGsLsDmNmhMwh20f5qOK5VvEROHhI62qleQFZASXfJYID6DOjz/sGwB1v1V1lIaNA3PSmt5Qc",
-	"4h73DK1wPJMSHhl+Ybk7gk4VaAnE9z4wcgE4doo5eTq61wyFcyW3KIyHy6atToyIt+GVsm7HPT0gyJ6j",
-	"TwF4BA/N0DdHBXbOWt2zP8V/g/ETNHLE8ZPswIwtoR3/qAWM2IL9G6fovPTYe48DJ9nmKBs7wEfGjuyI",
-	"YfoV11bkokJd5zvY3bnq158g6ThnBVguSihY9IHUwCruzyiEtD/mzVTBSba3IfgD41tiOSFMpwv8JexQ",
-	"535FbxMiU8dd6LKJUd39xCVDQEPEsxPB4yaw5bktd05Qs2vYsWvQwEy9oBCGoT/FqiqLB0j6Z/bM6L2z",
-	"Sd/oXnfxBQ4VLS8Va0Y6wX743vQUgw46vC5QKVVOsJANkJGEYFLsCKuU23Xhnz+FBzCBkjpAeqaNrvnm",
-	"+r9nOmjGFbD/VjXLuUSVq7bQyDRKo6CAAqSbwYlgzZw+OLHFEJSwAdIk8cvDh/2FP3zo91wYtoTr8GbQ",
-	"Neyj4+FDtOO8UsZ2Dtcd2EPdcTtPXB/ouHIXn9dC+jzlcMSTH3nKTr7qDd54u9yZMsYTrlv+rRlA72Ru",
-	"p6w9ppFp0V447iRfTjc+aLBu3PcLsalLbu/CawVXvMzUFWgtCjjIyf3EQsmvr3j5Y9MN30NC7mg0hyzH",
-	"V3wTx4I3rg89/HPjCCncAaag/6kAwTn1uqBOB1TMNlJVbDZQCG6h3LFKQw703s1JjqZZ6gmjSPh8zeUK",
-	"FQat6pUPbqVxkOHXhkwzupaDIZJCld3KDI3cqQvAh6mFJ49OnALuVLq+hZwUmGvezOdfuU65maM96HsM",
-	"kk6y+WxU43VIvWo1XkJO993mhMugI+9F+GknnuhKQdQ52WeIr3hb3GFym/vrmOzboVNQDieOIn7bj2NB",
-	"v07dLnd3IPTQQExDpcHgFRWbqQx9Vcv4jXYIFdwZC5uhJZ+6/nXk+L0e1ReVLIWEbKMk7JJpSYSE7/Fj",
-	"8jjhNTnSGQWWsb59HaQDfw+s7jxTqPG2+MXd7p/QvsfKfKP0XblEacDJ4v0ED+RBd7uf8qZ+Ul6WCdei",
-	"f8HZZwBm3gTrCs24MSoXKLOdF2buo4LJG+mfe3bR/6p5l3IHZ68/bs+HFicHQBsxlBXjLC8FWpCVNFbX",
-	"uX0nOdqooqUmgriCMj5utXwemqTNpAkrph/qneQYwNdYrpIBG0tImGm+AQjGS1OvVmBsT9dZAryTvpWQ",
-	"rJbC4lwbd1wyOi8VaIykOqGWG75jS0cTVrFfQCu2qG1X+scHysaKsvQOPTcNU8t3kltWAjeWfS/kmy0O",
-	"F5z+4chKsNdKXzZYSN/uK5BghMnSwWbf0leM6/fLX/sYfwx3p88h6LTNmDBzy+wkSfnf9//j2duz7H94",
-	"9suj7Mt/O33/4enHBw8HPz75+Oc//5/uT599/POD//jX1E4F2FPPZz3k5y+8Znz+AtWfKFS/D/sns/9v",
-	"hMySRBZHc/Roi93HVBGegB50jWN2De+k3UpHSFe8FIXjLTchh/4NMziLdDp6VNPZiJ4xLKz1SKXiFlyG",
-	"JZhMjzXeWIoaxmemH6qjU9K/PcfzsqwlbWWQvukdZogvU8t5k4yA8pQ9Y/hSfc1DkKf/88nnX8zm7Qvz",
-	"5vtsPvNf3ycoWRTbVB6BArYpXTF+JHHPsIrvDNg090DYk6F0FNsRD7uBzQK0WYvq03MKY8UizeHCkyVv",
-	"c9rKc0kB/u78oItz5z0navnp4bYaoIDKrlP5izqCGrZqdxOgF3ZSaXUFcs7ECZz0bT6F0xd9UF8JfBkC",
-	"U7VSU7Sh5hwQoQWqiLAeL2SSYSVFP73nDf7yN3euDvmBU3D150xF9N779us37NQzTHOPUlrQ0FESgoQq",
-	"7R9PdgKSHDeL35S9k+/kC1ii9UHJZ+9kwS0/XXAjcnNaG9Bf8ZLLHE5Wij0L7zFfcMvfyYGkNZpYMXo0",
-	"zap6UYqcXcYKSUuelCxrOMK7d295uVLv3r0fxGYM1Qc/VZK/0ASZE4RVbTOf6ifTcM11yvdlmlQvODLl",
-	"8to3KwnZqiYDaUgl5MdP8zxeVaaf8mG4/Koq3fIjMjQ+oYHbMmasat6jOQHFP+l1+/uD8heD5tfBrlIb",
-	"MOxvG169FdK+Z9m7+tGjz/BlX5sD4W/+ync0uatgsnVlNCVF36iCCye1EmPVs4qvUi62d+/eWuAV7j7K",
-	"yxu0cZQlw26dV4fhgQEO1S6geeI8ugEEx9GPg3FxF9QrpHVMLwE/4RZ2H2Dfar+i9/M33q4Db/B5bdeZ",
-	"O9vJVRlH4mFnmmxvKydkhWgMI1aorfrEeAtg+RryS5+xDDaV3c073UPAjxc0A+sQhnLZ0QtDzKaEDooF",
-	"sLoquBfFudz109oYelGBg76GS9i9UW0ypmPy2HTTqpixg4qUGkmXjljjY+vH6G++jyoLD019dhJ8vBnI",
-	"4llDF6HP+EEmkfcODnGKKDppP8YQwXUCEUT8Iyi4wULdeLci/dTyhMxBWnEFGZRiJRapNLz/NfSHBVgd",
-	"VfrMgz4KuRnQMLFkTpVf0MXq1XvN5Qrc9eyuVGV4SVlVk0EbqA+tgWu7AG732vllnJAiQIcq5TW+vEYL",
-	"39wtAbZuv4VFi52Ea6dVoKGI2vjo5ZPx+DMCHIobwhO6t5rCyaiu61GXyDgYbuUGu41a60PzYjpDuOj7",
-	"BjBlqbp2++KgUD7bJiV1ie6X2vAVjOgusfduYj6MjscPBzkkkSRlELXsixoDSSAJMjXO3JqTZxjcF3eI",
-	"Uc3sBWSGmchB7H1GmETbI2xRogDbRK7S3nPd8aJSVuAx0NKsBbRsRcEARhcj8XFccxOOI+ZLDVx2knT2",
-	"K6Z92Zea7jyKJYySojaJ58Jt2OegA73fJ6gLWelCKrpY6Z+QVs7pXvh8IbUdSqJoWkAJK1o4NQ6E0iZM",
-	"ajfIwfHjcom8JUuFJUYG6kgA8HOA01weMka+ETZ5hBQZR2Bj4AMOzH5Q8dmUq2OAlD7hEw9j4xUR/Q3p",
-	"h30UqO+EUVW5y1WM+BvzwAF8KopWsuhFVOMwTMg5c2zuipeOzXldvB1kkCENFYpePjQfevNgTNHY45qi",
-	"K/+oNZGQcJPVxNJsADotau+BeKG2Gb1QTuoii+3C0Xvy7QK+l04dTMpFd8+whdpiOBdeLRQrfwCWcTgC",
-	"GJHtZSsM0iv2G5OzCJh90+6Xc1NUaJBkvKG1IZcxQW/K1COy5Ri53I/Sy90IgJ4Zqq3V4M0SB80HXfFk",
-	"eJm3t9q8TZsanoWljv/YEUru0gj+hvaxbkK4v7SJ/8aTi4UT9Uky4Q0tS7fJUEidK8o6eEyCwj45dIDY",
-	"g9VXfTkwidZurFcXrxHWUqzEMd+hU3KINgMloBKcdUTT7DIVKeB0ecB7/CJ0i4x1uHtc7h5EAYQaVsJY",
-	"aJ1GIS7otzDHc0yfrNRyfHW20ku3vtdKNZc/uc2xY2eZn3wFGIG/FNrYDD1uySW4Rt8YNCJ945qmJdBu",
-	"iCIVGxBFmuPitJewywpR1ml69fN+98JN+0Nz0Zh6gbeYkBSgtcDiGMnA5T1TU2z73gW/pAW/5He23mmn",
-	"wTV1E2tHLt05fifnosfA9rGDBAGmiGO4a6Mo3cMgowfnQ+4YSaNRTMvJPm/D4DAVYeyDUWrh2fvYzU8j",
-	"JdcSpQFMvxBUqxUUIb1Z8IfJKIlcqeQqquJUVfty5p0wSl2Hmef2JK3zYfgwFoQfifuZkAVs09DHWgFC",
-	"3r6sw4R7OMkKJKUrSZuFkqiJQ/yxRWSr+8S+0P4DgGQQ9JueM7uNTqZdarYTN6AEXnidxEBY3/5jOdwQ",
-	"j7r5WPh0J/Pp/iOEAyJNCRsVNhmmIRhhwLyqRLHtOZ5o1FEjGD/KujwibSFr8YMdwEA3CDpJcJ1U2j7U",
-	"2hvYT1HnPXVaGcVe+8BiR9889w/wi1qjB6MT2TzM297oahPX/t3PF1ZpvgLvhcoIpFsNgcs5Bg1RVnTD",
-	"rKBwkkIslxB7X8xNPAcd4AY29mIC6SaILO2iqYW0XzxNkdEB6mlhPIyyNMUkaGHMJ/9m6OUKMn1kSmqu",
-	"hGhrbuCqSj7X/w522c+8rJ2SIbRpw3O926l7+R6x61eb72CHIx+MenWAHdgVtDy9BqTBlKW/+WSiBNb3",
-	"TCfFP6qXnS08YqfO0rt0R1vjizKME397y3SKFnSXcpuD0QZJOFim7MZFOjbBnR7oIr5Pyoc2QRSHZZBI",
-	"3o+nEiaUsBxeRU0uikO0+wZ4GYgXlzP7OJ/dLhIgdZv5EQ/g+lVzgSbxjJGm5BnuBPYciXJeVVpd8TLz",
-	"8RJjl79WV/7yx+YhvOITazJpyn7z9dnLVx78j/NZXgLXWWMJGF0Vtqt+N6uiMg77rxLK9u0NnWQpija/",
-	"ycgcx1hcY2bvnrFpUBSljZ+JjqKPuVimA94P8j4f6kNL3BPyA1UT8dP6PCngpxvkw6+4KIOzMUA7EpyO",
-	"i5tWWSfJFeIBbh0sFMV8ZXfKbganO306Wuo6wJNwrh8xNWVa45A+cSWyIh/8w+9cevpG6Q7z9y8Tk8FD",
-	"v55Y5YRswuNIrHaoX9kXpk4YCV5/W/3NncaHD+Oj9vDhnP2t9B8iAPH3hf8d9YuHD5Pew6QZyzEJtFJJ",
-	"voEHzSuL0Y34tAq4hOtpF/TZ1aaRLNU4GTYUSlFAAd3XHnvXWnh8Fv6XAkpwP51MUdLjTSd0x8BMOUEX",
-	"Yy8RmyDTDZXMNEzJfkw1PoJ1pIXM3pdkIGfs8AjJeoMOzMyUIk+HdsiFcexVUjCla8yw8Yi11o1Yi5HY",
-	"XFmLaCzXbErO1B6Q0RxJZJpk2tYWdwvlj3ctxT9qYKJwWs1SgMZ7rXfVBeUARx0IpGm7mB+Y/FTt8Lex",
-	"g+zxNwVb0D4jyF7/3YvGpxQWmir6c2QEeDzjgHHvid729OGpmV6zrbshmNP0mCml0wOj8866kTmSpdCF",
-	"yZZa/QJpRwj6jxKJMILjU6CZ9xeQqci9PktpnMptRfd29kPbPV03Htv4W+vCYdFN1bGbXKbpU33cRt5E",
-	"6TXpdM0eyWNKWBxh0H0aMMJa8HhFwbBYBiVEH3FJ54myQHRemKVPZfyW85TGb0+lh3nw/rXk1wueqhHj",
-	"dCEHU7S9nTgpq1joHDbANDkOaHYWRXA3bQVlkqtAtz6IYVbaG+o1NO1kjaZVYJCiYtVlTmEKpVGJYWp5",
-	"zSVVEXf9iF/53gbIBe96XSuNeSBNOqSrgFxskubYd+/eFvkwfKcQK0EFsmsDUQVmPxCjZJNIRb6KdZO5",
-	"w6PmfMkezaMy8H43CnEljFiUgC0eU4sFN3hdNu7wpotbHki7Ntj8yYTm61oWGgq7NoRYo1ije6KQ1wQm",
-	"LsBeA0j2CNs9/pLdx5BMI67ggcOiF4Jmzx5/iQE19Mej1C3rC5zvY9kF8uwQrJ2mY4xJpTEck/SjpqOv",
-	"lxrgFxi/HfacJuo65SxhS3+hHD5LGy75CtLvMzYHYKK+uJvozu/hRZI3AIzVaseETc8Pljv+NPLm27E/",
-	"AoPlarMRduMD94zaOHpqyyvTpGE4qvXv60UFuMJHjH+tQvhfz9b1idUYvhl5s4VRyj+gjzZG65xxSv5Z",
-	"ijYyPdTrZOchtzAW0GrqZhFu3Fxu6ShLYqD6klVaSIv2j9ousz85tVjz3LG/kzFws8UXTxOFqLq1WuRx",
-	"gH9yvGswoK/SqNcjZB9kFt+X3ZdKZhvHUYoHbY6F6FSOBuqmQzLH4kL3Dz1V8nWjZKPkVnfIjUec+laE",
-	"J/cMeEtSbNZzFD0evbJPTpm1TpMHr90O/fT6pZcyNkqnCga0x91LHBqsFnCFL+bSm+TGvOVe6HLSLtwG",
-	"+t82/imInJFYFs5yUhGIPJr7Hss7Kf7n79vM5+hYpZeIPRug0glrp7fbfeJow+Osbn3/LQWM4bcRzE1G",
-	"G44yxMpI9D2F1zd9fot4oT5ItOcdg+PjvzHtdHCU4x8+RKAfPpx7MfhvT7qfib0/fJhOQJw0ublfWyzc",
-	"RiPGvqk9/EolDGChamETUOTzIyQMkGOXlPvgmODCDzVn3Qpxn16KuJv3Xelo0/QpePfuLX4JeMA/+oj4",
-	"jZklbmD7SmH8sHcrZCZJpmi+R3HunH2ltlMJp3cHBeL5J0DRCEommudwJYMKoEl3/cF4kYhG3agLKJVT",
-	"MuOiQLE9//eDZ7f4+R5s16Isfm5zu/UuEs1lvk5GCS9cx7+SjN65golVJuuMrLmUUCaHI932r0EHTmjp",
-	"f1dT59kIObFtvwItLbe3uBbwLpgBqDChQ6+wpZsgxmo3bVaTlqFcqYLhPG1Ri5Y5Dks5p0poJt4347Cb",
-	"2vq4VXwL7hMOLUWJYZhpvzG2zDS3Iwm0sN55qC/kxsHy44bMDDQ6aMbFBi9mwzdVCXgyr0DzFXZVEnrd",
-	"MYUajhxVrGCmcp+wJSasUMzWWjK1XEbLAGmFhnI3ZxU3hgZ55JYFW5x79uzxo0dJsxdiZ8JKCYthmT+2",
-	"S3l8ik3oiy+yRKUAjgL2MKwfW4o6ZmOHhONrSv6jBmNTPBU/0MtV9JK6W5vqSTa1T0/Yt5j5yBFxJ9U9",
-	"mitDEuFuQs26KhUv5pjc+M3XZy8ZzUp9qIQ81bNcobWuS/5J98r0BKMhs9NI5pzp4+xP5eFWbWzWlJ9M",
-	"5SZ0LdoCmaIXc4N2vBg7J+wFmVCbAv40CcMU2XoDRVTtkpR4JA73H2t5vkbbZEcCGueV0wuxBnbWem6i",
-	"14dN9SNk2A5uX4uVSrHOmbJr0NfCAL7IhyvopkNscoN623hIj9hdnq6lJEo5OUIYbWodHYv2ABxJsiGo",
-	"IAlZD/FHWqaoHvOxdWkvsFf6LUavyG3P6x+S64UU2+x771zIuVRS5FgKISVJY+q2aW7KCVUj0v5FM/Mn",
-	"NHG4kqV1m7fAHoujxXYDI/SIG7r8o69uU4k66E8LW19ybQXWeM4GxTxUuvYOMSEN+GpWjohiPql0Iqgp",
-	"+RCiCaA4kowwK9OIhfMb9+0Hb//GpBiXQqKly6PN62fksiqNQM+0ZMKylQLj19N9zWPeuj4nmKWxgO37",
-	"k5dqJfILscIxKIzOLZtiRodDnYUIUh+x6do+d2197vzm5044GE16VlV+0vE66ElB0m7lKIJTcUshkCRC",
-	"bjN+PNoectsb+o33qSM0uMKoNajwHh4QRlNLuzvK1063JIrCFoxeVCYT6AqZAOOlkMGFmr4g8uSVgBuD",
-	"53Wkn8k1t6Q7TOJpb4CXIw8g8IUy+eBvO1S/coBDCa4xzDG+jW0Z8BHG0TRoJX4udywcCkfdkTDxnJdN",
-	"6HSiqDdKVV6IKvBxUa/Md4pxOMadhSeTHXQdfL7XdMdqHMfeRGM5Chd1sQKb8aJIpbb6Cr8y/BoeicEW",
-	"8ropQtW8DuzmKB9Sm58oV9LUmz1zhQa3nC6qm5+ghrh2f9hhzLSz2OG/qQpM4zvjg6aPfpUbIqSL4xLz",
-	"D18Zp6ReR9OZEatsOibwTrk9Otqpb0bobf87pfTwXPef4jVuj8vFe5Tib1+7iyNO3DuIT6erpcmri7Hg",
-	"Cr+HhEdNRsguV8KrbFBnDKMecPMSW9YDPjRMAn7Fy5GX8LGvhO5X8h+MvYfPR9M3cOvTc1nO9rKg0ZRH",
-	"FCvc874MXYhj8cEUHnx3Xgu/1r0IHffdfdfx1FGMWMssRj10N3OitRt8rBftu6uxFAmhTgd+j+uB+Cie",
-	"uU8DD1dC1SH6KsRAB5WQfvUpeDp1P0bWn3xZ8Ft7LUZ9LG98/VpaptfJv/uZvLAMpNW7fwKPy2DT+0Vl",
-	"EtIumafaJqwpfTipFGLnVpxSwyZVLsXLhsFWRqylQ0uD8jMDsnoxRRwY4OPjfHZeHHVhpkruzGiU1LF7",
-	"KVZrixn7/wK8AP3qQEWCtgoBHrFKGdFWIC3dYD4F7BqHO5n62MARsIgrKgzHCkGoV5BbLDvbBtdpgGPq",
-	"K7jJgtPnj8oE4+p08ybDFyTYV4VgWGv2wB0/SJwUJf+iOp0n03PunzUh1PQC7JqbNl1L78305JebyyXk",
-	"mBV5b6Kq/1qDjJIgzYNdBmFZRnmrRPOOCfN6H291bAHal0dqLzxRfZ1bgzP2jv0SdvcM61BDsnBo84jv",
-	"JomDEQPkAgs5pMcMyT5qTJiGMhALISTYp2Jui2OM5nyO0q7dcK5Aku7iaFOx7ZkyXfR80lyu61FpH/FJ",
-	"zlguq2HN5HH94wWWqDY+QI43iYdjLZ2dDwvnXPvExZhWrPGdhBTGYMJvIYcgzVKKS18/ALFCnqprrovQ",
-	"4k6SQtHdJNJAL5uZRfuAYxjkkCjFgG+h8lI5MSIbe1DWfTPRBBzeMxQZ2ibwQbiWoDUUjUukVAYyq8KD",
-	"j31w7EMFhb/eCAlmtPwRATea+vp1m9sby8BxTHXNfdRrvECmYcMddDrKwD0+5z5kP6fv4RF+KAN20MLU",
-	"0OvherTh6Y4wAyTGVL9k/rY8/Lj/JsYmISXoLHie+um4ZTcjG+bdLOqcLuj4YDQGucm5c/awkqSdJh+u",
-	"sqcjRI/kL2F3SkpQKOQbdjAGmiQnAj1KONrb5Ds1v5kU3Ks7Ae+3zSNXKVVmI86O82EO8T7FX4r8EjAH",
-	"YBPiPlKjnd1HG3vjzb5e70LO7KoCCcWDE8bOJD0qCo7tbnnB3uTynt03/xZnLWpK6++NaifvZPp1Bibc",
-	"17fkZmGY/TzMgGN1t5yKBjmQoXorx0JurjE5f7eK58lUrXzoau5XkW+JiqBIySQX5LF6jgc9ZTjCFAhR",
-	"rg50ZHLmPV3MlCoVy3uTNA1uqDSm4skQIAtySraABgo/eBIBybroiVNIqe980ju1ZBpaJ/JNs/8NS7in",
-	"NPr+zM0sXX63VBo6xdhdb8r02Tx8wTSa+J+FsJrr3U1y9A1KyA+sJ6NYPhiO1URitQtpo7GGOCxLdZ0h",
-	"s8qaOhcp1da1M93LOBRda/u5U72AKK6LGy+o7diaFyxXWkMe90i/9ySoNkpDVioM80p5oJfWyd0bfOQl",
-	"WalWTFW5KoDqxaQpaGyuWkqOYhNEUTVJFBDt4Gth6hPR8cQp3Z1KfqQMRa3VEbXzc6CX621WJ1p0Rr7M",
-	"kYhlMD6Lk8cQNR7Cu6f2f5o3L8UW6QZ06sgvmdU1zJlv0a+R7Q8+18A2whgCpaGla1GW+HBcbCPPaxO4",
-	"kEbtiNh7jmGVVwJjb7pJBEgartyd12RWiHnARZz2iNm1VvVqHSWYbuAMKq+uvUIcj/KTqTE8Cl+QuSme",
-	"so0y1muaNFK75Dbk7H6upNWqLLtGKRLRV97S/j3fnuW5fanU5YLnlw9Qr5XKNist5uF9dT84sJ1J91KL",
-	"dS/gjMqZH07VS+0wVM4T7WQG2WNxRxd2j8B8f5iDHra5nw0X1l9Xl5mm1ZgzybhVG5Gnz9TvK9puNEYu",
-	"xaKSOcuotiJlmcBmeNjjy6oJrkAWOUQzSJ4sDnfGPCPwTmZkN+6/KIH3x2VL8Ixm5KIcMhcvRWX5qKzX",
-	"AwAhpafPttZUkDGWxBquolaUKgFd5H1AJ94qGIl0O9jcCHcOlIVbATWIfmwAvE/GhznllqNIyoXahu8P",
-	"2uRzNwL+434q7zCPsRCvi5a0NAV5hUQ1IxwhneJ6bzzUG3z2vpgaFdUUz514w0cAjMdJdWCYFC11LBhL",
-	"LkooslTtxfPGRjWPNG3/NKtfEl0Yz8lzXofSh27sWoNPnEIivu76vyruSEk1zYeWZFnAFuhdxy+gFdU0",
-	"nEf+Fyip5GHPGKCqrIQr6ISP+WwuNYqa4gpCX9N0ZgVAhd7Ivo0sFRcV3+U9w4lfexZF1kzBbtKSQoil",
-	"nWIHzCRJo85WZnRMzNSj5CC6EkXNO/gzx4ocXTOgO8oJVA10hCzokVOn+YlGeB0GOAv9U6JMwMT7aXzo",
-	"aBaURt0+BnQwTrI2Y6depsMk41RFjYMFZysaRyyReMs3TMWv5bhBckjyrbo1cZ+EkhFiv95CjlKN13eg",
-	"8BrPiJPCZz1BapcABWkFrkvC2r4GyaSKSkxec9OoKm0OxfADTYyNhPTa9A2cym004+13luFgzPSSqY0q",
-	"Erqh05ub53+Tk7j3II6Ol6IRA/753x77V6Bur3ZgAyzlLd1+OtkfizT6W8xz8Tlb1GGgslTXVDMy1kNf",
-	"QPCDEvUFF5AXy0VzLYeozblP79k3dYgoXn3Dd0xp/Mdpnf+oeSmWO+QzBH7oxsyaOxLyjleKCPBRoG7i",
-	"/eLVPAAWrC0qTEXrFlPHjIbbuVEioN1FHor7KLbhlxBvAwY7EP/MrWOcpl6g5cJd2b3tHGLBLz6kaNnw",
-	"Itb0MVFkt4x6SB3sev9/7Vu4eKqQ360qeR4qhPoSRV0+g1WAA3HZNWz2P5Yc8rVAAk1l4ZZodXhdX9zA",
-	"ZHok60q9QBgrv9IBe1BxdVB55lbLmGj57dXY2PPMdNJS7noXpkbdDICO6zQeAj8uW/lp8J/M4Tq2jCng",
-	"/7PgfaRQbQwv1aT9BFjuZOBIwErW6oXaZhqW5lCACZmrnTqv29wdwcQqZK6BG4q4Of/RK55tilIhnSJM",
-	"MaGNT7MZpYClkC2zFLKqbUKPwUylchchLDb6I1pHXGhjUoITJq94+eMVaC2KsY1zp4NKOsYlIoKjw/dN",
-	"mDCaO3U4gDCtDofvM1szetzMXeBUhIrCNY3lsuC6iJsLyXLQ7t5n13xnbu5RapwDh3xKPJJmulkDIu8S",
-	"kjYBUu68U/iW/p4GQH6Hjp8JDhuMC044a8i0Y9WIf2YIw+/CYbPh26xUK3xFOHIgfG5a9PCRCqgkmsFJ",
-	"Ppu27jCPEb/A/mkwLb9nRFbhrFOm2H/uf8StRDXyJyns3pNPNsr+s06Ku6WDGZAqV23wPxHL8DymXuL6",
-	"5Cvxa9wgbIanKoH2INpEGPEPde3iI7uIYRD+GXdsBJ9e7qwbaZF670uWgQwtBmZPeD+YNpSd5z48a2hK",
-	"G5gaCClz/1r6SEsb2efDvTQCHtWm92e9O20TMuPGOaZG3P730VmlqiyfEvNJlTsK7ybwkHZhHKGPyAkw",
-	"su4mPMY0tWw6eY86RW2OLZM3WlTnkLeryvcp/WNmohGO3nVBqCXyMqrcjtYtfMnTGFPm/TdmXTNYwyQY",
-	"ZxryWqOZ+JrvDpcdG8kYffGXs88fP/nrk8+/YK4BK8QKTJt1vFe2q40LFLJv9/m0kYCD5dn0JoTsA4S4",
-	"4H8Mj6qaTfFnjbitaVOKDoqWHWNfTlwAieOYKBd1o73CcdrQ/n+u7Uot8s53LIWCX3/PtCrLdNWHRq5K",
-	"OFBSuxW5UJwGUoE2wljHCLseUGHbiGizRvMg5v69omwySuYQ7MeeCoQdCblKLWQsoBb5Gb7t9l4jBtuq",
-	"9LyKPD371uX1NLLQodCIUTELYJWqvGgvliwFEb4g0tHLWm/4RIt4FCPbMFuKlk0Roo88T5NeXDB7P7fv",
-	"FnO1aU7vNjEhXoRDeQPSHPNPjOctuAknaU37/zT8I5GI4c64RrPcX4NXJPWDmxXlnwTa8FF+gjwQgJHX",
-	"tp13ktFDsSgRsSYvAfoTggO5L3583zqWDz4LQUhChwPgxc9n23bNSwYPzm+c0ff7BinRUt6PUUJn+Yde",
-	"5AbW21wk0RZ5o4m1YIgtqaFYGD23Ns+bV8wjWsngsbNWyjKnmZZl4pE02XHwTMWE41QCfcXLT881vhHa",
-	"2DPEBxSvx59GxS9lYyQTKs3N8vS95JPmjl7F3t3U8hU+zP4vcHuUvOf8UN4JP7jN0LiDFetX4Vagt97s",
-	"GsekIKvHX7CFL7ZRaciF6Tv3r4Nw0jwMBS2WPqAVtvbAS9RD6/xZ2VuQ8TJE4rAfIvdW47P3ELZH9Ddm",
-	"KiMnN0nlKeobkEUCfykeFRfnPXBd3LIww83SvkQJ3I5M+zIsOzx1eZTaxF06tYHhOiff1h3cJi7qdm1T",
-	"cxZNru/w7t1bu5iSaihdi8F1x1xHd1KU4aiSDL9CliPCkR/Dz5uimJ/H8t5SbteR3Ny9/ahFeTBgpZNp",
-	"/eN8tgIJRhjMJf5XXzvm096lAQLKvDA8qgTrbdLFEGISa+1MHk0V5VCfkD7dd0vkvMZXjXmthd1h3eBg",
-	"QBN/TeZj+rbJ7eFzwzS+NH/3WXUJTe32NhNIbcLt+q3iJd5H5OKT7hZS5Qn7mjJ8+4Py53uLf4fP/vS0",
-	"ePTZ439f/OnR549yePr5l48e8S+f8sdffvYYnvzp86eP4PHyiy8XT4onT58snj55+sXnX+afPX28ePrF",
-	"l/9+z/EhBzIBGlL7P5v9r+ysXKns7NV59sYB2+KEV+I7cHuDuvJSYV1Lh9QcTyJsuChnz8JP/384YSe5",
-	"2rTDh19nvj7TbG1tZZ6dnl5fX5/EXU5X+PQ/s6rO16dhHqw22JFXXp03MfoUh4M72lqPcVM9KZzht9df",
-	"X7xhZ6/OT1qCmT2bPTp5dPLYl7aWvBKzZ7PP8Cc8PWvc91PMr3lqfOr80/atVtJv9xpD1oNwrldQsPvN",
-	"q5t/azy35kF4vLMUJV4ZfzdEjM0qzgskLl+jdIZV1zAYC8F68uhR2Asv6UQXzim+/nj2YdbWtu8LEwOk",
-	"vmkBTkLW1nwcLvoneSnVtWSYDJAOUL3ZcL2jFXSwEQ2O28RXBo3sWlxxC7P3rncf51XlCxaMoRyrXHVP",
-	"eeiMBNJkvHcnjBLh+7IDJoXyYbGEW2J/b3LIwWSJ3cFGrxzMIX1Ok1DRO4Q8ztBnTAhrzgiZHQaIns+q",
-	"OoHOr/FhjdmHs3mUhJ+gUWXRYHyA0Vf1/yMYdaTr76bZsw/urzXwEhNruT82jlDz8EkDL3b+/+aar1ag",
-	"T/w63U9XT06DFnL6wWdM+bjv22kcEXb6oZNYpjjQM0Q8HWpy+iGUzN4/YKdcso81jTpMBHRfs9MFlsma",
-	"2hTi1Y0vBWnenH5ABXz091NvRU1/REMI3bCnIUHTSEtKxZH+2EHhB7t1C9k/nGsTjZdzm6/r6vQD/gfJ",
-	"NloRZfY9tVt5ioEjpx86iPCfB4jo/t52j1tcbVQBATi1XFKd8X2fTz/Qv9FEsK1AC6eFYjYt/ytlPTzF",
-	"cpO74c87mSd/HK6jk/HtwGWO2QRNiKbqJopLXh/97HPmtsxuWi6bfs67oYA9lKT2rezjfPb0DrlyN1Nw",
-	"ApiveMFCYgOc+/Gnm/tcUlS3Ey1JBEYInn46CDrbx76DHftBWfYN2mo+zmeff8qdOJdOc+RlEOhuKPpN",
-	"Oz79a9TJ3k0zuSJBRVGyi+5ROyuKAdGTDgnGfqXwdh3D2MasKu+lbZHWqtBCuiXMp4nNw/SRlOQsCBJS",
-	"FTCLlVura/h4S57Qi+/i2p4nTMroG8GHHstQoD8CNZkLsR/9QiMPzR+HSPj8RZi0fR/xB0/5g6c0POXz",
-	"R599uukvQF+JHNgb2FRKcy3KHftJNg9vbszjzooimUC2e/QP8rj5bJvlqoAVyMwzsGyhip2vyDPrTHAJ",
-	"ZC0bCDKnwbrU0RhGuGewW6WklTYcfPbsbSoswj9vrOpFKXJGlnU0LVXcriPLT5PRs8v85nssE/NE1nhW",
-	"iLJusjnYa+VfSw8vlMjaYhUz/9B48eBBFHbHroUs1PWDkwDuP2pAPu/hDdPMEgBGMb7DAkmtw9ABOABr",
-	"bD70NE7Bzp7JX/KbzV3yY6d+/2vboJqMef958eMP0WtEsjRQQBC+hSPSxYcLWmFA/jXHiFAqpPicbEDl",
-	"Dl/VWm5r06nhdvLHPfQH77897/+2SaFM1dsslmUasqToLjiZJPAmefuHzp/ebjGjcOxU4mP3O+NshZU3",
-	"hxfUYsfOXwy0V+rWvxK+2mHT3q2Q4Pd9EI9i/CPsZZ9I4xayUrYJSqdF/SFk/iFk3kpxnXx4puiuScsS",
-	"1cPlA31sHkrbdh7+YPJyDPEagDLF/vSbHt872fihbStly6Ik61Cw6ANlp+ij+Q8W8QeLuB2L+BYShxFP",
-	"rWcaCaI7ztY1lWFgKqWiE2IZpI7QvC65jh4EHzJhn+GIaVXwV+Ean9pgl8QV2evw3YCggNnEBt6tDe8P",
-	"lvcHy/v9sLyzw4ymK5jc2up1CbsNrxpbl1nXtlDXkYccYaFg96GPjxT//t+n11zYbKm0L9nDlxb0sLMF",
-	"Xp76+ty9X9uSmIMvWOcz+jFORpf89ZR3nZZdx7ljvWMdB1711FfvOB5pFHIohM9tzF4cA4dsv4l+e/ve",
-	"sWwD+ircCG1I17PTU0yqs1bGns4+zj/0wr3ij+8b8vjQ3COeTD4iXSgtVkLyMvOxEVkbtvXk5NHs4/8N",
-	"AAD//1nr4yEUHQEA",
+	"H4sIAAAAAAAC/+x9/XPctpLgv4Ka3SrH3qFkO072xVev9hQ7H9o4ictSsrcX597DkJgZPHEAPgCUZuLz",
+	"/37VjQ+CJMjhSGM5qctPtob4aDQajUZ/vpvlclNJwYTRs+fvZhVVdMMMU/gXzXNZC5PxAv4qmM4VrwyX",
+	"YvbcfyPaKC5Ws/mMw68VNevZfCbohs2ex/3nM8X+WXPFitlzo2o2n+l8zTYUBja7ClqHkbbZSmZuiDM7",
+	"xPnL2fuRD7QoFNO6D+WPotwRLvKyLhgxigpNc/ikyQ03a2LWXBPXmXBBpGBELolZtxqTJWdloU/8Iv9Z",
+	"M7WLVukmH17S+wbETMmS9eF8ITcLLpiHigWgwoYQI0nBlthoTQ2BGQBW39BIohlV+ZospdoDqgUihpeJ",
+	"ejN7/stMM1EwhbuVM36N/10qxn5jmaFqxczs13lqcUvDVGb4JrG0c4d9xXRdGk2wLa5xxa+ZINDrhHxf",
+	"a0MWjFBB3nz9gnz66adfwEI21BhWOCIbXFUze7wm2332fFZQw/znPq3RciUVFUUW2r/5+gXOf+EWOLUV",
+	"1ZqlD8sZfCHnL4cW4DsmSIgLw1a4Dy3qhx6JQ9H8vGBLqdjEPbGNj7op8fwfdVdyavJ1JbkwiX0h+JXY",
+	"z0keFnUf42EBgFb7CjClYNBfHmdf/PruyfzJ4/f/8stZ9r/dn599+n7i8l+EcfdgINkwr5ViIt9lK8Uo",
+	"npY1FX18vHH0oNeyLguypte4+XSDrN71JdDXss5rWtZAJzxX8qxcSU2oI6OCLWldGuInJrUomdY4mqN2",
+	"wjWplLzmBSvmhAtys+b5muRU2yGwHbnhZQk0WGtWDNFaenUjh+l9jBKA61b4wAX9fpHRrGsPJtgWuUGW",
+	"l1KzzMg915O/cagoSHyhNHeVPuyyIpdrRnBy+GAvW8SdAJouyx0xuK8FoZpQ4q+mOeFLspM1ucHNKfkV",
+	"9nerAaxtCCANN6d1j8LhHUJfDxkJ5C2kLBkViDx/7vooE0u+qhXT5GbNzNrdeYrpSgrNiFz8g+UGtv0/",
+	"L378gUhFvmda0xV7TfMrwkQuC1ackPMlEdJEpOFoCXEIPYfW4eBKXfL/0BJoYqNXFc2v0jd6yTc8sarv",
+	"6ZZv6g0R9WbBFGypv0KMJIqZWokhgOyIe0hxQ7f9SS9VLXLc/2baliwH1MZ1VdIdImxDt399PHfgaELL",
+	"klRMFFysiNmKQTkO5t4PXqZkLYoJYo6BPY0uVl2xnC85K0gYZQQSN80+eLg4DJ5G+IrA4WIPOFxMA0ew",
+	"bYJm4HTDF1LRFYtI5oT85JgbfjXyiolA6GSxw0+VYtdc1jp0GoARpx6XwIU0LKsUW/IEjV04dGhCiW3j",
+	"OPDGyUC5FIZywQrChQVaGmaZ1SBM0YTj753+Lb6gmn3+bPZ+39eJu7+U3V0f3fFJu42NMnskE1cnfHUH",
+	"Ni1ZtfpPeB/Gc2u+yuzPvY3kq0u4bZa8xJvoH7B/Hg21RibQQoS/mzRfCWpqxZ6/FY/gL5KRC0NFQVUB",
+	"v2zsT9/XpeEXfAU/lfanV3LF8wu+GkBmgDX54MJuG/sPjJdmx2abfFe8kvKqruIF5a2H62JHzl8ObbId",
+	"81DCPAuv3fjhcbn1j5FDe5ht2MgBIAdxV1FoeMV2igG0NF/iP9sl0hNdqt/gn6oqobeplinUAh27KxnV",
+	"B06tcFZVJc8pIPGN+wxfgQkw+5CgTYtTvFCfv4tArJSsmDLcDkqrKitlTstMG2pwpH9VbDl7PvuX00b/",
+	"cmq769No8lfQ6wI7gchqxaCMVtUBY7wG0UePMAtg0PgJ2YRleyg0cWE3EUiJAwsu2TUV5mQ2T53J5gD/",
+	"4mZq8G2lHYvvzhNsEOHENlwwbSVg2/CBJhHqCaKVIFpRIF2VchF++OSsqhoM4vezqrL4QOmRcRTM2JZr",
+	"ox/i8mlzkuJ5zl+ekG/isVEUl6BeWjAnasDdsHS3lrvFgm7JraEZ8YEmuJ2grHk/D2jQmpljUBw+K9ay",
+	"BKlnL61A429d25jM4PdJnf8YJBbjdpi4oBVxmLNvHPwletx80qGcPuE4dc8JOev2vR3ZwCgjBKPPGywe",
+	"m3jwF27YRu+lhAiiiJrc9lCl6G7mhMQMhb0+mfykmaWQiq64QGjn8HwSZEOv7H5IxDsQAtPhXWRpCQdt",
+	"VKhO5nSoP+npWf4A1JraWC+JakJJybXBdzU2JmtWouBMhSfomFRuRRkTNnxkEQHmG0UrS8vuixW7uMD3",
+	"vG1kYb3jxTvxTkzC3HyONxqhujVb3ss6k5DAhy4MX9KSipzpS8XZayXl8ggnfVHK/CpbU71OHwL44g0i",
+	"2JasGS3cC9wyLHjP+adE8z7ZGdZSf/6fT/7jOag9afbb4+yLfzv99d2z9w8f9X58+v6vf/2/7Z8+ff/X",
+	"h//xr6nDm8vNhpsNE0nti/+G+h48z9LMI+01qosoadS1pKQLVj7vrmtOHvzLgzleBPClFhUt8H1KNfv0",
+	"qVXUAGdyGLn49iz77MnT06effd7CXi5RhWF5GvzYoH7uYDPS0BLVaiibVIppJszcyhsZgpRdMwU3DI6C",
+	"nU/Ii5IDVZGcCqIYkFltGOGGLJXcwNzaPVO1XUW+ZvlVND2hK8qFNtEmp3e3twElo8s03QBbZoIsHMkS",
+	"ozgjrGSwIfdPKPjsTMIppMhgFUTIgumAMdyQxhDIGSnkjfCUVFHFRPgM3WFJk67I3hn+QRYsdUkCAEP3",
+	"kjTtcxkj+f6Ru/cKdWA29mJqWB9wwjVZ1Lw0RF4zlbpQ57PRg7CfgdmZsTuJu5OCGkqo6Z986N0ofBp+",
+	"4+W1XArNhK5RdW9kLsvE0fHmZw1nkosex9H3v2eW06Qx5rTCPcbm989zqQS6qB7E2H2vsSN9Rfdc695w",
+	"PGzuNVN47OZB/eXwNEVqw9MMuMLd1o7JN4IYqKO1rFXOUC0stwFHDTl0TjLc+QD3t1Svj3XXf5s8KV/G",
+	"VzveKtMwiqNNwc637hjSlhTRLPGVXOkjLLGUhzxXquoFLUuYus+BO6vFgScJ72VJoDFhG45iBheRVd1e",
+	"peQrmq8JrSqS07KcN+YhWWUlu2YlUAgXAixcZk1NI/DjyF6XibKzZvDAMYxEq3GmJTSrqWB/UIxsKL46",
+	"N6DBrMp2n/Bq0nTDOpoPFBtkjZaDSLl4/tKvjl27CzEMjeCHNWp/dfrBT8hZ+IQzC2kXZ61+xvPMgL/w",
+	"RmgBDa2bN7RoppCqsHZq5FJckVwqO4R91bvJ4T+Mqqazpc5PKsUyN4Si10xpWtqj3VrUw0C+xzqde04m",
+	"3FPRyXRUmFa6Ws6B/VBWZiohT/yI/6Elgc/2PmQR9XBUQMjIhaqwj3FAlZ0JGqCNVZKNNV8SuD0OgvJF",
+	"M3mazUw6eV9Zi6mXYO0iwg5dbnmhj7VNONjQXrVPiG6Jhv2LeIzpRHNNQcClrIhlHx0QLKfA0SxC5Pbo",
+	"T9kv5TYF05d4z7WfsXLLjrITcmv/M03wltuXDjKp9mMex56CdFigoBum/W0fqxjmkS/O2UKq22kQOhdM",
+	"S4CkMGrDHGfzDpKwaV1l7mwm3sm2QWegxqlzXAjoDp/CWAsLF4Z+ACxoQyPg74CF9kDHxoLcVLxkRyD9",
+	"9HPHKSQuvj377MnTv4H+QYISQa4U3RAQuTX5xJniiDa7kj1MPudQukiP/vkz75fSHjc1jpV1N7TqD2X9",
+	"XdybDJsRaNfHWhvNTnp3AE7iiAyuNot2Yl25ALSXbFGvLpgxoN1+rW6pSBvjNr0ZUtBho9eVAsFCt32D",
+	"nLR0WkCTU7Y1ip5W2JKJAmke18E11ZptFkchqqGNL5pZCuIwWrC9h+LQbWqm2cVbpXaqPoZJgyklVfIK",
+	"9i930ChoLhNGideuBXEt/HZV3d8ttOSGagJzo8dSLYoB2wO4Ik2+v+zQl1vR4Gb0BrPrTazOzTtlX9rI",
+	"b14hFVOZ2QqC1NkyiaD+jJICO6Ks8Q0zVv7iG3Zh6Kb6cbk8joVT4kAJHQbfMA0zEduCcEE0y6WwDvx7",
+	"zDRu1Cno6SLGe5aYYQAcRi52Ikf3mGMc22H124YL9NXTO5FH5iyrtCxWTE3Ax3Sz1RA67FQPdAIcQMcr",
+	"/Iz2+ZesNPRrqS4b8fUbJevq6Oy5O+fU5VC3GKdJLKCvN/1ysSrbQSMrgP0ktcaPsqAXQYlg14DQI0W+",
+	"4qu1id6LtzcujcKYmmVUk0ZJCX36KiNQmsNia30EUbIZrOFwQLcxX6MLWRtC0UqAm1/rtJA5EGaA/s3o",
+	"lm1iuRX1E6D3ZkBdOa1htXVF0Om4b+4KHTOa2xOaIWoGFLmNr6xtZaezLuylYrQAZRATRC6cX6PzuMRF",
+	"UvSYDkYOJ+ImtfIRXJWSOdMaXEes1nMvaL5dY3oZwhMCjgCHWYiWZEnVnYG9ut4L5xXbZejfr8kn3/2s",
+	"H34EeFEZvQex2CaF3q4+rQ/1tOnHCK47eUx2VlNnqZYYiVJ5yQwbAOYwnAzuXxei3i7eHS3WkPSBKd5P",
+	"cjcCCqB+YHq/K7R1NRC15p7pIOHBhgkqpBesUoOVVJtsH1uGRvFaNKwg4oRJuzcMPCB4vaLavHFmvwJ1",
+	"mvY6wXmwD04xDPDgMwRG/tm/QPpjN7ZINwLRdVVJZViRWgN6YQ3O9QPbhrnkMmXnNJLUmu0beQhL0fgO",
+	"We4FjH9QE3yunBdXf3HoRwf3/C6JyhYQDSLGALnwrSLsxpE7A4Bw3SDaEg7XHcoJ4ULzmTayqoBbmKwW",
+	"od8Qmi5s6zPzU9O2T1zOFAtzkkIyjQYU195BfmMxa2O21lQTB4d3q0N1jvXR7sMMhzHTXOQsG6N8fOJB",
+	"q/gI7D2kdbVStGBZwUq6SzgE2s/Efh4bAHe8ee5KwzIbfJPe9IaSg7F3eGiJ4yWY5g+S4BeSwxGEp0BD",
+	"IK73npELhmOnmJOjowdhKJwruUV+PFy23erEiHgbXkvQSnl6QJAdR58C8AAewtC3RwV2zpq3Z3eK/2ba",
+	"TeDb3GKSHdNDS2jGP2gBA7pgF9ccnZcOe+9w4CTbHGRje/jI0JEdUEy/psrwnFf41vmO7Y7+9OtOkDSc",
+	"k4IZykHJGH2wz8Aq7k9s2Eh3zNs9BSfp3vrg95RvieV419w28Fdsh2/u14ypN6yqze09XafB3ppnCuTo",
+	"uBV6BH8mxpS7azZcLxhIewVG4gpT7uyK0LYeKW+O8TpPjEq4DZyGBfi4LXhUxE3Yluam3BEKMLMduWGK",
+	"EV0vrFNG30JkZJXFAyQtTiMzOntz0to7agC/wKGi5aWcAe0rZxy+y85Tp4UO97qppCwn6Px6yEhCMMkb",
+	"hlQSdp27IG4fxuvPRgtIdw2VOw+uu/xiNOMKyH/LGr1dva+rl9KkQtEH+uIMXEdzOpe9BkPOHTVg59Gj",
+	"7sIfPXJ7zjVZshuf+eDRoz46Hj1CzdRrqU2LXRxBwwsM5DxxIXYd9Lpccr8Plxt5kntbZ3A/KZ4prR3h",
+	"wvLvzAA6J3M7Ze0xjUzzXzPbiSu/bHs89daN+37BN3VJzTHscOyalhn4wCpesL383U3MpfjqmpY/hm6Y",
+	"1YHlIBAvecnSb19oUdtzZZuF1YVR58GB7TeODwZrhkTheVEvnReQi9FxQTn2HYTTG0VzluWYCuH+3Vx7",
+	"IEzEJruEPjaBA4zDBTfcB29O3RJ2bntd2E571AaNuzTfbFjBqWHljlRwwRbWksJ1tC0nBIcl+ZqKFT4C",
+	"laxXLkjJjoNXXq2tug3Mkt0hkoKy2YoMDRepK9C5HrrLDkVkRuGZ3rV6WEHhhob5WNG6GSfuQdcKlDR8",
+	"zmeDWgxA6nWjxbDIaeffmHAdtmT4CD/NxBPNY4g6kGf7+Iq3pWEnoJRgyGSOwVe2FVdsSFHKN2weGSoJ",
+	"vh3w4LNK5us5/ldbYAjXpOA6pwpDFY3PZoPEZt/caeIaoX3Y8aAgk8t4unmHJenOd9QtA9esaeRwi9oa",
+	"KQYgcV0zPgBOxOjDvH6+pDEfPYeyqbEQzgQXLwJiHwpNBs+lNyoP75+3ORMzjs8JJO9ppfGEjxDWXmwM",
+	"2pRzYPkWHoMUat/PbYsPY4Nshh4ErTVxFLbYfByKXAT9Ybk7wpvHDgSPMBsBplt6d22/ymWcaMoRvd5p",
+	"wzZ906Tt+rcB8nwzqACTouSCZRsp2C6ZW5EL9j1+TPW2UvJAZ3yvDPXtKlVa8HfAas8ziQTviF/c7e71",
+	"1DXB66+lOpaPhx1w8pt/gkvFXv8hN+VtHT/At77vK+HS0HRvPz0P0QdcEaq1zDmy8nMIWeSica9woYht",
+	"9L8OwfVHOHvdcTtOAXGGMzR6sbIilOQYgglNtVF1bt4Kikr3aKkJr1SvXRw2w7zwTdJ2n4RZxg31VlC8",
+	"JoMqPnlpLVniYfA1Y94ao+vVysrzrWSojL0VrhUXpBbc4FwbOC6ZPS8VU+gaemJbQuDJEmjCSPIbU5Is",
+	"atN+/GOWJW3AqGM9FGAaIpdvBTWkZFQb8j0H/zcYznsx+SMrmLmR6ipgIX2FrphgmuuBYMFv7FcMVHLL",
+	"j2MHXWfvRX/fLxkPOy8GIT9/6RRj5y9R+xHFHnVhvzeD5oaLLElksXtah7bIJ5jvzhHQw7a236zZWwG+",
+	"h0ZCukVeUHM7cujeML2zaE9Hh2paG9HR7vu1HqhTuAOXIQkm02GNR0oTAItPZ9tC4dMl0IJWZFkLu5X+",
+	"6WmTyTTBovOQUc0mW35OMN3Wmnqvdffn088+n82bNFnh+2w+c19/TVAyL7apZGgF26ZURXHU1wNNKrrT",
+	"zKS5B8Ke9A22zmrxsBsGOka95tX9cwpt+CLN4XwMplM5b8W5sBFLcH7QZ2PnTMFyef9wG8VYwapUoP6b",
+	"tqCGrZrdZKzjR2dzD8wJP2EnXZVvsfJR/hQD9+PkEBPYRDgHltA8VURYjxdyUNhwhyzjeC13+eujP4fc",
+	"wCm4unOmQhQefPPVJTl1DFM/QGy5oaNMagk9kv3Q9rA0hLaCZN+Kt+IlW6LqTYrnb0VBDT1dUM1zfVpr",
+	"plwqhZOVJM99UpmX1NC3oidpDWaHj+Paq3pR8hwMdCnytBl/+yO8ffsLGHXevv2152zWfz64qZL8xU6Q",
+	"gSAsa5O5fKWZYjdUpYz5OuSrxJGx9+isVsiWtYvbt+MTN36a59Gq0t28df3lV1UJy4/IULusbLBlRBsZ",
+	"Amy5DnmJYH9/kO5iUPTGKxVrzTT5+4ZWv3BhfiXZ2/rx408ZaSVy+7u78oEmdxWbrFoczKvX1Sjiwu2z",
+	"EoNvsoquUqqzt29/MYxWuPsoL29gC0DQxW4xTkLEFA7VLMDjY3gDLBwHZzjCxV3YXj43fXoJ+Am3sJ1F",
+	"6k77FSUBu/V27UkkRmuzzuBsJ1elgcT9zoSU1S7VjXUvAzsuHAKX3XvBbG4cl3aZbSqzm7e6y2VL0PSs",
+	"g1vdpwuZxlw7aJ+ERN1V4bWSVOy6uTm1DRHDQd+wK7a7lE1G2UOScbZzQ+qhg4qUGkmXQKzxsXVjdDff",
+	"ucn6yHmXYhGj0T1ZPA904fsMH2Qr8h7hEKeIopW7cAgRVCUQgR2GUHCLhcJ4dyL91PK4yJkw/JplrOQr",
+	"vkiZ9v6rbw73sLocUYxf+1wHYUANFnJutM9C4p73CgxMhKK/XCU1LW1piKQXGr6H1owqs2DUjBq5RJxV",
+	"z0MH/ckNnCyr4ZvDEtgW9psb1NgJdsMKpyiybVw4xsmwQ60FnBW3hMd3b14KJ4NvXYe6RNp0fysH7IZn",
+	"rfM1junsch2+Y7ahlZI3GhOOFUS6kgE2M2V0v9SargasHS3PgIlJ/VoGfxxkn0SSlEHAVtwWNXqSQBJk",
+	"2ziDNSfPMIMvcIjxmdnxMPczWf8QZzDFSkAOYYsSBdjgim/3nqqWE4VYjYGWZi1MiUYU9GC0MRIfxzXV",
+	"/jgW84jLTpLOPmDuyrH82ueRc3RU2SFkz/a3YZeD9t79Lsu2T63t82nHj/4JubFtVrA6vR1SoGhasJKt",
+	"7MJt405Oqwc62iCA48flEnlLlvKzjhTUkQDg5mDwcnlEiLWNkMkjpMg4Ahv9nnBg8oOMz6ZYHQKkcFlr",
+	"qR8br4job3YymC4MBYtMVnC58gFje+45gMut00gWnRARHIZwMSfA5q5pGSVqbAbppXnGB0UnqbPzvHs4",
+	"9NAYMU3ZK/+gNWGPW60mlmY90GlRewTihdxmNuVC8i2y2C6A3pPBWNAreTBtQu0HGpOSgX8qXi02+GcP",
+	"LMNweDAaADBTMqwd+w3JWRaYsWnH5dwUFWrySZA6G3IZEvSmTD0gWw6RyydRjuxbAdBRQzUF55xaYq/6",
+	"oC2e9C/z5laLTP4+zjV1/IeOUHKXBvDX14+1s1p/22QvH86Q7BrdTzrvvmbpLmnWbWcERB+UZb1LDi0g",
+	"RrD6uisHJtHaatXBa4S1FCvB9JY9o2QfbZqVDB/BWUs0za7YLv2WZ3iPX/hukbIOd4+K3cPIf1ixFdeG",
+	"NUYj7xT3MdTxTcrSwdWZSi1hfW+irLNxstJ4mfe+AgwpWnIFsStgcUsuARp9rVGJ9DU0TUugrc0mtmIa",
+	"L9IcF6eFKNSCl3WaXt28372EaX8IF42uF3iLcWG9ExdY4S8ZiTEytQ3WGV3wK7vgV/Ro6512GqApTIyZ",
+	"gNtz/EHORYeBjbGDBAGmiKO/a4MoHWGQUQaNPneMpNHIp+VkzNrQO0yFH3uvl5rP4zF089uRkmuJ8pqm",
+	"fS3lagWhnzZdmbeHiSgrZinFKipFW1VjSUBPoP6Rdqk0R7JwuigcNhSDE4n7GQeLbRr6qJmFvAkVxgyi",
+	"OAmY6TH/UlotJFd7InywRaSru2dbaDf+JxkDcdkxZjc+q3aXwnbiBpSMFu5Noplf3/ix7G+IQ918KHqi",
+	"Vb5h/AjhgEhT3ETVGft5VQYYMK0qXmw7hic76qASjB6kXR6QtpC1uMH2YKAdAZAkuFY9IBdn4BTsp/jm",
+	"PYVXmQ08cF71QN80dxlFilqhBaPl1t8vPhXeahPX/t3PF0YqumLOCpVZkO40BC7nEDREpZ00Mdy6kxR8",
+	"uWSx9UXfxnLQAq6nYy8mkG6CyNImmpoL8/mzFBntoZ4Gxv0oS1NMghaGbPKXfSuXaxurksKVEG3NLUxV",
+	"yfwj37Fd9jMoHUhFudKNe64zO7Uv3wN2/XrzHdvhyHu9XgGwPbuCmqc3DGkwpekPn3SU/P2BjjFmn5et",
+	"LTxgp87Su3SkrXGV5YaJv7ll4hV1lnKXg9E4SQAsU3bjIu2bAKeHtRHfJeV9mzAUHhJ1iuX9eCqufR3+",
+	"/lUUkuvso13IjOmJF5czez+f3c0TIHWbuRH34Pp1uECTeEZPU2sZbjn2HIhyWoH/Fi0z5y8xdPkree0u",
+	"f2zu3Svu+SWTpuzLr85evXbgg0m6ZFRlQRMwuCpsV/1hVmVr0Y1fJbZ8gVN0Wk1RtPkhxXzsY3GDpQo6",
+	"yqZeZcfGf6YZz/tcLNMO73t5n3P1sUsccflhVfD4aWye2Lnj5EOvKS+9sdFDO+CcjoubVh40yRXiAe7s",
+	"LBT5fGVHZTe9050+HQ117eFJONePmGs3/eIQLhMvsiLn/EOPLj19LVWL+buw3KTz0IcTq0DItngc8NX2",
+	"Rfi7wtQJsYLX31d/h9P46FF81B49mpO/l+5DBCD+vnC/4/vi0aM+0Pa2SzMJ1FIJumEPQ5TF4Ebc7wNc",
+	"sJtpF/TZ9SZIlnKYDAOFWi8gj+4bh70bxR0+C/cLmGPhp5Mpj/R40y26Y2CmnKCLoUjE4GS6sXX/NZGi",
+	"61ONEeBAWjY7jK0xY42x/SMk6g0aMDNd8jzt2iEWGtirsM6U0Jhg4wFtLYxY8wHfXFHzaCxoNiUJdAfI",
+	"aI4kMnUyD3WDu4V0x7sW/J81I7xgwsAnFaoKRledfxzgqD2BNK0XcwNjn2j4u+hBRuxNXhc0pgQZtd+9",
+	"DDYlv9BU5dIDPcDjGXuMe8R729GHo2YbzbZuu2BOe8d4g15SfeAsiJ7ROWPdwBxNkXTsZxNecZ0tlfyN",
+	"pQ0haD9K5MFxE+FzBHunPPe6LCUYlf164tn3bff0t/HQxt/5LewXHUon3+YyTZ/qwzbyNo9enc4/P5/F",
+	"RzINl/1I2qEBA6wFj1fkDIsJXLz3ERX2PNkUKK0Is/SpjFroUzt+cyodzN1dzUt6s6D5VfotBDBF29vy",
+	"kzKS+M5+A3RI8GFnJ5EHd2jLbWrMiqnGBtFPs33Ld42ddvKLpnnAQMfW08WWaaWllolhanFDhWHejcHy",
+	"K9dbM2uCh143UmFiW5126SpYzjdJdezbt78Ued99p+ArmMmmfSV0aVz+CjcQsdlzkYoKrquS7kLaGoea",
+	"8yV5PG/OpN+Ngl9zDY7M2OLJ3JUG1XhdBnN46ALLY8KsNTZ/OqH5uhaFYoVZu/q3WpLw9kQhLzgmLpi5",
+	"YUyQx9juyRfkE1fM8Zo9BCw6IWj2/MkX6FBj/3icumULtqR1acZYdoE82ztrp+kYfVLtGMAk3ahp7+ul",
+	"Yuw3Nnw7jJwm23XKWcKW7kLZf5Y2VFBASAqmzR6YbF/cTTTnd/AisFHBtFFyR7hJz88MBf40EPMN7M+C",
+	"Edd2NZJouQF68ozUHzY/3AmeDcvTA1z+I/q/Vt79r6PruudnDN2k6YGil/IPaKON0YolXDEBBm880x1D",
+	"PCHnPlk6VgQMhQAtbmAuWDrKkrCFmCWMC4P6j9oss7/As1jRHNjfyRC42eLzZ4nKeu3iU+IwwO8d74pp",
+	"pq7TqFcDZO9lFtcXouBFtuHA6h82ORaiUznoqJuc1gz5hY4PPVXyhVGyQXKrW+RGI059J8ITIwPekRTD",
+	"eg6ix4NXdu+UWas0edAaduinN6+clLGRKlUBpTnuTuJQzCjOrlkxuEkw5h33QpWTduEu0H9c/ycvckZi",
+	"mT/LyYdAZNEcC5YHKf7n75tSDmhYtZGIHR2gy9jWls+d3u6evQ0P07p17bfWYQy/DWBuMtpwlD5WBrzv",
+	"8eemz8fwF+qCZPe8pXB88nei4A2OcvyjRwg06B1t078/bX+27P3Ro3RG9aTKDX5tsHCXFzH2Te2h0/ro",
+	"S8VtPrYXa16mVC4khw+WL2NtBKe6rKjxtcjjeutN4ospxTYvo/xAMLzIMJ0ETolhizYObUO5KOxFCz+4",
+	"lMPOv7zpcUJ+dOXCQy4bC3sEsRMobXYLP9LcmZ8xnsulQw63jKvD8hGumRH3PXTktFpduYyWCmucE8VK",
+	"CsGosFrnF8aGYjIAfcPBr83IXHtcAxVM0H8FXzeYYBIJQg2xFAUGurgD/eEiFBuKTnJfPTJhorl1vLSE",
+	"EJyVppVJTh+ufX4zAcYktmSCFHzd5OAB6BKa9Nc/KFXCB5BaFm6oOWnXqL1/sf84AZlp9/D0tQXe4PDF",
+	"4wH/6CLiI0s3uIFNWNHw7dyu0Z0kmSJ8jwJTKPlSbqcSTkdo9MTzO0DRAEom6tNxJb0a5En/mr0OXhGN",
+	"wqgLBv7gulWWMDbA/XHwDIufj2AbcvD+3CRj7Eh+iop8nXTrx+S9f7OP6pbMbGWbFNbyNRWClcnhrDLq",
+	"b17ySKjV/iGnzrPhYmLbbg18u9zO4hrA22B6oPyEgF5uSpggxmo7z13Io1KuZGEzIDdltRrmeDJL7FW/",
+	"xHaPBO2wm9o4R3NM3uAyhC15Cf8bcPTAlpmiZiDjnXI5jMOI7JqBaRk1LHZ0pgjlG5SkNYVah3gyrxk4",
+	"9EJXKVinO+Y8xJGjmllEV/AJW2KGGUlMreC6X0bLYMJwxcrdnFRUazvIY1gW2+Lcs+dPHj9O6qkROxNW",
+	"arHol/ljs5Qnp9jEfnFlHm0xooOA3Q/r+4aiDtnYPuG4qtZYqiDFU/GDDTWHznhr24rWofr6CfkGU5UB",
+	"EbdK0wA0IeV9OwNuXZWSFnNMxQ+udMTOavsohojCitorgL9D/kl76PSMwD4V20Cqq+njjOfesUnHs5Fc",
+	"5a+wRVOim3ec5FDxHmPnhLy0Ng/tH0B2EoIFHdSGFVHuc6t1Q+KA/xhD8zU0kC0JaJhXTi8F79lZY2qN",
+	"woWv/Udk2AC3qwZvi8HPiYQXyg2H/OJratg1a+cv9WAEmd7lM20vT9VCWEo5OUAYDdUWD0W7Bw7HDV5A",
+	"Scg6iD9QlaxlrXJ2aGX8C+yVDp7qlNnvuOn4bJi+IAT53lkDcyqk4DmWLkpJ0phrcZpfwYQqT2mHAD1z",
+	"JzRxuJLF/UPwvsPiYLn/+ayFuL6PTvQVNtVSh/3TsK0r+rpiRjvOxoo5anl5yZwFmwvNXD1NIKKYT0qV",
+	"8EJMRi4FVcKBZIRp1AZMEl/Dtx+cwQqOILnitkyCQ5t7n1kbMySeAWoXhBuykky79bTD7/Qv0OcE06oW",
+	"bPvrySu54vkFX+EY1u8Vlm2dvPtDnXmXb+diDW1fQFtX6SX83PLftJOeVZWbNBmCHna49wmqmQwhOOVo",
+	"6DUjEXLD+PFoI+Q2GquB9ykQGpTzINqwCu/hHmEwpVIvxK9sERCgKGxBbAh0CiklFwkwXnHhfR7SF0Se",
+	"vBJwY/C8DvTTuaImX7fY0D4P74GIJUwpkF8dY6jOBiNKcI1+juFtvNwKV49ngHGEBo3ET8WO+EMB1B0J",
+	"ExCvHHznUQhqm29AqnJCVIHRgC6FrxXL0owDGHfmY5xb6Nobbxu6Y+2oQ2+ioaSii7pYMQMJK1O56L7E",
+	"rwS/+qjOpj6XXEbhvO2iAn1qcxPlUuh6MzKXb3DH6QquqdZssygTft4vw0dWhB0GSgPdJPybqpg4vDMu",
+	"yuHgMHof0lAcVkmjnxYgJfUCTWeQMG06JvBOuTs6mqlvR+hN/6NSuo+v/12Ez3e4XLxHKf72FVwccabt",
+	"XkCJvVpCImwM3pD43WcoCylc21wJvvXrgqKbEm5eYss6wPuGScCvaTmQuiI2btr71Rr8hhJY5IP5Vqhx",
+	"+fQMJaMsaDBHmXXu75hL+zb/IYd+689/PDOjW+soQoeN7d+1TOvWqbNhFoMm9dtZvZsNPtTs/d31UE4T",
+	"X1gHv8cFfJzb3dzZHNk1l7XbsBC04J+E9leXM6tVqGdg/clQoI9ttRi0sVy6Cvp2me5N/t3P1m2CMGHU",
+	"7ndgceltercKVELaxRYRwboncE9rNvCobd2KU4pOpeobOdnQ68osa2nRUq9eVI+sXk4RB3r4eD+fnRcH",
+	"XZipGlkzO0rq2L3iq7XBEhvfMlow9XpPCZGmbAgesUpq3tRAL2Ewl7N5jcOdTI0OAgLmcQmU/ljea/ya",
+	"5QYL3zfesIqxQwqiwGTe6PNnKZHh53QIonIVRMbKhvSr3e+543uZzqJsfSy4QUwsknEWYh5syCaUdQ35",
+	"lTpJDiaHWi+XLMc05qOZ5f7LVhX2WcvmXi+DsCyjRHM8BB5iIv7DtY4NQCW9JTwlPR44Q4knrtjugSYt",
+	"akgW+g5Rt7fJ9I0YsCYwn/R9SJHs3Dy5DpSBWPA+/LY7a6rZDCZpj/Ik3nIuT5KExrkTR6a8lobdci7o",
+	"elCeVoyhG0o+95ox9Yb5TOV7mZYKTe3NUDGfZG3D9YJBWuICs4pDzr+EbZMKwYqsFoYntvUnwbeRRSWq",
+	"CIwdomRrOC1wShxvTqTzYOPL1mchjWsy+RiEU7CgIsGPmkyPmGoUVBaQiJkKPbfByIWELIhOiC1qFXC1",
+	"py7wvkOJVCOXS5Z8GV56FuB3gUvVYAJISOdSDXFMuFAZG4g6wxHOXzeZBBSpnlbu5/Txx7lSdQga2LDJ",
+	"nBQst7FaEu1QUNyBXPb2154IbogCFDf1J5d8VcOigIa/pOJyrZiGUIYIKKdP7R4OXK4H1O11+nRgquJI",
+	"mBx+nb9khvJSO39vGvLoxzosUMd368DdUB1OTGNZ9Bn5mfa/+ZS4dpaSX7lyOMgzrB0Xsij7FkfJcYjN",
+	"CE8DvQwz8yYese8C1OeANrQ3LyUI2dlQfHQ7BDD4zz/QNtChyUeHcC2ZUqwIBsNSapYZ6al2DI4xVGiM",
+	"5rgVEvRgNT8L3GAlhzdNqQqsakqxcgN1QRzxAiMf36agxPCcY8h+Yb/7nDLeE3iv/jXQ6/7y4z4Slese",
+	"EmOqXxJ34ezPVXMbVSwXgqnM22W71SVEO8EoppEu6tyKr/HBCOrqyd6tI6wkqcXM+6vsvKCjnC9XbHdq",
+	"VQQu+0vYwRho+66woEf5szubfFTltE7BvToKeB83LWolZZkNmALP+yUxuhR/xcGlisBN4SO24GX0oH02",
+	"YBLyCVqggq/HzXrnS0BUFROseHhCyJmwMbLe7aNdLbczuXhgxubf4qxFbavUOJXzyVuRDjbE+jHqjtzM",
+	"DzPOwzQTxZ2nsoOMT2S2Ysgh7QZrzbSLUp9M1Vn1HTG6YklDVBaKlExyYe25L/Cgp9SqmNEnSj2FZn5K",
+	"nB2Y6FKmPN1vk3UIhkpjKp4MATJMTEl+E6BwgycR4HzcorqbyXdLSXN3ANAw4vyT3cbbKGqwldhngo93",
+	"RxcJDCk02pfQ6FS7sp6GV6wyc5taGv4EAVbxomDCpRpsdY0LWaYksxERyMVpzNtkPSDQDBclbCeQYHFh",
+	"HgXF//CEzJ3Twi2UKofUhuvM7zvgpRDG0Vbqt4XW0kkHjlJabKiC0+WadVRZyfJN81CviS89IT0/Shmn",
+	"H8VB/acWcbr3Ek7pBD8TDvaP9kglCMl/0VG1ivbxnq5hvFy3Dm//YjgkA158zEcsdprYd0U3716fuHx1",
+	"J6Quxpx5Z5KIFrAZV6wKOE15ngxUFjqLq9h8GBCjFE5jEHoj9qQxo9thSDc2SoRJtPWdx5jRTZSzTmzq",
+	"xMCYbEK+3+Nn9E352RWzeMw9ODoYM61Mqh8aNXfMbDqEndawowhKEXYaU3HCNIcWr0KYLizYb0Mp5Eaz",
+	"xF0m8pVFHUcywMXX+q1SvnmYxjD5pdxOQKAL5HQRrnI7tyoZhMi0GdStqIzIm+ChsJADxR7Sfr+XUdKQ",
+	"qPvvxssgQt1HAm/otDlX3/3saE/JC/c5umoVa3yub1vdwhWMGLz0rQG8O3OYpa0AWUrF4hnxlWIr2YTE",
+	"LnAZEvzPghtF1e42NSjaqEo5Gwxi+bWs8N/ijcPeGfQZWLlqokStNta/w2GlfOWq3liUxG/qOdHS2cON",
+	"tp4XmDjF4s0lNAXCKKJN5KbBKxctPJ51n29GgoXUerw6vGMeYZ/UAEyoCLwDeDMeCdVfuf/aWXf4GRc+",
+	"4nG5V94cCqYaZWCD21BVIyBNNVJNlSjDXTMIjv14dIAGyoZert3ud8CBwOGGgpGKyGNvcgcH5fYBtpEn",
+	"diBfb8Cq5Szjh9xEXMCNpFkY//xHYn2mp+dJiOOyDzq6ewMPQ8xhc3aauMP+AShLeZPhCrNQgjn1gIB2",
+	"uq1Yd8VCm9LN2h3JJoKRamd02ZE1LUgulWJ53COtFbBQbaRiGRQbSyYBfsWXRpOSb7jRBCv8roisclkw",
+	"W8o8zfyH5nKcKAucaBAFnn3JBPeaOCXox63HdGZNoFMfRJfQxyZVbQoO2EVnlgIHYvOZdgUGHIZs4z68",
+	"SDg2I3fXay4tKC35FumGqdRtvSRG1WxOXAscvUVCeD0AL99wrS0ogZZueFliTlO+ba5yFkJ0BvRJ7mYL",
+	"G5nRgavtNc2vhm6hYQGiCbjq336+1h1wleathLgjb6xUpMkAuaVXM2CQO8dw6GuOMXPtbL3Yg1SK5Syk",
+	"MI4v0Yu4vgAxayXr1Tqq5Biw7l1VVO0cWeJRftI1hjUiO4QpnpGN1MbZwO1IzQY2oaKf5FIYJcvS24as",
+	"M5lzBHAest/T7Vmem1dSXkHW3YdocUe1nFtpMfeJTLtBvc1MqlPDI7ZBoEVOev3T1LPXUiJoH/2G26v3",
+	"F9ez7QBczxwP1rA4Bt/zit3nZhqB+ev+i2W/0+1Zf2HddbXvmLSl9kwQauSG52lW88cKtx0Mkh2gnr7x",
+	"3p9IR89x5H/7DKO63XJBVviaJPjKcOcRnYi8cbbrvtSM47JEK2bPVl3ZrAttqZtopjWXQh8iOodltmsR",
+	"N8lK9eHaxY46ebLs3IOlp7uKBOvb6GXHQBoQVXswRe9294DxXii318fGypaDBMxYxkgdWtvDZTD3Dly6",
+	"JW2GOECUcfqkwwRNGpfOiLv7XDwUUrGsrO6nNy5ZMmp6c0eSbkI6sKlVJsyMINp8uqZWqDCiMQRuJL+D",
+	"bbHK5VzZ+Qi9OanaQb4+vl0wZYMkMV42khK+Qgy9ZNesBMSdvT5PL8jZaLN80JK8f10tO2+QDOTKagTx",
+	"EdTF/EQ5F1d1N9hghKMDZdidgOplHggAfmK5y9zaDsJb0n9/2FRquhXwe45t694eCq++aM6KwiahqsPA",
+	"ZZyuBzsai3yJOaIXUyOSg6w88c0RATAco9yCYVKk8qFgLCkvWZFRMyCgowfcPLLMOt/naHTuZGuvHLNC",
+	"NzwuKC9rxVyVAasvVG037jipJzTv+6mCzyOzqrLfmJIYY1bMo9gHn06042okq6wEztNW5gEt6xofRuAv",
+	"7frq0JkUjFVMOa4WddVj2p/ErenWnkVRrVOwm/TTsoi1O0X2OGElfar9c/KuQI29MSVKYEsezMnppyWu",
+	"Zd5fRTqT+1Zk9nzrqTwAoL7mRU1bG68PFTza3pHAgxJ73Hv/Zh4dU6f5yY7gFdj6zPdPPX88Jn6dxkAP",
+	"5p1p1I1xzr3JFWo9xK5EOrdCXJAk+J3jbEWI3rJns2F4uqI3YthPs39WG83VxH3iUkSI/WrLcpQvneqI",
+	"FU55NKp/t6fIysH2pbkSCSfkNRNEyEaDhE6aXk/SVErzP9iJsREXTjF5C5eRJgXC3XeW4GBEd0omJXfC",
+	"c6YipemacoCGbEAt5nE3j+iPcspHD/ngeCn608zlIxyxMPqT49Qg2EDWZUEE0AroIiCeyl/t7haZk0Xt",
+	"BwJ1YN/W9ZL50BNL2d7r3q7I1zFC45xF93zYnhYS6IA9TCr8R0hD/lnTki93yMMs+L4b0WsK5OliXWyI",
+	"oktLAROPy5xzD5hXiks/lV03nzpmNNzO35JuJJBunFEVS/1csXgbMPrS8ubcAFPW9QIVzCDHdLazjwW3",
+	"eF/kYUOLWIWJpeZ2Lc4T38//o0nOF0/lK0ShQ2vhN0/TTcezGyXEQFy3sVl6Emhsl4FogxmsuIVR+u6W",
+	"TetZtw/s6G3V8qo70jIm2tY7VfoPMtUmlnLsXbiTMTfzboB7wG+7DN4H/pNVIA+0SbfA/73gfcRm7eF1",
+	"tusPj+Vx07M3Ki7kNlNsqffF9GHrjpE92I5ahvLGSB6KHHIB2gGbpCKEkYRRCrbkomGWXFS1STzuUIst",
+	"dhHCYtssonUgamFISgBB9ZqWI9r6S4xCQYVtp8i8t0e7vgm9TrhT+wNw3bwhMWFkY+2Mm8EFXvAlOLZg",
+	"/ghtqCioKuLmXJCcKUM5hAvt9O0N/8GGu8/0TyNppp3GOHICQNK2gJQ7F4dzR7N8AJAe0T4/wa5+uWaO",
+	"+tvKX6vvMnLAjN6H4Q9hV9/QLbhiYFrDgQPhqluiIwY2wxzlIEWhfDZt3X4ezX9j49NgCRjHiIzEWadM",
+	"MX7uf8StxCfqT4Kb0ZNvFbfdPJM2EYg9mB6pYtVkI7LE0j+PVZ6erGM5CCYjlzvL0x6LNpENGb5bxoKB",
+	"XcTIM5dXNrYMHGAcawW3JW4Yp3XIUBuhR/INNRYyxLV2aqpehG9XjWGREkdCHaB+tEYLfy8NgOf84Z3v",
+	"YWvaEKWIJp3Jsk8UkpeGqJJVlk8Js7e1/wsLgIe0DeOYY8QodYSIRO0tzy1qjETeB5o0oXKHit/WWu7n",
+	"2mt9r/KxR/+QCmqAo7ftMpAlhJals/+B5kyqWFEz7ya9a6vYApMglCiW1wp15zd0l3RExgTOmTvxAzVn",
+	"L749++zJ0789/exzWz+s4CumTeR+hIMEthFCsbno6pTu1229tzyT3gSfDtkizluZfZa3sCnurFluq5ui",
+	"hK3VH2o0T1wAieOI4aVNwqFb7xWO0+Qa+n1tV2qRR9+xFAo+/J6B/1m6bnyQqxIGnNRuRXYleIFUTGmu",
+	"0ZOjbRbmpklCodeoHsTqodc2vb0UOfO6aUcF3Ax4BKYWMpTDAPkZfCLOakXYtiodr7Lmr7F1uXea1dCh",
+	"0IjuRaDF8qpjuGFTEKF/tYpSfTrFJ2rbo7QEgdnaBAXpUoCY7CNNemfORgb0Nc7tG+upZ9QJTg+bmBAv",
+	"/KG8BWkO2T6GEynfhpM0ZoPfDf9IZIY+GtcIy/0QvCL5PhhJgnrWcwYJWZEngdbPEpwgDwRgIP1nK3Fj",
+	"lLkuqoyorJUA7QnegN0VP75vDNt7M/EgJL7DHvDifJ5Nu+Cf6MD5yHFq3wekREv5dYgSWsvfl23Ps95w",
+	"kURb5JQmxjBt2ZLsi4VR/lf9IqRVHXiV9LKvKikNkQJ0I4msrVaPg2cqJhwuDFPXtLx/rvE1V9qcIT5Y",
+	"8WY4G1WcujNGskWlvl3hoFd00twl/QBTi9eYKfa/GOxR8p5zQzkDf+82Q+UOLW0UzDKu9HuDY+JOkyef",
+	"k4Ur118plnPddRy48cJJyFTJFFjHQubD8dSY+9b5szR3IOOld08iP7RC85w/gIOwOaIfmakMnNwklaeo",
+	"r0cWCfyleFQcBL/nurhjaffb5aGPKsocmIe+H94/dXm4Drx0as3665x8W7dwm7iom7VNLaIwuUL827e/",
+	"mMWU2gfpau7QHYsvHKWs+0FF3T9A2QWLIzeGmzdFMT8PFeKzxeYGioV29qN29eFHrWpx6VfIWsEE01xj",
+	"cdO/LT5/dv/Z7jwENiVC/6haWO+Sv94iJrHW1uTRVFFR1wn1XF23RBFOTCSX14qb3QXg3yvQ+N+SBSK+",
+	"CcnGXbL6YEtzd5+RV0x4f48mNXmt/e36jaQl3kfWxCfgFpLlCfnKlhx1B+WvDxb/zj79y7Pi8adP/n3x",
+	"l8efPc7Zs8++ePyYfvGMPvni0yfs6V8+e/aYPVl+/sXiafH02dPFs6fPPv/si/zTZ08Wzz7/4t8fzOYz",
+	"DiBbQH3ihOez/5WdlSuZnb0+zy4B2AYntOKQz/39e3wrLyUsH5Ga40lkG8rL2XP/0//0J+wkl5tmeP8r",
+	"HCUFzdfGVPr56enNzc1J3OV0hdlWMyPrfH3q53k/72D87PV5iMSwfji4o432+GTWkMIZfnvz1cUlcXEO",
+	"oXzm7PHJ45MnML6smKAVnz2ffYo/4elZ476fYsGvU+1q+Z42IbVJu90b9OP3wrkC98hPQjjhvwXLrX7o",
+	"oxIxzoMLAgFkAF1YxXmBxGVcsMx8Zp9Z2pLj08eP/V44SSe6cE5hMPjN8o9U5Z7384Ro5ABOQoYdcB2p",
+	"tNxXQt4IgtWJ7AGqNxuqdnYFLWxEg+M20ZVGJbvi15grB3p3cV5VroLyEMoVZ9esfcp9ZySQUIKXCl+Z",
+	"14XY6BTK+9Wb74j90WpVvckSu4ONXgPMPrmAh8cbhBzO0GZsERbOCO5IH9HzWVUn0GmDg/QYzuZRVWAL",
+	"jSyLgPEeRl/X/59gFEjX3U2z5+/grzWjpVm7PzZAqLn/pBgtdu7/+oauVkyduHXCT9dPT/0r5PSdS0f2",
+	"fuzbaYQw+Ln5K+PFHXqe+rIHI/29x9S+JqfvfCqn94e1ngDE/hax2vXUecBGHSaibxRXC7k9oGkP5n0d",
+	"WIzkYYzi0dWn71CPMPj7qVMGpz+iPscKCl0wuy1tEuf0xxbO35ltYi+7Pba8iMbLwdpfV6fv8D94+qIV",
+	"2YqJp2YrTtH/5fQdL/qfe4ho/950j1tcb2TBPHByudTM7Pl8+s7+G03EthVTHB7TtGz9KpVh6lSxqqS7",
+	"Pnw2QPsUwqbLXf/nnciTP/YH6iVAHRFasIyT9l5j7Qo9yWuyW/ZH35WpT0uT3pk18ZDoS4xjK3s/nz07",
+	"4u3TLtGYAOZLWhCfZwfnfnJ/c58L670OIrQV9RGCZ/cHQWv7yHdsB/lmyddAtwDLZ/e5E+fCMCVo6QXX",
+	"W4q4045PV1xoZScWKyuQSZt7qX3UzoqiR/T2rcy0+VIWuxGMbfSqctboBmmNqoALWMJ82vOgt6xOMiwh",
+	"CzaLH/FG1ez9HXlCx4+NKnOeUJ2jDcglxjQ9UJNVaLpePnbkvppnHwmfv/STNnEgf/KUP3lK4CmfPf70",
+	"/qa/YOqa54xcsk0lFVW83JGfRAgwujWPOyuKZOW+9tHfy+NADQvW2hUTmWNg2UIWu8ypiloTXDGrFewJ",
+	"Mqdei9Z6GQ1wT6+fS0krjdv77PkvKfcPFyJaQcmunFgLAqrQQD8UabhCKbU285uPaGDmieTfpOBlHXKT",
+	"mBvpQuX7F0qkVTKS6H/alO54ELnZkRsuCnnz8MSD+8+aqV0Dr59mlgAw8mXuQvh1ZBgFAHtgDc2HFtUp",
+	"2BmZ/BW93dwlPXTqXz+0ri0UY/nPix9/iKIurUalqVLnyNxlhMHAA6zUZqgChyHywuq6yh1GJtviA1w3",
+	"4YQnf95Df/L+u/P+b0LtSkwrpA34eyRYUnQXnEwSeJO8/V3rT6fYmFm381RNPfidULLi4I7Qv6AWO3L+",
+	"svd6td26V8KXu/OX/Vshwe+7IB7E+AfYy5hIAwtZSROc7+2i/hQy/xQy7/RwnXx4prxdk5qlb3Bg2nuP",
+	"zd1d1w5wwrqY6MrWA2WK/umjHt+jbHxft5XSZdn6nRBd13xwxXY7aP6TRfzJIu7GIr5hicOIp9YxjQTR",
+	"HabrmsowMI9W0XIl9VKHb16XVEWBz/tU2Gc4Yvop+EG4xn0r7JK4KooQH8GtY3BiA4+rw/uT5f3J8v44",
+	"LO9sP6NpCyZ31npdsd2GVo2uizGlT1Vcc3/gy+k7+CWy9+l1bQp5E3XBZeAqEnbQUNqw9ffpDeUGnCQz",
+	"NG9mdGmYSnVWjG6cCbT52TBa4vbZVLjxrwXXVGu2WfS/qJ2qI6hbKQKTv57Sthm09Q2Z+VDHnuU/9dXZ",
+	"qgca+ewTez6f+vzWU9udvnP/y/bPne50SotrKvIAWeOHGfs14hUXPBp/+RWuJ83Utb/9Gje956enmChp",
+	"LbU5nb2fx9905+Ov4Si8C3emOxLv8QxIxVdcQCkk6++SNa54T08ez97/vwEAvrbpWe9HAQA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -177,238 +177,270 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x9/XPctpLgv4Kb3Srb2qEkfyT74qvUnmInedrYsctSsvvW8iUYsmcGTyTAB4DzEZ//",
-	"9ys0ABIkwRmOpNjxbn6yNSSBRqPR6O9+P0lFUQoOXKvJ0/eTkkpagAaJf9E0FRXXCcvMXxmoVLJSM8En",
-	"T/0zorRkfDGZTpj5taR6OZlOOC2gecd8P51I+EfFJGSTp1pWMJ2odAkFNQPrbWnerkfaJAuRuCHO7BDn",
-	"zycfdjygWSZBqT6Ur3i+JYyneZUB0ZJyRVPzSJE100uil0wR9zFhnAgORMyJXrZeJnMGeaaO/SL/UYHc",
-	"Bqt0kw8v6UMDYiJFDn04n4lixjh4qKAGqt4QogXJYI4vLakmZgYDq39RC6KAynRJ5kLuAdUCEcILvCom",
-	"T99OFPAMJO5WCmyF/51LgN8g0VQuQE/eTWOLm2uQiWZFZGnnDvsSVJVrRfBdXOOCrYAT89UxeVkpTWZA",
-	"KCdvvntGHj9+/JVZSEG1hswR2eCqmtnDNdnPJ08nGdXgH/dpjeYLISnPkvr9N989w/kv3ALHvkWVgvhh",
-	"OTNPyPnzoQX4DyMkxLiGBe5Di/rNF5FD0fw8g7mQMHJP7Mt3uinh/J90V1Kq02UpGNeRfSH4lNjHUR4W",
-	"fL6Lh9UAtN4vDaakGfTtafLVu/cPpw9PP/zT27Pkv9yfXzz+MHL5z+px92Ag+mJaSQk83SYLCRRPy5Ly",
-	"Pj7eOHpQS1HlGVnSFW4+LZDVu2+J+dayzhXNK0MnLJXiLF8IRagjowzmtMo18ROTiueGTZnRHLUTpkgp",
-	"xYplkE0N910vWbokKVV2CHyPrFmeGxqsFGRDtBZf3Y7D9CFEiYHrRvjABf1xkdGsaw8mYIPcIElzoSDR",
-	"Ys/15G8cyjMSXijNXaUOu6zI5RIITm4e2MsWcccNTef5lmjc14xQRSjxV9OUsDnZioqscXNydo3fu9UY",
-	"rBXEIA03p3WPmsM7hL4eMiLImwmRA+WIPH/u+ijjc7aoJCiyXoJeujtPgioFV0DE7O+QarPt/37x6kci",
-	"JHkJStEFvKbpNQGeigyyY3I+J1zogDQcLSEOzZdD63BwxS75vythaKJQi5Km1/EbPWcFi6zqJd2woioI",
-	"r4oZSLOl/grRgkjQleRDANkR95BiQTf9SS9lxVPc/2balixnqI2pMqdbRFhBN1+fTh04itA8JyXwjPEF",
-	"0Rs+KMeZufeDl0hR8WyEmKPNngYXqyohZXMGGalH2QGJm2YfPIwfBk8jfAXg+EEGwaln2QMOh02EZszp",
-	"Nk9ISRcQkMwx+ckxN3yqxTXwmtDJbIuPSgkrJipVfzQAI069WwLnQkNSSpizCI1dOHQYBmPfcRy4cDJQ",
-	"KrimjENmmDMCLTRYZjUIUzDhbn2nf4vPqIIvnwzd8c3Tkbs/F91d37njo3YbX0rskYxcneapO7Bxyar1",
-	"/Qj9MJxbsUVif+5tJFtcmttmznK8if5u9s+joVLIBFqI8HeTYgtOdSXh6RU/Mn+RhFxoyjMqM/NLYX96",
-	"WeWaXbCF+Sm3P70QC5ZesMUAMmtYowoXflbYf8x4cXasN1G94oUQ11UZLihtKa6zLTl/PrTJdsxDCfOs",
-	"1nZDxeNy45WRQ7/Qm3ojB4AcxF1JzYvXsJVgoKXpHP/ZzJGe6Fz+Zv4py9x8rct5DLWGjt2VjOYDZ1Y4",
-	"K8ucpdQg8Y17bJ4aJgBWkaDNGyd4oT59H4BYSlGC1MwOSssyyUVK80RpqnGkf5Ywnzyd/NNJY385sZ+r",
-	"k2DyF+arC/zIiKxWDEpoWR4wxmsj+qgdzMIwaHyEbMKyPRSaGLebaEiJGRacw4pyfdyoLC1+UB/gt26m",
-	"Bt9W2rH47qhggwgn9sUZKCsB2xfvKRKgniBaCaIVBdJFLmb1D/fPyrLBID4/K0uLD5QegaFgBhumtHqA",
-	"y6fNSQrnOX9+TL4Px0ZRXPB8ay4HK2qYu2Hubi13i9W2JbeGZsR7iuB2CnlstsajwYj5d0FxqFYsRW6k",
-	"nr20Yl7+q3s3JDPz+6iPPw8SC3E7TFyoaDnMWR0HfwmUm/sdyukTjjP3HJOz7rc3Ixszyg6CUecNFu+a",
-	"ePAXpqFQeykhgCigJrc9VEq6nTghMUFhr08mPymwFFLSBeMI7dSoT5wU9Nruh0C8G0IAVetFlpasBFmb",
-	"UJ3M6VB/3LOzfAbUGttYL4kaSTVnSqNejS+TJeQoOFPuCToklRtRxogN37GIGua1pKWlZffEil2Moz5v",
-	"X7Kw3vLiHXknRmEO2H2w0QjVjdnyXtYZhQS5RgeGb3KRXv+VquUdnPCZH6tP+zgNWQLNQJIlVcvIwenQ",
-	"djPaGPo2LyLNklkw1XG9xBdioe5gibk4hHWV5TOa52bqPsvqrBYHHnWQ85yYlwkUDA3mTnG0Fnarf5Fv",
-	"abo0YgFJaZ5PG1ORKJMcVpAbpZ1xDnJK9JLq5vDjyF6vwXOkwDA7DSRYjTMzoYlN1rYICaSgeAMVRpsp",
-	"8/Y3NQdVtICOFIQ3oqjQihAoGufP/epgBRx5Uj00gl+vEa014eDHZm73CGfmwi7OWgC1d9/V+Kv5RQto",
-	"83Zzn/JmCiEza7PW5jcmSSqkHcLe8G5y8x+gsvnYUuf9UkLihpB0BVLR3Kyus6gHNfne1encczIzqmlw",
-	"Mh0VxhUwyznwOxTvQEasNK/wPzQn5rGRYgwlNdTDUBgRgTs1sxezQZWdybyA9lZBCmvKJCVNrw+C8lkz",
-	"eZzNjDp531rrqdtCt4h6hy43LFN3tU042NBetU+ItV15dtSTRXYynWCuMQi4FCWx7KMDguUUOJpFiNjc",
-	"+bX2jdjEYPpGbHpXmtjAneyEGWc0s/9GbJ47yITcj3kcewzSzQI5LUDh7cZDxmlmafxyZzMhbyZNdC4Y",
-	"ThpvI6Fm1ECYmnaQhK9WZeLOZsRjYV/oDNQEeOwWArrDxzDWwsKFpr8DFpQZ9S6w0B7orrEgipLlcAek",
-	"v4wKcTOq4PEjcvHXsy8ePvrl0RdfGpIspVhIWpDZVoMi951Zjii9zeFBVDtC6SI++pdPvI+qPW5sHCUq",
-	"mUJBy/5Q1vdltV/7GjHv9bHWRjOuugZwFEcEc7VZtBPr1jWgPYdZtbgArY2m+1qK+Z1zw94MMejwpdel",
-	"NIKFavsJnbR0kplXTmCjJT0p8U3gmY0zMOtgyuiAxexOiGpo47Nmlow4jGaw91Acuk3NNNtwq+RWVndh",
-	"3gAphYxewaUUWqQiT4ycx0TEQPHavUHcG367yu7vFlqypoqYudF7WfFswA6hN3z8/WWHvtzwBjc7bzC7",
-	"3sjq3Lxj9qWN/EYLKUEmesMJUmfLPDKXoiCUZPghyhrfg7byFyvgQtOifDWf3421U+BAETsOK0CZmYh9",
-	"w0g/ClLBbTDfHpONG3UMerqI8V4mPQyAw8jFlqfoKruLYztszSoYR7+92vI0MG0ZGHPIFi2yvL0Jawgd",
-	"dqp7KgKOQccLfIy2+ueQa/qdkJeN+Pq9FFV55+y5O+fY5VC3GOcNyMy33gzM+CJvB5AuDOzHsTV+kgU9",
-	"q40Idg0IPVLkC7ZY6kBffC3F73AnRmeJAYoPrLEoN9/0TUY/iswwE12pOxAlm8EaDmfoNuRrdCYqTSjh",
-	"IgPc/ErFhcyBkEOMdcIQLR3KrWifYIrMwFBXSiuz2qokGIDUuy+aDxOa2hOaIGrUQPhFHTdj37LT2XC2",
-	"XALNtmQGwImYuRgHF32Bi6QYPaW9mOZE3Ai/aMFVSpGCUpAlzhS9FzT/nr069A48IeAIcD0LUYLMqbw1",
-	"sNervXBewzbBWD9F7v/ws3rwCeDVQtN8D2LxnRh6u/a0PtTjpt9FcN3JQ7KzljpLtUa8NQwiBw1DKDwI",
-	"J4P714Wot4u3R8sKJIaU/K4U7ye5HQHVoP7O9H5baKtyIILdqelGwjMbxikXXrCKDZZTpZN9bNm81LIl",
-	"mBUEnDDGiXHgAcHrBVXahkExnqFN014nOI8VwswUwwAPqiFm5J+9BtIfOzX3IFeVqtURVZWlkBqy2BrQ",
-	"Izs414+wqecS82DsWufRglQK9o08hKVgfIcspwHjH1TX/lfn0e0vDn3q5p7fRlHZAqJBxC5ALvxbAXbD",
-	"KN4BQJhqEG0Jh6kO5dShw9OJ0qIsDbfQScXr74bQdGHfPtM/Ne/2ics6Oey9nQlQ6EBx7zvI1xazNn57",
-	"SRVxcHgXO5pzbLxWH2ZzGBPFeArJLspHFc+8FR6BvYe0KheSZpBkkNNtJDjAPib28a4BcMcbdVdoSGwg",
-	"bnzTG0r2cY87hhY4nooJjwSfkNQcQaMKNATivt4zcgY4dow5OTq6Vw+Fc0W3yI+Hy7ZbHRkRb8OV0GbH",
-	"HT0gyI6jjwF4AA/10DdHBX6cNLpnd4q/gXIT1HLE4ZNsQQ0toRn/oAUM2IJdjlNwXjrsvcOBo2xzkI3t",
-	"4SNDR3bAMP2aSs1SVqKu8wNs71z1604QdZyTDDRlOWQkeGDVwDL8ntgQ0u6YN1MFR9ne+uD3jG+R5fgw",
-	"nTbw17BFnfu1zU0ITB13octGRjX3E+UEAfURz0YED1+BDU11vjWCml7ClqxBAlHVzIYw9P0pWpRJOEDU",
-	"P7NjRuedjfpGd7qLL3CoYHmxWDOrE+yG77KjGLTQ4XSBUoh8hIWsh4woBKNiR0gpzK4zl/7kE2A8JbWA",
-	"dEwbXfP19X9PtdCMKyB/ExVJKUeVq9JQyzRCoqCAAqSZwYhg9ZwuOLHBEORQgNUk8cnRUXfhR0duz5ki",
-	"c1j7nEHzYhcdR0dox3ktlG4drjuwh5rjdh65PtBxZS4+p4V0ecr+iCc38pidfN0ZvPZ2mTOllCNcs/xb",
-	"M4DOydyMWXtII+OivXDcUb6cdnxQb9247xesqHKq78JrBSuaJ2IFUrIM9nJyNzET/NsVzV/Vn2E+JKSG",
-	"RlNIUsziGzkWXJpvbOKfGYdxZg6wDfofCxCc268u7Ed7VMwmUpUVBWSMasi3pJSQgs13M5Kjqpd6TGwk",
-	"fLqkfIEKgxTVwgW32nGQ4VfKmmZkxXtDRIUqveEJGrljF4ALU/Mpj0acAmpUuq6F3Cowa1rP57Jcx9zM",
-	"wR50PQZRJ9l0MqjxGqSuGo3XIqedtzniMmjJewF+molHulIQdUb26eMr3BZzmMzm/j4m+2boGJT9iYOI",
-	"3+bhUNCvUbfz7R0IPXYgIqGUoPCKCs1Uyj4V8zBH24cKbpWGom/Jt5/+MnD83gzqi4LnjENSCA7baFkS",
-	"xuElPoweJ7wmBz5GgWXo264O0oK/A1Z7njHUeFv84m53T2jXY6W+E/KuXKJ2wNHi/QgP5F53u5vypn5S",
-	"mucR16LL4OwyADWtg3WZJFQpkTKU2c4zNXVRwdYb6dI92+h/Xeel3MHZ647b8aGFxQHQRgx5SShJc4YW",
-	"ZMGVllWqrzhFG1Ww1EgQl1fGh62Wz/wrcTNpxIrphrriFAP4astVNGBjDhEzzXcA3nipqsUClO7oOnOA",
-	"K+7eYpxUnGmcqzDHJbHnpQSJkVTH9s2Cbsnc0IQW5DeQgswq3Zb+MUFZaZbnzqFnpiFifsWpJjlQpclL",
-	"xi83OJx3+vsjy0GvhbyusRC/3RfAQTGVxIPNvrdPMa7fLX/pYvwx3N0+9kGnTcWEiVlmq0jK/73/b0/f",
-	"niX/RZPfTpOv/uXk3fsnHx4c9X589OHrr/9f+6fHH75+8G//HNspD3ssfdZBfv7cacbnz1H9CUL1u7B/",
-	"NPt/wXgSJbIwmqNDW+Q+lopwBPSgbRzTS7jiesMNIa1ozjLDW25CDt0bpncW7enoUE1rIzrGML/WA5WK",
-	"W3AZEmEyHdZ4YymqH58ZT1RHp6TLPcfzMq+43Uovfds8TB9fJubTuhiBrVP2lGCm+pL6IE/356MvvpxM",
-	"mwzz+vlkOnFP30UomWWbWB2BDDYxXTFMkrinSEm3CnSceyDs0VA6G9sRDltAMQOplqz8+JxCaTaLczif",
-	"suRsTht+zm2Avzk/6OLcOs+JmH98uLUEyKDUy1j9opaghm81uwnQCTsppVgBnxJ2DMddm09m9EUX1JcD",
-	"nfvAVCnEGG2oPgeW0DxVBFgPFzLKsBKjn056g7v81Z2rQ27gGFzdOWMRvfe+//aSnDiGqe7ZkhZ26KAI",
-	"QUSVdsmTrYAkw83CnLIrfsWfwxytD4I/veIZ1fRkRhVL1UmlQH5Dc8pTOF4I8tTnYz6nml7xnqQ1WFgx",
-	"SJomZTXLWUquQ4WkIU9bLKs/wtXVW5ovxNXVu15sRl99cFNF+YudIDGCsKh04kr9JBLWVMZ8X6ou9YIj",
-	"21peu2a1QraorIHUlxJy48d5Hi1L1S350F9+WeZm+QEZKlfQwGwZUVrU+WhGQHEpvWZ/fxTuYpB07e0q",
-	"lQJFfi1o+ZZx/Y4kV9Xp6WPM7GtqIPzqrnxDk9sSRltXBktSdI0quHCrVmKselLSRczFdnX1VgMtcfdR",
-	"Xi7QxpHnBD9rZR36BAMcqllAneI8uAEWjoOTg3FxF/YrX9YxvgR8hFvYTsC+1X4F+fM33q49Ofi00svE",
-	"nO3oqpQhcb8zdbW3hRGyfDSGYgvUVl1hvBmQdAnptatYBkWpt9PW5z7gxwmannUwZWvZ2QxDrKaEDooZ",
-	"kKrMqBPFKd92y9oom1GBg76Ba9heiqYY0yF1bNplVdTQQUVKDaRLQ6zhsXVjdDffRZX5RFNXnQSTNz1Z",
-	"PK3pwn8zfJCtyHsHhzhGFK2yH0OIoDKCCEv8Ayi4wULNeLci/djyGE+Ba7aCBHK2YLNYGd7/6PvDPKyG",
-	"Kl3lQReFXA+oCJsTo8rP7MXq1HtJ+QLM9WyuVKFobquqRoM2UB9aApV6BlTvtPPzsCCFhw5VyjVmXqOF",
-	"b2qWABuz30yjxY7D2mgVaCiy77jo5ePh+DMLOGQ3hMd/3mgKx4O6rkNdpOKgv5Vr7NZqrQvNC+kM4bLP",
-	"C8CSpWJt9sVAIVy1TVvUJbhfKkUXMKC7hN67kfUwWh4/HGSfRBKVQcS8K2r0JIEoyPblxKw5eobBPDGH",
-	"GNXMTkCmn8k6iJ3PCItoO4TNchRg68hVu/dUtryotirwEGhx1gKSN6KgB6ONkfA4LqnyxxHrpXouO0o6",
-	"+x3LvuwqTXcexBIGRVHrwnP+Nuxy0J7e7wrU+ap0vhRdqPSPKCtndC9MX4hth+AommaQw8Iu3L7sCaUp",
-	"mNRskIHj1XyOvCWJhSUGBupAAHBzgNFcjgixvhEyeoQYGQdgY+ADDkx+FOHZ5ItDgOSu4BP1Y+MVEfwN",
-	"8cQ+G6hvhFFRmsuVDfgbU88BXCmKRrLoRFTjMITxKTFsbkVzw+acLt4M0quQhgpFpx6aC715MKRo7HBN",
-	"2Sv/oDVZIeEmqwmlWQ90XNTeAfFMbBKboRzVRWabmaH3aO4C5kvHDqatRXdPkZnYYDgXXi02Vn4PLMNw",
-	"eDAC28uGKaRX/G5IzrLA7Jp2t5wbo0KFJOMMrTW5DAl6Y6YekC2HyOV+UF7uRgB0zFBNrwZnlthrPmiL",
-	"J/3LvLnVpk3ZVJ8WFjv+Q0couksD+Ovbx9oF4f7aFP4bLi7mT9RHqYTXtyzdpkKh/bi0VQcPKVDYJYcW",
-	"EDuw+rorB0bR2o71auM1wFqMlRjm23dK9tGmIAdUgpOWaJpcxyIFjC4PeI9f+M8CYx3uHuXbB0EAoYQF",
-	"Uxoap5GPC/oU5niK5ZOFmA+vTpdybtb3Roj68rduc/ywtcyPvgKMwJ8zqXSCHrfoEsxL3yk0In1nXo1L",
-	"oO0QRdtsgGVxjovTXsM2yVhexenVzfvDczPtj/VFo6oZ3mKM2wCtGTbHiAYu75jaxrbvXPALu+AX9M7W",
-	"O+40mFfNxNKQS3uOz+RcdBjYLnYQIcAYcfR3bRClOxhkkHDe546BNBrEtBzv8jb0DlPmx94bpebT3odu",
-	"fjtSdC1BGcB4hqBYLCDz5c28P4wHReRywRdBF6ey3FUz75jY0nVYeW5H0ToXhg9DQfiBuJ8wnsEmDn2o",
-	"FSDkTWYdFtzDSRbAbbmSuFkoipowxB/fCGx1H9kX2k0AiAZBX3ac2U10st2lejtxA3KgmdNJFPj17T6W",
-	"/Q1xqJsOhU+3Kp/uPkI4INIU00Fjk34ZggEGTMuSZZuO48mOOmgEowdZlwekLWQtbrA9GGgHQUcJrlVK",
-	"24VaOwP7Ceq8J0Yrs7HXLrDY0DdNXQJ+Vkn0YLQim/t122tdbeTaf/j5QgtJF+C8UIkF6VZD4HIOQUNQ",
-	"FV0RzWw4Scbmcwi9L+omnoMWcD0bezaCdCNEFnfRVIzrL5/EyGgP9TQw7kdZnGIitDDkk7/se7m8TB+Y",
-	"kuorIdiaG7iqoun6P8A2+ZnmlVEymFRNeK5zO7Uv3wN2fVX8AFsceW/UqwFsz66g5ekNIA3GLP31IxUU",
-	"sL6nWiX+Ub1sbeEBO3UW36U72hrXlGGY+JtbptW0oL2U2xyMJkjCwDJmNy7isQnm9EAb8V1S3rcJLNsv",
-	"gwTyfjgVU76FZf8qqmtR7KPdS6C5J15czuTDdHK7SIDYbeZG3IPr1/UFGsUzRppaz3ArsOdAlNOylGJF",
-	"88TFSwxd/lKs3OWPr/vwio+sycQp+/LbsxevHfgfppM0ByqT2hIwuCp8r/xsVmXbOOy+Smy1b2fotJai",
-	"YPPrisxhjMUaK3t3jE29pihN/ExwFF3MxTwe8L6X97lQH7vEHSE/UNYRP43P0wb8tIN86Iqy3DsbPbQD",
-	"wem4uHGddaJcIRzg1sFCQcxXcqfspne646ejoa49PAnneoWlKeMaB3eFK5EVueAfeufS03dCtpi/y0yM",
-	"Bg/9fmKVEbItHgditX3/yq4wdUys4PXr4ldzGo+OwqN2dDQlv+buQQAg/j5zv6N+cXQU9R5GzViGSaCV",
-	"itMCHtRZFoMb8XEVcA7rcRf02aqoJUsxTIY1hdooII/utcPeWjKHz8z9kkEO5qfjMUp6uOkW3SEwY07Q",
-	"xVAmYh1kWtiWmYoI3o2pxiRYQ1rI7F1LBuuM7R8hXhXowExUztJ4aAefKcNeuQ2mNC8TfHnAWmtGrNhA",
-	"bC6vWDCWeW1MzdQOkMEcUWSqaNnWBncz4Y53xdk/KiAsM1rNnIHEe61z1XnlAEftCaRxu5gb2PqpmuFv",
-	"YwfZ4W/ytqBdRpCd/rvntU/JLzTW9OfACPBwxh7j3hG97ejDUbPNZlu2QzDH6TFjWqd7RuecdQNzRFuh",
-	"M5XMpfgN4o4Q9B9FCmF4xydDM+9vwGORe12WUjuVm47uzez7tnu8bjy08bfWhf2i665jN7lM46f6sI28",
-	"idKr4uWaHZKHlLAwwqCdGjDAWvB4BcGw2AbFRx9Rbs+TrQLRyjCLn8owl/PEjt+cSgdzL/81p+sZjfWI",
-	"MbqQgSnY3laclBbEf+w3QNU1DuzsJIjgrt9ltpJcCbLxQfSr0t5Qr7HTjtZoGgUGKSpUXaY2TCFXIjJM",
-	"xdeU2y7i5jvLr9zXCqwL3ny1FhLrQKp4SFcGKSui5tirq7dZ2g/fydiC2QbZlYKgA7MbiNhik0hFrot1",
-	"XbnDoeZ8Tk6nQRt4txsZWzHFZjngGw/tGzOq8Lqs3eH1J2Z5wPVS4euPRry+rHgmIdNLZRGrBKl1TxTy",
-	"6sDEGeg1ACen+N7Dr8h9DMlUbAUPDBadEDR5+vArDKixf5zGblnX4HwXy86QZ/tg7TgdY0yqHcMwSTdq",
-	"PPp6LgF+g+HbYcdpsp+OOUv4prtQ9p+lgnK6gHh+RrEHJvst7ia68zt44dYbAEpLsSVMx+cHTQ1/Gsj5",
-	"NuzPgkFSURRMFy5wT4nC0FPTXtlO6oezvf5dvygPl3+I8a+lD//r2Lo+shpDi4GcLYxS/hF9tCFap4Ta",
-	"4p85ayLTfb9Ocu5rC2MDrbpvlsWNmcssHWVJDFSfk1IyrtH+Uel58hejFkuaGvZ3PARuMvvySaQRVbtX",
-	"Cz8M8I+OdwkK5CqOejlA9l5mcd+S+1zwpDAcJXvQ1FgITuVgoG48JHMoLnT30GMlXzNKMkhuVYvcaMCp",
-	"b0V4fMeAtyTFej0H0ePBK/volFnJOHnQyuzQT29eOCmjEDLWMKA57k7ikKAlgxVmzMU3yYx5y72Q+ahd",
-	"uA30nzb+yYucgVjmz3JUEQg8mruS5Y0U//PLpvI5OlZtJmLHBihkxNrp7HYfOdrwMKtb139rA8bw2QDm",
-	"RqMNR+ljZSD63obX1998inihLkh2z1sGx4e/Eml0cJTjj44Q6KOjqRODf33UfmzZ+9FRvABx1ORmfm2w",
-	"cBuNGL+N7eE3ImIA810L64AiVx8hYoAcuqTMA8MEZ26oKWl3iPv4UsTd5HfFo03jp+Dq6i0+8XjAP7qI",
-	"+MTMEjewyVIYPuztDplRksnq50GcOyXfiM1YwuncQZ54/gAoGkDJSPMcrqTXATTqrt8bLxLQqBl1Brkw",
-	"SmbYFCi0538+eDaLn+7AdsXy7OemtlvnIpGUp8tolPDMfPiLldFbV7BlldE+I0vKOeTR4axu+4vXgSNa",
-	"+t/F2HkKxke+2+1Aa5fbWVwDeBtMD5Sf0KCX6dxMEGK1XTarLsuQL0RGcJ6mqUXDHPutnGMtNCP5zThs",
-	"UWkXt4q54K7g0JzlGIYZ9xvjm4mkeqCAFvY79/2FzDjYflxZM4MdHSShrMCLWdGizAFP5gokXeCngkPn",
-	"cyyhhiMHHSuIKs0jfBMLVgiiK8mJmM+DZQDXTEK+nZKSKmUHOTXLgg3OPXn68PQ0avZC7IxYqcWiX+ar",
-	"ZikPT/AV+8Q1WbKtAA4Cdj+sHxqKOmRj+4Tjekr+owKlYzwVH9jMVfSSmlvb9pOse58ek++x8pEh4lap",
-	"ezRX+iLC7YKaVZkLmk2xuPHlt2cviJ3VfmNbyNt+lgu01rXJP+peGV9g1Fd2GqicM36c3aU8zKqVTur2",
-	"k7HahOaNpkEm68TcoB0vxM4xeW5NqHUDfzsJwRLZsoAs6HZplXgkDvMfrWm6RNtkSwIa5pXjG7F6dtZ4",
-	"boLsw7r7ETJsA7frxWpbsU6J0EuQa6YAM/JhBe1yiHVtUGcb9+UR28uTFeeWUo4PEEbrXkeHot0DZyVZ",
-	"H1QQhayD+AMtU7Yf86F9aS/wq3guRqfJbcfr74vr+RLb5KVzLqSUC85SbIUQk6SxdNs4N+WIrhFx/6Ka",
-	"uBMaOVzR1rp1LrDD4mCzXc8IHeL6Lv/gqdlUSx32Tw0b13JtAVo5zgbZ1He6dg4xxhW4blaGiEI+KWQk",
-	"qCmaCFEHUBxIRliVacDC+Z159qOzf2NRjGvG0dLl0Ob0M+uyyhVDzzQnTJOFAOXW087mUW/NN8dYpTGD",
-	"zbvjF2LB0gu2wDFsGJ1Zto0Z7Q915iNIXcSmefeZedfVzq9/boWD2UnPytJNOtwHPSpI6g0fRHAsbskH",
-	"kgTIrccPR9tBbjtDv/E+NYQGK4xagxLv4R5h1L2026N8a3RLS1H4BrEZldECuoxHwHjBuHehxi+INHol",
-	"4MbgeR34TqWSaqs7jOJpl0DzgQQIzFC2PvjbDtXtHGBQgmv0cwxvY9MGfIBx1C80Ej/lW+IPhaHuQJh4",
-	"RvM6dDrS1BulKidEZZhc1GnzHWMchnEnPmWyha696Xv159iN49CbaKhG4azKFqATmmWx0lbf4FOCT32S",
-	"GGwgreomVHV2YLtGeZ/a3ESp4KoqdszlX7jldEHf/Ag1hL37/Q5jpZ3ZFv+NdWAa3hkXNH1wVq6PkM4O",
-	"K8zfzzKOSb2GphPFFsl4TOCdcnt0NFPfjNCb7++U0n267h8iG7fD5cI9ivG3b83FERbu7cWn26ulrquL",
-	"seACn/uCR3VFyDZXwqus12cMox5w8yJb1gHevxgFfEXzgUz40Fdi71frPxjKh08HyzdQ7cpzaUp2sqDB",
-	"kkc2Vrjjfem7EIfig2148N15LdxadyJ02Hf3Q8tTZ2PEGmYx6KG7mROt2eBDvWg/rIZKJPg+Hfg87Afi",
-	"onimrgw8rJiofPSVj4H2KqH91ZXgafX9GFh/NLPgU3stBn0sl65/rV2m08l/+Nl6YQlwLbd/AI9Lb9O7",
-	"TWUi0q41TzWvkLr14ahWiK1bcUwPm1i7FCcbeluZZS0tWuq1n+mR1fMx4kAPHx+mk/PsoAsz1nJnYkeJ",
-	"HbsXbLHUWLH/r0AzkK/3dCRouhDgESuFYk0H0twM5krALnG447HJBoaAWdhRoT+WD0JdQaqx7WwTXCcB",
-	"DumvYCbzTp8/OxMMq9N1ToZrSLCrC0G/1+yeO75XOCko/mX7dB6Pr7l/VodQ2wywNVVNuZZOzvTozM35",
-	"HFKsiryzUNV/LIEHRZCm3i6DsMyDulWszmPCut6HWx0bgHbVkdoJT9Bf59bgDOWxX8P2niItaog2Dq2T",
-	"+G5SOBgxYF1gvob0kCHZRY0xVVMGYsGHBLtSzE1zjMGaz0HZtRvO5UnSXBxNKbYdU8abno+ay3x6UNlH",
-	"TMkZqmXV75k8rH88xxbVygXI0brwcKilk/N+45y1K1yMZcVq34kvYQzK/+ZrCNpZcnbt+gcgVqynak1l",
-	"5t+4k6JQ9m5icaDn9cysSeDoBzlEWjFgLlSaCyNGJEMJZe2ciTrg8J6ykaFNAR+Eaw5SQla7RHKhINHC",
-	"J3zsgmMXKmz4642QoAbbH1ngBktfv2lqe2MbOIqlrqmLeg0XSCQU1EAngwrcw3PuQvYz+9wn4fs2YHst",
-	"TDW97u9H61N3mOohMaT6OXG35f7k/psYmxjnIBPveeqW4+btimxYdzOrUntBhwejNsiNrp2zg5VE7TRp",
-	"f5UdHSFIkr+G7YlVgnwjX7+DIdBWcrKgBwVHO5t8p+Y3FYN7cSfgfdo6cqUQeTLg7Djv1xDvUvw1S68B",
-	"awDWIe4DPdrJfbSx197s9XLra2aXJXDIHhwTcsZtUpF3bLfbC3Ym5/f0rvk3OGtW2bL+zqh2fMXj2RlY",
-	"cF/ekpv5YXbzMAWG1d1yKjvIngrVGz4UcrPG4vztLp7HY7Xyvqu520W+ISoLRUwmubAeq2d40GOGIyyB",
-	"ENTqQEcmJc7TRVQuYrG8NynTYIaKYyqcDAHSwMdUC6ihcINHERDtix45hbb0nSt6J+ZEQuNEvmn1v34L",
-	"95hG3525nqXN7+ZCQqsZu/naVvqsE1+wjCb+Z8a0pHJ7kxp9vRbyPevJIJb3hmPVkVjNQpporD4O81ys",
-	"E2RWSd3nIqbamvdU+zL2Tdea78ypnkEQ10WVE9S2ZEkzkgopIQ2/iOd7WqgKISHJBYZ5xTzQc23k7gKT",
-	"vDjJxYKIMhUZ2H4xcQoamqvinKLYBEFUTRQFlnYwW9h+E9DxyCnNnWr9SAmKWosDeuenYDPXm6pOdtGJ",
-	"9WUORCyDclWcHIbsy314d/T+j/PmOdsg3YCMHfk50bKCKXFvdHtku4NPJZCCKWVBqWlpzfIcE8fZJvC8",
-	"1oELcdQOiL3nGFa5Yhh70y4iYKXh0tx5dWWFkAdchGWPiF5KUS2WQYHpGk6v8srKKcThKD+pCsOjMIPM",
-	"TPGEFEJpp2nakZolNyFn91PBtRR53jZKWRF94SztL+nmLE31CyGuZzS9foB6LRe6Xmk29fnV3eDAZibZ",
-	"KS3WvoAT2858f6le+x6GyjmiHc0gOyzu4MbuAZjv9nPQ/Tb3s/7CuutqM9O4GnPGCdWiYGn8TH1e0XaD",
-	"MXIxFhWtWWZ7K9oqE/gaHvbwsqqDK5BF9tEMnEabw50RxwickxnZjfkvSuDdcckcHKMZuCj7zMVJUUk6",
-	"KOt1AEBIbeqzrqRtyBhKYjVXEQtbKgFd5F1AR94qGIl0O9jMCHcOlIZbAdWLfqwBvG+ND1NbW85GUs7E",
-	"xj9/0BSfuxHwH3ZTeYt5DIV4XTSkJW2Qly9UM8AR4iWud8ZDXWLa+2xsVFTdPHfkDR8AMBwn1YJhVLTU",
-	"oWDMKcshS2K9F89rG9U00LRdala3JTpTjpOntPKtD83YlQRXOMWK+LLt/yqpISVRv963JPMMNmDzOn4D",
-	"KWxPw2ngf4HctjzsGANEmeSwglb4mKvmUqGoyVbgv1X1xyQDKNEb2bWRxeKiwru8Yzhxa0+CyJox2I1a",
-	"Uixi7U6RPWaSqFFnwxN7TNTYo2QgWrGsoi38qUNFjrYZ0BzlCKp6OkLi9cix0/xkR3jjBzjz38dEGY+J",
-	"d+P40MEsKI66XQxob5xkpYZOPY+HSYalimoHC86W1Y5YS+IN31AlXfNhg2Sf5Bt1a+Q+McEDxH67gRSl",
-	"GqfvQOY0ngEnhat6gtTOATKrFZhPItb2JXDCRdBick1Vrao0NRT9D3ZifIlxp03fwKncRDPefmcJDkZU",
-	"p5jaoCIhazq9uXn+k5zEnQdxcLwYjShw6X877F+eup3agS9gK29u9tPI/tik0d1ijotPyazyA+W5WNue",
-	"kaEe+hy8H9RSn3cBObGc1deyj9qcuvKeXVMHC+LVC7olQuI/Ruv8R0VzNt8in7Hg+8+IWlJDQs7xaiMC",
-	"XBSomXi3eDX1gHlri/BT2XWzsWMGw23NKAHQ5iL3zX0EKeg1hNuAwQ6Wf6baME5VzdByYa7sznb2seAW",
-	"70u0FDQLNX0sFNluo+5LB5uv/3eTCxdO5eu7lTlNfYdQ16KozWewC7AnLr2EYneyZJ+veRKoOws3RCt9",
-	"dn12A5PpgawrloEw1H6lBXav42qv88ytljHS8tvpsbEjzXTUUu56F8ZG3fSADvs07gM/bFv5cfAfreE6",
-	"tIwx4P9R8D7QqDaE1/ak/QhYblXgiMBqrdUzsUkkzNW+ABNrrjbqvGxqd3gTK+OpBKpsxM35K6d4NiVK",
-	"GTeKsI0JrX2a9SgZzBlvmCXjZaUjegxWKuXbAGGh0R/ROuBCG5ISjDC5ovmrFUjJsqGNM6fDtnQMW0R4",
-	"R4f7NmLCqO/U/gBMNToc5mc2ZvTwNXOB2yZUNlxTacozKrPwdcZJCtLc+2RNt+rmHqXaObDPp0QDaaZd",
-	"NSDwLiFpW0DyrXMK39LfUwNI79DxM8Jhg3HBEWeNNe1oMeCf6cPwWThsCrpJcrHALMKBA+Fq06KHz6qA",
-	"gqMZ3Mpn49bt51HsN9g9DZbld4xIC5x1zBS7z/0r3EpUI3/iTO88+dZG2U3rtHG39mB6pPJFE/xviaV/",
-	"HmOZuK74SpiN64VNn6riaQ+CTYQB/1DbLj6wixgG4dK4QyP4+HZn7UiLWL6vtQwkaDFQO8L7QTWh7DR1",
-	"4Vl9U1rP1GCRMnXZ0gda2qx93t9LA+DZ3vTurLenrUNmzDiH9IjbnR+dlKJM0jExn7ZzR+bcBA7SNowD",
-	"9BE4AQbWXYfHqLqXTavuUaupzaFt8gab6uzzdpXpLqV/yEw0wNHbLggxR15mO7ejdQszeWpjyrSbY9Y2",
-	"g9VMglAiIa0kmonXdLu/7dhAxeiLv5598fDRL4+++JKYF0jGFqCaquOdtl1NXCDjXbvPx40E7C1PxzfB",
-	"Vx+wiPP+R59UVW+KO2uW26qmpGivadkh9uXIBRA5jpF2UTfaKxynCe3/Y21XbJF3vmMxFPz+eyZFnse7",
-	"PtRyVcSBEtutwIViNJASpGJKG0bY9oAy3UREqyWaB7H278pWkxE8BW8/dlTA9EDIVWwhQwG1yM8wt9t5",
-	"jQhsytzxKuvp2bUup6dZCx0KjRgVMwNSitKJ9mxOYhBhBpEMMmud4RMt4kGMbM1sbbRsjBBd5Hmc9MKG",
-	"2bu5fbuZq45zerOJEfHCH8obkOaQf2K4bsFNOElj2v/D8I9IIYY74xr1cn8PXhHVD27WlH8UaP2k/Ah5",
-	"IAAD2batPMkgUSwoRCytlwD9Cd6B3BU/XjaO5b1pIQiJ/2APeGH6bPNencngwPnEFX1f1kgJlvJuiBJa",
-	"y9+XketZb32RBFvkjCZag7JsSfTFwiDdWj2rs5gHtJJesrMUQhOjmeZ5JEna2nHwTIWEY1QCuaL5x+ca",
-	"3zGp9BniA7I3w6lRYaZsiGSLSnWzOn0v6Ki5g6zYu5uav8bE7P8As0fRe84N5ZzwvdsMjTvYsX7hbwWb",
-	"603WOKYNsnr4JZm5ZhulhJSprnN/7YWTOjEUJJu7gFbY6D2ZqPvW+bPQtyDjuY/EIT8G7q3aZ+8gbI7o",
-	"J2YqAyc3SuUx6uuRRQR/MR4VNufdc13csjHDzcq+BAXcDiz70m87PHZ5trSJuXQqBf11jr6tW7iNXNTN",
-	"2sbWLBrd3+Hq6q2ejSk1FO/FYD7HWkd30pThoJYMv0OVI4sjN4abN0YxPw/VvbW1XQdqc3f2o2L53oCV",
-	"VqX1D9PJAjgoprCW+C+ud8zHvUs9BLbyQv+oWlhvUy7GIiay1tbkwVRBDfUR5dPdZ5Ga15jVmFaS6S32",
-	"DfYGNPZLtB7T93VtD1cbpvalubtPi2uoe7c3lUAq5W/X7wXN8T6yLj5ubiGRH5NvbYVvd1C+vjf7V3j8",
-	"lyfZ6eOH/zr7y+kXpyk8+eKr01P61RP68KvHD+HRX754cgoP519+NXuUPXryaPbk0ZMvv/gqffzk4ezJ",
-	"l1/96z3DhwzIFlBf2v/p5D+Ts3whkrPX58mlAbbBCS3ZD2D2BnXlucC+lgapKZ5EKCjLJ0/9T//Hn7Dj",
-	"VBTN8P7XievPNFlqXaqnJyfr9fo4/ORkgan/iRZVujzx82C3wZa88vq8jtG3cTi4o431GDfVkcIZPnvz",
-	"7cUlOXt9ftwQzOTp5PT49Piha23NackmTyeP8Sc8PUvc9xOsr3miXOn8kzpX68O096wsbWF988jRqPtr",
-	"CTTHAjvmjwK0ZKl/JIFmW/d/taaLBchjzN6wP60enXhp5OS9q5zwYdezkzAy5OR9q8BEtudLH/mw75WT",
-	"97517u4BW21TXcyZQWrU5fk9aFduydoeIrU60NPgRp8ShXXzzU+lZMKc16m5fDPAuAAMb5NYQFzLiqfW",
-	"WWynAI7/fXn2n+gwf3n2n+Rrcjp1CQcKFZrY9Dbjuia088yC3Y9TVN9sz+pqJo1zffL0bczI5IJFy2qW",
-	"s5RYOQUPqqHC4BzVIzZ8Ei2KE1X3N2+4vuHkp8lX795/8ZcPMWmyJxvXSAoKfLS8vsJ3PkWkFXTz9RDK",
-	"Ni4C3Yz7jwrktllEQTeTEOC+BzVS9cwnCPkG0GFsYhC1+O8Xr34kQhKnPb+m6XWdHOWz4ZoMwDAZznw5",
-	"BLG7WEOggVeFuaNcllWhFmW7AHCN5nfYLREBRXby6PTU81CnoQQH9MSd+2CmjlmrT2gYphMYKvup8IrA",
-	"hqY63xKqgjgJjFr0nU07KWyiTFqB9DtNo/0Z3ZZEsxAOzcaPVKgXmuZ74LvsdIFsocOF/JTmkt2f/t5D",
-	"RhSCdzExItxaTyN/7u5/j93tSyWkFOZMM4zLbq4cf521gHSyaL714A4UGjkmfxMVyo5GK6g0xHrg4wzW",
-	"J+LmdHWRgkC6JnUInxwddRd+dNSE/c1hjUyWcnyxi46jo2OzU08OZGU77dStMsKjzs4hw/U26yXd1FHT",
-	"lHDBEw4LqtkKSKBwPjl9+Nmu8JzbOHUjLFuh/sN08sVnvGXn3Ag2NCf4pl3N4892NRcgVywFcglFKSSV",
-	"LN+Sn3idCBC0WO+zv5/4NRdr7hFh9NWqKKjcOiGa1jyn4kHfn538p1fhqBG0kYvShcJYGBRRrUzrqyDy",
-	"xeTdB68DjNQ9dr12MsMOmGNfhVBhGdZO0DOhTt6jbX3w9xPnII0/RB+HVZ5PfO3FgTdtla34w5ZW9F5v",
-	"zEJ2D2feCcZLqU6XVXnyHv+DenCwIlu0/0Rv+AnGhJ68byHCPe4hov1783n4xqoQGXjgxHyuUI/b9fjk",
-	"vf03mAg2JUhmriMslOl+tQWNT7CT9Lb/85an0R/762gVcx34+cSbYWIqdfvN960/2zSllpXOxDqYBR0Y",
-	"1vvWh8w8rFT375M1ZdoISa6GKJ1rkP2PNdD8xDUM6vza1OjvPcHGA8GPHbGqFLaIUFujfUPXl61cUGmL",
-	"ZXwj0FAxxHA3yYxx5EIhl2zMkvZhX0Xq8cbLJdj4W+/ZjcigWpCZFDRLqdLmD9daq6cbf7il/tWt7XEe",
-	"8dshmGhu6JejNPzkeK8zB8cdI2QG+0LOn/sJmwS0310w60H0Dc2IrzqVkJc0NxsOGTlz4n8LG7+3UPXp",
-	"paBPLLZ8NDnjG3/4FKFYgq+lIMp40ZygB94YocJokYYBLIAnjgUlM5FtXZuyiaRrvbE1OrrM7YS2b4y2",
-	"IZJKWqihh3dgpfxjmyb3WST/NAT+aQj801T0pyHwz9390xA40hD4p5nsTzPZ/0gz2SG2sZiY6cw/w9Im",
-	"9k2nrXmt3keb/hQ1i29XD2O6lslaaaTYCoPpY0IusfQLNbcErEDSnKRUWenKlSkqMLoTa5BB9vSKJy1I",
-	"bAylmfh+818bvHpVnZ4+BnL6oPuN0izPQ97c/xblXXxk80u+JleTq0lvJAmFWEFmk2HD+uj2q73D/q96",
-	"3Fe9xgqYBY+1dXypMqKq+ZylzKI8F3xB6EI0gddYkJULfALSAGfbUxGmpy5RhbnsaNe9vl3GvS259yWA",
-	"82YL94YUdMglHk1gCO/AUIJ/GRNH8D9aSr9pNavbMtKdY/e46p9c5WNwlU/OVz53J21gWvxvKWY+OX3y",
-	"2S4oNET/KDT5DpMKbieOuUKhabRL100FLV8oxpv7msDkMNAXb9E6xPftO3MRKJArf8E2catPT06wcthS",
-	"KH0yMddfO6Y1fPiuhvm9v51KyVbYBhqtm0KyBeM0T1zgZ9LEpj46Pp18+P8BAAD//3e/DWz5IQEA",
+	"H4sIAAAAAAAC/+y9e3PctpIo/lXwm90qx96hJDtO9sS/Su1V4jy0cWKXpWR3b+x7DobEzOCIA/AAoDQT",
+	"X333W914ECRBDkeS7WQ3f9ka4tFoNBqNfr6b5XJTScGE0bNn72YVVXTDDFP4F81zWQuT8QL+KpjOFa8M",
+	"l2L2zH8j2iguVrP5jMOvFTXr2Xwm6IbNnsX95zPF/lFzxYrZM6NqNp/pfM02FAY2uwpah5G22UpmbohT",
+	"O8TZ89nNyAdaFIpp3YfypSh3hIu8rAtGjKJC0xw+aXLNzZqYNdfEdSZcECkYkUti1q3GZMlZWegjv8h/",
+	"1EztolW6yYeXdNOAmClZsj6cX8vNggvmoWIBqLAhxEhSsCU2WlNDYAaA1Tc0kmhGVb4mS6n2gGqBiOFl",
+	"ot7Mnv0600wUTOFu5Yxf4X+XirHfWGaoWjEzeztPLW5pmMoM3ySWduawr5iuS6MJtsU1rvgVEwR6HZEf",
+	"a23IghEqyOtvvyaffvrpF7CQDTWGFY7IBlfVzB6vyXafPZsV1DD/uU9rtFxJRUWRhfavv/0a5z93C5za",
+	"imrN0oflFL6Qs+dDC/AdEyTEhWEr3IcW9UOPxKFofl6wpVRs4p7Yxve6KfH8H3VXcmrydSW5MIl9IfiV",
+	"2M9JHhZ1H+NhAYBW+wowpWDQX0+yL96+ezx/fHLzT7+eZv/b/fnZpzcTl/91GHcPBpIN81opJvJdtlKM",
+	"4mlZU9HHx2tHD3ot67Iga3qFm083yOpdXwJ9Leu8omUNdMJzJU/LldSEOjIq2JLWpSF+YlKLkmmNozlq",
+	"J1yTSskrXrBiTrgg12uer0lOtR0C25FrXpZAg7VmxRCtpVc3cphuYpQAXLfCBy7o94uMZl17MMG2yA2y",
+	"vJSaZUbuuZ78jUNFQeILpbmr9GGXFblYM4KTwwd72SLuBNB0We6IwX0tCNWEEn81zQlfkp2syTVuTskv",
+	"sb9bDWBtQwBpuDmtexQO7xD6eshIIG8hZcmoQOT5c9dHmVjyVa2YJtdrZtbuzlNMV1JoRuTi7yw3sO3/",
+	"fv7yJyIV+ZFpTVfsFc0vCRO5LFhxRM6WREgTkYajJcQh9Bxah4Mrdcn/XUugiY1eVTS/TN/oJd/wxKp+",
+	"pFu+qTdE1JsFU7Cl/goxkihmaiWGALIj7iHFDd32J71Qtchx/5tpW7IcUBvXVUl3iLAN3X55MnfgaELL",
+	"klRMFFysiNmKQTkO5t4PXqZkLYoJYo6BPY0uVl2xnC85K0gYZQQSN80+eLg4DJ5G+IrA4WIPOFxMA0ew",
+	"bYJm4HTDF1LRFYtI5oj87JgbfjXykolA6GSxw0+VYldc1jp0GoARpx6XwIU0LKsUW/IEjZ07dGhCiW3j",
+	"OPDGyUC5FIZywQrChQVaGmaZ1SBM0YTj753+Lb6gmn3+dHaz7+vE3V/K7q6P7vik3cZGmT2SiasTvroD",
+	"m5asWv0nvA/juTVfZfbn3kby1QXcNkte4k30d9g/j4ZaIxNoIcLfTZqvBDW1Ys/eiEfwF8nIuaGioKqA",
+	"Xzb2px/r0vBzvoKfSvvTC7ni+TlfDSAzwJp8cGG3jf0HxkuzY7NNviteSHlZV/GC8tbDdbEjZ8+HNtmO",
+	"eShhnobXbvzwuNj6x8ihPcw2bOQAkIO4qyg0vGQ7xQBami/xn+0S6Yku1W/wT1WV0NtUyxRqgY7dlYzq",
+	"A6dWOK2qkucUkPjafYavwASYfUjQpsUxXqjP3kUgVkpWTBluB6VVlZUyp2WmDTU40j8rtpw9m/3TcaN/",
+	"Obbd9XE0+QvodY6dQGS1YlBGq+qAMV6B6KNHmAUwaPyEbMKyPRSauLCbCKTEgQWX7IoKczSbp85kc4B/",
+	"dTM1+LbSjsV35wk2iHBiGy6YthKwbfhAkwj1BNFKEK0okK5KuQg/fHJaVQ0G8ftpVVl8oPTIOApmbMu1",
+	"0Q9x+bQ5SfE8Z8+PyHfx2CiKS1AvLZgTNeBuWLpby91iQbfk1tCM+EAT3E5Q1tzMAxq0ZuY+KA6fFWtZ",
+	"gtSzl1ag8feubUxm8Pukzn8MEotxO0xc0Io4zNk3Dv4SPW4+6VBOn3CcuueInHb73o5sYJQRgtFnDRbv",
+	"m3jwF27YRu+lhAiiiJrc9lCl6G7mhMQMhb0+mfysmaWQiq64QGjn8HwSZEMv7X5IxDsQAtPhXWRpCQdt",
+	"VKhO5nSoP+rpWf4A1JraWC+JakJJybXBdzU2JmtWouBMhSfomFRuRRkTNnxkEQHma0UrS8vuixW7uMD3",
+	"vG1kYb3jxTvxTkzC3HyONxqhujVb3ss6k5DAhy4MX9GSipzpC8XZKyXl8h5O+qKU+WW2pnqdPgTwxRtE",
+	"sC1ZM1q4F7hlWPCe80+J5n2yM6yl/vw/n/zbM1B70uy3k+yLfzl+++7pzcNHvR+f3Hz55f9t//TpzZcP",
+	"/+2fU4c3l5sNNxsmktoX/w31PXiepZlH2mtUF1HSqGtJSResfNZd15w8+KcHc7wI4EstKlrg+5Rq9ukT",
+	"q6gBzuQwcv79afbZ4yfHTz77vIW9XKIKw/I0+LFB/dzBZqShJarVUDapFNNMmLmVNzIEKbtiCm4YHAU7",
+	"H5GvSw5URXIqiGJAZrVhhBuyVHIDc2v3TNV2Ffma5ZfR9ISuKBfaRJuc3t3eBpSMLtN0A2yZCbJwJEuM",
+	"4oywksGGfHhCwWdnEk4hRQarIEIWTAeM4YY0hkDOSCGvhaekiiomwmfoDkuadEX2zvBPsmCpSxIAGLqX",
+	"pGmfyxjJHx65e69QB2ZjL6aG9QEnXJNFzUtD5BVTqQt1Phs9CPsZmJ0Zu5O4OymooYSa/smH3o3Cp+E3",
+	"Xl7LpdBM6BpV90bmskwcHW9+1nAmuehxHP3h98xymjTGnFa4x9j8/nkulUAX1YMY+9Br7Ehf0T3Xujcc",
+	"D5t7zRQeu3lQfzk8TZHa8DQDrnC3tWPyjSAG6mgta5UzVAvLbcBRQw6dkwx3PsD9PdXr+7rrv0+elK/i",
+	"qx1vlWkYxdGmYOd7dwxpS4polvhCrvQ9LLGUhzxXquprWpYwdZ8Dd1aLA08S3suSQGPCNhzFDC4iq7q9",
+	"Ssk3NF8TWlUkp2U5b8xDsspKdsVKoBAuBFi4zJqaRuDHkb0uE2VnzeCBYxiJVuNMS2hWU8H+oBjZUHx1",
+	"bkCDWZXtPuHVpOmGdTQfKDbIGi0HkXLx7LlfHbtyF2IYGsEPa9T+6vSDH5HT8AlnFtIuzlr9jOeZAX/h",
+	"jdACGlo3b2jRTCFVYe3UyKW4IrlUdgj7qneTw38YVU1nS52fVIplbghFr5jStLRHu7Woh4F87+t07jmZ",
+	"cE9FJ9NRYVrpajkH9kNZmamEPPES/0NLAp/tfcgi6uGogJCRC1VhH+OAKjsTNEAbqyQba74kcHscBOXX",
+	"zeRpNjPp5H1jLaZegrWLCDt0seWFvq9twsGG9qp9QnRLNOxfxGNMJ5prCgIuZEUs++iAYDkFjmYRIrf3",
+	"/pT9Sm5TMH2F91z7GSu37F52Qm7tf6YJ3nL73EEm1X7M49hTkA4LFHTDtL/tYxXDPPLFOV1IdTsNQueC",
+	"aQmQFEZtmONs3kESNq2rzJ3NxDvZNugM1Dh1jgsB3eFTGGth4dzQ94AFbWgE/B2w0B7ovrEgNxUv2T2Q",
+	"fvq54xQS59+ffvb4yV9B/yBBiSBXim4IiNyafOJMcUSbXckeJp9zKF2kR//8qfdLaY+bGsfKuhta9Yey",
+	"/i7uTYbNCLTrY62NZie9OwAncUQGV5tFO7GuXADac7aoV+fMGNBuv1K3VKSNcZveDCnosNGrSoFgodu+",
+	"QU5aOi6gyTHbGkWPK2zJRIE0j+vgmmrNNot7IaqhjS+aWQriMFqwvYfi0G1qptnFW6V2qr4PkwZTSqrk",
+	"Fexf7qBR0FwmjBKvXAviWvjtqrq/W2jJNdUE5kaPpVoUA7YHcEWafH/ZoS+2osHN6A1m15tYnZt3yr60",
+	"kd+8QiqmMrMVBKmzZRJB/RklBXZEWeM7Zqz8xTfs3NBN9XK5vB8Lp8SBEjoMvmEaZiK2BeGCaJZLYR34",
+	"95hp3KhT0NNFjPcsMcMAOIyc70SO7jH3cWyH1W8bLtBXT+9EHpmzrNKyWDE1AR/TzVZD6LBTPdAJcAAd",
+	"L/Az2uefs9LQb6W6aMTX75Ssq3tnz905py6HusU4TWIBfb3pl4tV2Q4aWQHsR6k1fpQFfR2UCHYNCD1S",
+	"5Au+WpvovXh749IojKlZRjVplJTQp68yAqU5LLbW9yBKNoM1HA7oNuZrdCFrQyhaCXDza50WMgfCDNC/",
+	"Gd2yTSy3on4C9N4MqCunNay2rgg6HffNXaFjRnN7QjNEzYAit/GVta3sdNaFvVSMFqAMYoLIhfNrdB6X",
+	"uEiKHtPByOFE3KRWPoKrUjJnWoPriNV67gXNt2tML0N4QsAR4DAL0ZIsqbozsJdXe+G8ZLsM/fs1+eSH",
+	"X/TDjwAvKqP3IBbbpNDb1af1oZ42/RjBdSePyc5q6izVEiNRKi+ZYQPAHIaTwf3rQtTbxbujxRqS3jPF",
+	"+0nuRkAB1PdM73eFtq4GotbcMx0kPNgwQYX0glVqsJJqk+1jy9AoXouGFUScMGn3hoEHBK8XVJvXzuxX",
+	"oE7TXic4D/bBKYYBHnyGwMi/+BdIf+zGFulGILquKqkMK1JrQC+swbl+Ytswl1ym7JxGklqzfSMPYSka",
+	"3yHLvYDxD2qCz5Xz4uovDv3o4J7fJVHZAqJBxBgg575VhN04cmcAEK4bRFvC4bpDOSFcaD7TRlYVcAuT",
+	"1SL0G0LTuW19an5u2vaJy5liYU5SSKbRgOLaO8ivLWZtzNaaauLg8G51qM6xPtp9mOEwZpqLnGVjlI9P",
+	"PGgVH4G9h7SuVooWLCtYSXcJh0D7mdjPYwPgjjfPXWlYZoNv0pveUHIw9g4PLXG8BNP8SRL8QnI4gvAU",
+	"aAjE9d4zcsFw7BRzcnT0IAyFcyW3yI+Hy7ZbnRgRb8MrCVopTw8IsuPoUwAewEMY+vaowM5Z8/bsTvFf",
+	"TLsJfJtbTLJjemgJzfgHLWBAF+zimqPz0mHvHQ6cZJuDbGwPHxk6sgOK6VdUGZ7zCt86P7DdvT/9uhMk",
+	"DeekYIZyUDJGH+wzsIr7Exs20h3zdk/BSbq3Pvg95VtiOd41tw38Jdvhm/sVY+o1q2pze0/XabC35pkC",
+	"OTpuhR7Bn4kx5e6aDdcLBtJegZG4wpQ7uyK0rUfKm/t4nSdGJdwGTsMCfNwWPCriJmxLc1PuCAWY2Y5c",
+	"M8WIrhfWKaNvITKyyuIBkhankRmdvTlp7R01gJ/jUNHyUs6A9pUzDt9F56nTQod73VRSlhN0fj1kJCGY",
+	"5A1DKgm7zl0Qtw/j9WejBaS7hsqdB9ddfjGacQXkv2SN3q7e19VLaVKh6AN9cQauozmdy16DIeeOGrDz",
+	"6FF34Y8euT3nmizZtc988OhRHx2PHqFm6pXUpsUu7kHDCwzkLHEhdh30ulxyvw+XG3mSe1tncD8pnimt",
+	"HeHC8u/MADoncztl7TGNTPNfM9uJK79oezz11o37fs43dUnNfdjh2BUtM/CBVbxge/m7m5hL8c0VLV+G",
+	"bpjVgeUgEC95ydJvX2hR23Nlm4XVhVHnwYHtN44PBmuGROF5US+dF5CL0XFBOfYdhNMbRXOW5ZgK4cO7",
+	"ufZAmIhNdgF9bAIHGIcLbrgP3py6JezM9jq3nfaoDRp3ab7ZsIJTw8odqeCCLawlhetoW44IDkvyNRUr",
+	"fAQqWa9ckJIdB6+8Wlt1G5glu0MkBWWzFRkaLlJXoHM9dJcdisiMwjO9a/WwgsI1DfOxonUzTtyDrhUo",
+	"aficzwa1GIDUq0aLYZHTzr8x4TpsyfARfpqJJ5rHEHUgz/bxFW9Lw05AKcGQydwHX9lWXLEhRSnfsHlk",
+	"qCT4dsCDzyqZr+f4X22BIVyTguucKgxVND6bDRKbfXOniWuE9mHHg4JMLuPp5h2WpDvfUbcMXLOmkcMt",
+	"amukGIDEdc34ADgRow/z+vmSxnz0HMqmxkI4E1y8CIh9KDQZPJfeqDy8f97mTMw4PieQvKeVxhM+Qlh7",
+	"sTFoU86B5Vt4DFKovZnbFu/HBtkMPQhaa+IobLH5OBS5CPrDcncPbx47EDzCbASYbundtf0ql3GiKUf0",
+	"eqcN2/RNk7brXwfI8/WgAkyKkguWbaRgu2RuRS7Yj/gx1dtKyQOd8b0y1LerVGnB3wGrPc8kErwjfnG3",
+	"u9dT1wSvv5Xqvnw87ICT3/wTXCr2+g+5KW/r+AG+9X1fCZeGpnv76XmIPuCKUK1lzpGVn0HIIheNe4UL",
+	"RWyj/1UIrr+Hs9cdt+MUEGc4Q6MXKytCSY4hmNBUG1Xn5o2gqHSPlprwSvXaxWEzzNe+SdrukzDLuKHe",
+	"CIrXZFDFJy+tJUs8DL5lzFtjdL1aWXm+lQyVsTfCteKC1IIbnGsDxyWz56ViCl1Dj2xLCDxZAk0YSX5j",
+	"SpJFbdqPf8yypA0YdayHAkxD5PKNoIaUjGpDfuTg/wbDeS8mf2QFM9dSXQYspK/QFRNMcz0QLPid/YqB",
+	"Sm75ceyg6+y96D/0S8bDzotByM+eO8XY2XPUfkSxR13YP5hBc8NFliSy2D2tQ1vkE8x35wjoYVvbb9bs",
+	"jQDfQyMh3SIvqLkdOXRvmN5ZtKejQzWtjeho9/1aD9Qp3IHLkAST6bDGe0oTAItPZ9tC4dMl0IJWZFkL",
+	"u5X+6WmTyTTBovOQUc0mW35GMN3Wmnqvdffnk88+n82bNFnh+2w+c1/fJiiZF9tUMrSCbVOqojjq64Em",
+	"Fd1pZtLcA2FP+gZbZ7V42A0DHaNe8+rDcwpt+CLN4XwMplM5b8WZsBFLcH7QZ2PnTMFy+eHhNoqxglWp",
+	"QP3XbUENWzW7yVjHj87mHpgTfsSOuirfYuWj/CkG7sfJISawiXAOLKF5qoiwHi/koLDhDlnG8Vru8tf3",
+	"/hxyA6fg6s6ZClF48N03F+TYMUz9ALHlho4yqSX0SPZD28PSENoKkn0j3ojnbImqNymevREFNfR4QTXP",
+	"9XGtmXKpFI5WkjzzSWWeU0PfiJ6kNZgdPo5rr+pFyXMw0KXI02b87Y/w5s2vYNR58+Ztz9ms/3xwUyX5",
+	"i50gA0FY1iZz+Uozxa6pShnzdchXiSNj79FZrZAtaxe3b8cnbvw0z6NVpbt56/rLr6oSlh+RoXZZ2WDL",
+	"iDYyBNhyHfISwf7+JN3FoOi1VyrWmmnytw2tfuXCvCXZm/rk5FNGWonc/uaufKDJXcUmqxYH8+p1NYq4",
+	"cPusxOCbrKKrlOrszZtfDaMV7j7KyxvYAhB0sVuMkxAxhUM1C/D4GN4AC8fBGY5wcee2l89Nn14CfsIt",
+	"bGeRutN+RUnAbr1dexKJ0dqsMzjbyVVpIHG/MyFltUt1Y93LwI4Lh8Bl914wmxvHpV1mm8rs5q3uctkS",
+	"ND3r4Fb36UKmMdcO2ichUXdVeK0kFbtubk5tQ8Rw0Nfsku0uZJNR9pBknO3ckHrooCKlRtIlEGt8bN0Y",
+	"3c13brI+ct6lWMRodE8WzwJd+D7DB9mKvPdwiFNE0cpdOIQIqhKIwA5DKLjFQmG8O5F+anlc5EwYfsUy",
+	"VvIVX6RMe//RN4d7WF2OKMavfK6DMKAGCzk32mchcc97BQYmQtFfrpKalrY0RNILDd9Da0aVWTBqRo1c",
+	"Is6q56GD/uQaTpbV8M1hCWwL+80NauwEu2aFUxTZNi4c42jYodYCzopbwuO7Ny+Fo8G3rkNdIm26v5UD",
+	"dsOz1vkax3R2sQ7fMdvQSslrjQnHCiJdyQCbmTK6X2pNVwPWjpZnwMSkfi2DPw6yTyJJyiBgK26LGj1J",
+	"IAmybZzBmpNnmMEXOMT4zOx4mPuZrH+IM5hiJSCHsEWJAmxwxbd7T1XLiUKsxkBLsxamRCMKejDaGImP",
+	"45pqfxyLecRlJ0ln7zF35Vh+7bPIOTqq7BCyZ/vbsMtBe+9+l2Xbp9b2+bTjR/+E3Ng2K1id3g4pUDQt",
+	"WMlWduG2cSen1QMdbRDA8XK5RN6SpfysIwV1JAC4ORi8XB4RYm0jZPIIKTKOwEa/JxyY/CTjsylWhwAp",
+	"XNZa6sfGKyL6mx0NpgtDwSKTFVyufMDYnnsO4HLrNJJFJ0QEhyFczAmwuStaRokam0F6aZ7xQdFJ6uw8",
+	"7x4OPTRGTFP2yj9oTdjjVquJpVkPdFrUHoF4IbeZTbmQfIsstgug92QwFvRKHkybUPuBxqRk4J+KV4sN",
+	"/tkDyzAcHowGAMyUDGvHfkNylgVmbNpxOTdFhZp8EqTOhlyGBL0pUw/IlkPk8kmUI/tWAHTUUE3BOaeW",
+	"2Ks+aIsn/cu8udUik7+Pc00d/6EjlNylAfz19WPtrNbfN9nLhzMku0YfJp13X7N0lzTrtjMCog/Kst4l",
+	"hxYQI1h91ZUDk2httergNcJaipVgesueUbKPNs1Kho/grCWaZpdsl37LM7zHz323SFmHu0fF7mHkP6zY",
+	"imvDGqORd4r7GOr4JmXp4OpMpZawvtdR1tk4WWm8zA++AgwpWnIFsStgcUsuARp9q1GJ9C00TUugrc0m",
+	"tmIaL9IcF6eFKNSCl3WaXt28PzyHaX8KF42uF3iLcWG9ExdY4S8ZiTEytQ3WGV3wC7vgF/Te1jvtNEBT",
+	"mBgzAbfn+IOciw4DG2MHCQJMEUd/1wZROsIgowwafe4YSaORT8vRmLWhd5gKP/ZeLzWfx2Po5rcjJdcS",
+	"5TVN+1rK1QpCP226Mm8PE1FWzFKKVVSKtqrGkoAeQf0j7VJpjmThdFE4bCgGJxL3Mw4W2zT0UTMLeRMq",
+	"jBlEcRIw02P+pbRaSK72RPhgi0hX94Ftod34n2QMxEXHmN34rNpdCtuJG1AyWrg3iWZ+fePHsr8hDnXz",
+	"oeiJVvmG8SOEAyJNcRNVZ+znVRlgwLSqeLHtGJ7sqINKMHqQdnlA2kLW4gbbg4F2BECS4Fr1gFycgVOw",
+	"H+Ob9xheZTbwwHnVA33T3GUUKWqFFoyWW3+/+FR4q01c+w+/nBup6Io5K1RmQbrTELicQ9AQlXbSxHDr",
+	"TlLw5ZLF1hd9G8tBC7iejr2YQLoJIkubaGouzOdPU2S0h3oaGPejLE0xCVoYsslf9K1crm2sSgpXQrQ1",
+	"tzBVJfOP/MB22S+gdCAV5Uo37rnO7NS+fA/Y9avND2yHI+/1egXA9uwKap5eM6TBlKY/fNJR8vcHOsaY",
+	"fV62tvCAnTpN79I9bY2rLDdM/M0tE6+os5S7HIzGSQJgmbIb52nfBDg9rI34Linv24Sh8JCoUyzvx1Nx",
+	"7evw96+ikFxnH+1CZkxPvLic2c18djdPgNRt5kbcg+tX4QJN4hk9Ta1luOXYcyDKaQX+W7TMnL/E0OWv",
+	"5JW7/LG5d6/4wC+ZNGVffHP64pUDH0zSJaMqC5qAwVVhu+oPsypbi278KrHlC5yi02qKos0PKeZjH4tr",
+	"LFXQUTb1Kjs2/jPNeN7nYpl2eN/L+5yrj13iiMsPq4LHT2PzxM4dJx96RXnpjY0e2gHndFzctPKgSa4Q",
+	"D3BnZ6HI5yu7V3bTO93p09FQ1x6ehHO9xFy76ReHcJl4kRU55x9679LTt1K1mL8Ly006D70/sQqEbIvH",
+	"AV9tX4S/K0wdESt4/W31NziNjx7FR+3Rozn5W+k+RADi7wv3O74vHj3qA21vuzSTQC2VoBv2MERZDG7E",
+	"h32AC3Y97YI+vdoEyVIOk2GgUOsF5NF97bB3rbjDZ+F+AXMs/HQ05ZEeb7pFdwzMlBN0PhSJGJxMN7bu",
+	"vyZSdH2qMQIcSMtmh7E1Zqwxtn+ERL1BA2amS56nXTvEQgN7FdaZEhoTbDygrYURaz7gmytqHo0FzaYk",
+	"ge4AGc2RRKZO5qFucLeQ7njXgv+jZoQXTBj4pEJVweiq848DHLUnkKb1Ym5g7BMNfxc9yIi9yeuCxpQg",
+	"o/a758Gm5Beaqlx6oAd4PGOPcY94bzv6cNRso9nWbRfMae8Yb9BLqg+cBdEzOmesG5ijKZKO/WzCK66z",
+	"pZK/sbQhBO1HiTw4biJ8jmDvlOdel6UEo7JfTzz7vu2e/jYe2vg7v4X9okPp5NtcpulTfdhG3ubRq9P5",
+	"5+ez+Eim4bIfSTs0YIC14PGKnGExgYv3PqLCniebAqUVYZY+lVELfWzHb06lg7m7q3lJrxc0v0y/hQCm",
+	"aHtbflJGEt/Zb4AOCT7s7CTy4A5tuU2NWTHV2CD6abZv+a6x005+0TQPGOjYerrYMq201DIxTC2uqTDM",
+	"uzFYfuV6a2ZN8NDrWipMbKvTLl0Fy/kmqY598+bXIu+77xR8BTPZtK+ELo3LX+EGIjZ7LlJRwXVV0l1I",
+	"W+NQc7YkJ/PmTPrdKPgV1+DIjC0ez11pUI3XZTCHhy6wPCbMWmPzJxOar2tRKFaYtat/qyUJb08U8oJj",
+	"4oKZa8YEOcF2j78gn7hijlfsIWDRCUGzZ4+/QIca+8dJ6pYt2JLWpRlj2QXybO+snaZj9Em1YwCTdKOm",
+	"va+XirHf2PDtMHKabNcpZwlbugtl/1naUEEBISmYNntgsn1xN9Gc38GLwEYF00bJHeEmPT8zFPjTQMw3",
+	"sD8LRlzb1Uii5QboyTNSf9j8cEd4NixPD3D5j+j/Wnn3v46u6wM/Y+gmTQ8UvZR/QhttjFYs4YoJMHjj",
+	"me4Y4hE588nSsSJgKARocQNzwdJRloQtxCxhXBjUf9Rmmf0FnsWK5sD+jobAzRafP01U1msXnxKHAf7B",
+	"8a6YZuoqjXo1QPZeZnF9IQpeZBsOrP5hk2MhOpWDjrrJac2QX+j40FMlXxglGyS3ukVuNOLUdyI8MTLg",
+	"HUkxrOcgejx4ZR+cMmuVJg9aww79/PqFkzI2UqUqoDTH3UkcihnF2RUrBjcJxrzjXqhy0i7cBfqP6//k",
+	"Rc5ILPNnOfkQiCyaY8HyIMX/8mNTygENqzYSsaMDdBnb2vK509t9YG/Dw7RuXfutdRjDbwOYm4w2HKWP",
+	"lQHve/y56fMx/IW6INk9bykcH/+NKHiDoxz/6BECDXpH2/RvT9qfLXt/9CidUT2pcoNfGyzc5UWMfVN7",
+	"6LQ++kJxm4/t6zUvUyoXksMHy5exNoJTXVbU+Frkcb31JvHFlGKbF1F+IBheZJhOAqfEsEUbh7ahXBT2",
+	"ooUfXMph51/e9DgiL1258JDLxsIeQewESpvdwo80d+ZnjOdy6ZDDLePqsHyEa2bEfQ8dOa1WVy6jpcIa",
+	"50SxkkIwKqzW+YWxoZgMQN9w8GszMtce10AFE/RfwdcNJphEglBDLEWBgS7uQH+4CMWGopPcV49MmGhu",
+	"HS8tIQRnpWllktOHa5/fTIAxiS2ZIAVfNzl4ALqEJv31D0qV8AGkloUbak7aNWo/vNh/PwGZaffw9LUF",
+	"3uDwxeMB/+gi4iNLN7iBTVjR8O3crtGdJJkifI8CUyj5Sm6nEk5HaPTE8ztA0QBKJurTcSW9GuRJ/5q9",
+	"Dl4RjcKoCwb+4LpVljA2wP1x8AyLn49gG3Lw/tIkY+xIfoqKfJ1068fkvX+1j+qWzGxlmxTW8jUVgpXJ",
+	"4awy6q9e8kio1f4up86z4WJi224NfLvczuIawNtgeqD8hIBebkqYIMZqO89dyKNSrmRhMyA3ZbUa5ng0",
+	"S+xVv8R2jwTtsJvaOEdzTN7gMoQteQn/G3D0wJaZomYg451yOYzDiOyKgWkZNSx2dKYI5RuUpDWFWod4",
+	"Mq8YOPRCVylYpzvmPMSRo5pZRFfwCVtihhlJTK3gul9Gy2DCcMXK3ZxUVGs7yAksi21x7tmzxycnST01",
+	"YmfCSi0W/TJfNkt5fIxN7BdX5tEWIzoI2P2w3jQUdcjG9gnHVbXGUgUpnoofbKg5dMZb21a0DtXXj8h3",
+	"mKoMiLhVmgagCSnv2xlw66qUtJhjKn5wpSN2VttHMUQUVtReAfwd8k/aQ6dnBPap2AZSXU0fZzz3jk06",
+	"no3kKn+BLZoS3bzjJIeK9xg7R+S5tXlo/wCykxAs6KA2rIhyn1utGxIH/McYmq+hgWxJQMO8cnopeM/O",
+	"GlNrFC585T8iwwa4XTV4Wwx+TiS8UK455BdfU8OuWDt/qQcjyPQun2l7eaoWwlLK0QHCaKi2eCjaPXA4",
+	"bvACSkLWQfyBqmQta5WzQyvjn2OvdPBUp8x+x03HZ8P0BSHIj84amFMhBc+xdFFKksZci9P8CiZUeUo7",
+	"BOiZO6GJw5Us7h+C9x0WB8v9z2ctxPV9dKKvsKmWOuyfhm1d0dcVM9pxNlbMUcvLS+Ys2Fxo5uppAhHF",
+	"fFKqhBdiMnIpqBIOJCNMozZgkvgWvv3kDFZwBMklt2USHNrc+8zamCHxDFC7INyQlWTaracdfqd/hT5H",
+	"mFa1YNu3Ry/kiufnfIVjWL9XWLZ18u4Pdepdvp2LNbT9Gtq6Si/h55b/pp30tKrcpMkQ9LDDvU9QzWQI",
+	"wSlHQ68ZiZAbxo9HGyG30VgNvE+B0KCcB9GGVXgP9wiDKZV6IX5ji4AARWELYkOgU0gpuUiA8YIL7/OQ",
+	"viDy5JWAG4PndaCfzhU1+brFhvZ5eA9ELGFKgfzyPobqbDCiBNfo5xjexoutcPV4BhhHaNBI/FTsiD8U",
+	"QN2RMAHxysF3HoWgtvkGpConRBUYDehS+FqxLM04gHFnPsa5ha698bahO9aOOvQmGkoquqiLFTOQsDKV",
+	"i+4r/Erwq4/qbOpzyWUUztsuKtCnNjdRLoWuNyNz+QZ3nK7gmmrNNosy4ef9PHxkRdhhoDTQTcK/qYqJ",
+	"wzvjohwODqP3IQ3FYZU0+mkBUlIv0HQGCdOmYwLvlLujo5n6doTe9L9XSvfx9b+L8PkOl4v3KMXfvoGL",
+	"I8603QsosVdLSISNwRsSv/sMZSGFa5srwbd+XVB0U8LNS2xZB3jfMAn4FS0HUlfExk17v1qD31ACi3ww",
+	"3wo1Lp+eoWSUBQ3mKLPO/R1zad/mP+TQb/3578/M6NY6itBhY/sPLdO6depsmMWgSf12Vu9mgw81e/9w",
+	"NZTTxBfWwe9xAR/ndjd3Nkd2xWXtNiwELfgnof3V5cxqFeoZWH8yFOhjWy0GbSwXroK+XaZ7k//wi3Wb",
+	"IEwYtfsdWFx6m96tApWQdrFFRLDuCdzTmg08alu34pSiU6n6Rk429Loyy1patNSrF9Ujq+dTxIEePm7m",
+	"s7PioAszVSNrZkdJHbsXfLU2WGLje0YLpl7tKSHSlA3BI1ZJzZsa6CUM5nI2r3G4o6nRQUDAPC6B0h/L",
+	"e41fsdxg4fvGG1YxdkhBFJjMG33+LCUy/JwOQVSugshY2ZB+tfs9d3wv01mUrY8FN4iJRTJOQ8yDDdmE",
+	"sq4hv1InycHkUOvlkuWYxnw0s9x/2KrCPmvZ3OtlEJZllGiOh8BDTMR/uNaxAaikt4SnpPcHzlDiiUu2",
+	"e6BJixqShb5D1O1tMn0jBqwJzCd9H1IkOzdPrgNlIBa8D7/tzppqNoNJ2qM8ibecy5MkoXHuxJEpr6Rh",
+	"t5wLuh6UpxVj6IaSz71iTL1mPlP5XqalQlN7M1TMJ1nbcL1gkJa4wKzikPMvYdukQrAiq4XhiW39WfBt",
+	"ZFGJKgJjhyjZGk4LnBLHmxPpPNj4svVZSOOaTD4G4RQsqEjwoybTI6YaBZUFJGKmQs9tMHIhIQuiE2KL",
+	"WgVc7akLvO9QItXI5ZIlX4YXngX4XeBSNZgAEtK5VEMcEy5UxgaiznCEs1dNJgFFqieV+zl9/HGuVB2C",
+	"BjZsMicFy22slkQ7FBR3IBe9/bUnghuiAMVN/cklX9WwKKDhr6i4WCumIZQhAsrpU7uHA5frAXV7nT4d",
+	"mKo4EiaHX+fPmaG81M7fm4Y8+rEOC9Tx3Tpw11SHE9NYFn1Gfqb9bz4lrp2l5JeuHA7yDGvHhSzKvsW9",
+	"5DjEZoSngV6GmXkTj9h3AepzQBvam5cShOxsKD66HQIY/OcfaBvo0OSjQ7iWTClWBINhKTXLjPRUOwbH",
+	"GCo0RnPcCgl6sJqfBW6wksPrplQFVjWlWLmBuiCOeIGRj29TUGJ4zjFkf22/+5wy3hN4r/410Ov+8uM+",
+	"EpXrHhJjql8Sd+Hsz1VzG1UsF4KpzNtlu9UlRDvBKKaRLurciq/xwQjq6snerSOsJKnFzPur7Lygo5wv",
+	"l2x3bFUELvtL2MEYaPuusKBH+bM7m3yvymmdgnt1L+B93LSolZRlNmAKPOuXxOhS/CUHlyoCN4WP2IKX",
+	"0YP22YBJyCdogQq+HtfrnS8BUVVMsOLhESGnwsbIerePdrXczuTigRmbf4uzFrWtUuNUzkdvRDrYEOvH",
+	"qDtyMz/MOA/TTBR3nsoOMj6R2Yohh7RrrDXTLkp9NFVn1XfE6IolDVFZKFIyybm1536NBz2lVsWMPlHq",
+	"KTTzU+LswESXMuXpfpusQzBUGlPxZAiQYWJK8psAhRs8iQDn4xbV3Uy+W0qauwOAhhHnn+w23kZRg63E",
+	"PhN8vDu6SGBIodG+hEan2pX1NLxklZnb1NLwJwiwihcFEy7VYKtrXMgyJZmNiEAuTmPeJusBgWa4KGE7",
+	"gQSLC/MoKP6HJ2TunBZuoVQ5pDZcZ37fAS+FMI62Ur8ttJZOOnAvpcWGKjhdrFlHlZUs3zQP9Zr40hPS",
+	"s3sp4/RSHNR/ahGnD17CKZ3gZ8LBfmmPVIKQ/BcdVatoH+/pGsaLdevw9i+GQzLgxcd8xGKniX1XdPPu",
+	"9YnLV3dC6mLMmXcmiWgBm3HFqoDTlOfJQGWh07iKzfsBMUrhNAahN2JPGjO6HYZ0Y6NEmERb33mMGd1E",
+	"OevEpk4MjMkm5Pu9/4y+KT+7YhaPuQdHB2OmlUn1faPmjplNh7DTGnYUQSnCTmMqTpjm0OJVCNOFBftt",
+	"KIXcaJa4i0S+sqjjSAa4+Fq/Vco3D9MYJr+S2wkIdIGcLsJVbudWJYMQmTaDuhWVEXkdPBQWcqDYQ9rv",
+	"9yJKGhJ1/914GUSo+0jgDZ025+q7nx3tKXnhPkdXrWKNz/Vtq1u4ghGDl741gHdnDrO0FSBLqVg8I75S",
+	"bCWbkNgFLkOC/1lwo6ja3aYGRRtVKWeDQSy/khX+W7x22DuFPgMrV02UqNXG+nc4rJSvXNUbi5L4TT0n",
+	"Wjp7uNHW8wITp1i8uYSmQBhFtIncNHjlooXH0+7zzUiwkFqPV4d3zCPskxqACRWBdwBvxiOh+iv3Xzvr",
+	"Dj/jwkc8LvfKm0PBVKMMbHAbqmoEpKlGqqkSZbhrBsGxH+8doIGyoRdrt/sdcCBwuKFgpCJy4k3u4KDc",
+	"PsA28sQO5OsNWLWcZfyQm4gLuJE0C+OfvSTWZ3p6noQ4Lvugo7s38DDEHDZnp4k77B+AspTXGa4wCyWY",
+	"Uw8IaKfbinVXLLQp3azdkWwiGKl2RpcdWdOC5FIplsc90loBC9VGKpZBsbFkEuAXfGk0KfmGG02wwu+K",
+	"yCqXBbOlzNPMf2gux4mywIkGUeDZl0xwr4lTgn7cekxn1gQ69UF0AX1sUtWm4IBddGYpcCA2n2lXYMBh",
+	"yDbuw4uEYzNyd73m0oLSkm+RbphK3dZLYlTN5sS1wNFbJITXA/DyDdfaghJo6ZqXJeY05dvmKmchRGdA",
+	"n+RutrCRGR242l7R/HLoFhoWIJqAq/7t52vdAVdp3kqIO/LaSkWaDJBbejUDBrkzDIe+4hgz187Wiz1I",
+	"pVjOQgrj+BI9j+sLELNWsl6to0qOAeveVUXVzpElHuVnXWNYI7JDmOIp2UhtnA3cjtRsYBMq+kkuhVGy",
+	"LL1tyDqTOUcA5yH7I92e5rl5IeUlZN19iBZ3VMu5lRZzn8i0G9TbzKQ6NTxiGwRa5KTXP009ey0lgvbR",
+	"b7i9en9xPdsOwPXM8WANi2PwPa/YfW6mEZhv918s+51uT/sL666rfcekLbWnglAjNzxPs5o/VrjtYJDs",
+	"APX0jff+RDp6jiP/22cY1e2WC7LC1yTBV4Y7j+hE5I2zXfelZhyXJVoxe7bqymZdaEvdRDOtuRT6ENE5",
+	"LLNdi7hJVqoP1y521MmTZeceLD3dVSRY30YvOwbSgKjagyl6t7sHjPdCub0+Nla2HCRgxjJG6tDaHi6D",
+	"uXfg0i1pM8QBoozTJx0maNK4dErc3efioZCKZWV1P71xyZJR05s7knQT0oFNrTJhZgTR5tM1tUKFEY0h",
+	"cCP5HWyLVS7nys5H6M1J1Q7y9fHtgikbJInxspGU8A1i6Dm7YiUg7vTVWXpBzkab5YOW5P3ratl5g2Qg",
+	"V1YjiI+gLuYnyrm4qrvBBiPcO1CG3QmoXuaBAOAnlrvMre0gvCX994dNpaZbAb/n2Lbu7aHw6vPmrChs",
+	"Eqo6DFzG6Xqwo7HIF5gjejE1IjnIyhPfHBEAwzHKLRgmRSofCsaS8pIVGTUDAjp6wM0jy6zzfY5G5062",
+	"9soxK3TD44LyslbMVRmw+kLVduOOk3pC876fKvg8Mqsq+40piTFmxTyKffDpRDuuRrLKSuA8bWUe0LKu",
+	"8WEE/tKurw6dScFYxZTjalFXPab9Sdyabu1ZFNU6BbtJPy2LWLtTZI8TVtKn2j8n7wrU2BtTogS25MGc",
+	"nH5a4lrm/VWkM7lvRWbPt57KAwDqK17UtLXx+lDBo+0dCTwosce992/m0TF1mp/tCF6BrU99/9Tzx2Pi",
+	"7TQGejDvTKNujHPuTa5Q6yF2JdK5FeKCJMHvHGcrQvSWPZsNw9MVvRbDfpr9s9poribuE5ciQuw3W5aj",
+	"fOlUR6xwyqNR/bs9RVYOti/NlUg4Ia+ZIEI2GiR00vR6kqZSmv/BToyNuHCKyVu4jDQpEO6+swQHI7pT",
+	"Mim5E54zFSlN15QDNGQDajGPu3lEf5RTPnrIB8dL0Z9mLh/hiIXRnxynBsEGsi4LIoBWQBcB8VT+ane3",
+	"yJwsaj8QqAP7tq7nzIeeWMr2Xvd2Rb6OERrnLLrnw/a0kEAH7GFS4T9CGvKPmpZ8uUMeZsH33YheUyBP",
+	"F+tiQxRdWgqYeFzmnHvAvFJc+qnsuvnUMaPhdv6WdCOBdOOMqljq55LF24DRl5Y35waYsq4XqGAGOaaz",
+	"nX0suMX7Ig8bWsQqTCw1t2txnvh+/v+b5HzxVL5CFDq0Fn7zNN10PLtRQgzEdRubpSeBxnYZiDaYwYpb",
+	"GKXvbtm0nnX7wI7eVi2vuntaxkTbeqdK/0Gm2sRS7nsX7mTMzbwb4B7w2y6DHwL/ySqQB9qkW+D/XvA+",
+	"YrP28Drb9fvH8rjp2RsVF3KbKbbU+2L6sHXHyB5sRy1DeWMkD0UOuQDtgE1SEcJIwigFW3LRMEsuqtok",
+	"HneoxRa7CGGxbRbROhC1MCQlgKB6RcsRbf0FRqGgwrZTZN7bo13fhF4n3Kn9Abhu3pCYMLKxdsbN4AIv",
+	"+HLJlM0foQ0VBVVF3JwLkjNlKIdwoZ2+veE/2HD3mf5pJM200xhHTgBI2haQcuficO5olg8A0nu0z0+w",
+	"q1+smaP+tvLX6ruMHDCj92H4Q9jVN3QLrhiY1nDgQLjqluiIgc2IFGiWs/LZtHX7eTT/jY1PgyVgHCMy",
+	"EmedMsX4uX+JW4lP1J8FN6Mn3ypuu3kmbSIQezA9UsWqyUZkiaV/Hqs8PVnHchBMRi53lqc9Fm0iGzJ8",
+	"t4wFA7uIkWcur2xsGTjAONYKbkvcME7rkKE2Qo/kG2osZIhr7dRUvQjfrhrDIiWOhDpA/WiNFv5eGgDP",
+	"+cM738PWtCFKEU06k2WfKCQvDVElqyyfEmZva/8XFgAPaRvGMceIUeoIEYnaW55b1BiJvA80aULlDhW/",
+	"rbXcz7XX+l7lY4/+IRXUAEdv22XkEnmZs//RqiJSxYqaeTfpXVvFFpgEoUSxvFaoO7+mu6QjMiZwztyJ",
+	"H6g5e/796WePn/z1yWef2/phBV8xbSL3IxwksI0Qis1FV6f0Yd3We8sz6U3w6ZDxc7Ay+yxvYVPcWbPc",
+	"VjdFCVurP9RonrgAEscRw0ubhEO33iscp8k19PvartQi733HUih4/3sG/mfpuvFBrkoYcFK7FdmV4AVS",
+	"MaW5Rk+OtlmYmyYJhV6jehCrh17Z9PZS5Mzrph0VcDPgEZhayFAOA+Rn8Ik4qxVh26p0vMqav8bW5d5p",
+	"VkOHQiO6F4EWy6uO4YZNQYT+1SpK9ekUn6htj9ISBGZrExSkSwFiso806Z06GxnQ1zi3b6ynnlEnOD1s",
+	"YkK88IfyFqQ5ZPsYTqR8G07SmA1+N/wjkRn63rhGWO774BXJ98FIEtTTnjNIyIo8CbR+luAEeSAAA+k/",
+	"W4kbo8x1UWVEZa0EaE/wBuyu+PFjY9jem4kHIfEd9oAX5/Ns2gX/RAfOR45T+zEgJVrK2yFKaC1/X7Y9",
+	"z3rDRRJtkVOaGMO0ZUuyLxZG+V/11yGt6sCrpJd9VUlpiBSgG0lkbbV6HDxTMeFwYZi6ouWH5xrfcqXN",
+	"KeKDFa+Hs1HFqTtjJFtU6tsVDnpBJ81d0vcwtXiFmWL/g8EeJe85N5Qz8PduM1Tu0NJGwSzjSr/XOCbu",
+	"NHn8OVm4cv2VYjnXXceBay+chEyVTIF1LGQ+HE+NuW+dv0hzBzJeevck8lMrNM/5AzgImyP6kZnKwMlN",
+	"UnmK+npkkcBfikfFQfB7ros7lna/XR76qKLMgXno++H9U5eH68BLp9asv87Jt3ULt4mLulnb1CIKkyvE",
+	"v3nzq1lMqX2QruYO3bH4wr2UdT+oqPt7KLtgceTGcPOmKOaXoUJ8ttjcQLHQzn7Urj78qFUtLv0KWSuY",
+	"YJprLG7618XnTz98tjsPgU2J0D+qFta75K+3iEmstTV5NFVU1HVCPVfXLVGEExPJ5bXiZncO+PcKNP7X",
+	"ZIGI70KycZesPtjS3N1n5CUT3t+jSU1ea3+7fidpifeRNfEJRgzkYiLf2JKj7qB8+WDxr+zTvzwtTj59",
+	"/K+Lv5x8dpKzp599cXJCv3hKH3/x6WP25C+fPT1hj5eff7F4Ujx5+mTx9MnTzz/7Iv/06ePF08+/+NcH",
+	"wIcAZAuoT5zwbPaf2Wm5ktnpq7PsAoBtcEIrDvncb27wrbyUsHxEao4nkW0oL2fP/E//y5+wo1xumuH9",
+	"r3CUFDRfG1PpZ8fH19fXR3GX4xVmW82MrPP1sZ/nZt7B+OmrsxCJYf1wcEcb7fHRrCGFU/z2+pvzC+Li",
+	"HEL5zNnJ0cnRYxhfVkzQis+ezT7Fn/D0rHHfj7Hg17F2tXyPQ0jtzbz3rapspV/45GjU/bVmtDRr98eG",
+	"GcVz/0kxWuzc//U1Xa2YOsJoMvvT1ZNjL40cv3NpiW7Gvh3HniHH76K/Ml7coeexT38+0t97TuxrcvzO",
+	"p3S5Oaz1BCD2t4jVL8fOEw62OmmI/Y4ZV5XCakQSSZvR/uFGnxNtA90wPodL4CKYma9g6K0AXdHZYk6M",
+	"qkVuTdh2Cibwvz+e/iea8X88/U/yJTmZu9gQDO5NTm9TbwbyPyss2H3vSf3V7jQkfW9M/rNnv6ZUXxZx",
+	"pIJ05Tmx0hOyDzgb0ekOIzbcG/WcM3t7ofkx3EVwv5xkX7x999lfblIybk9iD0iKMj3HqDeSFFxXJd0h",
+	"0jZ0++UQyrYuWADG/UfN1K5ZxIZuZzHAfbtuojiMD067jtIbhcJbjS/lv5+//IlIRdybHiLDQwipDz5u",
+	"Aq7j2GPoOQSxu+5joJmoN3BzuljUjV5V7TqJAc1v5zMPKDK5JycnnrO7d1N0+I8dN4pm6ijb+oQG66aR",
+	"+rSfExUUnzSHjOZUR94b6EtpTCoDE0SOxAOMK2z7M7otSQaMHJqWtS+bG2louQc+GzU0QMjOEQnTMO7P",
+	"g9pDRhKCtynhJt5aTyN/7u5/j93ty0qkknCmOXqLN1eOv85aQDoJudx5cAcyTh+R/5I1SrTwVqkNCyxQ",
+	"KmRn4cLkOprTJchvMBRFeeGXR4+6C3/0qHFGXLJrZLJUYMMuOh49OoKdenogKxvVnreqLU46O4cM19us",
+	"H+k2+HJTIqTIBGZnvWIkegY/PXn8h13hmbDe8yDC26fGzXz22R94y86EYUrQkmBLu5pP/7CrOWfqiueM",
+	"XLBNJRVVvNyRn0UIT7BPMZRPUrV5LoW8Fh4R8IquNxuqdk6IpoHn1CKUc9jDf7qMJxK0kYvSlUYPHRRR",
+	"Z618z2I1e3vj3wATX0RjzY4XcntA094zZF8HFr+bhh9JaGDRx+/QRDD4+7Gz86Y/oqnG6gC6YHZb2voM",
+	"6Y+tZ9Q7s008z7o9tryIxsupydd1dfwO/4PP+WhFthjysdmKY3RtPX7Hi/7nHiLavzfd4xZXG1kwD5xc",
+	"LjUzez4fv7P/RhOxbcUUh/uLlq1fpTJMHSsGb5I+fDb3yrGuq6rc9X/eiTz5Y3+gbm7z1M/HXt2UUh20",
+	"W75r/dkmuooxpY9VXARs4MvxO/gl6qrXtSnkddQFbTzWQNlfVMi13vr7+JpyAxKbq/tGl4DhRGfF6MZt",
+	"XPOzYbREbmhzc8S/NuWWe1+whnT0Y0f0q6TNktd+db+m1xetCF1l0x59JYvdyKWwzRZcIKeMOXmj0LUf",
+	"+8+4m3nCvoWey94mnpCTjSQLJWmRU23gD8HMtVSXvff7zR3fiN0sTWcJiyeC6fIZd/k9sLD9yeJx3CmC",
+	"cLQv5Oy5n7AJ3XvvwmMPoq9oQXxaxYz8SEvYcFaQU/dEaWHjfQt+H19S+8ii1QeThb7yh08TivViWo9Y",
+	"lU5/Zu3PeFCnCD7w0gUGsGIicywoW8hilznlkaLXZmtTvnSZ2zFt30Gtb6gz1EMf70GT+vtWn+7Tmv6p",
+	"rPxTWfmnOutPZeWfu/unsnKisvJPVd6fqrz/kaq8Q/R3KTHTaZyGpU1+xUS7ZIR799GmmHJg8e1kdNwE",
+	"mawVgIt1m7k5IpAzQdn8PZpdMUVLklPtK9JhKOQG/WIxpR0rnr0RWQsS630KE3/S/Ne6/b6pT04+ZeTk",
+	"YbePNhAlE/Hmfl+Ud/GTjcz5kryZvZn1RlJsI6EMO4YRx8U8ba+9w/5/YdyXvSrAmD8AsxL5zHdE18sl",
+	"z7lFeSnFitCVbFzWgW8TIfELUwAcA56rCTdzF+LDXVy53ZVOzdG25N6XAM6aLdzr9tAhl7THAxDege4O",
+	"/zLF1+F/tJR+2zxgd2Wko2PfzP/kKh+Bq3x0vvJHNyRHqsX/lmLm05Onf9gFxYron6Qh38JhuKM45jK2",
+	"5in91q0FLZ9iZ0Dd5z8f+yT+U9sdv3P/axuZDuh0TIsrKvIAWeNsHjtv4/0e3LZ/fQtXlGbqyl/9jS/y",
+	"s+NjzAa3ltocz27m8Tfd+fg2YPOdvzcrxa8ATzeod5WKr7iAem/WmTdr/I2fHJ3Mbv7fAPz4hI7UTAEA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
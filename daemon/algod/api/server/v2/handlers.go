@@ -50,11 +50,13 @@ import (
 	"github.com/Quarkonium-chain/go-quarkonium/data/bookkeeping"
 	"github.com/Quarkonium-chain/go-quarkonium/data/transactions"
 	"github.com/Quarkonium-chain/go-quarkonium/data/transactions/logic"
+	"github.com/Quarkonium-chain/go-quarkonium/ledger"
 	"github.com/Quarkonium-chain/go-quarkonium/ledger/eval"
 	"github.com/Quarkonium-chain/go-quarkonium/ledger/ledgercore"
 	"github.com/Quarkonium-chain/go-quarkonium/ledger/simulation"
 	"github.com/Quarkonium-chain/go-quarkonium/libgoal/participation"
 	"github.com/Quarkonium-chain/go-quarkonium/logging"
+	"github.com/Quarkonium-chain/go-quarkonium/network"
 	"github.com/Quarkonium-chain/go-quarkonium/node"
 	"github.com/Quarkonium-chain/go-quarkonium/protocol"
 	"github.com/Quarkonium-chain/go-quarkonium/rpcs"
@@ -102,6 +104,7 @@ type Handlers struct {
 type LedgerForAPI interface {
 	LookupAccount(round basics.Round, addr basics.Address) (ledgercore.AccountData, basics.Round, basics.MicroAlgos, error)
 	LookupLatest(addr basics.Address) (basics.AccountData, basics.Round, basics.MicroAlgos, error)
+	LookupAccountWithResources(round basics.Round, addr basics.Address) (basics.AccountData, basics.Round, basics.MicroAlgos, error)
 	LookupKv(round basics.Round, key string) ([]byte, error)
 	LookupKeysByPrefix(round basics.Round, keyPrefix string, maxKeyNum uint64) ([]string, error)
 	ConsensusParams(r basics.Round) (config.ConsensusParams, error)
@@ -114,12 +117,17 @@ type LedgerForAPI interface {
 	BlockHdr(rnd basics.Round) (blk bookkeeping.BlockHeader, err error)
 	Wait(r basics.Round) chan struct{}
 	WaitWithCancel(r basics.Round) (chan struct{}, func())
-	GetCreator(cidx basics.CreatableIndex, ctype basics.CreatableType) (basics.Address, bool, error)
+	GetCreatorForRound(rnd basics.Round, cidx basics.CreatableIndex, ctype basics.CreatableType) (basics.Address, bool, error)
 	EncodedBlockCert(rnd basics.Round) (blk []byte, cert []byte, err error)
 	Block(rnd basics.Round) (blk bookkeeping.Block, err error)
 	AddressTxns(id basics.Address, r basics.Round) ([]transactions.SignedTxnWithAD, error)
 	GetStateDeltaForRound(rnd basics.Round) (ledgercore.StateDelta, error)
 	GetTracer() logic.EvalTracer
+	ProveAccount(addr basics.Address) (ledgercore.BalancesTrieProof, error)
+	ProveResource(addr basics.Address, cidx basics.CreatableIndex, ctype basics.CreatableType) (ledgercore.BalancesTrieProof, error)
+	ProveKv(key string) (ledgercore.BalancesTrieProof, error)
+	RegisterBlockListeners(listeners []ledgercore.BlockListener)
+	UnregisterBlockListener(listener ledgercore.BlockListener)
 }
 
 // NodeInterface represents node fns used by the handlers.
@@ -131,6 +139,7 @@ type NodeInterface interface {
 	BroadcastSignedTxGroup(txgroup []transactions.SignedTxn) error
 	AsyncBroadcastSignedTxGroup(txgroup []transactions.SignedTxn) error
 	Simulate(request simulation.Request) (result simulation.Result, err error)
+	SimulationSessions() *simulation.Sessions
 	GetPendingTransaction(txID transactions.Txid) (res node.TxnWithStatus, found bool)
 	GetPendingTxnsFromPool() ([]transactions.SignedTxn, error)
 	SuggestedFee() basics.MicroAlgos
@@ -147,6 +156,9 @@ type NodeInterface interface {
 	UnsetSyncRound()
 	GetBlockTimeStampOffset() (*int64, error)
 	SetBlockTimeStampOffset(int64) error
+	PeerReputation() []network.PeerReputationEntry
+	ClearPeerReputation(peer string) bool
+	ReplayExporter(rnd basics.Round) bool
 }
 
 func roundToPtrOrNil(value basics.Round) *uint64 {
@@ -426,24 +438,28 @@ func (v2 *Handlers) AccountInformation(ctx echo.Context, address string, params
 		return badRequest(ctx, err, errFailedToParseAddress, v2.Log)
 	}
 
+	myLedger := v2.Node.LedgerForAPI()
+	rnd, err := stateLookupRound(myLedger, params.Round)
+	if err != nil {
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	}
+
 	// should we skip fetching apps and assets?
 	if params.Exclude != nil {
 		switch *params.Exclude {
 		case "all":
-			return v2.basicAccountInformation(ctx, addr, handle, contentType)
+			return v2.basicAccountInformation(ctx, addr, rnd, handle, contentType)
 		case "none", "":
 		default:
 			return badRequest(ctx, err, errFailedToParseExclude, v2.Log)
 		}
 	}
 
-	myLedger := v2.Node.LedgerForAPI()
-
 	// count total # of resources, if max limit is set
 	if maxResults := v2.Node.Config().MaxAPIResourcesPerAccount; maxResults != 0 {
-		record, _, _, lookupErr := myLedger.LookupAccount(myLedger.Latest(), addr)
+		record, _, _, lookupErr := myLedger.LookupAccount(rnd, addr)
 		if lookupErr != nil {
-			return internalError(ctx, lookupErr, errFailedLookingUpLedger, v2.Log)
+			return v2.stateLookupError(ctx, lookupErr)
 		}
 		totalResults := record.TotalAssets + record.TotalAssetParams + record.TotalAppLocalStates + record.TotalAppParams
 		if totalResults > maxResults {
@@ -462,9 +478,16 @@ func (v2 *Handlers) AccountInformation(ctx echo.Context, address string, params
 		}
 	}
 
-	record, lastRound, amountWithoutPendingRewards, err := myLedger.LookupLatest(addr)
+	var record basics.AccountData
+	var lastRound basics.Round
+	var amountWithoutPendingRewards basics.MicroAlgos
+	if params.Round == nil {
+		record, lastRound, amountWithoutPendingRewards, err = myLedger.LookupLatest(addr)
+	} else {
+		record, lastRound, amountWithoutPendingRewards, err = myLedger.LookupAccountWithResources(rnd, addr)
+	}
 	if err != nil {
-		return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+		return v2.stateLookupError(ctx, err)
 	}
 
 	// check against configured total limit on assets/apps
@@ -490,12 +513,35 @@ func (v2 *Handlers) AccountInformation(ctx echo.Context, address string, params
 	return ctx.JSON(http.StatusOK, response)
 }
 
+// stateLookupRound returns the round requested for a state lookup, or the
+// latest round if none was requested.
+func stateLookupRound(ledger LedgerForAPI, round *uint64) (basics.Round, error) {
+	latest := ledger.Latest()
+	if round == nil {
+		return latest, nil
+	}
+	if basics.Round(*round) > latest {
+		return 0, errors.New(errRoundGreaterThanTheLatest)
+	}
+	return basics.Round(*round), nil
+}
+
+// stateLookupError responds to a failed state lookup, telling apart the
+// rounds the ledger no longer has the state of.
+func (v2 *Handlers) stateLookupError(ctx echo.Context, err error) error {
+	var roErr *ledger.RoundOffsetError
+	if errors.As(err, &roErr) {
+		return badRequest(ctx, err, errStateRoundNotAvailable, v2.Log)
+	}
+	return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+}
+
 // basicAccountInformation handles the case when no resources (assets or apps) are requested.
-func (v2 *Handlers) basicAccountInformation(ctx echo.Context, addr basics.Address, handle codec.Handle, contentType string) error {
+func (v2 *Handlers) basicAccountInformation(ctx echo.Context, addr basics.Address, rnd basics.Round, handle codec.Handle, contentType string) error {
 	myLedger := v2.Node.LedgerForAPI()
-	record, lastRound, amountWithoutPendingRewards, err := myLedger.LookupAccount(myLedger.Latest(), addr)
+	record, lastRound, amountWithoutPendingRewards, err := myLedger.LookupAccount(rnd, addr)
 	if err != nil {
-		return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+		return v2.stateLookupError(ctx, err)
 	}
 
 	if handle == protocol.CodecHandle {
@@ -578,10 +624,13 @@ func (v2 *Handlers) AccountAssetInformation(ctx echo.Context, address string, as
 
 	ledger := v2.Node.LedgerForAPI()
 
-	lastRound := ledger.Latest()
+	lastRound, err := stateLookupRound(ledger, params.Round)
+	if err != nil {
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	}
 	record, err := ledger.LookupAsset(lastRound, addr, basics.AssetIndex(assetID))
 	if err != nil {
-		return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+		return v2.stateLookupError(ctx, err)
 	}
 
 	if record.AssetParams == nil && record.AssetHolding == nil {
@@ -616,6 +665,66 @@ func (v2 *Handlers) AccountAssetInformation(ctx echo.Context, address string, as
 	return ctx.JSON(http.StatusOK, response)
 }
 
+// respondBalancesTrieProof writes out a balances trie proof, or the matching error if the proof couldn't be produced.
+func (v2 *Handlers) respondBalancesTrieProof(ctx echo.Context, format *string, proof ledgercore.BalancesTrieProof, err error) error {
+	handle, contentType, herr := getCodecHandle(format)
+	if herr != nil {
+		return badRequest(ctx, herr, errFailedParsingFormatOption, v2.Log)
+	}
+
+	switch {
+	case errors.Is(err, ledgercore.ErrBalancesTrieDisabled):
+		return serviceUnavailable(ctx, err, errBalancesTrieUnavailable, v2.Log)
+	case errors.Is(err, ledgercore.ErrNotInBalancesTrie):
+		return notFound(ctx, err, errBalancesTrieEntryDoesNotExist, v2.Log)
+	case err != nil:
+		return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+	}
+
+	response := balancesTrieProofToModel(proof)
+	data, err := encode(handle, response)
+	if err != nil {
+		return internalError(ctx, err, errFailedToEncodeResponse, v2.Log)
+	}
+	return ctx.Blob(http.StatusOK, contentType, data)
+}
+
+// GetAccountBalancesTrieProof returns a proof of the account's inclusion in the balances trie.
+// (GET /v2/accounts/{address}/proof)
+func (v2 *Handlers) GetAccountBalancesTrieProof(ctx echo.Context, address string, params model.GetAccountBalancesTrieProofParams) error {
+	addr, err := basics.UnmarshalChecksumAddress(address)
+	if err != nil {
+		return badRequest(ctx, err, errFailedToParseAddress, v2.Log)
+	}
+
+	proof, err := v2.Node.LedgerForAPI().ProveAccount(addr)
+	return v2.respondBalancesTrieProof(ctx, (*string)(params.Format), proof, err)
+}
+
+// GetAccountAssetBalancesTrieProof returns a proof of the account's asset holding or params inclusion in the balances trie.
+// (GET /v2/accounts/{address}/assets/{asset-id}/proof)
+func (v2 *Handlers) GetAccountAssetBalancesTrieProof(ctx echo.Context, address string, assetID uint64, params model.GetAccountAssetBalancesTrieProofParams) error {
+	addr, err := basics.UnmarshalChecksumAddress(address)
+	if err != nil {
+		return badRequest(ctx, err, errFailedToParseAddress, v2.Log)
+	}
+
+	proof, err := v2.Node.LedgerForAPI().ProveResource(addr, basics.CreatableIndex(assetID), basics.AssetCreatable)
+	return v2.respondBalancesTrieProof(ctx, (*string)(params.Format), proof, err)
+}
+
+// GetAccountApplicationBalancesTrieProof returns a proof of the account's app local state or params inclusion in the balances trie.
+// (GET /v2/accounts/{address}/applications/{application-id}/proof)
+func (v2 *Handlers) GetAccountApplicationBalancesTrieProof(ctx echo.Context, address string, applicationID uint64, params model.GetAccountApplicationBalancesTrieProofParams) error {
+	addr, err := basics.UnmarshalChecksumAddress(address)
+	if err != nil {
+		return badRequest(ctx, err, errFailedToParseAddress, v2.Log)
+	}
+
+	proof, err := v2.Node.LedgerForAPI().ProveResource(addr, basics.CreatableIndex(applicationID), basics.AppCreatable)
+	return v2.respondBalancesTrieProof(ctx, (*string)(params.Format), proof, err)
+}
+
 // AccountApplicationInformation gets account information about a given app.
 // (GET /v2/accounts/{address}/applications/{application-id})
 func (v2 *Handlers) AccountApplicationInformation(ctx echo.Context, address string, applicationID uint64, params model.AccountApplicationInformationParams) error {
@@ -631,10 +740,13 @@ func (v2 *Handlers) AccountApplicationInformation(ctx echo.Context, address stri
 
 	ledger := v2.Node.LedgerForAPI()
 
-	lastRound := ledger.Latest()
+	lastRound, err := stateLookupRound(ledger, params.Round)
+	if err != nil {
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	}
 	record, err := ledger.LookupApplication(lastRound, addr, basics.AppIndex(applicationID))
 	if err != nil {
-		return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+		return v2.stateLookupError(ctx, err)
 	}
 
 	if record.AppParams == nil && record.AppLocalState == nil {
@@ -1043,6 +1155,34 @@ func (v2 *Handlers) WaitForBlock(ctx echo.Context, round uint64) error {
 	return v2.GetStatus(ctx)
 }
 
+// StreamBlocks streams the blocks, and optionally their state deltas, from the given round onward.
+// (GET /v2/stream/blocks)
+func (v2 *Handlers) StreamBlocks(ctx echo.Context, params model.StreamBlocksParams) error {
+	handle, contentType, err := getCodecHandle((*string)(params.Format))
+	if err != nil {
+		return badRequest(ctx, err, errFailedParsingFormatOption, v2.Log)
+	}
+
+	stat, err := v2.Node.Status()
+	if err != nil {
+		return internalError(ctx, err, errFailedRetrievingNodeStatus, v2.Log)
+	}
+	if stat.Catchpoint != "" {
+		// node is currently catching up to the requested catchpoint.
+		return serviceUnavailable(ctx, fmt.Errorf("StreamBlocks failed as the node was catchpoint catchuping"), errOperationNotAvailableDuringCatchup, v2.Log)
+	}
+
+	ledger := v2.Node.LedgerForAPI()
+	next := ledger.Latest() + 1
+	if params.From != nil {
+		next = basics.Round(*params.From)
+	}
+	withDeltas := params.Deltas != nil && *params.Deltas
+
+	w := makeBlockStreamWriter(ctx, handle, contentType)
+	return v2.streamBlocks(ctx, w, next, withDeltas)
+}
+
 // decodeTxGroup attempts to decode a request body containing a transaction group.
 func decodeTxGroup(body io.Reader, maxTxGroupSize int) ([]transactions.SignedTxn, error) {
 	var txgroup []transactions.SignedTxn
@@ -1216,6 +1356,7 @@ type PreEncodedSimulateTxnResult struct {
 	LogicSigBudgetConsumed   *uint64                                 `codec:"logic-sig-budget-consumed,omitempty"`
 	TransactionTrace         *model.SimulationTransactionExecTrace   `codec:"exec-trace,omitempty"`
 	UnnamedResourcesAccessed *model.SimulateUnnamedResourcesAccessed `codec:"unnamed-resources-accessed,omitempty"`
+	PopulatedResourceArrays  *model.SimulatePopulatedResourceArrays  `codec:"populated-resource-arrays,omitempty"`
 	FixedSigner              *string                                 `codec:"fixed-signer,omitempty"`
 }
 
@@ -1226,6 +1367,7 @@ type PreEncodedSimulateTxnGroupResult struct {
 	FailedAt                 *[]uint64                               `codec:"failed-at,omitempty"`
 	FailureMessage           *string                                 `codec:"failure-message,omitempty"`
 	UnnamedResourcesAccessed *model.SimulateUnnamedResourcesAccessed `codec:"unnamed-resources-accessed,omitempty"`
+	PopulateFailureMessage   *string                                 `codec:"populate-failure-message,omitempty"`
 	Txns                     []PreEncodedSimulateTxnResult           `codec:"txn-results"`
 }
 
@@ -1237,6 +1379,7 @@ type PreEncodedSimulateResponse struct {
 	EvalOverrides   *model.SimulationEvalOverrides     `codec:"eval-overrides,omitempty"`
 	ExecTraceConfig simulation.ExecTraceConfig         `codec:"exec-trace-config,omitempty"`
 	InitialStates   *model.SimulateInitialStates       `codec:"initial-states,omitempty"`
+	ExecProfile     []byte                             `codec:"exec-profile,omitempty"`
 }
 
 // PreEncodedSimulateRequestTransactionGroup mirrors model.SimulateRequestTransactionGroup
@@ -1246,19 +1389,27 @@ type PreEncodedSimulateRequestTransactionGroup struct {
 
 // PreEncodedSimulateRequest mirrors model.SimulateRequest
 type PreEncodedSimulateRequest struct {
-	TxnGroups             []PreEncodedSimulateRequestTransactionGroup `codec:"txn-groups"`
-	Round                 basics.Round                                `codec:"round,omitempty"`
-	AllowEmptySignatures  bool                                        `codec:"allow-empty-signatures,omitempty"`
-	AllowMoreLogging      bool                                        `codec:"allow-more-logging,omitempty"`
-	AllowUnnamedResources bool                                        `codec:"allow-unnamed-resources,omitempty"`
-	ExtraOpcodeBudget     uint64                                      `codec:"extra-opcode-budget,omitempty"`
-	ExecTraceConfig       simulation.ExecTraceConfig                  `codec:"exec-trace-config,omitempty"`
-	FixSigners            bool                                        `codec:"fix-signers,omitempty"`
+	TxnGroups              []PreEncodedSimulateRequestTransactionGroup `codec:"txn-groups"`
+	Round                  basics.Round                                `codec:"round,omitempty"`
+	AllowEmptySignatures   bool                                        `codec:"allow-empty-signatures,omitempty"`
+	AllowMoreLogging       bool                                        `codec:"allow-more-logging,omitempty"`
+	AllowUnnamedResources  bool                                        `codec:"allow-unnamed-resources,omitempty"`
+	PopulateResourceArrays bool                                        `codec:"populate-resource-arrays,omitempty"`
+	ExtraOpcodeBudget      uint64                                      `codec:"extra-opcode-budget,omitempty"`
+	ExecTraceConfig        simulation.ExecTraceConfig                  `codec:"exec-trace-config,omitempty"`
+	FixSigners             bool                                        `codec:"fix-signers,omitempty"`
+	StateOverrides         *model.SimulateStateOverrides               `codec:"state-overrides,omitempty"`
 }
 
 // SimulateTransaction simulates broadcasting a raw transaction to the network, returning relevant simulation results.
 // (POST /v2/transactions/simulate)
 func (v2 *Handlers) SimulateTransaction(ctx echo.Context, params model.SimulateTransactionParams) error {
+	return v2.simulateTransaction(ctx, (*string)(params.Format), v2.Node.Simulate)
+}
+
+// simulateTransaction decodes a simulation request and writes the result of
+// simulating it with simulate.
+func (v2 *Handlers) simulateTransaction(ctx echo.Context, format *string, simulate func(simulation.Request) (simulation.Result, error)) error {
 	stat, err := v2.Node.Status()
 	if err != nil {
 		return internalError(ctx, err, errFailedRetrievingNodeStatus, v2.Log)
@@ -1297,8 +1448,13 @@ func (v2 *Handlers) SimulateTransaction(ctx echo.Context, params model.SimulateT
 		}
 	}
 
+	request, err := convertSimulationRequest(simulateRequest)
+	if err != nil {
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	}
+
 	// Simulate transaction
-	simulationResult, err := v2.Node.Simulate(convertSimulationRequest(simulateRequest))
+	simulationResult, err := simulate(request)
 	if err != nil {
 		var invalidTxErr simulation.InvalidRequestError
 		switch {
@@ -1309,9 +1465,12 @@ func (v2 *Handlers) SimulateTransaction(ctx echo.Context, params model.SimulateT
 		}
 	}
 
-	response := convertSimulationResult(simulationResult)
+	response, err := convertSimulationResult(simulationResult)
+	if err != nil {
+		return internalError(ctx, err, err.Error(), v2.Log)
+	}
 
-	handle, contentType, err := getCodecHandle((*string)(params.Format))
+	handle, contentType, err := getCodecHandle(format)
 	if err != nil {
 		return badRequest(ctx, err, errFailedParsingFormatOption, v2.Log)
 	}
@@ -1323,6 +1482,112 @@ func (v2 *Handlers) SimulateTransaction(ctx echo.Context, params model.SimulateT
 	return ctx.Blob(http.StatusOK, contentType, responseData)
 }
 
+func simulationSessionToModel(status simulation.SessionStatus) model.SimulationSessionResponse {
+	return model.SimulationSessionResponse{
+		SessionId:  status.ID,
+		StartRound: uint64(status.StartRound),
+		Round:      uint64(status.Round),
+		Timestamp:  uint64(status.TimeStamp),
+		Expires:    uint64(status.Expires.Unix()),
+	}
+}
+
+func (v2 *Handlers) respondSimulationSession(ctx echo.Context, status simulation.SessionStatus, err error) error {
+	if err != nil {
+		var invalidRequestErr simulation.InvalidRequestError
+		switch {
+		case errors.Is(err, simulation.ErrSessionNotFound):
+			return notFound(ctx, err, err.Error(), v2.Log)
+		case errors.Is(err, simulation.ErrTooManySessions):
+			return serviceUnavailable(ctx, err, err.Error(), v2.Log)
+		case errors.As(err, &invalidRequestErr):
+			return badRequest(ctx, err, err.Error(), v2.Log)
+		default:
+			return internalError(ctx, err, err.Error(), v2.Log)
+		}
+	}
+	return ctx.JSON(http.StatusOK, simulationSessionToModel(status))
+}
+
+// StartSimulationSession starts a session chaining simulations on top of each other's state.
+// (POST /v2/transactions/simulate/sessions)
+func (v2 *Handlers) StartSimulationSession(ctx echo.Context, params model.StartSimulationSessionParams) error {
+	stat, err := v2.Node.Status()
+	if err != nil {
+		return internalError(ctx, err, errFailedRetrievingNodeStatus, v2.Log)
+	}
+	if stat.Catchpoint != "" {
+		// node is currently catching up to the requested catchpoint.
+		return serviceUnavailable(ctx, fmt.Errorf("StartSimulationSession failed as the node was catchpoint catchuping"), errOperationNotAvailableDuringCatchup, v2.Log)
+	}
+
+	var round basics.Round
+	if params.Round != nil {
+		round = basics.Round(*params.Round)
+	}
+	var ttl time.Duration
+	if params.Ttl != nil {
+		if *params.Ttl == 0 || *params.Ttl > uint64(simulation.MaxSessionTTL/time.Second) {
+			err = fmt.Errorf("ttl must be between 1 and %d seconds", uint64(simulation.MaxSessionTTL/time.Second))
+			return badRequest(ctx, err, err.Error(), v2.Log)
+		}
+		ttl = time.Duration(*params.Ttl) * time.Second
+	}
+
+	status, err := v2.Node.SimulationSessions().Start(round, ttl)
+	return v2.respondSimulationSession(ctx, status, err)
+}
+
+// GetSimulationSession returns the state of a simulation session.
+// (GET /v2/transactions/simulate/sessions/{session-id})
+func (v2 *Handlers) GetSimulationSession(ctx echo.Context, sessionID string) error {
+	session, err := v2.Node.SimulationSessions().Get(sessionID)
+	if err != nil {
+		return v2.respondSimulationSession(ctx, simulation.SessionStatus{}, err)
+	}
+	status, err := session.Status()
+	return v2.respondSimulationSession(ctx, status, err)
+}
+
+// DeleteSimulationSession discards a simulation session.
+// (DELETE /v2/transactions/simulate/sessions/{session-id})
+func (v2 *Handlers) DeleteSimulationSession(ctx echo.Context, sessionID string) error {
+	err := v2.Node.SimulationSessions().Stop(sessionID)
+	if err != nil {
+		return v2.respondSimulationSession(ctx, simulation.SessionStatus{}, err)
+	}
+	return ctx.NoContent(http.StatusOK)
+}
+
+// SimulateTransactionInSession simulates a transaction group on top of the state of a simulation session.
+// (POST /v2/transactions/simulate/sessions/{session-id})
+func (v2 *Handlers) SimulateTransactionInSession(ctx echo.Context, sessionID string, params model.SimulateTransactionInSessionParams) error {
+	session, err := v2.Node.SimulationSessions().Get(sessionID)
+	if err != nil {
+		return v2.respondSimulationSession(ctx, simulation.SessionStatus{}, err)
+	}
+	return v2.simulateTransaction(ctx, (*string)(params.Format), session.Simulate)
+}
+
+// AdvanceSimulationSession adds empty rounds to a simulation session.
+// (POST /v2/transactions/simulate/sessions/{session-id}/advance)
+func (v2 *Handlers) AdvanceSimulationSession(ctx echo.Context, sessionID string, params model.AdvanceSimulationSessionParams) error {
+	session, err := v2.Node.SimulationSessions().Get(sessionID)
+	if err != nil {
+		return v2.respondSimulationSession(ctx, simulation.SessionStatus{}, err)
+	}
+	var seconds int64
+	if params.Seconds != nil {
+		if *params.Seconds > math.MaxInt64 {
+			err = fmt.Errorf("seconds %d is too large", *params.Seconds)
+			return badRequest(ctx, err, err.Error(), v2.Log)
+		}
+		seconds = int64(*params.Seconds)
+	}
+	status, err := session.Advance(params.Rounds, seconds)
+	return v2.respondSimulationSession(ctx, status, err)
+}
+
 // TealDryrun takes transactions and additional simulated ledger state and returns debugging information.
 // (POST /v2/teal/dryrun)
 func (v2 *Handlers) TealDryrun(ctx echo.Context) error {
@@ -1423,6 +1688,48 @@ func (v2 *Handlers) GetSyncRound(ctx echo.Context) error {
 	return ctx.JSON(http.StatusOK, model.GetSyncRoundResponse{Round: rnd})
 }
 
+// GetPeerReputation returns the reputation of the peers that misbehaved recently.
+// (GET /v2/peers/reputation)
+func (v2 *Handlers) GetPeerReputation(ctx echo.Context) error {
+	now := time.Now()
+	entries := v2.Node.PeerReputation()
+	response := make(model.PeerReputationResponse, len(entries))
+	for i, e := range entries {
+		response[i] = model.PeerReputation{
+			Peer:  e.Peer,
+			Score: float32(e.Score),
+			Bans:  e.Bans,
+		}
+		if e.Banned(now) {
+			bannedUntil := uint64(e.BannedUntil.Unix())
+			response[i].BannedUntil = &bannedUntil
+		}
+		if e.LastOffense != "" {
+			lastOffense := e.LastOffense
+			response[i].LastOffense = &lastOffense
+		}
+	}
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// ClearPeerReputation forgets the reputation of a peer, lifting its ban.
+// (DELETE /v2/peers/reputation/{peer})
+func (v2 *Handlers) ClearPeerReputation(ctx echo.Context, peer string) error {
+	if !v2.Node.ClearPeerReputation(peer) {
+		return notFound(ctx, fmt.Errorf("peer %s is not tracked", peer), errPeerReputationNotFound, v2.Log)
+	}
+	return ctx.NoContent(http.StatusOK)
+}
+
+// ReplayExporter makes the exporter export every round from the given one again.
+// (POST /v2/exporter/replay/{round})
+func (v2 *Handlers) ReplayExporter(ctx echo.Context, round uint64) error {
+	if !v2.Node.ReplayExporter(basics.Round(round)) {
+		return notFound(ctx, fmt.Errorf("the exporter is not enabled"), errExporterNotEnabled, v2.Log)
+	}
+	return ctx.NoContent(http.StatusOK)
+}
+
 // GetLedgerStateDelta returns the deltas for a given round.
 // This should be a representation of the ledgercore.StateDelta object.
 // (GET /v2/deltas/{round})
@@ -1691,22 +1998,24 @@ func (v2 *Handlers) GetPendingTransactions(ctx echo.Context, params model.GetPen
 
 // GetApplicationByID returns application information by app idx.
 // (GET /v2/applications/{application-id})
-func (v2 *Handlers) GetApplicationByID(ctx echo.Context, applicationID uint64) error {
+func (v2 *Handlers) GetApplicationByID(ctx echo.Context, applicationID uint64, params model.GetApplicationByIDParams) error {
 	appIdx := basics.AppIndex(applicationID)
 	ledger := v2.Node.LedgerForAPI()
-	creator, ok, err := ledger.GetCreator(basics.CreatableIndex(appIdx), basics.AppCreatable)
+	lastRound, err := stateLookupRound(ledger, params.Round)
 	if err != nil {
-		return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	}
+	creator, ok, err := ledger.GetCreatorForRound(lastRound, basics.CreatableIndex(appIdx), basics.AppCreatable)
+	if err != nil {
+		return v2.stateLookupError(ctx, err)
 	}
 	if !ok {
 		return notFound(ctx, errors.New(errAppDoesNotExist), errAppDoesNotExist, v2.Log)
 	}
 
-	lastRound := ledger.Latest()
-
 	record, err := ledger.LookupApplication(lastRound, creator, basics.AppIndex(applicationID))
 	if err != nil {
-		return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+		return v2.stateLookupError(ctx, err)
 	}
 
 	if record.AppParams == nil {
@@ -1782,7 +2091,10 @@ func (v2 *Handlers) GetApplicationBoxes(ctx echo.Context, applicationID uint64,
 func (v2 *Handlers) GetApplicationBoxByName(ctx echo.Context, applicationID uint64, params model.GetApplicationBoxByNameParams) error {
 	appIdx := basics.AppIndex(applicationID)
 	ledger := v2.Node.LedgerForAPI()
-	lastRound := ledger.Latest()
+	lastRound, err := stateLookupRound(ledger, params.Round)
+	if err != nil {
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	}
 
 	encodedBoxName := params.Name
 	boxNameBytes, err := apps.NewAppCallBytes(encodedBoxName)
@@ -1796,7 +2108,7 @@ func (v2 *Handlers) GetApplicationBoxByName(ctx echo.Context, applicationID uint
 
 	value, err := ledger.LookupKv(lastRound, apps.MakeBoxKey(uint64(appIdx), string(boxName)))
 	if err != nil {
-		return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+		return v2.stateLookupError(ctx, err)
 	}
 	if value == nil {
 		return notFound(ctx, errors.New(errBoxDoesNotExist), errBoxDoesNotExist, v2.Log)
@@ -1810,23 +2122,42 @@ func (v2 *Handlers) GetApplicationBoxByName(ctx echo.Context, applicationID uint
 	return ctx.JSON(http.StatusOK, response)
 }
 
+// GetApplicationBoxBalancesTrieProof returns a proof of the box's inclusion in the balances trie.
+// (GET /v2/applications/{application-id}/box/proof)
+func (v2 *Handlers) GetApplicationBoxBalancesTrieProof(ctx echo.Context, applicationID uint64, params model.GetApplicationBoxBalancesTrieProofParams) error {
+	boxNameBytes, err := apps.NewAppCallBytes(params.Name)
+	if err != nil {
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	}
+	boxName, err := boxNameBytes.Raw()
+	if err != nil {
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	}
+
+	proof, err := v2.Node.LedgerForAPI().ProveKv(apps.MakeBoxKey(applicationID, string(boxName)))
+	return v2.respondBalancesTrieProof(ctx, (*string)(params.Format), proof, err)
+}
+
 // GetAssetByID returns application information by app idx.
 // (GET /v2/assets/{asset-id})
-func (v2 *Handlers) GetAssetByID(ctx echo.Context, assetID uint64) error {
+func (v2 *Handlers) GetAssetByID(ctx echo.Context, assetID uint64, params model.GetAssetByIDParams) error {
 	assetIdx := basics.AssetIndex(assetID)
 	ledger := v2.Node.LedgerForAPI()
-	creator, ok, err := ledger.GetCreator(basics.CreatableIndex(assetIdx), basics.AssetCreatable)
+	lastRound, err := stateLookupRound(ledger, params.Round)
 	if err != nil {
-		return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	}
+	creator, ok, err := ledger.GetCreatorForRound(lastRound, basics.CreatableIndex(assetIdx), basics.AssetCreatable)
+	if err != nil {
+		return v2.stateLookupError(ctx, err)
 	}
 	if !ok {
 		return notFound(ctx, errors.New(errAssetDoesNotExist), errAssetDoesNotExist, v2.Log)
 	}
 
-	lastRound := ledger.Latest()
 	record, err := ledger.LookupAsset(lastRound, creator, basics.AssetIndex(assetID))
 	if err != nil {
-		return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+		return v2.stateLookupError(ctx, err)
 	}
 
 	if record.AssetParams == nil {
@@ -1864,6 +2195,14 @@ type CompileResponseWithSourceMap struct {
 	Sourcemap *logic.SourceMap `json:"sourcemap,omitempty"`
 }
 
+// TealCompileRequest is the body of a /v2/teal/compile request with the bundle
+// parameter set. It carries the files that the source #includes or #imports,
+// keyed by their path.
+type TealCompileRequest struct {
+	Source string            `codec:"source"`
+	Files  map[string]string `codec:"files"`
+}
+
 // TealCompile compiles TEAL code to binary, return both binary and hash
 // (POST /v2/teal/compile)
 func (v2 *Handlers) TealCompile(ctx echo.Context, params model.TealCompileParams) (err error) {
@@ -1884,7 +2223,17 @@ func (v2 *Handlers) TealCompile(ctx echo.Context, params model.TealCompileParams
 		return badRequest(ctx, err, err.Error(), v2.Log)
 	}
 	source := buf.String()
-	ops, err := logic.AssembleString(source)
+	var loader logic.FileLoader
+	if params.Bundle != nil && *params.Bundle {
+		var request TealCompileRequest
+		err = decode(protocol.JSONStrictHandle, buf.Bytes(), &request)
+		if err != nil {
+			return badRequest(ctx, err, err.Error(), v2.Log)
+		}
+		source = request.Source
+		loader = logic.MapLoader(request.Files)
+	}
+	ops, err := logic.AssembleStringWithLoader(source, loader)
 	if err != nil {
 		sb := strings.Builder{}
 		ops.ReportMultipleErrors("", &sb)
@@ -1896,7 +2245,7 @@ func (v2 *Handlers) TealCompile(ctx echo.Context, params model.TealCompileParams
 	// If source map flag is enabled, then return the map.
 	var sourcemap *logic.SourceMap
 	if *params.Sourcemap {
-		rawmap := logic.GetSourceMap([]string{"<body>"}, ops.OffsetToSource)
+		rawmap := logic.GetSourceMap(ops.SourceNames("<body>"), ops.OffsetToSource)
 		sourcemap = &rawmap
 	}
 
@@ -78,6 +78,10 @@ func (l *mockLedger) LookupLatest(addr basics.Address) (basics.AccountData, basi
 	return ad, l.latest, basics.MicroAlgos{Raw: 0}, nil
 }
 
+func (l *mockLedger) LookupAccountWithResources(round basics.Round, addr basics.Address) (basics.AccountData, basics.Round, basics.MicroAlgos, error) {
+	return l.LookupLatest(addr)
+}
+
 func (l *mockLedger) LookupKv(round basics.Round, key string) ([]byte, error) {
 	if value, ok := l.kvstore[key]; ok {
 		return value, nil
@@ -167,12 +171,27 @@ func (l *mockLedger) Wait(r basics.Round) chan struct{} {
 func (l *mockLedger) WaitWithCancel(r basics.Round) (chan struct{}, func()) {
 	panic("not implemented")
 }
-func (l *mockLedger) GetCreator(cidx basics.CreatableIndex, ctype basics.CreatableType) (c basics.Address, ok bool, err error) {
+func (l *mockLedger) GetCreatorForRound(rnd basics.Round, cidx basics.CreatableIndex, ctype basics.CreatableType) (c basics.Address, ok bool, err error) {
 	panic("not implemented")
 }
 func (l *mockLedger) EncodedBlockCert(rnd basics.Round) (blk []byte, cert []byte, err error) {
 	panic("not implemented")
 }
+func (l *mockLedger) ProveAccount(addr basics.Address) (ledgercore.BalancesTrieProof, error) {
+	return ledgercore.BalancesTrieProof{}, ledgercore.ErrBalancesTrieDisabled
+}
+func (l *mockLedger) ProveResource(addr basics.Address, cidx basics.CreatableIndex, ctype basics.CreatableType) (ledgercore.BalancesTrieProof, error) {
+	return ledgercore.BalancesTrieProof{}, ledgercore.ErrBalancesTrieDisabled
+}
+func (l *mockLedger) ProveKv(key string) (ledgercore.BalancesTrieProof, error) {
+	return ledgercore.BalancesTrieProof{}, ledgercore.ErrBalancesTrieDisabled
+}
+func (l *mockLedger) RegisterBlockListeners(listeners []ledgercore.BlockListener) {
+	panic("not implemented")
+}
+func (l *mockLedger) UnregisterBlockListener(listener ledgercore.BlockListener) {
+	panic("not implemented")
+}
 func (l *mockLedger) Block(rnd basics.Round) (blk bookkeeping.Block, err error) {
 	if len(l.blocks) == 0 {
 		err = fmt.Errorf("mockledger error: no block")
@@ -19,6 +19,8 @@ package test
 import (
 	"bytes"
 	"context"
+	"encoding/base32"
+	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -52,6 +54,7 @@ import (
 	"github.com/Quarkonium-chain/go-quarkonium/crypto"
 	"github.com/Quarkonium-chain/go-quarkonium/crypto/merklearray"
 	"github.com/Quarkonium-chain/go-quarkonium/crypto/merklesignature"
+	"github.com/Quarkonium-chain/go-quarkonium/crypto/merkletrie"
 	v2 "github.com/Quarkonium-chain/go-quarkonium/daemon/algod/api/server/v2"
 	"github.com/Quarkonium-chain/go-quarkonium/daemon/algod/api/server/v2/generated/model"
 	"github.com/Quarkonium-chain/go-quarkonium/data"
@@ -63,8 +66,10 @@ import (
 	"github.com/Quarkonium-chain/go-quarkonium/data/transactions/logic"
 	"github.com/Quarkonium-chain/go-quarkonium/data/transactions/logic/mocktracer"
 	"github.com/Quarkonium-chain/go-quarkonium/data/txntest"
+	"github.com/Quarkonium-chain/go-quarkonium/ledger/simulation"
 	simulationtesting "github.com/Quarkonium-chain/go-quarkonium/ledger/simulation/testing"
 	"github.com/Quarkonium-chain/go-quarkonium/logging"
+	"github.com/Quarkonium-chain/go-quarkonium/network"
 	"github.com/Quarkonium-chain/go-quarkonium/node"
 	"github.com/Quarkonium-chain/go-quarkonium/protocol"
 	"github.com/Quarkonium-chain/go-quarkonium/stateproof"
@@ -139,6 +144,61 @@ func TestAccountInformation(t *testing.T) {
 	accountInformationTest(t, "bad account", 400)
 }
 
+func TestGetAccountBalancesTrieProof(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	handler, c, rec, _, _, releasefunc := setupTestForMethodGet(t, cannedStatusReportGolden)
+	defer releasefunc()
+
+	err := handler.GetAccountBalancesTrieProof(c, "bad account", model.GetAccountBalancesTrieProofParams{})
+	require.NoError(t, err)
+	require.Equal(t, 400, rec.Code)
+
+	c, rec = newReq(t)
+	err = handler.GetAccountBalancesTrieProof(c, poolAddr.String(), model.GetAccountBalancesTrieProofParams{})
+	require.NoError(t, err)
+	require.Equal(t, 200, rec.Code, rec.Body.String())
+
+	var response model.BalancesTrieProofResponse
+	require.NoError(t, protocol.DecodeJSON(rec.Body.Bytes(), &response))
+	proof := merkletrie.Proof{Path: make([]merkletrie.ProofNode, len(response.Path))}
+	for i, pnode := range response.Path {
+		for _, child := range pnode.Children {
+			pchild := merkletrie.ProofChild{HashIndex: byte(child.Index), Leaf: child.Leaf}
+			if child.Hash != nil {
+				pchild.Hash = *child.Hash
+			}
+			proof.Path[i].Children = append(proof.Path[i].Children, pchild)
+		}
+	}
+	var root crypto.Digest
+	copy(root[:], response.Root)
+	require.NoError(t, merkletrie.VerifyProof(root, response.Leaf, &proof))
+
+	// the commitment to the root is recomputed from the response and the block of its round
+	preimage := append(append(append([]byte{}, response.BlockHash...), response.Root...), response.Totals...)
+	if response.StateProofVerificationHash != nil {
+		preimage = append(preimage, *response.StateProofVerificationHash...)
+	}
+	commitment := crypto.Hash(preimage)
+	require.Equal(t, fmt.Sprintf("%d#%s", response.Round, base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(commitment[:])), response.Commitment)
+	hdr, err := handler.Node.LedgerForAPI().BlockHdr(basics.Round(response.Round))
+	require.NoError(t, err)
+	blockHash := crypto.Digest(hdr.Hash())
+	require.Equal(t, blockHash[:], response.BlockHash)
+
+	c, rec = newReq(t)
+	err = handler.GetAccountAssetBalancesTrieProof(c, poolAddr.String(), 1, model.GetAccountAssetBalancesTrieProofParams{})
+	require.NoError(t, err)
+	require.Equal(t, 404, rec.Code)
+
+	c, rec = newReq(t)
+	err = handler.GetApplicationBoxBalancesTrieProof(c, 1, model.GetApplicationBoxBalancesTrieProofParams{Name: "bad name"})
+	require.NoError(t, err)
+	require.Equal(t, 400, rec.Code)
+}
+
 func getBlockTest(t *testing.T, blockNum uint64, format string, expectedCode int) {
 	handler, c, rec, _, _, releasefunc := setupTestForMethodGet(t, cannedStatusReportGolden)
 	defer releasefunc()
@@ -1327,6 +1387,151 @@ func TestSimulateTransactionMultipleGroups(t *testing.T) {
 	require.Contains(t, bodyString, "expected 1 transaction group, got 2")
 }
 
+func TestSimulateTransactionStateOverrides(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	numAccounts := 5
+	offlineAccounts := true
+	mockLedger, roots, _, _, releasefunc := testingenv(t, numAccounts, 1, offlineAccounts)
+	defer releasefunc()
+	dummyShutdownChan := make(chan struct{})
+	mockNode := makeMockNode(mockLedger, t.Name(), nil, cannedStatusReportGolden, false)
+	handler := v2.Handlers{
+		Node:     mockNode,
+		Log:      logging.Base(),
+		Shutdown: dummyShutdownChan,
+	}
+
+	hdr, err := mockLedger.BlockHdr(mockLedger.Latest())
+	require.NoError(t, err)
+	txnInfo := simulationtesting.TxnInfo{LatestHeader: hdr}
+	empty := basics.Address(crypto.Hash([]byte("empty")))
+	stxn := txnInfo.NewTxn(txntest.Txn{
+		Type:     protocol.PaymentTx,
+		Sender:   empty,
+		Receiver: roots[0].Address(),
+		Amount:   1_000_000,
+	}).SignedTxn()
+
+	for _, funded := range []bool{false, true} {
+		// the request is decoded from JSON, as sent by REST clients
+		body := fmt.Sprintf(`{"txn-groups": [{"txns": [%s]}], "allow-empty-signatures": true`, protocol.EncodeJSON(&stxn))
+		if funded {
+			body += fmt.Sprintf(`, "state-overrides": {"accounts": [{"address": "%s", "data": {"amount": 5000000}}]}`, empty)
+		}
+		body += "}"
+		req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+		rec := httptest.NewRecorder()
+		c := echo.New().NewContext(req, rec)
+
+		err = handler.SimulateTransaction(c, model.SimulateTransactionParams{})
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+		var response v2.PreEncodedSimulateResponse
+		require.NoError(t, protocol.DecodeJSON(rec.Body.Bytes(), &response))
+		if funded {
+			require.Nil(t, response.TxnGroups[0].FailureMessage)
+		} else {
+			require.NotNil(t, response.TxnGroups[0].FailureMessage)
+			require.Contains(t, *response.TxnGroups[0].FailureMessage, "overspend")
+		}
+	}
+}
+
+func TestSimulationSession(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	numAccounts := 5
+	offlineAccounts := true
+	mockLedger, roots, _, _, releasefunc := testingenv(t, numAccounts, 1, offlineAccounts)
+	defer releasefunc()
+	dummyShutdownChan := make(chan struct{})
+	mockNode := makeMockNode(mockLedger, t.Name(), nil, cannedStatusReportGolden, false)
+	handler := v2.Handlers{
+		Node:     mockNode,
+		Log:      logging.Base(),
+		Shutdown: dummyShutdownChan,
+	}
+	latest := uint64(mockLedger.Latest())
+
+	newContext := func(body []byte) (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(body))
+		rec := httptest.NewRecorder()
+		return echo.New().NewContext(req, rec), rec
+	}
+	decodeSession := func(rec *httptest.ResponseRecorder) model.SimulationSessionResponse {
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+		var response model.SimulationSessionResponse
+		require.NoError(t, protocol.DecodeJSON(rec.Body.Bytes(), &response))
+		return response
+	}
+
+	c, rec := newContext(nil)
+	ttl := uint64(2 * simulation.MaxSessionTTL / time.Second)
+	require.NoError(t, handler.StartSimulationSession(c, model.StartSimulationSessionParams{Ttl: &ttl}))
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+
+	c, rec = newContext(nil)
+	require.NoError(t, handler.StartSimulationSession(c, model.StartSimulationSessionParams{}))
+	session := decodeSession(rec)
+	require.Equal(t, latest, session.StartRound)
+	require.Equal(t, latest, session.Round)
+
+	hdr, err := mockLedger.BlockHdr(mockLedger.Latest())
+	require.NoError(t, err)
+	txnInfo := simulationtesting.TxnInfo{LatestHeader: hdr}
+	stxn := txnInfo.NewTxn(txntest.Txn{
+		Type:     protocol.PaymentTx,
+		Sender:   roots[0].Address(),
+		Receiver: roots[1].Address(),
+		Amount:   1,
+	}).Txn().Sign(roots[0].Secrets())
+	request := v2.PreEncodedSimulateRequest{
+		TxnGroups: []v2.PreEncodedSimulateRequestTransactionGroup{{Txns: []transactions.SignedTxn{stxn}}},
+	}
+
+	// the second simulation sees the transaction committed by the first one
+	for i, expectedFailure := range []string{"", "already in ledger"} {
+		c, rec = newContext(protocol.EncodeReflect(&request))
+		require.NoError(t, handler.SimulateTransactionInSession(c, session.SessionId, model.SimulateTransactionInSessionParams{}))
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+		var response v2.PreEncodedSimulateResponse
+		require.NoError(t, protocol.DecodeJSON(rec.Body.Bytes(), &response))
+		require.Equal(t, latest+uint64(i), response.LastRound)
+		if expectedFailure == "" {
+			require.Nil(t, response.TxnGroups[0].FailureMessage)
+		} else {
+			require.NotNil(t, response.TxnGroups[0].FailureMessage)
+			require.Contains(t, *response.TxnGroups[0].FailureMessage, expectedFailure)
+		}
+	}
+
+	c, rec = newContext(nil)
+	require.NoError(t, handler.GetSimulationSession(c, session.SessionId))
+	status := decodeSession(rec)
+	require.Equal(t, latest+1, status.Round)
+
+	c, rec = newContext(nil)
+	seconds := uint64(60)
+	require.NoError(t, handler.AdvanceSimulationSession(c, session.SessionId, model.AdvanceSimulationSessionParams{Rounds: 5, Seconds: &seconds}))
+	advanced := decodeSession(rec)
+	require.Equal(t, latest+6, advanced.Round)
+	require.Equal(t, status.Timestamp+60, advanced.Timestamp)
+
+	c, rec = newContext(nil)
+	require.NoError(t, handler.DeleteSimulationSession(c, session.SessionId))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	c, rec = newContext(nil)
+	require.NoError(t, handler.GetSimulationSession(c, session.SessionId))
+	require.Equal(t, http.StatusNotFound, rec.Code)
+	c, rec = newContext(protocol.EncodeReflect(&request))
+	require.NoError(t, handler.SimulateTransactionInSession(c, session.SessionId, model.SimulateTransactionInSessionParams{}))
+	require.Equal(t, http.StatusNotFound, rec.Code)
+}
+
 func startCatchupTest(t *testing.T, catchpoint string, nodeError error, expectedCode int) {
 	startCatchupTestFull(t, catchpoint, nodeError, expectedCode, 0, "")
 }
@@ -1493,6 +1698,69 @@ int 1`, logic.AssemblerMaxVersion)
 	tealCompileTest(t, badProgramBytes, 400, true, params, nil)
 }
 
+func TestTealCompileWithFiles(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	mockLedger, _, _, _, releasefunc := testingenv(t, 1, 1, true)
+	defer releasefunc()
+	mockNode := makeMockNode(mockLedger, t.Name(), nil, cannedStatusReportGolden, false)
+	mockNode.config.EnableDeveloperAPI = true
+	handler := v2.Handlers{
+		Node:     mockNode,
+		Log:      logging.Base(),
+		Shutdown: make(chan struct{}),
+	}
+	compile := func(contentType string, bundle bool, body []byte) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, contentType)
+		rec := httptest.NewRecorder()
+		sourcemap := true
+		err := handler.TealCompile(echo.New().NewContext(req, rec), model.TealCompileParams{Sourcemap: &sourcemap, Bundle: &bundle})
+		require.NoError(t, err)
+		return rec
+	}
+
+	source := fmt.Sprintf("#pragma version %d\ncallsub util::one\nreturn\n#include \"util.teal\"\n", logic.AssemblerMaxVersion)
+	files := map[string]string{"util.teal": "one:\nint 1\nretsub\n"}
+	request := v2.TealCompileRequest{Source: source, Files: files}
+
+	rec := compile(echo.MIMEApplicationJSON, true, protocol.EncodeJSON(&request))
+	require.Equal(t, 200, rec.Code, rec.Body.String())
+	var response v2.CompileResponseWithSourceMap
+	require.NoError(t, protocol.DecodeJSON(rec.Body.Bytes(), &response))
+	ops, err := logic.AssembleStringWithLoader(source, logic.MapLoader(files))
+	require.NoError(t, err)
+	require.Equal(t, base64.StdEncoding.EncodeToString(ops.Program), response.Result)
+	require.Equal(t, []string{"<body>", "util.teal"}, response.Sourcemap.Sources)
+
+	// the bundle is decoded whatever the content type is
+	rec = compile(echo.MIMETextPlain, true, protocol.EncodeJSON(&request))
+	require.Equal(t, 200, rec.Code, rec.Body.String())
+
+	// requests without the bundle have no files to include
+	rec = compile(echo.MIMETextPlain, false, []byte(source))
+	require.Equal(t, 400, rec.Code)
+	require.Contains(t, rec.Body.String(), "#include is not supported without a file loader")
+
+	// and their body is the source, even when sent as application/json
+	plain := fmt.Sprintf("#pragma version %d\nint 1\n", logic.AssemblerMaxVersion)
+	rec = compile(echo.MIMEApplicationJSON, false, []byte(plain))
+	require.Equal(t, 200, rec.Code, rec.Body.String())
+	require.NoError(t, protocol.DecodeJSON(rec.Body.Bytes(), &response))
+	ops, err = logic.AssembleString(plain)
+	require.NoError(t, err)
+	require.Equal(t, base64.StdEncoding.EncodeToString(ops.Program), response.Result)
+
+	request.Files = nil
+	rec = compile(echo.MIMEApplicationJSON, true, protocol.EncodeJSON(&request))
+	require.Equal(t, 400, rec.Code)
+	require.Contains(t, rec.Body.String(), "util.teal: file not found")
+
+	rec = compile(echo.MIMEApplicationJSON, true, []byte(`{"source": 1}`))
+	require.Equal(t, 400, rec.Code)
+}
+
 func tealDisassembleTest(t *testing.T, program []byte, expectedCode int,
 	expectedString string, enableDeveloperAPI bool,
 ) (response model.DisassembleResponse) {
@@ -1965,6 +2233,75 @@ func insertRounds(a *require.Assertions, h v2.Handlers, numRounds int) {
 	}
 }
 
+// pipeResponseWriter is a flushable http.ResponseWriter letting tests read a
+// streamed response while it's being written.
+type pipeResponseWriter struct {
+	header http.Header
+	w      *io.PipeWriter
+}
+
+func (p *pipeResponseWriter) Header() http.Header         { return p.header }
+func (p *pipeResponseWriter) Write(b []byte) (int, error) { return p.w.Write(b) }
+func (p *pipeResponseWriter) WriteHeader(int)             {}
+func (p *pipeResponseWriter) Flush()                      {}
+
+func TestStreamBlocks(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+	a := require.New(t)
+
+	handler, _, _, _, _, releasefunc := setupTestForMethodGet(t, cannedStatusReportGolden)
+	defer releasefunc()
+	insertRounds(a, handler, 2)
+
+	reqCtx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	pr, pw := io.Pipe()
+	req := httptest.NewRequest(http.MethodGet, "/", nil).WithContext(reqCtx)
+	c := echo.New().NewContext(req, &pipeResponseWriter{header: http.Header{}, w: pw})
+
+	from := uint64(1)
+	deltas := true
+	format := "msgpack"
+	done := make(chan error, 1)
+	go func() {
+		done <- handler.StreamBlocks(c, model.StreamBlocksParams{From: &from, Deltas: &deltas, Format: (*model.StreamBlocksParamsFormat)(&format)})
+		pw.Close()
+	}()
+
+	dec := protocol.NewDecoder(pr)
+	readFrame := func(rnd basics.Round) {
+		var frame v2.BlockStreamFrame
+		a.NoError(dec.Decode(&frame))
+		a.Empty(frame.Error)
+		a.Equal(rnd, frame.Round)
+		a.NotNil(frame.Block)
+		a.Equal(rnd, frame.Block.Round())
+		a.NotNil(frame.Delta)
+		a.Equal(rnd, frame.Delta.Hdr.Round)
+	}
+
+	// rounds already in the ledger
+	readFrame(1)
+	readFrame(2)
+
+	// rounds added while streaming
+	ledger := handler.Node.LedgerForAPI()
+	genBlk, err := ledger.Block(0)
+	a.NoError(err)
+	lastBlk, err := ledger.Block(2)
+	a.NoError(err)
+	blk := newEmptyBlock(a, lastBlk, genBlk, ledger)
+	a.NoError(ledger.(*data.Ledger).AddBlock(blk, agreement.Certificate{}))
+	readFrame(3)
+
+	cancel()
+	// drain anything written concurrently with the cancellation
+	_, err = io.Copy(io.Discard, pr)
+	a.NoError(err)
+	a.NoError(<-done)
+}
+
 func TestStateProofNotFound(t *testing.T) {
 	partitiontest.PartitionTest(t)
 	a := require.New(t)
@@ -2234,6 +2571,75 @@ func TestTimestampOffsetInDevMode(t *testing.T) {
 	require.Equal(t, "{\"message\":\"failed to set timestamp offset on the node: block timestamp offset cannot be larger than max int64 value\"}\n", rec.Body.String())
 }
 
+func TestPeerReputation(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	handler, c, rec, _, _, releasefunc := setupTestForMethodGet(t, cannedStatusReportGolden)
+	defer releasefunc()
+	now := time.Now()
+	handler.Node.(*mockNode).reputation = []network.PeerReputationEntry{
+		{Peer: "10.0.0.1", Score: 0, BannedUntil: now.Add(time.Hour), Bans: 2, LastOffense: "BadData"},
+		{Peer: "QmPeer", Score: 42.5, BannedUntil: now.Add(-time.Hour), Bans: 1},
+	}
+
+	err := handler.GetPeerReputation(c)
+	require.NoError(t, err)
+	require.Equal(t, 200, rec.Code)
+	var response model.PeerReputationResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	require.Len(t, response, 2)
+	require.Equal(t, "10.0.0.1", response[0].Peer)
+	require.Equal(t, uint64(2), response[0].Bans)
+	require.NotNil(t, response[0].BannedUntil)
+	require.Equal(t, uint64(now.Add(time.Hour).Unix()), *response[0].BannedUntil)
+	require.Equal(t, "BadData", *response[0].LastOffense)
+	require.Equal(t, "QmPeer", response[1].Peer)
+	require.Equal(t, float32(42.5), response[1].Score)
+	// an expired ban is not reported
+	require.Nil(t, response[1].BannedUntil)
+	require.Nil(t, response[1].LastOffense)
+
+	c, rec = newReq(t)
+	err = handler.ClearPeerReputation(c, "10.0.0.1")
+	require.NoError(t, err)
+	require.Equal(t, 200, rec.Code)
+
+	c, rec = newReq(t)
+	err = handler.ClearPeerReputation(c, "10.0.0.1")
+	require.NoError(t, err)
+	require.Equal(t, 404, rec.Code)
+
+	c, rec = newReq(t)
+	err = handler.GetPeerReputation(c)
+	require.NoError(t, err)
+	require.Equal(t, 200, rec.Code)
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	require.Len(t, response, 1)
+	require.Equal(t, "QmPeer", response[0].Peer)
+}
+
+func TestReplayExporter(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	handler, c, rec, _, _, releasefunc := setupTestForMethodGet(t, cannedStatusReportGolden)
+	defer releasefunc()
+	mockNode := handler.Node.(*mockNode)
+
+	err := handler.ReplayExporter(c, 10)
+	require.NoError(t, err)
+	require.Equal(t, 404, rec.Code)
+	require.Empty(t, mockNode.exporterReplays)
+
+	mockNode.config.EnableExporter = true
+	c, rec = newReq(t)
+	err = handler.ReplayExporter(c, 10)
+	require.NoError(t, err)
+	require.Equal(t, 200, rec.Code)
+	require.Equal(t, []basics.Round{10}, mockNode.exporterReplays)
+}
+
 func TestDeltasForTxnGroup(t *testing.T) {
 	partitiontest.PartitionTest(t)
 	t.Parallel()
@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"math/rand"
 	"strconv"
+	"sync"
 	"testing"
 
 	"github.com/stretchr/testify/mock"
@@ -37,6 +38,7 @@ import (
 	"github.com/Quarkonium-chain/go-quarkonium/data/transactions/logic"
 	"github.com/Quarkonium-chain/go-quarkonium/ledger/simulation"
 	"github.com/Quarkonium-chain/go-quarkonium/logging"
+	"github.com/Quarkonium-chain/go-quarkonium/network"
 	"github.com/Quarkonium-chain/go-quarkonium/node"
 	"github.com/Quarkonium-chain/go-quarkonium/protocol"
 	"github.com/Quarkonium-chain/go-quarkonium/util/db"
@@ -97,6 +99,11 @@ type mockNode struct {
 	devmode         bool
 	timestampOffset *int64
 	PartKeyBinary   []byte
+	reputation      []network.PeerReputationEntry
+	exporterReplays []basics.Round
+
+	simulationSessionsOnce sync.Once
+	simulationSessions     *simulation.Sessions
 }
 
 func (m *mockNode) InstallParticipationKey(partKeyBinary []byte) (account.ParticipationID, error) {
@@ -177,6 +184,13 @@ func (m *mockNode) Simulate(request simulation.Request) (simulation.Result, erro
 	return simulator.Simulate(request)
 }
 
+func (m *mockNode) SimulationSessions() *simulation.Sessions {
+	m.simulationSessionsOnce.Do(func() {
+		m.simulationSessions = simulation.MakeSessions(m.ledger.(*data.Ledger), m.config.EnableDeveloperAPI)
+	})
+	return m.simulationSessions
+}
+
 func (m *mockNode) GetPendingTransaction(txID transactions.Txid) (res node.TxnWithStatus, found bool) {
 	res = node.TxnWithStatus{}
 	found = true
@@ -221,6 +235,28 @@ func (m *mockNode) GetBlockTimeStampOffset() (*int64, error) {
 	return m.timestampOffset, nil
 }
 
+func (m *mockNode) PeerReputation() []network.PeerReputationEntry {
+	return m.reputation
+}
+
+func (m *mockNode) ReplayExporter(rnd basics.Round) bool {
+	if !m.config.EnableExporter {
+		return false
+	}
+	m.exporterReplays = append(m.exporterReplays, rnd)
+	return true
+}
+
+func (m *mockNode) ClearPeerReputation(peer string) bool {
+	for i, e := range m.reputation {
+		if e.Peer == peer {
+			m.reputation = append(m.reputation[:i], m.reputation[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
 ////// mock ledger testing environment follows
 
 var sinkAddr = basics.Address{0x7, 0xda, 0xcb, 0x4b, 0x6d, 0x9e, 0xd1, 0x41, 0xb1, 0x75, 0x76, 0xbd, 0x45, 0x9a, 0xe6, 0x42, 0x1d, 0x48, 0x6d, 0xa3, 0xd4, 0xef, 0x22, 0x47, 0xc4, 0x9, 0xa3, 0x96, 0xb8, 0x2e, 0xa2, 0x21}
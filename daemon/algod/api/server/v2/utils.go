@@ -17,6 +17,7 @@
 package v2
 
 import (
+	"bytes"
 	"encoding/base64"
 	"errors"
 	"fmt"
@@ -479,6 +480,7 @@ func convertTxnResult(txnResult simulation.TxnResult) PreEncodedSimulateTxnResul
 		LogicSigBudgetConsumed:   omitEmpty(txnResult.LogicSigBudgetConsumed),
 		TransactionTrace:         convertTxnTrace(txnResult.Trace),
 		UnnamedResourcesAccessed: convertUnnamedResourcesAccessed(txnResult.UnnamedResourcesAccessed),
+		PopulatedResourceArrays:  convertPopulatedResourceArrays(txnResult.PopulatedResourceArrays),
 	}
 
 	if !txnResult.FixedSigner.IsZero() {
@@ -519,6 +521,24 @@ func convertUnnamedResourcesAccessed(resources *simulation.ResourceTracker) *mod
 	}
 }
 
+func convertPopulatedResourceArrays(arrays *simulation.PopulatedResourceArrays) *model.SimulatePopulatedResourceArrays {
+	if arrays == nil {
+		return nil
+	}
+	// unlike the unnamed resources accessed, the order of the arrays is kept
+	return &model.SimulatePopulatedResourceArrays{
+		Accounts: sliceOrNil(stringSlice(arrays.Accounts)),
+		Assets:   sliceOrNil(uint64Slice(arrays.Assets)),
+		Apps:     sliceOrNil(uint64Slice(arrays.Apps)),
+		Boxes: sliceOrNil(convertSlice(arrays.Boxes, func(box logic.BoxRef) model.BoxReference {
+			return model.BoxReference{
+				App:  uint64(box.App),
+				Name: []byte(box.Name),
+			}
+		})),
+	}
+}
+
 func convertAppKVStorePtr(address basics.Address, appKVPairs simulation.AppKVPairs) *model.ApplicationKVStorage {
 	if len(appKVPairs) == 0 && address.IsZero() {
 		return nil
@@ -576,6 +596,7 @@ func convertTxnGroupResult(txnGroupResult simulation.TxnGroupResult) PreEncodedS
 		AppBudgetAdded:           omitEmpty(txnGroupResult.AppBudgetAdded),
 		AppBudgetConsumed:        omitEmpty(txnGroupResult.AppBudgetConsumed),
 		UnnamedResourcesAccessed: convertUnnamedResourcesAccessed(txnGroupResult.UnnamedResourcesAccessed),
+		PopulateFailureMessage:   omitEmpty(txnGroupResult.PopulateFailureMessage),
 	}
 
 	if len(txnGroupResult.FailedAt) > 0 {
@@ -586,7 +607,7 @@ func convertTxnGroupResult(txnGroupResult simulation.TxnGroupResult) PreEncodedS
 	return encoded
 }
 
-func convertSimulationResult(result simulation.Result) PreEncodedSimulateResponse {
+func convertSimulationResult(result simulation.Result) (PreEncodedSimulateResponse, error) {
 	var evalOverrides *model.SimulationEvalOverrides
 	if result.EvalOverrides != (simulation.ResultEvalOverrides{}) {
 		evalOverrides = &model.SimulationEvalOverrides{
@@ -599,6 +620,15 @@ func convertSimulationResult(result simulation.Result) PreEncodedSimulateRespons
 		}
 	}
 
+	var execProfile []byte
+	if result.ExecProfile != nil {
+		var buf bytes.Buffer
+		if err := result.ExecProfile.WritePprof(&buf, nil); err != nil {
+			return PreEncodedSimulateResponse{}, fmt.Errorf("failed to encode execution profile: %w", err)
+		}
+		execProfile = buf.Bytes()
+	}
+
 	return PreEncodedSimulateResponse{
 		Version:         result.Version,
 		LastRound:       uint64(result.LastRound),
@@ -606,24 +636,125 @@ func convertSimulationResult(result simulation.Result) PreEncodedSimulateRespons
 		EvalOverrides:   evalOverrides,
 		ExecTraceConfig: result.TraceConfig,
 		InitialStates:   convertSimulateInitialStates(result.InitialStates),
-	}
+		ExecProfile:     execProfile,
+	}, nil
 }
 
-func convertSimulationRequest(request PreEncodedSimulateRequest) simulation.Request {
+func convertSimulationRequest(request PreEncodedSimulateRequest) (simulation.Request, error) {
 	txnGroups := make([][]transactions.SignedTxn, len(request.TxnGroups))
 	for i, txnGroup := range request.TxnGroups {
 		txnGroups[i] = txnGroup.Txns
 	}
+	stateOverrides, err := convertStateOverrides(request.StateOverrides)
+	if err != nil {
+		return simulation.Request{}, err
+	}
 	return simulation.Request{
-		TxnGroups:             txnGroups,
-		Round:                 request.Round,
-		AllowEmptySignatures:  request.AllowEmptySignatures,
-		AllowMoreLogging:      request.AllowMoreLogging,
-		AllowUnnamedResources: request.AllowUnnamedResources,
-		ExtraOpcodeBudget:     request.ExtraOpcodeBudget,
-		TraceConfig:           request.ExecTraceConfig,
-		FixSigners:            request.FixSigners,
+		TxnGroups:              txnGroups,
+		Round:                  request.Round,
+		AllowEmptySignatures:   request.AllowEmptySignatures,
+		AllowMoreLogging:       request.AllowMoreLogging,
+		AllowUnnamedResources:  request.AllowUnnamedResources,
+		PopulateResourceArrays: request.PopulateResourceArrays,
+		ExtraOpcodeBudget:      request.ExtraOpcodeBudget,
+		TraceConfig:            request.ExecTraceConfig,
+		FixSigners:             request.FixSigners,
+		StateOverrides:         stateOverrides,
+	}, nil
+}
+
+func convertSimulateAccountData(data *model.SimulateAccountData) (*basics.AccountData, error) {
+	if data == nil {
+		return nil, nil
+	}
+	ad := basics.AccountData{MicroAlgos: basics.MicroAlgos{Raw: data.Amount}}
+	if data.AuthAddr != nil {
+		authAddr, err := basics.UnmarshalChecksumAddress(*data.AuthAddr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid auth-addr %s: %w", *data.AuthAddr, err)
+		}
+		ad.AuthAddr = authAddr
 	}
+	if data.Status != nil {
+		status, err := basics.UnmarshalStatus(*data.Status)
+		if err != nil {
+			return nil, err
+		}
+		ad.Status = status
+	}
+	if data.Participation != nil {
+		copy(ad.VoteID[:], data.Participation.VoteParticipationKey)
+		copy(ad.SelectionID[:], data.Participation.SelectionParticipationKey)
+		ad.VoteFirstValid = basics.Round(data.Participation.VoteFirstValid)
+		ad.VoteLastValid = basics.Round(data.Participation.VoteLastValid)
+		ad.VoteKeyDilution = data.Participation.VoteKeyDilution
+		if data.Participation.StateProofKey != nil {
+			copy(ad.StateProofID[:], *data.Participation.StateProofKey)
+		}
+	}
+	if data.IncentiveEligible != nil {
+		ad.IncentiveEligible = *data.IncentiveEligible
+	}
+	return &ad, nil
+}
+
+// convertStateOverrides converts the state overrides of a simulate request to
+// the ones of the simulator.
+func convertStateOverrides(overrides *model.SimulateStateOverrides) (*simulation.StateOverrides, error) {
+	if overrides == nil {
+		return nil, nil
+	}
+	var converted simulation.StateOverrides
+	if overrides.Accounts != nil {
+		for _, ao := range *overrides.Accounts {
+			addr, err := basics.UnmarshalChecksumAddress(ao.Address)
+			if err != nil {
+				return nil, fmt.Errorf("invalid account override address %s: %w", ao.Address, err)
+			}
+			data, err := convertSimulateAccountData(ao.Data)
+			if err != nil {
+				return nil, fmt.Errorf("invalid data of account override %s: %w", ao.Address, err)
+			}
+			account := simulation.AccountOverride{Address: addr, Data: data}
+			if ao.Assets != nil {
+				for _, ho := range *ao.Assets {
+					holding := simulation.AssetHoldingOverride{AssetID: basics.AssetIndex(ho.AssetId)}
+					if ho.Amount != nil {
+						holding.Amount = *ho.Amount
+					}
+					if ho.IsFrozen != nil {
+						holding.Frozen = *ho.IsFrozen
+					}
+					account.Assets = append(account.Assets, holding)
+				}
+			}
+			if ao.AppLocalStates != nil {
+				for _, lo := range *ao.AppLocalStates {
+					kv, err := convertGeneratedTKV(&lo.KeyValue)
+					if err != nil {
+						return nil, fmt.Errorf("invalid local state of app %d of account override %s: %w", lo.AppId, ao.Address, err)
+					}
+					account.AppLocals = append(account.AppLocals, simulation.AppLocalStateOverride{AppID: basics.AppIndex(lo.AppId), KeyValue: kv})
+				}
+			}
+			converted.Accounts = append(converted.Accounts, account)
+		}
+	}
+	if overrides.Apps != nil {
+		for _, ao := range *overrides.Apps {
+			kv, err := convertGeneratedTKV(&ao.GlobalState)
+			if err != nil {
+				return nil, fmt.Errorf("invalid global state of app override %d: %w", ao.AppId, err)
+			}
+			converted.Apps = append(converted.Apps, simulation.AppOverride{AppID: basics.AppIndex(ao.AppId), GlobalState: kv})
+		}
+	}
+	if overrides.Boxes != nil {
+		for _, bo := range *overrides.Boxes {
+			converted.Boxes = append(converted.Boxes, simulation.BoxOverride{AppID: basics.AppIndex(bo.AppId), Name: bo.Name, Value: bo.Value})
+		}
+	}
+	return &converted, nil
 }
 
 // printableUTF8OrEmpty checks to see if the entire string is a UTF8 printable string.
@@ -639,3 +770,31 @@ func printableUTF8OrEmpty(in string) string {
 	}
 	return in
 }
+
+func balancesTrieProofToModel(proof ledgercore.BalancesTrieProof) model.BalancesTrieProofResponse {
+	path := make([]model.BalancesTrieProofNode, len(proof.Proof.Path))
+	for i, pnode := range proof.Proof.Path {
+		children := make([]model.BalancesTrieProofChild, len(pnode.Children))
+		for j, child := range pnode.Children {
+			children[j] = model.BalancesTrieProofChild{
+				Index: uint64(child.HashIndex),
+				Leaf:  child.Leaf,
+				Hash:  sliceOrNil(child.Hash),
+			}
+		}
+		path[i] = model.BalancesTrieProofNode{Children: children}
+	}
+	response := model.BalancesTrieProofResponse{
+		Round:      uint64(proof.Round),
+		Root:       proof.Root[:],
+		Leaf:       proof.Leaf,
+		Path:       path,
+		BlockHash:  proof.BlockHash[:],
+		Totals:     protocol.EncodeReflect(&proof.Totals), // as hashed in the commitment
+		Commitment: proof.Commitment,
+	}
+	if proof.StateProofVerificationHash != nil {
+		response.StateProofVerificationHash = sliceOrNil(proof.StateProofVerificationHash[:])
+	}
+	return response
+}
@@ -24,7 +24,6 @@ import (
 	"github.com/Quarkonium-chain/go-quarkonium/config"
 	"github.com/Quarkonium-chain/go-quarkonium/crypto"
 	"github.com/Quarkonium-chain/go-quarkonium/data/basics"
-	"github.com/Quarkonium-chain/go-quarkonium/data/transactions"
 	"github.com/Quarkonium-chain/go-quarkonium/protocol"
 )
 
@@ -43,30 +42,22 @@ func newAccount(t testing.TB, gen io.Reader, latest basics.Round, keyBatchesForw
 	return addr, s, &v, o
 }
 
-func signTx(s *crypto.SignatureSecrets, t transactions.Transaction) transactions.SignedTxn {
-	return t.Sign(s)
-}
-
-// testingenv creates a random set of participating accounts and random transactions between them, and
-// the associated selection parameters for use testing committee membership and credential validation.
-// seedGen is provided as an external source of randomness for the selection seed and transaction notes;
+// testingenv creates a random set of participating accounts, and the associated selection parameters
+// for use testing committee membership and credential validation.
+// seedGen is provided as an external source of randomness for the selection seed;
 // if the caller persists seedGen between calls to testingenv, each iteration that calls testingenv will
 // exercise a new selection seed.
-func testingenv(t testing.TB, numAccounts, numTxs int, seedGen io.Reader) (selectionParameterFn, selectionParameterListFn, basics.Round, []basics.Address, []*crypto.SignatureSecrets, []*crypto.VrfPrivkey, []*crypto.OneTimeSignatureSecrets, []transactions.SignedTxn) {
+func testingenv(t testing.TB, numAccounts, numTxs int, seedGen io.Reader) (selectionParameterFn, selectionParameterListFn, basics.Round, []basics.Address, []*crypto.SignatureSecrets, []*crypto.VrfPrivkey, []*crypto.OneTimeSignatureSecrets) {
 	return testingenvMoreKeys(t, numAccounts, numTxs, uint(5), seedGen)
 }
 
-func testingenvMoreKeys(t testing.TB, numAccounts, numTxs int, keyBatchesForward uint, seedGen io.Reader) (selectionParameterFn, selectionParameterListFn, basics.Round, []basics.Address, []*crypto.SignatureSecrets, []*crypto.VrfPrivkey, []*crypto.OneTimeSignatureSecrets, []transactions.SignedTxn) {
+func testingenvMoreKeys(t testing.TB, numAccounts, numTxs int, keyBatchesForward uint, seedGen io.Reader) (selectionParameterFn, selectionParameterListFn, basics.Round, []basics.Address, []*crypto.SignatureSecrets, []*crypto.VrfPrivkey, []*crypto.OneTimeSignatureSecrets) {
 	if seedGen == nil {
 		seedGen = rand.New(rand.NewSource(1)) // same source as setting GODEBUG=randautoseed=0, same as pre-Go 1.20 default seed
 	}
 	P := numAccounts          // n accounts
-	TXs := numTxs             // n txns
 	maxMoneyAtStart := 100000 // max money start
 	minMoneyAtStart := 10000  // max money start
-	transferredMoney := 100   // max money/txn
-	maxFee := 10              // max maxFee/txn
-	E := basics.Round(50)     // max round
 
 	// generate accounts
 	genesis := make(map[basics.Address]basics.AccountData)
@@ -99,32 +90,14 @@ func testingenvMoreKeys(t testing.TB, numAccounts, numTxs int, keyBatchesForward
 	var seed Seed
 	seedGen.Read(seed[:])
 
-	tx := make([]transactions.SignedTxn, TXs)
-	for i := 0; i < TXs; i++ {
-		send := gen.Int() % P
-		recv := gen.Int() % P
-
-		saddr := addrs[send]
-		raddr := addrs[recv]
-		amt := basics.MicroAlgos{Raw: uint64(gen.Int() % transferredMoney)}
-		fee := basics.MicroAlgos{Raw: uint64(gen.Int() % maxFee)}
-
-		t := transactions.Transaction{
-			Type: protocol.PaymentTx,
-			Header: transactions.Header{
-				Sender:     saddr,
-				Fee:        fee,
-				FirstValid: 0,
-				LastValid:  E,
-				Note:       make([]byte, 4),
-			},
-			PaymentTxnFields: transactions.PaymentTxnFields{
-				Receiver: raddr,
-				Amount:   amt,
-			},
-		}
-		seedGen.Read(t.Note) // to match output from previous versions, which shared global RNG for seed & note
-		tx[i] = t.Sign(secrets[send])
+	// draw the randomness that was used for generating transactions in
+	// previous versions, so that the selection seeds remain the same
+	for i := 0; i < numTxs; i++ {
+		gen.Int() // sender
+		gen.Int() // receiver
+		gen.Int() // amount
+		gen.Int() // fee
+		seedGen.Read(make([]byte, 4))
 	}
 
 	selParams := func(addr basics.Address) (bool, BalanceRecord, Seed, basics.MicroAlgos) {
@@ -149,7 +122,7 @@ func testingenvMoreKeys(t testing.TB, numAccounts, numTxs int, keyBatchesForward
 		return
 	}
 
-	return selParams, selParamsList, lookback, addrs, secrets, vrfSecrets, otSecrets, tx
+	return selParams, selParamsList, lookback, addrs, secrets, vrfSecrets, otSecrets
 }
 
 /* TODO deprecate these types after they have been removed successfully */
@@ -45,3 +45,16 @@ func (e *ErrTxPoolFeeError) Error() string {
 	return fmt.Sprintf("fee %d below threshold %d (%d per byte * %d bytes)",
 		e.fee, e.feeThreshold, e.feePerByte, e.encodedLength)
 }
+
+// ErrTxPoolReplacementFeeError is returned when a transaction group shares a
+// sender, a lease and a first valid round with pending groups without paying
+// enough to replace them.
+type ErrTxPoolReplacementFeeError struct {
+	fee         basics.MicroAlgos
+	replacedFee basics.MicroAlgos
+}
+
+func (e *ErrTxPoolReplacementFeeError) Error() string {
+	return fmt.Sprintf("fee %d too low to replace pending transactions with the same lease and a total fee of %d (at least %d%% more is required)",
+		e.fee.Raw, e.replacedFee.Raw, replaceByFeeBump)
+}
@@ -0,0 +1,255 @@
+// Copyright (C) 2019-2024 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package pools
+
+import (
+	"errors"
+	"fmt"
+	"math/bits"
+	"sort"
+
+	"github.com/Quarkonium-chain/go-quarkonium/data/basics"
+	"github.com/Quarkonium-chain/go-quarkonium/data/transactions"
+	"github.com/Quarkonium-chain/go-quarkonium/ledger/ledgercore"
+	"github.com/Quarkonium-chain/go-quarkonium/protocol"
+	"github.com/Quarkonium-chain/go-quarkonium/util/metrics"
+)
+
+// replaceByFeeBump is the minimal increase, in percent, of the total fee a
+// transaction group has to pay over the pending groups it conflicts with in
+// order to replace them.
+const replaceByFeeBump = 10
+
+var transactionPoolReplacedGroups = metrics.MakeCounter(metrics.TransactionPoolReplacedGroups)
+var transactionPoolEvictedGroups = metrics.MakeCounter(metrics.TransactionPoolEvictedGroups)
+
+// groupFee summarizes the fees paid by a pending transaction group.
+type groupFee struct {
+	fee    uint64
+	length uint64
+	// stateProof is set for groups holding a state proof transaction, which
+	// are never evicted.
+	stateProof bool
+}
+
+func makeGroupFee(txgroup []transactions.SignedTxn) (gf groupFee) {
+	for _, t := range txgroup {
+		gf.fee = basics.AddSaturate(gf.fee, t.Txn.Fee.Raw)
+		gf.length += uint64(t.GetEncodedLength())
+		if t.Txn.Type == protocol.StateProofTx {
+			gf.stateProof = true
+		}
+	}
+	return gf
+}
+
+// mulLess returns whether a*b < c*d, without overflowing.
+func mulLess(a, b, c, d uint64) bool {
+	hi1, lo1 := bits.Mul64(a, b)
+	hi2, lo2 := bits.Mul64(c, d)
+	return hi1 < hi2 || (hi1 == hi2 && lo1 < lo2)
+}
+
+// lowerFeePerByte returns whether gf pays a lower fee per byte than other.
+func (gf groupFee) lowerFeePerByte(other groupFee) bool {
+	return mulLess(gf.fee, other.length, other.fee, gf.length)
+}
+
+// replaceKey identifies the pending transactions a transaction replaces.
+type replaceKey struct {
+	ledgercore.Txlease
+	firstValid basics.Round
+}
+
+// makeRoom finds the pending groups to remove from the pool in order to admit
+// txgroup. These are the groups txgroup replaces, which share a sender, a
+// lease and a first valid round with it and so could never be committed along
+// with it, and, if the pool is full, the groups to evict, the ones paying the
+// lowest fee per byte.
+//
+// Replacing requires txgroup to pay replaceByFeeBump percent more than the
+// total fee of the replaced groups, and only groups paying less per byte than
+// txgroup are evicted. When no groups have to be removed, both returned lists
+// are empty and the pool size limit is left for checkPendingQueueSize. A group
+// that is already pending doesn't replace itself, it fails as a duplicate.
+func (pool *TransactionPool) makeRoom(txgroup []transactions.SignedTxn) (replaced []int, evicted []int, err error) {
+	keys := make(map[replaceKey]bool)
+	for _, t := range txgroup {
+		if t.Txn.Lease != ([32]byte{}) {
+			keys[replaceKey{Txlease: ledgercore.Txlease{Sender: t.Txn.Sender, Lease: t.Txn.Lease}, firstValid: t.Txn.FirstValid}] = true
+		}
+	}
+
+	pool.pendingMu.RLock()
+	defer pool.pendingMu.RUnlock()
+
+	pendingCount := len(pool.pendingTxids)
+	if len(keys) == 0 && pendingCount+len(txgroup) <= pool.txPoolMaxSize {
+		// fast path: nothing to replace and enough room.
+		return nil, nil, nil
+	}
+	for _, t := range txgroup {
+		txid := t.ID()
+		if _, pending := pool.pendingTxids[txid]; pending {
+			return nil, nil, &ledgercore.TransactionInLedgerError{Txid: txid, InBlockEvaluator: true}
+		}
+	}
+
+	newFee := makeGroupFee(txgroup)
+	isReplaced := make(map[int]bool)
+	var replacedFee uint64
+	replacedCount := 0
+	if len(keys) > 0 {
+		for i, pendingGroup := range pool.pendingTxGroups {
+			for _, t := range pendingGroup {
+				if keys[replaceKey{Txlease: ledgercore.Txlease{Sender: t.Txn.Sender, Lease: t.Txn.Lease}, firstValid: t.Txn.FirstValid}] {
+					replaced = append(replaced, i)
+					isReplaced[i] = true
+					replacedFee = basics.AddSaturate(replacedFee, pool.pendingGroupFees[i].fee)
+					replacedCount += len(pendingGroup)
+					break
+				}
+			}
+		}
+	}
+	if len(replaced) > 0 {
+		if mulLess(newFee.fee, 100, replacedFee, 100+replaceByFeeBump) {
+			return nil, nil, &ErrTxPoolReplacementFeeError{
+				fee:         basics.MicroAlgos{Raw: newFee.fee},
+				replacedFee: basics.MicroAlgos{Raw: replacedFee},
+			}
+		}
+		pendingCount -= replacedCount
+	}
+
+	excess := pendingCount + len(txgroup) - pool.txPoolMaxSize
+	if excess <= 0 || (newFee.stateProof && len(replaced) == 0) {
+		return replaced, nil, nil
+	}
+
+	// evict the groups paying the lowest fee per byte, the most recent ones first.
+	var candidates []int
+	for i, gf := range pool.pendingGroupFees {
+		if !isReplaced[i] && !gf.stateProof && gf.lowerFeePerByte(newFee) {
+			candidates = append(candidates, i)
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		gfi, gfj := pool.pendingGroupFees[candidates[i]], pool.pendingGroupFees[candidates[j]]
+		if gfi.lowerFeePerByte(gfj) {
+			return true
+		}
+		if gfj.lowerFeePerByte(gfi) {
+			return false
+		}
+		return candidates[i] > candidates[j]
+	})
+	for _, i := range candidates {
+		if excess <= 0 {
+			break
+		}
+		evicted = append(evicted, i)
+		excess -= len(pool.pendingTxGroups[i])
+	}
+	if excess > 0 {
+		return nil, nil, ErrPendingQueueReachedMaxCap
+	}
+	return replaced, evicted, nil
+}
+
+// rememberReplacing removes the replaced and evicted pending groups, as found
+// by makeRoom, and remembers txgroup instead. Rebuilding the pending block
+// evaluator without the removed groups costs as much as replaying the whole
+// pool, so it's only marked stale and rebuilt once per block, see
+// refreshStaleEvaluator. Until then, a group replacing pending groups the
+// evaluator holds is only tested against it, while a group evicting pending
+// groups is added to it as usual. If txgroup can't be remembered, the removed
+// groups are restored. The caller is assumed to be holding pool.mu.
+func (pool *TransactionPool) rememberReplacing(txgroup []transactions.SignedTxn, replaced []int, evicted []int) error {
+	removed := make(map[int]bool, len(replaced)+len(evicted))
+	for _, i := range replaced {
+		removed[i] = true
+	}
+	for _, i := range evicted {
+		removed[i] = true
+	}
+
+	pool.pendingMu.Lock()
+	origTxGroups := pool.pendingTxGroups
+	origGroupFees := pool.pendingGroupFees
+	origTxids := pool.pendingTxids
+	// PendingTxGroups hands out pendingTxGroups, so it's copied rather than
+	// compacted in place.
+	pool.pendingTxGroups = make([][]transactions.SignedTxn, 0, len(origTxGroups)-len(removed))
+	pool.pendingGroupFees = make([]groupFee, 0, len(origTxGroups)-len(removed))
+	pool.pendingTxids = make(map[transactions.Txid]transactions.SignedTxn, len(origTxids))
+	for i, pendingGroup := range origTxGroups {
+		if removed[i] {
+			continue
+		}
+		pool.pendingTxGroups = append(pool.pendingTxGroups, pendingGroup)
+		pool.pendingGroupFees = append(pool.pendingGroupFees, origGroupFees[i])
+		for _, t := range pendingGroup {
+			pool.pendingTxids[t.ID()] = t
+		}
+	}
+	pool.pendingMu.Unlock()
+
+	err := pool.ingest(txgroup, poolIngestParams{replacing: len(replaced) > 0})
+	if err != nil {
+		pool.pendingMu.Lock()
+		pool.pendingTxGroups = origTxGroups
+		pool.pendingGroupFees = origGroupFees
+		pool.pendingTxids = origTxids
+		pool.pendingMu.Unlock()
+		return err
+	}
+	pool.rememberCommit(false)
+	pool.pendingEvaluatorStale = true
+
+	replacedBy := fmt.Sprintf("transaction replaced by the higher fee transaction %s", txgroup[0].ID())
+	for _, i := range replaced {
+		for _, t := range origTxGroups[i] {
+			pool.statusCache.put(t, replacedBy)
+		}
+	}
+	for _, i := range evicted {
+		for _, t := range origTxGroups[i] {
+			pool.statusCache.put(t, "transaction evicted from the full transaction pool by higher fee transactions")
+		}
+	}
+	transactionPoolReplacedGroups.AddUint64(uint64(len(replaced)), nil)
+	transactionPoolEvictedGroups.AddUint64(uint64(len(evicted)), nil)
+	return nil
+}
+
+// testReplacing performs the checks of Test on a group replacing pending
+// groups. The pending block evaluator holds the replaced groups, or did before
+// they were removed and it went stale, so lease conflicts within it are ignored.
+func (pool *TransactionPool) testReplacing(txgroup []transactions.SignedTxn) error {
+	for _, t := range txgroup {
+		err := pool.pendingBlockEvaluator.TestTransaction(t)
+		var leaseErr *ledgercore.LeaseInLedgerError
+		if errors.As(err, &leaseErr) && leaseErr.InBlockEvaluator {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -31,6 +31,7 @@ import (
 	"github.com/Quarkonium-chain/go-quarkonium/data/transactions"
 	"github.com/Quarkonium-chain/go-quarkonium/data/transactions/logic"
 	"github.com/Quarkonium-chain/go-quarkonium/ledger"
+	"github.com/Quarkonium-chain/go-quarkonium/ledger/apply"
 	"github.com/Quarkonium-chain/go-quarkonium/ledger/ledgercore"
 	"github.com/Quarkonium-chain/go-quarkonium/logging"
 	"github.com/Quarkonium-chain/go-quarkonium/logging/telemetryspec"
@@ -67,6 +68,12 @@ type TransactionPool struct {
 	feeThresholdMultiplier uint64
 	statusCache            *statusCache
 
+	// pendingEvaluatorStale is set once pending groups were replaced or evicted
+	// since the pendingBlockEvaluator was built: it still holds them, and lacks
+	// the groups replacing them. It's rebuilt on the next block, or before
+	// assembling a block from it.
+	pendingEvaluatorStale bool
+
 	assemblyMu       deadlock.Mutex
 	assemblyCond     sync.Cond
 	assemblyDeadline time.Time
@@ -74,18 +81,21 @@ type TransactionPool struct {
 	assemblyRound   basics.Round
 	assemblyResults poolAsmResults
 
-	// pendingMu protects pendingTxGroups and pendingTxids
+	// pendingMu protects pendingTxGroups, pendingGroupFees and pendingTxids
 	pendingMu       deadlock.RWMutex
 	pendingTxGroups [][]transactions.SignedTxn
-	pendingTxids    map[transactions.Txid]transactions.SignedTxn
+	// pendingGroupFees holds the fees of each of the pendingTxGroups.
+	pendingGroupFees []groupFee
+	pendingTxids     map[transactions.Txid]transactions.SignedTxn
 
 	// Calls to remember() add transactions to rememberedTxGroups and
 	// rememberedTxids.  Calling rememberCommit() adds them to the
 	// pendingTxGroups and pendingTxids.  This allows us to batch the
 	// changes in OnNewBlock() without preventing a concurrent call
 	// to PendingTxGroups() or Verified().
-	rememberedTxGroups [][]transactions.SignedTxn
-	rememberedTxids    map[transactions.Txid]transactions.SignedTxn
+	rememberedTxGroups  [][]transactions.SignedTxn
+	rememberedGroupFees []groupFee
+	rememberedTxids     map[transactions.Txid]transactions.SignedTxn
 
 	log logging.Logger
 	vac VotingAccountSupplier
@@ -106,6 +116,7 @@ type TransactionPool struct {
 // BlockEvaluator defines the block evaluator interface exposed by the ledger package.
 type BlockEvaluator interface {
 	TestTransactionGroup(txgroup []transactions.SignedTxn) error
+	TestTransaction(txn transactions.SignedTxn) error
 	Round() basics.Round
 	PaySetSize() int
 	TransactionGroup(txads []transactions.SignedTxnWithAD) error
@@ -188,8 +199,10 @@ func (pool *TransactionPool) Reset() {
 	defer pool.cond.Broadcast()
 	pool.pendingTxids = make(map[transactions.Txid]transactions.SignedTxn)
 	pool.pendingTxGroups = nil
+	pool.pendingGroupFees = nil
 	pool.rememberedTxids = make(map[transactions.Txid]transactions.SignedTxn)
 	pool.rememberedTxGroups = nil
+	pool.rememberedGroupFees = nil
 	pool.expiredTxCount = make(map[basics.Round]int)
 	pool.numPendingWholeBlocks = 0
 	pool.pendingBlockEvaluator = nil
@@ -258,11 +271,13 @@ func (pool *TransactionPool) rememberCommit(flush bool) {
 
 	if flush {
 		pool.pendingTxGroups = pool.rememberedTxGroups
+		pool.pendingGroupFees = pool.rememberedGroupFees
 		pool.stateproofOverflowed = false
 		pool.pendingTxids = pool.rememberedTxids
 		pool.ledger.VerifiedTransactionCache().UpdatePinned(pool.pendingTxids)
 	} else {
 		pool.pendingTxGroups = append(pool.pendingTxGroups, pool.rememberedTxGroups...)
+		pool.pendingGroupFees = append(pool.pendingGroupFees, pool.rememberedGroupFees...)
 
 		for txid, txn := range pool.rememberedTxids {
 			pool.pendingTxids[txid] = txn
@@ -270,6 +285,7 @@ func (pool *TransactionPool) rememberCommit(flush bool) {
 	}
 
 	pool.rememberedTxGroups = nil
+	pool.rememberedGroupFees = nil
 	pool.rememberedTxids = make(map[transactions.Txid]transactions.SignedTxn)
 }
 
@@ -368,6 +384,12 @@ func (pool *TransactionPool) checkSufficientFee(txgroup []transactions.SignedTxn
 		if t.Type == protocol.StateProofTx && t.Sender == transactions.StateProofSender && t.Fee.IsZero() {
 			return nil
 		}
+		// Similarly, a free heartbeat that answers a challenge should get
+		// in even if the pool is congested, so that the account is not
+		// suspended.
+		if t.Type == protocol.HeartbeatTx && t.Fee.IsZero() && pool.answersChallenge(t.HeartbeatTxnFields) {
+			return nil
+		}
 	}
 
 	// get the current fee per byte
@@ -388,12 +410,44 @@ func (pool *TransactionPool) checkSufficientFee(txgroup []transactions.SignedTxn
 	return nil
 }
 
+// answersChallenge returns whether hb is for an online account that is
+// challenged in the round of the pending block, and was not seen since.
+func (pool *TransactionPool) answersChallenge(hb *transactions.HeartbeatTxnFields) bool {
+	if hb == nil {
+		return false
+	}
+	rnd := pool.pendingBlockEvaluator.Round()
+	hdr, err := pool.ledger.BlockHdr(rnd - 1)
+	if err != nil {
+		return false
+	}
+	rules := config.Consensus[hdr.CurrentProtocol].Payouts
+	for _, period := range []apply.ChallengePeriod{apply.ChRisky, apply.ChActive} {
+		ch, err := apply.FindChallenge(rules, rnd, pool.ledger, period)
+		if err != nil || ch.IsZero() {
+			continue
+		}
+		acct, _, err := pool.ledger.LookupWithoutRewards(rnd-1, hb.HbAddress)
+		if err != nil {
+			return false
+		}
+		return acct.Status == basics.Online && ch.Failed(hb.HbAddress, acct.LastSeen())
+	}
+	return false
+}
+
 // Test performs basic duplicate detection and well-formedness checks
 // on a transaction group without storing the group.
 func (pool *TransactionPool) Test(txgroup []transactions.SignedTxn) error {
-	if err := pool.checkPendingQueueSize(txgroup); err != nil {
+	replaced, evicted, err := pool.makeRoom(txgroup)
+	if err != nil {
 		return err
 	}
+	if len(replaced) == 0 && len(evicted) == 0 {
+		if err := pool.checkPendingQueueSize(txgroup); err != nil {
+			return err
+		}
+	}
 
 	pool.mu.Lock()
 	defer pool.mu.Unlock()
@@ -402,12 +456,17 @@ func (pool *TransactionPool) Test(txgroup []transactions.SignedTxn) error {
 		return fmt.Errorf("Test: pendingBlockEvaluator is nil")
 	}
 
+	if len(replaced) > 0 {
+		return pool.testReplacing(txgroup)
+	}
 	return pool.pendingBlockEvaluator.TestTransactionGroup(txgroup)
 }
 
 type poolIngestParams struct {
 	recomputing bool // if unset, perform fee checks and wait until ledger is caught up
+	replacing   bool // if set, the group is only tested, the stale pending block evaluator holds the groups it replaces
 	stats       *telemetryspec.AssembleBlockMetrics
+	fee         *groupFee // if set, the already known fees of the group
 }
 
 // remember attempts to add a transaction group to the pool.
@@ -420,10 +479,11 @@ func (pool *TransactionPool) remember(txgroup []transactions.SignedTxn) error {
 
 // add tries to add the transaction group to the pool, bypassing the fee
 // priority checks.
-func (pool *TransactionPool) add(txgroup []transactions.SignedTxn, stats *telemetryspec.AssembleBlockMetrics) error {
+func (pool *TransactionPool) add(txgroup []transactions.SignedTxn, fee groupFee, stats *telemetryspec.AssembleBlockMetrics) error {
 	params := poolIngestParams{
 		recomputing: true,
 		stats:       stats,
+		fee:         &fee,
 	}
 	return pool.ingest(txgroup, params)
 }
@@ -465,12 +525,22 @@ func (pool *TransactionPool) ingest(txgroup []transactions.SignedTxn, params poo
 		}
 	}
 
-	err := pool.addToPendingBlockEvaluator(txgroup, params.recomputing, params.stats)
+	var err error
+	if params.replacing {
+		err = pool.testReplacing(txgroup)
+	} else {
+		err = pool.addToPendingBlockEvaluator(txgroup, params.recomputing, params.stats)
+	}
 	if err != nil {
 		return err
 	}
 
 	pool.rememberedTxGroups = append(pool.rememberedTxGroups, txgroup)
+	if params.fee != nil {
+		pool.rememberedGroupFees = append(pool.rememberedGroupFees, *params.fee)
+	} else {
+		pool.rememberedGroupFees = append(pool.rememberedGroupFees, makeGroupFee(txgroup))
+	}
 	for _, t := range txgroup {
 		pool.rememberedTxids[t.ID()] = t
 	}
@@ -484,16 +554,31 @@ func (pool *TransactionPool) RememberOne(t transactions.SignedTxn) error {
 }
 
 // Remember stores the provided transaction group.
+// A group sharing a sender and a lease with pending groups replaces them if it
+// pays a sufficiently higher fee, and a full pool evicts its lowest fee groups
+// in favor of a group paying a higher fee per byte.
 // Precondition: Only Remember() properly-signed and well-formed transactions (i.e., ensure t.WellFormed())
 func (pool *TransactionPool) Remember(txgroup []transactions.SignedTxn) error {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+
+	replaced, evicted, err := pool.makeRoom(txgroup)
+	if err != nil {
+		return fmt.Errorf("TransactionPool.Remember: %w", err)
+	}
+	if len(replaced) > 0 || len(evicted) > 0 {
+		err = pool.rememberReplacing(txgroup, replaced, evicted)
+		if err != nil {
+			return fmt.Errorf("TransactionPool.Remember: %w", err)
+		}
+		return nil
+	}
+
 	if err := pool.checkPendingQueueSize(txgroup); err != nil {
 		return err
 	}
 
-	pool.mu.Lock()
-	defer pool.mu.Unlock()
-
-	err := pool.remember(txgroup)
+	err = pool.remember(txgroup)
 	if err != nil {
 		return fmt.Errorf("TransactionPool.Remember: %w", err)
 	}
@@ -690,6 +775,7 @@ func (pool *TransactionPool) addToPendingBlockEvaluator(txgroup []transactions.S
 // by the BlockEvaluator). Expects that the pool.mu mutex would be already taken.
 func (pool *TransactionPool) recomputeBlockEvaluator(committedTxIDs map[transactions.Txid]ledgercore.IncludedTransactions, knownCommitted uint) (stats telemetryspec.ProcessBlockMetrics) {
 	pool.pendingBlockEvaluator = nil
+	pool.pendingEvaluatorStale = false
 
 	latest := pool.ledger.Latest()
 	prev, err := pool.ledger.BlockHdr(latest)
@@ -717,6 +803,7 @@ func (pool *TransactionPool) recomputeBlockEvaluator(committedTxIDs map[transact
 	// Grab the transactions to be played through the new block evaluator
 	pool.pendingMu.RLock()
 	txgroups := pool.pendingTxGroups
+	groupFees := pool.pendingGroupFees
 	pendingCount := pool.pendingCountNoLock()
 	pool.pendingMu.RUnlock()
 
@@ -756,7 +843,7 @@ func (pool *TransactionPool) recomputeBlockEvaluator(committedTxIDs map[transact
 	firstTxnGrpTime := time.Now()
 
 	// Feed the transactions in order
-	for _, txgroup := range txgroups {
+	for i, txgroup := range txgroups {
 		if len(txgroup) == 0 {
 			asmStats.InvalidCount++
 			continue
@@ -765,7 +852,7 @@ func (pool *TransactionPool) recomputeBlockEvaluator(committedTxIDs map[transact
 			asmStats.EarlyCommittedCount++
 			continue
 		}
-		err := pool.add(txgroup, &asmStats)
+		err := pool.add(txgroup, groupFees[i], &asmStats)
 		if err != nil {
 			for _, tx := range txgroup {
 				pool.statusCache.put(tx, err.Error())
@@ -911,6 +998,8 @@ func (pool *TransactionPool) AssembleBlock(round basics.Round, deadline time.Tim
 		}()
 	}
 
+	pool.refreshStaleEvaluator(round, deadline)
+
 	pool.assemblyMu.Lock()
 
 	// if the transaction pool is more than two rounds behind, we don't want to wait.
@@ -995,6 +1084,24 @@ func (pool *TransactionPool) AssembleBlock(round basics.Round, deadline time.Tim
 	return pool.assemblyResults.blk, nil
 }
 
+// refreshStaleEvaluator rebuilds the pending block evaluator for round if it's
+// stale, so that the assembled block holds the groups replacing pending ones
+// rather than the replaced ones. The evaluator is rebuilt once per block rather
+// than on every replacement, and the block is assembled while rebuilding it.
+func (pool *TransactionPool) refreshStaleEvaluator(round basics.Round, deadline time.Time) {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+	if !pool.pendingEvaluatorStale || pool.shutdown || pool.pendingBlockEvaluator == nil || pool.pendingBlockEvaluator.Round() != round {
+		return
+	}
+
+	pool.assemblyMu.Lock()
+	pool.assemblyDeadline = deadline
+	pool.assemblyRound = round
+	pool.assemblyMu.Unlock()
+	pool.recomputeBlockEvaluator(nil, 0)
+}
+
 // assembleEmptyBlock construct a new block for the given round. Internally it's using the ledger database calls, so callers
 // need to be aware that it might take a while before it would return.
 func (pool *TransactionPool) assembleEmptyBlock(round basics.Round) (assembled *ledgercore.UnfinishedBlock, err error) {
@@ -984,6 +984,38 @@ func TestTransactionPool_CurrentFeePerByte(t *testing.T) {
 
 }
 
+func TestTransactionPool_FreeHeartbeatFee(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	secret := keypair()
+	addr := basics.Address(secret.SignatureVerifier)
+
+	l := makeMockLedger(t, initAccFixed([]basics.Address{addr}, 1<<32))
+	cfg := config.GetDefaultLocal()
+	cfg.TxPoolSize = testPoolSize
+	cfg.EnableProcessBlockStats = false
+	transactionPool := MakeTransactionPool(l, cfg, logging.Base(), nil)
+	transactionPool.numPendingWholeBlocks = 2
+
+	// the pool is congested, and no account is challenged, so a free
+	// heartbeat has to pay the congestion fee
+	hb := transactions.Transaction{
+		Type: protocol.HeartbeatTx,
+		Header: transactions.Header{
+			Sender:      addr,
+			FirstValid:  0,
+			LastValid:   basics.Round(proto.MaxTxnLife),
+			GenesisHash: l.GenesisHash(),
+		},
+		HeartbeatTxnFields: &transactions.HeartbeatTxnFields{
+			HbAddress: addr,
+		},
+	}
+	err := transactionPool.RememberOne(transactions.SignedTxn{Txn: hb})
+	var feeErr *ErrTxPoolFeeError
+	require.ErrorAs(t, err, &feeErr)
+}
+
 func BenchmarkTransactionPoolRememberOne(b *testing.B) {
 	numOfAccounts := 5
 	// Generate accounts
@@ -1393,6 +1425,176 @@ func TestTxPoolSizeLimits(t *testing.T) {
 	}
 }
 
+func TestTxPoolReplaceByFee(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	secrets := []*crypto.SignatureSecrets{keypair(), keypair()}
+	sender := basics.Address(secrets[0].SignatureVerifier)
+	receiver := basics.Address(secrets[1].SignatureVerifier)
+
+	cfg := config.GetDefaultLocal()
+	cfg.TxPoolSize = testPoolSize
+	cfg.EnableProcessBlockStats = false
+	ledger := makeMockLedger(t, initAcc(map[basics.Address]uint64{sender: proto.MinBalance + 100*proto.MinTxnFee}))
+	transactionPool := MakeTransactionPool(ledger, cfg, logging.Base(), nil)
+
+	var lease [32]byte
+	crypto.RandBytes(lease[:])
+	makeTx := func(fee uint64, lease [32]byte, firstValid basics.Round, note byte) transactions.SignedTxn {
+		tx := transactions.Transaction{
+			Type: protocol.PaymentTx,
+			Header: transactions.Header{
+				Sender:      sender,
+				Fee:         basics.MicroAlgos{Raw: fee},
+				FirstValid:  firstValid,
+				LastValid:   10,
+				Note:        []byte{note},
+				Lease:       lease,
+				GenesisHash: ledger.GenesisHash(),
+			},
+			PaymentTxnFields: transactions.PaymentTxnFields{
+				Receiver: receiver,
+				Amount:   basics.MicroAlgos{Raw: 0},
+			},
+		}
+		return tx.Sign(secrets[0])
+	}
+
+	original := makeTx(proto.MinTxnFee, lease, 0, 0)
+	other := makeTx(proto.MinTxnFee, [32]byte{}, 0, 1)
+	require.NoError(t, transactionPool.RememberOne(original))
+	require.NoError(t, transactionPool.RememberOne(other))
+
+	// using another first valid round isn't a replacement
+	var leaseErr *ledgercore.LeaseInLedgerError
+	otherFirstValid := makeTx(2*proto.MinTxnFee, lease, 1, 2)
+	require.ErrorAs(t, transactionPool.Test([]transactions.SignedTxn{otherFirstValid}), &leaseErr)
+	require.ErrorAs(t, transactionPool.RememberOne(otherFirstValid), &leaseErr)
+
+	// paying the same fee, or not enough of a fee bump
+	var feeErr *ErrTxPoolReplacementFeeError
+	sameFee := makeTx(proto.MinTxnFee, lease, 0, 2)
+	require.ErrorAs(t, transactionPool.Test([]transactions.SignedTxn{sameFee}), &feeErr)
+	require.ErrorAs(t, transactionPool.RememberOne(sameFee), &feeErr)
+	underpriced := makeTx(proto.MinTxnFee*(100+replaceByFeeBump)/100-1, lease, 0, 2)
+	require.ErrorAs(t, transactionPool.Test([]transactions.SignedTxn{underpriced}), &feeErr)
+	require.ErrorAs(t, transactionPool.RememberOne(underpriced), &feeErr)
+	require.Equal(t, [][]transactions.SignedTxn{{original}, {other}}, transactionPool.PendingTxGroups())
+
+	replacement := makeTx(proto.MinTxnFee*(100+replaceByFeeBump)/100, lease, 0, 3)
+	require.NoError(t, transactionPool.Test([]transactions.SignedTxn{replacement}))
+	evaluator := transactionPool.pendingBlockEvaluator
+	require.NoError(t, transactionPool.RememberOne(replacement))
+	// the pending block evaluator isn't rebuilt on every replacement
+	require.True(t, evaluator == transactionPool.pendingBlockEvaluator)
+	require.True(t, transactionPool.pendingEvaluatorStale)
+
+	// resubmitting a pending group fails as a duplicate rather than as an underpriced replacement
+	var dupErr *ledgercore.TransactionInLedgerError
+	require.ErrorAs(t, transactionPool.Test([]transactions.SignedTxn{replacement}), &dupErr)
+	require.ErrorAs(t, transactionPool.RememberOne(replacement), &dupErr)
+	require.ErrorAs(t, transactionPool.RememberOne(original), &feeErr)
+
+	require.ElementsMatch(t, []transactions.Txid{other.ID(), replacement.ID()}, transactionPool.PendingTxIDs())
+	require.Equal(t, [][]transactions.SignedTxn{{other}, {replacement}}, transactionPool.PendingTxGroups())
+	_, txErr, found := transactionPool.Lookup(original.ID())
+	require.True(t, found)
+	require.Contains(t, txErr, "replaced")
+
+	// the proposed block holds the replacement rather than the original
+	proposedTxids := func() []transactions.Txid {
+		ufblk, err := transactionPool.AssembleBlock(transactionPool.pendingBlockEvaluator.Round(), time.Now().Add(time.Second))
+		require.NoError(t, err)
+		require.False(t, transactionPool.pendingEvaluatorStale)
+		payset, err := ufblk.UnfinishedBlock().DecodePaysetFlat()
+		require.NoError(t, err)
+		var txids []transactions.Txid
+		for _, txn := range payset {
+			txids = append(txids, txn.ID())
+		}
+		return txids
+	}
+	require.ElementsMatch(t, []transactions.Txid{other.ID(), replacement.ID()}, proposedTxids())
+
+	// the replacement can itself be replaced
+	second := makeTx(2*proto.MinTxnFee, lease, 0, 4)
+	require.NoError(t, transactionPool.RememberOne(second))
+	require.Equal(t, [][]transactions.SignedTxn{{other}, {second}}, transactionPool.PendingTxGroups())
+	require.ElementsMatch(t, []transactions.Txid{other.ID(), second.ID()}, proposedTxids())
+}
+
+func TestTxPoolEvictLowestFee(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	secrets := []*crypto.SignatureSecrets{keypair(), keypair()}
+	sender := basics.Address(secrets[0].SignatureVerifier)
+	receiver := basics.Address(secrets[1].SignatureVerifier)
+
+	const poolSize = 10
+	cfg := config.GetDefaultLocal()
+	cfg.TxPoolSize = poolSize
+	cfg.EnableProcessBlockStats = false
+	ledger := makeMockLedger(t, initAcc(map[basics.Address]uint64{sender: proto.MinBalance + 100*poolSize*proto.MinTxnFee}))
+	transactionPool := MakeTransactionPool(ledger, cfg, logging.Base(), nil)
+
+	uniqueTxID := 0
+	makeTx := func(fee uint64) transactions.SignedTxn {
+		tx := transactions.Transaction{
+			Type: protocol.PaymentTx,
+			Header: transactions.Header{
+				Sender:      sender,
+				Fee:         basics.MicroAlgos{Raw: fee},
+				FirstValid:  0,
+				LastValid:   10,
+				Note:        []byte{byte(uniqueTxID), byte(uniqueTxID >> 8)},
+				GenesisHash: ledger.GenesisHash(),
+			},
+			PaymentTxnFields: transactions.PaymentTxnFields{
+				Receiver: receiver,
+				Amount:   basics.MicroAlgos{Raw: 0},
+			},
+		}
+		uniqueTxID++
+		return tx.Sign(secrets[0])
+	}
+
+	// fill the pool, the cheapest transaction in the middle
+	var txns []transactions.SignedTxn
+	for i := 0; i < poolSize; i++ {
+		fee := 2 * proto.MinTxnFee
+		if i == poolSize/2 {
+			fee = proto.MinTxnFee
+		}
+		txns = append(txns, makeTx(fee))
+		require.NoError(t, transactionPool.RememberOne(txns[i]))
+	}
+	cheapest := txns[poolSize/2]
+
+	// paying the same fee as the cheapest one isn't enough
+	err := transactionPool.RememberOne(makeTx(proto.MinTxnFee))
+	require.ErrorIs(t, err, ErrPendingQueueReachedMaxCap)
+
+	// a pair of transactions can only evict the cheapest one and one of the others
+	group := []transactions.SignedTxn{makeTx(2 * proto.MinTxnFee), makeTx(2 * proto.MinTxnFee)}
+	require.ErrorIs(t, transactionPool.Test(group), ErrPendingQueueReachedMaxCap)
+
+	evictor := makeTx(3 * proto.MinTxnFee)
+	require.NoError(t, transactionPool.Test([]transactions.SignedTxn{evictor}))
+	require.NoError(t, transactionPool.RememberOne(evictor))
+	require.Equal(t, poolSize, transactionPool.PendingCount())
+	require.True(t, transactionPool.pendingEvaluatorStale)
+	ufblk, err := transactionPool.AssembleBlock(transactionPool.pendingBlockEvaluator.Round(), time.Now().Add(time.Second))
+	require.NoError(t, err)
+	require.Len(t, ufblk.UnfinishedBlock().Payset, poolSize)
+
+	pending := transactionPool.PendingTxIDs()
+	require.NotContains(t, pending, cheapest.ID())
+	require.Contains(t, pending, evictor.ID())
+	_, txErr, found := transactionPool.Lookup(cheapest.ID())
+	require.True(t, found)
+	require.Contains(t, txErr, "evicted")
+}
+
 func TestStateProofLogging(t *testing.T) {
 	partitiontest.PartitionTest(t)
 
@@ -0,0 +1,56 @@
+// Copyright (C) 2019-2024 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package transactions
+
+import (
+	"github.com/Quarkonium-chain/go-quarkonium/crypto"
+	"github.com/Quarkonium-chain/go-quarkonium/data/basics"
+	"github.com/Quarkonium-chain/go-quarkonium/data/committee"
+)
+
+// HeartbeatTxnFields captures the fields used for an online account to show
+// that its participation keys are live. (Really, it shows that whoever holds
+// the account's participation keys is able to get transactions onto the
+// chain, so it should also be able to propose and vote.)
+type HeartbeatTxnFields struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	// HbAddress is the account this transaction is proving liveness for.
+	HbAddress basics.Address `codec:"a"`
+
+	// HbProof is a signature of HbSeed under HbAddress's participation key,
+	// using the one-time key for the transaction's LastValid round.
+	HbProof crypto.HeartbeatProof `codec:"prf"`
+
+	// HbSeed must be the seed of the block before the transaction's
+	// FirstValid. It is carried in the transaction so that HbProof can be
+	// checked without a ledger lookup, and it is compared with the actual
+	// block seed during evaluation.
+	HbSeed committee.Seed `codec:"sd"`
+
+	// HbVoteID must match HbAddress's current VoteID.
+	HbVoteID crypto.OneTimeSignatureVerifier `codec:"vid"`
+
+	// HbKeyDilution must match HbAddress's current VoteKeyDilution.
+	HbKeyDilution uint64 `codec:"kd"`
+}
+
+// Empty returns whether the HeartbeatTxnFields are all zero,
+// in the sense of being omitted in a msgpack encoding.
+func (hb *HeartbeatTxnFields) Empty() bool {
+	return hb == nil || hb.MsgIsZero()
+}
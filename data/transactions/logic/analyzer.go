@@ -0,0 +1,810 @@
+// Copyright (C) 2019-2024 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package logic
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+)
+
+// FindingKind names a class of problem reported by Analyze.
+type FindingKind string
+
+// The kinds of findings that Analyze reports.
+const (
+	// FindingUnreachable marks instructions that no path from the program
+	// start can reach.
+	FindingUnreachable FindingKind = "unreachable-code"
+	// FindingStackUnderflow marks an instruction that pops more values than
+	// the stack holds on some path.
+	FindingStackUnderflow FindingKind = "stack-underflow"
+	// FindingStackType marks an instruction that is given a value of the
+	// wrong type on some path.
+	FindingStackType FindingKind = "stack-type"
+	// FindingUncheckedOnCompletion marks an approving exit of an application
+	// program reached on a path that never reads txn OnCompletion.
+	FindingUncheckedOnCompletion FindingKind = "unchecked-on-completion"
+	// FindingUncheckedRekeyTo marks an approving exit of a logicsig reached
+	// on a path that never reads txn RekeyTo.
+	FindingUncheckedRekeyTo FindingKind = "unchecked-rekey-to"
+	// FindingUncheckedCloseRemainderTo marks an approving exit of a logicsig
+	// reached on a path that never reads txn CloseRemainderTo.
+	FindingUncheckedCloseRemainderTo FindingKind = "unchecked-close-remainder-to"
+	// FindingUnboundedCost marks a loop or recursive callsub, which makes the
+	// cost of the program depend on how many times it runs.
+	FindingUnboundedCost FindingKind = "unbounded-cost"
+	// FindingBudgetExceeded is reported when the worst-case cost of the
+	// program exceeds AnalysisParams.Budget.
+	FindingBudgetExceeded FindingKind = "budget-exceeded"
+)
+
+// Finding is a single problem found by Analyze.
+type Finding struct {
+	Kind FindingKind
+	// PC is the program counter of the instruction the finding is about.
+	PC int
+	// Location is the source location of PC, if known.
+	Location *SourceLocation
+	Message  string
+}
+
+func (f Finding) String() string {
+	if f.Location != nil {
+		return fmt.Sprintf("%d:%d: %s: %s", f.Location.Line+1, f.Location.Column, f.Kind, f.Message)
+	}
+	return fmt.Sprintf("pc=%d: %s: %s", f.PC, f.Kind, f.Message)
+}
+
+// AnalysisParams controls what Analyze checks.
+type AnalysisParams struct {
+	// Mode is ModeSig or ModeApp, and selects the transaction fields that
+	// every approving path is expected to check. If zero, it is ModeApp for
+	// programs that use stateful opcodes, and ModeSig otherwise.
+	Mode RunMode
+	// Budget is the opcode budget the program runs with. If positive, a
+	// worst-case cost above it is a finding.
+	Budget int
+}
+
+// Analysis is the result of Analyze.
+type Analysis struct {
+	Version uint64
+	Mode    RunMode
+	// MaxCost is the cost of the most expensive path through the program.
+	// If Unbounded, loops and recursive subroutines are counted as running
+	// once, so it is only the cost of a single iteration.
+	MaxCost   int
+	Unbounded bool
+	Findings  []Finding
+}
+
+// Analyze statically analyzes the assembled program, following every path
+// through its control-flow graph, including callsub frames, to find stack and
+// type errors, unreachable code, missing checks of dangerous transaction
+// fields, and the worst-case opcode cost.
+func (ops *OpStream) Analyze(params AnalysisParams) (*Analysis, error) {
+	if ops.Program == nil {
+		return nil, errors.New("program has not been assembled")
+	}
+	return AnalyzeProgram(ops.Program, ops.OffsetToSource, params)
+}
+
+// AnalyzeProgram is Analyze for program bytes. offsetToSource, which may be
+// nil, gives the source locations of the findings.
+func AnalyzeProgram(program []byte, offsetToSource map[int]SourceLocation, params AnalysisParams) (*Analysis, error) {
+	a, err := newAnalyzer(program, offsetToSource)
+	if err != nil {
+		return nil, err
+	}
+	mode := params.Mode
+	if mode == 0 {
+		stateful, err := HasStatefulOps(program)
+		if err != nil {
+			return nil, err
+		}
+		mode = ModeSig
+		if stateful {
+			mode = ModeApp
+		}
+	}
+	for i, cf := range checkedFields {
+		if cf.mode == mode {
+			a.required |= 1 << i
+		}
+	}
+
+	if a.entry == len(program) {
+		return &Analysis{Version: a.version, Mode: mode}, nil
+	}
+
+	main := a.function(a.entry)
+	a.checkExits(main)
+	a.checkReachability()
+	cost := a.cost(main)
+	result := &Analysis{
+		Version:   a.version,
+		Mode:      mode,
+		MaxCost:   max(cost.ret, cost.exit, 0),
+		Unbounded: a.unbounded,
+	}
+	if params.Budget > 0 && result.MaxCost > params.Budget {
+		a.report(FindingBudgetExceeded, a.entry, "worst-case cost %d exceeds the budget of %d", result.MaxCost, params.Budget)
+	}
+
+	sort.SliceStable(a.findings, func(i, j int) bool {
+		return a.findings[i].PC < a.findings[j].PC
+	})
+	result.Findings = a.findings
+	return result, nil
+}
+
+// checkedFields are the transaction fields that a program of the given mode
+// should read before approving, since ignoring them lets the sender do more
+// than the program intends. A fieldSet has bit i set for checkedFields[i].
+var checkedFields = []struct {
+	field TxnField
+	mode  RunMode
+	kind  FindingKind
+}{
+	{OnCompletion, ModeApp, FindingUncheckedOnCompletion},
+	{RekeyTo, ModeSig, FindingUncheckedRekeyTo},
+	{CloseRemainderTo, ModeSig, FindingUncheckedCloseRemainderTo},
+}
+
+type fieldSet uint8
+
+// instruction is a decoded opcode of the analyzed program.
+type instruction struct {
+	pc   int
+	next int
+	spec *OpSpec
+	// immediates, as the assembler would see them, for the refine functions
+	immediates []token
+	// targets of branches, switch, match, and callsub
+	targets []int
+	// reads are the checkedFields read by txn, gtxn, or gtxns
+	reads fieldSet
+	// returns, if not nil, narrow the types the spec returns, to those of
+	// the constant pushed by pushint or pushbytes, or of the field read by
+	// txn and the like
+	returns StackTypes
+}
+
+// flowState is what is known about the program before an instruction. height
+// is the stack height relative to the entry of the function, so it is negative
+// when a subroutine has consumed its arguments. If known.bottom is StackNone,
+// some path reaches the instruction with only the values in known.stack.
+type flowState struct {
+	known   ProgramKnowledge
+	height  int
+	checked fieldSet
+}
+
+func (s *flowState) clone() flowState {
+	c := *s
+	c.known.stack = append(StackTypes(nil), s.known.stack...)
+	return c
+}
+
+func (s *flowState) equal(o *flowState) bool {
+	if s.height != o.height || s.checked != o.checked || s.known.bottom != o.known.bottom ||
+		s.known.fp != o.known.fp || s.known.scratchSpace != o.known.scratchSpace ||
+		len(s.known.stack) != len(o.known.stack) {
+		return false
+	}
+	for i := range s.known.stack {
+		if s.known.stack[i] != o.known.stack[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// widenAfter is the number of times the state of an instruction may change
+// before its types lose their bounds, which ensures that loops converge.
+const widenAfter = 8
+
+// mergeStates returns what is known when control may come from a or b. Stacks
+// are aligned at their tops, which is where the next instructions look.
+func mergeStates(a, b *flowState, widen bool) flowState {
+	m := flowState{height: min(a.height, b.height), checked: a.checked & b.checked}
+	n := min(len(a.known.stack), len(b.known.stack))
+	// If the shorter stack is exact, some path has only those values, so
+	// popping more would underflow on that path.
+	m.known.bottom = StackAny
+	if a.known.bottom == StackNone && len(a.known.stack) == n ||
+		b.known.bottom == StackNone && len(b.known.stack) == n {
+		m.known.bottom = StackNone
+	}
+	m.known.fp = -1
+	if a.known.fp == b.known.fp {
+		m.known.fp = a.known.fp
+	}
+	m.known.stack = make(StackTypes, n)
+	for i := range m.known.stack {
+		st := a.known.stack[len(a.known.stack)-n+i].union(b.known.stack[len(b.known.stack)-n+i])
+		if widen {
+			st = st.widened()
+		}
+		m.known.stack[i] = st
+	}
+	for i := range m.known.scratchSpace {
+		st := a.known.scratchSpace[i].union(b.known.scratchSpace[i])
+		if widen {
+			st = st.widened()
+		}
+		m.known.scratchSpace[i] = st
+	}
+	return m
+}
+
+// function is the main program, or a subroutine entered by callsub.
+type function struct {
+	entry  int
+	states map[int]*flowState
+	visits map[int]int
+	// analyzing is set until states are complete, so a callsub that finds it
+	// set is recursive.
+	analyzing bool
+
+	// need is the number of stack values below its entry that the function
+	// may consume, and delta is how the stack height changes when it returns.
+	// deltaKnown is false if different retsubs disagree.
+	need       int
+	delta      int
+	deltaKnown bool
+	// proto holds the immediates of the proto that starts the function, if any
+	proto *[2]int
+	// returns is true if some retsub is reachable, in which case retChecked
+	// are the fields read on every path to a retsub.
+	returns    bool
+	retChecked fieldSet
+	// exits are the approving exits reachable from the function, mapped to
+	// the fields read on every path to them from its entry.
+	exits map[int]fieldSet
+
+	costing bool
+	costed  bool
+	cost    pathCost
+}
+
+type analyzer struct {
+	program        []byte
+	version        uint64
+	entry          int
+	offsetToSource map[int]SourceLocation
+
+	instructions map[int]*instruction
+	order        []int // pcs of instructions, in program order
+	functions    map[int]*function
+	required     fieldSet
+
+	unbounded bool
+	findings  []Finding
+	reported  map[string]bool
+}
+
+func newAnalyzer(program []byte, offsetToSource map[int]SourceLocation) (*analyzer, error) {
+	version, vlen := binary.Uvarint(program)
+	if vlen <= 0 {
+		return nil, errors.New("invalid version")
+	}
+	if version > LogicVersion {
+		return nil, fmt.Errorf("unsupported version %d", version)
+	}
+	a := &analyzer{
+		program:        program,
+		version:        version,
+		entry:          vlen,
+		offsetToSource: offsetToSource,
+		instructions:   make(map[int]*instruction),
+		functions:      make(map[int]*function),
+		reported:       make(map[string]bool),
+	}
+
+	dis := disassembleState{program: program, numericTargets: true}
+	dis.pc = vlen
+	for dis.pc < len(program) {
+		spec := &opsByOpcode[version][program[dis.pc]]
+		if spec.Name == "" {
+			return nil, fmt.Errorf("invalid opcode %02x at pc=%d", program[dis.pc], dis.pc)
+		}
+		text, err := disassemble(&dis, spec)
+		if err != nil {
+			return nil, fmt.Errorf("pc=%d: %w", dis.pc, err)
+		}
+		insn := &instruction{
+			pc:         dis.pc,
+			next:       dis.nextpc,
+			spec:       spec,
+			immediates: tokensFromLine(text, 0)[1:],
+		}
+		for i, imm := range spec.Immediates {
+			switch {
+			case imm.kind == immLabel:
+				insn.targets = append(insn.targets, insn.pc+3+decodeBranchOffset(program, insn.pc+1))
+			case imm.kind == immLabels:
+				insn.targets, _, err = parseLabels(program, insn.pc+1)
+				if err != nil {
+					return nil, fmt.Errorf("pc=%d: %w", dis.pc, err)
+				}
+			case imm.Group == &TxnScalarFields:
+				field := TxnField(program[insn.pc+1+i])
+				for j, cf := range checkedFields {
+					if cf.field == field {
+						insn.reads |= 1 << j
+					}
+				}
+			}
+			if imm.kind == immByte && imm.Group != nil {
+				insn.returns = fieldReturns(spec, imm.Group, program[insn.pc+1+i])
+			}
+		}
+		switch spec.Name {
+		case "pushint":
+			value, _ := binary.Uvarint(program[insn.pc+1:])
+			insn.returns = StackTypes{NewStackType(avmUint64, static(value))}
+		case "pushbytes":
+			length, _ := binary.Uvarint(program[insn.pc+1:])
+			insn.returns = StackTypes{NewStackType(avmBytes, static(length))}
+		}
+		a.instructions[insn.pc] = insn
+		a.order = append(a.order, insn.pc)
+		dis.pc = dis.nextpc
+	}
+
+	for _, insn := range a.instructions {
+		for _, target := range insn.targets {
+			_, ok := a.instructions[target]
+			if !ok && (target != len(program) || insn.spec.Name == "callsub") {
+				return nil, fmt.Errorf("%s at pc=%d targets %d, which is not an instruction", insn.spec.Name, insn.pc, target)
+			}
+		}
+	}
+	return a, nil
+}
+
+// fieldReturns are the types returned by spec when it reads the given field of
+// group, or nil if the field says no more than the spec. It narrows the types
+// as the assembler does, with OpStream.returns.
+func fieldReturns(spec *OpSpec, group *FieldGroup, field byte) StackTypes {
+	if int(field) >= len(group.Names) {
+		return nil
+	}
+	fs, ok := group.SpecByName(group.Names[field])
+	if !ok || !fs.Type().Typed() {
+		return nil
+	}
+	for i, t := range spec.Return.Types {
+		if t.AVMType == avmAny {
+			returns := slices.Clone(spec.Return.Types)
+			returns[i] = fs.Type()
+			return returns
+		}
+	}
+	return nil
+}
+
+func (a *analyzer) report(kind FindingKind, pc int, format string, args ...interface{}) {
+	msg := fmt.Sprintf(format, args...)
+	key := fmt.Sprintf("%s %d %s", kind, pc, msg)
+	if a.reported[key] {
+		return
+	}
+	a.reported[key] = true
+	finding := Finding{Kind: kind, PC: pc, Message: msg}
+	if loc, ok := a.offsetToSource[pc]; ok {
+		finding.Location = &loc
+	}
+	a.findings = append(a.findings, finding)
+}
+
+// function returns the dataflow analysis of the function entered at entry,
+// performing it if needed.
+func (a *analyzer) function(entry int) *function {
+	if f, ok := a.functions[entry]; ok {
+		return f
+	}
+	f := &function{
+		entry:     entry,
+		states:    make(map[int]*flowState),
+		visits:    make(map[int]int),
+		analyzing: true,
+		exits:     make(map[int]fieldSet),
+	}
+	a.functions[entry] = f
+
+	var init flowState
+	if entry == a.entry {
+		init.known = ProgramKnowledge{bottom: StackNone, fp: -1}
+		for i := range init.known.scratchSpace {
+			init.known.scratchSpace[i] = StackZeroUint64
+		}
+	} else {
+		init.known.reset()
+	}
+	f.states[entry] = &init
+
+	work := []int{entry}
+	for len(work) > 0 {
+		pc := work[len(work)-1]
+		work = work[:len(work)-1]
+		state := f.states[pc].clone()
+		for _, next := range a.step(f, a.instructions[pc], &state) {
+			if a.merge(f, next, &state) {
+				work = append(work, next)
+			}
+		}
+	}
+	f.analyzing = false
+	a.summarize(f)
+	return f
+}
+
+// merge adds s to the states known at pc, reporting whether that changed them.
+func (a *analyzer) merge(f *function, pc int, s *flowState) bool {
+	old, ok := f.states[pc]
+	if !ok {
+		c := s.clone()
+		f.states[pc] = &c
+		return true
+	}
+	f.visits[pc]++
+	merged := mergeStates(old, s, f.visits[pc] > widenAfter)
+	if merged.equal(old) {
+		return false
+	}
+	*old = merged
+	return true
+}
+
+// successors are the pcs that control may go to from insn, within its
+// function, and not counting exits. callsub continues at the next instruction
+// if the subroutine returns.
+func (a *analyzer) successors(insn *instruction) []int {
+	var next []int
+	switch {
+	case insn.spec.Name == "retsub" || insn.spec.AlwaysExits():
+		return nil
+	case insn.spec.Name == "b":
+		next = insn.targets
+	case insn.spec.Name == "callsub":
+		next = []int{insn.next}
+	default:
+		next = append([]int{insn.next}, insn.targets...)
+	}
+	var inside []int
+	for _, pc := range next {
+		if pc != len(a.program) {
+			inside = append(inside, pc)
+		}
+	}
+	return inside
+}
+
+// exits reports whether control may fall off the end of the program after
+// insn, which approves like return.
+func (a *analyzer) exits(insn *instruction) bool {
+	switch {
+	case insn.spec.Name == "retsub" || insn.spec.Name == "callsub" || insn.spec.AlwaysExits():
+		return false
+	case insn.spec.Name != "b" && insn.next == len(a.program):
+		return true
+	}
+	for _, target := range insn.targets {
+		if target == len(a.program) {
+			return true
+		}
+	}
+	return false
+}
+
+// step applies insn to s, and returns the pcs that control goes to next.
+func (a *analyzer) step(f *function, insn *instruction, s *flowState) []int {
+	spec := insn.spec
+	s.checked |= insn.reads
+	switch spec.Name {
+	case "callsub":
+		return a.call(insn, s)
+	case "retsub":
+		return nil
+	}
+
+	args, returns := spec.Arg.Types, spec.Return.Types
+	if spec.Name == "match" {
+		args = anyTypes(len(insn.targets) + 1)
+	}
+	if spec.Name == "proto" {
+		proto := [2]int{int(a.program[insn.pc+1]), int(a.program[insn.pc+2])}
+		f.proto = &proto
+		f.need = max(f.need, proto[0])
+	}
+	if spec.refine != nil {
+		nargs, nreturns, err := spec.refine(&s.known, insn.immediates)
+		if err != nil {
+			a.report(FindingStackType, insn.pc, "%s: %v", spec.Name, err)
+		}
+		if nargs != nil {
+			args = nargs
+		}
+		if nreturns != nil {
+			returns = nreturns
+		}
+	}
+	if insn.returns != nil {
+		returns = insn.returns
+	}
+	a.pop(f, insn, s, args)
+	if spec.AlwaysExits() {
+		return nil
+	}
+	s.known.push(returns...)
+	s.height += len(returns)
+	return a.successors(insn)
+}
+
+// pop removes args from s, reporting a stack underflow or type mismatch.
+func (a *analyzer) pop(f *function, insn *instruction, s *flowState, args StackTypes) {
+	if len(args) > len(s.known.stack) && s.known.bottom == StackNone {
+		a.report(FindingStackUnderflow, insn.pc, "%s expects %d stack arguments but stack height is %d",
+			insn.spec.Name, len(args), len(s.known.stack))
+	}
+	if len(args) > s.height && f.entry != a.entry {
+		f.need = max(f.need, len(args)-s.height)
+	}
+	for i := len(args) - 1; i >= 0; i-- {
+		stype := s.known.pop()
+		if stype != StackNone && !stype.overlaps(args[i]) {
+			a.report(FindingStackType, insn.pc, "%s arg %d wanted type %s got %s",
+				insn.spec.Name, i, args[i], stype)
+		}
+	}
+	s.height -= len(args)
+	if f.entry == a.entry && s.height < 0 {
+		s.height = 0
+	}
+}
+
+// call applies the summary of the subroutine called by insn to s.
+func (a *analyzer) call(insn *instruction, s *flowState) []int {
+	sub := a.function(insn.targets[0])
+	if sub.analyzing || !sub.deltaKnown {
+		// Recursive, or inconsistent, so the stack after the call is unknown.
+		s.known.reset()
+		if sub.analyzing || sub.returns {
+			return []int{insn.next}
+		}
+		return nil
+	}
+
+	if sub.need > len(s.known.stack) && s.known.bottom == StackNone {
+		a.report(FindingStackUnderflow, insn.pc, "callsub expects %d stack arguments but stack height is %d",
+			sub.need, len(s.known.stack))
+	}
+	if sub.need > len(s.known.stack) {
+		// Not every path through the subroutine uses all it may need, so
+		// the height after it returns is only known to be at least delta
+		// more than the height before.
+		s.known.stack = nil
+		s.known.bottom = StackAny
+	} else {
+		s.known.stack = s.known.stack[:len(s.known.stack)-sub.need]
+		s.known.push(anyTypes(max(sub.need+sub.delta, 0))...)
+	}
+	s.height += sub.delta
+	for i := range s.known.scratchSpace {
+		s.known.scratchSpace[i] = StackAny
+	}
+	s.checked |= sub.retChecked
+	return []int{insn.next}
+}
+
+// summarize records how f returns and exits, once its states are complete.
+func (a *analyzer) summarize(f *function) {
+	addExit := func(pc int, checked fieldSet) {
+		if old, ok := f.exits[pc]; ok {
+			checked &= old
+		}
+		f.exits[pc] = checked
+	}
+	for pc, s := range f.states {
+		insn := a.instructions[pc]
+		checked := s.checked | insn.reads
+		switch insn.spec.Name {
+		case "return":
+			addExit(pc, checked)
+		case "retsub":
+			if f.entry == a.entry {
+				continue // fails, since there is no frame to return to
+			}
+			delta := s.height
+			if f.proto != nil {
+				delta = f.proto[1] - f.proto[0]
+			}
+			if !f.returns {
+				f.returns = true
+				f.deltaKnown = true
+				f.delta = delta
+				f.retChecked = checked
+			} else {
+				f.deltaKnown = f.deltaKnown && delta == f.delta
+				f.retChecked &= checked
+			}
+		case "callsub":
+			sub := a.functions[insn.targets[0]]
+			for exit, subChecked := range sub.exits {
+				addExit(exit, checked|subChecked)
+			}
+		}
+		if a.exits(insn) {
+			addExit(pc, checked)
+		}
+	}
+}
+
+// checkExits reports approving exits of main that some path reaches without
+// reading the required fields.
+func (a *analyzer) checkExits(main *function) {
+	for pc, checked := range main.exits {
+		for i, cf := range checkedFields {
+			if a.required&(1<<i) != 0 && checked&(1<<i) == 0 {
+				a.report(cf.kind, pc, "program may approve without checking txn %s", cf.field)
+			}
+		}
+	}
+}
+
+// checkReachability reports runs of instructions that no function reaches.
+func (a *analyzer) checkReachability() {
+	reached := func(pc int) bool {
+		for _, f := range a.functions {
+			if _, ok := f.states[pc]; ok {
+				return true
+			}
+		}
+		return false
+	}
+	start, count := -1, 0
+	flush := func() {
+		switch {
+		case count == 1:
+			a.report(FindingUnreachable, start, "1 unreachable instruction")
+		case count > 1:
+			a.report(FindingUnreachable, start, "%d unreachable instructions", count)
+		}
+		start, count = -1, 0
+	}
+	for _, pc := range a.order {
+		if reached(pc) {
+			flush()
+			continue
+		}
+		if count == 0 {
+			start = pc
+		}
+		count++
+	}
+	flush()
+}
+
+// pathCost is the cost of the most expensive path through a function that
+// ends in retsub, and that ends the program. They are -1 if there is no such
+// path.
+type pathCost struct {
+	ret  int
+	exit int
+}
+
+func addCost(a, b int) int {
+	if a < 0 || b < 0 {
+		return -1
+	}
+	return a + b
+}
+
+// cost computes the worst-case cost of f, counting loops and recursion once.
+func (a *analyzer) cost(f *function) pathCost {
+	if f.costed {
+		return f.cost
+	}
+	f.costing = true
+	memo := make(map[int]pathCost)
+	onPath := make(map[int]bool)
+	var visit func(pc int) pathCost
+	visit = func(pc int) pathCost {
+		if c, ok := memo[pc]; ok {
+			return c
+		}
+		if onPath[pc] {
+			a.unbounded = true
+			a.report(FindingUnboundedCost, pc, "loop makes the cost unbounded")
+			return pathCost{-1, -1}
+		}
+		onPath[pc] = true
+		defer delete(onPath, pc)
+
+		insn := a.instructions[pc]
+		res := pathCost{-1, -1}
+		switch {
+		case insn.spec.Name == "retsub":
+			res.ret = 0
+		case insn.spec.AlwaysExits():
+			res.exit = 0
+		case insn.spec.Name == "callsub":
+			sub := a.functions[insn.targets[0]]
+			sc := pathCost{0, 0}
+			if sub.costing {
+				a.unbounded = true
+				a.report(FindingUnboundedCost, pc, "recursive callsub makes the cost unbounded")
+			} else {
+				sc = a.cost(sub)
+			}
+			res.exit = sc.exit
+			if _, ok := f.states[insn.next]; ok && sc.ret >= 0 {
+				cont := visit(insn.next)
+				res.exit = max(res.exit, addCost(sc.ret, cont.exit))
+				res.ret = addCost(sc.ret, cont.ret)
+			}
+		default:
+			if a.exits(insn) {
+				res.exit = 0
+			}
+			for _, next := range a.successors(insn) {
+				if _, ok := f.states[next]; !ok {
+					continue
+				}
+				c := visit(next)
+				res.ret = max(res.ret, c.ret)
+				res.exit = max(res.exit, c.exit)
+			}
+		}
+		c := a.opCost(insn, f.states[pc])
+		res = pathCost{addCost(res.ret, c), addCost(res.exit, c)}
+		memo[pc] = res
+		return res
+	}
+	f.cost = visit(f.entry)
+	f.costing = false
+	f.costed = true
+	return f.cost
+}
+
+// worstCaseBytes backs the stack values used to compute opcode costs.
+var worstCaseBytes = make([]byte, maxStringSize)
+
+// opCost is the cost of insn when its arguments are as long as s allows.
+func (a *analyzer) opCost(insn *instruction, s *flowState) int {
+	stack := make([]stackValue, len(blankStack))
+	for i := range stack {
+		depth := len(stack) - 1 - i
+		st := StackAny
+		if depth < len(s.known.stack) {
+			st = s.known.stack[len(s.known.stack)-1-depth]
+		}
+		switch st.AVMType {
+		case avmUint64:
+		case avmBytes:
+			stack[i].Bytes = worstCaseBytes[:min(st.Bound[1], maxStringSize)]
+		default:
+			stack[i].Bytes = worstCaseBytes
+		}
+	}
+	return insn.spec.OpDetails.Cost(a.program, insn.pc, stack)
+}
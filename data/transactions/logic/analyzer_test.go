@@ -0,0 +1,320 @@
+// Copyright (C) 2019-2024 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package logic
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Quarkonium-chain/go-quarkonium/test/partitiontest"
+)
+
+// analyze assembles source without type tracking, so that the analyzer sees
+// the errors the assembler would have caught.
+func analyze(t *testing.T, source string, params AnalysisParams) (*OpStream, *Analysis) {
+	t.Helper()
+	ops := testProg(t, notrack(source), 10)
+	analysis, err := ops.Analyze(params)
+	require.NoError(t, err)
+	return ops, analysis
+}
+
+func findingKinds(analysis *Analysis) []FindingKind {
+	var kinds []FindingKind
+	for _, f := range analysis.Findings {
+		kinds = append(kinds, f.Kind)
+	}
+	return kinds
+}
+
+// pcOfLine returns the pc of the first instruction on line of source, which
+// is 0-based and counts the line added by notrack.
+func pcOfLine(t *testing.T, ops *OpStream, line int) int {
+	t.Helper()
+	pc := -1
+	for offset, loc := range ops.OffsetToSource {
+		if loc.Line == line && (pc == -1 || offset < pc) {
+			pc = offset
+		}
+	}
+	require.NotEqual(t, -1, pc, "no instruction on line %d", line)
+	return pc
+}
+
+func TestAnalyzeClean(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	_, analysis := analyze(t, `
+txn OnCompletion
+int NoOp
+==
+assert
+pushbytes "counter"
+app_global_get
+`, AnalysisParams{Budget: 700})
+	require.Equal(t, ModeApp, analysis.Mode)
+	require.EqualValues(t, 10, analysis.Version)
+	require.Empty(t, analysis.Findings)
+	require.Equal(t, 6, analysis.MaxCost)
+	require.False(t, analysis.Unbounded)
+}
+
+func TestAnalyzeUncheckedFields(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	// a logicsig is expected to check RekeyTo and CloseRemainderTo
+	_, analysis := analyze(t, "pushint 1", AnalysisParams{})
+	require.Equal(t, ModeSig, analysis.Mode)
+	require.Equal(t, []FindingKind{FindingUncheckedRekeyTo, FindingUncheckedCloseRemainderTo}, findingKinds(analysis))
+
+	// an application is expected to check OnCompletion
+	_, analysis = analyze(t, "pushint 1", AnalysisParams{Mode: ModeApp})
+	require.Equal(t, []FindingKind{FindingUncheckedOnCompletion}, findingKinds(analysis))
+
+	// RekeyTo is only checked on one of the paths that approve
+	ops, analysis := analyze(t, `
+txn Fee
+bz skip
+txn RekeyTo
+global ZeroAddress
+==
+assert
+skip:
+txn CloseRemainderTo
+global ZeroAddress
+==
+`, AnalysisParams{})
+	require.Equal(t, []FindingKind{FindingUncheckedRekeyTo}, findingKinds(analysis))
+	require.Equal(t, pcOfLine(t, ops, 11), analysis.Findings[0].PC)
+	require.Equal(t, 11, analysis.Findings[0].Location.Line)
+
+	// checks in a subroutine count for the exits after it returns, but not
+	// for an exit before the check
+	ops, analysis = analyze(t, `
+callsub check
+txn Fee
+bz done
+pushint 1
+return
+done:
+pushint 1
+return
+check:
+txn Fee
+bnz ok
+pushint 1
+return
+ok:
+txn OnCompletion
+!
+assert
+retsub
+`, AnalysisParams{Mode: ModeApp})
+	require.Equal(t, []FindingKind{FindingUncheckedOnCompletion}, findingKinds(analysis))
+	require.Equal(t, pcOfLine(t, ops, 14), analysis.Findings[0].PC)
+}
+
+func TestAnalyzeUnreachable(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	ops, analysis := analyze(t, `
+txn OnCompletion
+return
+pushint 2
+pop
+unused:
+pushint 1
+retsub
+`, AnalysisParams{Mode: ModeApp})
+	require.Equal(t, []FindingKind{FindingUnreachable}, findingKinds(analysis))
+	require.Equal(t, pcOfLine(t, ops, 4), analysis.Findings[0].PC)
+	require.Equal(t, "4 unreachable instructions", analysis.Findings[0].Message)
+}
+
+func TestAnalyzeStackUnderflow(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	// only the path that skips `pushint 1` underflows
+	ops, analysis := analyze(t, `
+txn OnCompletion
+bz skip
+pushint 1
+skip:
+pushint 2
++
+`, AnalysisParams{Mode: ModeApp})
+	require.Equal(t, []FindingKind{FindingStackUnderflow}, findingKinds(analysis))
+	require.Equal(t, pcOfLine(t, ops, 7), analysis.Findings[0].PC)
+	require.Equal(t, "+ expects 2 stack arguments but stack height is 1", analysis.Findings[0].Message)
+
+	// subroutines consume the stack below their entry
+	for _, sub := range []string{"add:\n+\nretsub", "add:\nproto 2 1\nframe_dig -2\nframe_dig -1\n+\nretsub"} {
+		source := "txn OnCompletion\npop\npushint 1\ncallsub add\nreturn\n" + sub
+		ops, analysis = analyze(t, source, AnalysisParams{Mode: ModeApp})
+		require.Equal(t, []FindingKind{FindingStackUnderflow}, findingKinds(analysis), sub)
+		require.Equal(t, pcOfLine(t, ops, 4), analysis.Findings[0].PC)
+		require.Equal(t, "callsub expects 2 stack arguments but stack height is 1", analysis.Findings[0].Message)
+
+		source = "txn OnCompletion\npushint 1\npushint 2\ncallsub add\n+\nreturn\n" + sub
+		_, analysis = analyze(t, source, AnalysisParams{Mode: ModeApp})
+		require.Empty(t, analysis.Findings, sub)
+	}
+}
+
+func TestAnalyzeStackType(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	// The assembler forgets the stack at a label after a `b`, but the
+	// analyzer follows the branch.
+	ops, analysis := analyze(t, `
+txn OnCompletion
+pushbytes "x"
+b join
+join:
+pushint 1
++
+`, AnalysisParams{Mode: ModeApp})
+	require.Equal(t, []FindingKind{FindingStackType}, findingKinds(analysis))
+	require.Equal(t, pcOfLine(t, ops, 7), analysis.Findings[0].PC)
+	require.Equal(t, "+ arg 0 wanted type uint64 got [1]byte", analysis.Findings[0].Message)
+
+	// scratch slots are followed through branches too
+	_, analysis = analyze(t, `
+txn OnCompletion
+pushbytes "x"
+store 3
+b join
+join:
+load 3
++
+`, AnalysisParams{Mode: ModeApp})
+	require.Equal(t, []FindingKind{FindingStackType}, findingKinds(analysis))
+}
+
+func TestAnalyzeCost(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	// the costlier branch determines the cost, and a subroutine costs as
+	// much as it does at each callsub
+	source := `
+txn OnCompletion
+bz cheap
+callsub hash
+callsub hash
+pushint 1
+return
+cheap:
+pushint 1
+return
+hash:
+pushbytes "x"
+sha256
+pop
+retsub
+`
+	_, analysis := analyze(t, source, AnalysisParams{Mode: ModeApp})
+	require.Empty(t, analysis.Findings)
+	require.Equal(t, 2+2*(1+1+35+1+1)+2, analysis.MaxCost)
+
+	_, analysis = analyze(t, source, AnalysisParams{Mode: ModeApp, Budget: 50})
+	require.Equal(t, []FindingKind{FindingBudgetExceeded}, findingKinds(analysis))
+	require.Equal(t, "worst-case cost 82 exceeds the budget of 50", analysis.Findings[0].Message)
+
+	// length dependent costs use the longest value the stack may hold
+	_, analysis = analyze(t, `
+txn OnCompletion
+pop
+pushbytes "0123456789abcdef0123456789abcdef"
+base64_decode StdEncoding
+len
+`, AnalysisParams{Mode: ModeApp})
+	require.Equal(t, 1+1+1+(1+2)+1, analysis.MaxCost)
+	_, analysis = analyze(t, `
+txn OnCompletion
+pop
+txna ApplicationArgs 0
+base64_decode StdEncoding
+len
+`, AnalysisParams{Mode: ModeApp})
+	require.Equal(t, 1+1+1+(1+maxStringSize/16)+1, analysis.MaxCost)
+}
+
+func TestAnalyzeUnbounded(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	ops, analysis := analyze(t, `
+txn OnCompletion
+pop
+pushint 10
+loop:
+pushint 1
+-
+dup
+bnz loop
+`, AnalysisParams{Mode: ModeApp})
+	require.True(t, analysis.Unbounded)
+	require.Equal(t, []FindingKind{FindingUnboundedCost}, findingKinds(analysis))
+	require.Equal(t, pcOfLine(t, ops, 6), analysis.Findings[0].PC)
+	require.Equal(t, 7, analysis.MaxCost)
+
+	ops, analysis = analyze(t, `
+txn OnCompletion
+callsub rec
+return
+rec:
+proto 1 1
+frame_dig -1
+bz done
+frame_dig -1
+pushint 1
+-
+callsub rec
+frame_bury -1
+done:
+retsub
+`, AnalysisParams{Mode: ModeApp})
+	require.True(t, analysis.Unbounded)
+	require.Equal(t, []FindingKind{FindingUnboundedCost}, findingKinds(analysis))
+	require.Equal(t, pcOfLine(t, ops, 12), analysis.Findings[0].PC)
+}
+
+func TestAnalyzeErrors(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	_, err := (&OpStream{}).Analyze(AnalysisParams{})
+	require.ErrorContains(t, err, "not been assembled")
+
+	_, err = AnalyzeProgram([]byte{0x0a, 0xff}, nil, AnalysisParams{})
+	require.ErrorContains(t, err, "invalid opcode ff")
+
+	// a branch into the middle of pushint
+	_, err = AnalyzeProgram([]byte{0x0a, 0x42, 0x00, 0x01, 0x81, 0x01}, nil, AnalysisParams{})
+	require.ErrorContains(t, err, "not an instruction")
+
+	analysis, err := AnalyzeProgram([]byte{0x0a}, nil, AnalysisParams{})
+	require.NoError(t, err)
+	require.Empty(t, analysis.Findings)
+}
@@ -54,6 +54,9 @@ type labelReference struct {
 	// token holding the label name (and line, column)
 	label token
 
+	// label name, qualified by the namespace of the file holding the reference
+	name string
+
 	// ending position of the opcode containing the label reference.
 	offsetPosition int
 }
@@ -220,6 +223,9 @@ type SourceLocation struct {
 	Line int
 	// Column is the column number, starting at 0.
 	Column int
+	// File is the index of the source file, as returned by
+	// OpStream.SourceNames. 0 is the program being assembled.
+	File int
 }
 
 // OpStream accumulates state, including the final program, during assembly.
@@ -248,9 +254,27 @@ type OpStream struct {
 	known        ProgramKnowledge
 	typeTracking bool
 
+	// optimization level set by #pragma optimize, or by AssembleOptions
+	optimizeLevel int
+
 	// current sourceLine during assembly
 	sourceLine int
 
+	// file being assembled, and the stack of files including it
+	file     sourceFile
+	includes []sourceFile
+
+	// loader reads included files, nil if #include is not allowed
+	loader FileLoader
+	// paths of included files, indexed by SourceLocation.File-1
+	files []string
+	// label prefix of the namespace every file was first included into
+	imported map[string]string
+	// maps the label prefix of re-imported files to their original prefix
+	aliases map[string]string
+	// label prefixes of all namespaces, including aliases
+	namespaces map[string]bool
+
 	// map label string to position within pending buffer
 	labels map[string]int
 
@@ -273,6 +297,9 @@ type OpStream struct {
 func newOpStream(version uint64) OpStream {
 	o := OpStream{
 		labels:         make(map[string]int),
+		imported:       make(map[string]string),
+		aliases:        make(map[string]string),
+		namespaces:     make(map[string]bool),
 		OffsetToSource: make(map[int]SourceLocation),
 		typeTracking:   true,
 		Version:        version,
@@ -366,21 +393,27 @@ func (pgm *ProgramKnowledge) reset() {
 // error for a duplicate.
 func (ops *OpStream) createLabel(withColon token) {
 	label := strings.TrimSuffix(withColon.str, ":")
-	if _, ok := ops.labels[label]; ok {
+	if strings.Contains(label, namespaceSeparator) {
+		ops.record(withColon.errorf("label %#v cannot contain %s", label, namespaceSeparator))
+		return
+	}
+	name := ops.file.labelPrefix + label
+	if _, ok := ops.labels[name]; ok {
 		ops.record(withColon.errorf("duplicate label %#v", label))
 	}
-	ops.labels[label] = ops.pending.Len()
+	ops.labels[name] = ops.pending.Len()
 	ops.known.label()
 }
 
 // recordSourceLocation adds an entry to pc to source location mapping
 func (ops *OpStream) recordSourceLocation(line, column int) {
-	ops.OffsetToSource[ops.pending.Len()] = SourceLocation{line - 1, column}
+	ops.OffsetToSource[ops.pending.Len()] = SourceLocation{line - 1, column, ops.file.index}
 }
 
 // referToLabel records an opcode label reference to resolve later
 func (ops *OpStream) referToLabel(pc int, label token, offsetPosition int) {
-	ops.labelReferences = append(ops.labelReferences, labelReference{pc, label, offsetPosition})
+	ref := labelReference{pc, label, ops.file.labelPrefix + label.str, offsetPosition}
+	ops.labelReferences = append(ops.labelReferences, ref)
 }
 
 type refineFunc func(pgm *ProgramKnowledge, immediates []token) (StackTypes, StackTypes, error)
@@ -1106,9 +1139,9 @@ func (ops *OpStream) checkArgCount(name string, mnemonic token, args []token, ex
 			col = all[expected+1].col // start of first extra arg
 		}
 		if expected == 1 {
-			return &sourceError{line, col, fmt.Errorf("%s expects 1 immediate argument", name)}
+			return &sourceError{line, col, fmt.Errorf("%s expects 1 immediate argument", name), mnemonic.file}
 		}
-		return &sourceError{line, col, fmt.Errorf("%s expects %d immediate arguments", name, expected)}
+		return &sourceError{line, col, fmt.Errorf("%s expects %d immediate arguments", name, expected), mnemonic.file}
 	}
 	return nil
 }
@@ -1822,13 +1855,20 @@ type sourceError struct {
 	Line   int
 	Column int
 	Err    error
+	// File is the path of the included file holding the error, empty for
+	// the program being assembled.
+	File string
 }
 
 func (se sourceError) Error() string {
+	prefix := ""
+	if se.File != "" {
+		prefix = se.File + ":"
+	}
 	if se.Column != 0 {
-		return fmt.Sprintf("%d:%d: %s", se.Line, se.Column, se.Err.Error())
+		return fmt.Sprintf("%s%d:%d: %s", prefix, se.Line, se.Column, se.Err.Error())
 	}
-	return fmt.Sprintf("%d: %s", se.Line, se.Err.Error())
+	return fmt.Sprintf("%s%d: %s", prefix, se.Line, se.Err.Error())
 }
 
 func (se sourceError) Unwrap() error {
@@ -1836,17 +1876,18 @@ func (se sourceError) Unwrap() error {
 }
 
 func sourceErrorf(location SourceLocation, format string, a ...interface{}) *sourceError {
-	return &sourceError{location.Line, location.Column, fmt.Errorf(format, a...)}
+	return &sourceError{location.Line, location.Column, fmt.Errorf(format, a...), ""}
 }
 
 type token struct {
 	str  string
 	col  int
 	line int
+	file string // path of the included file, empty for the program itself
 }
 
 func (t token) error(err error) *sourceError {
-	return &sourceError{t.line, t.col, err}
+	return &sourceError{t.line, t.col, err, t.file}
 }
 
 func (t token) errorf(format string, args ...interface{}) *sourceError {
@@ -1854,7 +1895,7 @@ func (t token) errorf(format string, args ...interface{}) *sourceError {
 }
 
 func (t token) errorAfterf(format string, args ...interface{}) *sourceError {
-	return &sourceError{t.line, t.col + len(t.str), fmt.Errorf(format, args...)}
+	return &sourceError{t.line, t.col + len(t.str), fmt.Errorf(format, args...), t.file}
 }
 
 // newline not included since handled in scanner
@@ -1868,7 +1909,7 @@ func tokensFromLine(sourceLine string, lineno int) []token {
 	i := 0
 	for i < len(sourceLine) && tokenSeparators[sourceLine[i]] {
 		if sourceLine[i] == ';' {
-			tokens = append(tokens, token{";", i, lineno})
+			tokens = append(tokens, token{str: ";", col: i, line: lineno})
 		}
 		i++
 	}
@@ -1892,7 +1933,7 @@ func tokensFromLine(sourceLine string, lineno int) []token {
 			case '/': // is a comment?
 				if i < len(sourceLine)-1 && sourceLine[i+1] == '/' && !inBase64 && !inString {
 					if start != i { // if a comment without whitespace
-						tokens = append(tokens, token{sourceLine[start:i], start, lineno})
+						tokens = append(tokens, token{str: sourceLine[start:i], col: start, line: lineno})
 					}
 					return tokens
 				}
@@ -1915,9 +1956,9 @@ func tokensFromLine(sourceLine string, lineno int) []token {
 
 		if !inString {
 			s := sourceLine[start:i]
-			tokens = append(tokens, token{s, start, lineno})
+			tokens = append(tokens, token{str: s, col: start, line: lineno})
 			if sourceLine[i] == ';' {
-				tokens = append(tokens, token{";", i, lineno})
+				tokens = append(tokens, token{str: ";", col: i, line: lineno})
 			}
 			if inBase64 {
 				inBase64 = false
@@ -1931,7 +1972,7 @@ func tokensFromLine(sourceLine string, lineno int) []token {
 		if !inString {
 			for i < len(sourceLine) && tokenSeparators[sourceLine[i]] {
 				if sourceLine[i] == ';' {
-					tokens = append(tokens, token{";", i, lineno})
+					tokens = append(tokens, token{str: ";", col: i, line: lineno})
 				}
 				i++
 			}
@@ -1941,7 +1982,7 @@ func tokensFromLine(sourceLine string, lineno int) []token {
 
 	// add rest of the string if any
 	if start < len(sourceLine) {
-		tokens = append(tokens, token{sourceLine[start:i], start, lineno})
+		tokens = append(tokens, token{str: sourceLine[start:i], col: start, line: lineno})
 	}
 
 	return tokens
@@ -2035,24 +2076,60 @@ type directiveFunc func(*OpStream, []token) *sourceError
 
 var directives = map[string]directiveFunc{"pragma": pragma, "define": define}
 
+func init() {
+	// include assembles the included file, which would be an initialization
+	// cycle if it appeared in the literal above.
+	directives["include"] = include
+	directives["import"] = include
+}
+
 // assemble reads text from an input and accumulates the program
 func (ops *OpStream) assemble(text string) error {
 	if ops.Version > LogicVersion && ops.Version != assemblerNoVersion {
 		err := fmt.Errorf("Can not assemble version %d", ops.Version)
-		ops.record(&sourceError{0, 0, err})
+		ops.record(&sourceError{0, 0, err, ""})
 		return err
 	}
 	if strings.TrimSpace(text) == "" {
 		err := errors.New("Cannot assemble empty program text")
-		ops.record(&sourceError{0, 0, err})
+		ops.record(&sourceError{0, 0, err, ""})
 		return err
 	}
+	ops.assembleSource(text)
+
+	if ops.Version >= optimizeConstantsEnabledVersion {
+		ops.optimizeIntcBlock()
+		ops.optimizeBytecBlock()
+	}
+
+	ops.resolveLabels()
+	program := ops.prependCBlocks()
+	if ops.Errors != nil {
+		l := len(ops.Errors)
+		if l == 1 {
+			return fmt.Errorf("1 error: %w", ops.Errors[0])
+		}
+		return fmt.Errorf("%d errors", l)
+	}
+	ops.Program = program
+	if ops.optimizeLevel > 0 {
+		ops.optimize()
+	}
+	return nil
+}
+
+// assembleSource assembles the lines of text, which is either the program
+// itself or a file included by it.
+func (ops *OpStream) assembleSource(text string) {
 	fin := strings.NewReader(text)
 	scanner := bufio.NewScanner(fin)
 	for scanner.Scan() {
 		ops.sourceLine++
 		line := scanner.Text()
 		tokens := tokensFromLine(line, ops.sourceLine)
+		for i := range tokens {
+			tokens[i].file = ops.file.name
+		}
 		if len(tokens) > 0 {
 			if first := tokens[0]; first.str[0] == '#' {
 				directive := first.str[1:]
@@ -2136,25 +2213,8 @@ func (ops *OpStream) assemble(text string) error {
 		if errors.Is(err, bufio.ErrTooLong) {
 			err = errors.New("line too long")
 		}
-		ops.record(&sourceError{ops.sourceLine, 0, err})
-	}
-
-	if ops.Version >= optimizeConstantsEnabledVersion {
-		ops.optimizeIntcBlock()
-		ops.optimizeBytecBlock()
-	}
-
-	ops.resolveLabels()
-	program := ops.prependCBlocks()
-	if ops.Errors != nil {
-		l := len(ops.Errors)
-		if l == 1 {
-			return fmt.Errorf("1 error: %w", ops.Errors[0])
-		}
-		return fmt.Errorf("%d errors", l)
+		ops.record(&sourceError{ops.sourceLine, 0, err, ops.file.name})
 	}
-	ops.Program = program
-	return nil
 }
 
 // cycle return a slice of strings that constitute a cycle, if one is
@@ -2291,11 +2351,16 @@ func pragma(ops *OpStream, tokens []token) *sourceError {
 			return tokens[3].errorf("unexpected extra tokens:%s", reJoin("", tokens[3:]))
 		}
 		var ver uint64
+		value := tokens[2].str
+		ver, err := strconv.ParseUint(value, 0, 64)
 		if ops.pending.Len() > 0 {
+			// An included file may state the version it was written for,
+			// as long as that is the version being assembled.
+			if ops.file.name != "" && err == nil && ver == ops.Version {
+				return nil
+			}
 			return tokens[0].errorf("#pragma version is only allowed before instructions")
 		}
-		value := tokens[2].str
-		ver, err := strconv.ParseUint(value, 0, 64)
 		if err != nil {
 			return tokens[2].errorf("bad #pragma version: %#v", value)
 		}
@@ -2336,6 +2401,23 @@ func pragma(ops *OpStream, tokens []token) *sourceError {
 		}
 		ops.typeTracking = on
 
+		return nil
+	case "optimize":
+		if len(tokens) < 3 {
+			return tokens[1].errorf("no optimize value")
+		}
+		if len(tokens) > 3 {
+			return tokens[3].errorf("unexpected extra tokens:%s", reJoin("", tokens[3:]))
+		}
+		value := tokens[2].str
+		level, err := strconv.ParseUint(value, 0, 64)
+		if err != nil {
+			return tokens[2].errorf("bad #pragma optimize: %#v", value)
+		}
+		if level > maxOptimizeLevel {
+			return tokens[2].errorf("unsupported optimize level: %d", level)
+		}
+		ops.optimizeLevel = int(level)
 		return nil
 	default:
 		return tokens[0].errorf("unsupported pragma directive: %#v", key)
@@ -2346,12 +2428,12 @@ func (ops *OpStream) resolveLabels() {
 	raw := ops.pending.Bytes()
 	reported := make(map[string]bool)
 	for _, lr := range ops.labelReferences {
-		dest, ok := ops.labels[lr.label.str]
+		dest, ok := ops.labels[ops.unalias(lr.name)]
 		if !ok {
-			if !reported[lr.label.str] {
+			if !reported[lr.name] {
 				ops.record(lr.label.errorf("reference to undefined label %#v", lr.label.str))
 			}
-			reported[lr.label.str] = true
+			reported[lr.name] = true
 			continue
 		}
 
@@ -2533,7 +2615,7 @@ func (ops *OpStream) optimizeConstants(refs []constReference, constBlock []inter
 
 	for _, f := range freqs {
 		if f.freq == 0 {
-			err = sourceErrorf(SourceLocation{ops.sourceLine, 0}, "member of constant block is not used: %v", f.value)
+			err = sourceErrorf(SourceLocation{Line: ops.sourceLine}, "member of constant block is not used: %v", f.value)
 			return
 		}
 	}
@@ -2666,12 +2748,12 @@ func (ops *OpStream) prependCBlocks() []byte {
 	out := make([]byte, pbl+outl)
 	pl, err := prebytes.Read(out)
 	if pl != pbl || err != nil {
-		ops.record(&sourceError{ops.sourceLine, 0, fmt.Errorf("%d prebytes, %d to buffer? %w", pbl, pl, err)})
+		ops.record(&sourceError{ops.sourceLine, 0, fmt.Errorf("%d prebytes, %d to buffer? %w", pbl, pl, err), ""})
 		return nil
 	}
 	ol, err := ops.pending.Read(out[pl:])
 	if ol != outl || err != nil {
-		ops.record(&sourceError{ops.sourceLine, 0, fmt.Errorf("%d program bytes but %d to buffer. %w", outl, ol, err)})
+		ops.record(&sourceError{ops.sourceLine, 0, fmt.Errorf("%d program bytes but %d to buffer. %w", outl, ol, err), ""})
 		return nil
 	}
 
@@ -2694,7 +2776,7 @@ func (ops *OpStream) record(se *sourceError) {
 }
 
 func (ops *OpStream) warn(t token, format string, a ...interface{}) {
-	warning := sourceError{t.line, t.col, fmt.Errorf(format, a...)}
+	warning := sourceError{t.line, t.col, fmt.Errorf(format, a...), t.file}
 	ops.Warnings = append(ops.Warnings, warning)
 }
 
@@ -2715,7 +2797,8 @@ func (ops *OpStream) ReportMultipleErrors(fname string, writer io.Writer) {
 		if i > 9 {
 			break
 		}
-		if fname == "" {
+		// errors in included files name their own file
+		if fname == "" || e.File != "" {
 			fmt.Fprintf(writer, "%s\n", e)
 		} else {
 			fmt.Fprintf(writer, "%s: %s\n", fname, e)
@@ -2725,7 +2808,7 @@ func (ops *OpStream) ReportMultipleErrors(fname string, writer io.Writer) {
 		if i > 9 {
 			break
 		}
-		if fname == "" {
+		if fname == "" || w.File != "" {
 			fmt.Fprintf(writer, "%s\n", w)
 		} else {
 			fmt.Fprintf(writer, "%s: %s\n", fname, w)
@@ -2751,6 +2834,31 @@ func AssembleStringWithVersion(text string, version uint64) (*OpStream, error) {
 	return &ops, err
 }
 
+// AssembleStringWithLoader is AssembleString for programs that #include or
+// #import other files, which are read through loader.
+func AssembleStringWithLoader(text string, loader FileLoader) (*OpStream, error) {
+	return AssembleStringWithOptions(text, AssembleOptions{Loader: loader})
+}
+
+// AssembleOptions control how AssembleStringWithOptions assembles a program.
+type AssembleOptions struct {
+	// Loader reads files that are #include'd or #import'ed. If nil, the
+	// program may not include other files.
+	Loader FileLoader
+	// Optimize is the optimization level, as if the program started with
+	// #pragma optimize. The pragma takes precedence.
+	Optimize int
+}
+
+// AssembleStringWithOptions is AssembleString, with the assembly controlled by opts.
+func AssembleStringWithOptions(text string, opts AssembleOptions) (*OpStream, error) {
+	ops := newOpStream(assemblerNoVersion)
+	ops.loader = opts.Loader
+	ops.optimizeLevel = opts.Optimize
+	err := ops.assemble(text)
+	return &ops, err
+}
+
 type disassembleState struct {
 	program []byte
 	pc      int
@@ -3149,6 +3257,13 @@ func Disassemble(program []byte) (text string, err error) {
 	return
 }
 
+// DisassembleWithPCOffset is Disassemble, also returning where the text of
+// each opcode starts, for DebugState.PCToLine.
+func DisassembleWithPCOffset(program []byte) (text string, offsets []PCOffset, err error) {
+	text, ds, err := disassembleInstrumented(program, nil)
+	return text, ds.pcOffset, err
+}
+
 // HasStatefulOps checks if the program has stateful opcodes
 func HasStatefulOps(program []byte) (bool, error) {
 	_, ds, err := disassembleInstrumented(program, nil)
@@ -3709,7 +3709,7 @@ test.txt: 8:1: warning 4
 	expectedStrs := []string{}
 	for i := 1; i <= 11; i++ {
 		errS := fmt.Errorf("error %d", i)
-		les = append(les, sourceError{i, 5, errS})
+		les = append(les, sourceError{i, 5, errS, ""})
 		if i <= 10 {
 			expectedStrs = append(expectedStrs, fmt.Sprintf("%s: %d:5: %s", file, i, errS))
 		}
@@ -3721,14 +3721,14 @@ test.txt: 8:1: warning 4
 	require.Equal(t, expected, b.String())
 
 	// exactly 1 error + filename
-	ops = &OpStream{Errors: []sourceError{{42, 0, errors.New("super annoying error")}}}
+	ops = &OpStream{Errors: []sourceError{{42, 0, errors.New("super annoying error"), ""}}}
 	b.Reset()
 	ops.ReportMultipleErrors("galaxy.py", &b)
 	expected = "galaxy.py: 1 error: 42: super annoying error\n"
 	require.Equal(t, expected, b.String())
 
 	// exactly 1 error w/o filename
-	ops = &OpStream{Errors: []sourceError{{42, 0, errors.New("super annoying error")}}}
+	ops = &OpStream{Errors: []sourceError{{42, 0, errors.New("super annoying error"), ""}}}
 	b.Reset()
 	ops.ReportMultipleErrors("", &b)
 	expected = "1 error: 42: super annoying error\n"
@@ -24,6 +24,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"sort"
 	"strings"
 
 	"github.com/Quarkonium-chain/go-quarkonium/config"
@@ -51,9 +52,14 @@ type Debugger interface {
 type debuggerEvalTracerAdaptor struct {
 	NullEvalTracer
 
-	debugger   Debugger
-	txnDepth   int
-	debugState *DebugState
+	debugger Debugger
+	txnDepth int
+	// inners is set if the programs of inner transactions are reported
+	inners bool
+	// innerCount is the number of inner programs reported so far
+	innerCount int
+	// debugStates of the programs being run, the innermost last
+	debugStates []*DebugState
 }
 
 // MakeEvalTracerDebuggerAdaptor creates an adaptor that externally adheres to the EvalTracer
@@ -68,6 +74,14 @@ func MakeEvalTracerDebuggerAdaptor(debugger Debugger) EvalTracer {
 	return &debuggerEvalTracerAdaptor{debugger: debugger}
 }
 
+// MakeEvalTracerDebuggerAdaptorWithInners is MakeEvalTracerDebuggerAdaptor,
+// except that the programs of inner transactions are reported too. Each is
+// registered with the debugger as its own execution, while the execution of
+// the program that submitted it waits in the middle of its opcode.
+func MakeEvalTracerDebuggerAdaptorWithInners(debugger Debugger) EvalTracer {
+	return &debuggerEvalTracerAdaptor{debugger: debugger, inners: true}
+}
+
 // BeforeTxnGroup updates inner txn depth
 func (a *debuggerEvalTracerAdaptor) BeforeTxnGroup(ep *EvalParams) {
 	a.txnDepth++
@@ -78,32 +92,55 @@ func (a *debuggerEvalTracerAdaptor) AfterTxnGroup(ep *EvalParams, deltas *ledger
 	a.txnDepth--
 }
 
+// reported returns whether the program being run is reported to the debugger.
+func (a *debuggerEvalTracerAdaptor) reported() bool {
+	// only report top-level transactions by default, for backwards compatibility
+	return a.txnDepth == 0 || a.inners
+}
+
 // BeforeProgram invokes the debugger's Register hook
 func (a *debuggerEvalTracerAdaptor) BeforeProgram(cx *EvalContext) {
-	if a.txnDepth > 0 {
-		// only report updates for top-level transactions, for backwards compatibility
+	if !a.reported() {
 		return
 	}
-	a.debugState = makeDebugState(cx)
+	ds := makeDebugState(cx)
+	if a.txnDepth > 0 {
+		a.innerCount++
+		ds.ExecID = fmt.Sprintf("%s/%d", ds.ExecID, a.innerCount)
+	}
+	a.debugStates = append(a.debugStates, ds)
 	a.debugger.Register(a.refreshDebugState(cx, nil))
 }
 
 // BeforeOpcode invokes the debugger's Update hook
 func (a *debuggerEvalTracerAdaptor) BeforeOpcode(cx *EvalContext) {
-	if a.txnDepth > 0 {
-		// only report updates for top-level transactions, for backwards compatibility
+	if !a.reported() {
 		return
 	}
-	a.debugger.Update(a.refreshDebugState(cx, nil))
+	ds := a.refreshDebugState(cx, nil)
+	a.debugger.Update(ds)
+	if cx.runMode == ModeApp && changesBoxes(cx.GetOpSpec().Name) {
+		ds.boxesStale = true
+	}
+}
+
+// changesBoxes returns whether the opcode may change the contents of boxes,
+// including through the programs of inner transactions.
+func changesBoxes(opcode string) bool {
+	switch opcode {
+	case "box_create", "box_del", "box_put", "box_replace", "box_splice", "box_resize", "itxn_submit":
+		return true
+	}
+	return false
 }
 
 // AfterProgram invokes the debugger's Complete hook
 func (a *debuggerEvalTracerAdaptor) AfterProgram(cx *EvalContext, pass bool, evalError error) {
-	if a.txnDepth > 0 {
-		// only report updates for top-level transactions, for backwards compatibility
+	if !a.reported() {
 		return
 	}
 	a.debugger.Complete(a.refreshDebugState(cx, evalError))
+	a.debugStates = a.debugStates[:len(a.debugStates)-1]
 }
 
 // WebDebugger represents a connection to tealdbg
@@ -129,6 +166,10 @@ type CallFrame struct {
 // to json and send to tealdbg
 type DebugState struct {
 	// fields set once on Register
+
+	// ExecID is the program ID of GetProgramID. For the program of an inner
+	// transaction, it is followed by "/" and the number of inner programs
+	// run so far, so that it differs from the ExecID of its caller.
 	ExecID      string                         `codec:"execid"`
 	Disassembly string                         `codec:"disasm"`
 	PCOffset    []PCOffset                     `codec:"pctooffset"`
@@ -146,10 +187,23 @@ type DebugState struct {
 	OpcodeBudget int                `codec:"budget"`
 	CallStack    []CallFrame        `codec:"callstack"`
 
+	// boxes available to the program that exist, by app and name.
+	// Stateful TEAL only. Reloaded only after an opcode that may change them.
+	Boxes      []DebugBox `codec:"boxes"`
+	boxesStale bool
+
 	// global/local state changes are updated every step. Stateful TEAL only.
 	transactions.EvalDelta
 }
 
+// DebugBox is the content of a box, with the name and value b64 encoded, so
+// it is suitable for conversion to JSON.
+type DebugBox struct {
+	App   basics.AppIndex `codec:"app"`
+	Name  string          `codec:"name"`
+	Value string          `codec:"value"`
+}
+
 // GetProgramID returns program or execution ID that is string representation of sha256 checksum.
 // It is used later to link program on the user-facing side of the debugger with TEAL evaluator.
 func GetProgramID(program []byte) string {
@@ -190,6 +244,7 @@ func makeDebugState(cx *EvalContext) *DebugState {
 
 	if cx.runMode == ModeApp {
 		ds.EvalDelta = cx.txn.EvalDelta
+		ds.boxesStale = true
 	}
 
 	return ds
@@ -275,7 +330,7 @@ func (d *DebugState) parseCallstack(callstack []frame) []CallFrame {
 }
 
 func (a *debuggerEvalTracerAdaptor) refreshDebugState(cx *EvalContext, evalError error) *DebugState {
-	ds := a.debugState
+	ds := a.debugStates[len(a.debugStates)-1]
 
 	// Update pc, line, error, stack, scratch space, callstack,
 	// and opcode budget
@@ -302,11 +357,41 @@ func (a *debuggerEvalTracerAdaptor) refreshDebugState(cx *EvalContext, evalError
 
 	if cx.runMode == ModeApp {
 		ds.EvalDelta = cx.txn.EvalDelta
+		if ds.boxesStale {
+			ds.Boxes = debugBoxes(cx)
+			ds.boxesStale = false
+		}
 	}
 
 	return ds
 }
 
+// debugBoxes returns the boxes available to the program that exist.
+func debugBoxes(cx *EvalContext) []DebugBox {
+	var boxes []DebugBox
+	for br := range cx.available.boxes {
+		if len(br.Name) == 0 {
+			continue
+		}
+		value, exists, err := cx.Ledger.GetBox(br.App, br.Name)
+		if err != nil || !exists {
+			continue
+		}
+		boxes = append(boxes, DebugBox{
+			App:   br.App,
+			Name:  base64.StdEncoding.EncodeToString([]byte(br.Name)),
+			Value: base64.StdEncoding.EncodeToString(value),
+		})
+	}
+	sort.Slice(boxes, func(i, j int) bool {
+		if boxes[i].App != boxes[j].App {
+			return boxes[i].App < boxes[j].App
+		}
+		return boxes[i].Name < boxes[j].Name
+	})
+	return boxes
+}
+
 func (dbg *WebDebugger) postState(state *DebugState, endpoint string) error {
 	var body bytes.Buffer
 	enc := protocol.NewJSONEncoder(&body)
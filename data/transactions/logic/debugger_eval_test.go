@@ -17,6 +17,7 @@
 package logic_test
 
 import (
+	"encoding/base64"
 	"os"
 	"strings"
 	"testing"
@@ -301,3 +302,122 @@ func TestCallStackUpdate(t *testing.T) {
 	require.Len(t, testDbg.state.Stack, 1)
 	require.Equal(t, testDbg.state.CallStack, expectedCallFrames)
 }
+
+// execRecorder is a Debugger that records the executions it is told about.
+type execRecorder struct {
+	registered []string
+	completed  []string
+	active     []string
+}
+
+func (d *execRecorder) Register(state *DebugState) {
+	d.registered = append(d.registered, state.ExecID)
+	d.active = append(d.active, state.ExecID)
+}
+
+func (d *execRecorder) Update(state *DebugState) {
+	if d.active[len(d.active)-1] != state.ExecID {
+		panic("update of " + state.ExecID + " while running " + d.active[len(d.active)-1])
+	}
+}
+
+func (d *execRecorder) Complete(state *DebugState) {
+	d.completed = append(d.completed, state.ExecID)
+	d.active = d.active[:len(d.active)-1]
+}
+
+func TestDebuggerInnerPrograms(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	ep, tx, ledger := MakeSampleEnv()
+	ledger.NewApp(tx.Receiver, 888, basics.AppParams{})
+	ledger.NewAccount(basics.AppIndex(888).Address(), 200_000)
+	scenario := mocktracer.GetTestScenarios()["none"](mocktracer.TestScenarioInfo{
+		CallingTxn:   *tx,
+		CreatedAppID: basics.AppIndex(888),
+	})
+	ops := TestProg(t, scenario.Program, AssemblerNoVersion)
+	outer := GetProgramID(ops.Program)
+	inner := GetProgramID(scenario.ExpectedSimulationAD.EvalDelta.InnerTxns[0].Txn.ApprovalProgram)
+
+	recorder := execRecorder{}
+	ep.Tracer = MakeEvalTracerDebuggerAdaptorWithInners(&recorder)
+	TestAppBytes(t, ops.Program, ep)
+	require.Equal(t, []string{outer, inner + "/1"}, recorder.registered)
+	require.Equal(t, []string{inner + "/1", outer}, recorder.completed)
+
+	// by default, only the top-level program is reported
+	recorder = execRecorder{}
+	ep.Tracer = MakeEvalTracerDebuggerAdaptor(&recorder)
+	TestAppBytes(t, ops.Program, ep)
+	require.Equal(t, []string{outer}, recorder.registered)
+}
+
+func TestDebuggerBoxes(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	ep, tx, ledger := MakeSampleEnv()
+	ledger.NewApp(tx.Sender, 888, basics.AppParams{})
+
+	testDbg := testDebugger{}
+	ep.Tracer = MakeEvalTracerDebuggerAdaptor(&testDbg)
+	TestApp(t, `byte "self"; int 4; box_create; byte "self"; int 1; byte 0x07; box_replace`, ep)
+	require.Equal(t, []DebugBox{{
+		App:   888,
+		Name:  base64.StdEncoding.EncodeToString([]byte("self")),
+		Value: base64.StdEncoding.EncodeToString([]byte{0, 7, 0, 0}),
+	}}, testDbg.state.Boxes)
+}
+
+// boxRecorder is a Debugger that records the boxes reported at every step.
+type boxRecorder struct {
+	steps [][]DebugBox
+}
+
+func (d *boxRecorder) Register(state *DebugState) {}
+
+func (d *boxRecorder) Update(state *DebugState) {
+	d.steps = append(d.steps, state.Boxes)
+}
+
+func (d *boxRecorder) Complete(state *DebugState) {
+	d.steps = append(d.steps, state.Boxes)
+}
+
+func TestDebuggerBoxesReloadAfterWrites(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	ep, tx, ledger := MakeSampleEnv()
+	ledger.NewApp(tx.Sender, 888, basics.AppParams{})
+
+	recorder := boxRecorder{}
+	ep.Tracer = MakeEvalTracerDebuggerAdaptor(&recorder)
+	TestApp(t, `byte "self"; int 1; box_create; pop; byte "self"; box_len; pop; pop; byte "self"; byte 0x07; box_put; int 1`, ep)
+
+	box := func(value byte) []DebugBox {
+		return []DebugBox{{
+			App:   888,
+			Name:  base64.StdEncoding.EncodeToString([]byte("self")),
+			Value: base64.StdEncoding.EncodeToString([]byte{value}),
+		}}
+	}
+	// one step per opcode, after the constant blocks, then the completion
+	require.Len(t, recorder.steps, 15)
+	for i, boxes := range recorder.steps {
+		switch {
+		case i <= 4: // up to and including box_create
+			require.Empty(t, boxes, i)
+		case i <= 12: // up to and including box_put
+			require.Equal(t, box(0), boxes, i)
+			if i > 5 {
+				// the boxes were not reloaded, as nothing changed them
+				require.Same(t, &recorder.steps[5][0], &boxes[0], i)
+			}
+		default:
+			require.Equal(t, box(7), boxes, i)
+		}
+	}
+}
@@ -333,6 +333,14 @@ type EvalParams struct {
 	// optional tracer
 	Tracer EvalTracer
 
+	// ProgramCache, if set, keeps the results of statically checking
+	// logicsigs, and their decoded constant blocks, across groups.
+	ProgramCache *ProgramCache
+
+	// checkedSigs holds the checked logicsigs of TxnGroup, found by
+	// CheckSignature in ProgramCache, for their evaluation
+	checkedSigs []*checkedProgram
+
 	// minAvmVersion is the minimum allowed AVM version of a program to be
 	// evaluated in TxnGroup.
 	minAvmVersion uint64
@@ -701,6 +709,10 @@ type EvalContext struct {
 	instructionStarts []bool
 
 	programHashCached crypto.Digest
+
+	// checked is the checked program being evaluated, if it was found in a
+	// ProgramCache, to reuse its decoded constant blocks
+	checked *checkedProgram
 }
 
 // GroupIndex returns the group index of the transaction being evaluated
@@ -1237,6 +1249,12 @@ func EvalSignatureFull(gi int, params *EvalParams) (bool, *EvalContext, error) {
 	// values). But error returns and potentially debug code might like to
 	// return them.
 	cx.pastScratch[cx.groupIndex] = &cx.Scratch
+	if gi < len(params.checkedSigs) {
+		// the logicsig may have changed since it was checked
+		if checked := params.checkedSigs[gi]; checked != nil && bytes.Equal(checked.program, cx.txn.Lsig.Logic) {
+			cx.checked = checked
+		}
+	}
 	pass, err := eval(cx.txn.Lsig.Logic, &cx)
 
 	if err != nil {
@@ -1351,17 +1369,18 @@ func eval(program []byte, cx *EvalContext) (pass bool, err error) {
 // these static checks include a cost estimate that must be low enough
 // (controlled by params.Proto).
 func CheckContract(program []byte, params *EvalParams) error {
-	return check(program, params, ModeApp)
+	return check(program, params, ModeApp, -1)
 }
 
 // CheckSignature should be faster than EvalSignature.  It can perform static
 // checks and reject programs that are invalid. Prior to v4, these static checks
 // include a cost estimate that must be low enough (controlled by params.Proto).
 func CheckSignature(gi int, params *EvalParams) error {
-	return check(params.TxnGroup[gi].Lsig.Logic, params, ModeSig)
+	return check(params.TxnGroup[gi].Lsig.Logic, params, ModeSig, gi)
 }
 
-func check(program []byte, params *EvalParams, mode RunMode) (err error) {
+// check statically checks program. gi is the group index of a logicsig, or -1.
+func check(program []byte, params *EvalParams, mode RunMode, gi int) (err error) {
 	defer func() {
 		if x := recover(); x != nil {
 			buf := make([]byte, 16*1024)
@@ -1381,14 +1400,37 @@ func check(program []byte, params *EvalParams, mode RunMode) (err error) {
 	var cx EvalContext
 	cx.EvalParams = params
 	cx.runMode = mode
-	cx.branchTargets = make([]bool, len(program)+1) // teal v2 allowed jumping to the end of the prog
-	cx.instructionStarts = make([]bool, len(program)+1)
 
 	if err := cx.begin(program); err != nil {
 		return err
 	}
 
 	maxCost := cx.remainingBudget()
+	if params.ProgramCache == nil || params.Trace != nil {
+		return cx.checkSteps(maxCost, nil)
+	}
+	checked := params.ProgramCache.lookup(&cx)
+	err = checked.result(maxCost)
+	if gi >= 0 {
+		if params.checkedSigs == nil {
+			params.checkedSigs = make([]*checkedProgram, len(params.TxnGroup))
+		}
+		params.checkedSigs[gi] = nil
+		if err == nil {
+			params.checkedSigs[gi] = checked
+		}
+	}
+	return err
+}
+
+// checkSteps statically checks the steps of cx.program, starting at cx.pc.
+// Before v4, the static cost of the program must be within maxCost. When
+// checked is not nil, the check continues past the budget, to record the cost
+// of each step and the decoded constant blocks of the program in checked.
+func (cx *EvalContext) checkSteps(maxCost int, checked *checkedProgram) error {
+	cx.branchTargets = make([]bool, len(cx.program)+1) // teal v2 allowed jumping to the end of the prog
+	cx.instructionStarts = make([]bool, len(cx.program)+1)
+
 	staticCost := 0
 	for cx.pc < len(cx.program) {
 		prevpc := cx.pc
@@ -1397,8 +1439,12 @@ func check(program []byte, params *EvalParams, mode RunMode) (err error) {
 			return fmt.Errorf("pc=%3d %w", cx.pc, err)
 		}
 		staticCost += stepCost
-		if cx.version < backBranchEnabledVersion && staticCost > maxCost {
-			return fmt.Errorf("pc=%3d static cost budget of %d exceeded", cx.pc, maxCost)
+		if cx.version < backBranchEnabledVersion {
+			if checked != nil {
+				checked.staticCosts = append(checked.staticCosts, costAt{pc: cx.pc, cost: staticCost})
+			} else if staticCost > maxCost {
+				return fmt.Errorf("pc=%3d static cost budget of %d exceeded", cx.pc, maxCost)
+			}
 		}
 		if cx.pc <= prevpc {
 			// Recall, this is advancing through opcodes
@@ -1407,6 +1453,9 @@ func check(program []byte, params *EvalParams, mode RunMode) (err error) {
 			// back.
 			return fmt.Errorf("pc=%3d pc did not advance", cx.pc)
 		}
+		if checked != nil {
+			checked.decodeConstants(cx.version, prevpc)
+		}
 	}
 	return nil
 }
@@ -2417,6 +2466,10 @@ func opBytesZero(cx *EvalContext) error {
 }
 
 func opIntConstBlock(cx *EvalContext) error {
+	if block, ok := cx.checked.constants(cx.pc); ok {
+		cx.intc, cx.nextpc = block.intc, block.nextpc
+		return nil
+	}
 	var err error
 	cx.intc, cx.nextpc, err = parseIntImmArgs(cx.program, cx.pc+1)
 	return err
@@ -2474,6 +2527,10 @@ func opPushInts(cx *EvalContext) error {
 }
 
 func opByteConstBlock(cx *EvalContext) error {
+	if block, ok := cx.checked.constants(cx.pc); ok {
+		cx.bytec, cx.nextpc = block.bytec, block.nextpc
+		return nil
+	}
 	var err error
 	cx.bytec, cx.nextpc, err = parseByteImmArgs(cx.program, cx.pc+1)
 	return err
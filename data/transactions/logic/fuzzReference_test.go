@@ -0,0 +1,646 @@
+// Copyright (C) 2019-2024 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package logic
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"crypto/sha512"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"golang.org/x/crypto/sha3"
+)
+
+// This file contains a deliberately naive reference implementation of the
+// pure (stateless) AVM opcodes. It shares no code with eval.go: values are
+// modeled as refValue, arithmetic is done with math/big wherever overflow
+// matters, and costs are written out by hand rather than read from
+// opcodes.go. FuzzOpcodes compares it against the real interpreter.
+
+// refValue is the reference model of an AVM stack value.
+type refValue struct {
+	isBytes bool
+	u       uint64
+	b       []byte
+}
+
+func refUint(u uint64) refValue { return refValue{u: u} }
+func refBytes(b []byte) refValue {
+	if b == nil {
+		b = []byte{}
+	}
+	return refValue{isBytes: true, b: b}
+}
+func refBool(cond bool) refValue {
+	if cond {
+		return refUint(1)
+	}
+	return refUint(0)
+}
+
+func (v refValue) String() string {
+	if v.isBytes {
+		return fmt.Sprintf("0x%x", v.b)
+	}
+	return fmt.Sprintf("%d", v.u)
+}
+
+// refMachine executes ops against the reference model, tracking cost against
+// a fixed budget the same way the AVM does: an op that would exceed the
+// budget fails before its cost is charged, while an op that fails for any
+// other reason has already been charged.
+type refMachine struct {
+	stack  []refValue
+	cost   int
+	budget int
+}
+
+// refOp describes the reference semantics of one opcode. The arguments are
+// popped from the stack (deepest first) before apply is called, and its
+// results are pushed in order. Ops that need the whole stack set raw instead.
+type refOp struct {
+	cost  int
+	args  int
+	apply func(args []refValue, imms []byte) ([]refValue, error)
+	raw   func(m *refMachine, imms []byte) error
+}
+
+const (
+	refMaxStringSize = 4096
+	refMaxStackDepth = 1000
+	refMaxMathBytes  = 64
+)
+
+// push executes a pushint or pushbytes of v.
+func (m *refMachine) push(v refValue) error {
+	if m.budget-m.cost < 1 {
+		return errors.New("push exceeds budget")
+	}
+	m.cost++
+	m.stack = append(m.stack, v)
+	if len(m.stack) > refMaxStackDepth {
+		return errors.New("stack overflow")
+	}
+	return nil
+}
+
+// step executes a single op, leaving the machine untouched (except for cost)
+// if it fails.
+func (m *refMachine) step(name string, imms []byte) error {
+	op, ok := refOps[name]
+	if !ok {
+		return fmt.Errorf("no reference for %s", name)
+	}
+	if op.cost > m.budget-m.cost {
+		return fmt.Errorf("%s exceeds budget", name)
+	}
+	if len(m.stack) < op.args {
+		return fmt.Errorf("%s underflows stack", name)
+	}
+	m.cost += op.cost
+
+	if op.raw != nil {
+		saved := append([]refValue(nil), m.stack...)
+		if err := op.raw(m, imms); err != nil {
+			m.stack = saved
+			return err
+		}
+	} else {
+		first := len(m.stack) - op.args
+		results, err := op.apply(m.stack[first:], imms)
+		if err != nil {
+			return err
+		}
+		for _, r := range results {
+			if r.isBytes && len(r.b) > refMaxStringSize {
+				return fmt.Errorf("%s produced %d bytes", name, len(r.b))
+			}
+		}
+		m.stack = append(m.stack[:first:first], results...)
+	}
+	if len(m.stack) > refMaxStackDepth {
+		return errors.New("stack overflow")
+	}
+	return nil
+}
+
+func refBig(b []byte) *big.Int { return new(big.Int).SetBytes(b) }
+
+var two64 = new(big.Int).Lsh(big.NewInt(1), 64)
+
+// refSplit returns the high and low 64 bits of x, which must fit in 128 bits.
+func refSplit(x *big.Int) (refValue, refValue) {
+	hi := new(big.Int).Rsh(x, 64)
+	lo := new(big.Int).Mod(x, two64)
+	return refUint(hi.Uint64()), refUint(lo.Uint64())
+}
+
+// refJoin interprets hi and lo as a 128 bit number.
+func refJoin(hi, lo uint64) *big.Int {
+	x := new(big.Int).Lsh(new(big.Int).SetUint64(hi), 64)
+	return x.Add(x, new(big.Int).SetUint64(lo))
+}
+
+// refFits reports whether x is a valid uint64 result.
+func refFits(x *big.Int) bool {
+	return x.Sign() >= 0 && x.BitLen() <= 64
+}
+
+func refPow(base, exp uint64, bits int) (*big.Int, error) {
+	if base == 0 && exp == 0 {
+		return nil, errors.New("0^0")
+	}
+	// Bound the work: any base above 1 overflows long before exp reaches bits.
+	if base > 1 && exp >= uint64(bits) {
+		return nil, errors.New("exp overflow")
+	}
+	x := new(big.Int).Exp(new(big.Int).SetUint64(base), new(big.Int).SetUint64(exp), nil)
+	if x.BitLen() > bits {
+		return nil, errors.New("exp overflow")
+	}
+	return x, nil
+}
+
+func uintOp(f func(a, b uint64) (uint64, error)) refOp {
+	return refOp{cost: 1, args: 2, apply: func(args []refValue, _ []byte) ([]refValue, error) {
+		r, err := f(args[0].u, args[1].u)
+		return []refValue{refUint(r)}, err
+	}}
+}
+
+func uintBigOp(f func(a, b *big.Int) *big.Int) refOp {
+	return uintOp(func(a, b uint64) (uint64, error) {
+		r := f(new(big.Int).SetUint64(a), new(big.Int).SetUint64(b))
+		if r == nil {
+			return 0, errors.New("division by zero")
+		}
+		if !refFits(r) {
+			return 0, fmt.Errorf("result %s out of range", r)
+		}
+		return r.Uint64(), nil
+	})
+}
+
+func uintCompare(f func(a, b uint64) bool) refOp {
+	return uintOp(func(a, b uint64) (uint64, error) {
+		return refBool(f(a, b)).u, nil
+	})
+}
+
+func byteMathOp(cost int, f func(a, b *big.Int) *big.Int) refOp {
+	return refOp{cost: cost, args: 2, apply: func(args []refValue, _ []byte) ([]refValue, error) {
+		if len(args[0].b) > refMaxMathBytes || len(args[1].b) > refMaxMathBytes {
+			return nil, errors.New("byte math input too long")
+		}
+		r := f(refBig(args[0].b), refBig(args[1].b))
+		if r == nil {
+			return nil, errors.New("division by zero")
+		}
+		if r.Sign() < 0 {
+			return nil, errors.New("byte math negative")
+		}
+		return []refValue{refBytes(r.Bytes())}, nil
+	}}
+}
+
+func byteCompare(f func(c int) bool) refOp {
+	return refOp{cost: 1, args: 2, apply: func(args []refValue, _ []byte) ([]refValue, error) {
+		if len(args[0].b) > refMaxMathBytes || len(args[1].b) > refMaxMathBytes {
+			return nil, errors.New("byte math input too long")
+		}
+		return []refValue{refBool(f(refBig(args[0].b).Cmp(refBig(args[1].b))))}, nil
+	}}
+}
+
+func byteLogicOp(f func(a, b byte) byte) refOp {
+	return refOp{cost: 6, args: 2, apply: func(args []refValue, _ []byte) ([]refValue, error) {
+		a, b := args[0].b, args[1].b
+		size := max(len(a), len(b))
+		out := make([]byte, size)
+		for i := 0; i < size; i++ {
+			// Shorter inputs are treated as if they had leading zeros.
+			var x, y byte
+			if j := i - (size - len(a)); j >= 0 {
+				x = a[j]
+			}
+			if j := i - (size - len(b)); j >= 0 {
+				y = b[j]
+			}
+			out[i] = f(x, y)
+		}
+		return []refValue{refBytes(out)}, nil
+	}}
+}
+
+func hashOp(cost int, f func([]byte) []byte) refOp {
+	return refOp{cost: cost, args: 1, apply: func(args []refValue, _ []byte) ([]refValue, error) {
+		return []refValue{refBytes(f(args[0].b))}, nil
+	}}
+}
+
+// refSlice returns a copy of x[start:start+length], or an error if that range
+// is not within x.
+func refSlice(x []byte, start, length uint64) ([]byte, error) {
+	if start > uint64(len(x)) || length > uint64(len(x))-start {
+		return nil, fmt.Errorf("range %d+%d beyond %d", start, length, len(x))
+	}
+	return append([]byte{}, x[start:start+length]...), nil
+}
+
+func extractUintOp(n uint64) refOp {
+	return refOp{cost: 1, args: 2, apply: func(args []refValue, _ []byte) ([]refValue, error) {
+		b, err := refSlice(args[0].b, args[1].u, n)
+		if err != nil {
+			return nil, err
+		}
+		return []refValue{refUint(refBig(b).Uint64())}, nil
+	}}
+}
+
+func refReplace(x []byte, start uint64, with []byte) ([]refValue, error) {
+	if start > uint64(len(x)) || uint64(len(with)) > uint64(len(x))-start {
+		return nil, fmt.Errorf("replacement beyond %d", len(x))
+	}
+	out := append([]byte{}, x...)
+	copy(out[start:], with)
+	return []refValue{refBytes(out)}, nil
+}
+
+func refGetBit(v refValue, idx uint64) (uint64, error) {
+	if !v.isBytes {
+		if idx >= 64 {
+			return 0, errors.New("bit index beyond uint64")
+		}
+		return (v.u >> idx) & 1, nil
+	}
+	if idx/8 >= uint64(len(v.b)) {
+		return 0, errors.New("bit index beyond bytes")
+	}
+	// Bits of a byte array are numbered from the most significant bit of
+	// its first byte.
+	return uint64(v.b[idx/8]>>(7-idx%8)) & 1, nil
+}
+
+func refSetBit(v refValue, idx uint64, bit uint64) (refValue, error) {
+	if bit > 1 {
+		return refValue{}, errors.New("bit value > 1")
+	}
+	if _, err := refGetBit(v, idx); err != nil {
+		return refValue{}, err
+	}
+	if !v.isBytes {
+		return refUint(v.u&^(1<<idx) | bit<<idx), nil
+	}
+	out := append([]byte{}, v.b...)
+	mask := byte(0x80) >> (idx % 8)
+	if bit == 1 {
+		out[idx/8] |= mask
+	} else {
+		out[idx/8] &^= mask
+	}
+	return refBytes(out), nil
+}
+
+// stackOp builds a raw op that manipulates the stack using the immediate n.
+// args is only the minimum stack height the op demands before it is charged.
+func stackOp(args int, f func(m *refMachine, n int) error) refOp {
+	return refOp{cost: 1, args: args, raw: func(m *refMachine, imms []byte) error {
+		return f(m, int(imms[0]))
+	}}
+}
+
+var refOps = map[string]refOp{
+	"+": uintBigOp(func(a, b *big.Int) *big.Int { return a.Add(a, b) }),
+	"-": uintBigOp(func(a, b *big.Int) *big.Int { return a.Sub(a, b) }),
+	"*": uintBigOp(func(a, b *big.Int) *big.Int { return a.Mul(a, b) }),
+	"/": uintBigOp(func(a, b *big.Int) *big.Int {
+		if b.Sign() == 0 {
+			return nil
+		}
+		return a.Div(a, b)
+	}),
+	"%": uintBigOp(func(a, b *big.Int) *big.Int {
+		if b.Sign() == 0 {
+			return nil
+		}
+		return a.Mod(a, b)
+	}),
+	"<":  uintCompare(func(a, b uint64) bool { return a < b }),
+	">":  uintCompare(func(a, b uint64) bool { return a > b }),
+	"<=": uintCompare(func(a, b uint64) bool { return a <= b }),
+	">=": uintCompare(func(a, b uint64) bool { return a >= b }),
+	"&&": uintCompare(func(a, b uint64) bool { return a != 0 && b != 0 }),
+	"||": uintCompare(func(a, b uint64) bool { return a != 0 || b != 0 }),
+	"==": {cost: 1, args: 2, apply: func(args []refValue, _ []byte) ([]refValue, error) {
+		if args[0].isBytes != args[1].isBytes {
+			return nil, errors.New("cannot compare mixed types")
+		}
+		return []refValue{refBool(args[0].u == args[1].u && bytes.Equal(args[0].b, args[1].b))}, nil
+	}},
+	"!=": {cost: 1, args: 2, apply: func(args []refValue, _ []byte) ([]refValue, error) {
+		if args[0].isBytes != args[1].isBytes {
+			return nil, errors.New("cannot compare mixed types")
+		}
+		return []refValue{refBool(args[0].u != args[1].u || !bytes.Equal(args[0].b, args[1].b))}, nil
+	}},
+	"&": uintOp(func(a, b uint64) (uint64, error) { return a & b, nil }),
+	"|": uintOp(func(a, b uint64) (uint64, error) { return a | b, nil }),
+	"^": uintOp(func(a, b uint64) (uint64, error) { return a ^ b, nil }),
+	"shl": uintOp(func(a, b uint64) (uint64, error) {
+		if b >= 64 {
+			return 0, errors.New("shift too big")
+		}
+		return a << b, nil
+	}),
+	"shr": uintOp(func(a, b uint64) (uint64, error) {
+		if b >= 64 {
+			return 0, errors.New("shift too big")
+		}
+		return a >> b, nil
+	}),
+	"exp": uintOp(func(a, b uint64) (uint64, error) {
+		x, err := refPow(a, b, 64)
+		if err != nil {
+			return 0, err
+		}
+		return x.Uint64(), nil
+	}),
+	"expw": {cost: 10, args: 2, apply: func(args []refValue, _ []byte) ([]refValue, error) {
+		x, err := refPow(args[0].u, args[1].u, 128)
+		if err != nil {
+			return nil, err
+		}
+		hi, lo := refSplit(x)
+		return []refValue{hi, lo}, nil
+	}},
+	"addw": {cost: 1, args: 2, apply: func(args []refValue, _ []byte) ([]refValue, error) {
+		x := new(big.Int).SetUint64(args[0].u)
+		hi, lo := refSplit(x.Add(x, new(big.Int).SetUint64(args[1].u)))
+		return []refValue{hi, lo}, nil
+	}},
+	"mulw": {cost: 1, args: 2, apply: func(args []refValue, _ []byte) ([]refValue, error) {
+		x := new(big.Int).SetUint64(args[0].u)
+		hi, lo := refSplit(x.Mul(x, new(big.Int).SetUint64(args[1].u)))
+		return []refValue{hi, lo}, nil
+	}},
+	"divw": {cost: 1, args: 3, apply: func(args []refValue, _ []byte) ([]refValue, error) {
+		if args[2].u == 0 {
+			return nil, errors.New("division by zero")
+		}
+		q := refJoin(args[0].u, args[1].u)
+		q.Div(q, new(big.Int).SetUint64(args[2].u))
+		if !refFits(q) {
+			return nil, errors.New("divw overflow")
+		}
+		return []refValue{refUint(q.Uint64())}, nil
+	}},
+	"divmodw": {cost: 20, args: 4, apply: func(args []refValue, _ []byte) ([]refValue, error) {
+		den := refJoin(args[2].u, args[3].u)
+		if den.Sign() == 0 {
+			return nil, errors.New("division by zero")
+		}
+		q, r := new(big.Int).QuoRem(refJoin(args[0].u, args[1].u), den, new(big.Int))
+		qhi, qlo := refSplit(q)
+		rhi, rlo := refSplit(r)
+		return []refValue{qhi, qlo, rhi, rlo}, nil
+	}},
+	"!": {cost: 1, args: 1, apply: func(args []refValue, _ []byte) ([]refValue, error) {
+		return []refValue{refBool(args[0].u == 0)}, nil
+	}},
+	"~": {cost: 1, args: 1, apply: func(args []refValue, _ []byte) ([]refValue, error) {
+		return []refValue{refUint(^args[0].u)}, nil
+	}},
+	"sqrt": {cost: 4, args: 1, apply: func(args []refValue, _ []byte) ([]refValue, error) {
+		return []refValue{refUint(new(big.Int).Sqrt(new(big.Int).SetUint64(args[0].u)).Uint64())}, nil
+	}},
+	"bitlen": {cost: 1, args: 1, apply: func(args []refValue, _ []byte) ([]refValue, error) {
+		if args[0].isBytes {
+			return []refValue{refUint(uint64(refBig(args[0].b).BitLen()))}, nil
+		}
+		return []refValue{refUint(uint64(new(big.Int).SetUint64(args[0].u).BitLen()))}, nil
+	}},
+	"itob": {cost: 1, args: 1, apply: func(args []refValue, _ []byte) ([]refValue, error) {
+		out := make([]byte, 8)
+		new(big.Int).SetUint64(args[0].u).FillBytes(out)
+		return []refValue{refBytes(out)}, nil
+	}},
+	"btoi": {cost: 1, args: 1, apply: func(args []refValue, _ []byte) ([]refValue, error) {
+		if len(args[0].b) > 8 {
+			return nil, errors.New("btoi input too long")
+		}
+		return []refValue{refUint(refBig(args[0].b).Uint64())}, nil
+	}},
+
+	"len": {cost: 1, args: 1, apply: func(args []refValue, _ []byte) ([]refValue, error) {
+		return []refValue{refUint(uint64(len(args[0].b)))}, nil
+	}},
+	"concat": {cost: 1, args: 2, apply: func(args []refValue, _ []byte) ([]refValue, error) {
+		return []refValue{refBytes(append(append([]byte{}, args[0].b...), args[1].b...))}, nil
+	}},
+	"substring": {cost: 1, args: 1, apply: func(args []refValue, imms []byte) ([]refValue, error) {
+		if imms[1] < imms[0] {
+			return nil, errors.New("substring end before start")
+		}
+		b, err := refSlice(args[0].b, uint64(imms[0]), uint64(imms[1]-imms[0]))
+		return []refValue{refBytes(b)}, err
+	}},
+	"substring3": {cost: 1, args: 3, apply: func(args []refValue, _ []byte) ([]refValue, error) {
+		if args[2].u < args[1].u {
+			return nil, errors.New("substring end before start")
+		}
+		b, err := refSlice(args[0].b, args[1].u, args[2].u-args[1].u)
+		return []refValue{refBytes(b)}, err
+	}},
+	"extract": {cost: 1, args: 1, apply: func(args []refValue, imms []byte) ([]refValue, error) {
+		start, length := uint64(imms[0]), uint64(imms[1])
+		if length == 0 {
+			// A zero length immediate means "to the end".
+			if start > uint64(len(args[0].b)) {
+				return nil, errors.New("extract start beyond length")
+			}
+			length = uint64(len(args[0].b)) - start
+		}
+		b, err := refSlice(args[0].b, start, length)
+		return []refValue{refBytes(b)}, err
+	}},
+	"extract3": {cost: 1, args: 3, apply: func(args []refValue, _ []byte) ([]refValue, error) {
+		b, err := refSlice(args[0].b, args[1].u, args[2].u)
+		return []refValue{refBytes(b)}, err
+	}},
+	"extract_uint16": extractUintOp(2),
+	"extract_uint32": extractUintOp(4),
+	"extract_uint64": extractUintOp(8),
+	"getbyte": {cost: 1, args: 2, apply: func(args []refValue, _ []byte) ([]refValue, error) {
+		b, err := refSlice(args[0].b, args[1].u, 1)
+		if err != nil {
+			return nil, err
+		}
+		return []refValue{refUint(uint64(b[0]))}, nil
+	}},
+	"setbyte": {cost: 1, args: 3, apply: func(args []refValue, _ []byte) ([]refValue, error) {
+		if args[2].u > 255 {
+			return nil, errors.New("setbyte value > 255")
+		}
+		return refReplace(args[0].b, args[1].u, []byte{byte(args[2].u)})
+	}},
+	"getbit": {cost: 1, args: 2, apply: func(args []refValue, _ []byte) ([]refValue, error) {
+		bit, err := refGetBit(args[0], args[1].u)
+		return []refValue{refUint(bit)}, err
+	}},
+	"setbit": {cost: 1, args: 3, apply: func(args []refValue, _ []byte) ([]refValue, error) {
+		v, err := refSetBit(args[0], args[1].u, args[2].u)
+		return []refValue{v}, err
+	}},
+	"replace2": {cost: 1, args: 2, apply: func(args []refValue, imms []byte) ([]refValue, error) {
+		return refReplace(args[0].b, uint64(imms[0]), args[1].b)
+	}},
+	"replace3": {cost: 1, args: 3, apply: func(args []refValue, _ []byte) ([]refValue, error) {
+		return refReplace(args[0].b, args[1].u, args[2].b)
+	}},
+	"bzero": {cost: 1, args: 1, apply: func(args []refValue, _ []byte) ([]refValue, error) {
+		if args[0].u > refMaxStringSize {
+			return nil, errors.New("bzero too long")
+		}
+		return []refValue{refBytes(make([]byte, args[0].u))}, nil
+	}},
+
+	"b+": byteMathOp(10, func(a, b *big.Int) *big.Int { return a.Add(a, b) }),
+	"b-": byteMathOp(10, func(a, b *big.Int) *big.Int { return a.Sub(a, b) }),
+	"b*": byteMathOp(20, func(a, b *big.Int) *big.Int { return a.Mul(a, b) }),
+	"b/": byteMathOp(20, func(a, b *big.Int) *big.Int {
+		if b.Sign() == 0 {
+			return nil
+		}
+		return a.Div(a, b)
+	}),
+	"b%": byteMathOp(20, func(a, b *big.Int) *big.Int {
+		if b.Sign() == 0 {
+			return nil
+		}
+		return a.Mod(a, b)
+	}),
+	"b<":  byteCompare(func(c int) bool { return c < 0 }),
+	"b>":  byteCompare(func(c int) bool { return c > 0 }),
+	"b<=": byteCompare(func(c int) bool { return c <= 0 }),
+	"b>=": byteCompare(func(c int) bool { return c >= 0 }),
+	"b==": byteCompare(func(c int) bool { return c == 0 }),
+	"b!=": byteCompare(func(c int) bool { return c != 0 }),
+	"b|":  byteLogicOp(func(a, b byte) byte { return a | b }),
+	"b&":  byteLogicOp(func(a, b byte) byte { return a & b }),
+	"b^":  byteLogicOp(func(a, b byte) byte { return a ^ b }),
+	"b~": {cost: 4, args: 1, apply: func(args []refValue, _ []byte) ([]refValue, error) {
+		out := make([]byte, len(args[0].b))
+		for i, b := range args[0].b {
+			out[i] = ^b
+		}
+		return []refValue{refBytes(out)}, nil
+	}},
+	"bsqrt": {cost: 40, args: 1, apply: func(args []refValue, _ []byte) ([]refValue, error) {
+		if len(args[0].b) > refMaxMathBytes {
+			return nil, errors.New("byte math input too long")
+		}
+		x := refBig(args[0].b)
+		return []refValue{refBytes(x.Sqrt(x).Bytes())}, nil
+	}},
+
+	"sha256": hashOp(35, func(b []byte) []byte {
+		h := sha256.Sum256(b)
+		return h[:]
+	}),
+	"sha512_256": hashOp(45, func(b []byte) []byte {
+		h := sha512.Sum512_256(b)
+		return h[:]
+	}),
+	"sha3_256": hashOp(130, func(b []byte) []byte {
+		h := sha3.Sum256(b)
+		return h[:]
+	}),
+	"keccak256": hashOp(130, func(b []byte) []byte {
+		h := sha3.NewLegacyKeccak256()
+		h.Write(b)
+		return h.Sum(nil)
+	}),
+
+	"pop": {cost: 1, args: 1, apply: func(args []refValue, _ []byte) ([]refValue, error) {
+		return nil, nil
+	}},
+	"dup": {cost: 1, args: 1, apply: func(args []refValue, _ []byte) ([]refValue, error) {
+		return []refValue{args[0], args[0]}, nil
+	}},
+	"dup2": {cost: 1, args: 2, apply: func(args []refValue, _ []byte) ([]refValue, error) {
+		return []refValue{args[0], args[1], args[0], args[1]}, nil
+	}},
+	"swap": {cost: 1, args: 2, apply: func(args []refValue, _ []byte) ([]refValue, error) {
+		return []refValue{args[1], args[0]}, nil
+	}},
+	"select": {cost: 1, args: 3, apply: func(args []refValue, _ []byte) ([]refValue, error) {
+		if args[2].u != 0 {
+			return []refValue{args[1]}, nil
+		}
+		return []refValue{args[0]}, nil
+	}},
+	"dig": stackOp(1, func(m *refMachine, n int) error {
+		if n >= len(m.stack) {
+			return errors.New("dig beyond stack")
+		}
+		m.stack = append(m.stack, m.stack[len(m.stack)-1-n])
+		return nil
+	}),
+	"bury": stackOp(1, func(m *refMachine, n int) error {
+		if n == 0 || n >= len(m.stack) {
+			return errors.New("bury beyond stack")
+		}
+		top := m.stack[len(m.stack)-1]
+		m.stack = m.stack[:len(m.stack)-1]
+		m.stack[len(m.stack)-n] = top
+		return nil
+	}),
+	"cover": stackOp(1, func(m *refMachine, n int) error {
+		if n >= len(m.stack) {
+			return errors.New("cover beyond stack")
+		}
+		top := m.stack[len(m.stack)-1]
+		rest := append([]refValue{}, m.stack[len(m.stack)-1-n:len(m.stack)-1]...)
+		m.stack = append(append(m.stack[:len(m.stack)-1-n], top), rest...)
+		return nil
+	}),
+	"uncover": stackOp(1, func(m *refMachine, n int) error {
+		if n >= len(m.stack) {
+			return errors.New("uncover beyond stack")
+		}
+		idx := len(m.stack) - 1 - n
+		moved := m.stack[idx]
+		m.stack = append(append(m.stack[:idx:idx], m.stack[idx+1:]...), moved)
+		return nil
+	}),
+	"popn": stackOp(0, func(m *refMachine, n int) error {
+		if n > len(m.stack) {
+			return errors.New("popn beyond stack")
+		}
+		m.stack = m.stack[:len(m.stack)-n]
+		return nil
+	}),
+	"dupn": stackOp(1, func(m *refMachine, n int) error {
+		top := m.stack[len(m.stack)-1]
+		for i := 0; i < n; i++ {
+			m.stack = append(m.stack, top)
+		}
+		return nil
+	}),
+}
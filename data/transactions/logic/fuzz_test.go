@@ -0,0 +1,386 @@
+// Copyright (C) 2019-2024 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package logic
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Quarkonium-chain/go-quarkonium/test/partitiontest"
+)
+
+// fuzzSource turns fuzzer input into generator decisions. Once the input is
+// exhausted every decision is 0, so any input yields a finite program.
+type fuzzSource struct {
+	data []byte
+}
+
+func (s *fuzzSource) byte() byte {
+	if len(s.data) == 0 {
+		return 0
+	}
+	b := s.data[0]
+	s.data = s.data[1:]
+	return b
+}
+
+// intn returns a value in [0, n)
+func (s *fuzzSource) intn(n int) int {
+	if n <= 1 {
+		return 0
+	}
+	v := int(s.byte())
+	if n > 256 {
+		v = v<<8 | int(s.byte())
+	}
+	return v % n
+}
+
+func (s *fuzzSource) uint64() uint64 {
+	var buf [8]byte
+	for i := range buf {
+		buf[i] = s.byte()
+	}
+	return binary.BigEndian.Uint64(buf[:])
+}
+
+// small returns a value suitable for an index or length immediate: usually
+// small enough to land inside the values the generator makes, occasionally
+// anything that fits in a byte.
+func (s *fuzzSource) small() byte {
+	if s.intn(8) == 0 {
+		return s.byte()
+	}
+	return byte(s.intn(24))
+}
+
+// fuzzOp is one line of a generated program: either a constant push or an
+// opcode with its byte immediates.
+type fuzzOp struct {
+	name  string
+	imms  []byte
+	value *refValue
+}
+
+func (op fuzzOp) String() string {
+	if op.value != nil {
+		if op.value.isBytes {
+			return "pushbytes 0x" + hex.EncodeToString(op.value.b)
+		}
+		return fmt.Sprintf("pushint %d", op.value.u)
+	}
+	var sb strings.Builder
+	sb.WriteString(op.name)
+	for _, imm := range op.imms {
+		fmt.Fprintf(&sb, " %d", imm)
+	}
+	return sb.String()
+}
+
+func (op fuzzOp) run(m *refMachine) error {
+	if op.value != nil {
+		return m.push(*op.value)
+	}
+	return m.step(op.name, op.imms)
+}
+
+// fuzzOpNames are the opcodes that have a reference implementation and exist
+// in the current AVM version, in a fixed order so that generation is
+// deterministic. It is computed lazily because OpsByName is filled in by an
+// init function.
+var fuzzOpNames = sync.OnceValue(func() []string {
+	var names []string
+	for name := range refOps {
+		if _, ok := OpsByName[LogicVersion][name]; ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+})
+
+var fuzzInterestingUints = []uint64{
+	0, 1, 2, 3, 7, 8, 15, 16, 31, 32, 63, 64, 65, 127, 128, 255, 256,
+	4096, 4097, 1 << 32, 1<<63 - 1, 1 << 63, math.MaxUint64 - 1, math.MaxUint64,
+}
+
+var fuzzInterestingLengths = []int{0, 1, 2, 3, 4, 7, 8, 9, 16, 31, 32, 33, 63, 64, 65}
+
+func (s *fuzzSource) constant() fuzzOp {
+	if s.intn(2) == 0 {
+		var u uint64
+		if s.intn(2) == 0 {
+			u = fuzzInterestingUints[s.intn(len(fuzzInterestingUints))]
+		} else {
+			u = s.uint64()
+		}
+		v := refUint(u)
+		return fuzzOp{value: &v}
+	}
+
+	length := fuzzInterestingLengths[s.intn(len(fuzzInterestingLengths))]
+	if s.intn(4) == 0 {
+		length = s.intn(80)
+	}
+	b := make([]byte, length)
+	switch s.intn(4) {
+	case 0: // all zero
+	case 1:
+		for i := range b {
+			b[i] = 0xff
+		}
+	default:
+		for i := range b {
+			b[i] = s.byte()
+		}
+	}
+	v := refBytes(b)
+	return fuzzOp{value: &v}
+}
+
+// typesMatch reports whether the top of stack can be passed to spec.
+func typesMatch(spec OpSpec, stack []refValue) bool {
+	types := spec.Arg.Types
+	if len(stack) < len(types) {
+		return false
+	}
+	first := len(stack) - len(types)
+	if spec.Name == "==" || spec.Name == "!=" {
+		// Declared as "aa", but mixed types are always an error.
+		return stack[first].isBytes == stack[first+1].isBytes
+	}
+	for i, t := range types {
+		switch t.AVMType {
+		case avmUint64:
+			if stack[first+i].isBytes {
+				return false
+			}
+		case avmBytes:
+			if !stack[first+i].isBytes {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// immediates chooses immediates for name. Stack manipulation depths are
+// usually valid, but are occasionally arbitrary so that the failure paths get
+// exercised too.
+func (s *fuzzSource) immediates(name string, stack []refValue) []byte {
+	depth := func(lo int) byte {
+		if s.intn(8) == 0 || len(stack) <= lo {
+			return s.small()
+		}
+		return byte(min(lo+s.intn(len(stack)-lo), 255))
+	}
+	switch name {
+	case "dig", "cover", "uncover", "popn":
+		return []byte{depth(0)}
+	case "bury":
+		return []byte{depth(1)}
+	case "dupn":
+		return []byte{s.small()}
+	case "substring":
+		start, end := s.small(), s.small()
+		if end < start {
+			start, end = end, start
+		}
+		return []byte{start, end}
+	}
+	imms := make([]byte, len(OpsByName[LogicVersion][name].Immediates))
+	for i := range imms {
+		imms[i] = s.small()
+	}
+	return imms
+}
+
+// next chooses the next line of a program, given the current reference stack.
+func (s *fuzzSource) next(stack []refValue) fuzzOp {
+	// Push a constant a quarter of the time, or whenever nothing else fits.
+	if len(stack) == 0 || s.intn(4) == 0 {
+		return s.constant()
+	}
+	var candidates []string
+	for _, name := range fuzzOpNames() {
+		if typesMatch(OpsByName[LogicVersion][name], stack) {
+			candidates = append(candidates, name)
+		}
+	}
+	if len(candidates) == 0 {
+		return s.constant()
+	}
+	name := candidates[s.intn(len(candidates))]
+	return fuzzOp{name: name, imms: s.immediates(name, stack)}
+}
+
+// generateFuzzProgram deterministically builds a well-typed program from
+// data. The reference interpreter runs alongside generation so that the
+// types on the stack are always known, and generation stops after the first
+// op that the reference rejects.
+func generateFuzzProgram(data []byte) []fuzzOp {
+	s := fuzzSource{data: data}
+	m := refMachine{budget: math.MaxInt}
+	count := 1 + s.intn(48)
+	var prog []fuzzOp
+	for len(prog) < count {
+		op := s.next(m.stack)
+		// Most ops that would fail are replaced by a constant, so that
+		// programs usually get long enough to be interesting.
+		trial := refMachine{stack: append([]refValue(nil), m.stack...), budget: m.budget}
+		if op.run(&trial) != nil && s.intn(8) != 0 {
+			op = s.constant()
+		}
+		prog = append(prog, op)
+		if op.run(&m) != nil {
+			break
+		}
+	}
+	return prog
+}
+
+// fuzzProgramHeader precedes the generated ops. Type tracking is off so that
+// the assembler accepts ops that are meant to fail at runtime.
+var fuzzProgramHeader = fmt.Sprintf("#pragma version %d\n#pragma typetrack false\n", LogicVersion)
+
+func fuzzProgramSource(prog []fuzzOp) string {
+	var sb strings.Builder
+	sb.WriteString(fuzzProgramHeader)
+	for _, op := range prog {
+		sb.WriteString(op.String())
+		sb.WriteString("\n")
+	}
+	return sb.String()
+}
+
+// checkAgainstReference assembles prog, evaluates it as both an app and a
+// logicsig, and requires that each evaluation agree with the reference
+// interpreter about where (or whether) the program fails, the final stack,
+// and the cost.
+func checkAgainstReference(t *testing.T, prog []fuzzOp) {
+	source := fuzzProgramSource(prog)
+	ops, err := AssembleString(source)
+	require.NoError(t, err, source)
+
+	// pcs[i] is the program counter of prog[i]
+	header := strings.Count(fuzzProgramHeader, "\n")
+	pcs := make([]int, len(prog))
+	for pc, loc := range ops.OffsetToSource {
+		if i := loc.Line - header; i >= 0 && i < len(prog) {
+			pcs[i] = pc
+		}
+	}
+
+	ep := defaultAppParams()
+	pass, cx, err := EvalContract(ops.Program, 0, 888, ep)
+	checkEval(t, "app", source, ep.Trace, prog, pcs, ep.Proto.MaxAppProgramCost, pass, cx, err)
+
+	ep = defaultSigParams()
+	ep.TxnGroup[0].Lsig.Logic = ops.Program
+	pass, cx, err = EvalSignatureFull(0, ep)
+	checkEval(t, "sig", source, ep.Trace, prog, pcs, int(ep.Proto.LogicSigMaxCost), pass, cx, err)
+}
+
+func checkEval(t *testing.T, mode string, source string, trace fmt.Stringer, prog []fuzzOp, pcs []int,
+	budget int, pass bool, cx *EvalContext, err error) {
+	msg := func(format string, args ...interface{}) string {
+		return fmt.Sprintf("%s mode: %s\n%s\n%s", mode, fmt.Sprintf(format, args...), source, trace)
+	}
+
+	var pe panicError
+	require.False(t, errors.As(err, &pe), msg("panic: %v", err))
+	require.NotNil(t, cx, msg("no context: %v", err))
+
+	m := refMachine{budget: budget}
+	var refErr error
+	failed := -1
+	for i, op := range prog {
+		if refErr = op.run(&m); refErr != nil {
+			failed = i
+			break
+		}
+	}
+	require.Equal(t, m.cost, cx.Cost(), msg("cost"))
+
+	if refErr != nil {
+		require.Error(t, err, msg("reference failed at line %d (%s) with %v", failed, prog[failed], refErr))
+		require.Equal(t, pcs[failed], cx.pc, msg("reference failed at line %d (%s) with %v but eval: %v", failed, prog[failed], refErr, err))
+		return
+	}
+
+	require.Len(t, cx.Stack, len(m.stack), msg("stack height, eval: %v", err))
+	for i, want := range m.stack {
+		got := cx.Stack[i]
+		if want.isBytes {
+			require.Equal(t, avmBytes, got.avmType(), msg("stack[%d] want %s got %s", i, want, got))
+			require.Equal(t, want.b, got.Bytes, msg("stack[%d]", i))
+		} else {
+			require.Equal(t, avmUint64, got.avmType(), msg("stack[%d] want %s got %s", i, want, got))
+			require.Equal(t, want.u, got.Uint, msg("stack[%d]", i))
+		}
+	}
+	if len(m.stack) == 1 && !m.stack[0].isBytes {
+		require.NoError(t, err, msg("eval failed"))
+		require.Equal(t, m.stack[0].u != 0, pass, msg("pass"))
+	} else {
+		// The only acceptable failure is the final stack check.
+		require.Error(t, err, msg("improper final stack accepted"))
+		require.False(t, pass)
+	}
+}
+
+// FuzzOpcodes generates well-typed programs of pure opcodes and checks the
+// AVM against the reference interpreter in fuzzReference_test.go. The corpus
+// in testdata/fuzz/FuzzOpcodes runs as part of `go test`. To search for new
+// discrepancies, use:
+//
+//	go test -run=^$ -fuzz=FuzzOpcodes ./data/transactions/logic
+func FuzzOpcodes(f *testing.F) {
+	f.Add([]byte{})
+	f.Add([]byte("\x30\x00\x01\x00\xff\x01\x00\x01\x00\x02"))
+	f.Fuzz(func(t *testing.T, data []byte) {
+		checkAgainstReference(t, generateFuzzProgram(data))
+	})
+}
+
+// TestOpcodesAgainstReference runs a fixed, seeded batch of generated programs
+// so that ordinary test runs cover far more than the checked in corpus.
+func TestOpcodesAgainstReference(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	rng := rand.New(rand.NewSource(17))
+	count := 2000
+	if testing.Short() {
+		count = 200
+	}
+	data := make([]byte, 256)
+	for i := 0; i < count; i++ {
+		rng.Read(data)
+		checkAgainstReference(t, generateFuzzProgram(data))
+	}
+}
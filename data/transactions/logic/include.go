@@ -0,0 +1,196 @@
+// Copyright (C) 2019-2024 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package logic
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"unicode"
+)
+
+// namespaceSeparator separates the namespaces of included files from the
+// names of their labels. A label `add` of a file included as `math` is
+// referred to as `math::add` by the including file.
+const namespaceSeparator = "::"
+
+// FileLoader reads the files named by #include and #import directives. Paths
+// use forward slashes. Relative paths are resolved against the directory of
+// the including file before Load is called, so paths included by the program
+// itself are passed unchanged.
+type FileLoader interface {
+	Load(path string) (string, error)
+}
+
+// DirLoader loads files relative to the directory it names.
+type DirLoader string
+
+// Load implements FileLoader.
+func (d DirLoader) Load(p string) (string, error) {
+	name := filepath.FromSlash(p)
+	if !filepath.IsAbs(name) {
+		name = filepath.Join(string(d), name)
+	}
+	data, err := os.ReadFile(name)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// MapLoader loads files from memory, keyed by their path.
+type MapLoader map[string]string
+
+// Load implements FileLoader.
+func (m MapLoader) Load(p string) (string, error) {
+	if text, ok := m[p]; ok {
+		return text, nil
+	}
+	for key, text := range m {
+		if path.Clean(key) == p {
+			return text, nil
+		}
+	}
+	return "", fmt.Errorf("%s: file not found", p)
+}
+
+// sourceFile describes the file being assembled.
+type sourceFile struct {
+	// name is the path of the file, empty for the program itself
+	name string
+	// index is the SourceLocation.File of the file
+	index int
+	// labelPrefix qualifies the labels of the file by its namespace
+	labelPrefix string
+}
+
+// SourceNames returns the names of the source files of the program, indexed
+// by SourceLocation.File, for use with GetSourceMap. The program itself is
+// named by main, and is followed by the files it included.
+func (ops *OpStream) SourceNames(main string) []string {
+	return append([]string{main}, ops.files...)
+}
+
+// include handles both `#include "path" [as name]` and `#import "path" [as
+// name]`. The file is assembled in place, with its labels in the namespace
+// name, which defaults to the base name of the file without its extension.
+// #import skips files that were already included, and makes the namespace
+// refer to the labels of the earlier inclusion instead.
+func include(ops *OpStream, tokens []token) *sourceError {
+	directive := tokens[0].str
+	if directive != "#include" && directive != "#import" {
+		return tokens[0].errorf("invalid syntax: %s", directive)
+	}
+	if len(tokens) != 2 && (len(tokens) != 4 || tokens[2].str != "as") {
+		return tokens[0].errorf("%s directive requires a quoted path, optionally followed by: as name", directive)
+	}
+	raw, err := parseStringLiteral(tokens[1].str)
+	if err != nil || len(raw) == 0 {
+		return tokens[1].errorf("%s path must be a non-empty quoted string", directive)
+	}
+	if ops.loader == nil {
+		return tokens[0].errorf("%s is not supported without a file loader", directive)
+	}
+	p := string(raw)
+	if !path.IsAbs(p) {
+		p = path.Join(path.Dir(ops.file.name), p)
+	}
+	p = path.Clean(p)
+
+	nameToken := tokens[1]
+	namespace := strings.TrimSuffix(path.Base(p), path.Ext(p))
+	if len(tokens) == 4 {
+		nameToken = tokens[3]
+		namespace = nameToken.str
+	}
+	if err := checkNamespace(namespace); err != nil {
+		return nameToken.error(err)
+	}
+	prefix := ops.file.labelPrefix + namespace + namespaceSeparator
+	original, imported := ops.imported[p]
+	if imported && directive == "#import" && (original == prefix || ops.aliases[prefix] == original) {
+		return nil // already imported into this namespace
+	}
+	if ops.namespaces[prefix] {
+		return nameToken.errorf("namespace %#v is already in use", namespace)
+	}
+	ops.namespaces[prefix] = true
+	if imported && directive == "#import" {
+		ops.aliases[prefix] = original
+		return nil
+	}
+	for _, f := range append(ops.includes, ops.file) {
+		if f.name == p {
+			return tokens[1].errorf("%s cycle: %s includes itself", directive, p)
+		}
+	}
+
+	text, err := ops.loader.Load(p)
+	if err != nil {
+		return tokens[1].errorf("%s failed: %w", directive, err)
+	}
+	if !imported {
+		ops.imported[p] = prefix
+		ops.files = append(ops.files, p)
+	}
+	index := 0
+	for i, f := range ops.files {
+		if f == p {
+			index = i + 1
+		}
+	}
+
+	ops.includes = append(ops.includes, ops.file)
+	line := ops.sourceLine
+	ops.file = sourceFile{name: p, index: index, labelPrefix: prefix}
+	ops.sourceLine = 0
+	ops.trace("%3d: %s %s\n", tokens[0].line, directive, p)
+	ops.assembleSource(text)
+	ops.sourceLine = line
+	ops.file = ops.includes[len(ops.includes)-1]
+	ops.includes = ops.includes[:len(ops.includes)-1]
+	return nil
+}
+
+func checkNamespace(namespace string) error {
+	if namespace == "" {
+		return fmt.Errorf("empty namespace")
+	}
+	for _, r := range namespace {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_' && r != '-' && r != '.' {
+			return fmt.Errorf("%s character not allowed in namespace %#v", string(r), namespace)
+		}
+	}
+	return nil
+}
+
+// unalias rewrites a qualified label name that goes through a namespace of a
+// re-imported file into the name the label was actually assembled under.
+func (ops *OpStream) unalias(name string) string {
+	longest := ""
+	for alias := range ops.aliases {
+		if len(alias) > len(longest) && strings.HasPrefix(name, alias) {
+			longest = alias
+		}
+	}
+	if longest == "" {
+		return name
+	}
+	return ops.aliases[longest] + name[len(longest):]
+}
@@ -0,0 +1,266 @@
+// Copyright (C) 2019-2024 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package logic
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Quarkonium-chain/go-quarkonium/test/partitiontest"
+)
+
+var includeFiles = MapLoader{
+	"lib/math.teal": `#pragma version 8
+#import "util.teal"
+add:
+  +
+  callsub util::id
+  retsub
+`,
+	"lib/util.teal": `id:
+  retsub
+`,
+}
+
+const includeMain = `#pragma version 8
+int 1
+int 2
+callsub math::add
+int 3
+==
+return
+#include "lib/math.teal"
+`
+
+const includeFlat = `#pragma version 8
+int 1
+int 2
+callsub add
+int 3
+==
+return
+id:
+  retsub
+add:
+  +
+  callsub id
+  retsub
+`
+
+func TestInclude(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	ops, err := AssembleStringWithLoader(includeMain, includeFiles)
+	require.NoError(t, err, ops.Errors)
+	flat, err := AssembleString(includeFlat)
+	require.NoError(t, err)
+	require.Equal(t, flat.Program, ops.Program)
+	require.Equal(t, []string{"main.teal", "lib/math.teal", "lib/util.teal"}, ops.SourceNames("main.teal"))
+
+	// the same program, read from disk
+	dir := t.TempDir()
+	for name, text := range includeFiles {
+		require.NoError(t, os.MkdirAll(filepath.Join(dir, filepath.Dir(name)), 0755))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(text), 0644))
+	}
+	ops, err = AssembleStringWithLoader(includeMain, DirLoader(dir))
+	require.NoError(t, err, ops.Errors)
+	require.Equal(t, flat.Program, ops.Program)
+}
+
+func TestIncludeLabelNamespaces(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	// every file has its own loop label, and only sees its own
+	files := MapLoader{
+		"count.teal": "loop:\nint 1\n-\ndup\nbnz loop\nretsub\n",
+	}
+	ops, err := AssembleStringWithLoader(`#pragma version 8
+int 3
+callsub counter::loop
+loop:
+int 1
+bnz loop
+int 1
+return
+#include "count.teal" as counter
+`, files)
+	require.NoError(t, err, ops.Errors)
+	flat, err := AssembleString(`#pragma version 8
+int 3
+callsub count_loop
+loop:
+int 1
+bnz loop
+int 1
+return
+count_loop:
+int 1
+-
+dup
+bnz count_loop
+retsub
+`)
+	require.NoError(t, err)
+	require.Equal(t, flat.Program, ops.Program)
+
+	// labels of other files are not visible without their namespace
+	files["bad.teal"] = "b done\n"
+	ops, err = AssembleStringWithLoader("#pragma version 8\n#include \"bad.teal\"\ndone:\nint 1\n", files)
+	require.Error(t, err)
+	require.Len(t, ops.Errors, 1)
+	require.Equal(t, "bad.teal", ops.Errors[0].File)
+	require.Equal(t, 1, ops.Errors[0].Line)
+	require.ErrorContains(t, ops.Errors[0], `bad.teal:1:2: reference to undefined label "done"`)
+
+	var report strings.Builder
+	ops.ReportMultipleErrors("main.teal", &report)
+	require.Equal(t, "main.teal: 1 error: bad.teal:1:2: reference to undefined label \"done\"\n", report.String())
+}
+
+func TestImportOnce(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	// a diamond: util.teal is assembled once, for both a.teal and b.teal
+	files := MapLoader{
+		"a.teal":    "#import \"util.teal\"\nf:\ncallsub util::one\nretsub\n",
+		"b.teal":    "#import \"util.teal\" as u\ng:\ncallsub u::one\nretsub\n",
+		"util.teal": "one:\nint 1\nretsub\n",
+	}
+	ops, err := AssembleStringWithLoader(`#pragma version 8
+callsub a::f
+callsub b::g
+callsub a::util::one
++
++
+return
+#import "a.teal"
+#import "b.teal"
+#import "a.teal"
+`, files)
+	require.NoError(t, err, ops.Errors)
+	flat, err := AssembleString(`#pragma version 8
+callsub f
+callsub g
+callsub one
++
++
+return
+one:
+int 1
+retsub
+f:
+callsub one
+retsub
+g:
+callsub one
+retsub
+`)
+	require.NoError(t, err)
+	require.Equal(t, flat.Program, ops.Program)
+	require.Equal(t, []string{"", "a.teal", "util.teal", "b.teal"}, ops.SourceNames(""))
+
+	// #include assembles the file again, in another namespace
+	ops, err = AssembleStringWithLoader(`#pragma version 8
+callsub one::one
+callsub two::one
++
+return
+#include "util.teal" as one
+#include "util.teal" as two
+`, files)
+	require.NoError(t, err, ops.Errors)
+	require.Equal(t, []string{"", "util.teal"}, ops.SourceNames(""))
+}
+
+func TestIncludeErrors(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	files := MapLoader{
+		"self.teal":  "#include \"other.teal\"\n",
+		"other.teal": "#include \"self.teal\"\n",
+		"v6.teal":    "#pragma version 6\n",
+		"v8.teal":    "#pragma version 8\nint 1\n",
+		"util.teal":  "#pragma version 8\none:\nint 1\nretsub\n",
+	}
+	cases := []struct {
+		source string
+		err    string
+	}{
+		{`#include "util.teal" extra`, "requires a quoted path"},
+		{`#include util.teal`, "path must be a non-empty quoted string"},
+		{`#include ""`, "path must be a non-empty quoted string"},
+		{`#include "missing.teal"`, "1:9: #include failed: missing.teal: file not found"},
+		{`#include "self.teal"`, "other.teal:1:9: #include cycle: self.teal includes itself"},
+		{"#include \"util.teal\"\n#include \"util.teal\"", `2:9: namespace "util" is already in use`},
+		{"#include \"util.teal\" as u\n#import \"v8.teal\" as u", `2:21: namespace "u" is already in use`},
+		{`#include "util.teal" as a::b`, `: character not allowed in namespace "a::b"`},
+		{"#pragma version 8\nint 1\n#include \"v6.teal\"", "v6.teal:1: #pragma version is only allowed before instructions"},
+		{"#pragma version 8\nint 1\nutil::one:", `3: label "util::one" cannot contain ::`},
+	}
+	for _, tc := range cases {
+		ops, err := AssembleStringWithLoader(tc.source, files)
+		require.Error(t, err, tc.source)
+		require.ErrorContains(t, ops.Errors[0], tc.err, tc.source)
+	}
+
+	// an included file may restate the version being assembled
+	ops, err := AssembleStringWithLoader("#pragma version 8\nint 1\n#include \"v8.teal\"\n+\n", files)
+	require.NoError(t, err, ops.Errors)
+
+	ops, err = AssembleString(`#include "util.teal"`)
+	require.Error(t, err)
+	require.ErrorContains(t, ops.Errors[0], "#include is not supported without a file loader")
+}
+
+func TestIncludeSourceMap(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	ops, err := AssembleStringWithLoader(includeMain, includeFiles)
+	require.NoError(t, err, ops.Errors)
+	sourceMap := GetSourceMap(ops.SourceNames("main.teal"), ops.OffsetToSource)
+	require.Equal(t, []string{"main.teal", "lib/math.teal", "lib/util.teal"}, sourceMap.Sources)
+
+	locations, err := sourceMap.PCLocations()
+	require.NoError(t, err)
+	require.Equal(t, ops.OffsetToSource, locations)
+
+	type fileLine struct {
+		file string
+		line int
+	}
+	var lines []fileLine
+	for pc := 0; pc < len(ops.Program); pc++ {
+		if location, ok := locations[pc]; ok {
+			lines = append(lines, fileLine{sourceMap.Sources[location.File], location.Line})
+		}
+	}
+	require.Equal(t, []fileLine{
+		{"main.teal", 1}, {"main.teal", 2}, {"main.teal", 3}, {"main.teal", 4}, {"main.teal", 5}, {"main.teal", 6},
+		{"lib/util.teal", 1},
+		{"lib/math.teal", 3}, {"lib/math.teal", 4}, {"lib/math.teal", 5},
+	}, lines)
+}
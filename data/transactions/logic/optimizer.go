@@ -0,0 +1,459 @@
+// Copyright (C) 2019-2024 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package logic
+
+import (
+	"bytes"
+	"encoding/binary"
+	"sort"
+)
+
+// maxOptimizeLevel is the highest level accepted by #pragma optimize. Level 0
+// assembles programs as written.
+const maxOptimizeLevel = 1
+
+// maxOptimizerPasses bounds how many times the optimizer rewrites a program.
+// A pass can expose more to do, such as a `dup; pop` that surrounded a removed
+// `int 0; +`.
+const maxOptimizerPasses = 8
+
+// optimize applies the rewrites of #pragma optimize 1 to the assembled
+// program, and updates ops.OffsetToSource to match. The rewrites preserve
+// what the program computes: instructions are only removed or replaced where
+// the stack is known to hold what they need, and never where a branch enters
+// the middle of the rewritten instructions. Costs can only decrease.
+func (ops *OpStream) optimize() {
+	for i := 0; i < maxOptimizerPasses; i++ {
+		program, offsetToSource, changed := optimizePass(ops.Program, ops.OffsetToSource)
+		if !changed {
+			return
+		}
+		ops.Program = program
+		ops.OffsetToSource = offsetToSource
+	}
+}
+
+// optInstruction is an instruction of the optimized program. Its targets are
+// pcs in the program before the pass, which are resolved once the optimized
+// layout is known.
+type optInstruction struct {
+	spec *OpSpec
+	// immediates, except branch offsets, as encoded
+	immediates []byte
+	targets    []int
+	// pc of the instruction in the program before the pass, whose source
+	// location it takes
+	source int
+}
+
+func (oi *optInstruction) size() int {
+	if oi == nil {
+		return 0
+	}
+	size := 1 + len(oi.immediates)
+	if len(oi.spec.Immediates) > 0 {
+		switch oi.spec.Immediates[0].kind {
+		case immLabel:
+			size += 2
+		case immLabels:
+			size += 1 + 2*len(oi.targets)
+		}
+	}
+	return size
+}
+
+// identities are the binary uint64 opcodes that return their first argument
+// when the second is the given constant.
+var identities = map[string]uint64{
+	"+": 0, "-": 0, "|": 0, "^": 0, "shl": 0, "shr": 0,
+	"*": 1, "/": 1, "exp": 1,
+}
+
+// pureLoads are opcodes that push the same value every time they are run,
+// wherever they appear in a program. Two in a row can become one and a dup.
+var pureLoads = map[string]bool{
+	"pushint": true, "pushbytes": true, "intc": true, "bytec": true,
+	"load": true, "arg": true, "txn": true, "txna": true, "gtxn": true, "gtxna": true,
+}
+
+// constantBlock describes intcblock or bytecblock, and the opcodes that use it.
+type constantBlock struct {
+	block string // intcblock or bytecblock
+	ref   string // intc or bytec, also the prefix of intc_0 to intc_3
+	push  string // pushint or pushbytes
+	// encode appends the encoding of a constant as an immediate
+	encode func(out []byte, i int) []byte
+	count  int
+	pc     int // pc of the block, -1 if the program has none that can be reordered
+}
+
+// optimizer holds what is known about the program being optimized.
+type optimizer struct {
+	a *analyzer
+	// branch targets and callsub return points, where control may enter
+	// other than from the previous instruction
+	entries map[int]bool
+
+	intc  []uint64
+	bytec [][]byte
+	ints  constantBlock
+	bytes constantBlock
+}
+
+func optimizePass(program []byte, offsetToSource map[int]SourceLocation) ([]byte, map[int]SourceLocation, bool) {
+	a, err := newAnalyzer(program, nil)
+	if err != nil || a.entry == len(program) {
+		return program, offsetToSource, false
+	}
+	a.function(a.entry)
+	o := &optimizer{a: a, entries: make(map[int]bool)}
+	for _, insn := range a.instructions {
+		for _, target := range insn.targets {
+			o.entries[target] = true
+		}
+		if insn.spec.Name == "callsub" {
+			o.entries[insn.next] = true
+		}
+	}
+	o.findConstants()
+
+	// firstIndex maps the pc of every instruction that is kept, or that
+	// starts rewritten instructions, to its index in out.
+	firstIndex := make(map[int]int)
+	var out []*optInstruction
+	changed := false
+	for i := 0; i < len(a.order); {
+		pc := a.order[i]
+		firstIndex[pc] = len(out)
+		n, replacement := o.rewrite(i)
+		if n > 0 && len(replacement) == 0 && i+n == len(a.order) && a.version <= 1 {
+			// v1 programs may not branch to their end, which a branch to
+			// the removed instructions would become
+			n = 0
+		}
+		if n == 0 {
+			out = append(out, o.keep(a.instructions[pc]))
+			i++
+			continue
+		}
+		for _, r := range replacement {
+			r.source = pc
+		}
+		out = append(out, replacement...)
+		i += n
+		changed = true
+	}
+	firstIndex[len(program)] = len(out)
+
+	if o.reorderConstants(out, &o.ints) {
+		changed = true
+	}
+	if o.reorderConstants(out, &o.bytes) {
+		changed = true
+	}
+	if !changed {
+		return program, offsetToSource, false
+	}
+	newProgram, newOffsetToSource := o.encode(out, firstIndex, offsetToSource)
+	return newProgram, newOffsetToSource, true
+}
+
+// findConstants decodes the intcblock and bytecblock, if the program starts
+// with them and has no others, so that their references are known constants
+// and the blocks may be reordered.
+func (o *optimizer) findConstants() {
+	program := o.a.program
+	o.ints = constantBlock{block: "intcblock", ref: "intc", push: "pushint", pc: -1,
+		encode: func(out []byte, i int) []byte { return binary.AppendUvarint(out, o.intc[i]) }}
+	o.bytes = constantBlock{block: "bytecblock", ref: "bytec", push: "pushbytes", pc: -1,
+		encode: func(out []byte, i int) []byte {
+			out = binary.AppendUvarint(out, uint64(len(o.bytec[i])))
+			return append(out, o.bytec[i]...)
+		}}
+
+	leading := true
+	blocks := 0
+	for _, pc := range o.a.order {
+		switch o.a.instructions[pc].spec.Name {
+		case "intcblock":
+			blocks++
+			if leading && o.ints.pc == -1 {
+				o.intc, _, _ = parseIntImmArgs(program, pc+1)
+				o.ints.pc, o.ints.count = pc, len(o.intc)
+				continue
+			}
+			o.ints.pc = -2
+		case "bytecblock":
+			blocks++
+			if leading && o.bytes.pc == -1 {
+				o.bytec, _, _ = parseByteImmArgs(program, pc+1)
+				o.bytes.pc, o.bytes.count = pc, len(o.bytec)
+				continue
+			}
+			o.bytes.pc = -2
+		}
+		leading = false
+	}
+	if o.ints.pc < 0 {
+		o.ints.pc, o.intc = -1, nil
+	}
+	if o.bytes.pc < 0 {
+		o.bytes.pc, o.bytec = -1, nil
+	}
+}
+
+// has reports whether the program's version has the named opcode.
+func (o *optimizer) has(name string) bool {
+	_, ok := OpsByName[o.a.version][name]
+	return ok
+}
+
+func (o *optimizer) make(name string, immediates ...byte) *optInstruction {
+	spec := OpsByName[o.a.version][name]
+	return &optInstruction{spec: &spec, immediates: immediates}
+}
+
+func (o *optimizer) keep(insn *instruction) *optInstruction {
+	oi := &optInstruction{spec: insn.spec, targets: insn.targets, source: insn.pc}
+	if len(insn.targets) == 0 {
+		oi.immediates = o.a.program[insn.pc+1 : insn.next]
+	}
+	return oi
+}
+
+// stack returns the types known to be on the stack before the instruction at
+// pc, all of which are present whenever control reaches it.
+func (o *optimizer) stack(pc int) StackTypes {
+	var found *flowState
+	for _, f := range o.a.functions {
+		if s, ok := f.states[pc]; ok {
+			if found != nil {
+				return nil // reached as part of more than one function
+			}
+			found = s
+		}
+	}
+	if found == nil {
+		return nil
+	}
+	return found.known.stack
+}
+
+func topIs(stack StackTypes, t avmType) bool {
+	return len(stack) > 0 && stack[len(stack)-1].AVMType == t
+}
+
+// constantRef returns the index into the constant block used by insn, if it
+// is a reference to cb.
+func (o *optimizer) constantRef(spec *OpSpec, immediates []byte, cb *constantBlock) (int, bool) {
+	switch spec.Name {
+	case cb.ref:
+		return int(immediates[0]), true
+	case cb.ref + "_0", cb.ref + "_1", cb.ref + "_2", cb.ref + "_3":
+		return int(spec.Name[len(spec.Name)-1] - '0'), true
+	}
+	return 0, false
+}
+
+// uintConstant returns the value pushed by insn, if it pushes a known uint64.
+func (o *optimizer) uintConstant(insn *instruction) (uint64, bool) {
+	if insn.spec.Name == "pushint" {
+		value, _ := binary.Uvarint(o.a.program[insn.pc+1:])
+		return value, true
+	}
+	i, ok := o.constantRef(insn.spec, o.a.program[insn.pc+1:insn.next], &o.ints)
+	if !ok || i >= len(o.intc) {
+		return 0, false
+	}
+	return o.intc[i], true
+}
+
+// rewrite returns the number of instructions, starting with the ith, that are
+// replaced, and what they are replaced with. It returns 0 if there is nothing
+// to rewrite there.
+func (o *optimizer) rewrite(i int) (int, []*optInstruction) {
+	insn := o.a.instructions[o.a.order[i]]
+	stack := o.stack(insn.pc)
+
+	switch insn.spec.Name {
+	case "b":
+		// to the next instruction
+		if insn.targets[0] == insn.next {
+			return 1, nil
+		}
+	case "bz", "bnz":
+		if insn.targets[0] == insn.next && topIs(stack, avmUint64) {
+			return 1, []*optInstruction{o.make("pop")}
+		}
+	}
+
+	if i+1 >= len(o.a.order) || o.entries[o.a.order[i+1]] {
+		return 0, nil
+	}
+	next := o.a.instructions[o.a.order[i+1]]
+	switch {
+	case insn.spec.Name == "dup" && next.spec.Name == "pop" && len(stack) >= 1:
+		return 2, nil
+	case insn.spec.Name == "swap" && next.spec.Name == "swap" && len(stack) >= 2:
+		return 2, nil
+	case insn.spec.Name == "!" && next.spec.Name == "bnz" && o.has("bz"):
+		return 2, []*optInstruction{{spec: o.make("bz").spec, targets: next.targets}}
+	case insn.spec.Name == "!" && next.spec.Name == "bz":
+		return 2, []*optInstruction{{spec: o.make("bnz").spec, targets: next.targets}}
+	}
+
+	if value, ok := o.uintConstant(insn); ok && topIs(stack, avmUint64) {
+		if identity, ok := identities[next.spec.Name]; ok && identity == value {
+			return 2, nil
+		}
+		if value == 0 && next.spec.Name == "==" {
+			return 2, []*optInstruction{o.make("!")}
+		}
+	}
+
+	program := o.a.program
+	if (pureLoads[insn.spec.Name] || o.isConstantRef(insn)) && insn.next-insn.pc > 1 &&
+		bytes.Equal(program[insn.pc:insn.next], program[next.pc:next.next]) {
+		return 2, []*optInstruction{o.keep(insn), o.make("dup")}
+	}
+	return 0, nil
+}
+
+func (o *optimizer) isConstantRef(insn *instruction) bool {
+	immediates := o.a.program[insn.pc+1 : insn.next]
+	_, isInt := o.constantRef(insn.spec, immediates, &o.ints)
+	_, isBytes := o.constantRef(insn.spec, immediates, &o.bytes)
+	return isInt || isBytes
+}
+
+// reorderConstants sorts the constants of cb by how often they are used, so
+// that the most used get the short opcodes, replaces constants used once with
+// a push, and drops unused constants. It reports whether that changed out.
+func (o *optimizer) reorderConstants(out []*optInstruction, cb *constantBlock) bool {
+	if cb.pc == -1 {
+		return false
+	}
+	freqs := make([]int, cb.count)
+	for _, oi := range out {
+		if oi == nil {
+			continue
+		}
+		if i, ok := o.constantRef(oi.spec, oi.immediates, cb); ok && i < cb.count {
+			freqs[i]++
+		}
+	}
+	order := make([]int, cb.count)
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return freqs[order[i]] > freqs[order[j]]
+	})
+	pushes := o.has(cb.push)
+	newIndex := make([]int, cb.count)
+	var kept []int
+	for _, i := range order {
+		newIndex[i] = -1
+		if freqs[i] > 1 || freqs[i] == 1 && !pushes {
+			newIndex[i] = len(kept)
+			kept = append(kept, i)
+		}
+	}
+	changed := len(kept) != cb.count
+	for i, k := range kept {
+		changed = changed || i != k
+	}
+	if !changed {
+		return false
+	}
+
+	for j, oi := range out {
+		if oi == nil {
+			continue
+		}
+		if oi.spec.Name == cb.block && oi.source == cb.pc {
+			if len(kept) == 0 {
+				out[j] = nil
+				continue
+			}
+			immediates := binary.AppendUvarint(nil, uint64(len(kept)))
+			for _, i := range kept {
+				immediates = cb.encode(immediates, i)
+			}
+			out[j] = &optInstruction{spec: oi.spec, immediates: immediates, source: oi.source}
+			continue
+		}
+		i, ok := o.constantRef(oi.spec, oi.immediates, cb)
+		if !ok || i >= cb.count {
+			continue
+		}
+		var replacement *optInstruction
+		switch k := newIndex[i]; {
+		case k == -1:
+			replacement = o.make(cb.push, cb.encode(nil, i)...)
+		case k < 4:
+			replacement = o.make(cb.ref + "_" + string(rune('0'+k)))
+		default:
+			replacement = o.make(cb.ref, byte(k))
+		}
+		replacement.source = oi.source
+		out[j] = replacement
+	}
+	return true
+}
+
+// encode lays out out, resolving branch targets through firstIndex, and maps
+// the source locations of the instructions to their new pcs.
+func (o *optimizer) encode(out []*optInstruction, firstIndex map[int]int, offsetToSource map[int]SourceLocation) ([]byte, map[int]SourceLocation) {
+	pcs := make([]int, len(out)+1)
+	pcs[0] = o.a.entry
+	for i, oi := range out {
+		pcs[i+1] = pcs[i] + oi.size()
+	}
+	newPC := func(target int) int {
+		return pcs[firstIndex[target]]
+	}
+
+	program := make([]byte, 0, pcs[len(out)])
+	program = append(program, o.a.program[:o.a.entry]...)
+	newOffsetToSource := make(map[int]SourceLocation, len(out))
+	for i, oi := range out {
+		if oi == nil {
+			continue
+		}
+		if loc, ok := offsetToSource[oi.source]; ok {
+			newOffsetToSource[pcs[i]] = loc
+		}
+		program = append(program, oi.spec.Opcode)
+		program = append(program, oi.immediates...)
+		if len(oi.spec.Immediates) == 0 {
+			continue
+		}
+		switch oi.spec.Immediates[0].kind {
+		case immLabel:
+			offset := newPC(oi.targets[0]) - pcs[i+1]
+			program = append(program, byte(offset>>8), byte(offset))
+		case immLabels:
+			program = append(program, byte(len(oi.targets)))
+			for _, target := range oi.targets {
+				offset := newPC(target) - pcs[i+1]
+				program = append(program, byte(offset>>8), byte(offset))
+			}
+		}
+	}
+	return program, newOffsetToSource
+}
@@ -0,0 +1,283 @@
+// Copyright (C) 2019-2024 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package logic
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Quarkonium-chain/go-quarkonium/data/transactions"
+	"github.com/Quarkonium-chain/go-quarkonium/test/partitiontest"
+)
+
+// optimized assembles source as written, and with #pragma optimize 1.
+func optimized(t *testing.T, source string, ver uint64) (plain *OpStream, opt *OpStream) {
+	t.Helper()
+	return testProg(t, source, ver), testProg(t, "#pragma optimize 1\n"+source, ver)
+}
+
+func TestOptimizeRewrites(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	cases := []struct {
+		source   string
+		expected string
+	}{
+		{"pushint 5; pushint 0; +; return", "pushint 5; return"},
+		{"pushint 5; pushint 1; *; pushint 1; exp; return", "pushint 5; return"},
+		{"txn Fee; dup; pop; return", "txn Fee; return"},
+		{"pushint 5; pushint 6; swap; swap; -; return", "pushint 5; pushint 6; -; return"},
+		{"txn Fee; pushint 0; ==; return", "txn Fee; !; return"},
+		{"txn Fee; !; bnz a; err; a: pushint 1", "txn Fee; bz a; err; a: pushint 1"},
+		{"txn Fee; !; bz a; err; a: pushint 1", "txn Fee; bnz a; err; a: pushint 1"},
+		{"pushint 1; b a; a: return", "pushint 1; return"},
+		{"txn Fee; bz a; a: pushint 1; return", "txn Fee; pop; pushint 1; return"},
+		{"txn Fee; txn Fee; ==; return", "txn Fee; dup; ==; return"},
+		{"pushbytes 0x0102; pushbytes 0x0102; ==; return", "pushbytes 0x0102; dup; ==; return"},
+		// a removed instruction exposes another rewrite
+		{"pushint 5; dup; pushint 0; +; pop; return", "pushint 5; return"},
+		// branches over rewritten instructions are re-encoded
+		{"txn Fee; bnz a; pushint 2; pushint 0; +; return; a: pushint 1; return",
+			"txn Fee; bnz a; pushint 2; return; a: pushint 1; return"},
+		{"pushint 1; switch a; err; a: pushint 2; pushint 1; /; return",
+			"pushint 1; switch a; err; a: pushint 2; return"},
+		// constant blocks are sorted by use, and unused or single use constants dropped
+		{"intcblock 7 8 9; intc_2; intc_2; *; intc_0; +; return",
+			"intcblock 9; intc_0; intc_0; *; pushint 7; +; return"},
+		{"bytecblock 0x01 0x02; bytec_1; len; return", "pushbytes 0x02; len; return"},
+		{"intcblock 0 9; txn Fee; intc_0; ==; intc_1; intc_1; +; +; return",
+			"intcblock 9; txn Fee; !; intc_0; intc_0; +; +; return"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.source, func(t *testing.T) {
+			source := strings.ReplaceAll(tc.source, "; ", "\n")
+			plain, opt := optimized(t, source, 10)
+			expected := testProg(t, strings.ReplaceAll(tc.expected, "; ", "\n"), 10)
+			require.Equal(t, expected.Program, opt.Program, "%s", mustDisassemble(t, opt.Program))
+			require.Less(t, len(opt.Program), len(plain.Program))
+		})
+	}
+}
+
+func TestOptimizeUnchanged(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	cases := []struct {
+		source  string
+		version uint64
+	}{
+		// arg 0 is not a uint64, so these fail as written
+		{"#pragma typetrack false; arg 0; pushint 0; +; return", 10},
+		{"#pragma typetrack false; arg 0; bz a; a: pushint 1; return", 10},
+		// a branch enters between the instructions
+		{"pushint 1; txn Fee; bnz a; dup; a: pop; return", 10},
+		// the stack height in a subroutine without proto is not known
+		{"pushint 1; pushint 2; callsub s; return; s: swap; swap; retsub", 10},
+		// loads that are a single byte gain nothing from dup
+		{"arg_0; arg_0; ==; return", 10},
+		// v1 has no bz
+		{"txn Fee; !; bnz a; err; a: int 1", 1},
+		// already in order
+		{"intcblock 5 6; intc_0; intc_0; intc_1; intc_1; +; +; +; return", 10},
+	}
+	for _, tc := range cases {
+		t.Run(tc.source, func(t *testing.T) {
+			plain, opt := optimized(t, strings.ReplaceAll(tc.source, "; ", "\n"), tc.version)
+			require.Equal(t, plain.Program, opt.Program)
+		})
+	}
+}
+
+func TestOptimizeSourceMap(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	_, opt := optimized(t, `
+txn Fee
+pushint 0
++
+bnz done
+err
+done:
+pushint 1
+`, 10)
+	expected := testProg(t, "txn Fee\nbnz done\nerr\ndone:\npushint 1", 10)
+	require.Equal(t, expected.Program, opt.Program)
+	lines := make(map[int]int)
+	for pc, loc := range opt.OffsetToSource {
+		lines[pc] = loc.Line
+	}
+	// The pragma line is first, so source lines are 1 more than the lines above.
+	require.Equal(t, map[int]int{1: 2, 3: 5, 6: 6, 7: 8}, lines)
+}
+
+func mustDisassemble(t *testing.T, program []byte) string {
+	t.Helper()
+	dis, err := Disassemble(program)
+	require.NoError(t, err)
+	return dis
+}
+
+func TestOptimizePragma(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	testProg(t, "#pragma optimize", 10, exp(1, "no optimize value"))
+	testProg(t, "#pragma optimize 1 2", 10, exp(1, "unexpected extra tokens: 2"))
+	testProg(t, "#pragma optimize on", 10, exp(1, `bad #pragma optimize: "on"`))
+	testProg(t, "#pragma optimize 2", 10, exp(1, "unsupported optimize level: 2"))
+
+	source := "#pragma version 10\ntxn Fee\npushint 0\n+\n"
+	plain, err := AssembleStringWithOptions(source, AssembleOptions{})
+	require.NoError(t, err)
+	opt, err := AssembleStringWithOptions(source, AssembleOptions{Optimize: 1})
+	require.NoError(t, err)
+	require.Less(t, len(opt.Program), len(plain.Program))
+	pragma, err := AssembleString("#pragma optimize 1\n" + source)
+	require.NoError(t, err)
+	require.Equal(t, opt.Program, pragma.Program)
+	off, err := AssembleStringWithOptions(strings.Replace(source, "\n", "\n#pragma optimize 0\n", 1),
+		AssembleOptions{Optimize: 1})
+	require.NoError(t, err)
+	require.Equal(t, plain.Program, off.Program)
+}
+
+// evalOutcome is what differential tests compare between a program and its
+// optimized form.
+type evalOutcome struct {
+	checked bool
+	pass    bool
+	failed  bool
+	cost    int
+}
+
+func evalSigOutcome(program []byte, fee uint64, arg uint64) evalOutcome {
+	var txn transactions.SignedTxn
+	txn.Txn.Fee.Raw = fee
+	txn.Lsig.Args = [][]byte{binary.BigEndian.AppendUint64(nil, arg)}
+	txn.Lsig.Logic = program
+	ep := defaultSigParams(txn)
+	var out evalOutcome
+	out.checked = CheckSignature(0, ep) == nil
+	pass, cx, err := EvalSignatureFull(0, ep)
+	out.pass = pass
+	out.failed = err != nil
+	if cx != nil {
+		out.cost = cx.Cost()
+	}
+	return out
+}
+
+// requireSameEval evaluates source as written and optimized, with a range of
+// inputs, and requires the same outcome at no more cost.
+func requireSameEval(t *testing.T, source string) {
+	t.Helper()
+	plain, opt := optimized(t, source, 10)
+	for _, fee := range []uint64{0, 1, 1000} {
+		for _, arg := range []uint64{0, 1, 5, 64, 1 << 63} {
+			expected := evalSigOutcome(plain.Program, fee, arg)
+			actual := evalSigOutcome(opt.Program, fee, arg)
+			require.Equal(t, expected.checked, actual.checked, "check, fee=%d arg=%d:\n%s", fee, arg, source)
+			require.Equal(t, expected.pass, actual.pass, "pass, fee=%d arg=%d:\n%s", fee, arg, source)
+			require.Equal(t, expected.failed, actual.failed, "error, fee=%d arg=%d:\n%s", fee, arg, source)
+			require.LessOrEqual(t, actual.cost, expected.cost, "cost, fee=%d arg=%d:\n%s", fee, arg, source)
+		}
+	}
+}
+
+func TestOptimizeDifferential(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	sources := []string{
+		"arg 0; btoi; pushint 0; +; txn Fee; pushint 1; *; ==; return",
+		"arg 0; btoi; !; bnz zero; pushint 1; return; zero: txn Fee; pushint 0; ==",
+		"txn Fee; bz a; a: arg 0; btoi; dup; pop; pushint 5; swap; swap; <",
+		"arg 0; btoi; pushint 1; shl; pushint 0; shr; pushint 2; /; arg 0; btoi; ==",
+		"intcblock 1 0 5; arg 0; btoi; intc_2; ==; intc_0; intc_0; ==; &&; bnz yes; intc_1; return; yes: intc_0",
+		"txn Fee; txn Fee; *; arg 0; btoi; arg 0; btoi; -; +; callsub s; return; s: dup; pop; retsub",
+		"arg 0; btoi; pushint 1; b a; a: exp; switch x y; pushint 0; return; x: pushint 1; return; y: err",
+	}
+	for _, source := range sources {
+		requireSameEval(t, strings.ReplaceAll(source, "; ", "\n"))
+	}
+
+	rnd := rand.New(rand.NewSource(1))
+	for i := 0; i < 300; i++ {
+		requireSameEval(t, randomUintProgram(rnd))
+	}
+}
+
+// randomUintProgram generates a program that operates on uint64s, and tends to
+// contain what the optimizer rewrites. It may fail, by overflow or division by
+// zero, but never for lack of stack.
+func randomUintProgram(rnd *rand.Rand) string {
+	var sb strings.Builder
+	sb.WriteString("intcblock 0 1 5 7 9\n")
+	height := 0
+	labels := 0
+	for n := 5 + rnd.Intn(20); n > 0; n-- {
+		switch choice := rnd.Intn(12); {
+		case choice < 4 || height == 0:
+			loads := []string{"pushint 0", "pushint 1", "pushint 3", "txn Fee", "arg 0; btoi",
+				"intc_0", "intc_1", "intc 2", "intc 4"}
+			load := loads[rnd.Intn(len(loads))]
+			sb.WriteString(load + "\n")
+			height++
+			if rnd.Intn(3) == 0 {
+				sb.WriteString(load + "\n")
+				height++
+			}
+		case choice < 7 && height >= 2:
+			binops := []string{"+", "-", "*", "/", "|", "^", "==", "shl", "shr", "exp", "<"}
+			sb.WriteString(binops[rnd.Intn(len(binops))] + "\n")
+			height--
+		case choice == 7:
+			sb.WriteString([]string{"dup\npop\n", "!\n", "dup\n"}[rnd.Intn(3)])
+			if strings.HasSuffix(sb.String(), "dup\n") && !strings.HasSuffix(sb.String(), "pop\ndup\n") {
+				height++
+			}
+		case choice == 8 && height >= 2:
+			sb.WriteString("swap\nswap\n")
+		case choice == 9:
+			labels++
+			fmt.Fprintf(&sb, "%s l%d\nl%d:\n", []string{"bz", "bnz", "!\nbz", "!\nbnz"}[rnd.Intn(4)], labels, labels)
+			height--
+		case choice == 10:
+			labels++
+			fmt.Fprintf(&sb, "b l%d\nl%d:\n", labels, labels)
+		default:
+			labels++
+			fmt.Fprintf(&sb, "!\nbnz l%d\nintc_1\nreturn\nl%d:\n", labels, labels)
+			height--
+		}
+	}
+	for ; height > 1; height-- {
+		sb.WriteString("+\n")
+	}
+	if height == 0 {
+		sb.WriteString("intc_1\n")
+	}
+	return sb.String()
+}
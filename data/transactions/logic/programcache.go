@@ -0,0 +1,179 @@
+// Copyright (C) 2019-2024 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package logic
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/Quarkonium-chain/go-quarkonium/crypto"
+	"github.com/Quarkonium-chain/go-quarkonium/util/metrics"
+)
+
+var programCacheHits = metrics.MakeCounter(metrics.MetricName{Name: "algod_ledger_logic_program_cache_hits", Description: "Total logicsig checks answered by the program cache"})
+var programCacheMisses = metrics.MakeCounter(metrics.MetricName{Name: "algod_ledger_logic_program_cache_misses", Description: "Total logicsig checks that had to check the program"})
+var programCacheEvictions = metrics.MakeCounter(metrics.MetricName{Name: "algod_ledger_logic_program_cache_evictions", Description: "Total programs evicted from the program cache"})
+
+// costAt is the static cost of a program up to pc
+type costAt struct {
+	pc   int
+	cost int
+}
+
+// constBlock is a decoded intcblock or bytecblock
+type constBlock struct {
+	intc   []uint64
+	bytec  [][]byte
+	nextpc int
+}
+
+// checkedProgram is the result of statically checking a program. It only
+// holds what does not depend on the transaction group or on the consensus
+// parameters: check still verifies the program version, and the static cost
+// of programs before v4 against the budget of the group.
+type checkedProgram struct {
+	program []byte
+	mode    RunMode
+	// err is the error of checking the steps of the program, if any
+	err error
+	// staticCosts is the cost of the program after each step checked, before v4
+	staticCosts []costAt
+	// constantBlocks are the constant blocks of the program, by pc
+	constantBlocks map[int]constBlock
+}
+
+// result returns the error check would return for the program with a static
+// cost budget of maxCost
+func (cp *checkedProgram) result(maxCost int) error {
+	for _, c := range cp.staticCosts {
+		if c.cost > maxCost {
+			return fmt.Errorf("pc=%3d static cost budget of %d exceeded", c.pc, maxCost)
+		}
+	}
+	return cp.err
+}
+
+// decodeConstants decodes the instruction at pc if it is a constant block.
+// The instruction has been checked, so it decodes without error.
+func (cp *checkedProgram) decodeConstants(version uint64, pc int) {
+	var block constBlock
+	switch opsByOpcode[version][cp.program[pc]].Name {
+	case "intcblock":
+		block.intc, block.nextpc, _ = parseIntImmArgs(cp.program, pc+1)
+	case "bytecblock":
+		block.bytec, block.nextpc, _ = parseByteImmArgs(cp.program, pc+1)
+	default:
+		return
+	}
+	if cp.constantBlocks == nil {
+		cp.constantBlocks = make(map[int]constBlock)
+	}
+	cp.constantBlocks[pc] = block
+}
+
+// constants returns the decoded constant block at pc. cp may be nil.
+func (cp *checkedProgram) constants(pc int) (constBlock, bool) {
+	if cp == nil {
+		return constBlock{}, false
+	}
+	block, ok := cp.constantBlocks[pc]
+	return block, ok
+}
+
+type programCacheKey struct {
+	hash crypto.Digest
+	mode RunMode
+}
+
+// ProgramCache keeps the results of statically checking programs, so that
+// the many transactions signed by the same logicsig, such as those of an
+// escrow account, check it once. It holds up to twice its size of programs,
+// in two generations: when the current one is full, it replaces the previous
+// one, and the programs of the previous one that are used again move to the
+// current one.
+type ProgramCache struct {
+	mu       deadlock.Mutex
+	size     int
+	current  map[programCacheKey]*checkedProgram
+	previous map[programCacheKey]*checkedProgram
+}
+
+// MakeProgramCache creates a ProgramCache of size programs
+func MakeProgramCache(size int) *ProgramCache {
+	return &ProgramCache{
+		size:     size,
+		current:  make(map[programCacheKey]*checkedProgram, size),
+		previous: make(map[programCacheKey]*checkedProgram),
+	}
+}
+
+// Len returns the number of programs in the cache
+func (pc *ProgramCache) Len() int {
+	pc.mu.Lock()
+	defer pc.mu.Unlock()
+	return len(pc.current) + len(pc.previous)
+}
+
+// lookup returns the checked program of cx, which begin() has set up,
+// checking it if it is not in the cache
+func (pc *ProgramCache) lookup(cx *EvalContext) *checkedProgram {
+	key := programCacheKey{hash: HashProgram(cx.program), mode: cx.runMode}
+
+	pc.mu.Lock()
+	checked, ok := pc.current[key]
+	if !ok {
+		checked, ok = pc.previous[key]
+		if ok {
+			pc.insert(key, checked)
+		}
+	}
+	pc.mu.Unlock()
+	if ok && bytes.Equal(checked.program, cx.program) {
+		programCacheHits.Inc(nil)
+		return checked
+	}
+
+	programCacheMisses.Inc(nil)
+	// The program is copied so the cache keeps no transaction alive, and
+	// the decoded byte constants point into the copy.
+	checked = &checkedProgram{program: bytes.Clone(cx.program), mode: cx.runMode}
+	checker := EvalContext{
+		EvalParams: cx.EvalParams,
+		runMode:    cx.runMode,
+		program:    checked.program,
+		version:    cx.version,
+		pc:         cx.pc,
+	}
+	checked.err = checker.checkSteps(0, checked)
+
+	pc.mu.Lock()
+	pc.insert(key, checked)
+	pc.mu.Unlock()
+	return checked
+}
+
+func (pc *ProgramCache) insert(key programCacheKey, checked *checkedProgram) {
+	if len(pc.current) >= pc.size {
+		programCacheEvictions.AddUint64(uint64(len(pc.previous)), nil)
+		pc.previous = pc.current
+		pc.current = make(map[programCacheKey]*checkedProgram, pc.size)
+	}
+	pc.current[key] = checked
+	delete(pc.previous, key)
+}
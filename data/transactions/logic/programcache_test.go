@@ -0,0 +1,208 @@
+// Copyright (C) 2019-2024 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package logic
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Quarkonium-chain/go-quarkonium/config"
+	"github.com/Quarkonium-chain/go-quarkonium/data/transactions"
+	"github.com/Quarkonium-chain/go-quarkonium/test/partitiontest"
+)
+
+// programCacheCorpus returns programs of every version, those the fuzzer
+// generates, and broken copies of them
+func programCacheCorpus(t *testing.T, rng *rand.Rand, fuzzed int) [][]byte {
+	sources := []string{
+		"int 1",
+		"int 1; int 2; int 3; +; +; int 6; ==",
+		"intcblock 7 8 9; bytecblock 0x01 0x0203; intc_1; intc_2; <; bytec_1; len; int 2; ==; &&",
+		"intcblock 7 8; intc_0; intcblock 3 4; intc_0; ==; !",
+		"byte 0x00; sha256; sha256; sha256; sha256; sha256; len; int 32; ==",
+		"int 1; bnz skip; err; skip: int 1",
+		"arg 0; len; int 0; ==",
+		"txn Fee; int 1000; <=",
+	}
+	var corpus [][]byte
+	for _, source := range sources {
+		for v := uint64(1); v <= LogicVersion; v++ {
+			ops, err := AssembleStringWithVersion(source, v)
+			if err == nil {
+				corpus = append(corpus, ops.Program)
+			}
+		}
+	}
+	for v := uint64(4); v <= LogicVersion; v++ {
+		ops, err := AssembleStringWithVersion("intcblock 2 1; intc_0; loop: intc_1; -; dup; bnz loop; intc_1; +", v)
+		require.NoError(t, err)
+		corpus = append(corpus, ops.Program)
+	}
+	for i := 0; i < fuzzed; i++ {
+		data := make([]byte, 256)
+		rng.Read(data)
+		ops, err := AssembleString(fuzzProgramSource(generateFuzzProgram(data)))
+		require.NoError(t, err)
+		corpus = append(corpus, ops.Program)
+	}
+	for _, program := range corpus[:len(corpus):len(corpus)] {
+		broken := append([]byte(nil), program...)
+		broken[rng.Intn(len(broken))] = byte(rng.Intn(256))
+		corpus = append(corpus, broken, program[:rng.Intn(len(program))+1])
+	}
+	return corpus
+}
+
+func sameError(t *testing.T, expected, actual error, msgAndArgs ...interface{}) {
+	t.Helper()
+	var pe panicError
+	if errors.As(expected, &pe) {
+		// the stack trace of a panic differs
+		require.ErrorAs(t, actual, &pe, msgAndArgs...)
+		return
+	}
+	if expected == nil {
+		require.NoError(t, actual, msgAndArgs...)
+		return
+	}
+	require.EqualError(t, actual, expected.Error(), msgAndArgs...)
+}
+
+// TestProgramCacheMatchesCheck requires that checking and evaluating logicsigs
+// with a ProgramCache, whether the program is found in the cache or not, gives
+// the same results as without a cache.
+func TestProgramCacheMatchesCheck(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	rng := rand.New(rand.NewSource(20))
+	fuzzed := 300
+	if testing.Short() {
+		fuzzed = 50
+	}
+	corpus := programCacheCorpus(t, rng, fuzzed)
+
+	protos := map[string]protoOpt{
+		"default": nil,
+		"cheap":   func(p *config.ConsensusParams) { p.LogicSigMaxCost = 40 },
+		"pooled": func(p *config.ConsensusParams) {
+			p.LogicSigMaxCost = 20
+			p.EnableLogicSigCostPooling = true
+		},
+		"v3": protoVer(3),
+	}
+	cache := MakeProgramCache(len(corpus))
+	for name, opt := range protos {
+		for i, program := range corpus {
+			for round := 0; round < 2; round++ {
+				msg := fmt.Sprintf("%s program %d %x round %d", name, i, program, round)
+				txns := []transactions.SignedTxn{{Lsig: transactions.LogicSig{Logic: program, Args: [][]byte{{}}}}, {}}
+
+				plain := optSigParams(opt, txns...)
+				plain.Trace = nil
+				cached := optSigParams(opt, txns...)
+				cached.Trace = nil
+				cached.ProgramCache = cache
+
+				err := CheckSignature(0, plain)
+				sameError(t, err, CheckSignature(0, cached), msg)
+				if err != nil {
+					continue
+				}
+				if round == 1 {
+					require.NotNil(t, cached.checkedSigs[0], msg)
+				}
+
+				pass, cx, err := EvalSignatureFull(0, plain)
+				cachedPass, cachedCx, cachedErr := EvalSignatureFull(0, cached)
+				sameError(t, err, cachedErr, msg)
+				require.Equal(t, pass, cachedPass, msg)
+				require.Equal(t, cx.Cost(), cachedCx.Cost(), msg)
+				require.Equal(t, cx.Stack, cachedCx.Stack, msg)
+			}
+		}
+	}
+}
+
+func TestProgramCache(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	// not parallel, to count the cache metrics
+
+	ops := testProg(t, "intcblock 5 6; bytecblock 0x07; intc_1; bytec_0; len; ==; !", LogicVersion)
+	sigParams := func(program []byte, cache *ProgramCache, opt protoOpt) *EvalParams {
+		ep := optSigParams(opt, transactions.SignedTxn{Lsig: transactions.LogicSig{Logic: program}})
+		ep.Trace = nil
+		ep.ProgramCache = cache
+		return ep
+	}
+
+	cache := MakeProgramCache(2)
+	hits := programCacheHits.GetUint64Value()
+	misses := programCacheMisses.GetUint64Value()
+
+	ep := sigParams(ops.Program, cache, nil)
+	require.NoError(t, CheckSignature(0, ep))
+	checked := ep.checkedSigs[0]
+	require.Equal(t, []uint64{5, 6}, checked.constantBlocks[1].intc)
+	require.Equal(t, [][]byte{{7}}, checked.constantBlocks[5].bytec)
+	pass, cx, err := EvalSignatureFull(0, ep)
+	require.NoError(t, err)
+	require.True(t, pass)
+	require.Equal(t, checked, cx.checked)
+
+	// the same program in another transaction is a hit
+	ep = sigParams(append([]byte(nil), ops.Program...), cache, nil)
+	require.NoError(t, CheckSignature(0, ep))
+	require.Same(t, checked, ep.checkedSigs[0])
+	require.Equal(t, hits+1, programCacheHits.GetUint64Value())
+	require.Equal(t, misses+1, programCacheMisses.GetUint64Value())
+
+	// a cached program is still checked against the consensus parameters
+	ep = sigParams(ops.Program, cache, protoVer(LogicVersion-1))
+	require.ErrorContains(t, CheckSignature(0, ep), "greater than protocol supported version")
+	require.Nil(t, ep.checkedSigs)
+
+	// a logicsig that changed after its check is evaluated without the cache
+	ep = sigParams(append([]byte(nil), ops.Program...), cache, nil)
+	require.NoError(t, CheckSignature(0, ep))
+	ep.TxnGroup[0].Lsig.Logic[4] = 1 // intc_1 is now 1, the length of bytec_0
+	pass, cx, err = EvalSignatureFull(0, ep)
+	require.NoError(t, err)
+	require.False(t, pass)
+	require.Nil(t, cx.checked)
+
+	// the cache keeps at most two generations of 2 programs
+	for i := 0; i < 5; i++ {
+		program := testProg(t, fmt.Sprintf("int %d", i+1), LogicVersion).Program
+		require.NoError(t, CheckSignature(0, sigParams(program, cache, nil)))
+		require.LessOrEqual(t, cache.Len(), 4)
+	}
+	require.NoError(t, CheckSignature(0, sigParams(ops.Program, cache, nil)))
+	require.Equal(t, misses+7, programCacheMisses.GetUint64Value())
+
+	// tracing programs skips the cache
+	ep = sigParams(ops.Program, cache, nil)
+	ep.Trace = &strings.Builder{}
+	require.NoError(t, CheckSignature(0, ep))
+	require.Nil(t, ep.checkedSigs)
+	require.Contains(t, ep.Trace.String(), "intcblock")
+}
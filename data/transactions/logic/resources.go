@@ -95,6 +95,8 @@ func (r *resources) fill(tx *transactions.Transaction, ep *EvalParams) {
 	case protocol.StateProofTx:
 		// state proof txns add nothing to availability (they can't even appear
 		// in a group with an appl. but still.)
+	case protocol.HeartbeatTx:
+		r.fillHeartbeat(&tx.Header, tx.HeartbeatTxnFields)
 	default:
 		panic(tx.Type)
 	}
@@ -130,6 +132,13 @@ func (r *resources) fillKeyRegistration(hdr *transactions.Header) {
 	r.sharedAccounts[hdr.Sender] = struct{}{}
 }
 
+func (r *resources) fillHeartbeat(hdr *transactions.Header, tx *transactions.HeartbeatTxnFields) {
+	r.sharedAccounts[hdr.Sender] = struct{}{}
+	if tx != nil {
+		r.sharedAccounts[tx.HbAddress] = struct{}{}
+	}
+}
+
 func (r *resources) fillPayment(hdr *transactions.Header, tx *transactions.PaymentTxnFields) {
 	r.sharedAccounts[hdr.Sender] = struct{}{}
 	r.sharedAccounts[tx.Receiver] = struct{}{}
@@ -18,6 +18,7 @@ package logic
 
 import (
 	"bytes"
+	"fmt"
 	"strings"
 )
 
@@ -41,6 +42,7 @@ type SourceMap struct {
 
 // GetSourceMap returns a struct containing details about
 // the assembled file and encoded mappings to the source file.
+// sourceNames are indexed by SourceLocation.File, see OpStream.SourceNames.
 func GetSourceMap(sourceNames []string, offsetToLocation map[int]SourceLocation) SourceMap {
 	maxPC := 0
 	for pc := range offsetToLocation {
@@ -54,7 +56,7 @@ func GetSourceMap(sourceNames []string, offsetToLocation map[int]SourceLocation)
 	pcToLine := make([]string, maxPC+1)
 	for pc := range pcToLine {
 		if location, ok := offsetToLocation[pc]; ok {
-			pcToLine[pc] = MakeSourceMapLine(0, 0, location.Line-prevSourceLocation.Line, location.Column-prevSourceLocation.Column)
+			pcToLine[pc] = MakeSourceMapLine(0, location.File-prevSourceLocation.File, location.Line-prevSourceLocation.Line, location.Column-prevSourceLocation.Column)
 			prevSourceLocation = location
 		} else {
 			pcToLine[pc] = ""
@@ -92,3 +94,56 @@ func MakeSourceMapLine(tcol, sindex, sline, scol int) string {
 	intToVLQ(scol, buf)
 	return buf.String()
 }
+
+// vlqToInts decodes the values of a source map mapping's line entry.
+func vlqToInts(segment string) ([]int, error) {
+	var values []int
+	value, shift := 0, 0
+	for i := 0; i < len(segment); i++ {
+		digit := strings.IndexByte(b64table, segment[i])
+		if digit < 0 {
+			return nil, fmt.Errorf("invalid VLQ character %q", segment[i])
+		}
+		value |= (digit & 31) << shift
+		if digit&32 != 0 {
+			shift += 5
+			continue
+		}
+		if value&1 != 0 {
+			values = append(values, -(value >> 1))
+		} else {
+			values = append(values, value>>1)
+		}
+		value, shift = 0, 0
+	}
+	if shift != 0 {
+		return nil, fmt.Errorf("truncated VLQ value in %q", segment)
+	}
+	return values, nil
+}
+
+// PCLocations decodes the mappings of the source map into the source
+// location of every mapped PC. It is the inverse of GetSourceMap.
+func (s SourceMap) PCLocations() (map[int]SourceLocation, error) {
+	locations := make(map[int]SourceLocation)
+	var location SourceLocation
+	for pc, line := range strings.Split(s.Mappings, ";") {
+		if line == "" {
+			continue
+		}
+		// only the first segment of a line matters, a PC maps to one location.
+		segment, _, _ := strings.Cut(line, ",")
+		values, err := vlqToInts(segment)
+		if err != nil {
+			return nil, err
+		}
+		if len(values) < 4 {
+			return nil, fmt.Errorf("mapping of pc %d has %d fields instead of 4", pc, len(values))
+		}
+		location.File += values[1]
+		location.Line += values[2]
+		location.Column += values[3]
+		locations[pc] = location
+	}
+	return locations, nil
+}
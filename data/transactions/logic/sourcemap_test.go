@@ -45,6 +45,15 @@ func TestGetSourceMap(t *testing.T) {
 	a.Equal(sourceNames, actualSourceMap.Sources)
 	a.Equal([]string{}, actualSourceMap.Names)
 	a.Equal(";AACA;AACA;;;AACA;AAAC;AACD;AACK;AAAC;AACN", actualSourceMap.Mappings)
+
+	locations, err := actualSourceMap.PCLocations()
+	a.NoError(err)
+	a.Equal(offsetToLocation, locations)
+
+	_, err = SourceMap{Mappings: "AAC"}.PCLocations()
+	a.Error(err)
+	_, err = SourceMap{Mappings: "AA!A"}.PCLocations()
+	a.Error(err)
 }
 
 func TestVLQ(t *testing.T) {
@@ -58,4 +67,10 @@ func TestVLQ(t *testing.T) {
 	a.Equal("AAgBA", MakeSourceMapLine(0, 0, 16, 0))
 	a.Equal("AAggBA", MakeSourceMapLine(0, 0, 512, 0))
 	a.Equal("ADggBD", MakeSourceMapLine(0, -1, 512, -1))
+
+	values, err := vlqToInts("ADggBD")
+	a.NoError(err)
+	a.Equal([]int{0, -1, 512, -1}, values)
+	_, err = vlqToInts("Ag")
+	a.Error(err)
 }
@@ -198,3 +198,77 @@ func (n NullEvalTracer) AfterOpcode(cx *EvalContext, evalError error) {}
 
 // AfterBlock does nothing
 func (n NullEvalTracer) AfterBlock(hdr *bookkeeping.BlockHeader) {}
+
+// MultiEvalTracer implements EvalTracer by calling the hooks of each of its
+// tracers, in order.
+type MultiEvalTracer []EvalTracer
+
+// BeforeBlock calls BeforeBlock on each tracer
+func (m MultiEvalTracer) BeforeBlock(hdr *bookkeeping.BlockHeader) {
+	for _, t := range m {
+		t.BeforeBlock(hdr)
+	}
+}
+
+// BeforeTxnGroup calls BeforeTxnGroup on each tracer
+func (m MultiEvalTracer) BeforeTxnGroup(ep *EvalParams) {
+	for _, t := range m {
+		t.BeforeTxnGroup(ep)
+	}
+}
+
+// AfterTxnGroup calls AfterTxnGroup on each tracer
+func (m MultiEvalTracer) AfterTxnGroup(ep *EvalParams, deltas *ledgercore.StateDelta, evalError error) {
+	for _, t := range m {
+		t.AfterTxnGroup(ep, deltas, evalError)
+	}
+}
+
+// BeforeTxn calls BeforeTxn on each tracer
+func (m MultiEvalTracer) BeforeTxn(ep *EvalParams, groupIndex int) {
+	for _, t := range m {
+		t.BeforeTxn(ep, groupIndex)
+	}
+}
+
+// AfterTxn calls AfterTxn on each tracer
+func (m MultiEvalTracer) AfterTxn(ep *EvalParams, groupIndex int, ad transactions.ApplyData, evalError error) {
+	for _, t := range m {
+		t.AfterTxn(ep, groupIndex, ad, evalError)
+	}
+}
+
+// BeforeProgram calls BeforeProgram on each tracer
+func (m MultiEvalTracer) BeforeProgram(cx *EvalContext) {
+	for _, t := range m {
+		t.BeforeProgram(cx)
+	}
+}
+
+// AfterProgram calls AfterProgram on each tracer
+func (m MultiEvalTracer) AfterProgram(cx *EvalContext, pass bool, evalError error) {
+	for _, t := range m {
+		t.AfterProgram(cx, pass, evalError)
+	}
+}
+
+// BeforeOpcode calls BeforeOpcode on each tracer
+func (m MultiEvalTracer) BeforeOpcode(cx *EvalContext) {
+	for _, t := range m {
+		t.BeforeOpcode(cx)
+	}
+}
+
+// AfterOpcode calls AfterOpcode on each tracer
+func (m MultiEvalTracer) AfterOpcode(cx *EvalContext, evalError error) {
+	for _, t := range m {
+		t.AfterOpcode(cx, evalError)
+	}
+}
+
+// AfterBlock calls AfterBlock on each tracer
+func (m MultiEvalTracer) AfterBlock(hdr *bookkeeping.BlockHeader) {
+	for _, t := range m {
+		t.AfterBlock(hdr)
+	}
+}
@@ -494,6 +494,66 @@ func BenchmarkUnmarshalHeader(b *testing.B) {
 	}
 }
 
+func TestMarshalUnmarshalHeartbeatTxnFields(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	v := HeartbeatTxnFields{}
+	bts := v.MarshalMsg(nil)
+	left, err := v.UnmarshalMsg(bts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(left) > 0 {
+		t.Errorf("%d bytes left over after UnmarshalMsg(): %q", len(left), left)
+	}
+
+	left, err = msgp.Skip(bts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(left) > 0 {
+		t.Errorf("%d bytes left over after Skip(): %q", len(left), left)
+	}
+}
+
+func TestRandomizedEncodingHeartbeatTxnFields(t *testing.T) {
+	protocol.RunEncodingTest(t, &HeartbeatTxnFields{})
+}
+
+func BenchmarkMarshalMsgHeartbeatTxnFields(b *testing.B) {
+	v := HeartbeatTxnFields{}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		v.MarshalMsg(nil)
+	}
+}
+
+func BenchmarkAppendMsgHeartbeatTxnFields(b *testing.B) {
+	v := HeartbeatTxnFields{}
+	bts := make([]byte, 0, v.Msgsize())
+	bts = v.MarshalMsg(bts[0:0])
+	b.SetBytes(int64(len(bts)))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		bts = v.MarshalMsg(bts[0:0])
+	}
+}
+
+func BenchmarkUnmarshalHeartbeatTxnFields(b *testing.B) {
+	v := HeartbeatTxnFields{}
+	bts := v.MarshalMsg(nil)
+	b.ReportAllocs()
+	b.SetBytes(int64(len(bts)))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, err := v.UnmarshalMsg(bts)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
 func TestMarshalUnmarshalKeyregTxnFields(t *testing.T) {
 	partitiontest.PartitionTest(t)
 	v := KeyregTxnFields{}
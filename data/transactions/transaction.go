@@ -100,6 +100,11 @@ type Transaction struct {
 	AssetFreezeTxnFields
 	ApplicationCallTxnFields
 	StateProofTxnFields
+
+	// By making HeartbeatTxnFields a pointer we save a ton of space of the
+	// Transaction object. Unlike other txn types, the fields will be
+	// embedded under a named field in the transaction encoding.
+	HeartbeatTxnFields *HeartbeatTxnFields `codec:"hb"`
 }
 
 // ApplyData contains information about the transaction's execution.
@@ -346,6 +351,9 @@ var errNoteMustBeEmptyInStateproofTxn = errors.New("note must be empty in state-
 var errGroupMustBeZeroInStateproofTxn = errors.New("group must be zero in state-proof transaction")
 var errRekeyToMustBeZeroInStateproofTxn = errors.New("rekey must be zero in state-proof transaction")
 var errLeaseMustBeZeroInStateproofTxn = errors.New("lease must be zero in state-proof transaction")
+var errHeartbeatNotSupported = errors.New("heartbeat transaction not supported")
+var errHeartbeatFieldsMissing = errors.New("heartbeat transaction has no heartbeat fields")
+var errHeartbeatZeroKeyDilution = errors.New("heartbeat transaction must have a key dilution")
 
 // WellFormed checks that the transaction looks reasonable on its own (but not necessarily valid against the actual ledger). It does not check signatures.
 func (tx Transaction) WellFormed(spec SpecialAddresses, proto config.ConsensusParams) error {
@@ -565,6 +573,35 @@ func (tx Transaction) WellFormed(spec SpecialAddresses, proto config.ConsensusPa
 			return errLeaseMustBeZeroInStateproofTxn
 		}
 
+	case protocol.HeartbeatTx:
+		if !proto.Heartbeat {
+			return errHeartbeatNotSupported
+		}
+		if tx.HeartbeatTxnFields == nil {
+			return errHeartbeatFieldsMissing
+		}
+		if tx.HeartbeatTxnFields.HbKeyDilution == 0 {
+			return errHeartbeatZeroKeyDilution
+		}
+
+		// A heartbeat that does not pay the minimum fee is only useful to an
+		// account that is being challenged, so it must not do anything else.
+		if tx.Fee.Raw < proto.MinTxnFee && tx.Group.IsZero() {
+			kind := "free"
+			if tx.Fee.Raw > 0 {
+				kind = "cheap"
+			}
+			if len(tx.Note) > 0 {
+				return fmt.Errorf("tx.Note is set in %s heartbeat", kind)
+			}
+			if tx.Lease != [32]byte{} {
+				return fmt.Errorf("tx.Lease is set in %s heartbeat", kind)
+			}
+			if !tx.RekeyTo.IsZero() {
+				return fmt.Errorf("tx.RekeyTo is set in %s heartbeat", kind)
+			}
+		}
+
 	default:
 		return fmt.Errorf("unknown tx type %v", tx.Type)
 	}
@@ -598,6 +635,10 @@ func (tx Transaction) WellFormed(spec SpecialAddresses, proto config.ConsensusPa
 		nonZeroFields[protocol.StateProofTx] = true
 	}
 
+	if !tx.HeartbeatTxnFields.Empty() {
+		nonZeroFields[protocol.HeartbeatTx] = true
+	}
+
 	for t, nonZero := range nonZeroFields {
 		if nonZero && t != tx.Type {
 			return fmt.Errorf("transaction of type %v has non-zero fields for type %v", tx.Type, t)
@@ -723,6 +764,10 @@ func (tx Transaction) RelevantAddrs(spec SpecialAddresses) []basics.Address {
 		if !tx.AssetTransferTxnFields.AssetSender.IsZero() {
 			addrs = append(addrs, tx.AssetTransferTxnFields.AssetSender)
 		}
+	case protocol.HeartbeatTx:
+		if tx.HeartbeatTxnFields != nil {
+			addrs = append(addrs, tx.HeartbeatTxnFields.HbAddress)
+		}
 	}
 
 	return addrs
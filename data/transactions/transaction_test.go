@@ -1393,3 +1393,71 @@ func TestStateProofTxnShouldBeZero(t *testing.T) {
 	err = txn.WellFormed(SpecialAddresses{}, curProto)
 	require.NoError(t, err)
 }
+
+func TestWellFormedHeartbeatTxn(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	futureProto := config.Consensus[protocol.ConsensusFuture]
+	noHeartbeat := futureProto
+	noHeartbeat.Heartbeat = false
+	addr := basics.Address{0x01}
+
+	makeHb := func(fee uint64) Transaction {
+		return Transaction{
+			Type: protocol.HeartbeatTx,
+			Header: Header{
+				Sender:     addr,
+				Fee:        basics.MicroAlgos{Raw: fee},
+				FirstValid: 100,
+				LastValid:  110,
+			},
+			HeartbeatTxnFields: &HeartbeatTxnFields{
+				HbAddress:     addr,
+				HbKeyDilution: 100,
+			},
+		}
+	}
+
+	free := makeHb(0)
+	require.NoError(t, free.WellFormed(SpecialAddresses{}, futureProto))
+	require.ErrorIs(t, free.WellFormed(SpecialAddresses{}, noHeartbeat), errHeartbeatNotSupported)
+
+	missing := makeHb(0)
+	missing.HeartbeatTxnFields = nil
+	require.ErrorIs(t, missing.WellFormed(SpecialAddresses{}, futureProto), errHeartbeatFieldsMissing)
+
+	noDilution := makeHb(0)
+	noDilution.HeartbeatTxnFields.HbKeyDilution = 0
+	require.ErrorIs(t, noDilution.WellFormed(SpecialAddresses{}, futureProto), errHeartbeatZeroKeyDilution)
+
+	// free and cheap heartbeats can't carry anything extra
+	for _, fee := range []uint64{0, 1} {
+		txn := makeHb(fee)
+		txn.Note = []byte{0x01}
+		require.ErrorContains(t, txn.WellFormed(SpecialAddresses{}, futureProto), "tx.Note is set")
+
+		txn = makeHb(fee)
+		txn.Lease = [32]byte{0x01}
+		require.ErrorContains(t, txn.WellFormed(SpecialAddresses{}, futureProto), "tx.Lease is set")
+
+		txn = makeHb(fee)
+		txn.RekeyTo = basics.Address{0x02}
+		require.ErrorContains(t, txn.WellFormed(SpecialAddresses{}, futureProto), "tx.RekeyTo is set")
+	}
+
+	// but heartbeats that pay, or are grouped, can
+	paid := makeHb(futureProto.MinTxnFee)
+	paid.Note = []byte{0x01}
+	require.NoError(t, paid.WellFormed(SpecialAddresses{}, futureProto))
+
+	grouped := makeHb(0)
+	grouped.Group = crypto.Digest{0x01}
+	grouped.Note = []byte{0x01}
+	require.NoError(t, grouped.WellFormed(SpecialAddresses{}, futureProto))
+
+	// heartbeat fields are not allowed in other transaction types
+	pay := makeHb(futureProto.MinTxnFee)
+	pay.Type = protocol.PaymentTx
+	require.ErrorContains(t, pay.WellFormed(SpecialAddresses{}, futureProto), "has non-zero fields for type hb")
+}
@@ -80,6 +80,14 @@ type GroupContext struct {
 	evalParams      *logic.EvalParams
 }
 
+// logicSigProgramCacheSize is the number of logicsig programs whose static
+// checks are kept by logicSigProgramCache, per generation of the cache
+const logicSigProgramCacheSize = 1024
+
+// logicSigProgramCache is shared by the verification of all groups, so that a
+// logicsig signing many transactions, such as an escrow, is checked once.
+var logicSigProgramCache = logic.MakeProgramCache(logicSigProgramCacheSize)
+
 var errTxGroupInvalidFee = errors.New("txgroup fee requirement overflow")
 var errTxnSigHasNoSig = errors.New("signedtxn has no sig")
 var errTxnSigNotWellFormed = errors.New("signedtxn should only have one of Sig or Msig or LogicSig")
@@ -141,6 +149,7 @@ func PrepareGroupContext(group []transactions.SignedTxn, contextHdr *bookkeeping
 
 	ep := logic.NewSigEvalParams(group, &consensusParams, ledger)
 	ep.Tracer = evalTracer
+	ep.ProgramCache = logicSigProgramCache
 	return &GroupContext{
 		specAddrs: transactions.SpecialAddresses{
 			FeeSink:     contextHdr.FeeSink,
@@ -220,7 +229,11 @@ func txnGroupBatchPrep(stxs []transactions.SignedTxn, contextHdr *bookkeeping.Bl
 			prepErr.err = fmt.Errorf("transaction %+v invalid : %w", stxn, prepErr.err)
 			return nil, prepErr
 		}
-		if stxn.Txn.Type != protocol.StateProofTx {
+		// State proofs are free, and singleton heartbeats may be free or
+		// cheap. Whether a heartbeat's account is entitled to that is
+		// checked when the heartbeat is applied.
+		if stxn.Txn.Type != protocol.StateProofTx &&
+			(stxn.Txn.Type != protocol.HeartbeatTx || len(stxs) > 1) {
 			minFeeCount++
 		}
 		feesPaid = basics.AddSaturate(feesPaid, stxn.Txn.Fee.Raw)
@@ -253,6 +266,7 @@ const regularSig sigOrTxnType = 1
 const multiSig sigOrTxnType = 2
 const logicSig sigOrTxnType = 3
 const stateProofTxn sigOrTxnType = 4
+const heartbeatTxn sigOrTxnType = 5
 
 // checkTxnSigTypeCounts checks the number of signature types and reports an error in case of a violation
 func checkTxnSigTypeCounts(s *transactions.SignedTxn, groupIndex int) (sigType sigOrTxnType, err *TxGroupError) {
@@ -277,6 +291,15 @@ func checkTxnSigTypeCounts(s *transactions.SignedTxn, groupIndex int) (sigType s
 		if s.Txn.Sender == transactions.StateProofSender && s.Txn.Type == protocol.StateProofTx {
 			return stateProofTxn, nil
 		}
+		// Special case: a free heartbeat can be sent without any signature
+		// by the account it is for, since HbProof already shows that the
+		// sender holds the account's participation keys. The well-formed
+		// check ensures that such a heartbeat cannot have any other
+		// interesting fields.
+		if s.Txn.Type == protocol.HeartbeatTx && s.Txn.HeartbeatTxnFields != nil &&
+			s.Txn.Sender == s.Txn.HeartbeatTxnFields.HbAddress && s.Txn.Fee.IsZero() && s.Txn.Group.IsZero() {
+			return heartbeatTxn, nil
+		}
 		return 0, &TxGroupError{err: errTxnSigHasNoSig, GroupIndex: groupIndex, Reason: TxGroupErrorReasonHasNoSig}
 	}
 	if numSigCategories > 1 {
@@ -293,6 +316,10 @@ func stxnCoreChecks(gi int, groupCtx *GroupContext, batchVerifier crypto.BatchVe
 		return err
 	}
 
+	if s.Txn.Type == protocol.HeartbeatTx {
+		heartbeatProofBatchPrep(s.Txn.HeartbeatTxnFields, s.Txn.LastValid, batchVerifier)
+	}
+
 	switch sigType {
 	case regularSig:
 		batchVerifier.EnqueueSignature(crypto.SignatureVerifier(s.Authorizer()), s.Txn, s.Sig)
@@ -317,7 +344,7 @@ func stxnCoreChecks(gi int, groupCtx *GroupContext, batchVerifier crypto.BatchVe
 		}
 		return nil
 
-	case stateProofTxn:
+	case stateProofTxn, heartbeatTxn:
 		return nil
 
 	default:
@@ -325,6 +352,24 @@ func stxnCoreChecks(gi int, groupCtx *GroupContext, batchVerifier crypto.BatchVe
 	}
 }
 
+// heartbeatProofBatchPrep enqueues the signatures that make up HbProof, which
+// must be a signature of HbSeed by HbVoteID's one-time key for lastValid.
+func heartbeatProofBatchPrep(hb *transactions.HeartbeatTxnFields, lastValid basics.Round, batchVerifier crypto.BatchVerifier) {
+	id := basics.OneTimeIDForRound(lastValid, hb.HbKeyDilution)
+	offsetID := crypto.OneTimeSignatureSubkeyOffsetID{
+		SubKeyPK: hb.HbProof.PK,
+		Batch:    id.Batch,
+		Offset:   id.Offset,
+	}
+	batchID := crypto.OneTimeSignatureSubkeyBatchID{
+		SubKeyPK: hb.HbProof.PK2,
+		Batch:    id.Batch,
+	}
+	batchVerifier.EnqueueSignature(crypto.SignatureVerifier(hb.HbVoteID), batchID, crypto.Signature(hb.HbProof.PK2Sig))
+	batchVerifier.EnqueueSignature(crypto.SignatureVerifier(batchID.SubKeyPK), offsetID, crypto.Signature(hb.HbProof.PK1Sig))
+	batchVerifier.EnqueueSignature(crypto.SignatureVerifier(offsetID.SubKeyPK), hb.HbSeed, crypto.Signature(hb.HbProof.Sig))
+}
+
 // LogicSigSanityCheck checks that the signature is valid and that the program is basically well formed.
 // It does not evaluate the logic.
 func LogicSigSanityCheck(gi int, groupCtx *GroupContext) error {
@@ -440,6 +485,12 @@ func logicSigVerify(gi int, groupCtx *GroupContext) error {
 //
 // This version of verify is performing the verification over the provided execution pool.
 func PaysetGroups(ctx context.Context, payset [][]transactions.SignedTxn, blkHeader bookkeeping.BlockHeader, verificationPool execpool.BacklogPool, cache VerifiedTransactionCache, ledger logic.LedgerForSignature) (err error) {
+	return PaysetGroupsWithTracer(ctx, payset, blkHeader, verificationPool, cache, ledger, nil)
+}
+
+// PaysetGroupsWithTracer verifies a payset like PaysetGroups, while using a tracer for the logicsig evaluations.
+// Groups are verified concurrently, so the tracer must be safe for concurrent use.
+func PaysetGroupsWithTracer(ctx context.Context, payset [][]transactions.SignedTxn, blkHeader bookkeeping.BlockHeader, verificationPool execpool.BacklogPool, cache VerifiedTransactionCache, ledger logic.LedgerForSignature, evalTracer logic.EvalTracer) (err error) {
 	if len(payset) == 0 {
 		return nil
 	}
@@ -476,7 +527,7 @@ func PaysetGroups(ctx context.Context, payset [][]transactions.SignedTxn, blkHea
 
 					batchVerifier := crypto.MakeBatchVerifierWithHint(len(payset))
 					for i, signTxnsGrp := range txnGroups {
-						groupCtxs[i], grpErr = txnGroupBatchPrep(signTxnsGrp, &blkHeader, ledger, batchVerifier, nil)
+						groupCtxs[i], grpErr = txnGroupBatchPrep(signTxnsGrp, &blkHeader, ledger, batchVerifier, evalTracer)
 						// abort only if it's a non-cache error.
 						if grpErr != nil {
 							return grpErr
@@ -26,6 +26,7 @@ import (
 	"github.com/Quarkonium-chain/go-quarkonium/data/transactions"
 	"github.com/Quarkonium-chain/go-quarkonium/data/transactions/logic"
 	"github.com/Quarkonium-chain/go-quarkonium/ledger/ledgercore"
+	"github.com/Quarkonium-chain/go-quarkonium/protocol"
 	"github.com/Quarkonium-chain/go-quarkonium/util/execpool"
 )
 
@@ -236,20 +237,23 @@ func getNumberOfBatchableSigsInTxn(stx *transactions.SignedTxn, groupIndex int)
 	if err != nil {
 		return 0, err
 	}
+	var count uint64
 	switch sigType {
 	case regularSig:
-		return 1, nil
+		count = 1
 	case multiSig:
-		return uint64(stx.Msig.Signatures()), nil
+		count = uint64(stx.Msig.Signatures())
 	case logicSig:
 		// Currently the sigs in here are not batched. Something to consider later.
-		return 0, nil
-	case stateProofTxn:
-		return 0, nil
+	case stateProofTxn, heartbeatTxn:
 	default:
 		// this case is impossible
-		return 0, nil
 	}
+	if stx.Txn.Type == protocol.HeartbeatTx {
+		// the three signatures of HbProof
+		count += 3
+	}
+	return count, nil
 }
 
 func (tbp *txnSigBatchProcessor) postProcessVerifiedJobs(ctx interface{}, failed []bool, err error) {
@@ -30,6 +30,7 @@ import (
 	"github.com/Quarkonium-chain/go-quarkonium/crypto"
 	"github.com/Quarkonium-chain/go-quarkonium/data/basics"
 	"github.com/Quarkonium-chain/go-quarkonium/data/bookkeeping"
+	"github.com/Quarkonium-chain/go-quarkonium/data/committee"
 	"github.com/Quarkonium-chain/go-quarkonium/data/transactions"
 	"github.com/Quarkonium-chain/go-quarkonium/data/transactions/logic"
 	"github.com/Quarkonium-chain/go-quarkonium/data/transactions/logic/mocktracer"
@@ -340,6 +341,110 @@ func TestTxnValidationStateProof(t *testing.T) {
 	require.Error(t, err, "state proof txn %#v verified", stxn2)
 }
 
+func TestTxnValidationHeartbeat(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	proto := config.Consensus[protocol.ConsensusFuture]
+	voter := crypto.GenerateOneTimeSignatureSecrets(0, 10)
+	secret := keypair()
+	addr := basics.Address(secret.SignatureVerifier)
+
+	const lastValid = 110
+	const keyDilution = 100
+	seed := committee.Seed{0x01, 0x02}
+	id := basics.OneTimeIDForRound(lastValid, keyDilution)
+	stxn := transactions.SignedTxn{
+		Txn: transactions.Transaction{
+			Type: protocol.HeartbeatTx,
+			Header: transactions.Header{
+				Sender:     addr,
+				FirstValid: 100,
+				LastValid:  lastValid,
+			},
+			HeartbeatTxnFields: &transactions.HeartbeatTxnFields{
+				HbAddress:     addr,
+				HbProof:       voter.Sign(id, seed).ToHeartbeatProof(),
+				HbSeed:        seed,
+				HbVoteID:      voter.OneTimeSignatureVerifier,
+				HbKeyDilution: keyDilution,
+			},
+		},
+	}
+
+	blockHeader := &bookkeeping.BlockHeader{
+		RewardsState: bookkeeping.RewardsState{
+			FeeSink:     feeSink,
+			RewardsPool: poolAddr,
+		},
+		UpgradeState: bookkeeping.UpgradeState{
+			CurrentProtocol: protocol.ConsensusFuture,
+		},
+	}
+
+	// a free heartbeat from the account it is for needs no signature
+	_, err := TxnGroup([]transactions.SignedTxn{stxn}, blockHeader, nil, nil)
+	require.NoError(t, err)
+
+	// but its proof must be valid
+	bad := stxn
+	badFields := *stxn.Txn.HeartbeatTxnFields
+	badFields.HbSeed = committee.Seed{0x03}
+	bad.Txn.HeartbeatTxnFields = &badFields
+	_, err = TxnGroup([]transactions.SignedTxn{bad}, blockHeader, nil, nil)
+	require.Error(t, err)
+
+	bad = stxn
+	badFields = *stxn.Txn.HeartbeatTxnFields
+	badFields.HbVoteID = crypto.GenerateOneTimeSignatureSecrets(0, 1).OneTimeSignatureVerifier
+	bad.Txn.HeartbeatTxnFields = &badFields
+	_, err = TxnGroup([]transactions.SignedTxn{bad}, blockHeader, nil, nil)
+	require.Error(t, err)
+
+	// a heartbeat from another sender must be signed
+	bad = stxn
+	bad.Txn.Sender = basics.Address{0x01}
+	_, err = TxnGroup([]transactions.SignedTxn{bad}, blockHeader, nil, nil)
+	require.ErrorContains(t, err, errTxnSigHasNoSig.Error())
+
+	// as must a heartbeat that pays a fee
+	paid := stxn
+	paid.Txn.Fee = basics.MicroAlgos{Raw: proto.MinTxnFee}
+	_, err = TxnGroup([]transactions.SignedTxn{paid}, blockHeader, nil, nil)
+	require.ErrorContains(t, err, errTxnSigHasNoSig.Error())
+	_, err = TxnGroup([]transactions.SignedTxn{paid.Txn.Sign(secret)}, blockHeader, nil, nil)
+	require.NoError(t, err)
+
+	// a signed heartbeat still needs a valid proof
+	paid.Txn.HeartbeatTxnFields = &badFields
+	_, err = TxnGroup([]transactions.SignedTxn{paid.Txn.Sign(secret)}, blockHeader, nil, nil)
+	require.Error(t, err)
+
+	// heartbeats in a group must pay their share of the fees
+	pay := transactions.Transaction{
+		Type: protocol.PaymentTx,
+		Header: transactions.Header{
+			Sender:     addr,
+			Fee:        basics.MicroAlgos{Raw: proto.MinTxnFee},
+			FirstValid: 100,
+			LastValid:  lastValid,
+		},
+		PaymentTxnFields: transactions.PaymentTxnFields{Receiver: addr},
+	}
+	hb := stxn.Txn
+	group := []transactions.Transaction{pay, hb}
+	var txgroup transactions.TxGroup
+	for _, txn := range group {
+		txgroup.TxGroupHashes = append(txgroup.TxGroupHashes, crypto.Digest(txn.ID()))
+	}
+	for i := range group {
+		group[i].Group = crypto.HashObj(txgroup)
+	}
+	signed := []transactions.SignedTxn{group[0].Sign(secret), group[1].Sign(secret)}
+	_, err = TxnGroup(signed, blockHeader, nil, nil)
+	require.ErrorContains(t, err, "txgroup had 1000 in fees, which is less than the minimum 2 * 1000")
+}
+
 func TestDecodeNil(t *testing.T) {
 	partitiontest.PartitionTest(t)
 
@@ -843,6 +948,55 @@ byte base64 5rZMNsevs5sULO+54aN+OvU6lQ503z2X+SSYUABIx7E=
 	require.Greater(t, currentCounter, initCounter)
 }
 
+// TestLogicSigProgramCache makes sure that transactions of the same escrow
+// check its program once, with the same results as without the cache
+func TestLogicSigProgramCache(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	// not parallel, to replace logicSigProgramCache
+
+	_, signedTxn, _, _ := generateTestObjects(20, 20, 0, 50)
+	blkHdr := createDummyBlockHeader()
+	op, err := logic.AssembleString(`intcblock 2 3
+bytecblock 0x0102
+arg 0
+bytec_0
+==
+intc_0
+intc_1
+<
+&&`)
+	require.NoError(t, err)
+	program := logic.Program(op.Program)
+	for i := range signedTxn {
+		signedTxn[i].Lsig.Logic = op.Program
+		signedTxn[i].Txn.Sender = basics.Address(crypto.HashObj(&program))
+		signedTxn[i].Lsig.Args = [][]byte{{1, byte(1 + i%2)}}
+		signedTxn[i].Sig = crypto.Signature{}
+	}
+
+	defer func(cache *logic.ProgramCache) { logicSigProgramCache = cache }(logicSigProgramCache)
+	verifyAll := func() (errs []error) {
+		for i := range signedTxn {
+			_, err := TxnGroup(signedTxn[i:i+1], &blkHdr, nil, &DummyLedgerForSignature{})
+			errs = append(errs, err)
+		}
+		return errs
+	}
+
+	logicSigProgramCache = nil
+	expected := verifyAll()
+	logicSigProgramCache = logic.MakeProgramCache(4)
+	require.Equal(t, expected, verifyAll())
+	require.Equal(t, 1, logicSigProgramCache.Len())
+	for i, err := range expected {
+		if i%2 == 0 {
+			require.ErrorContains(t, err, "rejected by logic")
+		} else {
+			require.NoError(t, err)
+		}
+	}
+}
+
 // TestTxnGroupCacheUpdateLogicWithSig makes sure that a payment transaction contains logicsig signed with single signature is valid (and added to the cache) only
 // if the logic passes and the signature is correct.
 // for this, we will break the signature and make sure that txn verification fails.
@@ -73,13 +73,13 @@ var ErrInvalidLedger = errors.New("MakeTxHandler: ledger is nil on initializatio
 var transactionMessageTxPoolRememberCounter = metrics.NewTagCounter(
 	"algod_transaction_messages_txpool_remember_err_{TAG}", "Number of transaction messages not remembered by txpool b/c of {TAG}",
 	txPoolRememberTagCap, txPoolRememberPendingEval, txPoolRememberTagNoSpace, txPoolRememberTagFee, txPoolRememberTagTxnDead, txPoolRememberTagTxnEarly, txPoolRememberTagTooLarge, txPoolRememberTagGroupID,
-	txPoolRememberTagTxID, txPoolRememberTagLease, txPoolRememberTagTxIDEval, txPoolRememberTagLeaseEval, txPoolRememberTagEvalGeneric,
+	txPoolRememberTagTxID, txPoolRememberTagLease, txPoolRememberTagTxIDEval, txPoolRememberTagLeaseEval, txPoolRememberTagReplacementFee, txPoolRememberTagEvalGeneric,
 )
 
 var transactionMessageTxPoolCheckCounter = metrics.NewTagCounter(
 	"algod_transaction_messages_txpool_check_err_{TAG}", "Number of transaction messages that didn't pass check by txpool b/c of {TAG}",
 	txPoolRememberTagTxnNotWellFormed, txPoolRememberTagTxnDead, txPoolRememberTagTxnEarly, txPoolRememberTagTooLarge, txPoolRememberTagGroupID,
-	txPoolRememberTagTxID, txPoolRememberTagLease, txPoolRememberTagTxIDEval, txPoolRememberTagLeaseEval, txPoolRememberTagEvalGeneric,
+	txPoolRememberTagTxID, txPoolRememberTagLease, txPoolRememberTagTxIDEval, txPoolRememberTagLeaseEval, txPoolRememberTagReplacementFee, txPoolRememberTagEvalGeneric,
 )
 
 const (
@@ -97,6 +97,8 @@ const (
 	txPoolRememberTagLeaseEval   = "lease_eval"
 	txPoolRememberTagEvalGeneric = "eval"
 
+	txPoolRememberTagReplacementFee = "replacement_fee"
+
 	txPoolRememberTagTxnNotWellFormed = "not_well"
 )
 
@@ -428,6 +430,9 @@ func (handler *TxHandler) checkReportErrors(err error) {
 			transactionMessageTxPoolCheckCounter.Add(txPoolRememberTagLease, 1)
 		}
 		return
+	case *pools.ErrTxPoolReplacementFeeError:
+		transactionMessageTxPoolCheckCounter.Add(txPoolRememberTagReplacementFee, 1)
+		return
 	case *ledgercore.TxGroupMalformedError:
 		switch err.Reason {
 		case ledgercore.TxGroupMalformedErrorReasonExceedMaxSize:
@@ -490,6 +495,9 @@ func (handler *TxHandler) rememberReportErrors(err error) {
 			transactionMessageTxPoolRememberCounter.Add(txPoolRememberTagLease, 1)
 		}
 		return
+	case *pools.ErrTxPoolReplacementFeeError:
+		transactionMessageTxPoolRememberCounter.Add(txPoolRememberTagReplacementFee, 1)
+		return
 	case *ledgercore.TxGroupMalformedError:
 		switch err.Reason {
 		case ledgercore.TxGroupMalformedErrorReasonExceedMaxSize:
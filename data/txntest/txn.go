@@ -26,6 +26,7 @@ import (
 	"github.com/Quarkonium-chain/go-quarkonium/crypto/merklesignature"
 	"github.com/Quarkonium-chain/go-quarkonium/crypto/stateproof"
 	"github.com/Quarkonium-chain/go-quarkonium/data/basics"
+	"github.com/Quarkonium-chain/go-quarkonium/data/committee"
 	"github.com/Quarkonium-chain/go-quarkonium/data/stateproofmsg"
 	"github.com/Quarkonium-chain/go-quarkonium/data/transactions"
 	"github.com/Quarkonium-chain/go-quarkonium/data/transactions/logic"
@@ -91,6 +92,12 @@ type Txn struct {
 	StateProofType protocol.StateProofType
 	StateProof     stateproof.StateProof
 	StateProofMsg  stateproofmsg.Message
+
+	HbAddress     basics.Address
+	HbProof       crypto.HeartbeatProof
+	HbSeed        committee.Seed
+	HbVoteID      crypto.OneTimeSignatureVerifier
+	HbKeyDilution uint64
 }
 
 // internalCopy "finishes" a shallow copy done by a simple Go assignment by
@@ -218,6 +225,17 @@ func (tx Txn) Txn() transactions.Transaction {
 	case nil:
 		tx.Fee = basics.MicroAlgos{}
 	}
+
+	hb := &transactions.HeartbeatTxnFields{
+		HbAddress:     tx.HbAddress,
+		HbProof:       tx.HbProof,
+		HbSeed:        tx.HbSeed,
+		HbVoteID:      tx.HbVoteID,
+		HbKeyDilution: tx.HbKeyDilution,
+	}
+	if hb.MsgIsZero() {
+		hb = nil
+	}
 	return transactions.Transaction{
 		Type: tx.Type,
 		Header: transactions.Header{
@@ -281,6 +299,7 @@ func (tx Txn) Txn() transactions.Transaction {
 			StateProof:     tx.StateProof,
 			Message:        tx.StateProofMsg,
 		},
+		HeartbeatTxnFields: hb,
 	}
 }
 
@@ -591,6 +591,28 @@ func (au *accountUpdates) LookupWithoutRewards(rnd basics.Round, addr basics.Add
 	return
 }
 
+// lookupDeltasResources returns the creatable types of the resources of addr
+// which are modified by the in-memory deltas.
+func (au *accountUpdates) lookupDeltasResources(addr basics.Address) map[basics.CreatableIndex]basics.CreatableType {
+	au.accountsMu.RLock()
+	defer au.accountsMu.RUnlock()
+
+	resources := make(map[basics.CreatableIndex]basics.CreatableType)
+	for i := range au.deltas {
+		for _, rec := range au.deltas[i].Accts.GetAllAssetResources() {
+			if rec.Addr == addr {
+				resources[basics.CreatableIndex(rec.Aidx)] = basics.AssetCreatable
+			}
+		}
+		for _, rec := range au.deltas[i].Accts.GetAllAppResources() {
+			if rec.Addr == addr {
+				resources[basics.CreatableIndex(rec.Aidx)] = basics.AppCreatable
+			}
+		}
+	}
+	return resources
+}
+
 // GetCreatorForRound returns the creator for a given asset/app index at a given round
 func (au *accountUpdates) GetCreatorForRound(rnd basics.Round, cidx basics.CreatableIndex, ctype basics.CreatableType) (creator basics.Address, ok bool, err error) {
 	return au.getCreatorForRound(rnd, cidx, ctype, true /* take the lock */)
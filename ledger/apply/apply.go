@@ -25,6 +25,11 @@ import (
 	"github.com/Quarkonium-chain/go-quarkonium/ledger/ledgercore"
 )
 
+// HdrProvider allows fetching old block headers
+type HdrProvider interface {
+	BlockHdr(basics.Round) (bookkeeping.BlockHeader, error)
+}
+
 // StateProofsApplier allows fetching and updating state-proofs state on the ledger
 type StateProofsApplier interface {
 	BlockHdr(r basics.Round) (bookkeeping.BlockHeader, error)
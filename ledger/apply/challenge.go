@@ -0,0 +1,114 @@
+// Copyright (C) 2019-2024 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package apply
+
+import (
+	"math/bits"
+
+	"github.com/Quarkonium-chain/go-quarkonium/config"
+	"github.com/Quarkonium-chain/go-quarkonium/data/basics"
+	"github.com/Quarkonium-chain/go-quarkonium/data/committee"
+)
+
+// ChallengePeriod indicates which part of the challenge period is under discussion.
+type ChallengePeriod int
+
+const (
+	// ChRisky indicates that a challenge is in effect, and the initial grace period is running out.
+	// Challenged accounts may send a free heartbeat during this period.
+	ChRisky ChallengePeriod = iota
+	// ChActive indicates that a challenge is in effect, and the grace period
+	// has run out, so challenged accounts that have not responded can be suspended.
+	ChActive
+)
+
+// Challenge is issued once every ChallengeInterval rounds. Online accounts
+// whose addresses match the first Bits of Seed must propose or heartbeat to
+// stay online.
+type Challenge struct {
+	// Round is when the challenge occurred. 0 means this is not a challenge.
+	Round basics.Round
+	// accounts that match the first `Bits` of `Seed` must propose or heartbeat to stay online
+	Seed committee.Seed
+	Bits int
+}
+
+// FindChallenge returns the Challenge that was last issued if it's in the period requested.
+func FindChallenge(rules config.ProposerPayoutRules, current basics.Round, headers HdrProvider, period ChallengePeriod) (Challenge, error) {
+	// are challenges active?
+	interval := basics.Round(rules.ChallengeInterval)
+	if rules.ChallengeInterval == 0 || current < interval {
+		return Challenge{}, nil
+	}
+	lastChallenge := current - (current % interval)
+	grace := basics.Round(rules.ChallengeGracePeriod)
+	switch period {
+	case ChRisky:
+		// the challenge is risky until the first grace period ends
+		if current <= lastChallenge || current > lastChallenge+grace {
+			return Challenge{}, nil
+		}
+	case ChActive:
+		// challenge is in effect if we're after one grace period, but before the 2nd ends.
+		if current <= lastChallenge+grace || current > lastChallenge+2*grace {
+			return Challenge{}, nil
+		}
+	}
+	challengeHdr, err := headers.BlockHdr(lastChallenge)
+	if err != nil {
+		return Challenge{}, err
+	}
+	challengeProto := config.Consensus[challengeHdr.CurrentProtocol]
+	// challenge is not considered if rules have changed since that round
+	if challengeProto.Payouts != rules {
+		return Challenge{}, nil
+	}
+	return Challenge{lastChallenge, challengeHdr.Seed, rules.ChallengeBits}, nil
+}
+
+// IsZero returns true if the Challenge is empty (used to indicate no challenge)
+func (ch Challenge) IsZero() bool {
+	return ch == Challenge{}
+}
+
+// Failed returns true iff ch is in effect, matches address, and lastSeen is
+// before the challenge issue.
+func (ch Challenge) Failed(address basics.Address, lastSeen basics.Round) bool {
+	return ch.Round != 0 && bitsMatch(ch.Seed[:], address[:], ch.Bits) && lastSeen < ch.Round
+}
+
+// bitsMatch checks if the first n bits of two byte slices match. Written to
+// work on arbitrary slices, but we expect that n is small. Only user today
+// calls with n=5.
+func bitsMatch(a, b []byte, n int) bool {
+	// Ensure n is a valid number of bits to compare
+	if n < 0 || n > len(a)*8 || n > len(b)*8 {
+		return false
+	}
+
+	// Compare entire bytes when n is bigger than 8
+	for i := 0; i < n/8; i++ {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	remaining := n % 8
+	if remaining == 0 {
+		return true
+	}
+	return bits.LeadingZeros8(a[n/8]^b[n/8]) >= remaining
+}
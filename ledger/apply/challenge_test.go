@@ -0,0 +1,103 @@
+// Copyright (C) 2019-2024 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package apply
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Quarkonium-chain/go-quarkonium/config"
+	"github.com/Quarkonium-chain/go-quarkonium/data/basics"
+	"github.com/Quarkonium-chain/go-quarkonium/data/bookkeeping"
+	"github.com/Quarkonium-chain/go-quarkonium/protocol"
+	"github.com/Quarkonium-chain/go-quarkonium/test/partitiontest"
+)
+
+func TestBitsMatch(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	for b := 0; b <= 6; b++ {
+		require.True(t, bitsMatch([]byte{0x1}, []byte{0x2}, b), "%d", b)
+	}
+	require.False(t, bitsMatch([]byte{0x1}, []byte{0x2}, 7))
+	require.False(t, bitsMatch([]byte{0x1}, []byte{0x2}, 8))
+	require.False(t, bitsMatch([]byte{0x1}, []byte{0x2}, 9))
+
+	for b := 0; b <= 12; b++ {
+		require.True(t, bitsMatch([]byte{0x1, 0xff, 0xaa}, []byte{0x1, 0xf0}, b), "%d", b)
+	}
+	require.False(t, bitsMatch([]byte{0x1, 0xff, 0xaa}, []byte{0x1, 0xf0}, 13))
+
+	// on a byte boundary
+	require.True(t, bitsMatch([]byte{0x1}, []byte{0x1}, 8))
+	require.False(t, bitsMatch([]byte{0x1}, []byte{0x1}, 9))
+	require.True(t, bitsMatch([]byte{0x1, 0xff}, []byte{0x1, 0x00}, 8))
+	require.False(t, bitsMatch([]byte{0x1, 0xff}, []byte{0x1, 00}, 9))
+}
+
+type singleSource bookkeeping.BlockHeader
+
+func (ss singleSource) BlockHdr(r basics.Round) (bookkeeping.BlockHeader, error) {
+	return bookkeeping.BlockHeader(ss), nil
+}
+
+type failingSource struct{}
+
+func (failingSource) BlockHdr(r basics.Round) (bookkeeping.BlockHeader, error) {
+	return bookkeeping.BlockHeader{}, fmt.Errorf("no header for round %d", r)
+}
+
+func TestFindRiskyChallenge(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+	a := assert.New(t)
+
+	nowHeader := bookkeeping.BlockHeader{
+		UpgradeState: bookkeeping.UpgradeState{
+			CurrentProtocol: protocol.ConsensusFuture,
+		},
+	}
+	now := config.Consensus[nowHeader.CurrentProtocol]
+	interval := basics.Round(now.Payouts.ChallengeInterval)
+	grace := basics.Round(now.Payouts.ChallengeGracePeriod)
+	inChallenge := interval + grace + 1
+
+	// the risky period is the grace period before the challenge is active
+	for r := basics.Round(1); r <= interval; r++ {
+		ch, err := FindChallenge(now.Payouts, r, singleSource(nowHeader), ChRisky)
+		a.NoError(err)
+		a.True(ch.IsZero(), r)
+	}
+	for r := interval + 1; r < inChallenge; r++ {
+		ch, err := FindChallenge(now.Payouts, r, singleSource(nowHeader), ChRisky)
+		a.NoError(err)
+		a.Equal(interval, ch.Round, r)
+	}
+	ch, err := FindChallenge(now.Payouts, inChallenge, singleSource(nowHeader), ChRisky)
+	a.NoError(err)
+	a.True(ch.IsZero())
+
+	// a missing challenge header is an error, not the absence of a challenge
+	_, err = FindChallenge(now.Payouts, inChallenge, failingSource{}, ChActive)
+	a.Error(err)
+	_, err = FindChallenge(now.Payouts, inChallenge-1, failingSource{}, ChRisky)
+	a.Error(err)
+}
@@ -0,0 +1,104 @@
+// Copyright (C) 2019-2024 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package apply
+
+import (
+	"fmt"
+
+	"github.com/Quarkonium-chain/go-quarkonium/data/basics"
+	"github.com/Quarkonium-chain/go-quarkonium/data/transactions"
+)
+
+// Heartbeat applies a Heartbeat transaction using the Balances interface.
+func Heartbeat(hb transactions.HeartbeatTxnFields, header transactions.Header, balances Balances, provider HdrProvider, round basics.Round) error {
+	// Get the account's balance entry
+	account, err := balances.Get(hb.HbAddress, false)
+	if err != nil {
+		return err
+	}
+
+	if account.Status != basics.Online {
+		return fmt.Errorf("heartbeat for %v is not allowed when %v", hb.HbAddress, account.Status)
+	}
+
+	// txnGroupBatchPrep does not charge for singleton heartbeats. They are
+	// only meant for accounts that are being challenged, so an underpaid
+	// heartbeat is rejected unless the account is under challenge.
+	proto := balances.ConsensusParams()
+	if header.Fee.Raw < proto.MinTxnFee && header.Group.IsZero() {
+		kind := "free"
+		if header.Fee.Raw > 0 {
+			kind = "cheap"
+		}
+		ch, err := FindChallenge(proto.Payouts, round, provider, ChRisky)
+		if err != nil {
+			return err
+		}
+		if ch.IsZero() {
+			ch, err = FindChallenge(proto.Payouts, round, provider, ChActive)
+			if err != nil {
+				return err
+			}
+		}
+		if ch.IsZero() {
+			return fmt.Errorf("%s heartbeat for %v is not allowed with no challenge", kind, hb.HbAddress)
+		}
+		if !ch.Failed(hb.HbAddress, account.LastSeen()) {
+			return fmt.Errorf("%s heartbeat for %v is not challenged by %+v", kind, hb.HbAddress, ch)
+		}
+	}
+
+	// The heartbeat must be signed with the account's _current_ participation
+	// key. Unlike agreement, which looks back 320 rounds for voting keys, a
+	// heartbeat only shows that the keys the account is registered with right
+	// now are in use. An account that changes its keys invalidates any
+	// heartbeats it has already sent.
+
+	// The signed message is the seed of the round before FirstValid, to
+	// prevent presigning a bunch of heartbeats for later use, which would
+	// keep an unavailable account online.
+	hdr, err := provider.BlockHdr(header.FirstValid - 1)
+	if err != nil {
+		return err
+	}
+	if hdr.Seed != hb.HbSeed {
+		return fmt.Errorf("provided seed %v does not match round %d's seed %v",
+			hb.HbSeed, header.FirstValid-1, hdr.Seed)
+	}
+	if account.VoteID != hb.HbVoteID {
+		return fmt.Errorf("provided voter ID %v does not match %v's voter ID %v",
+			hb.HbVoteID, hb.HbAddress, account.VoteID)
+	}
+	keyDilution := account.VoteKeyDilution
+	if keyDilution == 0 {
+		keyDilution = proto.DefaultKeyDilution
+	}
+	if keyDilution != hb.HbKeyDilution {
+		return fmt.Errorf("provided key dilution %d does not match %v's key dilution %d",
+			hb.HbKeyDilution, hb.HbAddress, keyDilution)
+	}
+
+	account.LastHeartbeat = round
+
+	// Write the updated entry
+	err = balances.Put(hb.HbAddress, account)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,192 @@
+// Copyright (C) 2019-2024 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package apply
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Quarkonium-chain/go-quarkonium/crypto"
+	"github.com/Quarkonium-chain/go-quarkonium/data/basics"
+	"github.com/Quarkonium-chain/go-quarkonium/data/bookkeeping"
+	"github.com/Quarkonium-chain/go-quarkonium/data/committee"
+	"github.com/Quarkonium-chain/go-quarkonium/data/transactions"
+	"github.com/Quarkonium-chain/go-quarkonium/protocol"
+	"github.com/Quarkonium-chain/go-quarkonium/test/partitiontest"
+)
+
+// seededHeaders provides block headers of the future protocol, each with a
+// distinct seed, except that the seed of challenge rounds is challengeSeed.
+type seededHeaders struct {
+	challengeSeed committee.Seed
+	interval      basics.Round
+}
+
+func (sh seededHeaders) BlockHdr(r basics.Round) (bookkeeping.BlockHeader, error) {
+	hdr := bookkeeping.BlockHeader{
+		Round:        r,
+		UpgradeState: bookkeeping.UpgradeState{CurrentProtocol: protocol.ConsensusFuture},
+	}
+	if r%sh.interval == 0 {
+		hdr.Seed = sh.challengeSeed
+	} else {
+		hdr.Seed = committee.Seed(crypto.Hash([]byte{byte(r), byte(r >> 8)}))
+	}
+	return hdr, nil
+}
+
+func TestHeartbeat(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	voter := crypto.GenerateOneTimeSignatureSecrets(0, 10)
+	sender := basics.Address{0x01}
+	hbAddr := basics.Address{0x02}
+
+	mockBal := makeMockBalancesWithAccounts(protocol.ConsensusFuture, map[basics.Address]basics.AccountData{
+		sender: {MicroAlgos: basics.MicroAlgos{Raw: 10_000_000}},
+		hbAddr: {
+			Status:          basics.Online,
+			MicroAlgos:      basics.MicroAlgos{Raw: 10_000_000},
+			VoteID:          voter.OneTimeSignatureVerifier,
+			VoteKeyDilution: 100,
+			LastProposed:    10,
+		},
+	})
+	proto := mockBal.ConsensusParams()
+	headers := seededHeaders{interval: basics.Round(proto.Payouts.ChallengeInterval)}
+
+	fv := basics.Round(150)
+	seed, err := headers.BlockHdr(fv - 1)
+	require.NoError(t, err)
+	hb := transactions.HeartbeatTxnFields{
+		HbAddress:     hbAddr,
+		HbSeed:        seed.Seed,
+		HbVoteID:      voter.OneTimeSignatureVerifier,
+		HbKeyDilution: 100,
+	}
+	header := transactions.Header{
+		Sender:     sender,
+		Fee:        basics.MicroAlgos{Raw: proto.MinTxnFee},
+		FirstValid: fv,
+		LastValid:  fv + 10,
+	}
+
+	// the fields must match the account and the chain
+	wrongSeed := hb
+	wrongSeed.HbSeed = committee.Seed{0x01}
+	err = Heartbeat(wrongSeed, header, mockBal, headers, fv+2)
+	require.ErrorContains(t, err, "provided seed")
+
+	wrongVoter := hb
+	wrongVoter.HbVoteID = crypto.GenerateOneTimeSignatureSecrets(0, 1).OneTimeSignatureVerifier
+	err = Heartbeat(wrongVoter, header, mockBal, headers, fv+2)
+	require.ErrorContains(t, err, "provided voter ID")
+
+	wrongDilution := hb
+	wrongDilution.HbKeyDilution = 101
+	err = Heartbeat(wrongDilution, header, mockBal, headers, fv+2)
+	require.ErrorContains(t, err, "provided key dilution")
+
+	offline := hb
+	offline.HbAddress = sender
+	err = Heartbeat(offline, header, mockBal, headers, fv+2)
+	require.ErrorContains(t, err, "is not allowed when Offline")
+
+	// a heartbeat that pays the fee can be sent at any time
+	err = Heartbeat(hb, header, mockBal, headers, fv+2)
+	require.NoError(t, err)
+	require.Equal(t, fv+2, mockBal.b[hbAddr].LastHeartbeat)
+	require.Zero(t, mockBal.b[sender].LastHeartbeat)
+}
+
+func TestFreeHeartbeat(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	voter := crypto.GenerateOneTimeSignatureSecrets(0, 10)
+	challenged := basics.Address{0xaa, 0x01}
+	unchallenged := basics.Address{0x55, 0x01}
+
+	online := basics.AccountData{
+		Status:          basics.Online,
+		MicroAlgos:      basics.MicroAlgos{Raw: 10_000_000},
+		VoteID:          voter.OneTimeSignatureVerifier,
+		VoteKeyDilution: 100,
+		LastProposed:    10,
+	}
+	mockBal := makeMockBalancesWithAccounts(protocol.ConsensusFuture, map[basics.Address]basics.AccountData{
+		challenged:   online,
+		unchallenged: online,
+	})
+	proto := mockBal.ConsensusParams()
+	interval := basics.Round(proto.Payouts.ChallengeInterval)
+	grace := basics.Round(proto.Payouts.ChallengeGracePeriod)
+	headers := seededHeaders{challengeSeed: committee.Seed{0xaa}, interval: interval}
+
+	heartbeat := func(addr basics.Address, fee uint64, round basics.Round) error {
+		hdr, err := headers.BlockHdr(round - 2)
+		require.NoError(t, err)
+		hb := transactions.HeartbeatTxnFields{
+			HbAddress:     addr,
+			HbSeed:        hdr.Seed,
+			HbVoteID:      voter.OneTimeSignatureVerifier,
+			HbKeyDilution: 100,
+		}
+		header := transactions.Header{
+			Sender:     addr,
+			Fee:        basics.MicroAlgos{Raw: fee},
+			FirstValid: round - 1,
+			LastValid:  round + 10,
+		}
+		return Heartbeat(hb, header, mockBal, headers, round)
+	}
+
+	// no challenge before the first challenge round
+	err := heartbeat(challenged, 0, interval-5)
+	require.ErrorContains(t, err, "free heartbeat")
+	require.ErrorContains(t, err, "no challenge")
+	err = heartbeat(challenged, 1, interval-5)
+	require.ErrorContains(t, err, "cheap heartbeat")
+
+	// the challenge does not match every account
+	err = heartbeat(unchallenged, 0, interval+5)
+	require.ErrorContains(t, err, "is not challenged")
+
+	// a challenged account can heartbeat for free during both grace periods
+	err = heartbeat(challenged, 0, interval+5)
+	require.NoError(t, err)
+	require.Equal(t, interval+5, mockBal.b[challenged].LastHeartbeat)
+
+	// once it has been seen, it is no longer challenged
+	err = heartbeat(challenged, 0, interval+grace+5)
+	require.ErrorContains(t, err, "is not challenged")
+
+	mockBal.b[challenged] = online
+	err = heartbeat(challenged, 0, interval+grace+5)
+	require.NoError(t, err)
+
+	// but not after the challenge is over
+	mockBal.b[challenged] = online
+	err = heartbeat(challenged, 0, interval+2*grace+5)
+	require.ErrorContains(t, err, "no challenge")
+
+	// paying the fee is always allowed
+	err = heartbeat(unchallenged, proto.MinTxnFee, interval+5)
+	require.NoError(t, err)
+}
@@ -108,6 +108,10 @@ type catchpointTracker struct {
 	// log copied from ledger
 	log logging.Logger
 
+	// l is the ledger the tracker was loaded from, used to look up the block
+	// headers committed to by balances trie proofs.
+	l ledgerForTracker
+
 	// Connection to the database.
 	dbs             trackerdb.Store
 	catchpointStore trackerdb.CatchpointReaderWriter
@@ -192,6 +196,128 @@ func (ct *catchpointTracker) GetLastCatchpointLabel() string {
 	return ct.lastCatchpointLabel
 }
 
+// proveBalancesTrieEntry builds an inclusion proof of the trie element
+// returned by makeLeaf. The leaf is built from the persisted account data, so
+// the proof is for the state of the tracker database round. The proof carries
+// a catchpoint label style commitment to the trie root at that round, see
+// ledgercore.BalancesTrieProof.Commit.
+func (ct *catchpointTracker) proveBalancesTrieEntry(makeLeaf func(ar trackerdb.AccountsReader) ([]byte, error)) (proof ledgercore.BalancesTrieProof, err error) {
+	if !ct.catchpointEnabled() {
+		return ledgercore.BalancesTrieProof{}, ledgercore.ErrBalancesTrieDisabled
+	}
+
+	var spContexts []ledgercore.StateProofVerificationContext
+	err = ct.dbs.Snapshot(func(ctx context.Context, tx trackerdb.SnapshotScope) error {
+		are, err := tx.MakeAccountsReader()
+		if err != nil {
+			return err
+		}
+		proof.Round, err = are.AccountsHashRound(ctx)
+		if err != nil {
+			return err
+		}
+		proof.Totals, err = are.AccountsTotals(ctx, false)
+		if err != nil {
+			return err
+		}
+		spContexts, err = tx.MakeSpVerificationCtxReader().GetAllSPContexts(ctx)
+		if err != nil {
+			return err
+		}
+
+		ar, err := tx.MakeAccountsOptimizedReader()
+		if err != nil {
+			return err
+		}
+		defer ar.Close()
+		proof.Leaf, err = makeLeaf(ar)
+		if err != nil {
+			return err
+		}
+
+		// use a dedicated read-only trie, loaded within the snapshot, rather
+		// than ct.balancesTrie which is concurrently modified by commitRound.
+		mc, err := tx.MakeMerkleReader()
+		if err != nil {
+			return err
+		}
+		trie, err := merkletrie.MakeTrie(mc, trackerdb.TrieMemoryConfig)
+		if err != nil {
+			return err
+		}
+		proof.Root, err = trie.RootHash()
+		if err != nil {
+			return err
+		}
+		path, err := trie.Prove(proof.Leaf)
+		if err != nil {
+			return err
+		}
+		if path == nil {
+			return ledgercore.ErrNotInBalancesTrie
+		}
+		proof.Proof = *path
+		return nil
+	})
+	if err != nil {
+		return ledgercore.BalancesTrieProof{}, err
+	}
+
+	hdr, err := ct.l.BlockHdr(proof.Round)
+	if err != nil {
+		return ledgercore.BalancesTrieProof{}, err
+	}
+	proof.BlockHash = crypto.Digest(hdr.Hash())
+	if config.Consensus[hdr.CurrentProtocol].EnableCatchpointsWithSPContexts {
+		spVerificationHash := crypto.HashObj(catchpointStateProofVerificationContext{Data: spContexts})
+		proof.StateProofVerificationHash = &spVerificationHash
+	}
+	proof.Commitment = proof.Commit()
+	return proof, nil
+}
+
+// proveAccount returns a proof of the account data of addr in the balances trie.
+func (ct *catchpointTracker) proveAccount(addr basics.Address) (ledgercore.BalancesTrieProof, error) {
+	return ct.proveBalancesTrieEntry(func(ar trackerdb.AccountsReader) ([]byte, error) {
+		pad, err := ar.LookupAccount(addr)
+		if err != nil {
+			return nil, err
+		}
+		if pad.AccountData.IsEmpty() {
+			return nil, ledgercore.ErrNotInBalancesTrie
+		}
+		return trackerdb.AccountHashBuilderV6(addr, &pad.AccountData, protocol.Encode(&pad.AccountData)), nil
+	})
+}
+
+// proveResource returns a proof of the asset or application resource of addr in the balances trie.
+func (ct *catchpointTracker) proveResource(addr basics.Address, cidx basics.CreatableIndex, ctype basics.CreatableType) (ledgercore.BalancesTrieProof, error) {
+	return ct.proveBalancesTrieEntry(func(ar trackerdb.AccountsReader) ([]byte, error) {
+		prd, err := ar.LookupResources(addr, cidx, ctype)
+		if err != nil {
+			return nil, err
+		}
+		if prd.Data.IsEmpty() {
+			return nil, ledgercore.ErrNotInBalancesTrie
+		}
+		return trackerdb.ResourcesHashBuilderV6(&prd.Data, addr, cidx, prd.Data.UpdateRound, protocol.Encode(&prd.Data))
+	})
+}
+
+// proveKv returns a proof of the key-value pair (e.g. a box) of key in the balances trie.
+func (ct *catchpointTracker) proveKv(key string) (ledgercore.BalancesTrieProof, error) {
+	return ct.proveBalancesTrieEntry(func(ar trackerdb.AccountsReader) ([]byte, error) {
+		pv, err := ar.LookupKeyValue(key)
+		if err != nil {
+			return nil, err
+		}
+		if pv.Value == nil {
+			return nil, ledgercore.ErrNotInBalancesTrie
+		}
+		return trackerdb.KvHashBuilderV6(key, pv.Value), nil
+	})
+}
+
 func (ct *catchpointTracker) getSPVerificationData() (encodedData []byte, spVerificationHash crypto.Digest, err error) {
 	err = ct.dbs.Snapshot(func(ctx context.Context, tx trackerdb.SnapshotScope) error {
 		rawData, dbErr := tx.MakeSpVerificationCtxReader().GetAllSPContexts(ctx)
@@ -348,6 +474,7 @@ func (ct *catchpointTracker) recoverFromCrash(dbRound basics.Round, blockProto p
 // in isolation.
 func (ct *catchpointTracker) loadFromDisk(l ledgerForTracker, dbRound basics.Round) (err error) {
 	ct.log = l.trackerLog()
+	ct.l = l
 	ct.dbs = l.trackerDB()
 	ct.catchpointStore, err = l.trackerDB().MakeCatchpointReaderWriter()
 	if err != nil {
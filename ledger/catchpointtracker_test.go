@@ -36,6 +36,7 @@ import (
 	"github.com/Quarkonium-chain/go-quarkonium/agreement"
 	"github.com/Quarkonium-chain/go-quarkonium/config"
 	"github.com/Quarkonium-chain/go-quarkonium/crypto"
+	"github.com/Quarkonium-chain/go-quarkonium/crypto/merkletrie"
 	"github.com/Quarkonium-chain/go-quarkonium/data/basics"
 	"github.com/Quarkonium-chain/go-quarkonium/data/bookkeeping"
 	"github.com/Quarkonium-chain/go-quarkonium/data/transactions"
@@ -2094,3 +2095,70 @@ func TestMakeCatchpointFilePath(t *testing.T) {
 	}
 
 }
+
+// TestCatchpointBalancesTrieProofs checks that the proofs built by the tracker
+// verify against the root of its balances trie, and commit to that root.
+func TestCatchpointBalancesTrieProofs(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	accts := []map[basics.Address]basics.AccountData{ledgertesting.RandomAccounts(20, false)}
+	addSinkAndPoolAccounts(accts)
+
+	conf := config.GetDefaultLocal()
+	conf.CatchpointInterval = 1
+	conf.CatchpointTracking = 1
+	ml := makeMockLedgerForTracker(t, true, 10, protocol.ConsensusCurrentVersion, accts)
+	defer ml.Close()
+	ct := newCatchpointTracker(t, ml, conf, ".")
+
+	root, err := ct.balancesTrie.RootHash()
+	require.NoError(t, err)
+
+	resources := 0
+	for addr, ad := range accts[0] {
+		proof, err := ct.proveAccount(addr)
+		require.NoError(t, err)
+		require.Equal(t, root, proof.Root)
+		require.Equal(t, ml.trackers.dbRound, proof.Round)
+		require.NoError(t, merkletrie.VerifyProof(proof.Root, proof.Leaf, &proof.Proof))
+		require.Equal(t, proof.Commit(), proof.Commitment)
+		commitmentRound, _, err := ledgercore.ParseCatchpointLabel(proof.Commitment)
+		require.NoError(t, err)
+		require.Equal(t, proof.Round, commitmentRound)
+
+		for aidx := range ad.Assets {
+			proof, err := ct.proveResource(addr, basics.CreatableIndex(aidx), basics.AssetCreatable)
+			require.NoError(t, err)
+			require.NoError(t, merkletrie.VerifyProof(root, proof.Leaf, &proof.Proof))
+			resources++
+		}
+		for aidx := range ad.AppParams {
+			proof, err := ct.proveResource(addr, basics.CreatableIndex(aidx), basics.AppCreatable)
+			require.NoError(t, err)
+			require.NoError(t, merkletrie.VerifyProof(root, proof.Leaf, &proof.Proof))
+			resources++
+		}
+	}
+	require.NotZero(t, resources)
+
+	proof, err := ct.proveAccount(testSinkAddr)
+	require.NoError(t, err)
+	hdr, err := ml.BlockHdr(proof.Round)
+	require.NoError(t, err)
+	require.Equal(t, crypto.Digest(hdr.Hash()), proof.BlockHash)
+	totals, err := ml.trackers.accts.Totals(proof.Round)
+	require.NoError(t, err)
+	require.Equal(t, totals, proof.Totals)
+	// the commitment changes along with any of the committed values
+	tampered := proof
+	tampered.Totals.Online.Money.Raw++
+	require.NotEqual(t, proof.Commitment, tampered.Commit())
+
+	_, err = ct.proveAccount(ledgertesting.RandomAddress())
+	require.ErrorIs(t, err, ledgercore.ErrNotInBalancesTrie)
+	_, err = ct.proveKv("missing")
+	require.ErrorIs(t, err, ledgercore.ErrNotInBalancesTrie)
+
+	_, err = (&catchpointTracker{}).proveAccount(ledgertesting.RandomAddress())
+	require.ErrorIs(t, err, ledgercore.ErrBalancesTrieDisabled)
+}
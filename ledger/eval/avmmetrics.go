@@ -0,0 +1,520 @@
+// Copyright (C) 2019-2024 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package eval
+
+import (
+	"container/heap"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/Quarkonium-chain/go-quarkonium/crypto"
+	"github.com/Quarkonium-chain/go-quarkonium/data/basics"
+	"github.com/Quarkonium-chain/go-quarkonium/data/bookkeeping"
+	"github.com/Quarkonium-chain/go-quarkonium/data/transactions/logic"
+	"github.com/Quarkonium-chain/go-quarkonium/util/metrics"
+)
+
+// maxTrackedApps bounds the number of applications the AVMMetrics keeps
+// individual statistics for. When it is reached, the application that has
+// consumed the least budget is replaced by the new one.
+const maxTrackedApps = 10000
+
+// avmCostBuckets are the upper bounds of the program cost histogram buckets.
+var avmCostBuckets = []uint64{10, 50, 100, 200, 350, 700, 1400, 2800, 5600, 11200, 22400, 44800, 89600, 179200}
+
+// Failure classes reported by the AVMMetrics
+const (
+	avmFailureReject = "reject" // the program completed, but did not approve
+	avmFailureBudget = "budget" // the program ran out of budget
+	avmFailureErr    = "err"    // the program executed the err opcode
+	avmFailureAssert = "assert" // an assert failed
+	avmFailureOpcode = "opcode" // some other opcode failed
+	avmFailureStack  = "stack"  // the program finished with an improper stack
+	avmFailurePanic  = "panic"  // the evaluator panicked
+	avmFailureOther  = "other"  // the program could not be started
+)
+
+// AVMMetrics aggregates statistics about AVM execution in the blocks added to
+// the ledger and publishes them as metrics: evaluations, cost and failures of
+// application programs and logicsigs, per-opcode execution counts and costs,
+// and per-application counters for the applications that consumed the most
+// budget.
+//
+// Each block evaluation is traced by its own AVMBlockTracer, whose statistics
+// are only counted once the block is added, so that the proposals that are
+// validated but not chosen are not counted.
+type AVMMetrics struct {
+	// topApps is the number of applications reported individually
+	topApps int
+
+	opcodeNames [256]string
+
+	mu      deadlock.Mutex
+	apps    avmProgramStats
+	sigs    avmProgramStats
+	opcodes [256]avmOpcodeStats
+	// tracked holds the statistics of individual applications, as a min-heap by cost
+	tracked avmAppHeap
+	byApp   map[basics.AppIndex]*avmAppStats
+	// pending holds the statistics of the blocks evaluated but not yet added
+	pending map[crypto.Digest]avmPendingBlock
+}
+
+type avmPendingBlock struct {
+	round  basics.Round
+	tracer *AVMBlockTracer
+}
+
+// AVMBlockTracer is a logic.EvalTracer that collects the statistics of the
+// evaluation of a single block for an AVMMetrics.
+//
+// It is safe for concurrent use, as logicsigs are evaluated in parallel during
+// validation.
+type AVMBlockTracer struct {
+	// no-op methods we don't care about
+	logic.NullEvalTracer
+
+	metrics *AVMMetrics
+
+	// runs maps each *logic.EvalContext being evaluated to its *avmProgramRun
+	runs sync.Map
+
+	// opcodes is indexed by opcode, and updated atomically
+	opcodes [256]avmOpcodeStats
+
+	mu    deadlock.Mutex
+	apps  avmProgramStats
+	sigs  avmProgramStats
+	byApp map[basics.AppIndex]*avmAppStats
+}
+
+// avmProgramRun is the state of a single program evaluation
+type avmProgramRun struct {
+	start      time.Time
+	opcode     byte
+	costBefore int
+	failedOp   string
+}
+
+type avmOpcodeStats struct {
+	executions atomic.Uint64
+	cost       atomic.Uint64
+}
+
+type avmProgramStats struct {
+	evals     uint64
+	cost      uint64
+	failures  map[string]uint64
+	histogram []uint64 // counts per avmCostBuckets entry, plus one for +Inf
+}
+
+func (s *avmProgramStats) record(cost uint64, failure string) {
+	s.evals++
+	s.cost += cost
+	if failure != "" {
+		if s.failures == nil {
+			s.failures = make(map[string]uint64)
+		}
+		s.failures[failure]++
+	}
+	if s.histogram == nil {
+		s.histogram = make([]uint64, len(avmCostBuckets)+1)
+	}
+	s.histogram[sort.Search(len(avmCostBuckets), func(i int) bool { return cost <= avmCostBuckets[i] })]++
+}
+
+func (s *avmProgramStats) add(other *avmProgramStats) {
+	s.evals += other.evals
+	s.cost += other.cost
+	for class, n := range other.failures {
+		if s.failures == nil {
+			s.failures = make(map[string]uint64)
+		}
+		s.failures[class] += n
+	}
+	if other.histogram != nil {
+		if s.histogram == nil {
+			s.histogram = make([]uint64, len(avmCostBuckets)+1)
+		}
+		for i, n := range other.histogram {
+			s.histogram[i] += n
+		}
+	}
+}
+
+type avmAppStats struct {
+	app      basics.AppIndex
+	evals    uint64
+	cost     uint64
+	failures uint64
+	micros   uint64
+	index    int // position in the avmAppHeap
+}
+
+type avmAppHeap []*avmAppStats
+
+func (h avmAppHeap) Len() int           { return len(h) }
+func (h avmAppHeap) Less(i, j int) bool { return h[i].cost < h[j].cost }
+func (h avmAppHeap) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].index = i
+	h[j].index = j
+}
+func (h *avmAppHeap) Push(x any) {
+	s := x.(*avmAppStats)
+	s.index = len(*h)
+	*h = append(*h, s)
+}
+func (h *avmAppHeap) Pop() any {
+	old := *h
+	s := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return s
+}
+
+// MakeAVMMetrics creates an AVMMetrics that reports the topApps applications
+// that consumed the most budget individually. It must be registered with a
+// metrics.Registry to be published.
+func MakeAVMMetrics(topApps int) *AVMMetrics {
+	m := &AVMMetrics{
+		topApps: topApps,
+		byApp:   make(map[basics.AppIndex]*avmAppStats),
+		pending: make(map[crypto.Digest]avmPendingBlock),
+	}
+	for _, spec := range logic.OpcodesByVersion(logic.LogicVersion) {
+		m.opcodeNames[spec.Opcode] = spec.Name
+	}
+	return m
+}
+
+// Register registers the metrics with the default/specific registry
+func (m *AVMMetrics) Register(reg *metrics.Registry) {
+	if reg == nil {
+		reg = metrics.DefaultRegistry()
+	}
+	reg.Register(m)
+}
+
+// Deregister deregisters the metrics with the default/specific registry
+func (m *AVMMetrics) Deregister(reg *metrics.Registry) {
+	if reg == nil {
+		reg = metrics.DefaultRegistry()
+	}
+	reg.Deregister(m)
+}
+
+// BlockTracer returns a tracer for the evaluation of a block. Its statistics
+// are counted once it is passed to Evaluated, and the block to Added.
+func (m *AVMMetrics) BlockTracer() *AVMBlockTracer {
+	return &AVMBlockTracer{
+		metrics: m,
+		byApp:   make(map[basics.AppIndex]*avmAppStats),
+	}
+}
+
+// Evaluated records that tracer traced the successful evaluation of blk, so
+// that its statistics are counted if blk is added.
+func (m *AVMMetrics) Evaluated(blk bookkeeping.Block, tracer *AVMBlockTracer) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.pending[blk.Digest()] = avmPendingBlock{round: blk.Round(), tracer: tracer}
+}
+
+// Added counts the statistics of the evaluation of blk, which was added to the
+// ledger, and forgets those of the other blocks evaluated for its round.
+func (m *AVMMetrics) Added(blk bookkeeping.Block) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if p, ok := m.pending[blk.Digest()]; ok {
+		m.addLocked(p.tracer)
+	}
+	for digest, p := range m.pending {
+		if p.round <= blk.Round() {
+			delete(m.pending, digest)
+		}
+	}
+}
+
+func (m *AVMMetrics) addLocked(tracer *AVMBlockTracer) {
+	tracer.mu.Lock()
+	defer tracer.mu.Unlock()
+	m.apps.add(&tracer.apps)
+	m.sigs.add(&tracer.sigs)
+	for i := range tracer.opcodes {
+		m.opcodes[i].executions.Add(tracer.opcodes[i].executions.Load())
+		m.opcodes[i].cost.Add(tracer.opcodes[i].cost.Load())
+	}
+
+	for _, stats := range tracer.byApp {
+		app := m.byApp[stats.app]
+		if app == nil {
+			app = &avmAppStats{app: stats.app}
+			if len(m.tracked) >= maxTrackedApps {
+				// As in the space-saving algorithm, the new application
+				// takes over the cost of the one it replaces, so that it
+				// is not the next one evicted. Its reported cost may thus
+				// be overestimated by at most that much.
+				evicted := heap.Pop(&m.tracked).(*avmAppStats)
+				delete(m.byApp, evicted.app)
+				app.cost = evicted.cost
+			}
+			heap.Push(&m.tracked, app)
+			m.byApp[app.app] = app
+		}
+		app.evals += stats.evals
+		app.cost += stats.cost
+		app.micros += stats.micros
+		app.failures += stats.failures
+		heap.Fix(&m.tracked, app.index)
+	}
+}
+
+func (tracer *AVMBlockTracer) run(cx *logic.EvalContext) *avmProgramRun {
+	run, ok := tracer.runs.Load(cx)
+	if !ok {
+		return nil
+	}
+	return run.(*avmProgramRun)
+}
+
+// BeforeProgram implements the EvalTracer interface
+func (tracer *AVMBlockTracer) BeforeProgram(cx *logic.EvalContext) {
+	tracer.runs.Store(cx, &avmProgramRun{start: time.Now()})
+}
+
+// BeforeOpcode implements the EvalTracer interface
+func (tracer *AVMBlockTracer) BeforeOpcode(cx *logic.EvalContext) {
+	run := tracer.run(cx)
+	if run == nil {
+		return
+	}
+	run.opcode = cx.GetProgram()[cx.PC()]
+	run.costBefore = cx.Cost()
+}
+
+// AfterOpcode implements the EvalTracer interface
+func (tracer *AVMBlockTracer) AfterOpcode(cx *logic.EvalContext, evalError error) {
+	run := tracer.run(cx)
+	if run == nil {
+		return
+	}
+	stats := &tracer.opcodes[run.opcode]
+	stats.executions.Add(1)
+	stats.cost.Add(uint64(cx.Cost() - run.costBefore))
+	if evalError != nil && run.failedOp == "" {
+		run.failedOp = tracer.metrics.opcodeNames[run.opcode]
+	}
+}
+
+// AfterProgram implements the EvalTracer interface
+func (tracer *AVMBlockTracer) AfterProgram(cx *logic.EvalContext, pass bool, evalError error) {
+	value, ok := tracer.runs.LoadAndDelete(cx)
+	if !ok {
+		return
+	}
+	run := value.(*avmProgramRun)
+	micros := uint64(time.Since(run.start).Microseconds())
+	failure := classifyAVMFailure(cx, run, pass, evalError)
+	cost := uint64(cx.Cost())
+
+	tracer.mu.Lock()
+	defer tracer.mu.Unlock()
+	if cx.RunMode() == logic.ModeSig {
+		tracer.sigs.record(cost, failure)
+		return
+	}
+	tracer.apps.record(cost, failure)
+
+	app := tracer.byApp[cx.AppID()]
+	if app == nil {
+		app = &avmAppStats{app: cx.AppID()}
+		tracer.byApp[app.app] = app
+	}
+	app.evals++
+	app.cost += cost
+	app.micros += micros
+	if failure != "" {
+		app.failures++
+	}
+}
+
+// classifyAVMFailure returns the failure class of a program evaluation, or ""
+// if the program approved.
+func classifyAVMFailure(cx *logic.EvalContext, run *avmProgramRun, pass bool, evalError error) string {
+	switch {
+	case evalError == nil && pass:
+		return ""
+	case evalError == nil:
+		return avmFailureReject
+	case strings.HasPrefix(evalError.Error(), "panic in TEAL Eval"):
+		return avmFailurePanic
+	case strings.Contains(evalError.Error(), "dynamic cost budget exceeded"):
+		return avmFailureBudget
+	case run.failedOp == "err":
+		return avmFailureErr
+	case run.failedOp == "assert":
+		return avmFailureAssert
+	case run.failedOp != "":
+		return avmFailureOpcode
+	case len(cx.GetProgram()) > 0 && cx.PC() >= len(cx.GetProgram()):
+		// the final stack checks are the only failures after the last opcode
+		return avmFailureStack
+	default:
+		return avmFailureOther
+	}
+}
+
+func writeMetricHeader(buf *strings.Builder, name, kind, description string) {
+	buf.WriteString("# HELP ")
+	buf.WriteString(name)
+	buf.WriteString(" ")
+	buf.WriteString(description)
+	buf.WriteString("\n# TYPE ")
+	buf.WriteString(name)
+	buf.WriteString(" " + kind + "\n")
+}
+
+func writeMetricSample(buf *strings.Builder, name, parentLabels, labels string, value uint64) {
+	buf.WriteString(name)
+	if len(parentLabels) > 0 || len(labels) > 0 {
+		buf.WriteString("{")
+		buf.WriteString(parentLabels)
+		if len(parentLabels) > 0 && len(labels) > 0 {
+			buf.WriteString(",")
+		}
+		buf.WriteString(labels)
+		buf.WriteString("}")
+	}
+	buf.WriteString(" " + strconv.FormatUint(value, 10) + "\n")
+}
+
+func writeCounter(buf *strings.Builder, name, description, parentLabels string, value uint64) {
+	writeMetricHeader(buf, name, "counter", description)
+	writeMetricSample(buf, name, parentLabels, "", value)
+}
+
+func (s *avmProgramStats) writeMetric(buf *strings.Builder, prefix, what, parentLabels string) {
+	writeCounter(buf, prefix+"_evals_total", "Number of "+what+" evaluations", parentLabels, s.evals)
+	writeCounter(buf, prefix+"_cost_total", "Opcode budget consumed by "+what+"s", parentLabels, s.cost)
+
+	name := prefix + "_failures_total"
+	writeMetricHeader(buf, name, "counter", "Number of failed "+what+" evaluations, by class")
+	classes := make([]string, 0, len(s.failures))
+	for class := range s.failures {
+		classes = append(classes, class)
+	}
+	sort.Strings(classes)
+	for _, class := range classes {
+		writeMetricSample(buf, name, parentLabels, `class="`+class+`"`, s.failures[class])
+	}
+
+	name = prefix + "_cost"
+	writeMetricHeader(buf, name, "histogram", "Opcode budget consumed by each "+what+" evaluation")
+	var cumulative uint64
+	for i := 0; i <= len(avmCostBuckets); i++ {
+		if s.histogram != nil {
+			cumulative += s.histogram[i]
+		}
+		le := "+Inf"
+		if i < len(avmCostBuckets) {
+			le = strconv.FormatUint(avmCostBuckets[i], 10)
+		}
+		writeMetricSample(buf, name+"_bucket", parentLabels, `le="`+le+`"`, cumulative)
+	}
+	writeMetricSample(buf, name+"_sum", parentLabels, "", s.cost)
+	writeMetricSample(buf, name+"_count", parentLabels, "", s.evals)
+}
+
+// WriteMetric implements the metrics.Metric interface
+func (m *AVMMetrics) WriteMetric(buf *strings.Builder, parentLabels string) {
+	m.mu.Lock()
+	m.apps.writeMetric(buf, "algod_avm_app", "application program", parentLabels)
+	m.sigs.writeMetric(buf, "algod_avm_logicsig", "logicsig", parentLabels)
+	top := m.topAppsLocked()
+	m.mu.Unlock()
+
+	type appCounter struct {
+		name, description string
+		value             func(avmAppStats) uint64
+	}
+	for _, c := range []appCounter{
+		{"algod_avm_top_app_evals_total", "Number of evaluations of the applications that consumed the most budget",
+			func(s avmAppStats) uint64 { return s.evals }},
+		{"algod_avm_top_app_cost_total", "Opcode budget consumed by the applications that consumed the most budget",
+			func(s avmAppStats) uint64 { return s.cost }},
+		{"algod_avm_top_app_failures_total", "Number of failed evaluations of the applications that consumed the most budget",
+			func(s avmAppStats) uint64 { return s.failures }},
+		{"algod_avm_top_app_eval_microseconds_total", "Time spent evaluating the applications that consumed the most budget",
+			func(s avmAppStats) uint64 { return s.micros }},
+	} {
+		writeMetricHeader(buf, c.name, "counter", c.description)
+		for _, app := range top {
+			writeMetricSample(buf, c.name, parentLabels, `app="`+strconv.FormatUint(uint64(app.app), 10)+`"`, c.value(app))
+		}
+	}
+
+	executions := "algod_avm_opcode_executions_total"
+	cost := "algod_avm_opcode_cost_total"
+	writeMetricHeader(buf, executions, "counter", "Number of executions of each opcode")
+	for i := range m.opcodes {
+		if n := m.opcodes[i].executions.Load(); n > 0 {
+			writeMetricSample(buf, executions, parentLabels, "op="+strconv.Quote(m.opcodeNames[i]), n)
+		}
+	}
+	writeMetricHeader(buf, cost, "counter", "Opcode budget consumed by each opcode")
+	for i := range m.opcodes {
+		if m.opcodes[i].executions.Load() > 0 {
+			writeMetricSample(buf, cost, parentLabels, "op="+strconv.Quote(m.opcodeNames[i]), m.opcodes[i].cost.Load())
+		}
+	}
+}
+
+// topAppsLocked returns copies of the statistics of the topApps applications
+// that consumed the most budget, in decreasing order of cost.
+func (m *AVMMetrics) topAppsLocked() []avmAppStats {
+	apps := make([]avmAppStats, len(m.tracked))
+	for i, app := range m.tracked {
+		apps[i] = *app
+	}
+	sort.Slice(apps, func(i, j int) bool {
+		if apps[i].cost != apps[j].cost {
+			return apps[i].cost > apps[j].cost
+		}
+		return apps[i].app < apps[j].app
+	})
+	if len(apps) > m.topApps {
+		apps = apps[:m.topApps]
+	}
+	return apps
+}
+
+// AddMetric implements the metrics.Metric interface. Only the totals are
+// reported, to keep telemetry heartbeats small.
+func (m *AVMMetrics) AddMetric(values map[string]float64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	values["algod_avm_app_evals_total"] = float64(m.apps.evals)
+	values["algod_avm_app_cost_total"] = float64(m.apps.cost)
+	values["algod_avm_logicsig_evals_total"] = float64(m.sigs.evals)
+	values["algod_avm_logicsig_cost_total"] = float64(m.sigs.cost)
+}
@@ -0,0 +1,235 @@
+// Copyright (C) 2019-2024 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package eval
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Quarkonium-chain/go-quarkonium/config"
+	"github.com/Quarkonium-chain/go-quarkonium/data/basics"
+	"github.com/Quarkonium-chain/go-quarkonium/data/bookkeeping"
+	"github.com/Quarkonium-chain/go-quarkonium/data/transactions"
+	"github.com/Quarkonium-chain/go-quarkonium/data/transactions/logic"
+	"github.com/Quarkonium-chain/go-quarkonium/data/transactions/verify"
+	"github.com/Quarkonium-chain/go-quarkonium/data/txntest"
+	ledgertesting "github.com/Quarkonium-chain/go-quarkonium/ledger/testing"
+	"github.com/Quarkonium-chain/go-quarkonium/protocol"
+	"github.com/Quarkonium-chain/go-quarkonium/test/partitiontest"
+	"github.com/Quarkonium-chain/go-quarkonium/util/metrics"
+)
+
+func TestAVMMetrics(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	genesisInitState, addrs, _ := ledgertesting.Genesis(4)
+	genesisBalances := bookkeeping.GenesisBalances{
+		Balances:    genesisInitState.Accounts,
+		FeeSink:     testSinkAddr,
+		RewardsPool: testPoolAddr,
+		Timestamp:   0,
+	}
+	l := newTestLedger(t, genesisBalances)
+	blkHeader, err := l.BlockHdr(basics.Round(0))
+	require.NoError(t, err)
+	newBlock := bookkeeping.MakeBlock(blkHeader)
+
+	avmMetrics := MakeAVMMetrics(1)
+	reg := metrics.MakeRegistry()
+	avmMetrics.Register(reg)
+	tracer := avmMetrics.BlockTracer()
+
+	eval, err := l.StartEvaluator(newBlock.BlockHeader, 0, 0, tracer)
+	require.NoError(t, err)
+	eval.validate = true
+	eval.generate = true
+
+	// newTestLedger uses ConsensusFuture, so the first app may be offset
+	firstApp := basics.AppIndex(1)
+	if config.Consensus[protocol.ConsensusFuture].AppForbidLowResources {
+		firstApp += 1000
+	}
+
+	create := func(approval string, note string) transactions.SignedTxnWithAD {
+		return txntest.Txn{
+			Type:              protocol.ApplicationCallTx,
+			Sender:            addrs[0],
+			ApprovalProgram:   approval,
+			ClearStateProgram: "#pragma version 8\nint 1",
+			FirstValid:        newBlock.Round(),
+			LastValid:         newBlock.Round() + 1000,
+			Fee:               minFee,
+			GenesisHash:       l.GenesisHash(),
+			Note:              []byte(note),
+		}.SignedTxnWithAD()
+	}
+
+	// an expensive app, then a cheap one, then two that fail
+	require.NoError(t, eval.TransactionGroup([]transactions.SignedTxnWithAD{
+		create("#pragma version 8\nbyte 0x01\nsha256\nsha256\nlen", "hashes"),
+	}))
+	require.NoError(t, eval.TransactionGroup([]transactions.SignedTxnWithAD{
+		create("#pragma version 8\nint 1", "cheap"),
+	}))
+	require.Error(t, eval.TransactionGroup([]transactions.SignedTxnWithAD{
+		create("#pragma version 8\nint 0", "reject"),
+	}))
+	require.Error(t, eval.TransactionGroup([]transactions.SignedTxnWithAD{
+		create("#pragma version 8\nerr", "err"),
+	}))
+
+	// logicsigs are traced by the verifier
+	lsig, err := logic.AssembleString("#pragma version 8\nint 1\nint 2\n+")
+	require.NoError(t, err)
+	stxn := txntest.Txn{
+		Type:        protocol.PaymentTx,
+		Sender:      basics.Address(logic.HashProgram(lsig.Program)),
+		Receiver:    addrs[1],
+		FirstValid:  newBlock.Round(),
+		LastValid:   newBlock.Round() + 1000,
+		Fee:         minFee,
+		GenesisHash: l.GenesisHash(),
+	}.SignedTxn()
+	stxn.Lsig.Logic = lsig.Program
+	_, err = verify.TxnGroupWithTracer([]transactions.SignedTxn{stxn}, &newBlock.BlockHeader, nil, l, tracer)
+	require.NoError(t, err)
+
+	// nothing is counted until the block is added
+	var buf strings.Builder
+	reg.WriteMetrics(&buf, "")
+	require.Contains(t, buf.String(), "algod_avm_app_evals_total 0\n")
+	avmMetrics.Evaluated(newBlock, tracer)
+	buf.Reset()
+	reg.WriteMetrics(&buf, "")
+	require.Contains(t, buf.String(), "algod_avm_app_evals_total 0\n")
+
+	avmMetrics.Added(newBlock)
+	buf.Reset()
+	reg.WriteMetrics(&buf, "")
+	out := buf.String()
+
+	expected := []string{
+		"algod_avm_app_evals_total 4\n",
+		"algod_avm_app_cost_total 75\n", // 1 + 35 + 35 + 1, then 1, 1 and 1
+		`algod_avm_app_failures_total{class="err"} 1` + "\n",
+		`algod_avm_app_failures_total{class="reject"} 1` + "\n",
+		`algod_avm_app_cost_bucket{le="10"} 3` + "\n",
+		`algod_avm_app_cost_bucket{le="100"} 4` + "\n",
+		`algod_avm_app_cost_bucket{le="+Inf"} 4` + "\n",
+		"algod_avm_app_cost_count 4\n",
+		"algod_avm_logicsig_evals_total 1\n",
+		"algod_avm_logicsig_cost_total 3\n",
+		`algod_avm_opcode_executions_total{op="sha256"} 2` + "\n",
+		`algod_avm_opcode_cost_total{op="sha256"} 70` + "\n",
+		`algod_avm_opcode_executions_total{op="err"} 1` + "\n",
+		`algod_avm_opcode_executions_total{op="+"} 1` + "\n",
+		fmt.Sprintf("algod_avm_top_app_cost_total{app=\"%d\"} 72\n", firstApp),
+		fmt.Sprintf("algod_avm_top_app_evals_total{app=\"%d\"} 1\n", firstApp),
+	}
+	for _, line := range expected {
+		require.Contains(t, out, line)
+	}
+	// only the single most expensive app is reported individually
+	require.Equal(t, 1, strings.Count(out, "algod_avm_top_app_cost_total{"))
+	require.NotContains(t, out, `algod_avm_logicsig_failures_total{`)
+
+	values := make(map[string]float64)
+	avmMetrics.AddMetric(values)
+	require.Equal(t, float64(4), values["algod_avm_app_evals_total"])
+	require.Equal(t, float64(1), values["algod_avm_logicsig_evals_total"])
+
+	avmMetrics.Deregister(reg)
+	buf.Reset()
+	reg.WriteMetrics(&buf, "")
+	require.Empty(t, buf.String())
+}
+
+// blockTracerFor returns a block tracer that traced an evaluation of each app
+// with the given cost.
+func blockTracerFor(m *AVMMetrics, costs map[basics.AppIndex]uint64) *AVMBlockTracer {
+	tracer := m.BlockTracer()
+	for app, cost := range costs {
+		tracer.apps.record(cost, "")
+		tracer.byApp[app] = &avmAppStats{app: app, evals: 1, cost: cost}
+	}
+	return tracer
+}
+
+func TestAVMMetricsProposals(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	m := MakeAVMMetrics(10)
+	var blocks []bookkeeping.Block
+	for i := 0; i < 3; i++ {
+		blk := bookkeeping.Block{BlockHeader: bookkeeping.BlockHeader{Round: 1, TimeStamp: int64(i)}}
+		m.Evaluated(blk, blockTracerFor(m, map[basics.AppIndex]uint64{basics.AppIndex(i + 1): 100}))
+		blocks = append(blocks, blk)
+	}
+	next := bookkeeping.Block{BlockHeader: bookkeeping.BlockHeader{Round: 2}}
+	m.Evaluated(next, blockTracerFor(m, map[basics.AppIndex]uint64{4: 100}))
+
+	// only the proposal that was chosen is counted
+	m.Added(blocks[1])
+	require.Equal(t, uint64(1), m.apps.evals)
+	require.Len(t, m.byApp, 1)
+	require.Contains(t, m.byApp, basics.AppIndex(2))
+	// the other proposals for its round are forgotten
+	require.Len(t, m.pending, 1)
+
+	m.Added(next)
+	require.Equal(t, uint64(2), m.apps.evals)
+	require.Empty(t, m.pending)
+
+	// a block that was not evaluated for the metrics is not counted
+	m.Added(bookkeeping.Block{BlockHeader: bookkeeping.BlockHeader{Round: 3}})
+	require.Equal(t, uint64(2), m.apps.evals)
+}
+
+func TestAVMMetricsEviction(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	m := MakeAVMMetrics(3)
+	costs := make(map[basics.AppIndex]uint64, maxTrackedApps)
+	for i := 1; i <= maxTrackedApps; i++ {
+		costs[basics.AppIndex(i)] = uint64(10 + i)
+	}
+	m.addLocked(blockTracerFor(m, costs))
+	require.Len(t, m.tracked, maxTrackedApps)
+
+	// a new app replaces the cheapest one, and takes over its cost
+	m.addLocked(blockTracerFor(m, map[basics.AppIndex]uint64{100_000: 5}))
+	require.Len(t, m.tracked, maxTrackedApps)
+	require.NotContains(t, m.byApp, basics.AppIndex(1))
+	require.Equal(t, uint64(11+5), m.byApp[100_000].cost)
+	require.Equal(t, uint64(1), m.byApp[100_000].evals)
+
+	// so the next new app replaces the next cheapest one, not the new app
+	m.addLocked(blockTracerFor(m, map[basics.AppIndex]uint64{100_001: 5}))
+	require.Contains(t, m.byApp, basics.AppIndex(100_000))
+	require.NotContains(t, m.byApp, basics.AppIndex(2))
+	require.Equal(t, uint64(12+5), m.byApp[100_001].cost)
+
+	top := m.topAppsLocked()
+	require.Len(t, top, 3)
+	require.Equal(t, basics.AppIndex(maxTrackedApps), top[0].app)
+}
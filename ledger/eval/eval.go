@@ -21,7 +21,6 @@ import (
 	"errors"
 	"fmt"
 	"math"
-	"math/bits"
 	"sync"
 
 	"github.com/Quarkonium-chain/go-quarkonium/agreement"
@@ -29,7 +28,6 @@ import (
 	"github.com/Quarkonium-chain/go-quarkonium/crypto"
 	"github.com/Quarkonium-chain/go-quarkonium/data/basics"
 	"github.com/Quarkonium-chain/go-quarkonium/data/bookkeeping"
-	"github.com/Quarkonium-chain/go-quarkonium/data/committee"
 	"github.com/Quarkonium-chain/go-quarkonium/data/transactions"
 	"github.com/Quarkonium-chain/go-quarkonium/data/transactions/logic"
 	"github.com/Quarkonium-chain/go-quarkonium/data/transactions/verify"
@@ -1285,6 +1283,9 @@ func (eval *BlockEvaluator) applyTransaction(tx transactions.Transaction, cow *r
 		// Validation of the StateProof transaction before applying will only occur in validate mode.
 		err = apply.StateProof(tx.StateProofTxnFields, tx.Header.FirstValid, cow, eval.validate)
 
+	case protocol.HeartbeatTx:
+		err = apply.Heartbeat(*tx.HeartbeatTxnFields, tx.Header, cow, cow, cow.Round())
+
 	default:
 		err = fmt.Errorf("unknown transaction type %v", tx.Type)
 	}
@@ -1364,7 +1365,10 @@ func (eval *BlockEvaluator) endOfBlock() error {
 			}
 		}
 
-		eval.generateKnockOfflineAccountsList()
+		err = eval.generateKnockOfflineAccountsList()
+		if err != nil {
+			return err
+		}
 
 		if eval.proto.StateProofInterval > 0 {
 			var basicStateProof bookkeeping.StateProofTrackingData
@@ -1594,22 +1598,15 @@ func (eval *BlockEvaluator) proposerPayout() (basics.MicroAlgos, error) {
 	return basics.MinA(total, available), nil
 }
 
-type challenge struct {
-	// round is when the challenge occurred. 0 means this is not a challenge.
-	round basics.Round
-	// accounts that match the first `bits` of `seed` must propose or heartbeat to stay online
-	seed committee.Seed
-	bits int
-}
-
 // generateKnockOfflineAccountsList creates the lists of expired or absent
 // participation accounts by traversing over the modified accounts in the state
 // deltas and testing if any of them needs to be reset/suspended. Expiration
 // takes precedence - if an account is expired, it should be knocked offline and
 // key material deleted. If it is only suspended, the key material will remain.
-func (eval *BlockEvaluator) generateKnockOfflineAccountsList() {
+// It fails if the header of the challenge round can't be read.
+func (eval *BlockEvaluator) generateKnockOfflineAccountsList() error {
 	if !eval.generate {
-		return
+		return nil
 	}
 	current := eval.Round()
 
@@ -1618,7 +1615,10 @@ func (eval *BlockEvaluator) generateKnockOfflineAccountsList() {
 
 	updates := &eval.block.ParticipationUpdates
 
-	ch := activeChallenge(&eval.proto, uint64(eval.Round()), eval.state)
+	ch, err := apply.FindChallenge(eval.proto.Payouts, eval.Round(), eval.state, apply.ChActive)
+	if err != nil {
+		return err
+	}
 
 	for _, accountAddr := range eval.state.modifiedAccounts() {
 		acctData, found := eval.state.mods.Accts.GetData(accountAddr)
@@ -1646,9 +1646,9 @@ func (eval *BlockEvaluator) generateKnockOfflineAccountsList() {
 		}
 
 		if acctData.Status == basics.Online {
-			lastSeen := max(acctData.LastProposed, acctData.LastHeartbeat)
+			lastSeen := acctData.LastSeen()
 			if isAbsent(eval.state.prevTotals.Online.Money, acctData.MicroAlgos, lastSeen, current) ||
-				failsChallenge(ch, accountAddr, lastSeen) {
+				ch.Failed(accountAddr, lastSeen) {
 				updates.AbsentParticipationAccounts = append(
 					updates.AbsentParticipationAccounts,
 					accountAddr,
@@ -1656,36 +1656,7 @@ func (eval *BlockEvaluator) generateKnockOfflineAccountsList() {
 			}
 		}
 	}
-}
-
-// delete me in Go 1.21
-func max(a, b basics.Round) basics.Round {
-	if a > b {
-		return a
-	}
-	return b
-}
-
-// bitsMatch checks if the first n bits of two byte slices match. Written to
-// work on arbitrary slices, but we expect that n is small. Only user today
-// calls with n=5.
-func bitsMatch(a, b []byte, n int) bool {
-	// Ensure n is a valid number of bits to compare
-	if n < 0 || n > len(a)*8 || n > len(b)*8 {
-		return false
-	}
-
-	// Compare entire bytes when n is bigger than 8
-	for i := 0; i < n/8; i++ {
-		if a[i] != b[i] {
-			return false
-		}
-	}
-	remaining := n % 8
-	if remaining == 0 {
-		return true
-	}
-	return bits.LeadingZeros8(a[n/8]^b[n/8]) >= remaining
+	return nil
 }
 
 func isAbsent(totalOnlineStake basics.MicroAlgos, acctStake basics.MicroAlgos, lastSeen basics.Round, current basics.Round) bool {
@@ -1705,39 +1676,6 @@ func isAbsent(totalOnlineStake basics.MicroAlgos, acctStake basics.MicroAlgos, l
 	return lastSeen+basics.Round(allowableLag) < current
 }
 
-type headerSource interface {
-	BlockHdr(round basics.Round) (bookkeeping.BlockHeader, error)
-}
-
-func activeChallenge(proto *config.ConsensusParams, current uint64, headers headerSource) challenge {
-	rules := proto.Payouts
-	// are challenges active?
-	if rules.ChallengeInterval == 0 || current < rules.ChallengeInterval {
-		return challenge{}
-	}
-	lastChallenge := current - (current % rules.ChallengeInterval)
-	// challenge is in effect if we're after one grace period, but before the 2nd ends.
-	if current <= lastChallenge+rules.ChallengeGracePeriod ||
-		current > lastChallenge+2*rules.ChallengeGracePeriod {
-		return challenge{}
-	}
-	round := basics.Round(lastChallenge)
-	challengeHdr, err := headers.BlockHdr(round)
-	if err != nil {
-		panic(err)
-	}
-	challengeProto := config.Consensus[challengeHdr.CurrentProtocol]
-	// challenge is not considered if rules have changed since that round
-	if challengeProto.Payouts != rules {
-		return challenge{}
-	}
-	return challenge{round, challengeHdr.Seed, rules.ChallengeBits}
-}
-
-func failsChallenge(ch challenge, address basics.Address, lastSeen basics.Round) bool {
-	return ch.round != 0 && bitsMatch(ch.seed[:], address[:], ch.bits) && lastSeen < ch.round
-}
-
 // validateExpiredOnlineAccounts tests the expired online accounts specified in ExpiredParticipationAccounts, and verify
 // that they have all expired and need to be reset.
 func (eval *BlockEvaluator) validateExpiredOnlineAccounts() error {
@@ -1805,7 +1743,10 @@ func (eval *BlockEvaluator) validateAbsentOnlineAccounts() error {
 	// For consistency with expired account handling, we preclude duplicates
 	addressSet := make(map[basics.Address]bool, suspensionCount)
 
-	ch := activeChallenge(&eval.proto, uint64(eval.Round()), eval.state)
+	ch, err := apply.FindChallenge(eval.proto.Payouts, eval.Round(), eval.state, apply.ChActive)
+	if err != nil {
+		return err
+	}
 
 	for _, accountAddr := range eval.block.ParticipationUpdates.AbsentParticipationAccounts {
 		if _, exists := addressSet[accountAddr]; exists {
@@ -1822,11 +1763,11 @@ func (eval *BlockEvaluator) validateAbsentOnlineAccounts() error {
 			return fmt.Errorf("proposed absent account %v was %v, not Online", accountAddr, acctData.Status)
 		}
 
-		lastSeen := max(acctData.LastProposed, acctData.LastHeartbeat)
+		lastSeen := acctData.LastSeen()
 		if isAbsent(eval.state.prevTotals.Online.Money, acctData.MicroAlgos, lastSeen, eval.Round()) {
 			continue // ok. it's "normal absent"
 		}
-		if failsChallenge(ch, accountAddr, lastSeen) {
+		if ch.Failed(accountAddr, lastSeen) {
 			continue // ok. it's "challenge absent"
 		}
 		return fmt.Errorf("proposed absent account %v is not absent in %d, %d",
@@ -1938,6 +1879,7 @@ type evalTxValidator struct {
 	block            bookkeeping.Block
 	verificationPool execpool.BacklogPool
 	ledger           logic.LedgerForSignature
+	tracer           logic.EvalTracer
 
 	ctx      context.Context
 	txgroups [][]transactions.SignedTxnWithAD
@@ -1968,7 +1910,7 @@ func (validator *evalTxValidator) run() {
 
 	unverifiedTxnGroups = validator.txcache.GetUnverifiedTransactionGroups(unverifiedTxnGroups, specialAddresses, validator.block.BlockHeader.CurrentProtocol)
 
-	err := verify.PaysetGroups(validator.ctx, unverifiedTxnGroups, validator.block.BlockHeader, validator.verificationPool, validator.txcache, validator.ledger)
+	err := verify.PaysetGroupsWithTracer(validator.ctx, unverifiedTxnGroups, validator.block.BlockHeader, validator.verificationPool, validator.txcache, validator.ledger, validator.tracer)
 	if err != nil {
 		validator.done <- err
 	}
@@ -1981,6 +1923,13 @@ func (validator *evalTxValidator) run() {
 // AddBlock: Eval(context.Background(), l, blk, false, txcache, nil)
 // tracker:  Eval(context.Background(), l, blk, false, txcache, nil)
 func Eval(ctx context.Context, l LedgerForEvaluator, blk bookkeeping.Block, validate bool, txcache verify.VerifiedTransactionCache, executionPool execpool.BacklogPool, tracer logic.EvalTracer) (ledgercore.StateDelta, error) {
+	return EvalWithSigTracer(ctx, l, blk, validate, txcache, executionPool, tracer, nil)
+}
+
+// EvalWithSigTracer is Eval, except that when validating, sigTracer observes
+// the evaluation of the logicsigs of the groups that are not in txcache. These
+// are evaluated concurrently, so sigTracer must be safe for concurrent use.
+func EvalWithSigTracer(ctx context.Context, l LedgerForEvaluator, blk bookkeeping.Block, validate bool, txcache verify.VerifiedTransactionCache, executionPool execpool.BacklogPool, tracer logic.EvalTracer, sigTracer logic.EvalTracer) (ledgercore.StateDelta, error) {
 	// flush the pending writes in the cache to make everything read so far available during eval
 	l.FlushCaches()
 
@@ -2028,6 +1977,7 @@ func Eval(ctx context.Context, l LedgerForEvaluator, blk bookkeeping.Block, vali
 		txvalidator.block = blk
 		txvalidator.verificationPool = executionPool
 		txvalidator.ledger = l
+		txvalidator.tracer = sigTracer
 
 		txvalidator.ctx = validationCtx
 		txvalidator.txgroups = paysetgroups
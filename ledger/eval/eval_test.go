@@ -39,6 +39,7 @@ import (
 	"github.com/Quarkonium-chain/go-quarkonium/data/transactions/logic/mocktracer"
 	"github.com/Quarkonium-chain/go-quarkonium/data/transactions/verify"
 	"github.com/Quarkonium-chain/go-quarkonium/data/txntest"
+	"github.com/Quarkonium-chain/go-quarkonium/ledger/apply"
 	"github.com/Quarkonium-chain/go-quarkonium/ledger/ledgercore"
 	ledgertesting "github.com/Quarkonium-chain/go-quarkonium/ledger/testing"
 	"github.com/Quarkonium-chain/go-quarkonium/protocol"
@@ -1596,29 +1597,6 @@ func TestExpiredAccountGeneration(t *testing.T) {
 	require.Zero(t, recvAcct.StateProofID)
 }
 
-func TestBitsMatch(t *testing.T) {
-	partitiontest.PartitionTest(t)
-	t.Parallel()
-
-	for b := 0; b <= 6; b++ {
-		require.True(t, bitsMatch([]byte{0x1}, []byte{0x2}, b), "%d", b)
-	}
-	require.False(t, bitsMatch([]byte{0x1}, []byte{0x2}, 7))
-	require.False(t, bitsMatch([]byte{0x1}, []byte{0x2}, 8))
-	require.False(t, bitsMatch([]byte{0x1}, []byte{0x2}, 9))
-
-	for b := 0; b <= 12; b++ {
-		require.True(t, bitsMatch([]byte{0x1, 0xff, 0xaa}, []byte{0x1, 0xf0}, b), "%d", b)
-	}
-	require.False(t, bitsMatch([]byte{0x1, 0xff, 0xaa}, []byte{0x1, 0xf0}, 13))
-
-	// on a byte boundary
-	require.True(t, bitsMatch([]byte{0x1}, []byte{0x1}, 8))
-	require.False(t, bitsMatch([]byte{0x1}, []byte{0x1}, 9))
-	require.True(t, bitsMatch([]byte{0x1, 0xff}, []byte{0x1, 0x00}, 8))
-	require.False(t, bitsMatch([]byte{0x1, 0xff}, []byte{0x1, 00}, 9))
-}
-
 func TestIsAbsent(t *testing.T) {
 	partitiontest.PartitionTest(t)
 	t.Parallel()
@@ -1644,16 +1622,16 @@ func TestFailsChallenge(t *testing.T) {
 	a := assert.New(t)
 
 	// a valid challenge, with 4 matching bits, and an old last seen
-	a.True(failsChallenge(challenge{round: 11, seed: [32]byte{0xb0, 0xb4}, bits: 4}, basics.Address{0xbf, 0x34}, 10))
+	a.True(apply.Challenge{Round: 11, Seed: [32]byte{0xb0, 0xb4}, Bits: 4}.Failed(basics.Address{0xbf, 0x34}, 10))
 
 	// challenge isn't "on"
-	a.False(failsChallenge(challenge{round: 0, seed: [32]byte{0xb0, 0xb4}, bits: 4}, basics.Address{0xbf, 0x34}, 10))
+	a.False(apply.Challenge{Round: 0, Seed: [32]byte{0xb0, 0xb4}, Bits: 4}.Failed(basics.Address{0xbf, 0x34}, 10))
 	// node has appeared more recently
-	a.False(failsChallenge(challenge{round: 11, seed: [32]byte{0xb0, 0xb4}, bits: 4}, basics.Address{0xbf, 0x34}, 12))
+	a.False(apply.Challenge{Round: 11, Seed: [32]byte{0xb0, 0xb4}, Bits: 4}.Failed(basics.Address{0xbf, 0x34}, 12))
 	// bits don't match
-	a.False(failsChallenge(challenge{round: 11, seed: [32]byte{0xb0, 0xb4}, bits: 4}, basics.Address{0xcf, 0x34}, 10))
+	a.False(apply.Challenge{Round: 11, Seed: [32]byte{0xb0, 0xb4}, Bits: 4}.Failed(basics.Address{0xcf, 0x34}, 10))
 	// no enough bits match
-	a.False(failsChallenge(challenge{round: 11, seed: [32]byte{0xb0, 0xb4}, bits: 5}, basics.Address{0xbf, 0x34}, 10))
+	a.False(apply.Challenge{Round: 11, Seed: [32]byte{0xb0, 0xb4}, Bits: 5}.Failed(basics.Address{0xbf, 0x34}, 10))
 }
 
 type singleSource bookkeeping.BlockHeader
@@ -1674,27 +1652,32 @@ func TestActiveChallenge(t *testing.T) {
 		},
 	}
 	now := config.Consensus[nowHeader.CurrentProtocol]
+	activeChallenge := func(r basics.Round, headers apply.HdrProvider) apply.Challenge {
+		ch, err := apply.FindChallenge(now.Payouts, r, headers, apply.ChActive)
+		a.NoError(err)
+		return ch
+	}
 
 	// simplest test. when interval=X and grace=G, X+G+1 is a challenge
-	inChallenge := now.Payouts.ChallengeInterval + now.Payouts.ChallengeGracePeriod + 1
-	ch := activeChallenge(&now, inChallenge, singleSource(nowHeader))
-	a.NotZero(ch.round)
+	inChallenge := basics.Round(now.Payouts.ChallengeInterval + now.Payouts.ChallengeGracePeriod + 1)
+	ch := activeChallenge(inChallenge, singleSource(nowHeader))
+	a.NotZero(ch.Round)
 
 	// all rounds before that have no challenge
-	for r := uint64(1); r < inChallenge; r++ {
-		ch := activeChallenge(&now, r, singleSource(nowHeader))
-		a.Zero(ch.round, r)
+	for r := basics.Round(1); r < inChallenge; r++ {
+		ch := activeChallenge(r, singleSource(nowHeader))
+		a.Zero(ch.Round, r)
 	}
 
 	// ChallengeGracePeriod rounds allow challenges starting with inChallenge
-	for r := inChallenge; r < inChallenge+now.Payouts.ChallengeGracePeriod; r++ {
-		ch := activeChallenge(&now, r, singleSource(nowHeader))
-		a.EqualValues(ch.round, now.Payouts.ChallengeInterval)
+	for r := inChallenge; r < inChallenge+basics.Round(now.Payouts.ChallengeGracePeriod); r++ {
+		ch := activeChallenge(r, singleSource(nowHeader))
+		a.EqualValues(ch.Round, now.Payouts.ChallengeInterval)
 	}
 
 	// And the next round is again challenge-less
-	ch = activeChallenge(&now, inChallenge+now.Payouts.ChallengeGracePeriod, singleSource(nowHeader))
-	a.Zero(ch.round)
+	ch = activeChallenge(inChallenge+basics.Round(now.Payouts.ChallengeGracePeriod), singleSource(nowHeader))
+	a.Zero(ch.Round)
 
 	// ignore challenge if upgrade happened
 	oldHeader := bookkeeping.BlockHeader{
@@ -1703,6 +1686,6 @@ func TestActiveChallenge(t *testing.T) {
 			CurrentProtocol: protocol.ConsensusV39,
 		},
 	}
-	ch = activeChallenge(&now, inChallenge, singleSource(oldHeader))
-	a.Zero(ch.round)
+	ch = activeChallenge(inChallenge, singleSource(oldHeader))
+	a.Zero(ch.Round)
 }
@@ -344,6 +344,10 @@ func (p *accountPrefetcher) prefetch(ctx context.Context) {
 
 			case protocol.StateProofTx:
 			case protocol.KeyRegistrationTx:
+			case protocol.HeartbeatTx:
+				if stxn.Txn.HeartbeatTxnFields != nil {
+					loadAccountsAddAccountTask(&stxn.Txn.HeartbeatTxnFields.HbAddress, task, accountTasks, queue)
+				}
 			}
 
 			// If you add new addresses here, also add them in getTxnAddresses().
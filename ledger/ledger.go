@@ -19,6 +19,7 @@ package ledger
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"path/filepath"
 	"time"
@@ -89,6 +90,7 @@ type Ledger struct {
 	notifier       blockNotifier
 	metrics        metricsTracker
 	spVerification spVerificationTracker
+	stateHistory   stateHistoryTracker
 
 	trackers  trackerRegistry
 	trackerMu deadlock.RWMutex
@@ -101,6 +103,9 @@ type Ledger struct {
 	dirsAndPrefix DirsAndPrefix
 
 	tracer logic.EvalTracer
+
+	// avmMetrics, if set, collects AVM execution metrics while evaluating blocks
+	avmMetrics *eval.AVMMetrics
 }
 
 // DirsAndPrefix is a struct that holds the genesis directories and the database file prefix, so ledger can construct full paths to database files
@@ -123,7 +128,7 @@ func OpenLedger[T string | DirsAndPrefix](
 		log.Warnf("The VerifiedTranscationsCacheSize in the config file was misconfigured to have smaller size then the TxPoolSize; The verified cache size was adjusted from %d to %d.", cfg.VerifiedTranscationsCacheSize, cfg.TxPoolSize)
 	}
 	var tracer logic.EvalTracer
-	if cfg.EnableTxnEvalTracer {
+	if cfg.EnableTxnEvalTracer || cfg.EnableExporter {
 		tracer = eval.MakeTxnGroupDeltaTracer(cfg.MaxAcctLookback)
 	}
 
@@ -155,6 +160,10 @@ func OpenLedger[T string | DirsAndPrefix](
 		dirsAndPrefix:                  dirs,
 		tracer:                         tracer,
 	}
+	if cfg.EnableAVMMetrics {
+		l.avmMetrics = eval.MakeAVMMetrics(int(cfg.AVMMetricsTopApps))
+		l.avmMetrics.Register(nil)
+	}
 
 	defer func() {
 		if err != nil {
@@ -246,6 +255,12 @@ func (l *Ledger) reloadLedger() error {
 		&l.metrics,        // provides metrics reporting support
 		&l.spVerification, // provides state proof verification support
 	}
+	if l.stateHistoryEnabled() {
+		// the state history tracker reads the state the committed rounds modify, so it has to commit before accts
+		trackers = append([]ledgerTracker{&l.stateHistory}, trackers...)
+	} else if l.cfg.EnableStateHistory {
+		l.log.Warnf("reloadLedger: EnableStateHistory has no effect on non-archival nodes")
+	}
 
 	l.accts.initialize(l.cfg)
 	l.acctsOnline.initialize(l.cfg)
@@ -428,6 +443,10 @@ func (l *Ledger) Close() {
 	// last, we close the underlying database connections.
 	l.blockDBs.Close()
 	l.trackerDBs.Close()
+
+	if l.avmMetrics != nil {
+		l.avmMetrics.Deregister(nil)
+	}
 }
 
 // RegisterBlockListeners registers listeners that will be called when a
@@ -438,6 +457,13 @@ func (l *Ledger) RegisterBlockListeners(listeners []ledgercore.BlockListener) {
 	l.notifier.register(listeners)
 }
 
+// UnregisterBlockListener removes a listener registered with RegisterBlockListeners.
+func (l *Ledger) UnregisterBlockListener(listener ledgercore.BlockListener) {
+	l.trackerMu.RLock()
+	defer l.trackerMu.RUnlock()
+	l.notifier.unregister(listener)
+}
+
 // RegisterVotersCommitListener registers a listener that will be called when a
 // commit is about to cover a round.
 func (l *Ledger) RegisterVotersCommitListener(listener ledgercore.VotersCommitListener) {
@@ -501,13 +527,40 @@ func (l *Ledger) GetLastCatchpointLabel() string {
 	return l.catchpoint.GetLastCatchpointLabel()
 }
 
+// ProveAccount returns a proof that the account data of addr is included in
+// the catchpoint balances trie, as of the round of the tracker database.
+func (l *Ledger) ProveAccount(addr basics.Address) (ledgercore.BalancesTrieProof, error) {
+	l.trackerMu.RLock()
+	defer l.trackerMu.RUnlock()
+	return l.catchpoint.proveAccount(addr)
+}
+
+// ProveResource is like ProveAccount, for the asset holding/params or the
+// application local state/params of addr.
+func (l *Ledger) ProveResource(addr basics.Address, cidx basics.CreatableIndex, ctype basics.CreatableType) (ledgercore.BalancesTrieProof, error) {
+	l.trackerMu.RLock()
+	defer l.trackerMu.RUnlock()
+	return l.catchpoint.proveResource(addr, cidx, ctype)
+}
+
+// ProveKv is like ProveAccount, for a key-value pair such as an application box.
+func (l *Ledger) ProveKv(key string) (ledgercore.BalancesTrieProof, error) {
+	l.trackerMu.RLock()
+	defer l.trackerMu.RUnlock()
+	return l.catchpoint.proveKv(key)
+}
+
 // GetCreatorForRound takes a CreatableIndex and a CreatableType and tries to
 // look up a creator address, setting ok to false if the query succeeded but no
 // creator was found.
 func (l *Ledger) GetCreatorForRound(rnd basics.Round, cidx basics.CreatableIndex, ctype basics.CreatableType) (creator basics.Address, ok bool, err error) {
 	l.trackerMu.RLock()
 	defer l.trackerMu.RUnlock()
-	return l.accts.GetCreatorForRound(rnd, cidx, ctype)
+	creator, ok, err = l.accts.GetCreatorForRound(rnd, cidx, ctype)
+	if l.useStateHistory(err) {
+		return l.stateHistory.lookupCreator(rnd, cidx, ctype)
+	}
+	return creator, ok, err
 }
 
 // GetCreator is like GetCreatorForRound, but for the latest round and race-free
@@ -525,6 +578,26 @@ func (l *Ledger) GetStateDeltaForRound(rnd basics.Round) (ledgercore.StateDelta,
 	return l.accts.lookupStateDelta(rnd)
 }
 
+// evalBlock evaluates a block the ledger validates or adds. Unlike the
+// speculative evaluations of the transaction pool, these are also traced for
+// avmMetrics, which counts them if the block is added.
+func (l *Ledger) evalBlock(ctx context.Context, blk bookkeeping.Block, validate bool, executionPool execpool.BacklogPool) (ledgercore.StateDelta, error) {
+	if l.avmMetrics == nil {
+		return eval.Eval(ctx, l, blk, validate, l.verifiedTxnCache, executionPool, l.tracer)
+	}
+	blockTracer := l.avmMetrics.BlockTracer()
+	var tracer logic.EvalTracer = blockTracer
+	if l.tracer != nil {
+		tracer = logic.MultiEvalTracer{l.tracer, blockTracer}
+	}
+	// only blockTracer is safe for the concurrent evaluation of logicsigs
+	delta, err := eval.EvalWithSigTracer(ctx, l, blk, validate, l.verifiedTxnCache, executionPool, tracer, blockTracer)
+	if err == nil {
+		l.avmMetrics.Evaluated(blk, blockTracer)
+	}
+	return delta, err
+}
+
 // GetTracer returns the logic.EvalTracer attached to the ledger--can be nil.
 func (l *Ledger) GetTracer() logic.EvalTracer {
 	return l.tracer
@@ -572,7 +645,7 @@ func (l *Ledger) LookupAccount(round basics.Round, addr basics.Address) (data le
 	l.trackerMu.RLock()
 	defer l.trackerMu.RUnlock()
 
-	data, rnd, rewardsVersion, rewardsLevel, err := l.accts.lookupWithoutRewards(round, addr, true /* take lock */)
+	data, rnd, rewardsVersion, rewardsLevel, err := l.lookupWithoutRewards(round, addr)
 	if err != nil {
 		return ledgercore.AccountData{}, basics.Round(0), basics.MicroAlgos{}, err
 	}
@@ -583,6 +656,124 @@ func (l *Ledger) LookupAccount(round basics.Round, addr basics.Address) (data le
 	return data, rnd, withoutRewards, nil
 }
 
+// LookupAccountWithResources is like LookupAccount, but also returns the
+// resources (assets and applications) of the account at the given round.
+func (l *Ledger) LookupAccountWithResources(round basics.Round, addr basics.Address) (data basics.AccountData, validThrough basics.Round, withoutRewards basics.MicroAlgos, err error) {
+	l.trackerMu.RLock()
+	defer l.trackerMu.RUnlock()
+
+	acct, rnd, rewardsVersion, rewardsLevel, err := l.lookupWithoutRewards(round, addr)
+	if err != nil {
+		return basics.AccountData{}, basics.Round(0), basics.MicroAlgos{}, err
+	}
+
+	// A resource of the account at round either still exists, or it was
+	// modified after round. The in-memory deltas have to be collected before
+	// the persisted history, so that no round is missed by a concurrent commit.
+	resources := l.accts.lookupDeltasResources(addr)
+	if l.stateHistoryEnabled() {
+		modified, err := l.stateHistory.lookupModifiedResources(round, addr)
+		if err != nil {
+			return basics.AccountData{}, basics.Round(0), basics.MicroAlgos{}, err
+		}
+		for cidx, ctype := range modified {
+			resources[cidx] = ctype
+		}
+	}
+	latest, _, _, err := l.accts.lookupLatest(addr)
+	if err != nil {
+		return basics.AccountData{}, basics.Round(0), basics.MicroAlgos{}, err
+	}
+	for aidx := range latest.AssetParams {
+		resources[basics.CreatableIndex(aidx)] = basics.AssetCreatable
+	}
+	for aidx := range latest.Assets {
+		resources[basics.CreatableIndex(aidx)] = basics.AssetCreatable
+	}
+	for aidx := range latest.AppParams {
+		resources[basics.CreatableIndex(aidx)] = basics.AppCreatable
+	}
+	for aidx := range latest.AppLocalStates {
+		resources[basics.CreatableIndex(aidx)] = basics.AppCreatable
+	}
+
+	// Intentionally apply (pending) rewards up to rnd, remembering the old value
+	withoutRewards = acct.MicroAlgos
+	acct = acct.WithUpdatedRewards(config.Consensus[rewardsVersion], rewardsLevel)
+	ledgercore.AssignAccountData(&data, acct)
+
+	for cidx, ctype := range resources {
+		res, err := l.lookupResourceUnlocked(round, addr, cidx, ctype)
+		if err != nil {
+			return basics.AccountData{}, basics.Round(0), basics.MicroAlgos{}, err
+		}
+		if res.AssetParams != nil {
+			if data.AssetParams == nil {
+				data.AssetParams = make(map[basics.AssetIndex]basics.AssetParams)
+			}
+			data.AssetParams[basics.AssetIndex(cidx)] = *res.AssetParams
+		}
+		if res.AssetHolding != nil {
+			if data.Assets == nil {
+				data.Assets = make(map[basics.AssetIndex]basics.AssetHolding)
+			}
+			data.Assets[basics.AssetIndex(cidx)] = *res.AssetHolding
+		}
+		if res.AppParams != nil {
+			if data.AppParams == nil {
+				data.AppParams = make(map[basics.AppIndex]basics.AppParams)
+			}
+			data.AppParams[basics.AppIndex(cidx)] = *res.AppParams
+		}
+		if res.AppLocalState != nil {
+			if data.AppLocalStates == nil {
+				data.AppLocalStates = make(map[basics.AppIndex]basics.AppLocalState)
+			}
+			data.AppLocalStates[basics.AppIndex(cidx)] = *res.AppLocalState
+		}
+	}
+	return data, rnd, withoutRewards, nil
+}
+
+// lookupWithoutRewards looks up the account data from the accounts tracker,
+// falling back to the state history for rounds the tracker no longer has.
+// Requires trackerMu to be held.
+func (l *Ledger) lookupWithoutRewards(round basics.Round, addr basics.Address) (data ledgercore.AccountData, validThrough basics.Round, rewardsVersion protocol.ConsensusVersion, rewardsLevel uint64, err error) {
+	data, validThrough, rewardsVersion, rewardsLevel, err = l.accts.lookupWithoutRewards(round, addr, true /* take lock */)
+	if !l.useStateHistory(err) {
+		return
+	}
+
+	hdr, err := l.BlockHdr(round)
+	if err != nil {
+		return ledgercore.AccountData{}, basics.Round(0), "", 0, err
+	}
+	data, err = l.stateHistory.lookupAccount(round, addr)
+	if err != nil {
+		return ledgercore.AccountData{}, basics.Round(0), "", 0, err
+	}
+	return data, round, hdr.CurrentProtocol, hdr.RewardsLevel, nil
+}
+
+// stateHistoryEnabled returns true if the state history tracker is registered.
+func (l *Ledger) stateHistoryEnabled() bool {
+	return l.cfg.Archival && l.cfg.EnableStateHistory
+}
+
+// StateHistoryEnabled returns true if the ledger keeps the state history, so
+// that the state of rounds older than its lookback window can be looked up.
+func (l *Ledger) StateHistoryEnabled() bool {
+	return l.stateHistoryEnabled()
+}
+
+// useStateHistory returns true if a lookup failed because the accounts
+// tracker no longer has the requested round, and it has to be looked up in the
+// state history instead.
+func (l *Ledger) useStateHistory(err error) bool {
+	var roErr *RoundOffsetError
+	return l.stateHistoryEnabled() && errors.As(err, &roErr)
+}
+
 // LookupApplication loads an application resource that matches the request parameters from the ledger.
 func (l *Ledger) LookupApplication(rnd basics.Round, addr basics.Address, aidx basics.AppIndex) (ledgercore.AppResource, error) {
 	r, err := l.lookupResource(rnd, addr, basics.CreatableIndex(aidx), basics.AppCreatable)
@@ -606,8 +797,16 @@ func (l *Ledger) lookupResource(rnd basics.Round, addr basics.Address, aidx basi
 	l.trackerMu.RLock()
 	defer l.trackerMu.RUnlock()
 
+	return l.lookupResourceUnlocked(rnd, addr, aidx, ctype)
+}
+
+// lookupResourceUnlocked is lookupResource without taking the trackerMu lock.
+func (l *Ledger) lookupResourceUnlocked(rnd basics.Round, addr basics.Address, aidx basics.CreatableIndex, ctype basics.CreatableType) (ledgercore.AccountResource, error) {
 	// Intentionally apply (pending) rewards up to rnd.
 	res, _, err := l.accts.LookupResource(rnd, addr, aidx, ctype)
+	if l.useStateHistory(err) {
+		res, err = l.stateHistory.lookupResource(rnd, addr, aidx, ctype)
+	}
 	if err != nil {
 		return ledgercore.AccountResource{}, err
 	}
@@ -620,7 +819,11 @@ func (l *Ledger) LookupKv(rnd basics.Round, key string) ([]byte, error) {
 	l.trackerMu.RLock()
 	defer l.trackerMu.RUnlock()
 
-	return l.accts.LookupKv(rnd, key)
+	value, err := l.accts.LookupKv(rnd, key)
+	if l.useStateHistory(err) {
+		return l.stateHistory.lookupKv(rnd, key)
+	}
+	return value, err
 }
 
 // LookupKeysByPrefix searches keys with specific prefix, up to `maxKeyNum`
@@ -650,7 +853,7 @@ func (l *Ledger) LookupWithoutRewards(rnd basics.Round, addr basics.Address) (le
 
 	var result ledgercore.AccountData
 
-	result, validThrough, err := l.accts.LookupWithoutRewards(rnd, addr)
+	result, validThrough, _, _, err := l.lookupWithoutRewards(rnd, addr)
 	if err != nil {
 		return ledgercore.AccountData{}, basics.Round(0), err
 	}
@@ -749,7 +952,7 @@ func (l *Ledger) BlockCert(rnd basics.Round) (blk bookkeeping.Block, cert agreem
 func (l *Ledger) AddBlock(blk bookkeeping.Block, cert agreement.Certificate) error {
 	// passing nil as the executionPool is ok since we've asking the evaluator to skip verification.
 
-	updates, err := eval.Eval(context.Background(), l, blk, false, l.verifiedTxnCache, nil, l.tracer)
+	updates, err := l.evalBlock(context.Background(), blk, false, nil)
 	if err != nil {
 		if errNSBE, ok := err.(ledgercore.ErrNonSequentialBlockEval); ok && errNSBE.EvaluatorRound <= errNSBE.LatestRound {
 			return ledgercore.BlockInLedgerError{
@@ -785,6 +988,9 @@ func (l *Ledger) AddValidatedBlock(vb ledgercore.ValidatedBlock, cert agreement.
 		return err
 	}
 	l.trackers.newBlock(blk, vb.Delta())
+	if l.avmMetrics != nil {
+		l.avmMetrics.Added(blk)
+	}
 	l.log.Debugf("ledger.AddValidatedBlock: added blk %d", blk.Round())
 	return nil
 }
@@ -931,7 +1137,7 @@ func (l *Ledger) FlushCaches() {
 // not a valid block (e.g., it has duplicate transactions, overspends some
 // account, etc.).
 func (l *Ledger) Validate(ctx context.Context, blk bookkeeping.Block, executionPool execpool.BacklogPool) (*ledgercore.ValidatedBlock, error) {
-	delta, err := eval.Eval(ctx, l, blk, true, l.verifiedTxnCache, executionPool, l.tracer)
+	delta, err := l.evalBlock(ctx, blk, true, executionPool)
 	if err != nil {
 		return nil, err
 	}
@@ -112,6 +112,12 @@ func AssignAccountData(a *basics.AccountData, acct AccountData) {
 	a.LastHeartbeat = acct.LastHeartbeat
 }
 
+// LastSeen returns the last round that the account was seen online, either
+// by proposing a block or by sending a heartbeat.
+func (u AccountData) LastSeen() basics.Round {
+	return max(u.LastProposed, u.LastHeartbeat)
+}
+
 // WithUpdatedRewards calls basics account data WithUpdatedRewards
 func (u AccountData) WithUpdatedRewards(proto config.ConsensusParams, rewardsLevel uint64) AccountData {
 	u.MicroAlgos, u.RewardedMicroAlgos, u.RewardsBase = basics.WithUpdatedRewards(
@@ -0,0 +1,74 @@
+// Copyright (C) 2019-2024 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledgercore
+
+import (
+	"errors"
+
+	"github.com/Quarkonium-chain/go-quarkonium/crypto"
+	"github.com/Quarkonium-chain/go-quarkonium/crypto/merkletrie"
+	"github.com/Quarkonium-chain/go-quarkonium/data/basics"
+)
+
+// ErrBalancesTrieDisabled is returned when a balances trie proof is requested
+// from a node which doesn't track catchpoints.
+var ErrBalancesTrieDisabled = errors.New("catchpoint tracking is disabled, the balances trie is unavailable")
+
+// ErrNotInBalancesTrie is returned when the requested account, resource or
+// box has no entry in the balances trie.
+var ErrNotInBalancesTrie = errors.New("no such entry in the balances trie")
+
+// BalancesTrieProof proves the inclusion of an account, resource or box hash
+// in the catchpoint balances trie.
+//
+//msgp:ignore BalancesTrieProof
+type BalancesTrieProof struct {
+	// Round is the round of the account state the trie is built over.
+	Round basics.Round
+	// Root is the root hash of the trie.
+	Root crypto.Digest
+	// Leaf is the proven trie element, as built by the trackerdb hash builders.
+	Leaf []byte
+	// Proof is the path from the root of the trie to the leaf.
+	Proof merkletrie.Proof
+
+	// BlockHash is the hash of the block header of Round.
+	BlockHash crypto.Digest
+	// Totals are the account totals of Round.
+	Totals AccountTotals
+	// StateProofVerificationHash is the hash of the state proof verification
+	// data of Round, if the consensus protocol of Round has catchpoint labels
+	// committing to it.
+	StateProofVerificationHash *crypto.Digest
+	// Commitment commits to Root the way a catchpoint label does, as if a
+	// catchpoint was taken at Round, see Commit.
+	Commitment string
+}
+
+// Commit returns the commitment of the proof, formatted like a catchpoint
+// label: the round, followed by the hash of the block hash, the trie root, the
+// encoded totals and, if set, the state proof verification hash. Unlike a
+// catchpoint label, its round is the round of the account state rather than
+// CatchpointLookback rounds later, so that it can be computed by any node
+// whose accounts database is at that round, and checked by clients against
+// the block of that round.
+func (p BalancesTrieProof) Commit() string {
+	if p.StateProofVerificationHash != nil {
+		return makeLabel(MakeCatchpointLabelMakerCurrent(p.Round, &p.BlockHash, &p.Root, p.Totals, p.StateProofVerificationHash))
+	}
+	return makeLabel(MakeCatchpointLabelMakerV6(p.Round, &p.BlockHash, &p.Root, p.Totals))
+}
@@ -113,13 +113,17 @@ func (l *CatchpointLabelMakerCurrent) message() string {
 
 // MakeLabel returns the user-facing representation of this catchpoint label. ( i.e. the "label" )
 func MakeLabel(l CatchpointLabelMaker) string {
-	hash := crypto.Hash(l.buffer())
-	encodedHash := base32Encoder.EncodeToString(hash[:])
-	out := fmt.Sprintf("%d#%s", l.round(), encodedHash)
+	out := makeLabel(l)
 	logging.Base().Infof("Creating a catchpoint label %s for %s", out, l.message())
 	return out
 }
 
+func makeLabel(l CatchpointLabelMaker) string {
+	hash := crypto.Hash(l.buffer())
+	encodedHash := base32Encoder.EncodeToString(hash[:])
+	return fmt.Sprintf("%d#%s", l.round(), encodedHash)
+}
+
 // ParseCatchpointLabel parse the given label and breaks it into the round and hash components. In case of a parsing failure,
 // the returned err is non-nil.
 func ParseCatchpointLabel(label string) (round basics.Round, hash crypto.Digest, err error) {
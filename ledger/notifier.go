@@ -100,6 +100,21 @@ func (bn *blockNotifier) register(listeners []ledgercore.BlockListener) {
 	bn.listeners = append(bn.listeners, listeners...)
 }
 
+// unregister removes a previously registered listener. A block notification
+// already in progress may still reach the listener after it has been removed.
+func (bn *blockNotifier) unregister(listener ledgercore.BlockListener) {
+	bn.mu.Lock()
+	defer bn.mu.Unlock()
+
+	listeners := make([]ledgercore.BlockListener, 0, len(bn.listeners))
+	for _, l := range bn.listeners {
+		if l != listener {
+			listeners = append(listeners, l)
+		}
+	}
+	bn.listeners = listeners
+}
+
 func (bn *blockNotifier) newBlock(blk bookkeeping.Block, delta ledgercore.StateDelta) {
 	bn.mu.Lock()
 	defer bn.mu.Unlock()
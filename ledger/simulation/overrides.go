@@ -0,0 +1,402 @@
+// Copyright (C) 2019-2024 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package simulation
+
+import (
+	"fmt"
+
+	"github.com/algorand/avm-abi/apps"
+
+	"github.com/Quarkonium-chain/go-quarkonium/config"
+	"github.com/Quarkonium-chain/go-quarkonium/data/basics"
+	"github.com/Quarkonium-chain/go-quarkonium/ledger/ledgercore"
+)
+
+// StateOverrides are changes to the ledger state a simulation is evaluated
+// against. They only last for the duration of the simulation.
+type StateOverrides struct {
+	_struct struct{} `codec:",omitempty"`
+
+	Accounts []AccountOverride `codec:"accounts"`
+	Apps     []AppOverride     `codec:"apps"`
+	Boxes    []BoxOverride     `codec:"boxes"`
+}
+
+// AccountOverride overrides the state of an account.
+type AccountOverride struct {
+	_struct struct{} `codec:",omitempty"`
+
+	Address basics.Address `codec:"address"`
+	// Data, if set, replaces the account data. Its resources, and the counts
+	// of resources, are ignored: they are the ones of the ledger, updated by
+	// Assets, AppLocals and the boxes set by StateOverrides.Boxes.
+	Data *basics.AccountData `codec:"data"`
+	// Assets set asset holdings of the account, opting it in if needed.
+	Assets []AssetHoldingOverride `codec:"assets"`
+	// AppLocals set key-values in the local states of the account, opting it
+	// in if needed.
+	AppLocals []AppLocalStateOverride `codec:"app-local-states"`
+}
+
+// AssetHoldingOverride sets the holding of an asset.
+type AssetHoldingOverride struct {
+	_struct struct{} `codec:",omitempty"`
+
+	AssetID basics.AssetIndex `codec:"asset-id"`
+	Amount  uint64            `codec:"amount"`
+	Frozen  bool              `codec:"is-frozen"`
+}
+
+// AppLocalStateOverride sets key-values in the local state of an application.
+type AppLocalStateOverride struct {
+	_struct struct{} `codec:",omitempty"`
+
+	AppID    basics.AppIndex     `codec:"app-id"`
+	KeyValue basics.TealKeyValue `codec:"key-value"`
+}
+
+// AppOverride sets key-values in the global state of an application.
+type AppOverride struct {
+	_struct struct{} `codec:",omitempty"`
+
+	AppID       basics.AppIndex     `codec:"app-id"`
+	GlobalState basics.TealKeyValue `codec:"global-state"`
+}
+
+// BoxOverride sets the content of a box, creating it if needed.
+type BoxOverride struct {
+	_struct struct{} `codec:",omitempty"`
+
+	AppID basics.AppIndex `codec:"app-id"`
+	Name  []byte          `codec:"name"`
+	Value []byte          `codec:"value"`
+}
+
+// stateOverlay holds StateOverrides resolved against the ledger state they
+// apply to, as looked up by the simulatorLedger.
+type stateOverlay struct {
+	accounts  map[basics.Address]ledgercore.AccountData
+	holdings  map[ledgercore.AccountAsset]basics.AssetHolding
+	appParams map[ledgercore.AccountApp]basics.AppParams
+	appLocals map[ledgercore.AccountApp]basics.AppLocalState
+	kvs       map[string][]byte
+	// totals are the latest totals, counting the overridden accounts.
+	totals ledgercore.AccountTotals
+}
+
+func (o *stateOverlay) account(addr basics.Address) (ledgercore.AccountData, bool) {
+	if o == nil {
+		return ledgercore.AccountData{}, false
+	}
+	ad, ok := o.accounts[addr]
+	return ad, ok
+}
+
+func (o *stateOverlay) kv(key string) ([]byte, bool) {
+	if o == nil {
+		return nil, false
+	}
+	value, ok := o.kvs[key]
+	return value, ok
+}
+
+// LookupApplication is part of the ledger.Ledger interface.
+func (l simulatorLedger) LookupApplication(rnd basics.Round, addr basics.Address, aidx basics.AppIndex) (ledgercore.AppResource, error) {
+	result, err := l.lookupApplication(rnd, addr, aidx)
+	if err != nil || l.overlay == nil {
+		return result, err
+	}
+	key := ledgercore.AccountApp{Address: addr, App: aidx}
+	if params, ok := l.overlay.appParams[key]; ok {
+		result.AppParams = &params
+	}
+	if state, ok := l.overlay.appLocals[key]; ok {
+		result.AppLocalState = &state
+	}
+	return result, nil
+}
+
+// LookupAsset is part of the ledger.Ledger interface.
+func (l simulatorLedger) LookupAsset(rnd basics.Round, addr basics.Address, aidx basics.AssetIndex) (ledgercore.AssetResource, error) {
+	result, err := l.lookupAsset(rnd, addr, aidx)
+	if err != nil || l.overlay == nil {
+		return result, err
+	}
+	if holding, ok := l.overlay.holdings[ledgercore.AccountAsset{Address: addr, Asset: aidx}]; ok {
+		result.AssetHolding = &holding
+	}
+	return result, nil
+}
+
+func invalidOverride(format string, args ...interface{}) error {
+	return InvalidRequestError{SimulatorError{fmt.Errorf("invalid state override: "+format, args...)}}
+}
+
+// stateOverlayBuilder resolves StateOverrides against the latest round of a
+// simulatorLedger without an overlay.
+type stateOverlayBuilder struct {
+	ledger  simulatorLedger
+	rnd     basics.Round
+	proto   config.ConsensusParams
+	overlay *stateOverlay
+	// original holds the ledger state of the overridden accounts.
+	original map[basics.Address]ledgercore.AccountData
+}
+
+func (b *stateOverlayBuilder) account(addr basics.Address) (ledgercore.AccountData, error) {
+	if ad, ok := b.overlay.accounts[addr]; ok {
+		return ad, nil
+	}
+	ad, _, err := b.ledger.LookupWithoutRewards(b.rnd, addr)
+	if err != nil {
+		return ledgercore.AccountData{}, err
+	}
+	b.original[addr] = ad
+	return ad, nil
+}
+
+func (b *stateOverlayBuilder) appParams(aidx basics.AppIndex) (basics.Address, basics.AppParams, error) {
+	key := ledgercore.AccountApp{App: aidx}
+	creator, ok, err := b.ledger.GetCreatorForRound(b.rnd, basics.CreatableIndex(aidx), basics.AppCreatable)
+	if err != nil {
+		return basics.Address{}, basics.AppParams{}, err
+	}
+	if !ok {
+		return basics.Address{}, basics.AppParams{}, invalidOverride("app %d does not exist", aidx)
+	}
+	key.Address = creator
+	if params, ok := b.overlay.appParams[key]; ok {
+		return creator, params, nil
+	}
+	res, err := b.ledger.lookupApplication(b.rnd, creator, aidx)
+	if err != nil {
+		return basics.Address{}, basics.AppParams{}, err
+	}
+	if res.AppParams == nil {
+		return basics.Address{}, basics.AppParams{}, invalidOverride("app %d does not exist", aidx)
+	}
+	return creator, *res.AppParams, nil
+}
+
+// setKeyValues sets kv in state, checking they fit schema.
+func (b *stateOverlayBuilder) setKeyValues(state basics.TealKeyValue, kv basics.TealKeyValue, schema basics.StateSchema) (basics.TealKeyValue, error) {
+	state = state.Clone()
+	if state == nil {
+		state = make(basics.TealKeyValue, len(kv))
+	}
+	for key, value := range kv {
+		if len(key) > b.proto.MaxAppKeyLen {
+			return nil, invalidOverride("key %#x is too long", key)
+		}
+		if value.Type == basics.TealBytesType && len(key)+len(value.Bytes) > b.proto.MaxAppSumKeyValueLens {
+			return nil, invalidOverride("value of key %#x is too long", key)
+		}
+		state[key] = value
+	}
+	count, err := state.ToStateSchema()
+	if err != nil {
+		return nil, invalidOverride("%v", err)
+	}
+	if count.NumUint > schema.NumUint || count.NumByteSlice > schema.NumByteSlice {
+		return nil, invalidOverride("%d uints and %d byte slices exceed the schema %v", count.NumUint, count.NumByteSlice, schema)
+	}
+	return state, nil
+}
+
+func (b *stateOverlayBuilder) overrideAccount(ao AccountOverride) error {
+	if _, ok := b.original[ao.Address]; ok {
+		return invalidOverride("account %s is overridden more than once", ao.Address)
+	}
+	ad, err := b.account(ao.Address)
+	if err != nil {
+		return err
+	}
+	if ao.Data != nil {
+		base := ad.AccountBaseData
+		ad = ledgercore.ToAccountData(*ao.Data)
+		ad.TotalAppSchema = base.TotalAppSchema
+		ad.TotalExtraAppPages = base.TotalExtraAppPages
+		ad.TotalAppParams = base.TotalAppParams
+		ad.TotalAppLocalStates = base.TotalAppLocalStates
+		ad.TotalAssetParams = base.TotalAssetParams
+		ad.TotalAssets = base.TotalAssets
+		ad.TotalBoxes = base.TotalBoxes
+		ad.TotalBoxBytes = base.TotalBoxBytes
+	}
+
+	for _, ho := range ao.Assets {
+		key := ledgercore.AccountAsset{Address: ao.Address, Asset: ho.AssetID}
+		if _, ok := b.overlay.holdings[key]; ok {
+			return invalidOverride("asset %d of account %s is overridden more than once", ho.AssetID, ao.Address)
+		}
+		_, ok, err := b.ledger.GetCreatorForRound(b.rnd, basics.CreatableIndex(ho.AssetID), basics.AssetCreatable)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return invalidOverride("asset %d does not exist", ho.AssetID)
+		}
+		res, err := b.ledger.lookupAsset(b.rnd, ao.Address, ho.AssetID)
+		if err != nil {
+			return err
+		}
+		if res.AssetHolding == nil {
+			ad.TotalAssets++
+		}
+		b.overlay.holdings[key] = basics.AssetHolding{Amount: ho.Amount, Frozen: ho.Frozen}
+	}
+
+	for _, lo := range ao.AppLocals {
+		key := ledgercore.AccountApp{Address: ao.Address, App: lo.AppID}
+		if _, ok := b.overlay.appLocals[key]; ok {
+			return invalidOverride("local state of app %d of account %s is overridden more than once", lo.AppID, ao.Address)
+		}
+		_, params, err := b.appParams(lo.AppID)
+		if err != nil {
+			return err
+		}
+		res, err := b.ledger.lookupApplication(b.rnd, ao.Address, lo.AppID)
+		if err != nil {
+			return err
+		}
+		var state basics.AppLocalState
+		if res.AppLocalState != nil {
+			state = *res.AppLocalState
+		} else {
+			state.Schema = params.LocalStateSchema
+			ad.TotalAppLocalStates++
+			ad.TotalAppSchema = ad.TotalAppSchema.AddSchema(params.LocalStateSchema)
+		}
+		state.KeyValue, err = b.setKeyValues(state.KeyValue, lo.KeyValue, state.Schema)
+		if err != nil {
+			return err
+		}
+		b.overlay.appLocals[key] = state
+	}
+
+	b.overlay.accounts[ao.Address] = ad
+	return nil
+}
+
+func (b *stateOverlayBuilder) overrideApp(ao AppOverride) error {
+	creator, params, err := b.appParams(ao.AppID)
+	if err != nil {
+		return err
+	}
+	params.GlobalState, err = b.setKeyValues(params.GlobalState, ao.GlobalState, params.GlobalStateSchema)
+	if err != nil {
+		return err
+	}
+	b.overlay.appParams[ledgercore.AccountApp{Address: creator, App: ao.AppID}] = params
+	return nil
+}
+
+func (b *stateOverlayBuilder) overrideBox(bo BoxOverride) error {
+	if len(bo.Name) == 0 || len(bo.Name) > b.proto.MaxAppKeyLen {
+		return invalidOverride("box name length %d must be between 1 and %d", len(bo.Name), b.proto.MaxAppKeyLen)
+	}
+	if uint64(len(bo.Value)) > b.proto.MaxBoxSize {
+		return invalidOverride("box size %d exceeds %d", len(bo.Value), b.proto.MaxBoxSize)
+	}
+	if _, _, err := b.appParams(bo.AppID); err != nil {
+		return err
+	}
+	key := apps.MakeBoxKey(uint64(bo.AppID), string(bo.Name))
+	old, ok := b.overlay.kvs[key]
+	if !ok {
+		var err error
+		old, err = b.ledger.LookupKv(b.rnd, key)
+		if err != nil {
+			return err
+		}
+	}
+
+	appAddr := bo.AppID.Address()
+	ad, err := b.account(appAddr)
+	if err != nil {
+		return err
+	}
+	if old == nil {
+		ad.TotalBoxes = basics.AddSaturate(ad.TotalBoxes, 1)
+		ad.TotalBoxBytes = basics.AddSaturate(ad.TotalBoxBytes, uint64(len(bo.Name)))
+	}
+	ad.TotalBoxBytes = basics.AddSaturate(basics.SubSaturate(ad.TotalBoxBytes, uint64(len(old))), uint64(len(bo.Value)))
+	b.overlay.accounts[appAddr] = ad
+
+	value := bo.Value
+	if value == nil {
+		// a nil value would read as a missing box.
+		value = []byte{}
+	}
+	b.overlay.kvs[key] = value
+	return nil
+}
+
+// makeStateOverlay resolves overrides against the latest round of l.
+func makeStateOverlay(l simulatorLedger, overrides StateOverrides) (*stateOverlay, error) {
+	l.overlay = nil
+	hdr, err := l.BlockHdr(l.latest())
+	if err != nil {
+		return nil, err
+	}
+	b := stateOverlayBuilder{
+		ledger: l,
+		rnd:    l.latest(),
+		proto:  config.Consensus[hdr.CurrentProtocol],
+		overlay: &stateOverlay{
+			accounts:  make(map[basics.Address]ledgercore.AccountData),
+			holdings:  make(map[ledgercore.AccountAsset]basics.AssetHolding),
+			appParams: make(map[ledgercore.AccountApp]basics.AppParams),
+			appLocals: make(map[ledgercore.AccountApp]basics.AppLocalState),
+			kvs:       make(map[string][]byte),
+		},
+		original: make(map[basics.Address]ledgercore.AccountData),
+	}
+
+	for _, ao := range overrides.Accounts {
+		if err := b.overrideAccount(ao); err != nil {
+			return nil, err
+		}
+	}
+	for _, ao := range overrides.Apps {
+		if err := b.overrideApp(ao); err != nil {
+			return nil, err
+		}
+	}
+	for _, bo := range overrides.Boxes {
+		if err := b.overrideBox(bo); err != nil {
+			return nil, err
+		}
+	}
+
+	// the evaluator checks that the money in the overridden accounts is
+	// conserved, starting from totals counting it.
+	_, totals, err := l.LatestTotals()
+	if err != nil {
+		return nil, err
+	}
+	var ot basics.OverflowTracker
+	for addr, ad := range b.overlay.accounts {
+		totals.DelAccount(b.proto, b.original[addr], &ot)
+		totals.AddAccount(b.proto, ad, &ot)
+	}
+	if ot.Overflowed {
+		return nil, invalidOverride("the overridden balances overflow the total supply")
+	}
+	b.overlay.totals = totals
+	return b.overlay, nil
+}
@@ -0,0 +1,186 @@
+// Copyright (C) 2019-2024 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package simulation
+
+import (
+	"testing"
+
+	"github.com/algorand/avm-abi/apps"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Quarkonium-chain/go-quarkonium/crypto"
+	"github.com/Quarkonium-chain/go-quarkonium/data/basics"
+	"github.com/Quarkonium-chain/go-quarkonium/data/transactions"
+	"github.com/Quarkonium-chain/go-quarkonium/data/txntest"
+	simulationtesting "github.com/Quarkonium-chain/go-quarkonium/ledger/simulation/testing"
+	"github.com/Quarkonium-chain/go-quarkonium/protocol"
+	"github.com/Quarkonium-chain/go-quarkonium/test/partitiontest"
+)
+
+func TestSimulateStateOverrides(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	env := simulationtesting.PrepareSimulatorTest(t)
+	defer env.Close()
+	s := MakeSimulator(env.Ledger, false)
+	sender := env.Accounts[0]
+
+	// the app approves calls only while its reserve is over 1000 and its
+	// caller holds some of the asset, and logs the content of box "b".
+	assetID := env.CreateAsset(sender.Addr, basics.AssetParams{Total: 100})
+	appID := env.CreateApp(sender.Addr, simulationtesting.AppParams{
+		ApprovalProgram: `#pragma version 8
+txn ApplicationID
+bz end
+byte "reserve"
+app_global_get
+int 1000
+>
+assert
+txn Sender
+int 0
+asset_holding_get AssetBalance
+assert
+assert
+txn Sender
+byte "seen"
+app_local_get
+assert
+byte "b"
+box_get
+assert
+log
+end:
+int 1`,
+		ClearStateProgram: `#pragma version 8
+int 1`,
+		GlobalStateSchema: basics.StateSchema{NumUint: 1},
+		LocalStateSchema:  basics.StateSchema{NumUint: 1},
+	})
+	latest := env.Ledger.Latest()
+
+	poor := basics.Address(crypto.Hash([]byte("poor")))
+	call := env.TxnInfo.NewTxn(txntest.Txn{
+		Type:          protocol.ApplicationCallTx,
+		Sender:        poor,
+		ApplicationID: appID,
+		ForeignAssets: []basics.AssetIndex{assetID},
+		Boxes:         []transactions.BoxRef{{Name: []byte("b")}},
+	})
+	pay := env.TxnInfo.NewTxn(txntest.Txn{
+		Type:     protocol.PaymentTx,
+		Sender:   poor,
+		Receiver: sender.Addr,
+		Amount:   5_000_000,
+	})
+	request := Request{
+		TxnGroups:            [][]transactions.SignedTxn{txntest.Group(&call, &pay)},
+		AllowEmptySignatures: true,
+	}
+
+	result, err := s.Simulate(request)
+	require.NoError(t, err)
+	require.NotEmpty(t, result.TxnGroups[0].FailureMessage)
+
+	request.StateOverrides = &StateOverrides{
+		Accounts: []AccountOverride{{
+			Address:   poor,
+			Data:      &basics.AccountData{MicroAlgos: basics.MicroAlgos{Raw: 10_000_000}},
+			Assets:    []AssetHoldingOverride{{AssetID: assetID, Amount: 7}},
+			AppLocals: []AppLocalStateOverride{{AppID: appID, KeyValue: basics.TealKeyValue{"seen": {Type: basics.TealUintType, Uint: 1}}}},
+		}},
+		Apps: []AppOverride{{
+			AppID:       appID,
+			GlobalState: basics.TealKeyValue{"reserve": {Type: basics.TealUintType, Uint: 5000}},
+		}},
+		Boxes: []BoxOverride{{AppID: appID, Name: []byte("b"), Value: []byte("box content")}},
+	}
+	result, err = s.Simulate(request)
+	require.NoError(t, err)
+	require.Empty(t, result.TxnGroups[0].FailureMessage)
+	require.Equal(t, []string{"box content"}, result.TxnGroups[0].Txns[0].Txn.ApplyData.EvalDelta.Logs)
+	ad, ok := result.Block.Delta().Accts.GetData(poor)
+	require.True(t, ok)
+	require.Equal(t, uint64(10_000_000-5_000_000-2*env.TxnInfo.CurrentProtocolParams().MinTxnFee), ad.MicroAlgos.Raw)
+	require.Equal(t, uint64(1), ad.TotalAssets)
+	require.Equal(t, uint64(1), ad.TotalAppLocalStates)
+
+	// the overrides don't change the ledger
+	require.Equal(t, latest, env.Ledger.Latest())
+	ledgerAD, _, _, err := env.Ledger.LookupAccount(latest, poor)
+	require.NoError(t, err)
+	require.Zero(t, ledgerAD.MicroAlgos.Raw)
+	kv, err := env.Ledger.LookupKv(latest, apps.MakeBoxKey(uint64(appID), "b"))
+	require.NoError(t, err)
+	require.Nil(t, kv)
+}
+
+func TestSimulateStateOverridesInvalid(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	env := simulationtesting.PrepareSimulatorTest(t)
+	defer env.Close()
+	s := MakeSimulator(env.Ledger, false)
+	sender := env.Accounts[0]
+
+	appID := env.CreateApp(sender.Addr, simulationtesting.AppParams{
+		ApprovalProgram: `#pragma version 8
+int 1`,
+		ClearStateProgram: `#pragma version 8
+int 1`,
+		GlobalStateSchema: basics.StateSchema{NumUint: 1},
+	})
+	pay := env.TxnInfo.NewTxn(txntest.Txn{
+		Type:     protocol.PaymentTx,
+		Sender:   sender.Addr,
+		Receiver: sender.Addr,
+	}).SignedTxn()
+
+	testCases := map[string]StateOverrides{
+		"unknown app": {Apps: []AppOverride{{AppID: appID + 100}}},
+		"unknown asset": {Accounts: []AccountOverride{{
+			Address: sender.Addr,
+			Assets:  []AssetHoldingOverride{{AssetID: 12345}},
+		}}},
+		"duplicate account": {Accounts: []AccountOverride{{Address: sender.Addr}, {Address: sender.Addr}}},
+		"schema exceeded": {Apps: []AppOverride{{
+			AppID:       appID,
+			GlobalState: basics.TealKeyValue{"a": {Type: basics.TealBytesType, Bytes: "x"}},
+		}}},
+		"empty box name": {Boxes: []BoxOverride{{AppID: appID}}},
+		"supply overflow": {Accounts: []AccountOverride{{
+			Address: sender.Addr,
+			Data:    &basics.AccountData{MicroAlgos: basics.MicroAlgos{Raw: 1 << 63}},
+		}, {
+			Address: env.Accounts[1].Addr,
+			Data:    &basics.AccountData{MicroAlgos: basics.MicroAlgos{Raw: 1 << 63}},
+		}}},
+	}
+	for name, overrides := range testCases {
+		overrides := overrides
+		t.Run(name, func(t *testing.T) {
+			_, err := s.Simulate(Request{
+				TxnGroups:            [][]transactions.SignedTxn{{pay}},
+				AllowEmptySignatures: true,
+				StateOverrides:       &overrides,
+			})
+			require.ErrorAs(t, err, &InvalidRequestError{})
+		})
+	}
+}
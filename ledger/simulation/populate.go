@@ -0,0 +1,471 @@
+// Copyright (C) 2019-2024 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"github.com/Quarkonium-chain/go-quarkonium/config"
+	"github.com/Quarkonium-chain/go-quarkonium/data/basics"
+	"github.com/Quarkonium-chain/go-quarkonium/data/transactions"
+	"github.com/Quarkonium-chain/go-quarkonium/data/transactions/logic"
+	"github.com/Quarkonium-chain/go-quarkonium/ledger/ledgercore"
+	"github.com/Quarkonium-chain/go-quarkonium/protocol"
+)
+
+// PopulatedResourceArrays are the references to add to the foreign arrays of a transaction, so that
+// its group can access the unnamed resources it accessed in simulation without
+// AllowUnnamedResources.
+type PopulatedResourceArrays struct {
+	Accounts []basics.Address
+	Assets   []basics.AssetIndex
+	Apps     []basics.AppIndex
+	// Boxes with App 0 refer to the called app. Those with an empty name are only there to
+	// increase the box IO budget.
+	Boxes []logic.BoxRef
+}
+
+// Empty returns true if there are no references to add.
+func (p *PopulatedResourceArrays) Empty() bool {
+	return len(p.Accounts) == 0 && len(p.Assets) == 0 && len(p.Apps) == 0 && len(p.Boxes) == 0
+}
+
+// Apply adds the references to the foreign arrays of the transaction. The apps are added before
+// the boxes, which may refer to them.
+func (p *PopulatedResourceArrays) Apply(txn *transactions.Transaction) error {
+	if p.Empty() {
+		return nil
+	}
+	if txn.Type != protocol.ApplicationCallTx {
+		return fmt.Errorf("cannot add references to a %s transaction", txn.Type)
+	}
+	txn.Accounts = append(txn.Accounts, p.Accounts...)
+	txn.ForeignAssets = append(txn.ForeignAssets, p.Assets...)
+	txn.ForeignApps = append(txn.ForeignApps, p.Apps...)
+	for _, box := range p.Boxes {
+		ref := transactions.BoxRef{Name: []byte(box.Name)}
+		if box.App != 0 && box.App != txn.ApplicationID {
+			index := -1
+			for i, app := range txn.ForeignApps {
+				if app == box.App {
+					index = i
+					break
+				}
+			}
+			if index < 0 {
+				return fmt.Errorf("box of app %d cannot be referenced without the app", box.App)
+			}
+			ref.Index = uint64(index + 1)
+		}
+		txn.Boxes = append(txn.Boxes, ref)
+	}
+	return nil
+}
+
+// txnReferences are the references of an app call, with those being populated
+type txnReferences struct {
+	txn   *transactions.Transaction
+	added *PopulatedResourceArrays
+	proto *config.ConsensusParams
+}
+
+func (r *txnReferences) room(accounts, assets, apps, boxes int) bool {
+	txn := r.txn
+	if txn.Type != protocol.ApplicationCallTx {
+		return false
+	}
+	total := len(txn.Accounts) + len(txn.ForeignAssets) + len(txn.ForeignApps) + len(txn.Boxes) +
+		len(r.added.Accounts) + len(r.added.Assets) + len(r.added.Apps) + len(r.added.Boxes)
+	return len(txn.Accounts)+len(r.added.Accounts)+accounts <= r.proto.MaxAppTxnAccounts &&
+		len(txn.ForeignAssets)+len(r.added.Assets)+assets <= r.proto.MaxAppTxnForeignAssets &&
+		len(txn.ForeignApps)+len(r.added.Apps)+apps <= r.proto.MaxAppTxnForeignApps &&
+		len(txn.Boxes)+len(r.added.Boxes)+boxes <= r.proto.MaxAppBoxReferences &&
+		total+accounts+assets+apps+boxes <= r.proto.MaxAppTotalTxnReferences
+}
+
+func (r *txnReferences) hasApp(app basics.AppIndex) bool {
+	if r.txn.Type != protocol.ApplicationCallTx {
+		return false
+	}
+	if r.txn.ApplicationID == app {
+		return true
+	}
+	for _, foreign := range r.txn.ForeignApps {
+		if foreign == app {
+			return true
+		}
+	}
+	for _, added := range r.added.Apps {
+		if added == app {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *txnReferences) hasAsset(aid basics.AssetIndex) bool {
+	if r.txn.Type != protocol.ApplicationCallTx {
+		return false
+	}
+	for _, foreign := range r.txn.ForeignAssets {
+		if foreign == aid {
+			return true
+		}
+	}
+	for _, added := range r.added.Assets {
+		if added == aid {
+			return true
+		}
+	}
+	return false
+}
+
+// hasAccount tells whether the account is available to the app call, also as the account of an
+// app it references
+func (r *txnReferences) hasAccount(addr basics.Address) bool {
+	if r.txn.Type != protocol.ApplicationCallTx {
+		return false
+	}
+	if r.txn.Sender == addr {
+		return true
+	}
+	for _, accounts := range [][]basics.Address{r.txn.Accounts, r.added.Accounts} {
+		for _, account := range accounts {
+			if account == addr {
+				return true
+			}
+		}
+	}
+	for _, apps := range [][]basics.AppIndex{{r.txn.ApplicationID}, r.txn.ForeignApps, r.added.Apps} {
+		for _, app := range apps {
+			if app != 0 && app.Address() == addr {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (r *txnReferences) hasBox(box logic.BoxRef) bool {
+	for _, added := range r.added.Boxes {
+		if added == box {
+			return true
+		}
+	}
+	return false
+}
+
+// resourcePacker assigns the unnamed resources accessed by a group to the foreign arrays of its
+// app calls
+type resourcePacker struct {
+	txns []txnReferences
+}
+
+func (p *resourcePacker) anyHas(has func(r *txnReferences) bool) bool {
+	for i := range p.txns {
+		if has(&p.txns[i]) {
+			return true
+		}
+	}
+	return false
+}
+
+// place adds the references to the first app call that has room for them, trying each way of
+// adding them in order. A way returns the room it needs, or false if it does not apply to the
+// transaction.
+func (p *resourcePacker) place(what string, ways ...func(r *txnReferences) (need [4]int, add func(), ok bool)) error {
+	for _, way := range ways {
+		for i := range p.txns {
+			r := &p.txns[i]
+			need, add, ok := way(r)
+			if ok && r.room(need[0], need[1], need[2], need[3]) {
+				add()
+				return nil
+			}
+		}
+	}
+	return fmt.Errorf("no room in the group for %s", what)
+}
+
+func (p *resourcePacker) placeApp(app basics.AppIndex) error {
+	if p.anyHas(func(r *txnReferences) bool { return r.hasApp(app) }) {
+		return nil
+	}
+	return p.place(fmt.Sprintf("app %d", app), func(r *txnReferences) ([4]int, func(), bool) {
+		return [4]int{0, 0, 1, 0}, func() { r.added.Apps = append(r.added.Apps, app) }, true
+	})
+}
+
+func (p *resourcePacker) placeAsset(aid basics.AssetIndex) error {
+	if p.anyHas(func(r *txnReferences) bool { return r.hasAsset(aid) }) {
+		return nil
+	}
+	return p.place(fmt.Sprintf("asset %d", aid), func(r *txnReferences) ([4]int, func(), bool) {
+		return [4]int{0, 1, 0, 0}, func() { r.added.Assets = append(r.added.Assets, aid) }, true
+	})
+}
+
+func (p *resourcePacker) placeAccount(addr basics.Address) error {
+	if p.anyHas(func(r *txnReferences) bool { return r.hasAccount(addr) }) {
+		return nil
+	}
+	return p.place(fmt.Sprintf("account %s", addr), func(r *txnReferences) ([4]int, func(), bool) {
+		return [4]int{1, 0, 0, 0}, func() { r.added.Accounts = append(r.added.Accounts, addr) }, true
+	})
+}
+
+// placeHolding makes the holding available by having an app call reference both the account and
+// the asset, preferring app calls that already reference one of them
+func (p *resourcePacker) placeHolding(holding ledgercore.AccountAsset) error {
+	addr, aid := holding.Address, holding.Asset
+	if p.anyHas(func(r *txnReferences) bool { return r.hasAccount(addr) && r.hasAsset(aid) }) {
+		return nil
+	}
+	addAccount := func(r *txnReferences) func() {
+		return func() { r.added.Accounts = append(r.added.Accounts, addr) }
+	}
+	addAsset := func(r *txnReferences) func() {
+		return func() { r.added.Assets = append(r.added.Assets, aid) }
+	}
+	return p.place(fmt.Sprintf("holding of asset %d by %s", aid, addr),
+		func(r *txnReferences) ([4]int, func(), bool) {
+			return [4]int{1, 0, 0, 0}, addAccount(r), r.hasAsset(aid)
+		},
+		func(r *txnReferences) ([4]int, func(), bool) {
+			return [4]int{0, 1, 0, 0}, addAsset(r), r.hasAccount(addr)
+		},
+		func(r *txnReferences) ([4]int, func(), bool) {
+			return [4]int{1, 1, 0, 0}, func() { addAccount(r)(); addAsset(r)() }, true
+		})
+}
+
+// placeLocal makes the local state available by having an app call reference both the account
+// and the app
+func (p *resourcePacker) placeLocal(local ledgercore.AccountApp) error {
+	addr, app := local.Address, local.App
+	if p.anyHas(func(r *txnReferences) bool { return r.hasAccount(addr) && r.hasApp(app) }) {
+		return nil
+	}
+	addAccount := func(r *txnReferences) func() {
+		return func() { r.added.Accounts = append(r.added.Accounts, addr) }
+	}
+	addApp := func(r *txnReferences) func() {
+		return func() { r.added.Apps = append(r.added.Apps, app) }
+	}
+	return p.place(fmt.Sprintf("local state of app %d for %s", app, addr),
+		func(r *txnReferences) ([4]int, func(), bool) {
+			return [4]int{1, 0, 0, 0}, addAccount(r), r.hasApp(app)
+		},
+		func(r *txnReferences) ([4]int, func(), bool) {
+			// the app also makes its own account available
+			return [4]int{0, 0, 1, 0}, addApp(r), r.hasAccount(addr) || app.Address() == addr
+		},
+		func(r *txnReferences) ([4]int, func(), bool) {
+			return [4]int{1, 0, 1, 0}, func() { addAccount(r)(); addApp(r)() }, true
+		})
+}
+
+// placeBox references the box from an app call that references its app, adding the app if needed
+func (p *resourcePacker) placeBox(box logic.BoxRef) error {
+	if p.anyHas(func(r *txnReferences) bool { return r.hasBox(box) }) {
+		return nil
+	}
+	ref := func(r *txnReferences) logic.BoxRef {
+		if r.txn.ApplicationID == box.App {
+			return logic.BoxRef{Name: box.Name}
+		}
+		return box
+	}
+	return p.place(fmt.Sprintf("box %q of app %d", box.Name, box.App),
+		func(r *txnReferences) ([4]int, func(), bool) {
+			return [4]int{0, 0, 0, 1}, func() { r.added.Boxes = append(r.added.Boxes, ref(r)) }, r.hasApp(box.App)
+		},
+		func(r *txnReferences) ([4]int, func(), bool) {
+			return [4]int{0, 0, 1, 1}, func() {
+				r.added.Apps = append(r.added.Apps, box.App)
+				r.added.Boxes = append(r.added.Boxes, box)
+			}, true
+		})
+}
+
+func (p *resourcePacker) placeEmptyBoxRef() error {
+	return p.place("an extra box reference", func(r *txnReferences) ([4]int, func(), bool) {
+		return [4]int{0, 0, 0, 1}, func() { r.added.Boxes = append(r.added.Boxes, logic.BoxRef{}) }, true
+	})
+}
+
+func sortedAddresses(m map[basics.Address]struct{}) []basics.Address {
+	addrs := make([]basics.Address, 0, len(m))
+	for addr := range m {
+		addrs = append(addrs, addr)
+	}
+	sort.Slice(addrs, func(i, j int) bool { return bytes.Compare(addrs[i][:], addrs[j][:]) < 0 })
+	return addrs
+}
+
+func sortedApps(m map[basics.AppIndex]struct{}) []basics.AppIndex {
+	apps := make([]basics.AppIndex, 0, len(m))
+	for app := range m {
+		apps = append(apps, app)
+	}
+	sort.Slice(apps, func(i, j int) bool { return apps[i] < apps[j] })
+	return apps
+}
+
+func sortedAssets(m map[basics.AssetIndex]struct{}) []basics.AssetIndex {
+	assets := make([]basics.AssetIndex, 0, len(m))
+	for aid := range m {
+		assets = append(assets, aid)
+	}
+	sort.Slice(assets, func(i, j int) bool { return assets[i] < assets[j] })
+	return assets
+}
+
+// packLocal places the resources that only the transaction can access, because its programs
+// predate resource sharing
+func (p *resourcePacker) packLocal(gi int, tracker *ResourceTracker) error {
+	only := resourcePacker{txns: p.txns[gi : gi+1]}
+	for _, app := range sortedApps(tracker.Apps) {
+		if err := only.placeApp(app); err != nil {
+			return err
+		}
+	}
+	for _, aid := range sortedAssets(tracker.Assets) {
+		if err := only.placeAsset(aid); err != nil {
+			return err
+		}
+	}
+	for _, addr := range sortedAddresses(tracker.Accounts) {
+		if err := only.placeAccount(addr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// packGlobal places the resources that any transaction of the group can make available. Those with
+// the strictest placement go first: cross-products need both halves in the same transaction, and
+// boxes need their app.
+func (p *resourcePacker) packGlobal(tracker *ResourceTracker) error {
+	holdings := make([]ledgercore.AccountAsset, 0, len(tracker.AssetHoldings))
+	for holding := range tracker.AssetHoldings {
+		holdings = append(holdings, holding)
+	}
+	sort.Slice(holdings, func(i, j int) bool {
+		if holdings[i].Asset != holdings[j].Asset {
+			return holdings[i].Asset < holdings[j].Asset
+		}
+		return bytes.Compare(holdings[i].Address[:], holdings[j].Address[:]) < 0
+	})
+	for _, holding := range holdings {
+		if err := p.placeHolding(holding); err != nil {
+			return err
+		}
+	}
+
+	locals := make([]ledgercore.AccountApp, 0, len(tracker.AppLocals))
+	for local := range tracker.AppLocals {
+		locals = append(locals, local)
+	}
+	sort.Slice(locals, func(i, j int) bool {
+		if locals[i].App != locals[j].App {
+			return locals[i].App < locals[j].App
+		}
+		return bytes.Compare(locals[i].Address[:], locals[j].Address[:]) < 0
+	})
+	for _, local := range locals {
+		if err := p.placeLocal(local); err != nil {
+			return err
+		}
+	}
+
+	boxes := make([]logic.BoxRef, 0, len(tracker.Boxes))
+	for box := range tracker.Boxes {
+		boxes = append(boxes, box)
+	}
+	sort.Slice(boxes, func(i, j int) bool {
+		if boxes[i].App != boxes[j].App {
+			return boxes[i].App < boxes[j].App
+		}
+		return boxes[i].Name < boxes[j].Name
+	})
+	for _, box := range boxes {
+		if err := p.placeBox(box); err != nil {
+			return err
+		}
+	}
+
+	for _, app := range sortedApps(tracker.Apps) {
+		if err := p.placeApp(app); err != nil {
+			return err
+		}
+	}
+	for _, aid := range sortedAssets(tracker.Assets) {
+		if err := p.placeAsset(aid); err != nil {
+			return err
+		}
+	}
+	for _, addr := range sortedAddresses(tracker.Accounts) {
+		if err := p.placeAccount(addr); err != nil {
+			return err
+		}
+	}
+	for i := 0; i < tracker.NumEmptyBoxRefs; i++ {
+		if err := p.placeEmptyBoxRef(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// populateResourceArrays packs the unnamed resources accessed by the group into the foreign arrays
+// of its app calls, setting PopulatedResourceArrays of the transactions that need references
+// added. If they do not fit, PopulateFailureMessage says why.
+func populateResourceArrays(groupResult *TxnGroupResult, proto *config.ConsensusParams) {
+	added := make([]PopulatedResourceArrays, len(groupResult.Txns))
+	packer := resourcePacker{txns: make([]txnReferences, len(groupResult.Txns))}
+	for i := range groupResult.Txns {
+		packer.txns[i] = txnReferences{txn: &groupResult.Txns[i].Txn.Txn, added: &added[i], proto: proto}
+	}
+
+	err := func() error {
+		// resources of transactions that cannot share them have a single place to go
+		for i := range groupResult.Txns {
+			if tracker := groupResult.Txns[i].UnnamedResourcesAccessed; tracker != nil {
+				if err := packer.packLocal(i, tracker); err != nil {
+					return fmt.Errorf("transaction %d: %w", i, err)
+				}
+			}
+		}
+		if groupResult.UnnamedResourcesAccessed != nil {
+			return packer.packGlobal(groupResult.UnnamedResourcesAccessed)
+		}
+		return nil
+	}()
+	if err != nil {
+		groupResult.PopulateFailureMessage = err.Error()
+		return
+	}
+
+	for i := range added {
+		if !added[i].Empty() {
+			groupResult.Txns[i].PopulatedResourceArrays = &added[i]
+		}
+	}
+}
@@ -0,0 +1,153 @@
+// Copyright (C) 2019-2024 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package simulation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Quarkonium-chain/go-quarkonium/config"
+	"github.com/Quarkonium-chain/go-quarkonium/data/basics"
+	"github.com/Quarkonium-chain/go-quarkonium/data/transactions"
+	"github.com/Quarkonium-chain/go-quarkonium/data/transactions/logic"
+	"github.com/Quarkonium-chain/go-quarkonium/ledger/ledgercore"
+	"github.com/Quarkonium-chain/go-quarkonium/protocol"
+	"github.com/Quarkonium-chain/go-quarkonium/test/partitiontest"
+)
+
+func makePopulateGroup(txns ...transactions.Transaction) TxnGroupResult {
+	group := TxnGroupResult{Txns: make([]TxnResult, len(txns))}
+	for i := range txns {
+		group.Txns[i].Txn.Txn = txns[i]
+	}
+	return group
+}
+
+func appCall(app basics.AppIndex) transactions.Transaction {
+	var txn transactions.Transaction
+	txn.Type = protocol.ApplicationCallTx
+	txn.ApplicationID = app
+	return txn
+}
+
+func TestPopulateAccountLimits(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	proto := config.Consensus[protocol.ConsensusFuture]
+	accounts := make(map[basics.Address]struct{})
+	for i := 0; i <= proto.MaxAppTxnAccounts; i++ {
+		accounts[basics.Address{byte(i + 1)}] = struct{}{}
+	}
+
+	group := makePopulateGroup(appCall(1))
+	group.UnnamedResourcesAccessed = &ResourceTracker{Accounts: accounts}
+	populateResourceArrays(&group, &proto)
+	require.Contains(t, group.PopulateFailureMessage, "no room in the group for account")
+	require.Nil(t, group.Txns[0].PopulatedResourceArrays)
+
+	// a second app call makes room, the accounts fill the first one in order
+	var pay transactions.Transaction
+	pay.Type = protocol.PaymentTx
+	group = makePopulateGroup(pay, appCall(1), appCall(2))
+	group.UnnamedResourcesAccessed = &ResourceTracker{Accounts: accounts}
+	populateResourceArrays(&group, &proto)
+	require.Empty(t, group.PopulateFailureMessage)
+	require.Nil(t, group.Txns[0].PopulatedResourceArrays)
+	require.Len(t, group.Txns[1].PopulatedResourceArrays.Accounts, proto.MaxAppTxnAccounts)
+	require.Equal(t, []basics.Address{{byte(proto.MaxAppTxnAccounts + 1)}}, group.Txns[2].PopulatedResourceArrays.Accounts)
+}
+
+func TestPopulateCrossProducts(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	proto := config.Consensus[protocol.ConsensusFuture]
+	holder := basics.Address{1}
+	user := basics.Address{2}
+
+	withAsset := appCall(1)
+	withAsset.ForeignAssets = []basics.AssetIndex{10}
+	withUser := appCall(2)
+	withUser.Accounts = []basics.Address{user}
+	group := makePopulateGroup(appCall(1), withAsset, withUser)
+	group.UnnamedResourcesAccessed = &ResourceTracker{
+		Accounts:      map[basics.Address]struct{}{holder: {}, user: {}},
+		Apps:          map[basics.AppIndex]struct{}{3: {}},
+		AssetHoldings: map[ledgercore.AccountAsset]struct{}{{Address: holder, Asset: 10}: {}},
+		AppLocals:     map[ledgercore.AccountApp]struct{}{{Address: user, App: 3}: {}},
+	}
+	populateResourceArrays(&group, &proto)
+	require.Empty(t, group.PopulateFailureMessage)
+	require.Nil(t, group.Txns[0].PopulatedResourceArrays)
+	// the holder goes where the asset is, and the app where the user is
+	require.Equal(t, &PopulatedResourceArrays{Accounts: []basics.Address{holder}}, group.Txns[1].PopulatedResourceArrays)
+	require.Equal(t, &PopulatedResourceArrays{Apps: []basics.AppIndex{3}}, group.Txns[2].PopulatedResourceArrays)
+}
+
+func TestPopulateBoxes(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	proto := config.Consensus[protocol.ConsensusFuture]
+	group := makePopulateGroup(appCall(1))
+	group.UnnamedResourcesAccessed = &ResourceTracker{
+		Boxes: map[logic.BoxRef]uint64{
+			{App: 1, Name: "mine"}:   0,
+			{App: 2, Name: "theirs"}: 0,
+		},
+		NumEmptyBoxRefs: 1,
+	}
+	populateResourceArrays(&group, &proto)
+	require.Empty(t, group.PopulateFailureMessage)
+	added := group.Txns[0].PopulatedResourceArrays
+	require.Equal(t, &PopulatedResourceArrays{
+		Apps:  []basics.AppIndex{2},
+		Boxes: []logic.BoxRef{{Name: "mine"}, {App: 2, Name: "theirs"}, {}},
+	}, added)
+
+	txn := group.Txns[0].Txn.Txn
+	require.NoError(t, added.Apply(&txn))
+	require.Equal(t, []basics.AppIndex{2}, txn.ForeignApps)
+	require.Equal(t, []transactions.BoxRef{
+		{Index: 0, Name: []byte("mine")},
+		{Index: 1, Name: []byte("theirs")},
+		{Index: 0, Name: []byte{}},
+	}, txn.Boxes)
+}
+
+func TestPopulateLocalResources(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	proto := config.Consensus[protocol.ConsensusFuture]
+	group := makePopulateGroup(appCall(1), appCall(2))
+	// the account is available to the first app call, but the second cannot share it
+	group.Txns[0].Txn.Txn.Accounts = []basics.Address{{1}}
+	group.Txns[1].UnnamedResourcesAccessed = &ResourceTracker{
+		Accounts: map[basics.Address]struct{}{{1}: {}},
+		Assets:   map[basics.AssetIndex]struct{}{10: {}},
+	}
+	populateResourceArrays(&group, &proto)
+	require.Empty(t, group.PopulateFailureMessage)
+	require.Nil(t, group.Txns[0].PopulatedResourceArrays)
+	require.Equal(t, &PopulatedResourceArrays{
+		Accounts: []basics.Address{{1}},
+		Assets:   []basics.AssetIndex{10},
+	}, group.Txns[1].PopulatedResourceArrays)
+}
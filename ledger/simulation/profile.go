@@ -0,0 +1,239 @@
+// Copyright (C) 2019-2024 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package simulation
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"github.com/google/pprof/profile"
+
+	"github.com/Quarkonium-chain/go-quarkonium/crypto"
+	"github.com/Quarkonium-chain/go-quarkonium/data/transactions"
+	"github.com/Quarkonium-chain/go-quarkonium/data/transactions/logic"
+)
+
+// ExecProfile aggregates the opcode budget spent by the programs evaluated
+// during a simulation, by call stack.
+type ExecProfile struct {
+	Programs []ProfiledProgram
+	// Samples hold the budget spent with each distinct call stack.
+	Samples []ProfileSample
+}
+
+// ProfiledProgram is a program evaluated during a profiled simulation.
+type ProfiledProgram struct {
+	// Name identifies the program, e.g. "app 1002 approval".
+	Name string
+	Hash crypto.Digest
+}
+
+// ProfileFrame is a program of ExecProfile.Programs evaluating the opcode at PC.
+type ProfileFrame struct {
+	Program int
+	PC      int
+}
+
+// ProfileSample is the opcode budget spent with a call stack.
+type ProfileSample struct {
+	// Stack goes from the top level program to the program evaluating the
+	// opcodes, the callers being at the PC of their itxn_submit or itxn.
+	Stack []ProfileFrame
+	// Cost is the opcode budget spent.
+	Cost uint64
+	// Count is the number of opcodes evaluated.
+	Count uint64
+}
+
+type profiledProgramKey struct {
+	name string
+	hash crypto.Digest
+}
+
+// profiler builds an ExecProfile from the evalTracer hooks.
+type profiler struct {
+	profile ExecProfile
+
+	programs map[profiledProgramKey]int
+	samples  map[string]int
+
+	// frames is the stack of programs being evaluated, and costs the cost of
+	// each of them before its current opcode.
+	frames []ProfileFrame
+	costs  []int
+}
+
+func makeProfiler() *profiler {
+	return &profiler{
+		programs: make(map[profiledProgramKey]int),
+		samples:  make(map[string]int),
+	}
+}
+
+func programName(cx *logic.EvalContext) string {
+	if cx.RunMode() == logic.ModeSig {
+		return fmt.Sprintf("logicsig of txn %d", cx.GroupIndex())
+	}
+	if cx.TxnGroup[cx.GroupIndex()].Txn.OnCompletion == transactions.ClearStateOC {
+		return fmt.Sprintf("app %d clear state", cx.AppID())
+	}
+	return fmt.Sprintf("app %d approval", cx.AppID())
+}
+
+func (p *profiler) beforeProgram(cx *logic.EvalContext) {
+	key := profiledProgramKey{name: programName(cx), hash: crypto.Hash(cx.GetProgram())}
+	program, ok := p.programs[key]
+	if !ok {
+		program = len(p.profile.Programs)
+		p.programs[key] = program
+		p.profile.Programs = append(p.profile.Programs, ProfiledProgram{Name: key.name, Hash: key.hash})
+	}
+	p.frames = append(p.frames, ProfileFrame{Program: program})
+	p.costs = append(p.costs, 0)
+}
+
+func (p *profiler) afterProgram() {
+	p.frames = p.frames[:len(p.frames)-1]
+	p.costs = p.costs[:len(p.costs)-1]
+}
+
+func (p *profiler) beforeOpcode(cx *logic.EvalContext) {
+	p.frames[len(p.frames)-1].PC = cx.PC()
+	p.costs[len(p.costs)-1] = cx.Cost()
+}
+
+func (p *profiler) afterOpcode(cx *logic.EvalContext) {
+	cost := cx.Cost() - p.costs[len(p.costs)-1]
+
+	key := make([]byte, 0, 16*len(p.frames))
+	for _, frame := range p.frames {
+		key = binary.BigEndian.AppendUint64(key, uint64(frame.Program))
+		key = binary.BigEndian.AppendUint64(key, uint64(frame.PC))
+	}
+	i, ok := p.samples[string(key)]
+	if !ok {
+		i = len(p.profile.Samples)
+		p.samples[string(key)] = i
+		stack := make([]ProfileFrame, len(p.frames))
+		copy(stack, p.frames)
+		p.profile.Samples = append(p.profile.Samples, ProfileSample{Stack: stack})
+	}
+	p.profile.Samples[i].Cost += uint64(cost)
+	p.profile.Samples[i].Count++
+}
+
+// Pprof converts the profile to the pprof format. Each program is a function,
+// named after the program hash, and each PC a location, whose line is the PC.
+// See ApplyPprofSourceMaps for the use of sourceMaps.
+func (ep *ExecProfile) Pprof(sourceMaps map[crypto.Digest]logic.SourceMap) (*profile.Profile, error) {
+	p := &profile.Profile{
+		SampleType: []*profile.ValueType{
+			{Type: "cost", Unit: "count"},
+			{Type: "opcodes", Unit: "count"},
+		},
+		DefaultSampleType: "cost",
+		PeriodType:        &profile.ValueType{Type: "opcodes", Unit: "count"},
+		Period:            1,
+	}
+
+	for i, program := range ep.Programs {
+		p.Function = append(p.Function, &profile.Function{
+			ID:         uint64(i + 1),
+			Name:       program.Name,
+			SystemName: program.Hash.String(),
+			Filename:   program.Hash.String(),
+		})
+	}
+
+	locations := make(map[ProfileFrame]*profile.Location)
+	location := func(frame ProfileFrame) *profile.Location {
+		if loc, ok := locations[frame]; ok {
+			return loc
+		}
+		loc := &profile.Location{
+			ID:      uint64(len(p.Location) + 1),
+			Address: uint64(frame.PC),
+			Line:    []profile.Line{{Function: p.Function[frame.Program], Line: int64(frame.PC)}},
+		}
+		locations[frame] = loc
+		p.Location = append(p.Location, loc)
+		return loc
+	}
+
+	for _, sample := range ep.Samples {
+		// pprof stacks start with the leaf.
+		stack := make([]*profile.Location, len(sample.Stack))
+		for i, frame := range sample.Stack {
+			stack[len(stack)-1-i] = location(frame)
+		}
+		p.Sample = append(p.Sample, &profile.Sample{
+			Location: stack,
+			Value:    []int64{int64(sample.Cost), int64(sample.Count)},
+		})
+	}
+	if err := ApplyPprofSourceMaps(p, sourceMaps); err != nil {
+		return nil, err
+	}
+	return p, p.CheckValid()
+}
+
+// ApplyPprofSourceMaps maps the PCs of a profile returned by Pprof to source
+// lines, for the programs whose source map is in sourceMaps, by program hash.
+func ApplyPprofSourceMaps(p *profile.Profile, sourceMaps map[crypto.Digest]logic.SourceMap) error {
+	pcLocations := make(map[*profile.Function]map[int]logic.SourceLocation)
+	for _, function := range p.Function {
+		hash, err := crypto.DigestFromString(function.SystemName)
+		if err != nil {
+			continue
+		}
+		sourceMap, ok := sourceMaps[hash]
+		if !ok {
+			continue
+		}
+		locations, err := sourceMap.PCLocations()
+		if err != nil {
+			return fmt.Errorf("source map of %s: %w", function.Name, err)
+		}
+		pcLocations[function] = locations
+		if len(sourceMap.Sources) > 0 {
+			function.Filename = sourceMap.Sources[0]
+		}
+	}
+
+	for _, loc := range p.Location {
+		for i := range loc.Line {
+			locations, ok := pcLocations[loc.Line[i].Function]
+			if !ok {
+				continue
+			}
+			// source map lines start at 0, pprof ones at 1.
+			loc.Line[i].Line = int64(locations[int(loc.Address)].Line + 1)
+		}
+	}
+	return nil
+}
+
+// WritePprof writes the profile in the gzipped pprof protobuf format, as
+// read by go tool pprof. See Pprof for the use of sourceMaps.
+func (ep *ExecProfile) WritePprof(w io.Writer, sourceMaps map[crypto.Digest]logic.SourceMap) error {
+	p, err := ep.Pprof(sourceMaps)
+	if err != nil {
+		return err
+	}
+	return p.Write(w)
+}
@@ -0,0 +1,197 @@
+// Copyright (C) 2019-2024 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/google/pprof/profile"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Quarkonium-chain/go-quarkonium/crypto"
+	"github.com/Quarkonium-chain/go-quarkonium/data/basics"
+	"github.com/Quarkonium-chain/go-quarkonium/data/transactions"
+	"github.com/Quarkonium-chain/go-quarkonium/data/transactions/logic"
+	"github.com/Quarkonium-chain/go-quarkonium/data/txntest"
+	simulationtesting "github.com/Quarkonium-chain/go-quarkonium/ledger/simulation/testing"
+	"github.com/Quarkonium-chain/go-quarkonium/protocol"
+	"github.com/Quarkonium-chain/go-quarkonium/test/partitiontest"
+)
+
+// sourcePC returns the PC of the first opcode assembled from the source line.
+func sourcePC(t *testing.T, ops *logic.OpStream, line int) int {
+	pc := -1
+	for offset, location := range ops.OffsetToSource {
+		if location.Line == line && (pc == -1 || offset < pc) {
+			pc = offset
+		}
+	}
+	require.NotEqual(t, -1, pc)
+	return pc
+}
+
+func TestSimulateProfile(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	env := simulationtesting.PrepareSimulatorTest(t)
+	defer env.Close()
+	s := MakeSimulator(env.Ledger, true)
+	sender := env.Accounts[0]
+
+	calleeSource := `#pragma version 8
+byte "x"
+sha256
+pop
+int 1`
+	calleeOps, err := logic.AssembleString(calleeSource)
+	require.NoError(t, err)
+	calleeID := env.CreateApp(sender.Addr, simulationtesting.AppParams{
+		ApprovalProgram:   calleeSource,
+		ClearStateProgram: "#pragma version 8\nint 1",
+	})
+
+	callerSource := fmt.Sprintf(`#pragma version 8
+txn ApplicationID
+bz end
+itxn_begin
+int appl
+itxn_field TypeEnum
+int %d
+itxn_field ApplicationID
+int 0
+itxn_field Fee
+itxn_submit
+end:
+int 1`, calleeID)
+	callerOps, err := logic.AssembleString(callerSource)
+	require.NoError(t, err)
+	callerID := env.CreateApp(sender.Addr, simulationtesting.AppParams{
+		ApprovalProgram:   callerSource,
+		ClearStateProgram: "#pragma version 8\nint 1",
+	})
+	env.TransferAlgos(sender.Addr, callerID.Address(), 1_000_000)
+
+	call := env.TxnInfo.NewTxn(txntest.Txn{
+		Type:          protocol.ApplicationCallTx,
+		Sender:        sender.Addr,
+		ApplicationID: callerID,
+		ForeignApps:   []basics.AppIndex{calleeID},
+		Fee:           2 * env.TxnInfo.CurrentProtocolParams().MinTxnFee,
+	})
+	request := Request{
+		TxnGroups:   [][]transactions.SignedTxn{{call.Txn().Sign(sender.Sk)}},
+		TraceConfig: ExecTraceConfig{Profile: true},
+	}
+
+	result, err := s.Simulate(request)
+	require.NoError(t, err)
+	require.Empty(t, result.TxnGroups[0].FailureMessage)
+	execProfile := result.ExecProfile
+	require.NotNil(t, execProfile)
+
+	require.Equal(t, []ProfiledProgram{
+		{Name: fmt.Sprintf("app %d approval", callerID), Hash: crypto.Hash(callerOps.Program)},
+		{Name: fmt.Sprintf("app %d approval", calleeID), Hash: crypto.Hash(calleeOps.Program)},
+	}, execProfile.Programs)
+
+	submitPC := sourcePC(t, callerOps, 10)
+	sha256PC := sourcePC(t, calleeOps, 2)
+	var cost, innerCost, innerCount uint64
+	var sha256Sample *ProfileSample
+	for i, sample := range execProfile.Samples {
+		cost += sample.Cost
+		require.Equal(t, 0, sample.Stack[0].Program)
+		if len(sample.Stack) == 1 {
+			continue
+		}
+		require.Len(t, sample.Stack, 2)
+		require.Equal(t, ProfileFrame{Program: 0, PC: submitPC}, sample.Stack[0])
+		require.Equal(t, 1, sample.Stack[1].Program)
+		innerCost += sample.Cost
+		innerCount += sample.Count
+		if sample.Stack[1].PC == sha256PC {
+			sha256Sample = &execProfile.Samples[i]
+		}
+	}
+	require.Equal(t, uint64(result.TxnGroups[0].AppBudgetConsumed), cost)
+	require.NotNil(t, sha256Sample)
+	require.Equal(t, uint64(35), sha256Sample.Cost)
+	// all the opcodes of the callee but sha256 cost 1
+	require.Equal(t, 35+innerCount-1, innerCost)
+
+	// the pprof profile uses the PCs as lines, unless given the source map
+	sourceMaps := map[crypto.Digest]logic.SourceMap{
+		crypto.Hash(calleeOps.Program): logic.GetSourceMap([]string{"callee.teal"}, calleeOps.OffsetToSource),
+	}
+	for _, withSourceMap := range []bool{false, true} {
+		var buf bytes.Buffer
+		if withSourceMap {
+			require.NoError(t, execProfile.WritePprof(&buf, sourceMaps))
+		} else {
+			require.NoError(t, execProfile.WritePprof(&buf, nil))
+		}
+		p, err := profile.Parse(&buf)
+		require.NoError(t, err)
+		require.Len(t, p.Function, 2)
+		require.Len(t, p.Sample, len(execProfile.Samples))
+
+		var found bool
+		for _, sample := range p.Sample {
+			leaf := sample.Location[0]
+			if leaf.Line[0].Function.Name != fmt.Sprintf("app %d approval", calleeID) || leaf.Address != uint64(sha256PC) {
+				continue
+			}
+			found = true
+			require.Equal(t, []int64{35, 1}, sample.Value)
+			require.Len(t, sample.Location, 2)
+			require.Equal(t, uint64(submitPC), sample.Location[1].Address)
+			require.Equal(t, int64(submitPC), sample.Location[1].Line[0].Line)
+			if withSourceMap {
+				require.Equal(t, "callee.teal", leaf.Line[0].Function.Filename)
+				require.Equal(t, int64(3), leaf.Line[0].Line)
+			} else {
+				require.Equal(t, int64(sha256PC), leaf.Line[0].Line)
+			}
+		}
+		require.True(t, found)
+	}
+}
+
+func TestSimulateProfileRequiresDeveloperAPI(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	env := simulationtesting.PrepareSimulatorTest(t)
+	defer env.Close()
+	s := MakeSimulator(env.Ledger, false)
+	sender := env.Accounts[0]
+
+	pay := env.TxnInfo.NewTxn(txntest.Txn{
+		Type:     protocol.PaymentTx,
+		Sender:   sender.Addr,
+		Receiver: sender.Addr,
+	}).SignedTxn()
+	_, err := s.Simulate(Request{
+		TxnGroups:            [][]transactions.SignedTxn{{pay}},
+		AllowEmptySignatures: true,
+		TraceConfig:          ExecTraceConfig{Profile: true},
+	})
+	require.ErrorAs(t, err, &InvalidRequestError{})
+}
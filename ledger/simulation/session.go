@@ -0,0 +1,572 @@
+// Copyright (C) 2019-2024 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package simulation
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/Quarkonium-chain/go-quarkonium/config"
+	"github.com/Quarkonium-chain/go-quarkonium/crypto"
+	"github.com/Quarkonium-chain/go-quarkonium/data"
+	"github.com/Quarkonium-chain/go-quarkonium/data/basics"
+	"github.com/Quarkonium-chain/go-quarkonium/data/bookkeeping"
+	"github.com/Quarkonium-chain/go-quarkonium/data/transactions"
+	"github.com/Quarkonium-chain/go-quarkonium/ledger/eval"
+	"github.com/Quarkonium-chain/go-quarkonium/ledger/ledgercore"
+)
+
+const (
+	// DefaultSessionTTL is the time a simulation session is kept without being used, unless specified otherwise.
+	DefaultSessionTTL = 5 * time.Minute
+	// MaxSessionTTL is the longest time a simulation session can be kept without being used.
+	MaxSessionTTL = time.Hour
+	// MaxSessions is the number of simulation sessions a node keeps at once.
+	MaxSessions = 16
+	// MaxSessionRounds is the number of rounds a simulation session can go past the round it started from.
+	MaxSessionRounds = 1000
+)
+
+// ErrSessionNotFound is returned for an unknown or expired simulation session.
+var ErrSessionNotFound = errors.New("simulation session not found")
+
+// SessionRoundUnavailableError is returned for a simulation session discarded
+// because its start round fell out of the ledger's lookback window before its
+// TTL ran out. It matches ErrSessionNotFound.
+type SessionRoundUnavailableError struct {
+	ID         string
+	StartRound basics.Round
+	Oldest     basics.Round
+}
+
+func (e *SessionRoundUnavailableError) Error() string {
+	return fmt.Sprintf("simulation session %s was discarded: its start round %d is no longer available, oldest available round is %d; "+
+		"sessions outlive the ledger's lookback window only on archival nodes with EnableStateHistory set", e.ID, e.StartRound, e.Oldest)
+}
+
+// Is makes SessionRoundUnavailableError match ErrSessionNotFound.
+func (e *SessionRoundUnavailableError) Is(target error) bool {
+	return target == ErrSessionNotFound
+}
+
+// ErrTooManySessions is returned when starting a simulation session while MaxSessions are in use.
+var ErrTooManySessions = fmt.Errorf("too many simulation sessions, at most %d are kept at once", MaxSessions)
+
+// block returns the simulated block of round rnd, or nil if rnd isn't one of
+// the rounds simulated on top of round start.
+func (l simulatorLedger) block(rnd basics.Round) *ledgercore.ValidatedBlock {
+	if rnd <= l.start || rnd > l.latest() {
+		return nil
+	}
+	return &l.blocks[rnd-l.start-1]
+}
+
+// deltas returns the deltas of the simulated rounds up to rnd, latest first.
+func (l simulatorLedger) deltas(rnd basics.Round) []ledgercore.StateDelta {
+	if rnd <= l.start {
+		return nil
+	}
+	if rnd > l.latest() {
+		rnd = l.latest()
+	}
+	deltas := make([]ledgercore.StateDelta, 0, rnd-l.start)
+	for r := rnd; r > l.start; r-- {
+		deltas = append(deltas, l.blocks[r-l.start-1].Delta())
+	}
+	return deltas
+}
+
+// BlockHdr is part of the ledger.Ledger interface.
+func (l simulatorLedger) BlockHdr(rnd basics.Round) (bookkeeping.BlockHeader, error) {
+	if blk := l.block(rnd); blk != nil {
+		return blk.Block().BlockHeader, nil
+	}
+	if rnd > l.start && len(l.blocks) > 0 {
+		return bookkeeping.BlockHeader{}, ledgercore.ErrNoEntry{Round: rnd, Latest: l.latest(), Committed: l.latest()}
+	}
+	return l.Ledger.BlockHdr(rnd)
+}
+
+// CheckDup is part of the ledger.Ledger interface.
+func (l simulatorLedger) CheckDup(proto config.ConsensusParams, current basics.Round, firstValid basics.Round, lastValid basics.Round, txid transactions.Txid, txl ledgercore.Txlease) error {
+	for _, delta := range l.deltas(current - 1) {
+		if _, ok := delta.Txids[txid]; ok {
+			return &ledgercore.TransactionInLedgerError{Txid: txid, InBlockEvaluator: false}
+		}
+		if proto.SupportTransactionLeases && (txl.Lease != [32]byte{}) {
+			if expires, ok := delta.Txleases[txl]; ok && current <= expires {
+				return ledgercore.MakeLeaseInLedgerError(txid, txl, false)
+			}
+		}
+	}
+	return l.Ledger.CheckDup(proto, current, firstValid, lastValid, txid, txl)
+}
+
+// LookupWithoutRewards is part of the ledger.Ledger interface.
+func (l simulatorLedger) LookupWithoutRewards(rnd basics.Round, addr basics.Address) (ledgercore.AccountData, basics.Round, error) {
+	if ad, ok := l.overlay.account(addr); ok {
+		return ad, rnd, nil
+	}
+	if rnd <= l.start {
+		return l.Ledger.LookupWithoutRewards(rnd, addr)
+	}
+	for _, delta := range l.deltas(rnd) {
+		if ad, ok := delta.Accts.GetData(addr); ok {
+			return ad, rnd, nil
+		}
+	}
+	ad, _, err := l.Ledger.LookupWithoutRewards(l.start, addr)
+	return ad, rnd, err
+}
+
+// LookupAccount is part of the ledger.Ledger interface.
+func (l simulatorLedger) LookupAccount(rnd basics.Round, addr basics.Address) (ledgercore.AccountData, basics.Round, basics.MicroAlgos, error) {
+	if _, ok := l.overlay.account(addr); !ok && rnd <= l.start {
+		return l.Ledger.LookupAccount(rnd, addr)
+	}
+	hdr, err := l.BlockHdr(rnd)
+	if err != nil {
+		return ledgercore.AccountData{}, 0, basics.MicroAlgos{}, err
+	}
+	ad, validThrough, err := l.LookupWithoutRewards(rnd, addr)
+	if err != nil {
+		return ledgercore.AccountData{}, 0, basics.MicroAlgos{}, err
+	}
+	withoutRewards := ad.MicroAlgos
+	ad = ad.WithUpdatedRewards(config.Consensus[hdr.CurrentProtocol], hdr.RewardsLevel)
+	return ad, validThrough, withoutRewards, nil
+}
+
+// LookupAgreement is part of the ledger.Ledger interface.
+func (l simulatorLedger) LookupAgreement(rnd basics.Round, addr basics.Address) (basics.OnlineAccountData, error) {
+	if _, ok := l.overlay.account(addr); !ok && rnd <= l.start {
+		return l.Ledger.LookupAgreement(rnd, addr)
+	}
+	hdr, err := l.BlockHdr(rnd)
+	if err != nil {
+		return basics.OnlineAccountData{}, err
+	}
+	ad, _, err := l.LookupWithoutRewards(rnd, addr)
+	if err != nil {
+		return basics.OnlineAccountData{}, err
+	}
+	return ad.OnlineAccountData(config.Consensus[hdr.CurrentProtocol], hdr.RewardsLevel), nil
+}
+
+// OnlineCirculation is part of the ledger.Ledger interface. The online stake
+// of the simulated rounds is the one of round start.
+func (l simulatorLedger) OnlineCirculation(rnd basics.Round, voteRnd basics.Round) (basics.MicroAlgos, error) {
+	if rnd > l.start {
+		rnd = l.start
+	}
+	return l.Ledger.OnlineCirculation(rnd, voteRnd)
+}
+
+// VotersForStateProof is part of the ledger.Ledger interface. There are no
+// voters for the simulated rounds, so their blocks don't commit to any.
+func (l simulatorLedger) VotersForStateProof(rnd basics.Round) (*ledgercore.VotersForRound, error) {
+	if rnd > l.start {
+		return nil, nil
+	}
+	return l.Ledger.VotersForStateProof(rnd)
+}
+
+func (l simulatorLedger) lookupApplication(rnd basics.Round, addr basics.Address, aidx basics.AppIndex) (ledgercore.AppResource, error) {
+	if rnd <= l.start {
+		return l.Ledger.LookupApplication(rnd, addr, aidx)
+	}
+	var result ledgercore.AppResource
+	var paramsFound, stateFound bool
+	for _, delta := range l.deltas(rnd) {
+		if params, ok := delta.Accts.GetAppParams(addr, aidx); ok && !paramsFound {
+			result.AppParams = params.Params
+			paramsFound = true
+		}
+		if state, ok := delta.Accts.GetAppLocalState(addr, aidx); ok && !stateFound {
+			result.AppLocalState = state.LocalState
+			stateFound = true
+		}
+		if paramsFound && stateFound {
+			return result, nil
+		}
+	}
+	base, err := l.Ledger.LookupApplication(l.start, addr, aidx)
+	if err != nil {
+		return ledgercore.AppResource{}, err
+	}
+	if !paramsFound {
+		result.AppParams = base.AppParams
+	}
+	if !stateFound {
+		result.AppLocalState = base.AppLocalState
+	}
+	return result, nil
+}
+
+func (l simulatorLedger) lookupAsset(rnd basics.Round, addr basics.Address, aidx basics.AssetIndex) (ledgercore.AssetResource, error) {
+	if rnd <= l.start {
+		return l.Ledger.LookupAsset(rnd, addr, aidx)
+	}
+	var result ledgercore.AssetResource
+	var paramsFound, holdingFound bool
+	for _, delta := range l.deltas(rnd) {
+		if params, ok := delta.Accts.GetAssetParams(addr, aidx); ok && !paramsFound {
+			result.AssetParams = params.Params
+			paramsFound = true
+		}
+		if holding, ok := delta.Accts.GetAssetHolding(addr, aidx); ok && !holdingFound {
+			result.AssetHolding = holding.Holding
+			holdingFound = true
+		}
+		if paramsFound && holdingFound {
+			return result, nil
+		}
+	}
+	base, err := l.Ledger.LookupAsset(l.start, addr, aidx)
+	if err != nil {
+		return ledgercore.AssetResource{}, err
+	}
+	if !paramsFound {
+		result.AssetParams = base.AssetParams
+	}
+	if !holdingFound {
+		result.AssetHolding = base.AssetHolding
+	}
+	return result, nil
+}
+
+// LookupKv is part of the ledger.Ledger interface.
+func (l simulatorLedger) LookupKv(rnd basics.Round, key string) ([]byte, error) {
+	if value, ok := l.overlay.kv(key); ok {
+		return value, nil
+	}
+	if rnd <= l.start {
+		return l.Ledger.LookupKv(rnd, key)
+	}
+	for _, delta := range l.deltas(rnd) {
+		if kv, ok := delta.KvMods[key]; ok {
+			return kv.Data, nil
+		}
+	}
+	return l.Ledger.LookupKv(l.start, key)
+}
+
+// GetCreatorForRound is part of the ledger.Ledger interface.
+func (l simulatorLedger) GetCreatorForRound(rnd basics.Round, cidx basics.CreatableIndex, ctype basics.CreatableType) (basics.Address, bool, error) {
+	if rnd <= l.start {
+		return l.Ledger.GetCreatorForRound(rnd, cidx, ctype)
+	}
+	for _, delta := range l.deltas(rnd) {
+		if mc, ok := delta.Creatables[cidx]; ok && mc.Ctype == ctype {
+			if !mc.Created {
+				return basics.Address{}, false, nil
+			}
+			return mc.Creator, true, nil
+		}
+	}
+	return l.Ledger.GetCreatorForRound(l.start, cidx, ctype)
+}
+
+// Session is a sequence of simulations, each one building on the state left
+// by the previous ones. Every successful simulation adds a block to the
+// session, and the session can be advanced by empty blocks to reach a later
+// round or time.
+type Session struct {
+	// ID identifies the session in its Sessions.
+	ID string
+
+	ttl          time.Duration
+	developerAPI bool
+
+	// mu serializes the simulations of the session.
+	mu     sync.Mutex
+	ledger simulatorLedger
+
+	// expires is the time, in Unix nanoseconds, the session expires at.
+	expires atomic.Int64
+}
+
+// SessionStatus describes the state of a simulation session.
+type SessionStatus struct {
+	ID string
+	// StartRound is the round of the ledger the session builds on.
+	StartRound basics.Round
+	// Round is the latest round of the session.
+	Round basics.Round
+	// TimeStamp is the timestamp of the latest round of the session.
+	TimeStamp int64
+	// Expires is the time the session is discarded at, unless used before.
+	Expires time.Time
+}
+
+// Status returns the current state of the session.
+func (s *Session) Status() (SessionStatus, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.statusNoLock()
+}
+
+func (s *Session) statusNoLock() (SessionStatus, error) {
+	hdr, err := s.ledger.BlockHdr(s.ledger.latest())
+	if err != nil {
+		return SessionStatus{}, err
+	}
+	return SessionStatus{
+		ID:         s.ID,
+		StartRound: s.ledger.start,
+		Round:      s.ledger.latest(),
+		TimeStamp:  hdr.TimeStamp,
+		Expires:    time.Unix(0, s.expires.Load()),
+	}, nil
+}
+
+func (s *Session) checkRounds(rounds uint64) error {
+	if rounds > MaxSessionRounds-uint64(len(s.ledger.blocks)) {
+		return InvalidRequestError{SimulatorError{
+			fmt.Errorf("simulation session cannot go more than %d rounds past round %d", MaxSessionRounds, s.ledger.start),
+		}}
+	}
+	return nil
+}
+
+// Simulate simulates a transaction group on top of the latest round of the
+// session. If the group is successfully evaluated, its block is added to the
+// session, so that the next simulations see its effects.
+func (s *Session) Simulate(request Request) (Result, error) {
+	if request.Round != 0 {
+		return Result{}, InvalidRequestError{SimulatorError{
+			errors.New("the round of a simulation session is set by its previous simulations"),
+		}}
+	}
+	if request.StateOverrides != nil {
+		return Result{}, InvalidRequestError{SimulatorError{
+			errors.New("state overrides are not supported in simulation sessions"),
+		}}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.checkRounds(1); err != nil {
+		return Result{}, err
+	}
+	simulator := Simulator{ledger: s.ledger, developerAPI: s.developerAPI}
+	result, err := simulator.simulate(request)
+	if err != nil {
+		return Result{}, err
+	}
+	if result.Block != nil && result.TxnGroups[0].FailureMessage == "" {
+		s.ledger.blocks = append(s.ledger.blocks, *result.Block)
+	}
+	return result, nil
+}
+
+// Advance adds the given number of empty rounds to the session. If seconds is
+// not zero, the last of them is timestamped seconds after the current latest
+// round of the session, regardless of the consensus limit on timestamp
+// increments.
+func (s *Session) Advance(rounds uint64, seconds int64) (SessionStatus, error) {
+	if rounds == 0 {
+		return SessionStatus{}, InvalidRequestError{SimulatorError{errors.New("cannot advance a simulation session by 0 rounds")}}
+	}
+	if seconds < 0 {
+		return SessionStatus{}, InvalidRequestError{SimulatorError{errors.New("cannot move a simulation session back in time")}}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.checkRounds(rounds); err != nil {
+		return SessionStatus{}, err
+	}
+	prev, err := s.ledger.BlockHdr(s.ledger.latest())
+	if err != nil {
+		return SessionStatus{}, err
+	}
+	timestamp := prev.TimeStamp + seconds
+
+	blocks := s.ledger.blocks
+	for i := uint64(0); i < rounds; i++ {
+		hdr := bookkeeping.MakeBlock(prev).BlockHeader
+		if seconds != 0 && i == rounds-1 && hdr.TimeStamp < timestamp {
+			hdr.TimeStamp = timestamp
+		}
+		// Empty blocks aren't validated, as the consensus rules would not allow
+		// the timestamp jump.
+		l := s.ledger
+		l.blocks = blocks
+		evaluator, err := eval.StartEvaluator(&l, hdr, eval.EvaluatorOptions{Generate: true})
+		if err != nil {
+			return SessionStatus{}, err
+		}
+		ub, err := evaluator.GenerateBlock(nil)
+		if err != nil {
+			return SessionStatus{}, err
+		}
+		blocks = append(blocks, ledgercore.MakeValidatedBlock(ub.UnfinishedBlock(), ub.UnfinishedDeltas()))
+		prev = blocks[len(blocks)-1].Block().BlockHeader
+	}
+	s.ledger.blocks = blocks
+	return s.statusNoLock()
+}
+
+// Sessions keeps the simulation sessions of a node, discarding the ones left
+// unused for longer than their TTL.
+type Sessions struct {
+	ledger       *data.Ledger
+	developerAPI bool
+	now          func() time.Time
+
+	// keepsHistory is set if the ledger keeps the state history, so that the
+	// start round of a session can be looked up for as long as the session is.
+	keepsHistory bool
+
+	mu       sync.Mutex
+	sessions map[string]*Session
+	// unavailable holds the sessions discarded as their start round fell out
+	// of the lookback window, until their TTL runs out, to report it to Get.
+	unavailable map[string]*Session
+}
+
+// MakeSessions creates an empty set of simulation sessions on top of a ledger.
+func MakeSessions(ledger *data.Ledger, developerAPI bool) *Sessions {
+	return &Sessions{
+		ledger:       ledger,
+		developerAPI: developerAPI,
+		now:          time.Now,
+		keepsHistory: ledger.StateHistoryEnabled(),
+		sessions:     make(map[string]*Session),
+		unavailable:  make(map[string]*Session),
+	}
+}
+
+// pruneNoLock discards the expired sessions, and, unless the ledger keeps the
+// state history, the sessions built on a round older than the ledger's
+// lookback window, whose state can no longer be looked up. The caller is
+// assumed to be holding ss.mu.
+func (ss *Sessions) pruneNoLock(now time.Time) {
+	for id, s := range ss.unavailable {
+		if now.UnixNano() > s.expires.Load() {
+			delete(ss.unavailable, id)
+		}
+	}
+	oldest := ss.ledger.LatestTrackerCommitted()
+	for id, s := range ss.sessions {
+		if now.UnixNano() > s.expires.Load() {
+			delete(ss.sessions, id)
+		} else if !ss.keepsHistory && s.ledger.start < oldest {
+			delete(ss.sessions, id)
+			ss.unavailable[id] = s
+		}
+	}
+}
+
+// Start starts a simulation session on top of the given round, or the latest
+// round of the ledger if round is 0. A session is discarded once unused for
+// ttl, or DefaultSessionTTL if ttl is 0. As the session looks up the state of
+// round, on ledgers that don't keep the state history it is also discarded once
+// round falls out of the ledger's lookback window, MaxAcctLookback rounds
+// behind the latest round, whichever comes first. Get then returns a
+// SessionRoundUnavailableError.
+func (ss *Sessions) Start(round basics.Round, ttl time.Duration) (SessionStatus, error) {
+	if ttl == 0 {
+		ttl = DefaultSessionTTL
+	}
+	if ttl < 0 || ttl > MaxSessionTTL {
+		return SessionStatus{}, InvalidRequestError{SimulatorError{
+			fmt.Errorf("simulation session TTL %v is not within (0, %v]", ttl, MaxSessionTTL),
+		}}
+	}
+	if round == 0 {
+		round = ss.ledger.Latest()
+	} else if round > ss.ledger.Latest() {
+		return SessionStatus{}, InvalidRequestError{SimulatorError{
+			fmt.Errorf("cannot start a simulation session on round %d, latest round is %d", round, ss.ledger.Latest()),
+		}}
+	} else if oldest := ss.ledger.LatestTrackerCommitted(); round < oldest {
+		return SessionStatus{}, InvalidRequestError{SimulatorError{
+			fmt.Errorf("cannot start a simulation session on round %d, oldest available round is %d", round, oldest),
+		}}
+	}
+
+	totals, err := ss.ledger.Totals(round)
+	if err != nil {
+		return SessionStatus{}, err
+	}
+
+	var rawID [16]byte
+	crypto.RandBytes(rawID[:])
+	session := &Session{
+		ID:           hex.EncodeToString(rawID[:]),
+		ttl:          ttl,
+		developerAPI: ss.developerAPI,
+		ledger:       simulatorLedger{Ledger: ss.ledger, start: round, startTotals: &totals},
+	}
+
+	ss.mu.Lock()
+	defer ss.mu.Unlock()
+	now := ss.now()
+	ss.pruneNoLock(now)
+	if len(ss.sessions) >= MaxSessions {
+		return SessionStatus{}, ErrTooManySessions
+	}
+	session.expires.Store(now.Add(ttl).UnixNano())
+	status, err := session.statusNoLock()
+	if err != nil {
+		return SessionStatus{}, err
+	}
+	ss.sessions[session.ID] = session
+	return status, nil
+}
+
+// Get returns the simulation session with the given ID, extending its
+// lifetime by its TTL.
+func (ss *Sessions) Get(id string) (*Session, error) {
+	ss.mu.Lock()
+	defer ss.mu.Unlock()
+	now := ss.now()
+	ss.pruneNoLock(now)
+	session, ok := ss.sessions[id]
+	if !ok {
+		if s, ok := ss.unavailable[id]; ok {
+			return nil, &SessionRoundUnavailableError{ID: id, StartRound: s.ledger.start, Oldest: ss.ledger.LatestTrackerCommitted()}
+		}
+		return nil, ErrSessionNotFound
+	}
+	session.expires.Store(now.Add(session.ttl).UnixNano())
+	return session, nil
+}
+
+// Stop discards the simulation session with the given ID.
+func (ss *Sessions) Stop(id string) error {
+	ss.mu.Lock()
+	defer ss.mu.Unlock()
+	ss.pruneNoLock(ss.now())
+	if _, ok := ss.unavailable[id]; ok {
+		delete(ss.unavailable, id)
+		return nil
+	}
+	if _, ok := ss.sessions[id]; !ok {
+		return ErrSessionNotFound
+	}
+	delete(ss.sessions, id)
+	return nil
+}
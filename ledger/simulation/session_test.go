@@ -0,0 +1,238 @@
+// Copyright (C) 2019-2024 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package simulation
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Quarkonium-chain/go-quarkonium/config"
+	"github.com/Quarkonium-chain/go-quarkonium/crypto"
+	"github.com/Quarkonium-chain/go-quarkonium/data/basics"
+	"github.com/Quarkonium-chain/go-quarkonium/data/transactions"
+	"github.com/Quarkonium-chain/go-quarkonium/data/txntest"
+	simulationtesting "github.com/Quarkonium-chain/go-quarkonium/ledger/simulation/testing"
+	"github.com/Quarkonium-chain/go-quarkonium/protocol"
+	"github.com/Quarkonium-chain/go-quarkonium/test/partitiontest"
+)
+
+func TestSimulationSession(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	env := simulationtesting.PrepareSimulatorTest(t)
+	defer env.Close()
+	sender := env.Accounts[0]
+	latest := env.Ledger.Latest()
+
+	appID := env.CreateApp(sender.Addr, simulationtesting.AppParams{
+		ApprovalProgram: `#pragma version 8
+byte "counter"
+byte "counter"
+app_global_get
+int 1
++
+app_global_put
+int 1`,
+		ClearStateProgram: `#pragma version 8
+int 1`,
+		GlobalStateSchema: basics.StateSchema{NumUint: 1},
+	})
+	latest++
+	require.Equal(t, latest, env.Ledger.Latest())
+
+	sessions := MakeSessions(env.Ledger, false)
+	status, err := sessions.Start(0, 0)
+	require.NoError(t, err)
+	require.Equal(t, latest, status.StartRound)
+	require.Equal(t, latest, status.Round)
+
+	session, err := sessions.Get(status.ID)
+	require.NoError(t, err)
+
+	// fund an account that only exists in the session
+	receiver := basics.Address(crypto.Hash([]byte("receiver")))
+	pay := env.TxnInfo.NewTxn(txntest.Txn{
+		Type:     protocol.PaymentTx,
+		Sender:   sender.Addr,
+		Receiver: receiver,
+		Amount:   1_000_000,
+	}).SignedTxn()
+	result, err := session.Simulate(Request{TxnGroups: [][]transactions.SignedTxn{{pay}}, AllowEmptySignatures: true})
+	require.NoError(t, err)
+	require.Empty(t, result.TxnGroups[0].FailureMessage)
+	require.Equal(t, latest, result.LastRound)
+
+	// the same transaction is now in the session ledger
+	result, err = session.Simulate(Request{TxnGroups: [][]transactions.SignedTxn{{pay}}, AllowEmptySignatures: true})
+	require.NoError(t, err)
+	require.Contains(t, result.TxnGroups[0].FailureMessage, "already in ledger")
+
+	// spend from the account funded by the session
+	payBack := env.TxnInfo.NewTxn(txntest.Txn{
+		Type:     protocol.PaymentTx,
+		Sender:   receiver,
+		Receiver: sender.Addr,
+		Amount:   100_000,
+	}).SignedTxn()
+	result, err = session.Simulate(Request{TxnGroups: [][]transactions.SignedTxn{{payBack}}, AllowEmptySignatures: true})
+	require.NoError(t, err)
+	require.Empty(t, result.TxnGroups[0].FailureMessage)
+	require.Equal(t, latest+1, result.LastRound)
+
+	// the app state accumulates over the session, on top of the app creation
+	for i := uint64(1); i <= 3; i++ {
+		call := env.TxnInfo.NewTxn(txntest.Txn{
+			Type:          protocol.ApplicationCallTx,
+			Sender:        sender.Addr,
+			ApplicationID: appID,
+			Note:          []byte{byte(i)},
+		}).SignedTxn()
+		result, err = session.Simulate(Request{TxnGroups: [][]transactions.SignedTxn{{call}}, AllowEmptySignatures: true})
+		require.NoError(t, err)
+		require.Empty(t, result.TxnGroups[0].FailureMessage)
+		params, ok := result.Block.Delta().Accts.GetAppParams(sender.Addr, appID)
+		require.True(t, ok)
+		require.Equal(t, basics.TealValue{Type: basics.TealUintType, Uint: i + 1}, params.Params.GlobalState["counter"])
+	}
+
+	status, err = session.Status()
+	require.NoError(t, err)
+	require.Equal(t, latest, status.StartRound)
+	require.Equal(t, latest+5, status.Round)
+
+	// advance the session in time
+	advanced, err := session.Advance(10, 3600)
+	require.NoError(t, err)
+	require.Equal(t, latest+15, advanced.Round)
+	require.Equal(t, status.TimeStamp+3600, advanced.TimeStamp)
+
+	_, err = session.Advance(MaxSessionRounds, 0)
+	require.ErrorAs(t, err, &InvalidRequestError{})
+
+	// the session doesn't change the ledger
+	require.Equal(t, latest, env.Ledger.Latest())
+	ad, _, _, err := env.Ledger.LookupAccount(latest, receiver)
+	require.NoError(t, err)
+	require.Zero(t, ad.MicroAlgos.Raw)
+
+	require.NoError(t, sessions.Stop(status.ID))
+	_, err = sessions.Get(status.ID)
+	require.ErrorIs(t, err, ErrSessionNotFound)
+	require.ErrorIs(t, sessions.Stop(status.ID), ErrSessionNotFound)
+}
+
+func TestSimulationSessionsLimits(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	env := simulationtesting.PrepareSimulatorTest(t)
+	defer env.Close()
+	sessions := MakeSessions(env.Ledger, false)
+	now := time.Now()
+	sessions.now = func() time.Time { return now }
+
+	_, err := sessions.Start(0, 2*MaxSessionTTL)
+	require.ErrorAs(t, err, &InvalidRequestError{})
+	_, err = sessions.Start(env.Ledger.Latest()+1, 0)
+	require.ErrorAs(t, err, &InvalidRequestError{})
+
+	for i := 0; i < MaxSessions; i++ {
+		_, err = sessions.Start(0, time.Millisecond)
+		require.NoError(t, err)
+	}
+	_, err = sessions.Start(0, 0)
+	require.ErrorIs(t, err, ErrTooManySessions)
+
+	// expired sessions make room for new ones
+	now = now.Add(2 * time.Millisecond)
+	status, err := sessions.Start(0, 0)
+	require.NoError(t, err)
+	_, err = sessions.Get(status.ID)
+	require.NoError(t, err)
+
+	// sessions are discarded once their start round falls out of the lookback window
+	start := env.Ledger.Latest()
+	for i := uint64(0); i <= env.Config.MaxAcctLookback; i++ {
+		env.TransferAlgos(env.Accounts[0].Addr, env.Accounts[0].Addr, 0)
+	}
+	require.Eventually(t, func() bool {
+		return env.Ledger.LatestTrackerCommitted() > start
+	}, 10*time.Second, 10*time.Millisecond)
+	_, err = sessions.Get(status.ID)
+	require.ErrorIs(t, err, ErrSessionNotFound)
+	var unavailableErr *SessionRoundUnavailableError
+	require.ErrorAs(t, err, &unavailableErr)
+	require.Equal(t, start, unavailableErr.StartRound)
+	_, err = sessions.Start(start, 0)
+	require.ErrorAs(t, err, &InvalidRequestError{})
+
+	// the discarded session is reported until its TTL runs out
+	now = now.Add(DefaultSessionTTL + time.Millisecond)
+	_, err = sessions.Get(status.ID)
+	require.ErrorIs(t, err, ErrSessionNotFound)
+	require.False(t, errors.As(err, &unavailableErr))
+}
+
+func TestSimulationSessionStateHistory(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	cfg := config.GetDefaultLocal()
+	cfg.Archival = true
+	cfg.EnableStateHistory = true
+	env := simulationtesting.PrepareSimulatorTestWithConfig(t, cfg)
+	defer env.Close()
+	sender := env.Accounts[0]
+	receiver := env.Accounts[1]
+
+	sessions := MakeSessions(env.Ledger, false)
+	status, err := sessions.Start(0, 0)
+	require.NoError(t, err)
+	start := status.StartRound
+	senderBalance := sender.AcctData.MicroAlgos.Raw
+
+	// the session outlives the lookback window, its start round is looked up in the state history
+	for i := uint64(0); i <= env.Config.MaxAcctLookback; i++ {
+		env.TransferAlgos(sender.Addr, receiver.Addr, 1000)
+	}
+	require.Eventually(t, func() bool {
+		return env.Ledger.LatestTrackerCommitted() > start
+	}, 10*time.Second, 10*time.Millisecond)
+
+	session, err := sessions.Get(status.ID)
+	require.NoError(t, err)
+	require.Equal(t, start, session.ledger.start)
+	ad, _, err := session.ledger.LookupWithoutRewards(start, sender.Addr)
+	require.NoError(t, err)
+	require.Equal(t, senderBalance, ad.MicroAlgos.Raw)
+
+	pay := env.TxnInfo.NewTxn(txntest.Txn{
+		Type:     protocol.PaymentTx,
+		Sender:   sender.Addr,
+		Receiver: receiver.Addr,
+		Amount:   1000,
+	})
+	pay.FirstValid, pay.LastValid = start, start+100
+	result, err := session.Simulate(Request{TxnGroups: [][]transactions.SignedTxn{{pay.SignedTxn()}}, AllowEmptySignatures: true})
+	require.NoError(t, err)
+	require.Empty(t, result.TxnGroups[0].FailureMessage)
+	require.Equal(t, start+1, result.Block.Block().Round())
+}
@@ -8897,6 +8897,103 @@ func TestUnnamedResourcesCrossProductLimits(t *testing.T) {
 	}
 }
 
+// TestPopulateResourceArrays checks that a group with the references populated by simulation can
+// access its resources without AllowUnnamedResources.
+func TestPopulateResourceArrays(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	env := simulationtesting.PrepareSimulatorTest(t)
+	defer env.Close()
+
+	sender := env.Accounts[0]
+	holder := env.Accounts[1].Addr
+	other := env.Accounts[2].Addr
+	asset := env.CreateAsset(sender.Addr, basics.AssetParams{Total: 100})
+	otherApp := env.CreateApp(sender.Addr, simulationtesting.AppParams{
+		ApprovalProgram:   "#pragma version 8\nint 1",
+		ClearStateProgram: "#pragma version 8\nint 1",
+	})
+	appID := env.CreateApp(sender.Addr, simulationtesting.AppParams{
+		ApprovalProgram: fmt.Sprintf(`#pragma version %d
+txn ApplicationID
+bz end
+addr %s
+balance
+pop
+int %d
+asset_params_get AssetTotal
+pop
+pop
+addr %s
+int %d
+asset_holding_get AssetBalance
+pop
+pop
+int %d
+app_params_get AppApprovalProgram
+pop
+pop
+byte "box"
+box_len
+pop
+pop
+end:
+int 1
+`, logic.LogicVersion, other, asset, holder, asset, otherApp),
+		ClearStateProgram: fmt.Sprintf("#pragma version %d\nint 1", logic.LogicVersion),
+	})
+
+	pay := env.TxnInfo.NewTxn(txntest.Txn{
+		Type:     protocol.PaymentTx,
+		Sender:   sender.Addr,
+		Receiver: sender.Addr,
+	})
+	call := env.TxnInfo.NewTxn(txntest.Txn{
+		Type:          protocol.ApplicationCallTx,
+		Sender:        sender.Addr,
+		ApplicationID: appID,
+	})
+	makeGroup := func() []transactions.SignedTxn {
+		txntest.Group(&pay, &call)
+		return []transactions.SignedTxn{pay.Txn().Sign(sender.Sk), call.Txn().Sign(sender.Sk)}
+	}
+
+	simulator := simulation.MakeSimulator(env.Ledger, false)
+	result, err := simulator.Simulate(simulation.Request{
+		TxnGroups:              [][]transactions.SignedTxn{makeGroup()},
+		AllowUnnamedResources:  true,
+		PopulateResourceArrays: true,
+	})
+	require.NoError(t, err)
+	group := result.TxnGroups[0]
+	require.Empty(t, group.FailureMessage)
+	require.Empty(t, group.PopulateFailureMessage)
+	require.Nil(t, group.Txns[0].PopulatedResourceArrays)
+	require.Equal(t, &simulation.PopulatedResourceArrays{
+		Accounts: []basics.Address{holder, other},
+		Assets:   []basics.AssetIndex{asset},
+		Apps:     []basics.AppIndex{otherApp},
+		Boxes:    []logic.BoxRef{{Name: "box"}},
+	}, group.Txns[1].PopulatedResourceArrays)
+
+	callTxn := call.Txn()
+	require.NoError(t, group.Txns[1].PopulatedResourceArrays.Apply(&callTxn))
+	call.Accounts = callTxn.Accounts
+	call.ForeignAssets = callTxn.ForeignAssets
+	call.ForeignApps = callTxn.ForeignApps
+	call.Boxes = callTxn.Boxes
+	result, err = simulator.Simulate(simulation.Request{TxnGroups: [][]transactions.SignedTxn{makeGroup()}})
+	require.NoError(t, err)
+	require.Empty(t, result.TxnGroups[0].FailureMessage)
+
+	_, err = simulator.Simulate(simulation.Request{
+		TxnGroups:              [][]transactions.SignedTxn{makeGroup()},
+		PopulateResourceArrays: true,
+	})
+	require.ErrorContains(t, err, "PopulateResourceArrays requires AllowUnnamedResources")
+}
+
 func TestFixSigners(t *testing.T) {
 	partitiontest.PartitionTest(t)
 	t.Parallel()
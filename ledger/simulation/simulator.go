@@ -20,6 +20,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/Quarkonium-chain/go-quarkonium/config"
 	"github.com/Quarkonium-chain/go-quarkonium/crypto"
 	"github.com/Quarkonium-chain/go-quarkonium/data"
 	"github.com/Quarkonium-chain/go-quarkonium/data/basics"
@@ -39,25 +40,52 @@ type Request struct {
 	AllowEmptySignatures  bool
 	AllowMoreLogging      bool
 	AllowUnnamedResources bool
-	ExtraOpcodeBudget     uint64
-	TraceConfig           ExecTraceConfig
-	FixSigners            bool
+	// PopulateResourceArrays packs the unnamed resources accessed into the foreign arrays of the
+	// group. It requires AllowUnnamedResources.
+	PopulateResourceArrays bool
+	ExtraOpcodeBudget      uint64
+	TraceConfig            ExecTraceConfig
+	FixSigners             bool
+	StateOverrides         *StateOverrides
 }
 
 // simulatorLedger patches the ledger interface to use a constant latest round.
 type simulatorLedger struct {
 	*data.Ledger
 	start basics.Round
+	// blocks are the blocks of a Session, simulated on top of round start.
+	blocks []ledgercore.ValidatedBlock
+	// startTotals are the account totals of round start, kept by a Session as
+	// the ledger only has the totals of the rounds in its lookback window.
+	startTotals *ledgercore.AccountTotals
+	// overlay holds the state overrides of a simulation, taking precedence
+	// over the state of any round.
+	overlay *stateOverlay
+}
+
+// latest returns the round of the last simulated block, or start if there is
+// none.
+func (l simulatorLedger) latest() basics.Round {
+	return l.start + basics.Round(len(l.blocks))
 }
 
 // Latest is part of the ledger.Ledger interface.
 // We override this to use the set latest to prevent racing with the network
 func (l simulatorLedger) Latest() basics.Round {
-	return l.start
+	return l.latest()
 }
 
 // LatestTotals is part of the ledger.Ledger interface.
 func (l simulatorLedger) LatestTotals() (basics.Round, ledgercore.AccountTotals, error) {
+	if l.overlay != nil {
+		return l.latest(), l.overlay.totals, nil
+	}
+	if len(l.blocks) > 0 {
+		return l.latest(), l.blocks[len(l.blocks)-1].Delta().Totals, nil
+	}
+	if l.startTotals != nil {
+		return l.start, *l.startTotals, nil
+	}
 	totals, err := l.Totals(l.start)
 	return l.start, totals, err
 }
@@ -120,7 +148,7 @@ type Simulator struct {
 // MakeSimulator creates a new simulator from a ledger.
 func MakeSimulator(ledger *data.Ledger, developerAPI bool) *Simulator {
 	return &Simulator{
-		ledger:       simulatorLedger{ledger, 0}, // start round to be specified in Simulate method
+		ledger:       simulatorLedger{Ledger: ledger}, // start round to be specified in Simulate method
 		developerAPI: developerAPI,
 	}
 }
@@ -209,7 +237,7 @@ func (s Simulator) evaluate(hdr bookkeeping.BlockHeader, group []transactions.Si
 }
 
 func (s Simulator) simulateWithTracer(txgroup []transactions.SignedTxnWithAD, tracer logic.EvalTracer, overrides ResultEvalOverrides) (*ledgercore.ValidatedBlock, error) {
-	prevBlockHdr, err := s.ledger.BlockHdr(s.ledger.start)
+	prevBlockHdr, err := s.ledger.BlockHdr(s.ledger.latest())
 	if err != nil {
 		return nil, err
 	}
@@ -234,7 +262,7 @@ func (s Simulator) simulateWithTracer(txgroup []transactions.SignedTxnWithAD, tr
 				// Otherwise lookup the sender's account and set the txn auth addr to the account's auth addr
 				if txnHasNoSignature(*stxn) {
 					var data ledgercore.AccountData
-					data, _, _, err = s.ledger.LookupAccount(s.ledger.start, sender)
+					data, _, _, err = s.ledger.LookupAccount(s.ledger.latest(), sender)
 					if err != nil {
 						return nil, err
 					}
@@ -281,6 +309,18 @@ func (s Simulator) simulateWithTracer(txgroup []transactions.SignedTxnWithAD, tr
 
 // Simulate simulates a transaction group using the simulator. Will error if the transaction group is not well-formed.
 func (s Simulator) Simulate(simulateRequest Request) (Result, error) {
+	if simulateRequest.Round != 0 {
+		s.ledger.start = simulateRequest.Round
+	} else {
+		// Access underlying data.Ledger to get the real latest round
+		s.ledger.start = s.ledger.Ledger.Latest()
+	}
+
+	return s.simulate(simulateRequest)
+}
+
+// simulate simulates a transaction group on top of the latest round of s.ledger.
+func (s Simulator) simulate(simulateRequest Request) (Result, error) {
 	if simulateRequest.FixSigners && !simulateRequest.AllowEmptySignatures {
 		return Result{}, InvalidRequestError{
 			SimulatorError{
@@ -289,11 +329,12 @@ func (s Simulator) Simulate(simulateRequest Request) (Result, error) {
 		}
 	}
 
-	if simulateRequest.Round != 0 {
-		s.ledger.start = simulateRequest.Round
-	} else {
-		// Access underlying data.Ledger to get the real latest round
-		s.ledger.start = s.ledger.Ledger.Latest()
+	if simulateRequest.PopulateResourceArrays && !simulateRequest.AllowUnnamedResources {
+		return Result{}, InvalidRequestError{
+			SimulatorError{
+				errors.New("PopulateResourceArrays requires AllowUnnamedResources to be enabled"),
+			},
+		}
 	}
 
 	if len(simulateRequest.TxnGroups) != 1 {
@@ -304,9 +345,17 @@ func (s Simulator) Simulate(simulateRequest Request) (Result, error) {
 		}
 	}
 
+	if simulateRequest.StateOverrides != nil {
+		overlay, err := makeStateOverlay(s.ledger, *simulateRequest.StateOverrides)
+		if err != nil {
+			return Result{}, err
+		}
+		s.ledger.overlay = overlay
+	}
+
 	group := transactions.WrapSignedTxnsWithAD(simulateRequest.TxnGroups[0])
 
-	simulatorTracer, err := makeEvalTracer(s.ledger.start, group, simulateRequest, s.developerAPI)
+	simulatorTracer, err := makeEvalTracer(s.ledger.latest(), group, simulateRequest, s.developerAPI)
 	if err != nil {
 		return Result{}, err
 	}
@@ -347,6 +396,15 @@ func (s Simulator) Simulate(simulateRequest Request) (Result, error) {
 		}
 	}
 
+	if simulateRequest.PopulateResourceArrays {
+		prevBlockHdr, err := s.ledger.BlockHdr(s.ledger.latest())
+		if err != nil {
+			return Result{}, err
+		}
+		proto := config.Consensus[bookkeeping.MakeBlock(prevBlockHdr).CurrentProtocol]
+		populateResourceArrays(&simulatorTracer.result.TxnGroups[0], &proto)
+	}
+
 	simulatorTracer.result.Block = block
 
 	// Update total cost by aggregating individual txn costs
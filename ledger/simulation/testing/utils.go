@@ -239,12 +239,18 @@ func (env *Environment) Rekey(account, rekeyTo basics.Address) {
 // PrepareSimulatorTest creates an environment to test transaction simulations. The caller is
 // responsible for calling Close() on the returned Environment.
 func PrepareSimulatorTest(t *testing.T) Environment {
+	cfg := config.GetDefaultLocal()
+	cfg.Archival = true
+	return PrepareSimulatorTestWithConfig(t, cfg)
+}
+
+// PrepareSimulatorTestWithConfig is like PrepareSimulatorTest, with a ledger
+// opened with the given config.
+func PrepareSimulatorTestWithConfig(t *testing.T, cfg config.Local) Environment {
 	genesisInitState, keys := ledgertesting.GenerateInitState(t, protocol.ConsensusFuture, 100)
 
 	// Prepare ledger
 	const inMem = true
-	cfg := config.GetDefaultLocal()
-	cfg.Archival = true
 	log := logging.TestingLog(t)
 	log.SetLevel(logging.Warn)
 	realLedger, err := ledger.OpenLedger(log, t.Name(), inMem, genesisInitState, cfg)
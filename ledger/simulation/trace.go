@@ -46,6 +46,10 @@ type TxnResult struct {
 	// In that case, it will be populated with the unnamed resources accessed by this transaction.
 	UnnamedResourcesAccessed *ResourceTracker
 
+	// PopulatedResourceArrays is present if PopulateResourceArrays is true and references must be
+	// added to this transaction for the group to access the unnamed resources it accessed.
+	PopulatedResourceArrays *PopulatedResourceArrays
+
 	// If the signer needed to be changed, this will be the address of the required signer
 	// This will only be present if FixSigners is true in the EvalOverrides
 	FixedSigner basics.Address
@@ -72,6 +76,10 @@ type TxnGroupResult struct {
 	// will be placed in the corresponding `UnnamedResourcesAccessed` field in the appropriate
 	// TxnResult struct.
 	UnnamedResourcesAccessed *ResourceTracker
+
+	// PopulateFailureMessage is set if PopulateResourceArrays is true, but the unnamed resources
+	// accessed do not fit in the foreign arrays of the group.
+	PopulateFailureMessage string
 }
 
 func makeTxnGroupResult(txgroup []transactions.SignedTxn) TxnGroupResult {
@@ -136,6 +144,9 @@ type ExecTraceConfig struct {
 	Stack   bool `codec:"stack-change"`
 	Scratch bool `codec:"scratch-change"`
 	State   bool `codec:"state-change"`
+	// Profile aggregates the opcode budget spent by program, PC and inner
+	// call stack into Result.ExecProfile.
+	Profile bool `codec:"profile"`
 }
 
 // Result contains the result from a call to Simulator.Simulate
@@ -147,6 +158,7 @@ type Result struct {
 	Block         *ledgercore.ValidatedBlock
 	TraceConfig   ExecTraceConfig
 	InitialStates *ResourcesInitialStates
+	ExecProfile   *ExecProfile
 }
 
 // ReturnTrace reads from Result object and decides if simulation returns PC.
@@ -163,6 +175,9 @@ func (r Result) ReturnScratchChange() bool { return r.TraceConfig.Scratch }
 // ReturnStateChange tells if the simulation runs with state-change enabled.
 func (r Result) ReturnStateChange() bool { return r.TraceConfig.State }
 
+// ReturnProfile tells if the simulation runs with profiling enabled.
+func (r Result) ReturnProfile() bool { return r.TraceConfig.Profile }
+
 // validateSimulateRequest first checks relation between request and config variables, including developerAPI:
 // if `developerAPI` provided is turned off, this method would:
 // - error on asking for exec trace
@@ -174,6 +189,13 @@ func validateSimulateRequest(request Request, developerAPI bool) error {
 			},
 		}
 	}
+	if !developerAPI && request.TraceConfig.Profile {
+		return InvalidRequestError{
+			SimulatorError{
+				err: fmt.Errorf("the local configuration of the node has `EnableDeveloperAPI` turned off, while requesting for execution profile"),
+			},
+		}
+	}
 	if !request.TraceConfig.Enable {
 		if request.TraceConfig.Stack {
 			return InvalidRequestError{
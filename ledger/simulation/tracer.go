@@ -100,6 +100,9 @@ type evalTracer struct {
 	scratchSlots []uint64
 
 	groups [][]transactions.SignedTxnWithAD
+
+	// profiler is set when profiling, building result.ExecProfile.
+	profiler *profiler
 }
 
 func makeEvalTracer(lastRound basics.Round, group []transactions.SignedTxnWithAD, request Request, developerAPI bool) (*evalTracer, error) {
@@ -107,7 +110,12 @@ func makeEvalTracer(lastRound basics.Round, group []transactions.SignedTxnWithAD
 	if err != nil {
 		return nil, err
 	}
-	return &evalTracer{result: &result, groups: [][]transactions.SignedTxnWithAD{group}}, nil
+	tracer := &evalTracer{result: &result, groups: [][]transactions.SignedTxnWithAD{group}}
+	if result.ReturnProfile() {
+		tracer.profiler = makeProfiler()
+		result.ExecProfile = &tracer.profiler.profile
+	}
+	return tracer, nil
 }
 
 // handleError is responsible for setting the failedAt field properly.
@@ -284,6 +292,10 @@ func (o *OpcodeTraceUnit) computeStackValueDeletions(cx *logic.EvalContext, trac
 func (tracer *evalTracer) BeforeOpcode(cx *logic.EvalContext) {
 	groupIndex := cx.GroupIndex()
 
+	if tracer.profiler != nil {
+		tracer.profiler.beforeOpcode(cx)
+	}
+
 	if cx.RunMode() == logic.ModeApp {
 		// Remember app EvalDelta before executing the opcode. We do this
 		// because if this opcode fails, the block evaluator resets the EvalDelta.
@@ -393,6 +405,10 @@ func (o *OpcodeTraceUnit) updateNewStateValues(cx *logic.EvalContext) {
 func (tracer *evalTracer) AfterOpcode(cx *logic.EvalContext, evalError error) {
 	groupIndex := cx.GroupIndex()
 
+	if tracer.profiler != nil {
+		tracer.profiler.afterOpcode(cx)
+	}
+
 	// NOTE: only when we have no evalError on current opcode,
 	// we can proceed for recording stack change
 	if evalError == nil && tracer.result.ReturnTrace() {
@@ -435,6 +451,10 @@ func (tracer *evalTracer) AfterOpcode(cx *logic.EvalContext, evalError error) {
 func (tracer *evalTracer) BeforeProgram(cx *logic.EvalContext) {
 	groupIndex := cx.GroupIndex()
 
+	if tracer.profiler != nil {
+		tracer.profiler.beforeProgram(cx)
+	}
+
 	switch cx.RunMode() {
 	case logic.ModeSig:
 		// Before Program, activated for logic sig, happens before txn group execution
@@ -490,6 +510,10 @@ func (tracer *evalTracer) BeforeProgram(cx *logic.EvalContext) {
 func (tracer *evalTracer) AfterProgram(cx *logic.EvalContext, pass bool, evalError error) {
 	groupIndex := cx.GroupIndex()
 
+	if tracer.profiler != nil {
+		tracer.profiler.afterProgram()
+	}
+
 	if cx.RunMode() == logic.ModeSig {
 		// Report cost for LogicSig program and exit
 		tracer.result.TxnGroups[0].Txns[groupIndex].LogicSigBudgetConsumed = uint64(cx.Cost())
@@ -0,0 +1,465 @@
+// Copyright (C) 2019-2024 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/Quarkonium-chain/go-quarkonium/data/basics"
+	"github.com/Quarkonium-chain/go-quarkonium/data/bookkeeping"
+	"github.com/Quarkonium-chain/go-quarkonium/ledger/ledgercore"
+	"github.com/Quarkonium-chain/go-quarkonium/ledger/store/trackerdb"
+	"github.com/Quarkonium-chain/go-quarkonium/logging"
+	"github.com/Quarkonium-chain/go-quarkonium/protocol"
+)
+
+// The state history keys are made of a single byte prefix, followed by the
+// identifier of the state object. An empty history value means that the state
+// object did not exist.
+const (
+	stateHistoryAccountPrefix   = 'a' // address
+	stateHistoryResourcePrefix  = 'r' // address, creatable type, creatable index
+	stateHistoryKvPrefix        = 'k' // kv key
+	stateHistoryCreatablePrefix = 'c' // creatable index
+)
+
+// stateHistoryKvExists prefixes the value of existing kv pairs, so that an
+// empty kv value can be told apart from a deleted one.
+const stateHistoryKvExists = 0x01
+
+// stateHistoryTracker persists, for every committed round, the value that each
+// account, resource, kv pair and creatable had before it was modified by that
+// round. Together with the current accounts database, this allows archival
+// nodes to look up the state at any round since the history was started,
+// rather than only within the accountUpdates in-memory deltas.
+type stateHistoryTracker struct {
+	// deltas stores the state changes of every round after dbRound.
+	deltas []ledgercore.StateDelta
+
+	// mu protects deltas.
+	mu deadlock.RWMutex
+
+	// log copied from ledger
+	log logging.Logger
+
+	l ledgerForTracker
+}
+
+func stateHistoryAccountKey(addr basics.Address) []byte {
+	key := make([]byte, 0, 1+len(addr))
+	key = append(key, stateHistoryAccountPrefix)
+	return append(key, addr[:]...)
+}
+
+func stateHistoryResourcesPrefix(addr basics.Address) []byte {
+	key := make([]byte, 0, 1+len(addr)+1+8)
+	key = append(key, stateHistoryResourcePrefix)
+	return append(key, addr[:]...)
+}
+
+func stateHistoryResourceKey(addr basics.Address, cidx basics.CreatableIndex, ctype basics.CreatableType) []byte {
+	key := stateHistoryResourcesPrefix(addr)
+	key = append(key, byte(ctype))
+	return binary.BigEndian.AppendUint64(key, uint64(cidx))
+}
+
+func stateHistoryKvKey(kvKey string) []byte {
+	key := make([]byte, 0, 1+len(kvKey))
+	key = append(key, stateHistoryKvPrefix)
+	return append(key, kvKey...)
+}
+
+func stateHistoryCreatableKey(cidx basics.CreatableIndex) []byte {
+	key := make([]byte, 0, 1+8)
+	key = append(key, stateHistoryCreatablePrefix)
+	return binary.BigEndian.AppendUint64(key, uint64(cidx))
+}
+
+func (sht *stateHistoryTracker) loadFromDisk(l ledgerForTracker, dbRound basics.Round) error {
+	sht.log = l.trackerLog()
+	sht.l = l
+
+	sht.mu.Lock()
+	sht.deltas = nil
+	sht.mu.Unlock()
+
+	return l.trackerDB().Transaction(func(ctx context.Context, tx trackerdb.TransactionScope) error {
+		start, end, err := tx.MakeStateHistoryReader().StateHistoryRounds()
+		if err == nil && end == dbRound {
+			sht.log.Infof("stateHistoryTracker: state history available for rounds %d-%d", start, end)
+			return nil
+		}
+		if err != nil && !errors.Is(err, trackerdb.ErrNotFound) {
+			return err
+		}
+		if err == nil {
+			// the accounts database was updated without the history being
+			// maintained, e.g. by a catchpoint catchup or while the history
+			// was disabled, so the existing history has a gap. Its records are
+			// kept, but only the new range can be looked up.
+			sht.log.Warnf("stateHistoryTracker: state history ends at round %d but the accounts database is at round %d, starting a new state history range; rounds %d-%d are no longer served", end, dbRound, start, end)
+		}
+		return tx.MakeStateHistoryWriter().StartStateHistory(ctx, dbRound)
+	})
+}
+
+func (sht *stateHistoryTracker) newBlock(blk bookkeeping.Block, delta ledgercore.StateDelta) {
+	sht.mu.Lock()
+	defer sht.mu.Unlock()
+	sht.deltas = append(sht.deltas, delta)
+}
+
+func (sht *stateHistoryTracker) committedUpTo(round basics.Round) (minRound, lookback basics.Round) {
+	return round, 0
+}
+
+func (sht *stateHistoryTracker) produceCommittingTask(_ basics.Round, _ basics.Round, dcr *deferredCommitRange) *deferredCommitRange {
+	return dcr
+}
+
+func (sht *stateHistoryTracker) prepareCommit(dcc *deferredCommitContext) error {
+	sht.mu.RLock()
+	defer sht.mu.RUnlock()
+
+	if dcc.offset > uint64(len(sht.deltas)) {
+		return fmt.Errorf("stateHistoryTracker: attempted to commit %d rounds but only %d are tracked", dcc.offset, len(sht.deltas))
+	}
+	dcc.stateHistoryDeltas = make([]ledgercore.StateDelta, dcc.offset)
+	copy(dcc.stateHistoryDeltas, sht.deltas[:dcc.offset])
+	return nil
+}
+
+// commitRound stores the state history records of the committed rounds. It has
+// to run before the accountUpdates commitRound, as it reads the values of the
+// modified state objects at dcc.oldBase from the accounts database.
+func (sht *stateHistoryTracker) commitRound(ctx context.Context, tx trackerdb.TransactionScope, dcc *deferredCommitContext) error {
+	if len(dcc.stateHistoryDeltas) == 0 {
+		return nil
+	}
+
+	ar, err := tx.MakeAccountsOptimizedReader()
+	if err != nil {
+		return err
+	}
+	defer ar.Close()
+
+	records, err := makeStateHistoryRecords(ar, dcc.oldBase, dcc.stateHistoryDeltas)
+	if err != nil {
+		return err
+	}
+	return tx.MakeStateHistoryWriter().StoreStateHistory(ctx, records, dcc.newBase())
+}
+
+func (sht *stateHistoryTracker) postCommit(_ context.Context, dcc *deferredCommitContext) {
+	sht.mu.Lock()
+	defer sht.mu.Unlock()
+	sht.deltas = sht.deltas[len(dcc.stateHistoryDeltas):]
+}
+
+func (sht *stateHistoryTracker) postCommitUnlocked(context.Context, *deferredCommitContext) {
+}
+
+func (sht *stateHistoryTracker) handleUnorderedCommit(dcc *deferredCommitContext) {
+}
+func (sht *stateHistoryTracker) handlePrepareCommitError(dcc *deferredCommitContext) {
+}
+func (sht *stateHistoryTracker) handleCommitError(dcc *deferredCommitContext) {
+}
+
+func (sht *stateHistoryTracker) close() {
+}
+
+// stateHistoryRecorder tracks the values of the state objects while the deltas
+// of the committed rounds are being walked.
+type stateHistoryRecorder struct {
+	ar      trackerdb.AccountsReader
+	current map[string][]byte
+	records []trackerdb.StateHistoryRecord
+}
+
+// record appends the current value of the key as its history record for rnd,
+// and sets its current value to next. The initial current value is read from
+// the accounts database by load.
+func (r *stateHistoryRecorder) record(key []byte, rnd basics.Round, load func() ([]byte, error), next func(prev []byte) ([]byte, error)) error {
+	prev, ok := r.current[string(key)]
+	if !ok {
+		var err error
+		prev, err = load()
+		if err != nil {
+			return err
+		}
+	}
+	value, err := next(prev)
+	if err != nil {
+		return err
+	}
+	r.records = append(r.records, trackerdb.StateHistoryRecord{Key: key, Round: rnd, Value: prev})
+	r.current[string(key)] = value
+	return nil
+}
+
+// makeStateHistoryRecords returns the state history records for the given
+// deltas, which have to be the ones of the rounds following oldBase.
+func makeStateHistoryRecords(ar trackerdb.AccountsReader, oldBase basics.Round, deltas []ledgercore.StateDelta) ([]trackerdb.StateHistoryRecord, error) {
+	r := stateHistoryRecorder{ar: ar, current: make(map[string][]byte)}
+	for i := range deltas {
+		delta := &deltas[i]
+		rnd := oldBase + basics.Round(i) + 1
+
+		for j := 0; j < delta.Accts.Len(); j++ {
+			addr, data := delta.Accts.GetByIdx(j)
+			err := r.record(stateHistoryAccountKey(addr), rnd, func() ([]byte, error) {
+				return lookupAccountHistoryValue(ar, addr)
+			}, func([]byte) ([]byte, error) {
+				var ba trackerdb.BaseAccountData
+				ba.SetCoreAccountData(&data)
+				if ba.IsEmpty() {
+					return nil, nil
+				}
+				return protocol.Encode(&ba), nil
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		for _, rec := range delta.Accts.GetAllAssetResources() {
+			cidx := basics.CreatableIndex(rec.Aidx)
+			err := r.record(stateHistoryResourceKey(rec.Addr, cidx, basics.AssetCreatable), rnd, func() ([]byte, error) {
+				return lookupResourceHistoryValue(ar, rec.Addr, cidx, basics.AssetCreatable)
+			}, func(prev []byte) ([]byte, error) {
+				return nextResourceHistoryValue(prev, func(rd *trackerdb.ResourcesData) {
+					rd.SetAssetData(rec.Params, rec.Holding)
+				})
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		for _, rec := range delta.Accts.GetAllAppResources() {
+			cidx := basics.CreatableIndex(rec.Aidx)
+			err := r.record(stateHistoryResourceKey(rec.Addr, cidx, basics.AppCreatable), rnd, func() ([]byte, error) {
+				return lookupResourceHistoryValue(ar, rec.Addr, cidx, basics.AppCreatable)
+			}, func(prev []byte) ([]byte, error) {
+				return nextResourceHistoryValue(prev, func(rd *trackerdb.ResourcesData) {
+					rd.SetAppData(rec.Params, rec.State)
+				})
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		for key, kv := range delta.KvMods {
+			err := r.record(stateHistoryKvKey(key), rnd, func() ([]byte, error) {
+				return lookupKvHistoryValue(ar, key)
+			}, func([]byte) ([]byte, error) {
+				return kvHistoryValue(kv.Data), nil
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		for cidx, mc := range delta.Creatables {
+			err := r.record(stateHistoryCreatableKey(cidx), rnd, func() ([]byte, error) {
+				return lookupCreatableHistoryValue(ar, cidx, mc.Ctype)
+			}, func([]byte) ([]byte, error) {
+				if !mc.Created {
+					return nil, nil
+				}
+				return creatableHistoryValue(mc.Creator, mc.Ctype), nil
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	return r.records, nil
+}
+
+func lookupAccountHistoryValue(ar trackerdb.AccountsReader, addr basics.Address) ([]byte, error) {
+	pad, err := ar.LookupAccount(addr)
+	if err != nil || pad.Ref == nil {
+		return nil, err
+	}
+	return protocol.Encode(&pad.AccountData), nil
+}
+
+func lookupResourceHistoryValue(ar trackerdb.AccountsReader, addr basics.Address, cidx basics.CreatableIndex, ctype basics.CreatableType) ([]byte, error) {
+	prd, err := ar.LookupResources(addr, cidx, ctype)
+	if err != nil || prd.Data.IsEmpty() {
+		return nil, err
+	}
+	return protocol.Encode(&prd.Data), nil
+}
+
+func nextResourceHistoryValue(prev []byte, apply func(rd *trackerdb.ResourcesData)) ([]byte, error) {
+	rd := trackerdb.MakeResourcesData(0)
+	if len(prev) > 0 {
+		err := protocol.Decode(prev, &rd)
+		if err != nil {
+			return nil, err
+		}
+	}
+	apply(&rd)
+	if rd.IsEmpty() {
+		return nil, nil
+	}
+	return protocol.Encode(&rd), nil
+}
+
+func lookupKvHistoryValue(ar trackerdb.AccountsReader, key string) ([]byte, error) {
+	pv, err := ar.LookupKeyValue(key)
+	if err != nil {
+		return nil, err
+	}
+	return kvHistoryValue(pv.Value), nil
+}
+
+func kvHistoryValue(value []byte) []byte {
+	if value == nil {
+		return nil
+	}
+	return append([]byte{stateHistoryKvExists}, value...)
+}
+
+func lookupCreatableHistoryValue(ar trackerdb.AccountsReader, cidx basics.CreatableIndex, ctype basics.CreatableType) ([]byte, error) {
+	creator, ok, _, err := ar.LookupCreator(cidx, ctype)
+	if err != nil || !ok {
+		return nil, err
+	}
+	return creatableHistoryValue(creator, ctype), nil
+}
+
+func creatableHistoryValue(creator basics.Address, ctype basics.CreatableType) []byte {
+	return append([]byte{byte(ctype)}, creator[:]...)
+}
+
+// lookup returns the value of the state history key at rnd, or the current
+// value from the accounts database if the key was not modified after rnd.
+func (sht *stateHistoryTracker) lookup(rnd basics.Round, key []byte, current func(ar trackerdb.AccountsReader) ([]byte, error)) (value []byte, err error) {
+	err = sht.l.trackerDB().Snapshot(func(ctx context.Context, tx trackerdb.SnapshotScope) error {
+		shr := tx.MakeStateHistoryReader()
+		start, _, err0 := shr.StateHistoryRounds()
+		if err0 != nil {
+			return err0
+		}
+		if rnd < start {
+			return &RoundOffsetError{round: rnd, dbRound: start}
+		}
+
+		value, err0 = shr.LookupStateHistory(key, rnd)
+		if !errors.Is(err0, trackerdb.ErrNotFound) {
+			return err0
+		}
+
+		ar, err0 := tx.MakeAccountsOptimizedReader()
+		if err0 != nil {
+			return err0
+		}
+		defer ar.Close()
+		value, err0 = current(ar)
+		return err0
+	})
+	return value, err
+}
+
+// lookupAccount returns the account data of addr at rnd, without rewards.
+func (sht *stateHistoryTracker) lookupAccount(rnd basics.Round, addr basics.Address) (ledgercore.AccountData, error) {
+	value, err := sht.lookup(rnd, stateHistoryAccountKey(addr), func(ar trackerdb.AccountsReader) ([]byte, error) {
+		return lookupAccountHistoryValue(ar, addr)
+	})
+	if err != nil || len(value) == 0 {
+		return ledgercore.AccountData{}, err
+	}
+	var ba trackerdb.BaseAccountData
+	err = protocol.Decode(value, &ba)
+	if err != nil {
+		return ledgercore.AccountData{}, err
+	}
+	return ba.GetLedgerCoreAccountData(), nil
+}
+
+// lookupResource returns the resource of addr at rnd.
+func (sht *stateHistoryTracker) lookupResource(rnd basics.Round, addr basics.Address, cidx basics.CreatableIndex, ctype basics.CreatableType) (ledgercore.AccountResource, error) {
+	value, err := sht.lookup(rnd, stateHistoryResourceKey(addr, cidx, ctype), func(ar trackerdb.AccountsReader) ([]byte, error) {
+		return lookupResourceHistoryValue(ar, addr, cidx, ctype)
+	})
+	if err != nil || len(value) == 0 {
+		return ledgercore.AccountResource{}, err
+	}
+	prd := trackerdb.PersistedResourcesData{Aidx: cidx}
+	err = protocol.Decode(value, &prd.Data)
+	if err != nil {
+		return ledgercore.AccountResource{}, err
+	}
+	return prd.AccountResource(), nil
+}
+
+// lookupKv returns the kv value of key at rnd, or nil if it did not exist.
+func (sht *stateHistoryTracker) lookupKv(rnd basics.Round, key string) ([]byte, error) {
+	value, err := sht.lookup(rnd, stateHistoryKvKey(key), func(ar trackerdb.AccountsReader) ([]byte, error) {
+		return lookupKvHistoryValue(ar, key)
+	})
+	if err != nil || len(value) == 0 {
+		return nil, err
+	}
+	return value[1:], nil
+}
+
+// lookupCreator returns the creator of the creatable at rnd.
+func (sht *stateHistoryTracker) lookupCreator(rnd basics.Round, cidx basics.CreatableIndex, ctype basics.CreatableType) (basics.Address, bool, error) {
+	value, err := sht.lookup(rnd, stateHistoryCreatableKey(cidx), func(ar trackerdb.AccountsReader) ([]byte, error) {
+		return lookupCreatableHistoryValue(ar, cidx, ctype)
+	})
+	if err != nil || len(value) == 0 || basics.CreatableType(value[0]) != ctype {
+		return basics.Address{}, false, err
+	}
+	var creator basics.Address
+	copy(creator[:], value[1:])
+	return creator, true, nil
+}
+
+// lookupModifiedResources returns the resources of addr which were persisted
+// as modified by a round after rnd.
+func (sht *stateHistoryTracker) lookupModifiedResources(rnd basics.Round, addr basics.Address) (map[basics.CreatableIndex]basics.CreatableType, error) {
+	var keys [][]byte
+	err := sht.l.trackerDB().Snapshot(func(ctx context.Context, tx trackerdb.SnapshotScope) (err error) {
+		keys, err = tx.MakeStateHistoryReader().LookupStateHistoryKeys(stateHistoryResourcesPrefix(addr), rnd)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	prefixLen := len(stateHistoryResourcesPrefix(addr))
+	resources := make(map[basics.CreatableIndex]basics.CreatableType, len(keys))
+	for _, key := range keys {
+		if len(key) != prefixLen+1+8 {
+			return nil, fmt.Errorf("stateHistoryTracker: malformed resource history key %x", key)
+		}
+		cidx := basics.CreatableIndex(binary.BigEndian.Uint64(key[prefixLen+1:]))
+		resources[cidx] = basics.CreatableType(key[prefixLen])
+	}
+	return resources, nil
+}
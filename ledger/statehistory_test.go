@@ -0,0 +1,223 @@
+// Copyright (C) 2019-2024 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"context"
+	"testing"
+
+	"github.com/algorand/avm-abi/apps"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Quarkonium-chain/go-quarkonium/config"
+	"github.com/Quarkonium-chain/go-quarkonium/data/basics"
+	"github.com/Quarkonium-chain/go-quarkonium/data/transactions"
+	"github.com/Quarkonium-chain/go-quarkonium/data/txntest"
+	"github.com/Quarkonium-chain/go-quarkonium/ledger/store/trackerdb"
+	ledgertesting "github.com/Quarkonium-chain/go-quarkonium/ledger/testing"
+	"github.com/Quarkonium-chain/go-quarkonium/protocol"
+	"github.com/Quarkonium-chain/go-quarkonium/test/partitiontest"
+)
+
+type stateHistorySnapshot struct {
+	accounts map[basics.Address]basics.AccountData
+	box      []byte
+	creator  basics.Address
+	created  bool
+}
+
+func takeStateHistorySnapshot(t *testing.T, l *Ledger, rnd basics.Round, addrs []basics.Address, boxKey string, asset basics.AssetIndex) stateHistorySnapshot {
+	s := stateHistorySnapshot{accounts: make(map[basics.Address]basics.AccountData)}
+	for _, addr := range addrs {
+		data, validThrough, _, err := l.LookupAccountWithResources(rnd, addr)
+		require.NoError(t, err)
+		require.GreaterOrEqual(t, validThrough, rnd)
+		s.accounts[addr] = data
+	}
+	var err error
+	s.box, err = l.LookupKv(rnd, boxKey)
+	require.NoError(t, err)
+	s.creator, s.created, err = l.GetCreatorForRound(rnd, basics.CreatableIndex(asset), basics.AssetCreatable)
+	require.NoError(t, err)
+	return s
+}
+
+// flushStateHistory commits all the rounds the trackers allow to commit, and
+// checks that the accounts database reached rnd.
+func flushStateHistory(t *testing.T, l *Ledger, rnd basics.Round) {
+	commitRoundLookback(basics.Round(l.cfg.MaxAcctLookback), l)
+	require.GreaterOrEqual(t, l.trackers.getDbRound(), rnd)
+}
+
+func TestStateHistoryLookups(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	genBalances, addrs, _ := ledgertesting.NewTestGenesis()
+	cfg := config.GetDefaultLocal()
+	cfg.MaxAcctLookback = 2
+	cfg.EnableStateHistory = true
+	dl := NewDoubleLedger(t, genBalances, protocol.ConsensusCurrentVersion, cfg)
+	defer dl.Close()
+	l := dl.generator
+
+	proto := config.Consensus[protocol.ConsensusCurrentVersion]
+	tracked := addrs[:3]
+
+	vb := dl.fullBlock(&txntest.Txn{
+		Type:        "acfg",
+		Sender:      addrs[0],
+		AssetParams: basics.AssetParams{Total: 1000, UnitName: "hist", Manager: addrs[0]},
+	})
+	asset := vb.Block().Payset[0].ApplyData.ConfigAsset
+
+	appID := dl.fundedApp(addrs[0], proto.MinBalance+boxFee(proto, 28), boxAppSource)
+	call := txntest.Txn{
+		Type:          "appl",
+		Sender:        addrs[0],
+		ApplicationID: appID,
+		Boxes:         []transactions.BoxRef{{Index: 0, Name: []byte("adam")}},
+	}
+	boxKey := apps.MakeBoxKey(uint64(appID), "adam")
+	optInApp := dl.createApp(addrs[0], main(""))
+
+	rounds := []func(){
+		func() {
+			dl.fullBlock(&txntest.Txn{Type: "axfer", Sender: addrs[1], AssetReceiver: addrs[1], XferAsset: asset})
+		},
+		func() {
+			dl.fullBlock(&txntest.Txn{Type: "axfer", Sender: addrs[0], AssetReceiver: addrs[1], XferAsset: asset, AssetAmount: 10},
+				&txntest.Txn{Type: "appl", Sender: addrs[1], ApplicationID: optInApp, OnCompletion: transactions.OptInOC})
+		},
+		func() {
+			dl.fullBlock(call.Args("create", "adam"))
+		},
+		func() {
+			dl.fullBlock(&txntest.Txn{Type: "pay", Sender: addrs[1], Receiver: addrs[2], Amount: 1_000_000})
+		},
+		func() {
+			dl.fullBlock(&txntest.Txn{Type: "axfer", Sender: addrs[1], AssetReceiver: addrs[0], XferAsset: asset, AssetCloseTo: addrs[0]},
+				&txntest.Txn{Type: "appl", Sender: addrs[1], ApplicationID: optInApp, OnCompletion: transactions.ClearStateOC})
+		},
+		func() {
+			dl.fullBlock(&txntest.Txn{Type: "acfg", Sender: addrs[0], ConfigAsset: asset},
+				call.Args("delete", "adam"))
+		},
+	}
+
+	snapshots := make(map[basics.Round]stateHistorySnapshot)
+	snapshots[l.Latest()] = takeStateHistorySnapshot(t, l, l.Latest(), tracked, boxKey, asset)
+	for _, round := range rounds {
+		round()
+		rnd := l.Latest()
+		snapshots[rnd] = takeStateHistorySnapshot(t, l, rnd, tracked, boxKey, asset)
+		// the assembled account resources match the ones of the latest lookup
+		for _, addr := range tracked {
+			latest, _, _, err := l.LookupLatest(addr)
+			require.NoError(t, err)
+			require.Equal(t, latest, snapshots[rnd].accounts[addr])
+		}
+	}
+	lastTracked := l.Latest()
+
+	for i := 0; i < 10; i++ {
+		dl.fullBlock()
+	}
+	flushStateHistory(t, l, lastTracked+1)
+
+	check := func() {
+		for rnd, expected := range snapshots {
+			_, _, err := l.accts.LookupWithoutRewards(rnd, addrs[0])
+			require.ErrorAs(t, err, new(*RoundOffsetError))
+			require.Equal(t, expected, takeStateHistorySnapshot(t, l, rnd, tracked, boxKey, asset), "round %d", rnd)
+		}
+	}
+	check()
+
+	// the history survives a reload
+	dl.reloadLedgers()
+	check()
+}
+
+func TestStateHistoryStartsWhenEnabled(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	genBalances, addrs, _ := ledgertesting.NewTestGenesis()
+	cfg := config.GetDefaultLocal()
+	cfg.MaxAcctLookback = 2
+	l := newSimpleLedgerWithConsensusVersion(t, genBalances, protocol.ConsensusCurrentVersion, cfg)
+	defer l.Close()
+
+	pay := txntest.Txn{Type: "pay", Sender: addrs[0], Receiver: addrs[1], Amount: 1000}
+	for i := 0; i < 10; i++ {
+		eval := nextBlock(t, l)
+		txn(t, l, eval, pay.Noted(string(rune('a'+i))))
+		endBlock(t, l, eval)
+	}
+	flushStateHistory(t, l, 5)
+
+	_, _, _, err := l.LookupAccount(1, addrs[1])
+	require.ErrorAs(t, err, new(*RoundOffsetError))
+
+	l.cfg.EnableStateHistory = true
+	require.NoError(t, l.reloadLedger())
+	start := l.trackers.getDbRound()
+
+	for i := 0; i < 10; i++ {
+		eval := nextBlock(t, l)
+		txn(t, l, eval, pay.Noted(string(rune('A'+i))))
+		endBlock(t, l, eval)
+	}
+	flushStateHistory(t, l, start+5)
+
+	// rounds before the history start are still unavailable
+	_, _, _, err = l.LookupAccount(start-1, addrs[1])
+	require.ErrorAs(t, err, new(*RoundOffsetError))
+
+	data, _, _, err := l.LookupAccount(start+1, addrs[1])
+	require.NoError(t, err)
+	next, _, _, err := l.LookupAccount(start+2, addrs[1])
+	require.NoError(t, err)
+	require.Equal(t, data.MicroAlgos.Raw+1000, next.MicroAlgos.Raw)
+
+	// running with the history disabled leaves a gap, after which a new range
+	// starts without deleting the records of the previous one
+	l.cfg.EnableStateHistory = false
+	require.NoError(t, l.reloadLedger())
+	for i := 0; i < 10; i++ {
+		eval := nextBlock(t, l)
+		txn(t, l, eval, pay.Noted(string(rune('k'+i))))
+		endBlock(t, l, eval)
+	}
+	flushStateHistory(t, l, start+15)
+
+	l.cfg.EnableStateHistory = true
+	require.NoError(t, l.reloadLedger())
+	restart := l.trackers.getDbRound()
+	require.Greater(t, restart, start+10)
+
+	_, _, _, err = l.LookupAccount(start+1, addrs[1])
+	require.ErrorAs(t, err, new(*RoundOffsetError))
+
+	err = l.trackerDB().Snapshot(func(ctx context.Context, tx trackerdb.SnapshotScope) error {
+		value, err0 := tx.MakeStateHistoryReader().LookupStateHistory(stateHistoryAccountKey(addrs[1]), start+1)
+		require.NotEmpty(t, value)
+		return err0
+	})
+	require.NoError(t, err)
+}
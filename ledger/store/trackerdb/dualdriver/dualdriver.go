@@ -231,6 +231,13 @@ func (r *reader) MakeSpVerificationCtxReader() trackerdb.SpVerificationCtxReader
 	return &stateproofReader{primary, secondary}
 }
 
+// MakeStateHistoryReader implements trackerdb.Reader
+func (r *reader) MakeStateHistoryReader() trackerdb.StateHistoryReader {
+	primary := r.primary.MakeStateHistoryReader()
+	secondary := r.secondary.MakeStateHistoryReader()
+	return &stateHistoryReader{primary, secondary}
+}
+
 // MakeCatchpointPendingHashesIterator implements trackerdb.Reader
 func (*reader) MakeCatchpointPendingHashesIterator(hashCount int) trackerdb.CatchpointPendingHashesIter {
 	// TODO: catchpoint
@@ -243,6 +250,12 @@ func (*reader) MakeCatchpointReader() (trackerdb.CatchpointReader, error) {
 	return nil, nil
 }
 
+// MakeMerkleReader implements trackerdb.Reader
+func (*reader) MakeMerkleReader() (trackerdb.MerkleCommitter, error) {
+	// TODO: catchpoint
+	return nil, nil
+}
+
 // MakeEncodedAccoutsBatchIter implements trackerdb.Reader
 func (*reader) MakeEncodedAccoutsBatchIter() trackerdb.EncodedAccountsBatchIter {
 	// TODO: catchpoint
@@ -300,6 +313,13 @@ func (w *writer) MakeSpVerificationCtxWriter() trackerdb.SpVerificationCtxWriter
 	return &stateproofWriter{primary, secondary}
 }
 
+// MakeStateHistoryWriter implements trackerdb.Writer
+func (w *writer) MakeStateHistoryWriter() trackerdb.StateHistoryWriter {
+	primary := w.primary.MakeStateHistoryWriter()
+	secondary := w.secondary.MakeStateHistoryWriter()
+	return &stateHistoryWriter{primary, secondary}
+}
+
 // Testing implements trackerdb.Writer
 func (w *writer) Testing() trackerdb.WriterTestExt {
 	primary := w.primary.Testing()
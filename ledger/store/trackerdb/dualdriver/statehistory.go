@@ -0,0 +1,111 @@
+// Copyright (C) 2019-2024 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package dualdriver
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/Quarkonium-chain/go-quarkonium/data/basics"
+	"github.com/Quarkonium-chain/go-quarkonium/ledger/store/trackerdb"
+)
+
+type stateHistoryReader struct {
+	primary   trackerdb.StateHistoryReader
+	secondary trackerdb.StateHistoryReader
+}
+
+type stateHistoryWriter struct {
+	primary   trackerdb.StateHistoryWriter
+	secondary trackerdb.StateHistoryWriter
+}
+
+// StateHistoryRounds implements trackerdb.StateHistoryReader
+func (r *stateHistoryReader) StateHistoryRounds() (start basics.Round, end basics.Round, err error) {
+	startP, endP, errP := r.primary.StateHistoryRounds()
+	startS, endS, errS := r.secondary.StateHistoryRounds()
+	// coalesce errors
+	err = coalesceErrors(errP, errS)
+	if err != nil {
+		return
+	}
+	// check results match
+	if startP != startS || endP != endS {
+		err = ErrInconsistentResult
+		return
+	}
+	// return primary results
+	return startP, endP, nil
+}
+
+// LookupStateHistory implements trackerdb.StateHistoryReader
+func (r *stateHistoryReader) LookupStateHistory(key []byte, rnd basics.Round) (value []byte, err error) {
+	valueP, errP := r.primary.LookupStateHistory(key, rnd)
+	valueS, errS := r.secondary.LookupStateHistory(key, rnd)
+	// coalesce errors
+	err = coalesceErrors(errP, errS)
+	if err != nil {
+		return
+	}
+	// check results match
+	if !bytes.Equal(valueP, valueS) {
+		err = ErrInconsistentResult
+		return
+	}
+	// return primary results
+	return valueP, nil
+}
+
+// LookupStateHistoryKeys implements trackerdb.StateHistoryReader
+func (r *stateHistoryReader) LookupStateHistoryKeys(prefix []byte, rnd basics.Round) (keys [][]byte, err error) {
+	keysP, errP := r.primary.LookupStateHistoryKeys(prefix, rnd)
+	keysS, errS := r.secondary.LookupStateHistoryKeys(prefix, rnd)
+	// coalesce errors
+	err = coalesceErrors(errP, errS)
+	if err != nil {
+		return
+	}
+	// check results match
+	if len(keysP) != len(keysS) {
+		err = ErrInconsistentResult
+		return
+	}
+	for i := range keysP {
+		if !bytes.Equal(keysP[i], keysS[i]) {
+			err = ErrInconsistentResult
+			return
+		}
+	}
+	// return primary results
+	return keysP, nil
+}
+
+// StartStateHistory implements trackerdb.StateHistoryWriter
+func (w *stateHistoryWriter) StartStateHistory(ctx context.Context, rnd basics.Round) error {
+	errP := w.primary.StartStateHistory(ctx, rnd)
+	errS := w.secondary.StartStateHistory(ctx, rnd)
+	// coalesce errors
+	return coalesceErrors(errP, errS)
+}
+
+// StoreStateHistory implements trackerdb.StateHistoryWriter
+func (w *stateHistoryWriter) StoreStateHistory(ctx context.Context, records []trackerdb.StateHistoryRecord, rnd basics.Round) error {
+	errP := w.primary.StoreStateHistory(ctx, records, rnd)
+	errS := w.secondary.StoreStateHistory(ctx, records, rnd)
+	// coalesce errors
+	return coalesceErrors(errP, errS)
+}
@@ -53,6 +53,11 @@ func (r *reader) MakeSpVerificationCtxReader() trackerdb.SpVerificationCtxReader
 	return MakeStateproofReader(r)
 }
 
+// MakeStateHistoryReader implements trackerdb.Reader
+func (r *reader) MakeStateHistoryReader() trackerdb.StateHistoryReader {
+	return MakeStateHistoryReader(r)
+}
+
 // MakeCatchpointPendingHashesIterator implements trackerdb.Reader
 func (r *reader) MakeCatchpointPendingHashesIterator(hashCount int) trackerdb.CatchpointPendingHashesIter {
 	// TODO: catchpoint
@@ -65,6 +70,12 @@ func (r *reader) MakeCatchpointReader() (trackerdb.CatchpointReader, error) {
 	panic("unimplemented")
 }
 
+// MakeMerkleReader implements trackerdb.Reader
+func (r *reader) MakeMerkleReader() (trackerdb.MerkleCommitter, error) {
+	// TODO: catchpoint
+	panic("unimplemented")
+}
+
 // MakeEncodedAccoutsBatchIter implements trackerdb.Reader
 func (r *reader) MakeEncodedAccoutsBatchIter() trackerdb.EncodedAccountsBatchIter {
 	// TODO: catchpoint
@@ -42,6 +42,8 @@ const (
 	kvTxTail                     = "xj"
 	kvOnlineAccountRoundParams   = "xk"
 	kvPrefixStateproof           = "xl"
+	kvPrefixStateHistory         = "xm"
+	kvStateHistoryRounds         = "xn"
 )
 
 const (
@@ -398,3 +400,49 @@ func stateproofFullRangePrefix() ([3]byte, [3]byte) {
 
 	return low, high
 }
+
+// stateHistoryKey returns the key of the state history record of key for rnd.
+// The key length is encoded after the key, so that the records of a key are
+// told apart from the ones of the keys it is a prefix of.
+func stateHistoryKey(key []byte, rnd basics.Round) []byte {
+	ret := stateHistoryKeyPrefix(key)
+	rnd8 := bigEndianUint64(uint64(rnd))
+	return append(ret, rnd8[:]...)
+}
+
+func stateHistoryKeyPrefix(key []byte) []byte {
+	ret := make([]byte, 0, prefixLength+separatorLength+len(key)+2+roundLength)
+	ret = append(ret, kvPrefixStateHistory...)
+	ret = append(ret, separator)
+	ret = append(ret, key...)
+	return binary.BigEndian.AppendUint16(ret, uint16(len(key)))
+}
+
+// extractStateHistoryKey returns the key and round of a state history record key.
+func extractStateHistoryKey(recordKey []byte) (key []byte, rnd basics.Round, ok bool) {
+	const offset int = prefixLength + separatorLength
+	if len(recordKey) < offset+2+roundLength {
+		return nil, 0, false
+	}
+	end := len(recordKey) - roundLength - 2
+	if int(binary.BigEndian.Uint16(recordKey[end:end+2])) != end-offset {
+		return nil, 0, false
+	}
+	return recordKey[offset:end], basics.Round(binary.BigEndian.Uint64(recordKey[end+2:])), true
+}
+
+func stateHistoryPrefixRange(prefix []byte) ([]byte, []byte) {
+	low := make([]byte, 0, prefixLength+separatorLength+len(prefix))
+	low = append(low, kvPrefixStateHistory...)
+	low = append(low, separator)
+	low = append(low, prefix...)
+
+	// the high end is the first key after all the ones starting with low
+	high := append([]byte{}, low...)
+	for len(high) > 0 && high[len(high)-1] == 0xff {
+		high = high[:len(high)-1]
+	}
+	high[len(high)-1]++
+
+	return low, high
+}
@@ -0,0 +1,171 @@
+// Copyright (C) 2019-2024 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package generickv
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"math"
+
+	"golang.org/x/exp/slices"
+
+	"github.com/Quarkonium-chain/go-quarkonium/data/basics"
+	"github.com/Quarkonium-chain/go-quarkonium/ledger/store/trackerdb"
+)
+
+type stateHistoryReader struct {
+	kvr KvRead
+}
+
+type stateHistoryWriter struct {
+	kvw KvWrite
+	kvr KvRead
+}
+
+// MakeStateHistoryReader returns a trackerdb.StateHistoryReader for a KV
+func MakeStateHistoryReader(kvr KvRead) trackerdb.StateHistoryReader {
+	return &stateHistoryReader{kvr}
+}
+
+// MakeStateHistoryWriter returns a trackerdb.StateHistoryWriter for a KV
+func MakeStateHistoryWriter(kvw KvWrite, kvr KvRead) trackerdb.StateHistoryWriter {
+	return &stateHistoryWriter{kvw, kvr}
+}
+
+// StateHistoryRounds implements trackerdb.StateHistoryReader
+func (r *stateHistoryReader) StateHistoryRounds() (start basics.Round, end basics.Round, err error) {
+	value, closer, err := r.kvr.Get([]byte(kvStateHistoryRounds))
+	if err != nil {
+		return 0, 0, err
+	}
+	defer closer.Close()
+
+	start = basics.Round(binary.BigEndian.Uint64(value[0:8]))
+	end = basics.Round(binary.BigEndian.Uint64(value[8:16]))
+	return start, end, nil
+}
+
+// LookupStateHistory implements trackerdb.StateHistoryReader
+func (r *stateHistoryReader) LookupStateHistory(key []byte, rnd basics.Round) ([]byte, error) {
+	// SQL at the time of writing:
+	//
+	// SELECT value
+	// FROM statehistory
+	// WHERE key=? AND rnd>?
+	// ORDER BY rnd LIMIT 1
+
+	low := stateHistoryKey(key, rnd)
+	high := append(stateHistoryKey(key, math.MaxUint64), 0)
+	iter := r.kvr.NewIter(low, high, false)
+	defer iter.Close()
+
+	for iter.Next() {
+		// skip the record of rnd itself, and the records of the longer keys
+		// that sort among the ones of key
+		recordKey, recordRound, ok := extractStateHistoryKey(iter.Key())
+		if !ok || recordRound <= rnd || !bytes.Equal(recordKey, key) {
+			continue
+		}
+		value, err := iter.Value()
+		if err != nil {
+			return nil, err
+		}
+		return append([]byte{}, value...), nil
+	}
+
+	return nil, trackerdb.ErrNotFound
+}
+
+// LookupStateHistoryKeys implements trackerdb.StateHistoryReader
+func (r *stateHistoryReader) LookupStateHistoryKeys(prefix []byte, rnd basics.Round) ([][]byte, error) {
+	// SQL at the time of writing:
+	//
+	// SELECT DISTINCT key
+	// FROM statehistory
+	// WHERE key>=? AND key<? AND rnd>?
+	// ORDER BY key
+
+	low, high := stateHistoryPrefixRange(prefix)
+	iter := r.kvr.NewIter(low, high, false)
+	defer iter.Close()
+
+	var keys [][]byte
+	seen := make(map[string]bool)
+	for iter.Next() {
+		key, recordRound, ok := extractStateHistoryKey(iter.Key())
+		if !ok || recordRound <= rnd || seen[string(key)] {
+			continue
+		}
+		seen[string(key)] = true
+		keys = append(keys, append([]byte{}, key...))
+	}
+	// the keys that are prefixes of others might not be sorted
+	slices.SortFunc(keys, bytes.Compare)
+
+	return keys, nil
+}
+
+// StartStateHistory implements trackerdb.StateHistoryWriter
+func (w *stateHistoryWriter) StartStateHistory(ctx context.Context, rnd basics.Round) error {
+	// SQL at the time of writing:
+	//
+	// DELETE FROM statehistory WHERE rnd>?
+
+	// the records are sorted by key first, so all of them have to be visited
+	low, high := stateHistoryPrefixRange(nil)
+	iter := w.kvr.NewIter(low, high, false)
+	var stale [][]byte
+	for iter.Next() {
+		_, recordRound, ok := extractStateHistoryKey(iter.Key())
+		if ok && recordRound > rnd {
+			stale = append(stale, append([]byte{}, iter.Key()...))
+		}
+	}
+	iter.Close()
+
+	for _, key := range stale {
+		err := w.kvw.Delete(key)
+		if err != nil {
+			return err
+		}
+	}
+	return w.setRounds(rnd, rnd)
+}
+
+// StoreStateHistory implements trackerdb.StateHistoryWriter
+func (w *stateHistoryWriter) StoreStateHistory(ctx context.Context, records []trackerdb.StateHistoryRecord, rnd basics.Round) error {
+	for _, record := range records {
+		err := w.kvw.Set(stateHistoryKey(record.Key, record.Round), record.Value)
+		if err != nil {
+			return err
+		}
+	}
+
+	start, _, err := MakeStateHistoryReader(w.kvr).StateHistoryRounds()
+	if err != nil {
+		return err
+	}
+	return w.setRounds(start, rnd)
+}
+
+func (w *stateHistoryWriter) setRounds(start, end basics.Round) error {
+	var value [16]byte
+	binary.BigEndian.PutUint64(value[0:8], uint64(start))
+	binary.BigEndian.PutUint64(value[8:16], uint64(end))
+	return w.kvw.Set([]byte(kvStateHistoryRounds), value[:])
+}
@@ -59,6 +59,11 @@ func (w *writer) MakeSpVerificationCtxWriter() trackerdb.SpVerificationCtxWriter
 	return MakeStateproofWriter(w)
 }
 
+// MakeStateHistoryWriter implements trackerdb.Writer
+func (w *writer) MakeStateHistoryWriter() trackerdb.StateHistoryWriter {
+	return MakeStateHistoryWriter(w, w)
+}
+
 // Testing implements trackerdb.Writer
 func (w *writer) Testing() trackerdb.WriterTestExt {
 	return &writerForTesting{w.store, w, w}
@@ -247,6 +247,43 @@ type CatchpointPendingHashesIter interface {
 	Close()
 }
 
+// StateHistoryRecord is the value of a state history key before the round
+// that modified it.
+type StateHistoryRecord struct {
+	Key   []byte
+	Round basics.Round
+	Value []byte
+}
+
+// StateHistoryReader is the read interface for the state history of archival
+// nodes. The state history keeps, for every round since the history start
+// round, the values of the state keys before that round modified them.
+// Use with SnapshotScope
+type StateHistoryReader interface {
+	// StateHistoryRounds returns the round the history starts at, and the round
+	// it was last updated at. It returns ErrNotFound if there is no history.
+	StateHistoryRounds() (start basics.Round, end basics.Round, err error)
+	// LookupStateHistory returns the value of key before the earliest round
+	// after rnd that modified it, which is the value of key at rnd.
+	// It returns ErrNotFound if key was not modified after rnd.
+	LookupStateHistory(key []byte, rnd basics.Round) (value []byte, err error)
+	// LookupStateHistoryKeys returns the keys starting with prefix that were
+	// modified after rnd.
+	LookupStateHistoryKeys(prefix []byte, rnd basics.Round) (keys [][]byte, err error)
+}
+
+// StateHistoryWriter is the write interface for the state history of archival nodes.
+// Use with BatchScope
+type StateHistoryWriter interface {
+	// StartStateHistory starts a new range of the state history at rnd. The
+	// records of the earlier ranges are kept, while the ones after rnd, which
+	// can't belong to the new range, are deleted.
+	StartStateHistory(ctx context.Context, rnd basics.Round) error
+	// StoreStateHistory adds records to the state history, and marks it as
+	// updated up to rnd.
+	StoreStateHistory(ctx context.Context, records []StateHistoryRecord, rnd basics.Round) error
+}
+
 // SpVerificationCtxReader is a reader abstraction for stateproof verification tracker
 // Use with SnapshotScope
 type SpVerificationCtxReader interface {
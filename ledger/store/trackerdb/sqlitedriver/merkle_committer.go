@@ -18,6 +18,7 @@ package sqlitedriver
 
 import (
 	"database/sql"
+	"errors"
 
 	"github.com/Quarkonium-chain/go-quarkonium/util/db"
 )
@@ -75,3 +76,38 @@ func (mc *merkleCommitter) LoadPage(page uint64) (content []byte, err error) {
 	}
 	return content, nil
 }
+
+// errMerkleReaderStore is returned when storing a page through a read-only merkle reader.
+var errMerkleReaderStore = errors.New("merkle reader is read-only")
+
+//msgp:ignore merkleReader
+type merkleReader struct {
+	selectStmt *sql.Stmt
+}
+
+// makeMerkleReader creates a read-only merkletrie.Committer loading merkletrie pages from the accounthashes table.
+// It can be used from a snapshot, as StorePage always fails rather than writing to the database.
+func makeMerkleReader(q db.Queryable) (mr *merkleReader, err error) {
+	mr = &merkleReader{}
+	mr.selectStmt, err = q.Prepare("SELECT data FROM accounthashes WHERE id = ?")
+	if err != nil {
+		return nil, err
+	}
+	return mr, nil
+}
+
+// StorePage is the merkletrie.Committer interface implementation, it always fails.
+func (mr *merkleReader) StorePage(page uint64, content []byte) error {
+	return errMerkleReaderStore
+}
+
+// LoadPage is the merkletrie.Committer interface implementation, load a single page from a sqlite database table.
+func (mr *merkleReader) LoadPage(page uint64) (content []byte, err error) {
+	err = mr.selectStmt.QueryRow(page).Scan(&content)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return content, nil
+}
@@ -155,6 +155,15 @@ const createStateProofVerificationTableQuery = `
 	lastattestedround integer primary key NOT NULL,
 	verificationcontext blob NOT NULL)`
 
+// Table containing the values of the accounts, resources, creatables and kvs
+// before the rounds that modified them, for the historical state lookups.
+const createStateHistoryTableQuery = `
+	CREATE TABLE IF NOT EXISTS statehistory (
+	key blob NOT NULL,
+	rnd integer NOT NULL,
+	value blob NOT NULL,
+	PRIMARY KEY (key, rnd))`
+
 const createVoteLastValidIndex = `
 	CREATE INDEX IF NOT EXISTS onlineaccounts_votelastvalid_idx
 	ON onlineaccounts ( votelastvalid )`
@@ -396,6 +405,11 @@ func createStateProofVerificationTable(ctx context.Context, e db.Executable) err
 	return err
 }
 
+func createStateHistoryTable(ctx context.Context, e db.Executable) error {
+	_, err := e.ExecContext(ctx, createStateHistoryTableQuery)
+	return err
+}
+
 // performResourceTableMigration migrate the database to use the resources table.
 func performResourceTableMigration(ctx context.Context, e db.Executable, log func(processed, total uint64)) (err error) {
 	now := time.Now().UnixNano()
@@ -180,6 +180,11 @@ func (r *sqlReader) MakeSpVerificationCtxReader() trackerdb.SpVerificationCtxRea
 	return makeStateProofVerificationReader(r.q)
 }
 
+// MakeStateHistoryReader implements trackerdb.Reader
+func (r *sqlReader) MakeStateHistoryReader() trackerdb.StateHistoryReader {
+	return makeStateHistoryReader(r.q)
+}
+
 // MakeCatchpointPendingHashesIterator implements trackerdb.Reader
 func (r *sqlReader) MakeCatchpointPendingHashesIterator(hashCount int) trackerdb.CatchpointPendingHashesIter {
 	return MakeCatchpointPendingHashesIterator(hashCount, r.q)
@@ -190,6 +195,11 @@ func (r *sqlReader) MakeCatchpointReader() (trackerdb.CatchpointReader, error) {
 	return makeCatchpointReader(r.q), nil
 }
 
+// MakeMerkleReader implements trackerdb.Reader
+func (r *sqlReader) MakeMerkleReader() (trackerdb.MerkleCommitter, error) {
+	return makeMerkleReader(r.q)
+}
+
 // MakeEncodedAccoutsBatchIter implements trackerdb.Reader
 func (r *sqlReader) MakeEncodedAccoutsBatchIter() trackerdb.EncodedAccountsBatchIter {
 	return MakeEncodedAccoutsBatchIter(r.q)
@@ -224,6 +234,11 @@ func (w *sqlWriter) MakeSpVerificationCtxWriter() trackerdb.SpVerificationCtxWri
 	return makeStateProofVerificationWriter(w.e)
 }
 
+// MakeStateHistoryWriter implements trackerdb.Writer
+func (w *sqlWriter) MakeStateHistoryWriter() trackerdb.StateHistoryWriter {
+	return makeStateHistoryWriter(w.e)
+}
+
 // Testing implements trackerdb.Writer
 func (w *sqlWriter) Testing() trackerdb.WriterTestExt {
 	return w
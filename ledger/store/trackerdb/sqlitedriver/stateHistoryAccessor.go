@@ -0,0 +1,140 @@
+// Copyright (C) 2019-2024 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package sqlitedriver
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/Quarkonium-chain/go-quarkonium/data/basics"
+	"github.com/Quarkonium-chain/go-quarkonium/ledger/store/trackerdb"
+	"github.com/Quarkonium-chain/go-quarkonium/util/db"
+)
+
+type stateHistoryReader struct {
+	q db.Queryable
+}
+
+type stateHistoryWriter struct {
+	e db.Executable
+}
+
+func makeStateHistoryReader(q db.Queryable) *stateHistoryReader {
+	return &stateHistoryReader{q: q}
+}
+
+func makeStateHistoryWriter(e db.Executable) *stateHistoryWriter {
+	return &stateHistoryWriter{e: e}
+}
+
+// StateHistoryRounds implements trackerdb.StateHistoryReader
+func (r *stateHistoryReader) StateHistoryRounds() (start basics.Round, end basics.Round, err error) {
+	queryFunc := func() error {
+		row := r.q.QueryRow("SELECT s.rnd, e.rnd FROM acctrounds s, acctrounds e WHERE s.id='historystart' AND e.id='historyend'")
+		err0 := row.Scan(&start, &end)
+		if err0 == sql.ErrNoRows {
+			return trackerdb.ErrNotFound
+		}
+		return err0
+	}
+	err = db.Retry(queryFunc)
+	return
+}
+
+// LookupStateHistory implements trackerdb.StateHistoryReader
+func (r *stateHistoryReader) LookupStateHistory(key []byte, rnd basics.Round) (value []byte, err error) {
+	queryFunc := func() error {
+		row := r.q.QueryRow("SELECT value FROM statehistory WHERE key=? AND rnd>? ORDER BY rnd LIMIT 1", key, rnd)
+		err0 := row.Scan(&value)
+		if err0 == sql.ErrNoRows {
+			return trackerdb.ErrNotFound
+		}
+		return err0
+	}
+	err = db.Retry(queryFunc)
+	return
+}
+
+// LookupStateHistoryKeys implements trackerdb.StateHistoryReader
+func (r *stateHistoryReader) LookupStateHistoryKeys(prefix []byte, rnd basics.Round) (keys [][]byte, err error) {
+	start, end := keyPrefixIntervalPreprocessing(prefix)
+	queryFunc := func() error {
+		keys = keys[:0]
+		var rows *sql.Rows
+		var err0 error
+		if end == nil {
+			rows, err0 = r.q.Query("SELECT DISTINCT key FROM statehistory WHERE key>=? AND rnd>? ORDER BY key", start, rnd)
+		} else {
+			rows, err0 = r.q.Query("SELECT DISTINCT key FROM statehistory WHERE key>=? AND key<? AND rnd>? ORDER BY key", start, end, rnd)
+		}
+		if err0 != nil {
+			return err0
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var key []byte
+			err0 = rows.Scan(&key)
+			if err0 != nil {
+				return err0
+			}
+			keys = append(keys, key)
+		}
+		return rows.Err()
+	}
+	err = db.Retry(queryFunc)
+	return
+}
+
+// StartStateHistory implements trackerdb.StateHistoryWriter
+func (w *stateHistoryWriter) StartStateHistory(ctx context.Context, rnd basics.Round) error {
+	// the table is only created once the history is enabled, so that the
+	// nodes that don't keep it don't need a schema upgrade
+	err := createStateHistoryTable(ctx, w.e)
+	if err != nil {
+		return err
+	}
+	_, err = w.e.ExecContext(ctx, "DELETE FROM statehistory WHERE rnd>?", rnd)
+	if err != nil {
+		return err
+	}
+	_, err = w.e.ExecContext(ctx, "INSERT OR REPLACE INTO acctrounds(id, rnd) VALUES('historystart', ?), ('historyend', ?)", rnd, rnd)
+	return err
+}
+
+// StoreStateHistory implements trackerdb.StateHistoryWriter
+func (w *stateHistoryWriter) StoreStateHistory(ctx context.Context, records []trackerdb.StateHistoryRecord, rnd basics.Round) error {
+	if len(records) > 0 {
+		insertStmt, err := w.e.PrepareContext(ctx, "INSERT OR REPLACE INTO statehistory(key, rnd, value) VALUES(?, ?, ?)")
+		if err != nil {
+			return err
+		}
+		defer insertStmt.Close()
+		for _, record := range records {
+			value := record.Value
+			if value == nil {
+				// the value column is NOT NULL
+				value = []byte{}
+			}
+			_, err = insertStmt.ExecContext(ctx, record.Key, record.Round, value)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	_, err := w.e.ExecContext(ctx, "UPDATE acctrounds SET rnd=? WHERE id='historyend'", rnd)
+	return err
+}
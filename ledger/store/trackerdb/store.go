@@ -56,11 +56,14 @@ type Reader interface {
 	MakeAccountsOptimizedReader() (AccountsReader, error)
 	MakeOnlineAccountsOptimizedReader() (OnlineAccountsReader, error)
 	MakeSpVerificationCtxReader() SpVerificationCtxReader
+	MakeStateHistoryReader() StateHistoryReader
 	// catchpoint
 	// Note: BuildMerkleTrie() needs this on the reader handle in sqlite to not get locked by write txns
 	MakeCatchpointPendingHashesIterator(hashCount int) CatchpointPendingHashesIter
 	// Note: Catchpoint tracker needs this on the reader handle in sqlite to not get locked by write txns
 	MakeCatchpointReader() (CatchpointReader, error)
+	// Note: the returned committer only loads pages, StorePage always fails
+	MakeMerkleReader() (MerkleCommitter, error)
 	MakeEncodedAccoutsBatchIter() EncodedAccountsBatchIter
 	MakeKVsIter(ctx context.Context) (KVsIter, error)
 }
@@ -72,6 +75,7 @@ type Writer interface {
 	MakeAccountsOptimizedWriter(hasAccounts, hasResources, hasKvPairs, hasCreatables bool) (AccountsWriter, error)
 	MakeOnlineAccountsOptimizedWriter(hasAccounts bool) (OnlineAccountsWriter, error)
 	MakeSpVerificationCtxWriter() SpVerificationCtxWriter
+	MakeStateHistoryWriter() StateHistoryWriter
 	// testing
 	Testing() WriterTestExt
 }
@@ -0,0 +1,162 @@
+// Copyright (C) 2019-2024 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package testsuite
+
+import (
+	"context"
+
+	"github.com/Quarkonium-chain/go-quarkonium/data/basics"
+	"github.com/Quarkonium-chain/go-quarkonium/ledger/store/trackerdb"
+	"github.com/stretchr/testify/require"
+)
+
+func init() {
+	// register tests that will run on each KV implementation
+	registerTest("statehistory-crud", CustomTestStateHistoryReadWrite)
+	registerTest("statehistory-keys", CustomTestStateHistoryKeys)
+}
+
+func CustomTestStateHistoryReadWrite(t *customT) {
+	shw := t.db.MakeStateHistoryWriter()
+	shr := t.db.MakeStateHistoryReader()
+
+	//
+	// test
+	//
+
+	// no history yet
+	_, _, err := shr.StateHistoryRounds()
+	require.Equal(t, trackerdb.ErrNotFound, err)
+
+	// start the history
+	err = shw.StartStateHistory(context.Background(), basics.Round(10))
+	require.NoError(t, err)
+
+	start, end, err := shr.StateHistoryRounds()
+	require.NoError(t, err)
+	require.Equal(t, basics.Round(10), start)
+	require.Equal(t, basics.Round(10), end)
+
+	// the key "a" is modified in rounds 12 and 15, and "b" in round 15
+	err = shw.StoreStateHistory(context.Background(), []trackerdb.StateHistoryRecord{
+		{Key: []byte("a"), Round: basics.Round(12), Value: []byte("a10")},
+	}, basics.Round(13))
+	require.NoError(t, err)
+	err = shw.StoreStateHistory(context.Background(), []trackerdb.StateHistoryRecord{
+		{Key: []byte("a"), Round: basics.Round(15), Value: []byte("a12")},
+		{Key: []byte("b"), Round: basics.Round(15), Value: nil},
+	}, basics.Round(16))
+	require.NoError(t, err)
+
+	start, end, err = shr.StateHistoryRounds()
+	require.NoError(t, err)
+	require.Equal(t, basics.Round(10), start)
+	require.Equal(t, basics.Round(16), end)
+
+	// read back the values
+	value, err := shr.LookupStateHistory([]byte("a"), basics.Round(10))
+	require.NoError(t, err)
+	require.Equal(t, []byte("a10"), value)
+
+	value, err = shr.LookupStateHistory([]byte("a"), basics.Round(11))
+	require.NoError(t, err)
+	require.Equal(t, []byte("a10"), value)
+
+	value, err = shr.LookupStateHistory([]byte("a"), basics.Round(12))
+	require.NoError(t, err)
+	require.Equal(t, []byte("a12"), value)
+
+	value, err = shr.LookupStateHistory([]byte("a"), basics.Round(14))
+	require.NoError(t, err)
+	require.Equal(t, []byte("a12"), value)
+
+	// the empty value is kept
+	value, err = shr.LookupStateHistory([]byte("b"), basics.Round(14))
+	require.NoError(t, err)
+	require.Empty(t, value)
+
+	// no modification after the round
+	_, err = shr.LookupStateHistory([]byte("a"), basics.Round(15))
+	require.Equal(t, trackerdb.ErrNotFound, err)
+
+	// a key sharing a prefix with an existing key
+	_, err = shr.LookupStateHistory([]byte("ab"), basics.Round(10))
+	require.Equal(t, trackerdb.ErrNotFound, err)
+
+	// start a new range, the records of the previous one are kept
+	err = shw.StartStateHistory(context.Background(), basics.Round(20))
+	require.NoError(t, err)
+
+	start, end, err = shr.StateHistoryRounds()
+	require.NoError(t, err)
+	require.Equal(t, basics.Round(20), start)
+	require.Equal(t, basics.Round(20), end)
+
+	value, err = shr.LookupStateHistory([]byte("a"), basics.Round(10))
+	require.NoError(t, err)
+	require.Equal(t, []byte("a10"), value)
+
+	_, err = shr.LookupStateHistory([]byte("a"), basics.Round(20))
+	require.Equal(t, trackerdb.ErrNotFound, err)
+
+	// starting a range before the end of the records drops the later ones
+	err = shw.StartStateHistory(context.Background(), basics.Round(13))
+	require.NoError(t, err)
+
+	value, err = shr.LookupStateHistory([]byte("a"), basics.Round(10))
+	require.NoError(t, err)
+	require.Equal(t, []byte("a10"), value)
+
+	_, err = shr.LookupStateHistory([]byte("a"), basics.Round(13))
+	require.Equal(t, trackerdb.ErrNotFound, err)
+
+	_, err = shr.LookupStateHistory([]byte("b"), basics.Round(13))
+	require.Equal(t, trackerdb.ErrNotFound, err)
+}
+
+func CustomTestStateHistoryKeys(t *customT) {
+	shw := t.db.MakeStateHistoryWriter()
+	shr := t.db.MakeStateHistoryReader()
+
+	//
+	// test
+	//
+
+	err := shw.StartStateHistory(context.Background(), basics.Round(0))
+	require.NoError(t, err)
+
+	err = shw.StoreStateHistory(context.Background(), []trackerdb.StateHistoryRecord{
+		{Key: []byte("pa"), Round: basics.Round(1), Value: []byte("x")},
+		{Key: []byte("pb"), Round: basics.Round(1), Value: []byte("x")},
+		{Key: []byte("q"), Round: basics.Round(1), Value: []byte("x")},
+		{Key: []byte("pb"), Round: basics.Round(3), Value: []byte("y")},
+		{Key: []byte("pc"), Round: basics.Round(3), Value: []byte("y")},
+	}, basics.Round(3))
+	require.NoError(t, err)
+
+	keys, err := shr.LookupStateHistoryKeys([]byte("p"), basics.Round(0))
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{[]byte("pa"), []byte("pb"), []byte("pc")}, keys)
+
+	keys, err = shr.LookupStateHistoryKeys([]byte("p"), basics.Round(1))
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{[]byte("pb"), []byte("pc")}, keys)
+
+	keys, err = shr.LookupStateHistoryKeys([]byte("p"), basics.Round(3))
+	require.NoError(t, err)
+	require.Empty(t, keys)
+}
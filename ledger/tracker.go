@@ -285,6 +285,9 @@ type deferredCommitContext struct {
 	stats       telemetryspec.AccountsUpdateMetrics
 	updateStats bool
 
+	// state deltas of the committed rounds range, for the state history tracker
+	stateHistoryDeltas []ledgercore.StateDelta
+
 	spVerification struct {
 		// state proof verification deletion information
 		lastDeleteIndex           int
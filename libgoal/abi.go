@@ -0,0 +1,612 @@
+// Copyright (C) 2019-2024 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package libgoal
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/algorand/avm-abi/abi"
+
+	"github.com/Quarkonium-chain/go-quarkonium/crypto"
+	v2 "github.com/Quarkonium-chain/go-quarkonium/daemon/algod/api/server/v2"
+	"github.com/Quarkonium-chain/go-quarkonium/data/basics"
+	"github.com/Quarkonium-chain/go-quarkonium/data/transactions"
+	"github.com/Quarkonium-chain/go-quarkonium/protocol"
+)
+
+// ABIReturnPrefix is the 4-byte prefix of the log holding the return value of
+// an ARC-4 method call
+var ABIReturnPrefix = []byte{0x15, 0x1f, 0x7c, 0x75}
+
+// abiContractsDirName is the name of the directory within the cache dir where
+// registered ABI contracts are stored, one file per application
+const abiContractsDirName = "abi"
+
+// maxABIAppArgs is the number of application arguments an ARC-4 method call
+// can use: the selector, then up to 14 arguments and a tuple of the rest.
+const maxABIAppArgs = 16
+
+// ABIArg is an argument of an ARC-4 method or of an ARC-28 event
+type ABIArg struct {
+	Name string `json:"name,omitempty"`
+	Type string `json:"type"`
+	Desc string `json:"desc,omitempty"`
+}
+
+// ABIReturns describes the return value of an ARC-4 method
+type ABIReturns struct {
+	Type string `json:"type"`
+	Desc string `json:"desc,omitempty"`
+}
+
+// ABIEvent is an ARC-28 event
+type ABIEvent struct {
+	Name string   `json:"name"`
+	Desc string   `json:"desc,omitempty"`
+	Args []ABIArg `json:"args"`
+}
+
+// ABIMethod is an ARC-4 method. Events lists the ARC-28 events the method
+// may emit, as ARC-56 describes them.
+type ABIMethod struct {
+	Name    string     `json:"name"`
+	Desc    string     `json:"desc,omitempty"`
+	Args    []ABIArg   `json:"args"`
+	Returns ABIReturns `json:"returns"`
+	Events  []ABIEvent `json:"events,omitempty"`
+}
+
+// ABIContract is an ARC-4 contract description. ARC-56 application
+// specifications are read as well, keeping the fields needed to decode calls.
+type ABIContract struct {
+	Name    string      `json:"name"`
+	Desc    string      `json:"desc,omitempty"`
+	Methods []ABIMethod `json:"methods"`
+	Events  []ABIEvent  `json:"events,omitempty"`
+}
+
+func abiArgTypes(args []ABIArg) string {
+	types := make([]string, len(args))
+	for i, arg := range args {
+		types[i] = arg.Type
+	}
+	return strings.Join(types, ",")
+}
+
+// Signature returns the method signature, e.g. "add(uint64,uint64)uint64"
+func (m ABIMethod) Signature() string {
+	return m.Name + "(" + abiArgTypes(m.Args) + ")" + m.Returns.Type
+}
+
+// Selector returns the 4-byte selector of the method
+func (m ABIMethod) Selector() []byte {
+	hash := crypto.Hash([]byte(m.Signature()))
+	return hash[:4]
+}
+
+// Signature returns the event signature, e.g. "Transfer(address,uint64)"
+func (e ABIEvent) Signature() string {
+	return e.Name + "(" + abiArgTypes(e.Args) + ")"
+}
+
+// Selector returns the 4-byte prefix of the logs emitting the event
+func (e ABIEvent) Selector() []byte {
+	hash := crypto.Hash([]byte(e.Signature()))
+	return hash[:4]
+}
+
+// ParseABIContract parses and checks an ARC-4 contract description or an
+// ARC-56 application specification
+func ParseABIContract(data []byte) (*ABIContract, error) {
+	var contract ABIContract
+	if err := json.Unmarshal(data, &contract); err != nil {
+		return nil, err
+	}
+	if len(contract.Methods) == 0 {
+		return nil, errors.New("contract has no methods")
+	}
+	selectors := make(map[string]string, len(contract.Methods))
+	for _, method := range contract.Methods {
+		if method.Returns.Type == "" {
+			return nil, fmt.Errorf("method %s has no return type", method.Name)
+		}
+		sig := method.Signature()
+		if err := abi.VerifyMethodSignature(sig); err != nil {
+			return nil, fmt.Errorf("method %s: %w", method.Name, err)
+		}
+		if other, ok := selectors[string(method.Selector())]; ok && other != sig {
+			return nil, fmt.Errorf("methods %s and %s have the same selector", other, sig)
+		}
+		selectors[string(method.Selector())] = sig
+		for _, event := range method.Events {
+			if err := checkABIEvent(event); err != nil {
+				return nil, err
+			}
+		}
+	}
+	for _, event := range contract.Events {
+		if err := checkABIEvent(event); err != nil {
+			return nil, err
+		}
+	}
+	return &contract, nil
+}
+
+func checkABIEvent(event ABIEvent) error {
+	for _, arg := range event.Args {
+		if _, err := abi.TypeOf(arg.Type); err != nil {
+			return fmt.Errorf("event %s: %w", event.Name, err)
+		}
+	}
+	return nil
+}
+
+// MethodBySelector returns the method with the given selector, or nil
+func (c *ABIContract) MethodBySelector(selector []byte) *ABIMethod {
+	for i := range c.Methods {
+		if bytes.Equal(c.Methods[i].Selector(), selector) {
+			return &c.Methods[i]
+		}
+	}
+	return nil
+}
+
+// MethodByName returns the method with the given name, or its full signature
+func (c *ABIContract) MethodByName(name string) (*ABIMethod, error) {
+	var found *ABIMethod
+	for i := range c.Methods {
+		m := &c.Methods[i]
+		if m.Signature() == name {
+			return m, nil
+		}
+		if m.Name != name {
+			continue
+		}
+		if found != nil {
+			return nil, fmt.Errorf("method name %s is ambiguous: %s or %s", name, found.Signature(), m.Signature())
+		}
+		found = m
+	}
+	if found == nil {
+		return nil, fmt.Errorf("contract %s has no method %s", c.Name, name)
+	}
+	return found, nil
+}
+
+// eventBySelector looks for the event of a log first among the events of the
+// method, then among those of the contract
+func (c *ABIContract) eventBySelector(method *ABIMethod, selector []byte) *ABIEvent {
+	for _, events := range [][]ABIEvent{method.Events, c.Events} {
+		for i := range events {
+			if bytes.Equal(events[i].Selector(), selector) {
+				return &events[i]
+			}
+		}
+	}
+	return nil
+}
+
+// DecodedABIValue is an argument, return value or event argument decoded as
+// JSON. Error is set, and Raw holds the encoded value, when it cannot be
+// decoded.
+type DecodedABIValue struct {
+	Name  string          `json:"name,omitempty"`
+	Type  string          `json:"type"`
+	Value json.RawMessage `json:"value,omitempty"`
+	Raw   []byte          `json:"raw,omitempty"`
+	Error string          `json:"error,omitempty"`
+}
+
+// DecodedABIEvent is an ARC-28 event logged by a method call
+type DecodedABIEvent struct {
+	Name string            `json:"name"`
+	Args []DecodedABIValue `json:"args"`
+}
+
+// DecodedABICall is an application call decoded with the ARC-4 contract of
+// the application. Transaction arguments are listed without a value, since
+// they are the transactions preceding the call in its group. InnerCalls holds
+// the inner application calls that could be decoded as well.
+type DecodedABICall struct {
+	AppID      basics.AppIndex   `json:"app-id"`
+	Method     string            `json:"method"`
+	Args       []DecodedABIValue `json:"args"`
+	Return     *DecodedABIValue  `json:"return,omitempty"`
+	Events     []DecodedABIEvent `json:"events,omitempty"`
+	InnerCalls []DecodedABICall  `json:"inner-calls,omitempty"`
+}
+
+func decodeABIValue(name, typeStr string, encoded []byte) DecodedABIValue {
+	value := DecodedABIValue{Name: name, Type: typeStr}
+	abiType, err := abi.TypeOf(typeStr)
+	if err == nil {
+		var decoded interface{}
+		decoded, err = abiType.Decode(encoded)
+		if err == nil {
+			value.Value, err = abiType.MarshalToJSON(decoded)
+		}
+	}
+	if err != nil {
+		value.Raw = encoded
+		value.Error = err.Error()
+	}
+	return value
+}
+
+// decodeABIReference turns the foreign array index passed for a reference
+// argument into the account, asset or application it refers to
+func decodeABIReference(value *DecodedABIValue, txn *transactions.Transaction) {
+	index, err := strconv.Atoi(string(value.Value))
+	if err != nil {
+		return
+	}
+	var resolved interface{}
+	switch value.Type {
+	case abi.AccountReferenceType:
+		if index == 0 {
+			resolved = txn.Sender.String()
+		} else if index <= len(txn.Accounts) {
+			resolved = txn.Accounts[index-1].String()
+		}
+	case abi.AssetReferenceType:
+		if index < len(txn.ForeignAssets) {
+			resolved = txn.ForeignAssets[index]
+		}
+	case abi.ApplicationReferenceType:
+		if index == 0 {
+			resolved = txn.ApplicationID
+		} else if index <= len(txn.ForeignApps) {
+			resolved = txn.ForeignApps[index-1]
+		}
+	}
+	if resolved == nil {
+		value.Error = fmt.Sprintf("%s reference %d is out of range", value.Type, index)
+		return
+	}
+	value.Value, _ = json.Marshal(resolved)
+}
+
+// decodeABIArgs decodes the arguments of a method from the application
+// arguments of a call
+func decodeABIArgs(method *ABIMethod, txn *transactions.Transaction) ([]DecodedABIValue, error) {
+	appArgs := txn.ApplicationArgs[1:]
+	args := make([]DecodedABIValue, len(method.Args))
+	var encoded []int
+	for i, arg := range method.Args {
+		args[i] = DecodedABIValue{Name: arg.Name, Type: arg.Type}
+		if !abi.IsTransactionType(arg.Type) {
+			encoded = append(encoded, i)
+		}
+	}
+	if len(encoded) > maxABIAppArgs-1 {
+		// the arguments past the 14th are packed in a tuple in the last
+		// application argument
+		if len(appArgs) != maxABIAppArgs-1 {
+			return nil, fmt.Errorf("method %s takes %d application arguments, not %d", method.Name, maxABIAppArgs-1, len(appArgs))
+		}
+		packed := encoded[maxABIAppArgs-2:]
+		types := make([]abi.Type, len(packed))
+		for j, i := range packed {
+			typeStr := method.Args[i].Type
+			if abi.IsReferenceType(typeStr) {
+				typeStr = "uint8"
+			}
+			t, err := abi.TypeOf(typeStr)
+			if err != nil {
+				return nil, err
+			}
+			types[j] = t
+		}
+		tupleType, err := abi.MakeTupleType(types)
+		if err != nil {
+			return nil, err
+		}
+		decoded, err := tupleType.Decode(appArgs[maxABIAppArgs-2])
+		if err != nil {
+			return nil, fmt.Errorf("cannot decode the packed arguments of method %s: %w", method.Name, err)
+		}
+		values := decoded.([]interface{})
+		for j, i := range packed {
+			args[i].Value, err = types[j].MarshalToJSON(values[j])
+			if err != nil {
+				args[i].Error = err.Error()
+			}
+		}
+		encoded = encoded[:maxABIAppArgs-2]
+		appArgs = appArgs[:maxABIAppArgs-2]
+	}
+	if len(appArgs) != len(encoded) {
+		return nil, fmt.Errorf("method %s takes %d application arguments, not %d", method.Name, len(encoded), len(appArgs))
+	}
+	for j, i := range encoded {
+		typeStr := method.Args[i].Type
+		if abi.IsReferenceType(typeStr) {
+			typeStr = "uint8"
+		}
+		decoded := decodeABIValue(args[i].Name, typeStr, appArgs[j])
+		decoded.Type = args[i].Type
+		args[i] = decoded
+	}
+	for i := range args {
+		if abi.IsReferenceType(args[i].Type) && args[i].Error == "" {
+			decodeABIReference(&args[i], txn)
+		}
+	}
+	return args, nil
+}
+
+// DecodeABICall decodes a call of a method of contract: its arguments, its
+// return value and the ARC-28 events it emitted, from the logs of the call.
+// The logs may be nil for a call that has not been evaluated, and then only
+// the arguments are decoded.
+func DecodeABICall(contract *ABIContract, txn transactions.Transaction, logs [][]byte) (DecodedABICall, error) {
+	if txn.Type != protocol.ApplicationCallTx {
+		return DecodedABICall{}, errors.New("not an application call")
+	}
+	if len(txn.ApplicationArgs) == 0 || len(txn.ApplicationArgs[0]) != 4 {
+		return DecodedABICall{}, errors.New("not a method call")
+	}
+	method := contract.MethodBySelector(txn.ApplicationArgs[0])
+	if method == nil {
+		return DecodedABICall{}, fmt.Errorf("contract %s has no method with selector %x", contract.Name, txn.ApplicationArgs[0])
+	}
+	args, err := decodeABIArgs(method, &txn)
+	if err != nil {
+		return DecodedABICall{}, err
+	}
+	call := DecodedABICall{
+		AppID:  txn.ApplicationID,
+		Method: method.Signature(),
+		Args:   args,
+	}
+	if logs == nil {
+		return call, nil
+	}
+
+	returnLog := -1
+	if method.Returns.Type != abi.VoidReturnType {
+		if len(logs) > 0 && bytes.HasPrefix(logs[len(logs)-1], ABIReturnPrefix) {
+			returnLog = len(logs) - 1
+			ret := decodeABIValue("", method.Returns.Type, logs[returnLog][len(ABIReturnPrefix):])
+			call.Return = &ret
+		} else {
+			call.Return = &DecodedABIValue{Type: method.Returns.Type, Error: "no return value logged"}
+		}
+	}
+	for i, log := range logs {
+		if i == returnLog || len(log) < 4 {
+			continue
+		}
+		event := contract.eventBySelector(method, log[:4])
+		if event == nil {
+			continue
+		}
+		call.Events = append(call.Events, decodeABIEvent(event, log[4:]))
+	}
+	return call, nil
+}
+
+func decodeABIEvent(event *ABIEvent, data []byte) DecodedABIEvent {
+	decoded := DecodedABIEvent{Name: event.Name, Args: make([]DecodedABIValue, len(event.Args))}
+	types := make([]abi.Type, len(event.Args))
+	for i, arg := range event.Args {
+		decoded.Args[i] = DecodedABIValue{Name: arg.Name, Type: arg.Type}
+		// the types were checked by ParseABIContract
+		types[i], _ = abi.TypeOf(arg.Type)
+	}
+	tupleType, err := abi.MakeTupleType(types)
+	var values interface{}
+	if err == nil {
+		values, err = tupleType.Decode(data)
+	}
+	if err != nil {
+		for i := range decoded.Args {
+			decoded.Args[i].Error = err.Error()
+		}
+		if len(decoded.Args) > 0 {
+			decoded.Args[0].Raw = data
+		}
+		return decoded
+	}
+	for i, value := range values.([]interface{}) {
+		decoded.Args[i].Value, err = types[i].MarshalToJSON(value)
+		if err != nil {
+			decoded.Args[i].Error = err.Error()
+		}
+	}
+	return decoded
+}
+
+// ABIContracts holds the contracts used to decode application calls: those
+// registered for an application, and fallbacks tried, in order, for the calls
+// of applications with no registered contract.
+type ABIContracts struct {
+	ByApp     map[basics.AppIndex]*ABIContract
+	Fallbacks []*ABIContract
+}
+
+func (cs ABIContracts) decode(appID basics.AppIndex, txn transactions.Transaction, logs [][]byte) (DecodedABICall, bool) {
+	if contract, ok := cs.ByApp[appID]; ok {
+		call, err := DecodeABICall(contract, txn, logs)
+		call.AppID = appID
+		return call, err == nil
+	}
+	for _, contract := range cs.Fallbacks {
+		call, err := DecodeABICall(contract, txn, logs)
+		if err == nil {
+			call.AppID = appID
+			return call, true
+		}
+	}
+	return DecodedABICall{}, false
+}
+
+// DecodeTxn decodes an application call that has not been evaluated. It
+// returns false when the transaction is not a call of a known method.
+func (cs ABIContracts) DecodeTxn(txn transactions.Transaction) (DecodedABICall, bool) {
+	return cs.decode(txn.ApplicationID, txn, nil)
+}
+
+// DecodeTxInfo decodes an evaluated application call, as returned for a
+// pending transaction or by simulate, and its inner application calls. It
+// returns false when neither the call nor any of its inner calls could be
+// decoded.
+func (cs ABIContracts) DecodeTxInfo(info v2.PreEncodedTxInfo) (DecodedABICall, bool) {
+	if info.Txn.Txn.Type != protocol.ApplicationCallTx {
+		return DecodedABICall{}, false
+	}
+	appID := info.Txn.Txn.ApplicationID
+	if appID == 0 && info.ApplicationIndex != nil {
+		appID = basics.AppIndex(*info.ApplicationIndex)
+	}
+	logs := [][]byte{}
+	if info.Logs != nil {
+		logs = *info.Logs
+	}
+	call, ok := cs.decode(appID, info.Txn.Txn, logs)
+	if info.Inners != nil {
+		for _, inner := range *info.Inners {
+			if innerCall, innerOK := cs.DecodeTxInfo(inner); innerOK {
+				call.InnerCalls = append(call.InnerCalls, innerCall)
+			}
+		}
+	}
+	if !ok && len(call.InnerCalls) > 0 {
+		// keep the inner calls of an undecoded call under its application
+		call.AppID = appID
+		ok = true
+	}
+	return call, ok
+}
+
+func (c *Client) abiContractPath(appID basics.AppIndex) (string, error) {
+	if c.cacheDir == "" {
+		return "", fmt.Errorf("libgoal not initialized with cacheDir")
+	}
+	return filepath.Join(c.cacheDir, abiContractsDirName, fmt.Sprintf("%d.json", appID)), nil
+}
+
+// RegisterABIContract checks an ARC-4 or ARC-56 contract description and
+// stores it as the contract of an application, to decode its calls
+func (c *Client) RegisterABIContract(appID basics.AppIndex, contractJSON []byte) error {
+	if appID == 0 {
+		return errors.New("cannot register a contract for application 0")
+	}
+	if _, err := ParseABIContract(contractJSON); err != nil {
+		return err
+	}
+	path, err := c.abiContractPath(appID)
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return err
+	}
+	return writeLocked(path, contractJSON, 0600)
+}
+
+// RemoveABIContract forgets the contract registered for an application
+func (c *Client) RemoveABIContract(appID basics.AppIndex) error {
+	path, err := c.abiContractPath(appID)
+	if err != nil {
+		return err
+	}
+	return os.Remove(path)
+}
+
+// ABIContract returns the contract registered for an application, or nil if
+// there is none
+func (c *Client) ABIContract(appID basics.AppIndex) (*ABIContract, error) {
+	path, err := c.abiContractPath(appID)
+	if err != nil {
+		return nil, err
+	}
+	data, err := readLocked(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return ParseABIContract(data)
+}
+
+// RegisteredABIContracts returns the IDs of the applications with a
+// registered contract, in increasing order
+func (c *Client) RegisteredABIContracts() ([]basics.AppIndex, error) {
+	path, err := c.abiContractPath(0)
+	if err != nil {
+		return nil, err
+	}
+	entries, err := os.ReadDir(filepath.Dir(path))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var apps []basics.AppIndex
+	for _, entry := range entries {
+		id, err := strconv.ParseUint(strings.TrimSuffix(entry.Name(), ".json"), 10, 64)
+		if err != nil || entry.IsDir() || !strings.HasSuffix(entry.Name(), ".json") {
+			continue
+		}
+		apps = append(apps, basics.AppIndex(id))
+	}
+	sort.Slice(apps, func(i, j int) bool { return apps[i] < apps[j] })
+	return apps, nil
+}
+
+// LoadABIContracts returns all the registered contracts
+func (c *Client) LoadABIContracts() (ABIContracts, error) {
+	apps, err := c.RegisteredABIContracts()
+	if err != nil {
+		return ABIContracts{}, err
+	}
+	contracts := ABIContracts{ByApp: make(map[basics.AppIndex]*ABIContract, len(apps))}
+	for _, app := range apps {
+		contract, err := c.ABIContract(app)
+		if err != nil {
+			return ABIContracts{}, fmt.Errorf("contract of application %d: %w", app, err)
+		}
+		contracts.ByApp[app] = contract
+	}
+	return contracts, nil
+}
+
+// DecodeABIPendingTransaction decodes a pending or recently confirmed
+// application call, and its inner calls, with the registered contracts
+func (c *Client) DecodeABIPendingTransaction(txid string) (call DecodedABICall, ok bool, err error) {
+	contracts, err := c.LoadABIContracts()
+	if err != nil {
+		return
+	}
+	info, err := c.ParsedPendingTransaction(txid)
+	if err != nil {
+		return
+	}
+	call, ok = contracts.DecodeTxInfo(info)
+	return
+}
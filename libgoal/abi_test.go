@@ -0,0 +1,293 @@
+// Copyright (C) 2019-2024 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package libgoal
+
+import (
+	"encoding/json"
+	"os"
+	"strconv"
+	"testing"
+
+	"github.com/algorand/avm-abi/abi"
+	"github.com/stretchr/testify/require"
+
+	v2 "github.com/Quarkonium-chain/go-quarkonium/daemon/algod/api/server/v2"
+	"github.com/Quarkonium-chain/go-quarkonium/data/basics"
+	"github.com/Quarkonium-chain/go-quarkonium/data/transactions"
+	"github.com/Quarkonium-chain/go-quarkonium/protocol"
+	"github.com/Quarkonium-chain/go-quarkonium/test/partitiontest"
+)
+
+// testABIContract is an ARC-56 application specification, with the fields
+// ARC-4 contracts do not have
+const testABIContract = `{
+	"arcs": [4, 56],
+	"name": "Token",
+	"structs": {"Pair": [{"name": "a", "type": "uint64"}, {"name": "b", "type": "uint64"}]},
+	"methods": [
+		{"name": "transfer", "args": [{"type": "pay", "name": "payment"}, {"type": "account", "name": "to"}, {"type": "uint64", "name": "amount"}], "returns": {"type": "bool"},
+		 "events": [{"name": "Transfer", "args": [{"type": "address", "name": "to"}, {"type": "uint64", "name": "amount"}]}]},
+		{"name": "sum", "args": [
+			{"type": "uint8"}, {"type": "uint8"}, {"type": "uint8"}, {"type": "uint8"}, {"type": "uint8"},
+			{"type": "uint8"}, {"type": "uint8"}, {"type": "uint8"}, {"type": "uint8"}, {"type": "uint8"},
+			{"type": "uint8"}, {"type": "uint8"}, {"type": "uint8"}, {"type": "string"}, {"type": "asset"},
+			{"type": "application", "name": "app"}, {"type": "(uint64,uint64)", "struct": "Pair", "name": "pair"}
+		], "returns": {"type": "uint64"}},
+		{"name": "noop", "args": [], "returns": {"type": "void"}}
+	],
+	"events": [{"name": "Burn", "args": [{"type": "uint64", "name": "amount"}]}]
+}`
+
+func abiEncode(t *testing.T, typeStr string, value interface{}) []byte {
+	abiType, err := abi.TypeOf(typeStr)
+	require.NoError(t, err)
+	encoded, err := abiType.Encode(value)
+	require.NoError(t, err)
+	return encoded
+}
+
+func TestParseABIContract(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	contract, err := ParseABIContract([]byte(testABIContract))
+	require.NoError(t, err)
+	require.Equal(t, "Token", contract.Name)
+	require.Len(t, contract.Methods, 3)
+	require.Equal(t, "transfer(pay,account,uint64)bool", contract.Methods[0].Signature())
+	require.Equal(t, "Transfer(address,uint64)", contract.Methods[0].Events[0].Signature())
+
+	m, err := contract.MethodByName("noop")
+	require.NoError(t, err)
+	require.Equal(t, "noop()void", m.Signature())
+	m, err = contract.MethodByName("noop()void")
+	require.NoError(t, err)
+	require.Equal(t, "noop", m.Name)
+	_, err = contract.MethodByName("missing")
+	require.ErrorContains(t, err, "has no method missing")
+	require.Equal(t, &contract.Methods[1], contract.MethodBySelector(contract.Methods[1].Selector()))
+
+	_, err = ParseABIContract([]byte(`{"name": "Empty", "methods": []}`))
+	require.ErrorContains(t, err, "no methods")
+	_, err = ParseABIContract([]byte(`{"name": "Bad", "methods": [{"name": "m", "args": [{"type": "uint7"}], "returns": {"type": "void"}}]}`))
+	require.Error(t, err)
+	_, err = ParseABIContract([]byte(`{"name": "Bad", "methods": [{"name": "m", "args": [], "returns": {"type": "void"}}], "events": [{"name": "E", "args": [{"type": "pay"}]}]}`))
+	require.ErrorContains(t, err, "event E")
+}
+
+func TestDecodeABICall(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	contract, err := ParseABIContract([]byte(testABIContract))
+	require.NoError(t, err)
+	transfer := contract.Methods[0]
+	sender := basics.Address{1}
+	receiver := basics.Address{2}
+
+	txn := transactions.Transaction{
+		Type:   protocol.ApplicationCallTx,
+		Header: transactions.Header{Sender: sender},
+		ApplicationCallTxnFields: transactions.ApplicationCallTxnFields{
+			ApplicationID:   7,
+			ApplicationArgs: [][]byte{transfer.Selector(), {1}, abiEncode(t, "uint64", uint64(500))},
+			Accounts:        []basics.Address{receiver},
+		},
+	}
+	transferEvent := append(transfer.Events[0].Selector(), abiEncode(t, "(address,uint64)", []interface{}{receiver[:], uint64(500)})...)
+	burnEvent := append(contract.Events[0].Selector(), abiEncode(t, "(uint64)", []interface{}{uint64(3)})...)
+	logs := [][]byte{[]byte("not an event"), transferEvent, burnEvent, append(ABIReturnPrefix, 0x80)}
+
+	call, err := DecodeABICall(contract, txn, logs)
+	require.NoError(t, err)
+	require.Equal(t, basics.AppIndex(7), call.AppID)
+	require.Equal(t, "transfer(pay,account,uint64)bool", call.Method)
+	require.Equal(t, []DecodedABIValue{
+		{Name: "payment", Type: "pay"},
+		{Name: "to", Type: "account", Value: json.RawMessage(`"` + receiver.String() + `"`)},
+		{Name: "amount", Type: "uint64", Value: json.RawMessage(`500`)},
+	}, call.Args)
+	require.Equal(t, &DecodedABIValue{Type: "bool", Value: json.RawMessage(`true`)}, call.Return)
+	require.Equal(t, []DecodedABIEvent{
+		{Name: "Transfer", Args: []DecodedABIValue{
+			{Name: "to", Type: "address", Value: json.RawMessage(`"` + receiver.String() + `"`)},
+			{Name: "amount", Type: "uint64", Value: json.RawMessage(`500`)},
+		}},
+		{Name: "Burn", Args: []DecodedABIValue{{Name: "amount", Type: "uint64", Value: json.RawMessage(`3`)}}},
+	}, call.Events)
+
+	// without logs, only the arguments are decoded
+	call, err = DecodeABICall(contract, txn, nil)
+	require.NoError(t, err)
+	require.Nil(t, call.Return)
+	require.Empty(t, call.Events)
+
+	// a missing return value, and an out of range reference
+	txn.ApplicationArgs[1] = []byte{2}
+	call, err = DecodeABICall(contract, txn, [][]byte{})
+	require.NoError(t, err)
+	require.Equal(t, "account reference 2 is out of range", call.Args[1].Error)
+	require.Equal(t, "no return value logged", call.Return.Error)
+
+	// a bad argument encoding
+	txn.ApplicationArgs[2] = []byte{1, 2}
+	call, err = DecodeABICall(contract, txn, nil)
+	require.NoError(t, err)
+	require.NotEmpty(t, call.Args[2].Error)
+	require.Equal(t, []byte{1, 2}, call.Args[2].Raw)
+
+	txn.ApplicationArgs = txn.ApplicationArgs[:2]
+	_, err = DecodeABICall(contract, txn, nil)
+	require.ErrorContains(t, err, "takes 2 application arguments, not 1")
+
+	txn.ApplicationArgs[0] = []byte{1, 2, 3, 4}
+	_, err = DecodeABICall(contract, txn, nil)
+	require.ErrorContains(t, err, "no method with selector 01020304")
+
+	txn.ApplicationArgs = nil
+	_, err = DecodeABICall(contract, txn, nil)
+	require.ErrorContains(t, err, "not a method call")
+
+	_, err = DecodeABICall(contract, transactions.Transaction{Type: protocol.PaymentTx}, nil)
+	require.ErrorContains(t, err, "not an application call")
+}
+
+func TestDecodeABICallPackedArgs(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	contract, err := ParseABIContract([]byte(testABIContract))
+	require.NoError(t, err)
+	sum := contract.Methods[1]
+
+	appArgs := [][]byte{sum.Selector()}
+	for i := 0; i < 13; i++ {
+		appArgs = append(appArgs, []byte{byte(i)})
+	}
+	appArgs = append(appArgs, abiEncode(t, "string", "hi"))
+	// the asset, application and pair arguments are packed in a tuple
+	appArgs = append(appArgs, abiEncode(t, "(uint8,uint8,(uint64,uint64))", []interface{}{uint8(0), uint8(1), []interface{}{uint64(4), uint64(5)}}))
+	txn := transactions.Transaction{
+		Type: protocol.ApplicationCallTx,
+		ApplicationCallTxnFields: transactions.ApplicationCallTxnFields{
+			ApplicationID:   7,
+			ApplicationArgs: appArgs,
+			ForeignAssets:   []basics.AssetIndex{33},
+			ForeignApps:     []basics.AppIndex{44},
+		},
+	}
+	call, err := DecodeABICall(contract, txn, nil)
+	require.NoError(t, err)
+	require.Len(t, call.Args, 17)
+	for i := 0; i < 13; i++ {
+		require.Equal(t, DecodedABIValue{Type: "uint8", Value: json.RawMessage(strconv.Itoa(i))}, call.Args[i])
+	}
+	require.Equal(t, json.RawMessage(`"hi"`), call.Args[13].Value)
+	require.Equal(t, DecodedABIValue{Type: "asset", Value: json.RawMessage(`33`)}, call.Args[14])
+	require.Equal(t, DecodedABIValue{Name: "app", Type: "application", Value: json.RawMessage(`44`)}, call.Args[15])
+	require.Equal(t, DecodedABIValue{Name: "pair", Type: "(uint64,uint64)", Value: json.RawMessage(`[4,5]`)}, call.Args[16])
+}
+
+func TestABIContractsDecodeTxInfo(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	contract, err := ParseABIContract([]byte(testABIContract))
+	require.NoError(t, err)
+	noop := contract.Methods[2]
+	appCall := func(appID basics.AppIndex, appArgs ...[]byte) transactions.SignedTxn {
+		return transactions.SignedTxn{Txn: transactions.Transaction{
+			Type: protocol.ApplicationCallTx,
+			ApplicationCallTxnFields: transactions.ApplicationCallTxnFields{
+				ApplicationID:   appID,
+				ApplicationArgs: appArgs,
+			},
+		}}
+	}
+	created := uint64(9)
+	burnEvent := append(contract.Events[0].Selector(), abiEncode(t, "(uint64)", []interface{}{uint64(3)})...)
+	info := v2.PreEncodedTxInfo{
+		Txn:              appCall(0, []byte("bare")),
+		ApplicationIndex: &created,
+		Inners: &[]v2.PreEncodedTxInfo{
+			{Txn: transactions.SignedTxn{Txn: transactions.Transaction{Type: protocol.PaymentTx}}},
+			{Txn: appCall(8, noop.Selector()), Logs: &[][]byte{burnEvent}},
+			{Txn: appCall(5, noop.Selector())},
+		},
+	}
+
+	// the created app is not a method call, its inner calls of app 8 are
+	contracts := ABIContracts{ByApp: map[basics.AppIndex]*ABIContract{8: contract}}
+	call, ok := contracts.DecodeTxInfo(info)
+	require.True(t, ok)
+	require.Equal(t, basics.AppIndex(9), call.AppID)
+	require.Empty(t, call.Method)
+	require.Len(t, call.InnerCalls, 1)
+	require.Equal(t, basics.AppIndex(8), call.InnerCalls[0].AppID)
+	require.Equal(t, "noop()void", call.InnerCalls[0].Method)
+	require.Equal(t, "Burn", call.InnerCalls[0].Events[0].Name)
+
+	// fallbacks decode the calls of the other apps
+	contracts.Fallbacks = []*ABIContract{contract}
+	call, ok = contracts.DecodeTxInfo(info)
+	require.True(t, ok)
+	require.Len(t, call.InnerCalls, 2)
+	require.Equal(t, basics.AppIndex(5), call.InnerCalls[1].AppID)
+
+	call, ok = contracts.DecodeTxn(appCall(5, noop.Selector()).Txn)
+	require.True(t, ok)
+	require.Equal(t, "noop()void", call.Method)
+	_, ok = ABIContracts{}.DecodeTxInfo(info)
+	require.False(t, ok)
+}
+
+func TestRegisterABIContract(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	c := Client{cacheDir: t.TempDir()}
+	apps, err := c.RegisteredABIContracts()
+	require.NoError(t, err)
+	require.Empty(t, apps)
+	contract, err := c.ABIContract(12)
+	require.NoError(t, err)
+	require.Nil(t, contract)
+
+	require.Error(t, c.RegisterABIContract(12, []byte(`{"name": "Empty", "methods": []}`)))
+	require.Error(t, c.RegisterABIContract(0, []byte(testABIContract)))
+	require.NoError(t, c.RegisterABIContract(12, []byte(testABIContract)))
+	require.NoError(t, c.RegisterABIContract(3, []byte(testABIContract)))
+
+	apps, err = c.RegisteredABIContracts()
+	require.NoError(t, err)
+	require.Equal(t, []basics.AppIndex{3, 12}, apps)
+	contract, err = c.ABIContract(12)
+	require.NoError(t, err)
+	require.Equal(t, "Token", contract.Name)
+	contracts, err := c.LoadABIContracts()
+	require.NoError(t, err)
+	require.Len(t, contracts.ByApp, 2)
+
+	require.NoError(t, c.RemoveABIContract(12))
+	require.True(t, os.IsNotExist(c.RemoveABIContract(12)))
+	apps, err = c.RegisteredABIContracts()
+	require.NoError(t, err)
+	require.Equal(t, []basics.AppIndex{3}, apps)
+
+	_, err = (&Client{}).ABIContract(3)
+	require.ErrorContains(t, err, "cacheDir")
+}
@@ -1350,6 +1350,33 @@ func (c *Client) GetLedgerStateDelta(round uint64) (rep ledgercore.StateDelta, e
 	return
 }
 
+// PeerReputation returns the reputation of the peers that misbehaved recently
+func (c *Client) PeerReputation() (resp model.PeerReputationResponse, err error) {
+	algod, err := c.ensureAlgodClient()
+	if err == nil {
+		return algod.PeerReputation()
+	}
+	return
+}
+
+// ClearPeerReputation forgets the reputation of a peer, lifting its ban
+func (c *Client) ClearPeerReputation(peer string) (err error) {
+	algod, err := c.ensureAlgodClient()
+	if err == nil {
+		return algod.ClearPeerReputation(peer)
+	}
+	return
+}
+
+// ReplayExporter makes the exporter export every round from round again
+func (c *Client) ReplayExporter(round uint64) (err error) {
+	algod, err := c.ensureAlgodClient()
+	if err == nil {
+		return algod.ReplayExporter(round)
+	}
+	return
+}
+
 // BlockLogs returns all the logs in a block for a given round
 func (c *Client) BlockLogs(round uint64) (resp model.BlockLogsResponse, err error) {
 	algod, err := c.ensureAlgodClient()
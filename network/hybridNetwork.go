@@ -25,6 +25,7 @@ import (
 	"github.com/Quarkonium-chain/go-quarkonium/config"
 	"github.com/Quarkonium-chain/go-quarkonium/logging"
 	"github.com/Quarkonium-chain/go-quarkonium/network/addr"
+	"github.com/Quarkonium-chain/go-quarkonium/network/messagerecorder"
 	"github.com/Quarkonium-chain/go-quarkonium/protocol"
 )
 
@@ -118,13 +119,34 @@ func (n *HybridP2PNetwork) Broadcast(ctx context.Context, tag protocol.Tag, data
 	})
 }
 
-// Relay implements GossipNode
+// Relay implements GossipNode.
+// It bridges the two networks: a message received over websockets is relayed to ws peers and published
+// on the corresponding GossipSub topic for p2p-only peers, while a message received over a GossipSub topic
+// has already been forwarded to the p2p mesh by its validator and only needs to reach ws peers.
 func (n *HybridP2PNetwork) Relay(ctx context.Context, tag protocol.Tag, data []byte, wait bool, except Peer) error {
+	if n.p2pNetwork.forwardedByGossipSub(tag, except) {
+		// the sender is not a ws peer, so there is nobody to exclude on the ws side
+		return n.wsNetwork.Relay(ctx, tag, data, wait, nil)
+	}
 	return n.runParallel(func(net GossipNode) error {
 		return net.Relay(ctx, tag, data, wait, except)
 	})
 }
 
+// SetPeerReputation specifies the reputation service shared by the p2p and websocket networks,
+// where peers are respectively identified by peer ID and IP address. It must be called before Start.
+func (n *HybridP2PNetwork) SetPeerReputation(r *PeerReputation) {
+	n.p2pNetwork.SetPeerReputation(r)
+	n.wsNetwork.SetPeerReputation(r)
+}
+
+// SetMessageRecorder specifies the recorder shared by the p2p and websocket networks.
+// It must be called before Start.
+func (n *HybridP2PNetwork) SetMessageRecorder(r *messagerecorder.Recorder) {
+	n.p2pNetwork.SetMessageRecorder(r)
+	n.wsNetwork.SetMessageRecorder(r)
+}
+
 // Disconnect implements GossipNode
 func (n *HybridP2PNetwork) Disconnect(badnode DisconnectablePeer) {
 	net := badnode.GetNetwork()
@@ -0,0 +1,252 @@
+// Copyright (C) 2019-2024 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package messagerecorder
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Quarkonium-chain/go-quarkonium/logging"
+	"github.com/Quarkonium-chain/go-quarkonium/protocol"
+	"github.com/Quarkonium-chain/go-quarkonium/test/partitiontest"
+)
+
+func makeTestRecords(count int, start time.Time) []Record {
+	peers := []string{"", "r1.example.com:4160", "QmPeer"}
+	tags := []protocol.Tag{protocol.AgreementVoteTag, protocol.TxnTag, protocol.ProposalPayloadTag}
+	records := make([]Record, count)
+	for i := range records {
+		records[i] = Record{
+			Direction: Direction(i % 2),
+			// records can be slightly out of order when recorded from several threads
+			Time: start.Add(time.Duration(i*10-(i%3)*7) * time.Millisecond),
+			Tag:  tags[i%len(tags)],
+			Peer: peers[i%len(peers)],
+			Data: bytes.Repeat([]byte{byte(i)}, i%50),
+		}
+	}
+	return records
+}
+
+func readAll(t *testing.T, r *Reader) []Record {
+	var records []Record
+	for {
+		rec, err := r.Next()
+		if err == io.EOF {
+			return records
+		}
+		require.NoError(t, err)
+		records = append(records, rec)
+	}
+}
+
+func requireRecordsEqual(t *testing.T, expected, actual []Record) {
+	require.Len(t, actual, len(expected))
+	for i := range expected {
+		require.Equal(t, expected[i].Direction, actual[i].Direction, i)
+		require.True(t, expected[i].Time.Equal(actual[i].Time), i)
+		require.Equal(t, expected[i].Tag, actual[i].Tag, i)
+		require.Equal(t, expected[i].Peer, actual[i].Peer, i)
+		require.True(t, bytes.Equal(expected[i].Data, actual[i].Data), i)
+	}
+}
+
+func TestWriterReader(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), Filename)
+	w, err := MakeWriter(path, 0, 2)
+	require.NoError(t, err)
+	records := makeTestRecords(100, time.Now())
+	for _, rec := range records {
+		require.NoError(t, w.Write(rec))
+	}
+	require.ErrorIs(t, w.Write(Record{Tag: "TXN"}), errInvalidTag)
+	require.NoError(t, w.Close())
+	require.ErrorIs(t, w.Write(records[0]), os.ErrClosed)
+
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+	requireRecordsEqual(t, records, readAll(t, MakeReader(f)))
+}
+
+func TestWriterRotation(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), Filename)
+	w, err := MakeWriter(path, 1000, 3)
+	require.NoError(t, err)
+	records := makeTestRecords(300, time.Now())
+	for _, rec := range records {
+		require.NoError(t, w.Write(rec))
+	}
+	require.NoError(t, w.Close())
+
+	files := Files(path)
+	require.Equal(t, []string{path + ".3", path + ".2", path + ".1", path}, files)
+	_, err = os.Stat(path + ".4")
+	require.True(t, os.IsNotExist(err))
+
+	// every file can be read on its own, and the files make the tail of the recording
+	var readers []io.Reader
+	var tail []Record
+	for _, name := range files {
+		data, err := os.ReadFile(name)
+		require.NoError(t, err)
+		require.LessOrEqual(t, len(data), 1000)
+		tail = append(tail, readAll(t, MakeReader(bytes.NewReader(data)))...)
+		readers = append(readers, bytes.NewReader(data))
+	}
+	requireRecordsEqual(t, records[len(records)-len(tail):], tail)
+	requireRecordsEqual(t, tail, readAll(t, MakeReader(io.MultiReader(readers...))))
+
+	// an existing recording is archived rather than overwritten
+	live, err := os.ReadFile(path)
+	require.NoError(t, err)
+	w, err = MakeWriter(path, 1000, 3)
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	archived, err := os.ReadFile(path + ".1")
+	require.NoError(t, err)
+	require.Equal(t, live, archived)
+	fresh, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Empty(t, readAll(t, MakeReader(bytes.NewReader(fresh))))
+}
+
+func TestReaderErrors(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	_, err := MakeReader(bytes.NewReader([]byte("not a recording"))).Next()
+	require.ErrorIs(t, err, errBadMagic)
+	_, err = MakeReader(bytes.NewReader([]byte{0, 'A', 'V'})).Next()
+	require.ErrorIs(t, err, errBadMagic)
+
+	buf := appendHeader(nil, time.Now().UnixNano())
+	rec := makeTestRecords(2, time.Now())[1]
+	buf = appendRecord(buf, &rec, 0, 0, true)
+	for _, n := range []int{len(fileMagic) - 1, len(buf) - 1, len(buf) - len(rec.Data) - 1} {
+		_, err = MakeReader(bytes.NewReader(buf[:n])).Next()
+		require.ErrorIs(t, err, io.ErrUnexpectedEOF, n)
+	}
+
+	unknownPeer := appendRecord(appendHeader(nil, 0), &rec, 0, 1, false)
+	_, err = MakeReader(bytes.NewReader(unknownPeer)).Next()
+	require.ErrorContains(t, err, "invalid peer index")
+}
+
+func TestRecorder(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	var nilRecorder *Recorder
+	nilRecorder.Record(Inbound, time.Now(), protocol.TxnTag, "", nil)
+	nilRecorder.Close()
+
+	path := filepath.Join(t.TempDir(), Filename)
+	w, err := MakeWriter(path, 0, 0)
+	require.NoError(t, err)
+	r := MakeRecorder(logging.TestingLog(t), w)
+
+	records := makeTestRecords(50, time.Now())
+	for _, rec := range records {
+		data := rec.Data
+		r.Record(rec.Direction, rec.Time, rec.Tag, rec.Peer, data)
+		// the recorder keeps its own copy
+		for i := range data {
+			data[i] = 0xff
+		}
+	}
+	r.Close()
+	r.Record(Inbound, time.Now(), protocol.TxnTag, "", nil)
+
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+	requireRecordsEqual(t, makeTestRecords(50, records[0].Time), readAll(t, MakeReader(f)))
+}
+
+func TestReplay(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	var buf bytes.Buffer
+	start := time.Now()
+	records := []Record{
+		{Time: start, Tag: protocol.TxnTag},
+		{Time: start.Add(200 * time.Millisecond), Tag: protocol.AgreementVoteTag},
+		{Time: start.Add(400 * time.Millisecond), Tag: protocol.ProposalPayloadTag},
+	}
+	enc := appendHeader(nil, start.UnixNano())
+	last := start.UnixNano()
+	for i := range records {
+		enc = appendRecord(enc, &records[i], last, 0, i == 0)
+		last = records[i].Time.UnixNano()
+	}
+	buf.Write(enc)
+
+	replay := func(speed float64) ([]Record, []time.Duration) {
+		var replayed []Record
+		var elapsed []time.Duration
+		begin := time.Now()
+		err := Replay(context.Background(), MakeReader(bytes.NewReader(buf.Bytes())), speed, func(rec Record) error {
+			replayed = append(replayed, rec)
+			elapsed = append(elapsed, time.Since(begin))
+			return nil
+		})
+		require.NoError(t, err)
+		return replayed, elapsed
+	}
+
+	// original timing
+	replayed, elapsed := replay(1)
+	requireRecordsEqual(t, records, replayed)
+	require.GreaterOrEqual(t, elapsed[1], 200*time.Millisecond)
+	require.GreaterOrEqual(t, elapsed[2], 400*time.Millisecond)
+
+	// accelerated
+	replayed, elapsed = replay(4)
+	requireRecordsEqual(t, records, replayed)
+	require.GreaterOrEqual(t, elapsed[2], 100*time.Millisecond)
+	require.Less(t, elapsed[2], 400*time.Millisecond)
+
+	// canceled while waiting for the next record
+	ctx, cancel := context.WithCancel(context.Background())
+	err := Replay(ctx, MakeReader(bytes.NewReader(buf.Bytes())), 1, func(rec Record) error {
+		cancel()
+		return nil
+	})
+	require.ErrorIs(t, err, context.Canceled)
+
+	// stopped by deliver
+	errStop := io.ErrClosedPipe
+	err = Replay(context.Background(), MakeReader(bytes.NewReader(buf.Bytes())), 0, func(rec Record) error {
+		return errStop
+	})
+	require.ErrorIs(t, err, errStop)
+}
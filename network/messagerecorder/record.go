@@ -0,0 +1,225 @@
+// Copyright (C) 2019-2024 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+// Package messagerecorder records the gossip messages a node sends and receives to rotating files,
+// and reads them back to replay them into message handlers with their original timing.
+package messagerecorder
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/Quarkonium-chain/go-quarkonium/protocol"
+)
+
+// A recording is a sequence of files, each made of a header and of records:
+//
+//	header: fileMagic, start time as a varint of unix nanoseconds
+//	record: direction byte, 2 bytes tag,
+//	        varint nanoseconds since the previous record (or the file start),
+//	        uvarint peer index, followed by uvarint length and peer if the index is a new one,
+//	        uvarint data length, data
+//
+// Peers are numbered in the order they first appear in a file. Files are self-contained so
+// that they can be read after rotation, and concatenating them yields a valid recording.
+const fileMagic = "algorec1"
+
+// maxPeerLength bounds the peer addresses read from a recording
+const maxPeerLength = 1024
+
+// maxDataLength bounds the message payloads read from a recording
+const maxDataLength = 16 * 1024 * 1024
+
+// errBadMagic is returned when reading a file that is not a message recording
+var errBadMagic = errors.New("not a message recording")
+
+// Direction is whether a recorded message was received or sent
+type Direction byte
+
+const (
+	// Inbound messages were received from Peer
+	Inbound Direction = iota
+	// Outbound messages were sent to Peer, or broadcast if Peer is empty
+	Outbound
+)
+
+func (d Direction) String() string {
+	switch d {
+	case Inbound:
+		return "in"
+	case Outbound:
+		return "out"
+	default:
+		return fmt.Sprintf("Direction(%d)", byte(d))
+	}
+}
+
+// Record is a single recorded message
+type Record struct {
+	Direction Direction
+	Time      time.Time
+	Tag       protocol.Tag
+	Peer      string
+	Data      []byte
+}
+
+// Reader reads the records of a recording
+type Reader struct {
+	r     *bufio.Reader
+	last  int64
+	peers []string
+	// started is set once a file header was read
+	started bool
+}
+
+// MakeReader returns a Reader for the recording read from r, which may be made of several
+// concatenated recording files.
+func MakeReader(r io.Reader) *Reader {
+	return &Reader{r: bufio.NewReader(r)}
+}
+
+// Next returns the next record, or io.EOF at the end of the recording.
+// A record truncated by an interrupted write returns io.ErrUnexpectedEOF.
+func (r *Reader) Next() (rec Record, err error) {
+	for {
+		var b []byte
+		b, err = r.r.Peek(1)
+		if err != nil {
+			return Record{}, err
+		}
+		if b[0] != fileMagic[0] {
+			break
+		}
+		err = r.readHeader()
+		if err != nil {
+			return Record{}, err
+		}
+	}
+	if !r.started {
+		return Record{}, errBadMagic
+	}
+
+	var hdr [3]byte
+	_, err = io.ReadFull(r.r, hdr[:])
+	if err != nil {
+		return Record{}, unexpectedEOF(err)
+	}
+	rec.Direction = Direction(hdr[0])
+	if rec.Direction != Inbound && rec.Direction != Outbound {
+		return Record{}, fmt.Errorf("invalid message direction %d", hdr[0])
+	}
+	rec.Tag = protocol.Tag(hdr[1:3])
+
+	delta, err := binary.ReadVarint(r.r)
+	if err != nil {
+		return Record{}, unexpectedEOF(err)
+	}
+	r.last += delta
+	rec.Time = time.Unix(0, r.last)
+
+	idx, err := binary.ReadUvarint(r.r)
+	if err != nil {
+		return Record{}, unexpectedEOF(err)
+	}
+	switch {
+	case idx < uint64(len(r.peers)):
+		rec.Peer = r.peers[idx]
+	case idx == uint64(len(r.peers)):
+		var peer []byte
+		peer, err = r.readBytes(maxPeerLength)
+		if err != nil {
+			return Record{}, err
+		}
+		rec.Peer = string(peer)
+		r.peers = append(r.peers, rec.Peer)
+	default:
+		return Record{}, fmt.Errorf("invalid peer index %d, %d peers known", idx, len(r.peers))
+	}
+
+	rec.Data, err = r.readBytes(maxDataLength)
+	if err != nil {
+		return Record{}, err
+	}
+	return rec, nil
+}
+
+// readHeader reads a file header, starting a new peer table
+func (r *Reader) readHeader() error {
+	var magic [len(fileMagic)]byte
+	_, err := io.ReadFull(r.r, magic[:])
+	if err != nil {
+		return unexpectedEOF(err)
+	}
+	if string(magic[:]) != fileMagic {
+		return errBadMagic
+	}
+	start, err := binary.ReadVarint(r.r)
+	if err != nil {
+		return unexpectedEOF(err)
+	}
+	r.last = start
+	r.peers = r.peers[:0]
+	r.started = true
+	return nil
+}
+
+func (r *Reader) readBytes(limit uint64) ([]byte, error) {
+	n, err := binary.ReadUvarint(r.r)
+	if err != nil {
+		return nil, unexpectedEOF(err)
+	}
+	if n > limit {
+		return nil, fmt.Errorf("recorded length %d exceeds %d", n, limit)
+	}
+	data := make([]byte, n)
+	_, err = io.ReadFull(r.r, data)
+	if err != nil {
+		return nil, unexpectedEOF(err)
+	}
+	return data, nil
+}
+
+// unexpectedEOF reports a recording ending within a record
+func unexpectedEOF(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
+
+// appendHeader appends a file header to buf
+func appendHeader(buf []byte, start int64) []byte {
+	buf = append(buf, fileMagic...)
+	return binary.AppendVarint(buf, start)
+}
+
+// appendRecord appends rec to buf, given the time of the previous record and whether
+// its peer is new or already has an index in the file.
+func appendRecord(buf []byte, rec *Record, last int64, peerIdx uint64, newPeer bool) []byte {
+	buf = append(buf, byte(rec.Direction), rec.Tag[0], rec.Tag[1])
+	buf = binary.AppendVarint(buf, rec.Time.UnixNano()-last)
+	buf = binary.AppendUvarint(buf, peerIdx)
+	if newPeer {
+		buf = binary.AppendUvarint(buf, uint64(len(rec.Peer)))
+		buf = append(buf, rec.Peer...)
+	}
+	buf = binary.AppendUvarint(buf, uint64(len(rec.Data)))
+	return append(buf, rec.Data...)
+}
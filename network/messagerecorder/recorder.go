@@ -0,0 +1,121 @@
+// Copyright (C) 2019-2024 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package messagerecorder
+
+import (
+	"time"
+
+	"github.com/Quarkonium-chain/go-quarkonium/logging"
+	"github.com/Quarkonium-chain/go-quarkonium/protocol"
+	"github.com/Quarkonium-chain/go-quarkonium/util/metrics"
+)
+
+// Filename is the name of the recording file in the node's hot data directory
+const Filename = "network.rec"
+
+// recorderQueueSize is the number of records that can be waiting to be written
+// before new ones are dropped
+const recorderQueueSize = 4096
+
+// recorderFlushInterval is how often buffered records are flushed to the file
+const recorderFlushInterval = time.Second
+
+var recordedMessagesTotal = metrics.MakeCounter(metrics.MetricName{Name: "algod_network_recorder_messages_total", Description: "number of network messages recorded"})
+var recorderDroppedTotal = metrics.MakeCounter(metrics.MetricName{Name: "algod_network_recorder_dropped_total", Description: "number of network messages not recorded because the recorder fell behind"})
+
+// Recorder records messages to a Writer from its own goroutine, so that the network
+// threads never wait on the disk. Messages are dropped if the writes fall behind.
+// A nil *Recorder records nothing.
+type Recorder struct {
+	log     logging.Logger
+	w       *Writer
+	queue   chan Record
+	closing chan struct{}
+	done    chan struct{}
+}
+
+// MakeRecorder creates a Recorder writing to w and starts its writing goroutine
+func MakeRecorder(log logging.Logger, w *Writer) *Recorder {
+	r := &Recorder{
+		log:     log,
+		w:       w,
+		queue:   make(chan Record, recorderQueueSize),
+		closing: make(chan struct{}),
+		done:    make(chan struct{}),
+	}
+	go r.writeThread()
+	return r
+}
+
+// Record records a message. The data is copied, so the caller keeps ownership of it.
+func (r *Recorder) Record(dir Direction, t time.Time, tag protocol.Tag, peer string, data []byte) {
+	if r == nil {
+		return
+	}
+	rec := Record{Direction: dir, Time: t, Tag: tag, Peer: peer, Data: append([]byte(nil), data...)}
+	select {
+	case r.queue <- rec:
+	default:
+		recorderDroppedTotal.Inc(nil)
+	}
+}
+
+// Close writes the queued records and closes the recording. Messages recorded afterward are dropped.
+func (r *Recorder) Close() {
+	if r == nil {
+		return
+	}
+	close(r.closing)
+	<-r.done
+}
+
+func (r *Recorder) writeThread() {
+	defer close(r.done)
+	ticker := time.NewTicker(recorderFlushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case rec := <-r.queue:
+			r.write(rec)
+		case <-ticker.C:
+			if err := r.w.Flush(); err != nil {
+				r.log.Warnf("unable to flush the message recording: %v", err)
+			}
+		case <-r.closing:
+			for {
+				select {
+				case rec := <-r.queue:
+					r.write(rec)
+				default:
+					if err := r.w.Close(); err != nil {
+						r.log.Warnf("unable to close the message recording: %v", err)
+					}
+					return
+				}
+			}
+		}
+	}
+}
+
+func (r *Recorder) write(rec Record) {
+	err := r.w.Write(rec)
+	if err != nil {
+		r.log.Warnf("unable to record %s message: %v", rec.Tag, err)
+		return
+	}
+	recordedMessagesTotal.Inc(nil)
+}
@@ -0,0 +1,61 @@
+// Copyright (C) 2019-2024 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package messagerecorder
+
+import (
+	"context"
+	"io"
+	"time"
+)
+
+// Replay reads the records of src and passes them to deliver, waiting between records for the
+// time that separated them when recorded divided by speed. A speed of 0 or less delivers the
+// records without waiting. Replay stops at the end of the recording, when the context is
+// canceled, or when deliver returns an error.
+func Replay(ctx context.Context, src *Reader, speed float64, deliver func(Record) error) error {
+	var start, first time.Time
+	for {
+		rec, err := src.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if speed > 0 {
+			if start.IsZero() {
+				start, first = time.Now(), rec.Time
+			}
+			due := start.Add(time.Duration(float64(rec.Time.Sub(first)) / speed))
+			if wait := time.Until(due); wait > 0 {
+				timer := time.NewTimer(wait)
+				select {
+				case <-timer.C:
+				case <-ctx.Done():
+					timer.Stop()
+					return ctx.Err()
+				}
+			}
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := deliver(rec); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,198 @@
+// Copyright (C) 2019-2024 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package messagerecorder
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"time"
+)
+
+// writeBufferSize is the size of the buffer records are written through
+const writeBufferSize = 256 * 1024
+
+var errInvalidTag = errors.New("recorded tags must be 2 bytes long")
+
+// Writer writes records to a file that is rotated once it reaches a size limit.
+// Rotated files are kept as path.1 (the most recent) to path.N.
+// A Writer is not safe for concurrent use.
+type Writer struct {
+	path      string
+	sizeLimit uint64
+	archives  int
+
+	file *os.File
+	buf  *bufio.Writer
+	size uint64
+	last int64
+	// peers indexes the peers recorded in the current file
+	peers map[string]uint64
+
+	scratch []byte
+}
+
+// MakeWriter creates a Writer recording to path. An existing recording at path is rotated first.
+// A sizeLimit of 0 never rotates the recording.
+func MakeWriter(path string, sizeLimit uint64, archives int) (*Writer, error) {
+	w := &Writer{
+		path:      path,
+		sizeLimit: sizeLimit,
+		archives:  archives,
+	}
+	_, err := os.Stat(path)
+	if err == nil {
+		err = w.rotate()
+		if err != nil {
+			return nil, err
+		}
+	} else if !os.IsNotExist(err) {
+		return nil, err
+	}
+	err = w.open(time.Now())
+	if err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+// Write appends a record to the recording, rotating it first if the record does not fit
+func (w *Writer) Write(rec Record) error {
+	if len(rec.Tag) != 2 {
+		return errInvalidTag
+	}
+	if w.file == nil {
+		return os.ErrClosed
+	}
+	peerIdx, known := w.peers[rec.Peer]
+	if !known {
+		peerIdx = uint64(len(w.peers))
+	}
+	w.scratch = appendRecord(w.scratch[:0], &rec, w.last, peerIdx, !known)
+
+	if w.sizeLimit != 0 && w.size+uint64(len(w.scratch)) > w.sizeLimit && len(w.peers) > 0 {
+		err := w.close()
+		if err != nil {
+			return err
+		}
+		err = w.rotate()
+		if err != nil {
+			return err
+		}
+		err = w.open(rec.Time)
+		if err != nil {
+			return err
+		}
+		// the peer table and time base of the new file differ, encode again
+		peerIdx, known = 0, false
+		w.scratch = appendRecord(w.scratch[:0], &rec, w.last, peerIdx, true)
+	}
+
+	_, err := w.buf.Write(w.scratch)
+	if err != nil {
+		return err
+	}
+	w.size += uint64(len(w.scratch))
+	w.last = rec.Time.UnixNano()
+	if !known {
+		w.peers[rec.Peer] = peerIdx
+	}
+	return nil
+}
+
+// Flush writes the buffered records to the file
+func (w *Writer) Flush() error {
+	if w.file == nil {
+		return os.ErrClosed
+	}
+	return w.buf.Flush()
+}
+
+// Close flushes and closes the recording
+func (w *Writer) Close() error {
+	if w.file == nil {
+		return os.ErrClosed
+	}
+	return w.close()
+}
+
+func (w *Writer) close() error {
+	err := w.buf.Flush()
+	cerr := w.file.Close()
+	w.file, w.buf = nil, nil
+	if err != nil {
+		return err
+	}
+	return cerr
+}
+
+// open starts a new recording file
+func (w *Writer) open(start time.Time) error {
+	file, err := os.OpenFile(w.path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0644)
+	if err != nil {
+		return err
+	}
+	w.file = file
+	w.buf = bufio.NewWriterSize(file, writeBufferSize)
+	w.last = start.UnixNano()
+	w.peers = make(map[string]uint64)
+	w.scratch = appendHeader(w.scratch[:0], w.last)
+	_, err = w.buf.Write(w.scratch)
+	w.size = uint64(len(w.scratch))
+	return err
+}
+
+// rotate shifts the archives by one, dropping the oldest, and archives the current file as path.1
+func (w *Writer) rotate() error {
+	if w.archives <= 0 {
+		return os.Remove(w.path)
+	}
+	err := os.Remove(archivePath(w.path, w.archives))
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	for i := w.archives - 1; i >= 1; i-- {
+		err = os.Rename(archivePath(w.path, i), archivePath(w.path, i+1))
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return os.Rename(w.path, archivePath(w.path, 1))
+}
+
+func archivePath(path string, i int) string {
+	return fmt.Sprintf("%s.%d", path, i)
+}
+
+// Files returns the files of the recording at path, oldest archive first and path last, so that
+// reading them in order replays the whole recording.
+func Files(path string) []string {
+	var files []string
+	for i := 1; ; i++ {
+		if _, err := os.Stat(archivePath(path, i)); err != nil {
+			break
+		}
+		files = append(files, archivePath(path, i))
+	}
+	slices.Reverse(files)
+	if _, err := os.Stat(path); err == nil {
+		files = append(files, path)
+	}
+	return files
+}
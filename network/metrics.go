@@ -96,6 +96,13 @@ var networkPeerAlreadyClosed = metrics.MakeCounter(metrics.MetricName{Name: "alg
 
 var networkSlowPeerDrops = metrics.MakeCounter(metrics.MetricName{Name: "algod_network_slow_drops_total", Description: "number of peers dropped for being slow to send to"})
 var networkIdlePeerDrops = metrics.MakeCounter(metrics.MetricName{Name: "algod_network_idle_drops_total", Description: "number of peers dropped due to idle connection"})
+var networkPeersBannedTotal = metrics.MakeCounter(metrics.MetricName{Name: "algod_network_peers_banned_total", Description: "number of times a peer was banned for misbehaving"})
+
+var networkTxInventoryAnnouncedTotal = metrics.MakeCounter(metrics.MetricName{Name: "algod_network_txinv_announced_total", Description: "number of transaction group digests announced to peers"})
+var networkTxInventoryRequestedTotal = metrics.MakeCounter(metrics.MetricName{Name: "algod_network_txinv_requested_total", Description: "number of announced transaction groups requested from peers"})
+var networkTxInventoryServedTotal = metrics.MakeCounter(metrics.MetricName{Name: "algod_network_txinv_served_total", Description: "number of transaction groups sent to peers that requested them"})
+var networkTxInventoryRejectedTotal = metrics.MakeCounter(metrics.MetricName{Name: "algod_network_txinv_rejected_requests_total", Description: "number of transaction group requests for groups not announced to the peer or already sent to it"})
+var networkTxInventoryTimeoutsTotal = metrics.MakeCounter(metrics.MetricName{Name: "algod_network_txinv_request_timeouts_total", Description: "number of transaction group requests that timed out"})
 
 var peers = metrics.MakeGauge(metrics.MetricName{Name: "algod_network_peers", Description: "Number of active peers."})
 var incomingPeers = metrics.MakeGauge(metrics.MetricName{Name: "algod_network_incoming_peers", Description: "Number of active incoming peers."})
@@ -132,26 +139,46 @@ func (t pubsubMetricsTracer) Graft(p peer.ID, topic string) {}
 // Prune is invoked when a peer is pruned from the message (gossipsub)
 func (t pubsubMetricsTracer) Prune(p peer.ID, topic string) {}
 
+// isTxTopicMessage reports whether msg belongs to the transaction topic,
+// the transaction message counters must not account for agreement topics.
+func isTxTopicMessage(msg *pubsub.Message) bool {
+	return msg != nil && msg.Topic != nil && *msg.Topic == p2p.TXTopicName
+}
+
 // ValidateMessage is invoked when a message first enters the validation pipeline.
 func (t pubsubMetricsTracer) ValidateMessage(msg *pubsub.Message) {
 	if msg != nil && msg.Topic != nil {
+		var tag protocol.Tag
 		switch *msg.Topic {
 		case p2p.TXTopicName:
+			tag = protocol.TxnTag
+		case p2p.AVTopicName:
+			tag = protocol.AgreementVoteTag
+		case p2p.PPTopicName:
+			tag = protocol.ProposalPayloadTag
+		}
+		if tag != "" {
 			networkP2PReceivedBytesTotal.AddUint64(uint64(len(msg.Data)), nil)
-			networkP2PReceivedBytesByTag.Add(string(protocol.TxnTag), uint64(len(msg.Data)))
-			networkP2PMessageReceivedByTag.Add(string(protocol.TxnTag), 1)
+			networkP2PReceivedBytesByTag.Add(string(tag), uint64(len(msg.Data)))
+			networkP2PMessageReceivedByTag.Add(string(tag), 1)
 		}
 	}
 }
 
 // DeliverMessage is invoked when a message is delivered
 func (t pubsubMetricsTracer) DeliverMessage(msg *pubsub.Message) {
+	if !isTxTopicMessage(msg) {
+		return
+	}
 	transactionMessagesP2PDeliverMessage.Inc(nil)
 }
 
 // RejectMessage is invoked when a message is Rejected or Ignored.
 // The reason argument can be one of the named strings Reject*.
 func (t pubsubMetricsTracer) RejectMessage(msg *pubsub.Message, reason string) {
+	if !isTxTopicMessage(msg) {
+		return
+	}
 	// TagCounter cannot handle tags with spaces so pubsub.Reject* cannot be used directly.
 	// Since Go's strings are immutable, char replacement is a new allocation so that stick to string literals.
 	switch reason {
@@ -170,6 +197,9 @@ func (t pubsubMetricsTracer) RejectMessage(msg *pubsub.Message, reason string) {
 
 // DuplicateMessage is invoked when a duplicate message is dropped.
 func (t pubsubMetricsTracer) DuplicateMessage(msg *pubsub.Message) {
+	if !isTxTopicMessage(msg) {
+		return
+	}
 	transactionMessagesP2PDuplicateMessage.Inc(nil)
 }
 
@@ -186,11 +216,19 @@ func (t pubsubMetricsTracer) SendRPC(rpc *pubsub.RPC, p peer.ID) {
 	networkP2PGossipSubSentBytesTotal.AddUint64(uint64(rpc.Size()), nil)
 	for i := range rpc.GetPublish() {
 		if rpc.Publish[i] != nil && rpc.Publish[i].Topic != nil {
+			var tag protocol.Tag
 			switch *rpc.Publish[i].Topic {
 			case p2p.TXTopicName:
-				networkP2PSentBytesByTag.Add(string(protocol.TxnTag), uint64(len(rpc.Publish[i].Data)))
+				tag = protocol.TxnTag
+			case p2p.AVTopicName:
+				tag = protocol.AgreementVoteTag
+			case p2p.PPTopicName:
+				tag = protocol.ProposalPayloadTag
+			}
+			if tag != "" {
+				networkP2PSentBytesByTag.Add(string(tag), uint64(len(rpc.Publish[i].Data)))
 				networkP2PSentBytesTotal.AddUint64(uint64(len(rpc.Publish[i].Data)), nil)
-				networkP2PMessageSentByTag.Add(string(protocol.TxnTag), 1)
+				networkP2PMessageSentByTag.Add(string(tag), 1)
 			}
 		}
 	}
@@ -202,5 +240,8 @@ func (t pubsubMetricsTracer) DropRPC(rpc *pubsub.RPC, p peer.ID) {}
 // UndeliverableMessage is invoked when the consumer of Subscribe is not reading messages fast enough and
 // the pressure release mechanism trigger, dropping messages.
 func (t pubsubMetricsTracer) UndeliverableMessage(msg *pubsub.Message) {
+	if !isTxTopicMessage(msg) {
+		return
+	}
 	transactionMessagesP2PUnderdeliverableMessage.Inc(nil)
 }
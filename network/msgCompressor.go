@@ -24,6 +24,7 @@ import (
 	"github.com/DataDog/zstd"
 
 	"github.com/Quarkonium-chain/go-quarkonium/logging"
+	"github.com/Quarkonium-chain/go-quarkonium/network/vpack"
 	"github.com/Quarkonium-chain/go-quarkonium/protocol"
 )
 
@@ -52,19 +53,33 @@ func zstdCompressMsg(tbytes []byte, d []byte) ([]byte, string) {
 	return mbytesComp, ""
 }
 
+// voteCompressMsg appends to buf a concatenation of a tag and the vote data compressed
+// by the connection's encoder. It returns false if the data is not a well-formed vote
+// encoding, in which case the message should be sent non-compressed.
+func voteCompressMsg(enc *vpack.StatefulEncoder, buf []byte, msg []byte) ([]byte, bool) {
+	tlen := len(protocol.AgreementVoteTag)
+	comp, err := enc.Compress(append(buf[:0], msg[:tlen]...), msg[tlen:])
+	if err != nil {
+		return nil, false
+	}
+	return comp, true
+}
+
 // MaxDecompressedMessageSize defines a maximum decompressed data size
 // to prevent zip bombs. This depends on MaxTxnBytesPerBlock consensus parameter
 // and should be larger.
 const MaxDecompressedMessageSize = 20 * 1024 * 1024 // some large enough value
 
 // wsPeerMsgDataConverter performs optional incoming messages conversion.
-// At the moment it only supports zstd decompression for payload proposal
+// It supports zstd decompression for payload proposal and stateful vote decompression
 type wsPeerMsgDataConverter struct {
 	log    logging.Logger
 	origin string
 
 	// actual converter(s)
 	ppdec zstdProposalDecompressor
+	// avdec is set if the peer may send compressed votes
+	avdec *vpack.StatefulDecoder
 }
 
 type zstdProposalDecompressor struct{}
@@ -109,6 +124,14 @@ func (c *wsPeerMsgDataConverter) convert(tag protocol.Tag, data []byte) ([]byte,
 		}
 		c.log.Warnf("peer %s supported zstd but sent non-compressed data", c.origin)
 	}
+	if tag == protocol.AgreementVoteTag && c.avdec != nil && vpack.IsCompressed(data) {
+		// the sender falls back to non-compressed votes it cannot compress
+		res, err := c.avdec.Decompress(nil, data)
+		if err != nil {
+			return nil, fmt.Errorf("peer %s: %w", c.origin, err)
+		}
+		return res, nil
+	}
 	return data, nil
 }
 
@@ -119,5 +142,8 @@ func makeWsPeerMsgDataConverter(wp *wsPeer) *wsPeerMsgDataConverter {
 	}
 
 	c.ppdec = zstdProposalDecompressor{}
+	if wp.features&pfCompressedVote != 0 {
+		c.avdec = vpack.NewStatefulDecoder(int(protocol.AgreementVoteTag.MaxMessageSize()))
+	}
 	return &c
 }
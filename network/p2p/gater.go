@@ -0,0 +1,58 @@
+// Copyright (C) 2019-2024 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package p2p
+
+import (
+	"github.com/libp2p/go-libp2p/core/control"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/multiformats/go-multiaddr"
+)
+
+// BannedPeerFunc reports whether connections to and from a peer are refused
+type BannedPeerFunc func(peer.ID) bool
+
+// bannedPeerGater is a libp2p connection gater refusing banned peers
+// as soon as their peer ID is known: before dialing and after the security handshake.
+type bannedPeerGater struct {
+	banned BannedPeerFunc
+}
+
+// InterceptPeerDial implements connmgr.ConnectionGater
+func (g bannedPeerGater) InterceptPeerDial(p peer.ID) bool {
+	return !g.banned(p)
+}
+
+// InterceptAddrDial implements connmgr.ConnectionGater
+func (g bannedPeerGater) InterceptAddrDial(p peer.ID, _ multiaddr.Multiaddr) bool {
+	return !g.banned(p)
+}
+
+// InterceptAccept implements connmgr.ConnectionGater
+func (g bannedPeerGater) InterceptAccept(network.ConnMultiaddrs) bool {
+	return true
+}
+
+// InterceptSecured implements connmgr.ConnectionGater
+func (g bannedPeerGater) InterceptSecured(_ network.Direction, p peer.ID, _ network.ConnMultiaddrs) bool {
+	return !g.banned(p)
+}
+
+// InterceptUpgraded implements connmgr.ConnectionGater
+func (g bannedPeerGater) InterceptUpgraded(network.Conn) (bool, control.DisconnectReason) {
+	return true, 0
+}
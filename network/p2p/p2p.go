@@ -72,6 +72,11 @@ type Service interface {
 	Subscribe(topic string, val pubsub.ValidatorEx) (SubNextCancellable, error)
 	Publish(ctx context.Context, topic string, data []byte) error
 
+	// SetStreamHandler sets the handler of the streams peers open with the given protocol
+	SetStreamHandler(pid protocol.ID, handler network.StreamHandler)
+	// NewStream opens a stream with the given protocol to a peer
+	NewStream(ctx context.Context, p peer.ID, pid protocol.ID) (network.Stream, error)
+
 	// GetHTTPClient returns a rate-limiting libp2p-streaming http client that can be used to make requests to the given peer
 	GetHTTPClient(addrInfo *peer.AddrInfo, connTimeStore limitcaller.ConnectionTimeStore, queueingTimeout time.Duration) (*http.Client, error)
 }
@@ -93,6 +98,9 @@ type serviceImpl struct {
 // AlgorandWsProtocol defines a libp2p protocol name for algorand's websockets messages
 const AlgorandWsProtocol = "/algorand-ws/1.0.0"
 
+// AlgorandTxInventoryProtocol defines a libp2p protocol name for requesting announced transaction groups
+const AlgorandTxInventoryProtocol = "/algorand-txinv/1.0.0"
+
 // algorandGUIDProtocolPrefix defines a libp2p protocol name for algorand node telemetry GUID exchange
 const algorandGUIDProtocolPrefix = "/algorand-telemetry/1.0.0/"
 const algorandGUIDProtocolTemplate = algorandGUIDProtocolPrefix + "%s/%s"
@@ -101,7 +109,8 @@ const dialTimeout = 30 * time.Second
 
 // MakeHost creates a libp2p host but does not start listening.
 // Use host.Network().Listen() on the returned address to start listening.
-func MakeHost(cfg config.Local, datadir string, pstore *pstore.PeerStore) (host.Host, string, error) {
+// If banned is not nil, connections to and from the peers it reports are refused.
+func MakeHost(cfg config.Local, datadir string, pstore *pstore.PeerStore, banned BannedPeerFunc) (host.Host, string, error) {
 	// load stored peer ID, or make ephemeral peer ID
 	privKey, err := GetPrivKey(cfg, datadir)
 	if err != nil {
@@ -149,7 +158,7 @@ func MakeHost(cfg config.Local, datadir string, pstore *pstore.PeerStore) (host.
 		return nil, "", err
 	}
 
-	host, err := libp2p.New(
+	opts := []libp2p.Option{
 		libp2p.Identity(privKey),
 		libp2p.UserAgent(ua),
 		libp2p.Transport(tcp.NewTCPTransport),
@@ -160,7 +169,11 @@ func MakeHost(cfg config.Local, datadir string, pstore *pstore.PeerStore) (host.
 		enableMetrics,
 		libp2p.ResourceManager(rm),
 		libp2p.AddrsFactory(addrFactory),
-	)
+	}
+	if banned != nil {
+		opts = append(opts, libp2p.ConnectionGater(bannedPeerGater{banned: banned}))
+	}
+	host, err := libp2p.New(opts...)
 	return host, listenAddr, err
 }
 
@@ -181,8 +194,9 @@ func configureResourceManager(cfg config.Local) (network.ResourceManager, error)
 	return rm, err
 }
 
-// MakeService creates a P2P service instance
-func MakeService(ctx context.Context, log logging.Logger, cfg config.Local, h host.Host, listenAddr string, wsStreamHandler StreamHandler, metricsTracer pubsub.RawTracer) (*serviceImpl, error) {
+// MakeService creates a P2P service instance. If txTopicPeer is set, transactions are only
+// gossiped over TXTopicName to the peers it accepts.
+func MakeService(ctx context.Context, log logging.Logger, cfg config.Local, h host.Host, listenAddr string, wsStreamHandler StreamHandler, metricsTracer pubsub.RawTracer, txTopicPeer func(peer.ID) bool) (*serviceImpl, error) {
 
 	sm := makeStreamManager(ctx, log, h, wsStreamHandler, cfg.EnableGossipService)
 	h.Network().Notify(sm)
@@ -194,7 +208,7 @@ func MakeService(ctx context.Context, log logging.Logger, cfg config.Local, h ho
 	telemetryProtoInfo := formatPeerTelemetryInfoProtocolName(telemetryID, telemetryInstance)
 	h.SetStreamHandler(protocol.ID(telemetryProtoInfo), func(s network.Stream) { s.Close() })
 
-	ps, err := makePubSub(ctx, cfg, h, metricsTracer)
+	ps, err := makePubSub(ctx, cfg, h, metricsTracer, txTopicPeer)
 	if err != nil {
 		return nil, err
 	}
@@ -297,6 +311,16 @@ func (s *serviceImpl) ClosePeer(peer peer.ID) error {
 	return s.host.Network().ClosePeer(peer)
 }
 
+// SetStreamHandler sets the handler of the streams peers open with the given protocol
+func (s *serviceImpl) SetStreamHandler(pid protocol.ID, handler network.StreamHandler) {
+	s.host.SetStreamHandler(pid, handler)
+}
+
+// NewStream opens a stream with the given protocol to a peer
+func (s *serviceImpl) NewStream(ctx context.Context, p peer.ID, pid protocol.ID) (network.Stream, error) {
+	return s.host.NewStream(ctx, p, pid)
+}
+
 // netAddressToListenAddress converts a netAddress in "ip:port" format to a listen address
 // that can be passed in to libp2p.ListenAddrStrings
 func netAddressToListenAddress(netAddress string) (string, error) {
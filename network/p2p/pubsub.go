@@ -56,9 +56,17 @@ const (
 // Naming convention: "algo" + 2 bytes protocol tag + 2 bytes version
 const TXTopicName = "algotx01"
 
+// AVTopicName defines a pubsub topic for agreement vote messages
+const AVTopicName = "algoav01"
+
+// PPTopicName defines a pubsub topic for agreement proposal payload messages
+const PPTopicName = "algopp01"
+
 const incomingThreads = 20 // matches to number wsNetwork workers
 
-func makePubSub(ctx context.Context, cfg config.Local, host host.Host, metricsTracer pubsub.RawTracer) (*pubsub.PubSub, error) {
+// makePubSub creates a GossipSub router. If txTopicPeer is set, the transaction topic is only
+// gossiped to the peers it accepts.
+func makePubSub(ctx context.Context, cfg config.Local, host host.Host, metricsTracer pubsub.RawTracer, txTopicPeer func(peer.ID) bool) (*pubsub.PubSub, error) {
 	//defaultParams := pubsub.DefaultGossipSubParams()
 
 	options := []pubsub.Option{
@@ -84,6 +92,38 @@ func makePubSub(ctx context.Context, cfg config.Local, host host.Host, metricsTr
 					InvalidMessageDeliveriesWeight: -1000,
 					InvalidMessageDeliveriesDecay:  pubsub.ScoreParameterDecay(time.Hour),
 				},
+				AVTopicName: {
+					// agreement traffic is what keeps the network alive, weight it above transactions
+					TopicWeight: 0.5,
+
+					TimeInMeshWeight:  0.0002778, // ~1/3600
+					TimeInMeshQuantum: time.Second,
+					TimeInMeshCap:     1,
+
+					// votes are small and numerous: every round carries thousands of them
+					FirstMessageDeliveriesWeight: 0.05, // max value is 50
+					FirstMessageDeliveriesDecay:  pubsub.ScoreParameterDecay(10 * time.Minute),
+					FirstMessageDeliveriesCap:    1000,
+
+					// a single invalid vote is a strong signal of a misbehaving peer
+					InvalidMessageDeliveriesWeight: -1000,
+					InvalidMessageDeliveriesDecay:  pubsub.ScoreParameterDecay(time.Hour),
+				},
+				PPTopicName: {
+					TopicWeight: 0.5,
+
+					TimeInMeshWeight:  0.0002778, // ~1/3600
+					TimeInMeshQuantum: time.Second,
+					TimeInMeshCap:     1,
+
+					// only a handful of proposals per round, each of them expensive to relay
+					FirstMessageDeliveriesWeight: 1, // max value is 50
+					FirstMessageDeliveriesDecay:  pubsub.ScoreParameterDecay(10 * time.Minute),
+					FirstMessageDeliveriesCap:    50,
+
+					InvalidMessageDeliveriesWeight: -1000,
+					InvalidMessageDeliveriesDecay:  pubsub.ScoreParameterDecay(time.Hour),
+				},
 			},
 		},
 			&pubsub.PeerScoreThresholds{
@@ -95,7 +135,7 @@ func makePubSub(ctx context.Context, cfg config.Local, host host.Host, metricsTr
 			},
 		),
 		// pubsub.WithPeerGater(&pubsub.PeerGaterParams{}),
-		pubsub.WithSubscriptionFilter(pubsub.WrapLimitSubscriptionFilter(pubsub.NewAllowlistSubscriptionFilter(TXTopicName), 100)),
+		pubsub.WithSubscriptionFilter(pubsub.WrapLimitSubscriptionFilter(pubsub.NewAllowlistSubscriptionFilter(TXTopicName, AVTopicName, PPTopicName), 100)),
 		// pubsub.WithEventTracer(jsonTracer),
 		pubsub.WithValidateQueueSize(256),
 		pubsub.WithMessageSignaturePolicy(pubsub.StrictNoSign),
@@ -106,11 +146,23 @@ func makePubSub(ctx context.Context, cfg config.Local, host host.Host, metricsTr
 	if metricsTracer != nil {
 		options = append(options, pubsub.WithRawTracer(metricsTracer))
 	}
+	if txTopicPeer != nil {
+		// the filter applies to the mesh and to the gossip but not to flood publishing, so messages of
+		// every topic are published to the mesh peers only
+		options = append(options,
+			pubsub.WithPeerFilter(func(pid peer.ID, topic string) bool {
+				return topic != TXTopicName || txTopicPeer(pid)
+			}),
+			pubsub.WithFloodPublish(false),
+		)
+	}
 
 	return pubsub.NewGossipSub(ctx, host, options...)
 }
 
-func txMsgID(m *pubsub_pb.Message) string {
+// contentMsgID identifies messages by their payload so that the same transaction group
+// or agreement message published by different peers is deduplicated by gossipsub.
+func contentMsgID(m *pubsub_pb.Message) string {
 	h := blake2b.Sum256(m.Data)
 	return string(h[:])
 }
@@ -130,8 +182,8 @@ func (s *serviceImpl) getOrCreateTopic(topicName string) (*pubsub.Topic, error)
 	if _, ok := s.topics[topicName]; !ok {
 		var topt []pubsub.TopicOpt
 		switch topicName {
-		case TXTopicName:
-			topt = append(topt, pubsub.WithTopicMessageIdFn(txMsgID))
+		case TXTopicName, AVTopicName, PPTopicName:
+			topt = append(topt, pubsub.WithTopicMessageIdFn(contentMsgID))
 		}
 
 		psTopic, err := s.pubsub.Join(topicName, topt...)
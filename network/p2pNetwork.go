@@ -17,9 +17,13 @@
 package network
 
 import (
+	"bufio"
 	"context"
+	"encoding/binary"
+	"io"
 	"math/rand"
 	"net/http"
+	"slices"
 	"strings"
 	"sync"
 	"sync/atomic"
@@ -30,6 +34,7 @@ import (
 	"github.com/Quarkonium-chain/go-quarkonium/logging"
 	"github.com/Quarkonium-chain/go-quarkonium/logging/telemetryspec"
 	"github.com/Quarkonium-chain/go-quarkonium/network/limitcaller"
+	"github.com/Quarkonium-chain/go-quarkonium/network/messagerecorder"
 	"github.com/Quarkonium-chain/go-quarkonium/network/p2p"
 	"github.com/Quarkonium-chain/go-quarkonium/network/p2p/dnsaddr"
 	"github.com/Quarkonium-chain/go-quarkonium/network/p2p/peerstore"
@@ -84,6 +89,9 @@ type P2PNetwork struct {
 	httpServer        *p2p.HTTPServer
 
 	identityTracker identityTracker
+
+	// reputation scores misbehaving peers by peer ID and bans them
+	reputation *PeerReputation
 }
 
 type bootstrapper struct {
@@ -196,7 +204,9 @@ type p2pPeerStats struct {
 
 // gossipSubTags defines protocol messages that are relayed using GossipSub
 var gossipSubTags = map[protocol.Tag]string{
-	protocol.TxnTag: p2p.TXTopicName,
+	protocol.TxnTag:             p2p.TXTopicName,
+	protocol.AgreementVoteTag:   p2p.AVTopicName,
+	protocol.ProposalPayloadTag: p2p.PPTopicName,
 }
 
 // NewP2PNetwork returns an instance of GossipNode that uses the p2p.Service
@@ -248,6 +258,13 @@ func NewP2PNetwork(log logging.Logger, cfg config.Local, datadir string, phonebo
 		broadcastQueueBulk:     make(chan broadcastRequest, 100),
 	}
 
+	var txTopicPeer func(peer.ID) bool
+	if cfg.EnableTxInventory {
+		net.broadcaster.txInventory = makeTxInventory(log, net.requestTxInventory, net.penalizeTxInventoryRequest)
+		net.broadcaster.txTopicPeer = net.txTopicWsPeer
+		txTopicPeer = net.txTopicPeer
+	}
+
 	if identityOpts != nil {
 		net.identityTracker = identityOpts.tracker
 	}
@@ -260,16 +277,19 @@ func NewP2PNetwork(log logging.Logger, cfg config.Local, datadir string, phonebo
 		return nil, err
 	}
 
-	h, la, err := p2p.MakeHost(cfg, datadir, pstore)
+	h, la, err := p2p.MakeHost(cfg, datadir, pstore, net.peerBanned)
 	if err != nil {
 		return nil, err
 	}
 	log.Infof("P2P host created: peer ID %s addrs %s", h.ID(), h.Addrs())
 
-	net.service, err = p2p.MakeService(net.ctx, log, cfg, h, la, net.wsStreamHandler, pubsubMetricsTracer{})
+	net.service, err = p2p.MakeService(net.ctx, log, cfg, h, la, net.wsStreamHandler, pubsubMetricsTracer{}, txTopicPeer)
 	if err != nil {
 		return nil, err
 	}
+	if net.broadcaster.txInventory != nil {
+		net.service.SetStreamHandler(p2p.AlgorandTxInventoryProtocol, net.txInventoryStreamHandler)
+	}
 
 	peerIDs := pstore.Peers()
 	addrInfos := make([]*peer.AddrInfo, 0, len(peerIDs))
@@ -313,6 +333,24 @@ func (n *P2PNetwork) setup() error {
 	return nil
 }
 
+// SetPeerReputation specifies the reputation service used to score and ban peers.
+// It must be called before Start.
+func (n *P2PNetwork) SetPeerReputation(r *PeerReputation) {
+	n.reputation = r
+}
+
+// SetMessageRecorder specifies the recorder of the messages sent and received.
+// It must be called before Start.
+func (n *P2PNetwork) SetMessageRecorder(r *messagerecorder.Recorder) {
+	n.handler.recorder = r
+	n.broadcaster.recorder = r
+}
+
+// peerBanned is consulted by the libp2p host before connecting to or accepting a peer
+func (n *P2PNetwork) peerBanned(p peer.ID) bool {
+	return n.reputation.Banned(p.String())
+}
+
 // PeerID returns this node's peer ID.
 func (n *P2PNetwork) PeerID() p2p.PeerID {
 	return p2p.PeerID(n.service.ID())
@@ -337,6 +375,15 @@ func (n *P2PNetwork) Start() error {
 		n.wg.Add(1)
 		go n.txTopicHandleLoop()
 	}
+	if n.broadcaster.txInventory != nil {
+		n.wg.Add(1)
+		go n.broadcaster.txInventory.loop(n.ctx, &n.wg)
+	}
+
+	// agreement messages are needed by every node regardless of its participation status
+	n.wg.Add(2)
+	go n.topicHandleLoop(p2p.AVTopicName, n.topicValidator(protocol.AgreementVoteTag), nil)
+	go n.topicHandleLoop(p2p.PPTopicName, n.topicValidator(protocol.ProposalPayloadTag), nil)
 
 	if n.wsPeersConnectivityCheckTicker != nil {
 		n.wsPeersConnectivityCheckTicker.Stop()
@@ -529,30 +576,83 @@ func (n *P2PNetwork) Address() (string, bool) {
 func (n *P2PNetwork) Broadcast(ctx context.Context, tag protocol.Tag, data []byte, wait bool, except Peer) error {
 	// For tags using pubsub topics, publish to GossipSub
 	if topic, ok := n.topicTags[tag]; ok {
-		return n.service.Publish(ctx, topic, data)
+		if n.handler.recorder != nil {
+			n.handler.recorder.Record(messagerecorder.Outbound, time.Now(), tag, "", data)
+		}
+		err := n.service.Publish(ctx, topic, data)
+		if err != nil || !n.txInventoryTag(tag) {
+			return err
+		}
+		// the peers supporting the transaction inventory are out of the topic mesh
 	}
 	// Otherwise broadcast over websocket protocol stream
+	return n.broadcastStreams(ctx, tag, data, wait, except)
+}
+
+// broadcastStreams broadcasts a message over the websocket protocol streams
+func (n *P2PNetwork) broadcastStreams(ctx context.Context, tag protocol.Tag, data []byte, wait bool, except Peer) error {
+	if p, ok := except.(gossipSubPeer); ok {
+		// a message received over a topic is relayed under another tag, skip the sender's stream
+		n.wsPeersLock.RLock()
+		except = n.wsPeers[p.peerID]
+		n.wsPeersLock.RUnlock()
+	}
 	return n.broadcaster.BroadcastArray(ctx, []protocol.Tag{tag}, [][]byte{data}, wait, except)
 }
 
+// txInventoryTag returns true if messages with the tag are both published on their topic and
+// sent over the websocket protocol streams to the peers supporting the transaction inventory
+func (n *P2PNetwork) txInventoryTag(tag protocol.Tag) bool {
+	return tag == protocol.TxnTag && n.broadcaster.txInventory != nil
+}
+
 // Relay message
 func (n *P2PNetwork) Relay(ctx context.Context, tag protocol.Tag, data []byte, wait bool, except Peer) error {
+	if n.forwardedByGossipSub(tag, except) {
+		if n.relayMessages && n.txInventoryTag(tag) {
+			// GossipSub only forwarded the transactions to the peers without inventory support
+			return n.broadcastStreams(ctx, tag, data, wait, except)
+		}
+		return nil
+	}
 	if n.relayMessages {
 		return n.Broadcast(ctx, tag, data, wait, except)
 	}
 	return nil
 }
 
+// forwardedByGossipSub reports whether a message with the given tag received from sender
+// was already forwarded to the mesh by GossipSub once its topic validator accepted it.
+func (n *P2PNetwork) forwardedByGossipSub(tag protocol.Tag, sender Peer) bool {
+	if _, ok := sender.(gossipSubPeer); !ok {
+		return false
+	}
+	_, ok := n.topicTags[tag]
+	return ok
+}
+
 // Disconnect from a peer, probably due to protocol errors.
 func (n *P2PNetwork) Disconnect(badpeer DisconnectablePeer) {
+	n.disconnect(badpeer, disconnectBadData)
+}
+
+// disconnect closes the connections to a peer and scores it for the reason it was disconnected
+func (n *P2PNetwork) disconnect(badpeer DisconnectablePeer, reason disconnectReason) {
 	var peerID peer.ID
 	var wsp *wsPeer
 
+	defer func() {
+		if peerID != "" {
+			n.reputation.reportDisconnect(peerID.String(), reason)
+		}
+	}()
 	n.wsPeersLock.Lock()
 	defer n.wsPeersLock.Unlock()
 	switch p := badpeer.(type) {
 	case *wsPeer: // Disconnect came from a message received via wsPeer
 		peerID, wsp = n.wsPeersToIDs[p], p
+	case gossipSubPeer: // Disconnect came from a message received via GossipSub
+		peerID, wsp = p.peerID, n.wsPeers[p.peerID]
 	default:
 		n.log.Warnf("Unknown peer type %T", badpeer)
 		return
@@ -572,7 +672,7 @@ func (n *P2PNetwork) Disconnect(badpeer DisconnectablePeer) {
 
 func (n *P2PNetwork) disconnectThread(badnode DisconnectablePeer, reason disconnectReason) {
 	defer n.wg.Done()
-	n.Disconnect(badnode) // ignores reason
+	n.disconnect(badnode, reason)
 }
 
 // DisconnectPeers is used by testing
@@ -788,17 +888,22 @@ func (n *P2PNetwork) wsStreamHandler(ctx context.Context, p2pPeer peer.ID, strea
 	}
 	peerCore := makePeerCore(ctx, n, n.log, n.handler.readBuffer, addr, client, addr)
 	wsp := &wsPeer{
-		wsPeerCore: peerCore,
-		conn:       &wsPeerConnP2P{stream: stream},
-		outgoing:   !incoming,
-		identity:   netIdentPeerID,
-		peerType:   peerTypeP2P,
+		wsPeerCore:  peerCore,
+		conn:        &wsPeerConnP2P{stream: stream},
+		outgoing:    !incoming,
+		identity:    netIdentPeerID,
+		peerType:    peerTypeP2P,
+		txInventory: n.broadcaster.txInventory,
+		recorder:    n.handler.recorder,
 	}
 	protos, err := n.pstore.GetProtocols(p2pPeer)
 	if err != nil {
 		n.log.Warnf("Error getting protocols for peer %s: %v", p2pPeer, err)
 	}
 	wsp.TelemetryGUID, wsp.InstanceName = p2p.GetPeerTelemetryInfo(protos)
+	if wsp.txInventory != nil && slices.Contains(protos, p2p.AlgorandTxInventoryProtocol) {
+		wsp.features |= pfTxInventory
+	}
 
 	localAddr, has := n.Address()
 	if !has {
@@ -855,9 +960,14 @@ func (n *P2PNetwork) peerRemoteClose(peer *wsPeer, reason disconnectReason) {
 	remotePeerID := peer.conn.(*wsPeerConnP2P).stream.Conn().RemotePeer()
 	n.wsPeersLock.Lock()
 	n.identityTracker.removeIdentity(peer)
+	_, tracked := n.wsPeersToIDs[peer]
 	delete(n.wsPeers, remotePeerID)
 	delete(n.wsPeersToIDs, peer)
 	n.wsPeersLock.Unlock()
+	if tracked {
+		// peers removed by disconnect were already scored
+		n.reputation.reportDisconnect(remotePeerID.String(), reason)
+	}
 	n.wsPeersChangeCounter.Add(1)
 
 	eventDetails := telemetryspec.PeerEventDetails{
@@ -913,30 +1023,65 @@ func (n *P2PNetwork) getPeersChangeCounter() int32 {
 func (n *P2PNetwork) checkSlowWritingPeers()  {}
 func (n *P2PNetwork) checkPeersConnectivity() {}
 
+// gossipSubPeer identifies the sender of a message received over a GossipSub topic.
+type gossipSubPeer struct {
+	peerID      peer.ID
+	net         GossipNode
+	routingAddr [8]byte
+}
+
+// GetNetwork implements DisconnectablePeer
+func (p gossipSubPeer) GetNetwork() GossipNode { return p.net }
+
+// RoutingAddr implements IPAddressable
+func (p gossipSubPeer) RoutingAddr() []byte { return p.routingAddr[:] }
+
+// txTopicPeer returns true if transactions are gossiped to the peer over their pubsub topic,
+// that is unless it advertises the transaction inventory protocol. Identify completes before
+// the GossipSub streams are opened, so the peerstore knows the protocols of the mesh candidates.
+func (n *P2PNetwork) txTopicPeer(peerID peer.ID) bool {
+	protos, err := n.pstore.SupportsProtocols(peerID, p2p.AlgorandTxInventoryProtocol)
+	return err != nil || len(protos) == 0
+}
+
+// txTopicWsPeer is txTopicPeer for the peer of a websocket protocol stream
+func (n *P2PNetwork) txTopicWsPeer(wp *wsPeer) bool {
+	n.wsPeersLock.RLock()
+	peerID, ok := n.wsPeersToIDs[wp]
+	n.wsPeersLock.RUnlock()
+	return ok && n.txTopicPeer(peerID)
+}
+
 // txTopicHandleLoop reads messages from the pubsub topic for transactions.
 func (n *P2PNetwork) txTopicHandleLoop() {
+	n.topicHandleLoop(p2p.TXTopicName, n.txTopicValidator, n.wantTXGossip.Load)
+}
+
+// topicHandleLoop reads messages from the pubsub topic until the network is stopped
+// or the optional want callback reports the subscription is no longer needed.
+func (n *P2PNetwork) topicHandleLoop(topic string, validator pubsub.ValidatorEx, want func() bool) {
 	defer n.wg.Done()
-	sub, err := n.service.Subscribe(p2p.TXTopicName, n.txTopicValidator)
+	sub, err := n.service.Subscribe(topic, validator)
 	if err != nil {
-		n.log.Errorf("Failed to subscribe to topic %s: %v", p2p.TXTopicName, err)
+		n.log.Errorf("Failed to subscribe to topic %s: %v", topic, err)
 		return
 	}
-	n.log.Debugf("Subscribed to topic %s", p2p.TXTopicName)
+	n.log.Debugf("Subscribed to topic %s", topic)
 
 	for {
-		// msg from sub.Next not used since all work done by txTopicValidator
+		// msg from sub.Next not used since all work done by the validator
 		_, err := sub.Next(n.ctx)
 		if err != nil {
 			if err != pubsub.ErrSubscriptionCancelled && err != context.Canceled {
 				n.log.Errorf("Error reading from subscription %v, peerId %s", err, n.service.ID())
 			}
-			n.log.Debugf("Cancelling subscription to topic %s due Subscription.Next error: %v", p2p.TXTopicName, err)
+			n.log.Debugf("Cancelling subscription to topic %s due Subscription.Next error: %v", topic, err)
 			sub.Cancel()
 			return
 		}
 		// participation or configuration change, cancel subscription and quit
-		if !n.wantTXGossip.Load() {
-			n.log.Debugf("Cancelling subscription to topic %s due participation change", p2p.TXTopicName)
+		if want != nil && !want() {
+			n.log.Debugf("Cancelling subscription to topic %s due participation change", topic)
 			sub.Cancel()
 			return
 		}
@@ -945,11 +1090,22 @@ func (n *P2PNetwork) txTopicHandleLoop() {
 
 // txTopicValidator calls txHandler to validate and process incoming transactions.
 func (n *P2PNetwork) txTopicValidator(ctx context.Context, peerID peer.ID, msg *pubsub.Message) pubsub.ValidationResult {
+	return n.validateTopicMessage(protocol.TxnTag, peerID, msg)
+}
+
+// topicValidator returns a pubsub validator passing messages of the given tag to the registered validator handler.
+func (n *P2PNetwork) topicValidator(tag protocol.Tag) pubsub.ValidatorEx {
+	return func(ctx context.Context, peerID peer.ID, msg *pubsub.Message) pubsub.ValidationResult {
+		return n.validateTopicMessage(tag, peerID, msg)
+	}
+}
+
+// validateTopicMessage runs the validator handler registered for tag and maps its decision to a pubsub result:
+// accepted messages are forwarded by GossipSub, rejected ones penalize the sender's score.
+func (n *P2PNetwork) validateTopicMessage(tag protocol.Tag, peerID peer.ID, msg *pubsub.Message) pubsub.ValidationResult {
 	var routingAddr [8]byte
 	n.wsPeersLock.Lock()
-	var wsp *wsPeer
-	var ok bool
-	if wsp, ok = n.wsPeers[peerID]; ok {
+	if wsp, ok := n.wsPeers[peerID]; ok {
 		copy(routingAddr[:], wsp.RoutingAddr())
 	} else {
 		// well, otherwise use last 8 bytes of peerID
@@ -958,9 +1114,8 @@ func (n *P2PNetwork) txTopicValidator(ctx context.Context, peerID peer.ID, msg *
 	n.wsPeersLock.Unlock()
 
 	inmsg := IncomingMessage{
-		// Sender:   gossipSubPeer{peerID: msg.ReceivedFrom, net: n, routingAddr: routingAddr},
-		Sender:   wsp,
-		Tag:      protocol.TxnTag,
+		Sender:   gossipSubPeer{peerID: peerID, net: n, routingAddr: routingAddr},
+		Tag:      tag,
 		Data:     msg.Data,
 		Net:      n,
 		Received: time.Now().UnixNano(),
@@ -970,15 +1125,24 @@ func (n *P2PNetwork) txTopicValidator(ctx context.Context, peerID peer.ID, msg *
 	if msg.ReceivedFrom == n.service.ID() {
 		return pubsub.ValidationAccept
 	}
+	if n.handler.recorder != nil {
+		n.handler.recorder.Record(messagerecorder.Inbound, time.Unix(0, inmsg.Received), tag, peerID.String(), msg.Data)
+	}
 
-	n.peerStatsMu.Lock()
-	peerStats, ok := n.peerStats[peerID]
-	if !ok {
-		peerStats = &p2pPeerStats{}
-		n.peerStats[peerID] = peerStats
+	if tag == protocol.TxnTag {
+		n.peerStatsMu.Lock()
+		peerStats, ok := n.peerStats[peerID]
+		if !ok {
+			peerStats = &p2pPeerStats{}
+			n.peerStats[peerID] = peerStats
+		}
+		peerStats.txReceived.Add(1)
+		n.peerStatsMu.Unlock()
+		if n.broadcaster.txInventory != nil {
+			// no need to request the group if it is announced later on
+			n.broadcaster.txInventory.received(nil, msg.Data)
+		}
 	}
-	peerStats.txReceived.Add(1)
-	n.peerStatsMu.Unlock()
 
 	outmsg := n.handler.ValidateHandle(inmsg)
 	// there was a decision made in the handler about this message
@@ -995,3 +1159,129 @@ func (n *P2PNetwork) txTopicValidator(ctx context.Context, peerID peer.ID, msg *
 		return pubsub.ValidationIgnore
 	}
 }
+
+// requestTxInventory is the txInventory request function of p2p peers. It requests the transaction groups
+// over a p2p.AlgorandTxInventoryProtocol stream and queues the groups received for the message handlers.
+func (n *P2PNetwork) requestTxInventory(wp *wsPeer, digests []algocrypto.Digest) {
+	n.wsPeersLock.RLock()
+	p2pPeer, ok := n.wsPeersToIDs[wp]
+	n.wsPeersLock.RUnlock()
+	if !ok {
+		return
+	}
+
+	go func() {
+		ctx, cancel := context.WithTimeout(n.ctx, txInventoryRequestTimeout)
+		defer cancel()
+		stream, err := n.service.NewStream(ctx, p2pPeer, p2p.AlgorandTxInventoryProtocol)
+		if err != nil {
+			n.log.Debugf("unable to request transaction groups from %s: %v", p2pPeer, err)
+			return
+		}
+		defer stream.Close()
+		_ = stream.SetDeadline(time.Now().Add(txInventoryRequestTimeout))
+		_, err = stream.Write(encodeTxInventoryDigests(digests))
+		if err == nil {
+			err = stream.CloseWrite()
+		}
+		if err != nil {
+			n.log.Debugf("unable to request transaction groups from %s: %v", p2pPeer, err)
+			return
+		}
+
+		requested := make(map[algocrypto.Digest]bool, len(digests))
+		for _, d := range digests {
+			requested[d] = true
+		}
+		reader := bufio.NewReader(stream)
+		for range digests {
+			size, err := binary.ReadUvarint(reader)
+			if err == io.EOF {
+				return
+			}
+			if err != nil || size == 0 || size > protocol.TxnTagMaxSize {
+				n.log.Infof("bad transaction inventory response from %s: %v", p2pPeer, err)
+				return
+			}
+			data := make([]byte, size)
+			_, err = io.ReadFull(reader, data)
+			if err != nil {
+				n.log.Infof("bad transaction inventory response from %s: %v", p2pPeer, err)
+				return
+			}
+			digest := generateMessageDigest(protocol.TxnTag, data)
+			if !requested[digest] {
+				n.log.Infof("peer %s sent a transaction group that was not requested", p2pPeer)
+				n.disconnect(wp, disconnectBadData)
+				return
+			}
+			delete(requested, digest)
+			wp.txInventory.received(wp, data)
+			msg := IncomingMessage{Sender: wp, Tag: protocol.TxnTag, Data: data, Net: n, Received: time.Now().UnixNano()}
+			select {
+			case n.handler.readBuffer <- msg:
+			case <-n.ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+// penalizeTxInventoryRequest is the txInventory penalize function of the p2p network
+func (n *P2PNetwork) penalizeTxInventoryRequest(wp *wsPeer) {
+	n.wsPeersLock.RLock()
+	peerID, ok := n.wsPeersToIDs[wp]
+	n.wsPeersLock.RUnlock()
+	if ok {
+		n.reputation.reportBadTxInventoryRequest(peerID.String())
+	}
+}
+
+// txInventoryStreamHandler serves the announced transaction groups a peer requests over a
+// p2p.AlgorandTxInventoryProtocol stream. The request is a list of digests and the response
+// is the groups found, each prefixed with its uvarint encoded length.
+func (n *P2PNetwork) txInventoryStreamHandler(stream network.Stream) {
+	defer stream.Close()
+	_ = stream.SetDeadline(time.Now().Add(txInventoryRequestTimeout))
+	data, err := io.ReadAll(io.LimitReader(stream, protocol.TxnRequestTagMaxSize+1))
+	if err != nil {
+		n.log.Debugf("unable to read transaction inventory request from %s: %v", stream.Conn().RemotePeer(), err)
+		_ = stream.Reset()
+		return
+	}
+	digests, err := decodeTxInventoryDigests(data)
+	if err != nil {
+		n.log.Infof("bad transaction inventory request from %s: %v", stream.Conn().RemotePeer(), err)
+		_ = stream.Reset()
+		return
+	}
+	n.wsPeersLock.RLock()
+	wsp, ok := n.wsPeers[stream.Conn().RemotePeer()]
+	n.wsPeersLock.RUnlock()
+	if ok {
+		wsp.txInventoryPeer.Store(true)
+	}
+
+	groups, ok := n.broadcaster.txInventory.lookup(wsp, digests)
+	if !ok {
+		n.reputation.reportBadTxInventoryRequest(stream.Conn().RemotePeer().String())
+	}
+	writer := bufio.NewWriter(stream)
+	var size [binary.MaxVarintLen64]byte
+	for _, group := range groups {
+		l := binary.PutUvarint(size[:], uint64(len(group)))
+		_, err = writer.Write(size[:l])
+		if err == nil {
+			_, err = writer.Write(group)
+		}
+		if err != nil {
+			break
+		}
+	}
+	if err == nil {
+		err = writer.Flush()
+	}
+	if err != nil {
+		n.log.Debugf("unable to send transaction groups to %s: %v", stream.Conn().RemotePeer(), err)
+	}
+}
@@ -45,6 +45,7 @@ import (
 	"github.com/libp2p/go-libp2p/core/crypto"
 	"github.com/libp2p/go-libp2p/core/network"
 	"github.com/libp2p/go-libp2p/core/peer"
+	p2proto "github.com/libp2p/go-libp2p/core/protocol"
 	ma "github.com/multiformats/go-multiaddr"
 	"github.com/stretchr/testify/require"
 )
@@ -150,6 +151,105 @@ func TestP2PSubmitTX(t *testing.T) {
 	)
 }
 
+// TestP2PAgreementTopics checks agreement votes and proposals are carried over their gossipsub topics
+// by every node, and only messages accepted by the validator are forwarded further.
+func TestP2PAgreementTopics(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	cfg := config.GetDefaultLocal()
+	cfg.NetAddress = "127.0.0.1:0"
+	log := logging.TestingLog(t)
+	netA, err := NewP2PNetwork(log, cfg, "", nil, genesisID, config.Devtestnet, &nopeNodeInfo{}, nil)
+	require.NoError(t, err)
+	netA.Start()
+	defer netA.Stop()
+
+	peerInfoA := netA.service.AddrInfo()
+	addrsA, err := peer.AddrInfoToP2pAddrs(&peerInfoA)
+	require.NoError(t, err)
+	require.NotZero(t, addrsA[0])
+
+	// B and C are non-participating clients that still need agreement messages
+	cfg.NetAddress = ""
+	phoneBookAddresses := []string{addrsA[0].String()}
+	netB, err := NewP2PNetwork(log, cfg, "", phoneBookAddresses, genesisID, config.Devtestnet, &nopeNodeInfo{}, nil)
+	require.NoError(t, err)
+	netB.Start()
+	defer netB.Stop()
+
+	netC, err := NewP2PNetwork(log, cfg, "", phoneBookAddresses, genesisID, config.Devtestnet, &nopeNodeInfo{}, nil)
+	require.NoError(t, err)
+	netC.Start()
+	defer netC.Stop()
+
+	require.Eventually(
+		t,
+		func() bool {
+			for _, topic := range []string{p2p.AVTopicName, p2p.PPTopicName} {
+				if len(netA.service.ListPeersForTopic(topic)) != 2 ||
+					len(netB.service.ListPeersForTopic(topic)) != 1 ||
+					len(netC.service.ListPeersForTopic(topic)) != 1 {
+					return false
+				}
+			}
+			return true
+		},
+		2*time.Second,
+		50*time.Millisecond,
+	)
+	require.Eventually(t, func() bool {
+		return netA.hasPeers() && netB.hasPeers() && netC.hasPeers()
+	}, 2*time.Second, 50*time.Millisecond)
+	time.Sleep(time.Second) // give time for the mesh to form
+
+	type received struct {
+		votes, proposals atomic.Uint32
+	}
+	// A accepts messages starting with 1 and rejects everything else
+	validator := func(r *received, accept func([]byte) bool) []TaggedMessageValidatorHandler {
+		handle := func(msg IncomingMessage) OutgoingMessage {
+			require.IsType(t, gossipSubPeer{}, msg.Sender)
+			switch msg.Tag {
+			case protocol.AgreementVoteTag:
+				r.votes.Add(1)
+			case protocol.ProposalPayloadTag:
+				r.proposals.Add(1)
+			}
+			if accept(msg.Data) {
+				return OutgoingMessage{Action: Accept, Tag: msg.Tag}
+			}
+			return OutgoingMessage{Action: Disconnect}
+		}
+		return []TaggedMessageValidatorHandler{
+			{Tag: protocol.AgreementVoteTag, MessageHandler: ValidateHandleFunc(handle)},
+			{Tag: protocol.ProposalPayloadTag, MessageHandler: ValidateHandleFunc(handle)},
+		}
+	}
+	var recvA, recvC received
+	netA.RegisterValidatorHandlers(validator(&recvA, func(data []byte) bool { return data[0] == 1 }))
+	netC.RegisterValidatorHandlers(validator(&recvC, func([]byte) bool { return true }))
+
+	for i := 0; i < 5; i++ {
+		require.NoError(t, netB.Broadcast(context.Background(), protocol.AgreementVoteTag, []byte{1, byte(i)}, false, nil))
+		require.NoError(t, netB.Broadcast(context.Background(), protocol.ProposalPayloadTag, []byte{1, byte(i), 0}, false, nil))
+	}
+	require.Eventually(t, func() bool {
+		return recvC.votes.Load() == 5 && recvC.proposals.Load() == 5
+	}, 2*time.Second, 50*time.Millisecond)
+
+	// rejected vote stops at A
+	require.NoError(t, netB.Broadcast(context.Background(), protocol.AgreementVoteTag, []byte{2}, false, nil))
+	require.Eventually(t, func() bool { return recvA.votes.Load() == 6 }, 2*time.Second, 50*time.Millisecond)
+	time.Sleep(100 * time.Millisecond)
+	require.Equal(t, uint32(5), recvC.votes.Load())
+
+	// relaying a message received over a topic is left to gossipsub, other senders are relayed as usual
+	require.True(t, netA.forwardedByGossipSub(protocol.AgreementVoteTag, gossipSubPeer{}))
+	require.False(t, netA.forwardedByGossipSub(protocol.VoteBundleTag, gossipSubPeer{}))
+	require.False(t, netA.forwardedByGossipSub(protocol.AgreementVoteTag, &wsPeer{}))
+	require.False(t, netA.forwardedByGossipSub(protocol.AgreementVoteTag, nil))
+}
+
 // TestP2PSubmitTXNoGossip tests nodes without gossip enabled cannot receive transactions
 func TestP2PSubmitTXNoGossip(t *testing.T) {
 	partitiontest.PartitionTest(t)
@@ -285,7 +385,7 @@ func TestP2PSubmitWS(t *testing.T) {
 
 	// now we should be connected in a line: B <-> A <-> C where both B and C are connected to A but not each other
 
-	testTag := protocol.AgreementVoteTag
+	testTag := protocol.VoteBundleTag
 	var handlerCount atomic.Uint32
 
 	// Since we aren't using the transaction handler in this test, we need to register a pass-through handler
@@ -368,6 +468,13 @@ func (s *mockService) Publish(ctx context.Context, topic string, data []byte) er
 	return nil
 }
 
+func (s *mockService) SetStreamHandler(pid p2proto.ID, handler network.StreamHandler) {
+}
+
+func (s *mockService) NewStream(ctx context.Context, p peer.ID, pid p2proto.ID) (network.Stream, error) {
+	return nil, errors.New("not implemented")
+}
+
 func (s *mockService) GetHTTPClient(addrInfo *peer.AddrInfo, connTimeStore limitcaller.ConnectionTimeStore, queueingTimeout time.Duration) (*http.Client, error) {
 	return nil, nil
 }
@@ -1290,7 +1397,7 @@ func TestP2PEnableGossipService_NodeDisable(t *testing.T) {
 				return netA.hasPeers() && netB.hasPeers()
 			}, 1*time.Second, 50*time.Millisecond)
 
-			testTag := protocol.AgreementVoteTag
+			testTag := protocol.VoteBundleTag
 
 			var handlerCountA atomic.Uint32
 			passThroughHandlerA := []TaggedMessageHandler{
@@ -1374,3 +1481,243 @@ func TestP2PEnableGossipService_BothDisable(t *testing.T) {
 	require.False(t, netA.hasPeers())
 	require.False(t, netB.hasPeers())
 }
+
+// TestP2PTxInventory checks that large transaction groups are announced over the websocket protocol
+// streams and requested over the transaction inventory protocol instead of being published to GossipSub
+func TestP2PTxInventory(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	cfg := config.GetDefaultLocal()
+	cfg.DNSBootstrapID = "" // disable DNS lookups since the test uses phonebook addresses
+	cfg.ForceFetchTransactions = true
+	cfg.EnableTxInventory = true
+	cfg.NetAddress = "127.0.0.1:0"
+	log := logging.TestingLog(t)
+	netA, err := NewP2PNetwork(log.With("net", "netA"), cfg, "", nil, genesisID, config.Devtestnet, &nopeNodeInfo{}, nil)
+	require.NoError(t, err)
+	netA.SetPeerReputation(MakePeerReputation(log, cfg, ""))
+	err = netA.Start()
+	require.NoError(t, err)
+	defer netA.Stop()
+
+	peerInfoA := netA.service.AddrInfo()
+	addrsA, err := peer.AddrInfoToP2pAddrs(&peerInfoA)
+	require.NoError(t, err)
+	require.NotZero(t, addrsA[0])
+
+	cfg.NetAddress = ""
+	netB, err := NewP2PNetwork(log.With("net", "netB"), cfg, "", []string{addrsA[0].String()}, genesisID, config.Devtestnet, &nopeNodeInfo{}, nil)
+	require.NoError(t, err)
+	err = netB.Start()
+	require.NoError(t, err)
+	defer netB.Stop()
+
+	require.Eventually(t, func() bool {
+		return netA.hasPeer(netB.service.ID()) && netB.hasPeer(netA.service.ID())
+	}, 2*time.Second, 50*time.Millisecond)
+	require.False(t, netA.txTopicPeer(netB.service.ID()))
+	require.False(t, netB.txTopicPeer(netA.service.ID()))
+
+	makeMessages := func(count int) [][]byte {
+		var messages [][]byte
+		for i := 0; i < count; i++ {
+			size := 100
+			if i%2 == 0 {
+				size = 2000
+			}
+			msg := make([]byte, size)
+			algocrypto.RandBytes(msg)
+			messages = append(messages, msg)
+		}
+		return messages
+	}
+	exchange := func(from, to *P2PNetwork, messages [][]byte) {
+		matcher := newMessageMatcher(t, messages)
+		counterDone := matcher.done
+		to.ClearHandlers()
+		to.RegisterHandlers([]TaggedMessageHandler{{Tag: protocol.TxnTag, MessageHandler: matcher}})
+		for _, msg := range messages {
+			err := from.Broadcast(context.Background(), protocol.TxnTag, msg, false, nil)
+			require.NoError(t, err)
+		}
+		select {
+		case <-counterDone:
+		case <-time.After(3 * time.Second):
+			t.Errorf("timeout, count=%d, wanted %d", len(matcher.received), len(messages))
+		}
+		require.True(t, matcher.Match())
+	}
+	peerSupportsTxInventory := func(n *P2PNetwork, p peer.ID) bool {
+		n.wsPeersLock.RLock()
+		defer n.wsPeersLock.RUnlock()
+		return n.wsPeers[p].txInventoryEnabled()
+	}
+
+	// the peer that accepted the stream may not know the other one supports transaction inventory
+	// until it receives an announcement or a request, but the groups are delivered either way
+	exchange(netA, netB, makeMessages(2))
+	exchange(netB, netA, makeMessages(2))
+	require.True(t, peerSupportsTxInventory(netA, netB.service.ID()))
+	require.True(t, peerSupportsTxInventory(netB, netA.service.ID()))
+
+	messages := makeMessages(6)
+	var announced []algocrypto.Digest
+	for _, msg := range messages {
+		if len(msg) >= txInventoryPushThreshold {
+			announced = append(announced, generateMessageDigest(protocol.TxnTag, msg))
+		}
+	}
+	exchange(netA, netB, messages)
+	require.Equal(t, make([]int, len(announced)), netA.broadcaster.txInventory.unserved(announced))
+	netB.broadcaster.txInventory.mu.Lock()
+	require.Empty(t, netB.broadcaster.txInventory.requests)
+	netB.broadcaster.txInventory.mu.Unlock()
+
+	// requesting a group again is penalized
+	netB.wsPeersLock.RLock()
+	wpA := netB.wsPeers[netA.service.ID()]
+	netB.wsPeersLock.RUnlock()
+	netB.requestTxInventory(wpA, announced[:1])
+	require.Eventually(t, func() bool {
+		entries := netA.reputation.Entries()
+		return len(entries) == 1 && entries[0].Peer == netB.service.ID().String() &&
+			entries[0].LastOffense == "BadTxInventoryRequest"
+	}, 2*time.Second, 50*time.Millisecond)
+}
+
+// p2pTxReceiver records the transaction groups a network received over the topic and over the
+// websocket protocol streams, and relays them as the transaction handler does
+type p2pTxReceiver struct {
+	net    *P2PNetwork
+	mu     sync.Mutex
+	topic  map[string]int
+	stream map[string]int
+}
+
+func makeP2PTxReceiver(net *P2PNetwork) *p2pTxReceiver {
+	r := &p2pTxReceiver{net: net, topic: make(map[string]int), stream: make(map[string]int)}
+	net.RegisterValidatorHandlers([]TaggedMessageValidatorHandler{{
+		Tag: protocol.TxnTag,
+		MessageHandler: ValidateHandleFunc(func(msg IncomingMessage) OutgoingMessage {
+			r.mu.Lock()
+			r.topic[string(msg.Data)]++
+			r.mu.Unlock()
+			net.Relay(context.Background(), msg.Tag, msg.Data, false, msg.Sender)
+			return OutgoingMessage{Action: Accept, Tag: msg.Tag}
+		}),
+	}})
+	net.RegisterHandlers([]TaggedMessageHandler{{
+		Tag: protocol.TxnTag,
+		MessageHandler: HandlerFunc(func(msg IncomingMessage) OutgoingMessage {
+			r.mu.Lock()
+			r.stream[string(msg.Data)]++
+			r.mu.Unlock()
+			net.Relay(context.Background(), msg.Tag, msg.Data, false, msg.Sender)
+			return OutgoingMessage{Action: Ignore}
+		}),
+	}})
+	return r
+}
+
+// received returns how many times a group was received over the topic and over the streams
+func (r *p2pTxReceiver) received(data []byte) (int, int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.topic[string(data)], r.stream[string(data)]
+}
+
+// TestP2PTxInventoryMixedPeers checks that transactions keep being gossiped over the topic to
+// the peers without transaction inventory support, and only over the streams to the others
+func TestP2PTxInventoryMixedPeers(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	cfg := config.GetDefaultLocal()
+	cfg.DNSBootstrapID = "" // disable DNS lookups since the test uses phonebook addresses
+	cfg.ForceFetchTransactions = true
+	cfg.EnableTxInventory = true
+	cfg.NetAddress = "127.0.0.1:0"
+	log := logging.TestingLog(t)
+	netA, err := NewP2PNetwork(log.With("net", "netA"), cfg, "", nil, genesisID, config.Devtestnet, &nopeNodeInfo{}, nil)
+	require.NoError(t, err)
+	err = netA.Start()
+	require.NoError(t, err)
+	defer netA.Stop()
+
+	peerInfoA := netA.service.AddrInfo()
+	addrsA, err := peer.AddrInfoToP2pAddrs(&peerInfoA)
+	require.NoError(t, err)
+	require.NotZero(t, addrsA[0])
+
+	cfg.NetAddress = ""
+	netB, err := NewP2PNetwork(log.With("net", "netB"), cfg, "", []string{addrsA[0].String()}, genesisID, config.Devtestnet, &nopeNodeInfo{}, nil)
+	require.NoError(t, err)
+	err = netB.Start()
+	require.NoError(t, err)
+	defer netB.Stop()
+
+	cfg.EnableTxInventory = false
+	netC, err := NewP2PNetwork(log.With("net", "netC"), cfg, "", []string{addrsA[0].String()}, genesisID, config.Devtestnet, &nopeNodeInfo{}, nil)
+	require.NoError(t, err)
+	err = netC.Start()
+	require.NoError(t, err)
+	defer netC.Stop()
+
+	require.Eventually(t, func() bool {
+		return len(netA.service.ListPeersForTopic(p2p.TXTopicName)) == 2 &&
+			netA.hasPeer(netB.service.ID()) && netA.hasPeer(netC.service.ID()) &&
+			netB.hasPeers() && netC.hasPeers()
+	}, 2*time.Second, 50*time.Millisecond)
+	require.False(t, netA.txTopicPeer(netB.service.ID()))
+	require.True(t, netA.txTopicPeer(netC.service.ID()))
+	// let a heartbeat build the topic mesh, since messages are not flood published
+	time.Sleep(2 * time.Second)
+
+	recvA := makeP2PTxReceiver(netA)
+	recvB := makeP2PTxReceiver(netB)
+	recvC := makeP2PTxReceiver(netC)
+
+	makeMessage := func(size int) []byte {
+		msg := make([]byte, size)
+		algocrypto.RandBytes(msg)
+		return msg
+	}
+	// receivedOnce checks that a group is received exactly once, over the topic or over the streams
+	receivedOnce := func(r *p2pTxReceiver, msg []byte, overTopic bool) {
+		require.Eventually(t, func() bool {
+			topic, stream := r.received(msg)
+			return topic+stream > 0
+		}, 3*time.Second, 50*time.Millisecond)
+		time.Sleep(100 * time.Millisecond)
+		topic, stream := r.received(msg)
+		if overTopic {
+			require.Equal(t, [2]int{1, 0}, [2]int{topic, stream})
+		} else {
+			require.Equal(t, [2]int{0, 1}, [2]int{topic, stream})
+		}
+	}
+
+	// A publishes to C and announces or pushes to B
+	large, small := makeMessage(2000), makeMessage(100)
+	for _, msg := range [][]byte{large, small} {
+		err = netA.Broadcast(context.Background(), protocol.TxnTag, msg, false, nil)
+		require.NoError(t, err)
+	}
+	for _, msg := range [][]byte{large, small} {
+		receivedOnce(recvC, msg, true)
+		receivedOnce(recvB, msg, false)
+	}
+
+	// A relays the groups published by C to B over the streams
+	fromC := makeMessage(2000)
+	err = netC.Broadcast(context.Background(), protocol.TxnTag, fromC, false, nil)
+	require.NoError(t, err)
+	receivedOnce(recvA, fromC, true)
+	receivedOnce(recvB, fromC, false)
+
+	// A publishes the groups it requested from B to C
+	fromB := makeMessage(2000)
+	err = netB.Broadcast(context.Background(), protocol.TxnTag, fromB, false, nil)
+	require.NoError(t, err)
+	receivedOnce(recvA, fromB, false)
+	receivedOnce(recvC, fromB, true)
+}
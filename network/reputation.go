@@ -0,0 +1,301 @@
+// Copyright (C) 2019-2024 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"math"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/Quarkonium-chain/go-quarkonium/config"
+	"github.com/Quarkonium-chain/go-quarkonium/logging"
+	"github.com/Quarkonium-chain/go-quarkonium/util/codecs"
+)
+
+// PeerReputationFilename is the name of the file in the genesis directory the peer bans are persisted to
+const PeerReputationFilename = "peerbans.json"
+
+// reputationHalfLife is the time it takes a peer score to decay to half its value
+const reputationHalfLife = 15 * time.Minute
+
+// maxBanDuration caps the ban duration that doubles on each repeated ban
+const maxBanDuration = 24 * time.Hour
+
+// banCountResetInterval is how long a peer has to go without being banned for
+// its next ban to be back at the base duration
+const banCountResetInterval = 7 * 24 * time.Hour
+
+// maxReputationEntries bounds the number of peers tracked; once exceeded, peers
+// whose score has decayed away are forgotten
+const maxReputationEntries = 10000
+
+// rateLimitPenalty is the score added each time a peer exceeds a rate limit
+const rateLimitPenalty = 10
+
+// badTxInventoryRequestPenalty is the score added each time a peer requests transaction groups
+// that were not announced to it, or that it was already sent
+const badTxInventoryRequestPenalty = 10
+
+// disconnectPenalties is the score added when disconnecting a peer for the given reason.
+// Reasons not listed, such as a remote close or a duplicate connection, are not the peer's fault.
+var disconnectPenalties = map[disconnectReason]float64{
+	disconnectBadData:             35,
+	disconnectBadIdentityData:     35,
+	disconnectSlowConn:            10,
+	disconnectStaleWrite:          10,
+	disconnectLeastPerformingPeer: 5,
+}
+
+// PeerReputationEntry is the reputation of a single peer.
+// Websocket peers are identified by their IP address and p2p peers by their peer ID.
+type PeerReputationEntry struct {
+	Peer string `json:"peer"`
+	// Score decays over time and results in a ban once it reaches PeerBanThreshold
+	Score   float64   `json:"score"`
+	Updated time.Time `json:"updated"`
+	// BannedUntil is zero if the peer has never been banned
+	BannedUntil time.Time `json:"banned-until,omitempty"`
+	// Bans is the number of consecutive bans, which determines the next ban duration
+	Bans        uint64 `json:"bans,omitempty"`
+	LastOffense string `json:"last-offense,omitempty"`
+}
+
+// Banned returns true if the peer is banned at the given time
+func (e *PeerReputationEntry) Banned(now time.Time) bool {
+	return now.Before(e.BannedUntil)
+}
+
+// decay brings the score down to its value at the given time
+func (e *PeerReputationEntry) decay(now time.Time) {
+	elapsed := now.Sub(e.Updated)
+	if elapsed <= 0 {
+		return
+	}
+	e.Score *= math.Pow(0.5, float64(elapsed)/float64(reputationHalfLife))
+	e.Updated = now
+}
+
+type peerReputationFile struct {
+	Peers []PeerReputationEntry `json:"peers"`
+}
+
+// PeerReputation scores peers on their misbehavior and bans the ones whose
+// score crosses the configured threshold. It is shared by the websocket and p2p
+// networks, and bans are persisted so that they survive restarts.
+// A nil *PeerReputation tracks nothing and bans no one.
+type PeerReputation struct {
+	log         logging.Logger
+	threshold   float64
+	banDuration time.Duration
+	filename    string
+	now         func() time.Time
+
+	mu    deadlock.Mutex
+	peers map[string]*PeerReputationEntry
+
+	// saveMu serializes writes to filename
+	saveMu deadlock.Mutex
+}
+
+// MakePeerReputation creates a PeerReputation and loads the bans persisted to filename.
+// An empty filename keeps the bans in memory only.
+func MakePeerReputation(log logging.Logger, cfg config.Local, filename string) *PeerReputation {
+	return makePeerReputation(log, cfg, filename, time.Now)
+}
+
+func makePeerReputation(log logging.Logger, cfg config.Local, filename string, now func() time.Time) *PeerReputation {
+	r := &PeerReputation{
+		log:         log,
+		threshold:   float64(cfg.PeerBanThreshold),
+		banDuration: time.Duration(cfg.PeerBanDurationSeconds) * time.Second,
+		filename:    filename,
+		now:         now,
+		peers:       make(map[string]*PeerReputationEntry),
+	}
+	if filename == "" {
+		return r
+	}
+	var f peerReputationFile
+	err := codecs.LoadObjectFromFile(filename, &f)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			log.Warnf("unable to load peer bans from %s: %v", filename, err)
+		}
+		return r
+	}
+	loaded := r.now()
+	for i := range f.Peers {
+		e := f.Peers[i]
+		if e.Peer == "" || loaded.Sub(e.BannedUntil) > banCountResetInterval {
+			continue
+		}
+		r.peers[e.Peer] = &e
+	}
+	return r
+}
+
+// Banned returns true if the peer is currently banned
+func (r *PeerReputation) Banned(peer string) bool {
+	if r == nil || peer == "" {
+		return false
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	e, ok := r.peers[peer]
+	return ok && e.Banned(r.now())
+}
+
+// reportDisconnect penalizes a peer we disconnected from for the given reason
+func (r *PeerReputation) reportDisconnect(peer string, reason disconnectReason) {
+	if penalty, ok := disconnectPenalties[reason]; ok {
+		r.penalize(peer, penalty, string(reason))
+	}
+}
+
+// reportRateLimited penalizes a peer for exceeding a rate limit
+func (r *PeerReputation) reportRateLimited(peer string) {
+	r.penalize(peer, rateLimitPenalty, "RateLimited")
+}
+
+// reportBadTxInventoryRequest penalizes a peer for requesting transaction groups it should not
+func (r *PeerReputation) reportBadTxInventoryRequest(peer string) {
+	r.penalize(peer, badTxInventoryRequestPenalty, "BadTxInventoryRequest")
+}
+
+func (r *PeerReputation) penalize(peer string, penalty float64, offense string) {
+	if r == nil || peer == "" {
+		return
+	}
+	r.mu.Lock()
+	now := r.now()
+	e, ok := r.peers[peer]
+	if !ok {
+		if len(r.peers) >= maxReputationEntries {
+			r.prune(now)
+		}
+		e = &PeerReputationEntry{Peer: peer, Updated: now}
+		r.peers[peer] = e
+	}
+	e.decay(now)
+	e.Score += penalty
+	e.LastOffense = offense
+	banned := false
+	if r.threshold > 0 && e.Score >= r.threshold && !e.Banned(now) {
+		if now.Sub(e.BannedUntil) > banCountResetInterval {
+			e.Bans = 0
+		}
+		e.Bans++
+		duration := r.banDuration
+		for i := uint64(1); i < e.Bans && duration < maxBanDuration; i++ {
+			duration *= 2
+		}
+		if duration > maxBanDuration {
+			duration = maxBanDuration
+		}
+		e.BannedUntil = now.Add(duration)
+		e.Score = 0
+		banned = true
+		r.log.Infof("banning peer %s for %s after %s", peer, duration, offense)
+	}
+	r.mu.Unlock()
+
+	if banned {
+		networkPeersBannedTotal.Inc(nil)
+		r.save()
+	}
+}
+
+// prune forgets the peers that are not banned and whose score has decayed away.
+// It is called with r.mu held.
+func (r *PeerReputation) prune(now time.Time) {
+	for peer, e := range r.peers {
+		e.decay(now)
+		if e.Score < 1 && !e.Banned(now) && now.Sub(e.BannedUntil) > banCountResetInterval {
+			delete(r.peers, peer)
+		}
+	}
+}
+
+// Entries returns the reputation of the tracked peers, banned peers first and then by decreasing score
+func (r *PeerReputation) Entries() []PeerReputationEntry {
+	if r == nil {
+		return nil
+	}
+	r.mu.Lock()
+	now := r.now()
+	entries := make([]PeerReputationEntry, 0, len(r.peers))
+	for _, e := range r.peers {
+		e.decay(now)
+		entries = append(entries, *e)
+	}
+	r.mu.Unlock()
+
+	sort.Slice(entries, func(i, j int) bool {
+		bi, bj := entries[i].Banned(now), entries[j].Banned(now)
+		if bi != bj {
+			return bi
+		}
+		if entries[i].Score != entries[j].Score {
+			return entries[i].Score > entries[j].Score
+		}
+		return entries[i].Peer < entries[j].Peer
+	})
+	return entries
+}
+
+// Clear forgets a peer, lifting its ban if any. It returns false if the peer is not tracked.
+func (r *PeerReputation) Clear(peer string) bool {
+	if r == nil {
+		return false
+	}
+	r.mu.Lock()
+	_, ok := r.peers[peer]
+	delete(r.peers, peer)
+	r.mu.Unlock()
+	if ok {
+		r.save()
+	}
+	return ok
+}
+
+// save persists the peers that were banned recently enough for their ban count to matter
+func (r *PeerReputation) save() {
+	if r.filename == "" {
+		return
+	}
+	r.saveMu.Lock()
+	defer r.saveMu.Unlock()
+
+	var f peerReputationFile
+	r.mu.Lock()
+	now := r.now()
+	for _, e := range r.peers {
+		if e.Bans > 0 && now.Sub(e.BannedUntil) <= banCountResetInterval {
+			f.Peers = append(f.Peers, *e)
+		}
+	}
+	r.mu.Unlock()
+
+	sort.Slice(f.Peers, func(i, j int) bool { return f.Peers[i].Peer < f.Peers[j].Peer })
+	err := codecs.SaveObjectToFile(r.filename, &f, true)
+	if err != nil {
+		r.log.Warnf("unable to save peer bans to %s: %v", r.filename, err)
+	}
+}
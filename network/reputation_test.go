@@ -0,0 +1,179 @@
+// Copyright (C) 2019-2024 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Quarkonium-chain/go-quarkonium/config"
+	"github.com/Quarkonium-chain/go-quarkonium/logging"
+	"github.com/Quarkonium-chain/go-quarkonium/test/partitiontest"
+)
+
+func makeTestPeerReputation(t *testing.T, filename string, now *time.Time) *PeerReputation {
+	cfg := config.GetDefaultLocal()
+	cfg.PeerBanThreshold = 100
+	cfg.PeerBanDurationSeconds = 600
+	return makePeerReputation(logging.TestingLog(t), cfg, filename, func() time.Time { return *now })
+}
+
+func TestPeerReputationBan(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	now := time.Unix(1700000000, 0)
+	r := makeTestPeerReputation(t, "", &now)
+
+	// slow connections alone take a while to result in a ban
+	for i := 0; i < 9; i++ {
+		r.reportDisconnect("10.0.0.1", disconnectSlowConn)
+	}
+	require.False(t, r.Banned("10.0.0.1"))
+	// disconnects that are not the peer fault are not scored
+	r.reportDisconnect("10.0.0.1", disconnectRequestReceived)
+	require.False(t, r.Banned("10.0.0.1"))
+
+	r.reportRateLimited("10.0.0.1")
+	require.True(t, r.Banned("10.0.0.1"))
+	require.False(t, r.Banned("10.0.0.2"))
+
+	entries := r.Entries()
+	require.Len(t, entries, 1)
+	require.Equal(t, "10.0.0.1", entries[0].Peer)
+	require.Equal(t, uint64(1), entries[0].Bans)
+	require.Equal(t, "RateLimited", entries[0].LastOffense)
+	require.Equal(t, now.Add(10*time.Minute), entries[0].BannedUntil)
+
+	now = now.Add(10*time.Minute + time.Second)
+	require.False(t, r.Banned("10.0.0.1"))
+}
+
+func TestPeerReputationDecay(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	now := time.Unix(1700000000, 0)
+	r := makeTestPeerReputation(t, "", &now)
+
+	r.reportDisconnect("peer", disconnectBadData)
+	r.reportDisconnect("peer", disconnectBadData)
+	now = now.Add(reputationHalfLife)
+	entries := r.Entries()
+	require.Len(t, entries, 1)
+	require.InDelta(t, 35, entries[0].Score, 0.001)
+
+	// 35 + 35 + 35 would be enough for a ban if the score did not decay
+	r.reportDisconnect("peer", disconnectBadData)
+	require.False(t, r.Banned("peer"))
+	r.reportDisconnect("peer", disconnectBadData)
+	require.True(t, r.Banned("peer"))
+}
+
+func TestPeerReputationBanEscalation(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	now := time.Unix(1700000000, 0)
+	r := makeTestPeerReputation(t, "", &now)
+
+	ban := func() time.Duration {
+		for !r.Banned("peer") {
+			r.reportDisconnect("peer", disconnectBadData)
+		}
+		e := r.Entries()[0]
+		return e.BannedUntil.Sub(now)
+	}
+
+	expected := []time.Duration{10 * time.Minute, 20 * time.Minute, 40 * time.Minute, 80 * time.Minute}
+	for _, d := range expected {
+		require.Equal(t, d, ban())
+		now = now.Add(d + time.Second)
+	}
+	for i := 0; i < 10; i++ {
+		d := ban()
+		require.LessOrEqual(t, d, maxBanDuration)
+		now = now.Add(d + time.Second)
+	}
+	require.Equal(t, maxBanDuration, ban())
+
+	// a peer that behaved for long enough starts over
+	now = now.Add(maxBanDuration + banCountResetInterval + time.Second)
+	require.Equal(t, 10*time.Minute, ban())
+	require.Equal(t, uint64(1), r.Entries()[0].Bans)
+}
+
+func TestPeerReputationPersistence(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	filename := filepath.Join(t.TempDir(), PeerReputationFilename)
+	now := time.Unix(1700000000, 0)
+	r := makeTestPeerReputation(t, filename, &now)
+
+	for !r.Banned("10.0.0.1") {
+		r.reportDisconnect("10.0.0.1", disconnectBadData)
+	}
+	for !r.Banned("QmPeer") {
+		r.reportRateLimited("QmPeer")
+	}
+	// peers that were never banned are not persisted
+	r.reportDisconnect("10.0.0.2", disconnectBadData)
+
+	r = makeTestPeerReputation(t, filename, &now)
+	require.True(t, r.Banned("10.0.0.1"))
+	require.True(t, r.Banned("QmPeer"))
+	require.Len(t, r.Entries(), 2)
+
+	require.True(t, r.Clear("10.0.0.1"))
+	require.False(t, r.Clear("10.0.0.1"))
+	require.False(t, r.Banned("10.0.0.1"))
+
+	r = makeTestPeerReputation(t, filename, &now)
+	require.False(t, r.Banned("10.0.0.1"))
+	require.True(t, r.Banned("QmPeer"))
+
+	// bans too old to affect the next ban duration are dropped on load
+	now = now.Add(maxBanDuration + banCountResetInterval)
+	r = makeTestPeerReputation(t, filename, &now)
+	require.Empty(t, r.Entries())
+}
+
+func TestPeerReputationDisabled(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	var r *PeerReputation
+	r.reportDisconnect("peer", disconnectBadData)
+	r.reportRateLimited("peer")
+	require.False(t, r.Banned("peer"))
+	require.Empty(t, r.Entries())
+	require.False(t, r.Clear("peer"))
+
+	// a zero threshold scores peers without ever banning them
+	cfg := config.GetDefaultLocal()
+	cfg.PeerBanThreshold = 0
+	r = MakePeerReputation(logging.TestingLog(t), cfg, "")
+	for i := 0; i < 100; i++ {
+		r.reportDisconnect("peer", disconnectBadData)
+	}
+	require.False(t, r.Banned("peer"))
+	require.Len(t, r.Entries(), 1)
+}
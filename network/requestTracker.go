@@ -223,6 +223,8 @@ type RequestTracker struct {
 	httpHostRequests  hostsIncomingMap             // maps a request host to a request data (i.e. "1.2.3.4" -> *hostIncomingRequests )
 	httpConnections   map[net.Addr]*TrackerRequest // maps a local address interface  to a tracked request data (i.e. "1.2.3.4:1560" -> *TrackerRequest ); used to associate connection between the Accept and the ServeHTTP
 	httpConnectionsMu deadlock.Mutex               // used to syncronize access to the httpHostRequests and httpConnections variables
+
+	reputation *PeerReputation // penalizes the hosts exceeding the connection rate limits
 }
 
 // makeRequestsTracker creates a request tracker object.
@@ -263,6 +265,10 @@ func (rt *RequestTracker) Accept() (conn net.Conn, err error) {
 		// check the number of connections
 		if originConnections > rt.config.ConnectionsRateLimitingCount && connectionLimitEnabled && rateLimitedRemoteHost {
 			rt.hostRequestsMu.Unlock()
+			if rt.config.UseXForwardedForAddressField == "" {
+				// behind a proxy the connecting host is the proxy, the origin is only known once the request is read
+				rt.reputation.reportRateLimited(trackerRequest.remoteHost)
+			}
 			networkConnectionsDroppedTotal.Inc(map[string]string{"reason": "incoming_connection_per_ip_tcp_rate_limit"})
 			rt.log.With("connection", "tcp").With("count", originConnections).Debugf("Rejected connection due to excessive connections attempt rate")
 			rt.log.EventWithDetails(telemetryspec.Network, telemetryspec.ConnectPeerFailEvent,
@@ -450,6 +456,7 @@ func (rt *RequestTracker) ServeHTTP(response http.ResponseWriter, request *http.
 	connectionLimitEnabled := rt.config.ConnectionsRateLimitingWindowSeconds > 0 && rt.config.ConnectionsRateLimitingCount > 0
 
 	if originConnections > rt.config.ConnectionsRateLimitingCount && connectionLimitEnabled && rateLimitedRemoteHost {
+		rt.reputation.reportRateLimited(trackedRequest.remoteHost)
 		networkConnectionsDroppedTotal.Inc(map[string]string{"reason": "incoming_connection_per_ip_rate_limit"})
 		rt.log.With("connection", "http").With("count", originConnections).Debugf("Rejected connection due to excessive connections attempt rate")
 		rt.log.EventWithDetails(telemetryspec.Network, telemetryspec.ConnectPeerFailEvent,
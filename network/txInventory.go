@@ -0,0 +1,390 @@
+// Copyright (C) 2019-2024 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/Quarkonium-chain/go-quarkonium/crypto"
+	"github.com/Quarkonium-chain/go-quarkonium/logging"
+	"github.com/Quarkonium-chain/go-quarkonium/protocol"
+)
+
+// Transaction groups smaller than txInventoryPushThreshold are broadcast in full as usual,
+// since their digest is not much smaller than the group itself. Larger groups are announced
+// by digest to the peers supporting pfTxInventory with a TxnAnnounceTag message, and those
+// peers request the groups they have not received yet: with a TxnRequestTag message on
+// websockets, or over a p2p.AlgorandTxInventoryProtocol stream on p2p.
+// A group is identified by the digest of its TxnTag message, see generateMessageDigest.
+const txInventoryPushThreshold = 512
+
+// maxTxInventoryDigests is the maximum number of digests in an announcement or a request
+const maxTxInventoryDigests = 256
+
+// txInventoryFlushInterval is how long announcements are batched for before being sent
+const txInventoryFlushInterval = 20 * time.Millisecond
+
+// txInventoryRequestTimeout is how long a peer has to send a requested group before it is
+// requested from another peer that announced it
+const txInventoryRequestTimeout = time.Second
+
+// txInventoryMaxFallbacks bounds the number of other peers a request can fall back to
+const txInventoryMaxFallbacks = 4
+
+// txInventoryRetention is how long announced groups are kept for peers to request them
+const txInventoryRetention = time.Minute
+
+// txInventoryMaxStored bounds the number of announced groups kept for peers to request them
+const txInventoryMaxStored = 20000
+
+// the peer and seen filters remember roughly the last txInventorySeenBucketSize*(txInventorySeenBuckets-1) digests
+const txInventorySeenBuckets = 3
+const txInventorySeenBucketSize = 10000
+const txInventoryKnownBucketSize = 2000
+
+var errTxInventoryBadDigests = errors.New("transaction inventory message is not a list of digests")
+
+type txInventoryEntry struct {
+	digest crypto.Digest
+	data   []byte
+	added  time.Time
+	// announced has the peers the group was announced to and not yet sent to
+	announced map[*wsPeer]struct{}
+}
+
+type txInventoryRequest struct {
+	peer     *wsPeer
+	deadline time.Time
+	// fallbacks are the other peers that announced the group
+	fallbacks []*wsPeer
+}
+
+// txInventory keeps the transaction groups announced to peers so that they can be served,
+// batches the announcements to each peer and tracks the groups requested from peers.
+type txInventory struct {
+	log logging.Logger
+	// request asks a peer for the groups with the given digests. It must not block.
+	request func(wp *wsPeer, digests []crypto.Digest)
+	// penalize reports a peer that requested groups that were not announced to it, or more than once
+	penalize func(wp *wsPeer)
+	// seen has the digests of the groups received from peers
+	seen *messageFilter
+
+	mu       deadlock.Mutex
+	store    map[crypto.Digest]*txInventoryEntry
+	order    []*txInventoryEntry
+	batches  map[*wsPeer][]crypto.Digest
+	requests map[crypto.Digest]*txInventoryRequest
+}
+
+func makeTxInventory(log logging.Logger, request func(wp *wsPeer, digests []crypto.Digest), penalize func(wp *wsPeer)) *txInventory {
+	return &txInventory{
+		log:      log,
+		request:  request,
+		penalize: penalize,
+		seen:     makeMessageFilter(txInventorySeenBuckets, txInventorySeenBucketSize),
+		store:    make(map[crypto.Digest]*txInventoryEntry),
+		batches:  make(map[*wsPeer][]crypto.Digest),
+		requests: make(map[crypto.Digest]*txInventoryRequest),
+	}
+}
+
+// requestTxInventory is the txInventory request function of websocket peers
+func requestTxInventory(wp *wsPeer, digests []crypto.Digest) {
+	err := wp.Unicast(context.Background(), encodeTxInventoryDigests(digests), protocol.TxnRequestTag)
+	if err != nil {
+		wp.log.Debugf("unable to request %d transaction groups: %v", len(digests), err)
+	}
+}
+
+// txInventoryEnabled returns true if transaction groups are announced to the peer rather than sent in full
+func (wp *wsPeer) txInventoryEnabled() bool {
+	return wp.txInventory != nil && wp.txInventoryPeer.Load()
+}
+
+// handleTxInventoryMessage handles a TxnAnnounceTag or TxnRequestTag message.
+// It returns an error if the message is malformed.
+func (wp *wsPeer) handleTxInventoryMessage(msg IncomingMessage) error {
+	digests, err := decodeTxInventoryDigests(msg.Data)
+	if err != nil {
+		return err
+	}
+	wp.txInventoryPeer.Store(true)
+	if msg.Tag == protocol.TxnAnnounceTag {
+		wp.txInventory.announced(wp, digests)
+		return nil
+	}
+	groups, ok := wp.txInventory.lookup(wp, digests)
+	if !ok {
+		wp.txInventory.penalize(wp)
+	}
+	for _, data := range groups {
+		err = wp.Unicast(context.Background(), data, protocol.TxnTag)
+		if err != nil {
+			wp.log.Debugf("unable to send a requested transaction group: %v", err)
+			break
+		}
+	}
+	return nil
+}
+
+// TxInventoryMsgMaxSize returns the maximum size of a TxnAnnounceTag or TxnRequestTag message
+func TxInventoryMsgMaxSize() int {
+	return maxTxInventoryDigests * crypto.DigestSize
+}
+
+func encodeTxInventoryDigests(digests []crypto.Digest) []byte {
+	data := make([]byte, 0, len(digests)*crypto.DigestSize)
+	for i := range digests {
+		data = append(data, digests[i][:]...)
+	}
+	return data
+}
+
+func decodeTxInventoryDigests(data []byte) ([]crypto.Digest, error) {
+	if len(data) == 0 || len(data)%crypto.DigestSize != 0 || len(data) > maxTxInventoryDigests*crypto.DigestSize {
+		return nil, errTxInventoryBadDigests
+	}
+	digests := make([]crypto.Digest, len(data)/crypto.DigestSize)
+	for i := range digests {
+		copy(digests[i][:], data[i*crypto.DigestSize:])
+	}
+	return digests, nil
+}
+
+// split separates the transaction groups of a broadcast that are announced to the peers
+// supporting pfTxInventory. It returns the messages still sent in full to those peers along
+// with their digests, and the digests to announce. Nothing is returned if nothing is announced.
+func (inv *txInventory) split(request broadcastRequest, data [][]byte, digests []crypto.Digest) ([][]byte, []crypto.Digest, []crypto.Digest) {
+	var pushData [][]byte
+	var pushDigests, announce []crypto.Digest
+	for i, d := range request.data {
+		if request.tags[i] != protocol.TxnTag || len(d) < txInventoryPushThreshold {
+			pushData = append(pushData, data[i])
+			pushDigests = append(pushDigests, digests[i])
+			continue
+		}
+		digest := generateMessageDigest(protocol.TxnTag, d)
+		inv.add(digest, d)
+		announce = append(announce, digest)
+	}
+	if len(announce) == 0 {
+		return nil, nil, nil
+	}
+	return pushData, pushDigests, announce
+}
+
+// add keeps a group for peers to request it
+func (inv *txInventory) add(digest crypto.Digest, data []byte) {
+	inv.mu.Lock()
+	defer inv.mu.Unlock()
+	if _, has := inv.store[digest]; has {
+		return
+	}
+	e := &txInventoryEntry{digest: digest, data: data, added: time.Now(), announced: make(map[*wsPeer]struct{})}
+	inv.store[digest] = e
+	inv.order = append(inv.order, e)
+	if len(inv.order) > txInventoryMaxStored {
+		delete(inv.store, inv.order[0].digest)
+		inv.order[0] = nil
+		inv.order = inv.order[1:]
+	}
+}
+
+// announce queues announcements of the given groups to a peer, skipping the groups the peer
+// is known to have. Announcements are sent once a batch is full or on the next flush.
+func (inv *txInventory) announce(wp *wsPeer, digests []crypto.Digest) {
+	var full [][]crypto.Digest
+	inv.mu.Lock()
+	batch := inv.batches[wp]
+	for _, d := range digests {
+		if wp.txKnown.CheckDigest(d, true, false) {
+			continue
+		}
+		if e, has := inv.store[d]; has {
+			e.announced[wp] = struct{}{}
+		}
+		batch = append(batch, d)
+		if len(batch) == maxTxInventoryDigests {
+			full = append(full, batch)
+			batch = nil
+		}
+	}
+	if len(batch) > 0 {
+		inv.batches[wp] = batch
+	} else {
+		delete(inv.batches, wp)
+	}
+	inv.mu.Unlock()
+
+	for _, batch := range full {
+		inv.sendAnnouncement(wp, batch)
+	}
+}
+
+func (inv *txInventory) sendAnnouncement(wp *wsPeer, digests []crypto.Digest) {
+	err := wp.Unicast(context.Background(), encodeTxInventoryDigests(digests), protocol.TxnAnnounceTag)
+	if err != nil {
+		wp.log.Debugf("unable to announce %d transaction groups: %v", len(digests), err)
+		return
+	}
+	networkTxInventoryAnnouncedTotal.AddUint64(uint64(len(digests)), nil)
+}
+
+// announced handles an announcement from a peer by requesting the groups we have not seen
+func (inv *txInventory) announced(wp *wsPeer, digests []crypto.Digest) {
+	var want []crypto.Digest
+	now := time.Now()
+	inv.mu.Lock()
+	for _, d := range digests {
+		wp.txKnown.CheckDigest(d, true, false)
+		if _, has := inv.store[d]; has || inv.seen.CheckDigest(d, false, false) {
+			continue
+		}
+		if r, has := inv.requests[d]; has {
+			if r.peer != wp && len(r.fallbacks) < txInventoryMaxFallbacks {
+				r.fallbacks = append(r.fallbacks, wp)
+			}
+			continue
+		}
+		inv.requests[d] = &txInventoryRequest{peer: wp, deadline: now.Add(txInventoryRequestTimeout)}
+		want = append(want, d)
+	}
+	inv.mu.Unlock()
+
+	if len(want) > 0 {
+		networkTxInventoryRequestedTotal.AddUint64(uint64(len(want)), nil)
+		inv.request(wp, want)
+	}
+}
+
+// received records a group received from a peer, whether it was requested or not.
+// wp is nil for the groups received over the p2p transaction topic.
+func (inv *txInventory) received(wp *wsPeer, data []byte) {
+	digest := generateMessageDigest(protocol.TxnTag, data)
+	inv.seen.CheckDigest(digest, true, false)
+	if wp != nil && wp.txKnown != nil {
+		wp.txKnown.CheckDigest(digest, true, false)
+	}
+	inv.mu.Lock()
+	delete(inv.requests, digest)
+	inv.mu.Unlock()
+}
+
+// lookup returns the stored groups with the given digests that were announced to the peer,
+// each of them once. It returns false if the peer requested a stored group that was not
+// announced to it or that it was already sent. wp is nil for a p2p peer without a stream.
+func (inv *txInventory) lookup(wp *wsPeer, digests []crypto.Digest) ([][]byte, bool) {
+	var groups [][]byte
+	ok := true
+	inv.mu.Lock()
+	for _, d := range digests {
+		e, has := inv.store[d]
+		if !has {
+			// forgotten since it was announced, or never stored: nothing is sent either way
+			continue
+		}
+		if _, announced := e.announced[wp]; !announced {
+			ok = false
+			continue
+		}
+		delete(e.announced, wp)
+		groups = append(groups, e.data)
+	}
+	inv.mu.Unlock()
+	networkTxInventoryServedTotal.AddUint64(uint64(len(groups)), nil)
+	if !ok {
+		networkTxInventoryRejectedTotal.Inc(nil)
+	}
+	return groups, ok
+}
+
+// flush sends the pending announcements
+func (inv *txInventory) flush() {
+	inv.mu.Lock()
+	batches := inv.batches
+	inv.batches = make(map[*wsPeer][]crypto.Digest, len(batches))
+	inv.mu.Unlock()
+
+	for wp, digests := range batches {
+		inv.sendAnnouncement(wp, digests)
+	}
+}
+
+// expire requests the groups that timed out from another peer that announced them,
+// and forgets the groups stored for longer than txInventoryRetention
+func (inv *txInventory) expire(now time.Time) {
+	retry := make(map[*wsPeer][]crypto.Digest)
+	inv.mu.Lock()
+	for d, r := range inv.requests {
+		if now.Before(r.deadline) {
+			continue
+		}
+		networkTxInventoryTimeoutsTotal.Inc(nil)
+		delete(inv.requests, d)
+		for len(r.fallbacks) > 0 {
+			next := r.fallbacks[0]
+			r.fallbacks = r.fallbacks[1:]
+			if next.didSignalClose.Load() != 0 {
+				continue
+			}
+			r.peer = next
+			r.deadline = now.Add(txInventoryRequestTimeout)
+			inv.requests[d] = r
+			retry[next] = append(retry[next], d)
+			break
+		}
+	}
+	expired := 0
+	for expired < len(inv.order) && now.Sub(inv.order[expired].added) > txInventoryRetention {
+		delete(inv.store, inv.order[expired].digest)
+		inv.order[expired] = nil
+		expired++
+	}
+	inv.order = inv.order[expired:]
+	inv.mu.Unlock()
+
+	for wp, digests := range retry {
+		networkTxInventoryRequestedTotal.AddUint64(uint64(len(digests)), nil)
+		for len(digests) > maxTxInventoryDigests {
+			inv.request(wp, digests[:maxTxInventoryDigests])
+			digests = digests[maxTxInventoryDigests:]
+		}
+		inv.request(wp, digests)
+	}
+}
+
+// loop flushes announcements and expires requests until ctx is done
+func (inv *txInventory) loop(ctx context.Context, wg *sync.WaitGroup) {
+	defer wg.Done()
+	ticker := time.NewTicker(txInventoryFlushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case now := <-ticker.C:
+			inv.flush()
+			inv.expire(now)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
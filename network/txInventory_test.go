@@ -0,0 +1,296 @@
+// Copyright (C) 2019-2024 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Quarkonium-chain/go-quarkonium/crypto"
+	"github.com/Quarkonium-chain/go-quarkonium/logging"
+	"github.com/Quarkonium-chain/go-quarkonium/network/phonebook"
+	"github.com/Quarkonium-chain/go-quarkonium/protocol"
+	"github.com/Quarkonium-chain/go-quarkonium/test/partitiontest"
+)
+
+type txInventoryRequestRecorder struct {
+	requests  map[*wsPeer][][]crypto.Digest
+	penalized map[*wsPeer]int
+}
+
+func (r *txInventoryRequestRecorder) request(wp *wsPeer, digests []crypto.Digest) {
+	r.requests[wp] = append(r.requests[wp], digests)
+}
+
+func (r *txInventoryRequestRecorder) penalize(wp *wsPeer) {
+	r.penalized[wp]++
+}
+
+func makeTestTxInventory(t *testing.T) (*txInventory, *txInventoryRequestRecorder) {
+	r := &txInventoryRequestRecorder{requests: make(map[*wsPeer][][]crypto.Digest), penalized: make(map[*wsPeer]int)}
+	return makeTxInventory(logging.TestingLog(t), r.request, r.penalize), r
+}
+
+// unserved returns the number of peers each group was announced to and not sent to yet,
+// or -1 if the group is not stored
+func (inv *txInventory) unserved(digests []crypto.Digest) []int {
+	inv.mu.Lock()
+	defer inv.mu.Unlock()
+	counts := make([]int, len(digests))
+	for i, d := range digests {
+		counts[i] = -1
+		if e, has := inv.store[d]; has {
+			counts[i] = len(e.announced)
+		}
+	}
+	return counts
+}
+
+func makeTxInventoryTestPeer() *wsPeer {
+	return &wsPeer{txKnown: makeMessageFilter(txInventorySeenBuckets, txInventoryKnownBucketSize)}
+}
+
+func TestTxInventoryDigestsEncoding(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	digests := []crypto.Digest{crypto.Hash([]byte("a")), crypto.Hash([]byte("b"))}
+	data := encodeTxInventoryDigests(digests)
+	require.Len(t, data, 2*crypto.DigestSize)
+	decoded, err := decodeTxInventoryDigests(data)
+	require.NoError(t, err)
+	require.Equal(t, digests, decoded)
+
+	for _, size := range []int{0, 1, crypto.DigestSize + 1, (maxTxInventoryDigests + 1) * crypto.DigestSize} {
+		_, err = decodeTxInventoryDigests(make([]byte, size))
+		require.ErrorIs(t, err, errTxInventoryBadDigests, "size %d", size)
+	}
+	require.Equal(t, protocol.TxnAnnounceTag.MaxMessageSize(), uint64(TxInventoryMsgMaxSize()))
+}
+
+func TestTxInventorySplit(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	inv, _ := makeTestTxInventory(t)
+	small := []byte("small")
+	large := make([]byte, txInventoryPushThreshold)
+	vote := make([]byte, 2*txInventoryPushThreshold)
+	request := broadcastRequest{
+		tags: []protocol.Tag{protocol.TxnTag, protocol.TxnTag, protocol.AgreementVoteTag},
+		data: [][]byte{small, large, vote},
+	}
+	data := [][]byte{[]byte("TXsmall"), []byte("TXlarge"), []byte("AVvote")}
+	digests := []crypto.Digest{{1}, {2}, {3}}
+
+	pushData, pushDigests, announce := inv.split(request, data, digests)
+	require.Equal(t, [][]byte{data[0], data[2]}, pushData)
+	require.Equal(t, []crypto.Digest{digests[0], digests[2]}, pushDigests)
+	largeDigest := generateMessageDigest(protocol.TxnTag, large)
+	require.Equal(t, []crypto.Digest{largeDigest}, announce)
+	require.Equal(t, []int{0, -1}, inv.unserved([]crypto.Digest{largeDigest, {4}}))
+
+	// nothing to announce
+	request.data[1] = small
+	pushData, pushDigests, announce = inv.split(request, data, digests)
+	require.Nil(t, pushData)
+	require.Nil(t, pushDigests)
+	require.Nil(t, announce)
+}
+
+func TestTxInventoryAnnounce(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	inv, _ := makeTestTxInventory(t)
+	wp := makeTxInventoryTestPeer()
+	d1, d2 := crypto.Hash([]byte("1")), crypto.Hash([]byte("2"))
+
+	inv.announce(wp, []crypto.Digest{d1, d2})
+	require.Equal(t, []crypto.Digest{d1, d2}, inv.batches[wp])
+	// a group is announced once per peer
+	inv.announce(wp, []crypto.Digest{d1})
+	require.Equal(t, []crypto.Digest{d1, d2}, inv.batches[wp])
+
+	// groups the peer announced or sent are not announced to it
+	other := makeTxInventoryTestPeer()
+	inv.announced(other, []crypto.Digest{d1})
+	inv.received(other, []byte("group"))
+	inv.announce(other, []crypto.Digest{d1, generateMessageDigest(protocol.TxnTag, []byte("group"))})
+	require.NotContains(t, inv.batches, other)
+}
+
+func TestTxInventoryRequests(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	inv, r := makeTestTxInventory(t)
+	wpA, wpB, wpC := makeTxInventoryTestPeer(), makeTxInventoryTestPeer(), makeTxInventoryTestPeer()
+	group1, group2 := []byte("group1"), []byte("group2")
+	d1, d2 := generateMessageDigest(protocol.TxnTag, group1), generateMessageDigest(protocol.TxnTag, group2)
+
+	// the first peer announcing a group is asked for it, the others are fallbacks
+	inv.announced(wpA, []crypto.Digest{d1, d2})
+	inv.announced(wpB, []crypto.Digest{d1, d2})
+	inv.announced(wpC, []crypto.Digest{d1})
+	require.Equal(t, [][]crypto.Digest{{d1, d2}}, r.requests[wpA])
+	require.Empty(t, r.requests[wpB])
+	require.Len(t, inv.requests, 2)
+
+	// a received group is no longer requested
+	inv.received(wpA, group2)
+	require.Len(t, inv.requests, 1)
+	inv.announced(wpC, []crypto.Digest{d2})
+	require.Empty(t, r.requests[wpC])
+
+	// a request that times out falls back to the next peer, skipping closed ones
+	wpB.didSignalClose.Store(1)
+	inv.expire(time.Now())
+	require.Empty(t, r.requests[wpB])
+	inv.expire(time.Now().Add(txInventoryRequestTimeout))
+	require.Empty(t, r.requests[wpB])
+	require.Equal(t, [][]crypto.Digest{{d1}}, r.requests[wpC])
+
+	// and is dropped once there is no other peer to ask
+	inv.expire(time.Now().Add(3 * txInventoryRequestTimeout))
+	require.Empty(t, inv.requests)
+	inv.received(wpC, group1)
+	inv.announced(wpA, []crypto.Digest{d1})
+	require.Len(t, r.requests[wpA], 1)
+}
+
+func TestTxInventoryLookup(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	inv, r := makeTestTxInventory(t)
+	wpA, wpB := makeTxInventoryTestPeer(), makeTxInventoryTestPeer()
+	wpA.txInventory, wpB.txInventory = inv, inv
+	group := []byte("group")
+	d := generateMessageDigest(protocol.TxnTag, group)
+	inv.add(d, group)
+	inv.announce(wpA, []crypto.Digest{d})
+	require.Equal(t, []int{1}, inv.unserved([]crypto.Digest{d}))
+
+	// a group is only sent to the peers it was announced to
+	groups, ok := inv.lookup(wpB, []crypto.Digest{d})
+	require.False(t, ok)
+	require.Empty(t, groups)
+	groups, ok = inv.lookup(nil, []crypto.Digest{d})
+	require.False(t, ok)
+	require.Empty(t, groups)
+	err := wpB.handleTxInventoryMessage(IncomingMessage{Tag: protocol.TxnRequestTag, Data: encodeTxInventoryDigests([]crypto.Digest{d})})
+	require.NoError(t, err)
+	require.Equal(t, 1, r.penalized[wpB])
+
+	// groups that are not stored are not sent, whether they were announced or not
+	groups, ok = inv.lookup(wpA, []crypto.Digest{{1}, d})
+	require.True(t, ok)
+	require.Equal(t, [][]byte{group}, groups)
+	require.Equal(t, []int{0}, inv.unserved([]crypto.Digest{d}))
+
+	// and they are sent once
+	groups, ok = inv.lookup(wpA, []crypto.Digest{d})
+	require.False(t, ok)
+	require.Empty(t, groups)
+	err = wpA.handleTxInventoryMessage(IncomingMessage{Tag: protocol.TxnRequestTag, Data: encodeTxInventoryDigests([]crypto.Digest{d})})
+	require.NoError(t, err)
+	require.Equal(t, 1, r.penalized[wpA])
+	require.Equal(t, 1, r.penalized[wpB])
+}
+
+func TestTxInventoryStoreExpiry(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	inv, _ := makeTestTxInventory(t)
+	var digests []crypto.Digest
+	for i := 0; i < txInventoryMaxStored+10; i++ {
+		data := []byte(fmt.Sprintf("group%d", i))
+		d := generateMessageDigest(protocol.TxnTag, data)
+		inv.add(d, data)
+		digests = append(digests, d)
+	}
+	require.Len(t, inv.store, txInventoryMaxStored)
+	require.Equal(t, []int{-1, 0}, inv.unserved([]crypto.Digest{digests[9], digests[10]}))
+
+	inv.expire(time.Now())
+	require.Len(t, inv.store, txInventoryMaxStored)
+	inv.expire(time.Now().Add(txInventoryRetention + time.Second))
+	require.Empty(t, inv.store)
+	require.Empty(t, inv.order)
+}
+
+// TestWebsocketNetworkTxInventory checks that large transaction groups are announced and requested
+// between peers supporting it, while small groups are still sent in full
+func TestWebsocketNetworkTxInventory(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	conf := defaultConfig
+	conf.GossipFanout = 1
+	conf.EnableTxInventory = true
+	netA := makeTestWebsocketNodeWithConfig(t, conf)
+	netA.Start()
+	defer netStop(t, netA, "A")
+	netB := makeTestWebsocketNodeWithConfig(t, conf)
+	addrA, postListen := netA.Address()
+	require.True(t, postListen)
+	netB.phonebook.ReplacePeerList([]string{addrA}, "default", phonebook.PhoneBookEntryRelayRole)
+	netB.Start()
+	defer netStop(t, netB, "B")
+
+	var messages [][]byte
+	var announced []crypto.Digest
+	for i := 0; i < 10; i++ {
+		size := 100
+		if i%2 == 0 {
+			size = 2000
+		}
+		msg := make([]byte, size)
+		crypto.RandBytes(msg)
+		messages = append(messages, msg)
+		if size >= txInventoryPushThreshold {
+			announced = append(announced, generateMessageDigest(protocol.TxnTag, msg))
+		}
+	}
+	matcher := newMessageMatcher(t, messages)
+	counterDone := matcher.done
+	netB.RegisterHandlers([]TaggedMessageHandler{{Tag: protocol.TxnTag, MessageHandler: matcher}})
+
+	readyTimeout := time.NewTimer(2 * time.Second)
+	waitReady(t, netA, readyTimeout.C)
+	waitReady(t, netB, readyTimeout.C)
+
+	peers := netA.GetPeers(PeersConnectedIn)
+	require.Len(t, peers, 1)
+	require.True(t, peers[0].(*wsPeer).txInventoryEnabled())
+
+	for _, msg := range messages {
+		netA.Broadcast(context.Background(), protocol.TxnTag, msg, false, nil)
+	}
+
+	select {
+	case <-counterDone:
+	case <-time.After(2 * time.Second):
+		t.Errorf("timeout, count=%d, wanted %d", len(matcher.received), len(messages))
+	}
+	require.True(t, matcher.Match())
+
+	// the large groups were stored and sent once B requested them, and B is not waiting on any of them
+	require.Equal(t, make([]int, len(announced)), netA.broadcaster.txInventory.unserved(announced))
+	netB.broadcaster.txInventory.mu.Lock()
+	require.Empty(t, netB.broadcaster.txInventory.requests)
+	netB.broadcaster.txInventory.mu.Unlock()
+}
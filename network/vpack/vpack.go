@@ -0,0 +1,470 @@
+// Copyright (C) 2019-2024 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+// Package vpack implements a stateful, per-connection compression of
+// agreement vote messages.
+//
+// Votes are small msgpack maps whose size is dominated by field names and by
+// 32 and 64 byte values (sender addresses, proposal digests, one-time
+// signature keys) that repeat across steps and periods of a round. The
+// encoder walks the msgpack object, replaces map keys found in a static
+// dictionary with a one byte index, and replaces repeated values with a
+// reference into a table of recently seen values that is maintained in
+// lockstep by the encoder and the decoder. Everything else is copied
+// verbatim, so any well-formed msgpack object round-trips exactly.
+//
+// The escape byte is 0xc1, which msgpack reserves as "never used": a
+// compressed message starts with it and uncompressed messages never do.
+package vpack
+
+import (
+	"errors"
+	"fmt"
+)
+
+const (
+	// escapeByte marks a compressed message and the dictionary and table
+	// references within it.
+	escapeByte = 0xc1
+	// formatVersion follows escapeByte at the start of a compressed message.
+	formatVersion = 0x01
+
+	// TableSize is the number of recently seen values each side of a
+	// connection remembers.
+	TableSize = 1024
+
+	// maxDepth bounds the nesting of msgpack containers; votes are four
+	// levels deep.
+	maxDepth = 16
+
+	// tableRefFlag marks a table reference, distinguishing it from a
+	// dictionary index that never exceeds 0x7f.
+	tableRefFlag = 0x80
+)
+
+// dictionary holds the map keys used by votes. The order is part of the wire
+// format: append only.
+var dictionary = [...]string{
+	"cred", "pf", "r", "per", "prop", "dig", "encdig", "oper", "oprop",
+	"rnd", "snd", "step", "sig", "p", "p1s", "p2", "p2s", "ps", "s",
+}
+
+var dictionaryIndex = func() map[string]byte {
+	m := make(map[string]byte, len(dictionary))
+	for i, k := range dictionary {
+		m[k] = byte(i)
+	}
+	return m
+}()
+
+// tableKeys are the map keys whose values are remembered in the table. The
+// one-time signature itself ("s") and the VRF proof ("pf") are unique per
+// vote and are left out.
+var tableKeys = map[string]bool{
+	"snd": true, "oprop": true, "dig": true, "encdig": true,
+	"p": true, "p1s": true, "p2": true, "p2s": true, "ps": true,
+}
+
+var (
+	errTruncated      = errors.New("vpack: truncated input")
+	errInvalidByte    = errors.New("vpack: invalid msgpack byte")
+	errTooDeep        = errors.New("vpack: nesting too deep")
+	errTrailingBytes  = errors.New("vpack: trailing bytes after object")
+	errNotCompressed  = errors.New("vpack: missing compression header")
+	errBadReference   = errors.New("vpack: invalid reference")
+	errOutputTooLarge = errors.New("vpack: decompressed message too large")
+)
+
+// IsCompressed reports whether data was produced by a StatefulEncoder.
+func IsCompressed(data []byte) bool {
+	return len(data) > 0 && data[0] == escapeByte
+}
+
+// readToken returns the size of the msgpack token at the start of b,
+// excluding any nested objects, and the number of nested objects that follow
+// it: one per array element and two per map entry.
+func readToken(b []byte) (size int, children int, err error) {
+	if len(b) == 0 {
+		return 0, 0, errTruncated
+	}
+	c := b[0]
+	// length reads an n byte big-endian length following the first byte.
+	length := func(n int) (int, error) {
+		if len(b) < 1+n {
+			return 0, errTruncated
+		}
+		v := 0
+		for _, x := range b[1 : 1+n] {
+			v = v<<8 | int(x)
+		}
+		if v < 0 {
+			return 0, errTruncated
+		}
+		return v, nil
+	}
+	switch {
+	case c <= 0x7f, c >= 0xe0:
+		size = 1
+	case c <= 0x8f:
+		return 1, 2 * int(c&0x0f), nil
+	case c <= 0x9f:
+		return 1, int(c & 0x0f), nil
+	case c <= 0xbf:
+		size = 1 + int(c&0x1f)
+	default:
+		switch c {
+		case 0xc0, 0xc2, 0xc3:
+			size = 1
+		case 0xc4, 0xd9:
+			n, lerr := length(1)
+			size, err = 2+n, lerr
+		case 0xc5, 0xda:
+			n, lerr := length(2)
+			size, err = 3+n, lerr
+		case 0xc6, 0xdb:
+			n, lerr := length(4)
+			size, err = 5+n, lerr
+		case 0xc7:
+			n, lerr := length(1)
+			size, err = 3+n, lerr
+		case 0xc8:
+			n, lerr := length(2)
+			size, err = 4+n, lerr
+		case 0xc9:
+			n, lerr := length(4)
+			size, err = 6+n, lerr
+		case 0xcc, 0xd0:
+			size = 2
+		case 0xcd, 0xd1:
+			size = 3
+		case 0xca, 0xce, 0xd2:
+			size = 5
+		case 0xcb, 0xcf, 0xd3:
+			size = 9
+		case 0xd4:
+			size = 3
+		case 0xd5:
+			size = 4
+		case 0xd6:
+			size = 6
+		case 0xd7:
+			size = 10
+		case 0xd8:
+			size = 18
+		case 0xdc:
+			n, lerr := length(2)
+			return 3, n, lerr
+		case 0xdd:
+			n, lerr := length(4)
+			return 5, n, lerr
+		case 0xde:
+			n, lerr := length(2)
+			return 3, 2 * n, lerr
+		case 0xdf:
+			n, lerr := length(4)
+			return 5, 2 * n, lerr
+		default:
+			return 0, 0, errInvalidByte
+		}
+	}
+	if err != nil {
+		return 0, 0, err
+	}
+	if size > len(b) {
+		return 0, 0, errTruncated
+	}
+	return size, 0, nil
+}
+
+func isMap(c byte) bool {
+	return (c >= 0x80 && c <= 0x8f) || c == 0xde || c == 0xdf
+}
+
+// fixstr returns the string held by a msgpack fixstr token at the start of b.
+func fixstr(b []byte) (string, bool) {
+	if len(b) == 0 || b[0]&0xe0 != 0xa0 {
+		return "", false
+	}
+	n := int(b[0] & 0x1f)
+	if len(b) < 1+n {
+		return "", false
+	}
+	return string(b[1 : 1+n]), true
+}
+
+// tableValue returns the size of a bin8 token at the start of b whose value
+// is eligible for the table, or zero.
+func tableValue(b []byte) int {
+	if len(b) < 2 || b[0] != 0xc4 || (b[1] != 32 && b[1] != 64) {
+		return 0
+	}
+	if len(b) < 2+int(b[1]) {
+		return 0
+	}
+	return 2 + int(b[1])
+}
+
+// skip returns the size of the msgpack object at the start of b.
+func skip(b []byte, depth int) (int, error) {
+	size, children, err := readToken(b)
+	if err != nil {
+		return 0, err
+	}
+	if children > 0 && depth >= maxDepth {
+		return 0, errTooDeep
+	}
+	pos := size
+	for i := 0; i < children; i++ {
+		n, err := skip(b[pos:], depth+1)
+		if err != nil {
+			return 0, err
+		}
+		pos += n
+	}
+	return pos, nil
+}
+
+// lruTable is a fixed size table of values ordered by last use. The encoder
+// and decoder of a connection apply the same sequence of operations to their
+// tables, so slot numbers agree on both sides.
+type lruTable struct {
+	entries    []lruEntry
+	head, tail int
+}
+
+type lruEntry struct {
+	value      []byte
+	prev, next int
+}
+
+func (t *lruTable) unlink(i int) {
+	e := &t.entries[i]
+	if e.prev >= 0 {
+		t.entries[e.prev].next = e.next
+	} else {
+		t.head = e.next
+	}
+	if e.next >= 0 {
+		t.entries[e.next].prev = e.prev
+	} else {
+		t.tail = e.prev
+	}
+}
+
+func (t *lruTable) pushFront(i int) {
+	e := &t.entries[i]
+	e.prev, e.next = -1, t.head
+	if t.head >= 0 {
+		t.entries[t.head].prev = i
+	}
+	t.head = i
+	if t.tail < 0 {
+		t.tail = i
+	}
+}
+
+// touch marks slot i as most recently used.
+func (t *lruTable) touch(i int) {
+	if t.head == i {
+		return
+	}
+	t.unlink(i)
+	t.pushFront(i)
+}
+
+// insert stores a copy of v, evicting the least recently used value once the
+// table is full. It returns the slot used and the evicted value, if any.
+func (t *lruTable) insert(v []byte) (slot int, evicted []byte) {
+	if len(t.entries) == 0 {
+		t.head, t.tail = -1, -1
+	}
+	if len(t.entries) < TableSize {
+		slot = len(t.entries)
+		t.entries = append(t.entries, lruEntry{value: append([]byte(nil), v...)})
+	} else {
+		slot = t.tail
+		t.unlink(slot)
+		evicted = t.entries[slot].value
+		t.entries[slot].value = append([]byte(nil), v...)
+	}
+	t.pushFront(slot)
+	return slot, evicted
+}
+
+// StatefulEncoder compresses the votes sent over a single connection. It is
+// not safe for concurrent use, and messages must be delivered to the peer's
+// StatefulDecoder in the order they were compressed.
+type StatefulEncoder struct {
+	table lruTable
+	index map[string]int
+}
+
+// NewStatefulEncoder creates an encoder with an empty table.
+func NewStatefulEncoder() *StatefulEncoder {
+	return &StatefulEncoder{index: make(map[string]int)}
+}
+
+// Compress appends the compressed form of the msgpack object src to dst. If
+// src is not a single well-formed msgpack object an error is returned, the
+// encoder state is unchanged and src should be sent as is.
+func (e *StatefulEncoder) Compress(dst, src []byte) ([]byte, error) {
+	n, err := skip(src, 0)
+	if err != nil {
+		return dst, err
+	}
+	if n != len(src) {
+		return dst, errTrailingBytes
+	}
+	dst = append(dst, escapeByte, formatVersion)
+	dst, _ = e.encode(dst, src)
+	return dst, nil
+}
+
+// encode appends the object at the start of src, which has already been
+// validated, and returns the number of bytes consumed.
+func (e *StatefulEncoder) encode(dst, src []byte) ([]byte, int) {
+	size, children, _ := readToken(src)
+	dst = append(dst, src[:size]...)
+	pos := size
+	mapped := isMap(src[0])
+	key := ""
+	for i := 0; i < children; i++ {
+		var n int
+		switch {
+		case mapped && i%2 == 0:
+			k, ok := fixstr(src[pos:])
+			key = k
+			if idx, found := dictionaryIndex[k]; ok && found {
+				dst = append(dst, escapeByte, idx)
+				n = 1 + len(k)
+			} else {
+				dst, n = e.encode(dst, src[pos:])
+			}
+		case mapped && tableKeys[key] && tableValue(src[pos:]) > 0:
+			n = tableValue(src[pos:])
+			v := src[pos+2 : pos+n]
+			if slot, ok := e.index[string(v)]; ok {
+				e.table.touch(slot)
+				dst = append(dst, escapeByte, tableRefFlag|byte(slot>>8), byte(slot))
+			} else {
+				slot, evicted := e.table.insert(v)
+				if evicted != nil {
+					delete(e.index, string(evicted))
+				}
+				e.index[string(v)] = slot
+				dst = append(dst, src[pos:pos+n]...)
+			}
+		default:
+			dst, n = e.encode(dst, src[pos:])
+		}
+		pos += n
+	}
+	return dst, pos
+}
+
+// StatefulDecoder decompresses the votes received over a single connection.
+// It is not safe for concurrent use. After Decompress returns an error the
+// decoder is out of sync with the peer's encoder and the connection should be
+// dropped.
+type StatefulDecoder struct {
+	table   lruTable
+	maxSize int
+}
+
+// NewStatefulDecoder creates a decoder with an empty table that rejects
+// messages decompressing to more than maxSize bytes.
+func NewStatefulDecoder(maxSize int) *StatefulDecoder {
+	return &StatefulDecoder{maxSize: maxSize}
+}
+
+// Decompress appends the msgpack object encoded in src to dst.
+func (d *StatefulDecoder) Decompress(dst, src []byte) ([]byte, error) {
+	if len(src) < 2 || src[0] != escapeByte {
+		return dst, errNotCompressed
+	}
+	if src[1] != formatVersion {
+		return dst, fmt.Errorf("vpack: unsupported format version %d", src[1])
+	}
+	start := len(dst)
+	dst, n, err := d.decode(dst, src[2:], start, 0)
+	if err != nil {
+		return dst, err
+	}
+	if 2+n != len(src) {
+		return dst, errTrailingBytes
+	}
+	return dst, nil
+}
+
+func (d *StatefulDecoder) decode(dst, src []byte, start, depth int) ([]byte, int, error) {
+	size, children, err := readToken(src)
+	if err != nil {
+		return dst, 0, err
+	}
+	if children > 0 && depth >= maxDepth {
+		return dst, 0, errTooDeep
+	}
+	dst = append(dst, src[:size]...)
+	if len(dst)-start > d.maxSize {
+		return dst, 0, errOutputTooLarge
+	}
+	pos := size
+	mapped := isMap(src[0])
+	key := ""
+	for i := 0; i < children; i++ {
+		var n int
+		switch {
+		case mapped && i%2 == 0 && pos < len(src) && src[pos] == escapeByte:
+			if pos+1 >= len(src) || int(src[pos+1]) >= len(dictionary) {
+				return dst, 0, errBadReference
+			}
+			key = dictionary[src[pos+1]]
+			dst = append(dst, 0xa0|byte(len(key)))
+			dst = append(dst, key...)
+			n = 2
+		case mapped && i%2 == 0:
+			key, _ = fixstr(src[pos:])
+			dst, n, err = d.decode(dst, src[pos:], start, depth+1)
+		case mapped && tableKeys[key] && pos < len(src) && src[pos] == escapeByte:
+			if pos+2 >= len(src) || src[pos+1]&tableRefFlag == 0 {
+				return dst, 0, errBadReference
+			}
+			slot := int(src[pos+1]&^tableRefFlag)<<8 | int(src[pos+2])
+			if slot >= len(d.table.entries) {
+				return dst, 0, errBadReference
+			}
+			d.table.touch(slot)
+			v := d.table.entries[slot].value
+			dst = append(dst, 0xc4, byte(len(v)))
+			dst = append(dst, v...)
+			n = 3
+		case mapped && tableKeys[key] && tableValue(src[pos:]) > 0:
+			n = tableValue(src[pos:])
+			d.table.insert(src[pos+2 : pos+n])
+			dst = append(dst, src[pos:pos+n]...)
+		default:
+			dst, n, err = d.decode(dst, src[pos:], start, depth+1)
+		}
+		if err != nil {
+			return dst, 0, err
+		}
+		if len(dst)-start > d.maxSize {
+			return dst, 0, errOutputTooLarge
+		}
+		pos += n
+	}
+	return dst, pos, nil
+}
@@ -0,0 +1,347 @@
+// Copyright (C) 2019-2024 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package vpack
+
+import (
+	"io"
+	"math/rand"
+	"os"
+	"testing"
+
+	"github.com/Quarkonium-chain/go-quarkonium/network/messagerecorder"
+	"github.com/Quarkonium-chain/go-quarkonium/protocol"
+	"github.com/Quarkonium-chain/go-quarkonium/test/partitiontest"
+	"github.com/stretchr/testify/require"
+)
+
+// The types below mirror the msgpack layout of agreement's unauthenticated
+// votes, which are not exported.
+type testProposalValue struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	OriginalPeriod   uint64   `codec:"oper"`
+	OriginalProposer [32]byte `codec:"oprop"`
+	BlockDigest      [32]byte `codec:"dig"`
+	EncodingDigest   [32]byte `codec:"encdig"`
+}
+
+type testRawVote struct {
+	_struct  struct{}          `codec:",omitempty,omitemptyarray"`
+	Sender   [32]byte          `codec:"snd"`
+	Round    uint64            `codec:"rnd"`
+	Period   uint64            `codec:"per"`
+	Step     uint64            `codec:"step"`
+	Proposal testProposalValue `codec:"prop"`
+}
+
+type testCredential struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+	Proof   [80]byte `codec:"pf"`
+}
+
+type testOneTimeSignature struct {
+	_struct  struct{} `codec:""`
+	Sig      [64]byte `codec:"s"`
+	PK       [32]byte `codec:"p"`
+	PKSigOld [64]byte `codec:"ps"`
+	PK2      [32]byte `codec:"p2"`
+	PK1Sig   [64]byte `codec:"p1s"`
+	PK2Sig   [64]byte `codec:"p2s"`
+}
+
+type testVote struct {
+	_struct struct{}             `codec:",omitempty,omitemptyarray"`
+	R       testRawVote          `codec:"r"`
+	Cred    testCredential       `codec:"cred"`
+	Sig     testOneTimeSignature `codec:"sig,omitempty,omitemptycheckstruct"`
+}
+
+// generateVoteTraffic produces the votes a relay would forward over a single
+// connection: a committee drawn from a fixed set of participants votes in the
+// soft, cert and next steps of each round, mostly for the same proposal.
+func generateVoteTraffic(rng *rand.Rand, rounds int, participants int, votersPerStep int) [][]byte {
+	random := func(b []byte) { rng.Read(b) }
+	type account struct {
+		addr, pk2      [32]byte
+		pk1Sig, pk2Sig [64]byte
+		roundKey       map[uint64][32]byte
+	}
+	accounts := make([]account, participants)
+	for i := range accounts {
+		random(accounts[i].addr[:])
+		random(accounts[i].pk2[:])
+		random(accounts[i].pk1Sig[:])
+		random(accounts[i].pk2Sig[:])
+		accounts[i].roundKey = make(map[uint64][32]byte)
+	}
+
+	var msgs [][]byte
+	for r := uint64(1000); r < uint64(1000+rounds); r++ {
+		var prop testProposalValue
+		prop.OriginalProposer = accounts[rng.Intn(participants)].addr
+		random(prop.BlockDigest[:])
+		random(prop.EncodingDigest[:])
+		for _, s := range []uint64{1, 2, 3} {
+			for _, i := range rng.Perm(participants)[:votersPerStep] {
+				a := &accounts[i]
+				pk, ok := a.roundKey[r]
+				if !ok {
+					random(pk[:])
+					a.roundKey[r] = pk
+				}
+				v := testVote{
+					R:   testRawVote{Sender: a.addr, Round: r, Step: s, Proposal: prop},
+					Sig: testOneTimeSignature{PK: pk, PK2: a.pk2, PK1Sig: a.pk1Sig, PK2Sig: a.pk2Sig},
+				}
+				if s == 3 && rng.Intn(4) == 0 {
+					// a next vote for bottom
+					v.R.Proposal = testProposalValue{}
+				}
+				random(v.Cred.Proof[:])
+				random(v.Sig.Sig[:])
+				msgs = append(msgs, protocol.EncodeReflect(&v))
+			}
+		}
+	}
+	return msgs
+}
+
+func TestVoteRoundTrip(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	rng := rand.New(rand.NewSource(1))
+	msgs := generateVoteTraffic(rng, 20, 300, 100)
+	enc := NewStatefulEncoder()
+	dec := NewStatefulDecoder(4096)
+
+	var raw, compressed int
+	for _, msg := range msgs {
+		require.False(t, IsCompressed(msg))
+		c, err := enc.Compress(nil, msg)
+		require.NoError(t, err)
+		require.True(t, IsCompressed(c))
+		d, err := dec.Decompress(nil, c)
+		require.NoError(t, err)
+		require.Equal(t, msg, d)
+		raw += len(msg)
+		compressed += len(c)
+	}
+	// field names and repeated keys and digests make up more than a third of
+	// a vote
+	require.Less(t, compressed, raw*2/3)
+}
+
+// recordedVoteTraffic returns the votes of testdata/votes.rec, recorded by
+// messagerecorder on the relay of a private network of nine participating
+// accounts over about 25 rounds. It holds the distinct votes the relay
+// received, in order, which is the stream it forwards over each connection.
+func recordedVoteTraffic(tb testing.TB) [][]byte {
+	f, err := os.Open("testdata/votes.rec")
+	require.NoError(tb, err)
+	defer f.Close()
+
+	var msgs [][]byte
+	r := messagerecorder.MakeReader(f)
+	for {
+		rec, err := r.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(tb, err)
+		require.Equal(tb, protocol.AgreementVoteTag, rec.Tag)
+		msgs = append(msgs, rec.Data)
+	}
+	require.NotEmpty(tb, msgs)
+	return msgs
+}
+
+func TestRecordedVoteRoundTrip(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	enc := NewStatefulEncoder()
+	dec := NewStatefulDecoder(4096)
+	var raw, compressed int
+	for _, msg := range recordedVoteTraffic(t) {
+		c, err := enc.Compress(nil, msg)
+		require.NoError(t, err)
+		require.True(t, IsCompressed(c))
+		d, err := dec.Decompress(nil, c)
+		require.NoError(t, err)
+		require.Equal(t, msg, d)
+		raw += len(msg)
+		compressed += len(c)
+	}
+	require.Less(t, compressed, raw)
+}
+
+func TestTableEviction(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	// far more distinct values than the table holds, revisited at random, so
+	// that both hits and evictions are exercised
+	rng := rand.New(rand.NewSource(2))
+	values := make([][32]byte, 3*TableSize)
+	for i := range values {
+		rng.Read(values[i][:])
+	}
+	enc := NewStatefulEncoder()
+	dec := NewStatefulDecoder(4096)
+	var hits int
+	for i := 0; i < 20*TableSize; i++ {
+		msg := protocol.EncodeReflect(&testRawVote{
+			Sender: values[rng.Intn(len(values))],
+			Round:  uint64(i),
+			Proposal: testProposalValue{
+				BlockDigest: values[rng.Intn(TableSize/4)],
+			},
+		})
+		c, err := enc.Compress(nil, msg)
+		require.NoError(t, err)
+		d, err := dec.Decompress(nil, c)
+		require.NoError(t, err)
+		require.Equal(t, msg, d)
+		if len(c) < len(msg)-60 {
+			hits++
+		}
+	}
+	require.Len(t, enc.table.entries, TableSize)
+	require.Len(t, enc.index, TableSize)
+	require.Greater(t, hits, 0)
+}
+
+func TestArbitraryMsgpack(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	objs := []interface{}{
+		nil,
+		true,
+		uint64(1) << 40,
+		int64(-5),
+		-1000000,
+		1.5,
+		"snd",
+		[]byte{1, 2, 3},
+		make([]byte, 300),
+		[]interface{}{"r", map[string]interface{}{"snd": make([]byte, 32)}},
+		map[string]interface{}{
+			"snd":                             make([]byte, 31),
+			"dig":                             "not binary",
+			"other":                           map[string]interface{}{"p": make([]byte, 64)},
+			"a long key that is not a fixstr": []int{1, 2, 3},
+		},
+		map[uint64]string{1: "a", 2: "b"},
+	}
+	enc := NewStatefulEncoder()
+	dec := NewStatefulDecoder(4096)
+	for i := 0; i < 2; i++ {
+		for _, obj := range objs {
+			msg := protocol.EncodeReflect(obj)
+			c, err := enc.Compress(nil, msg)
+			require.NoError(t, err)
+			d, err := dec.Decompress([]byte("prefix"), c)
+			require.NoError(t, err)
+			require.Equal(t, append([]byte("prefix"), msg...), d)
+		}
+	}
+}
+
+func TestInvalidInput(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	enc := NewStatefulEncoder()
+	msg := generateVoteTraffic(rand.New(rand.NewSource(3)), 1, 1, 1)[0]
+
+	for _, bad := range [][]byte{
+		nil,
+		{0xc1},
+		msg[:len(msg)-1],
+		append(append([]byte(nil), msg...), 0x01),
+		{0x91, 0x91, 0x91, 0x91, 0x91, 0x91, 0x91, 0x91, 0x91, 0x91, 0x91, 0x91, 0x91, 0x91, 0x91, 0x91, 0x91, 0x01},
+	} {
+		c, err := enc.Compress(nil, bad)
+		require.Error(t, err)
+		require.Empty(t, c)
+	}
+	require.Empty(t, enc.table.entries)
+
+	c, err := enc.Compress(nil, msg)
+	require.NoError(t, err)
+	c2, err := enc.Compress(nil, msg)
+	require.NoError(t, err)
+	require.Less(t, len(c2), len(c))
+
+	for _, bad := range [][]byte{
+		nil,
+		msg,
+		{escapeByte, formatVersion + 1},
+		c[:len(c)-1],
+		append(append([]byte(nil), c...), 0x01),
+		// a table reference before anything was inserted
+		c2,
+	} {
+		_, err := NewStatefulDecoder(4096).Decompress(nil, bad)
+		require.Error(t, err)
+	}
+
+	_, err = NewStatefulDecoder(len(msg)-1).Decompress(nil, c)
+	require.ErrorIs(t, err, errOutputTooLarge)
+	d, err := NewStatefulDecoder(len(msg)).Decompress(nil, c)
+	require.NoError(t, err)
+	require.Equal(t, msg, d)
+}
+
+func BenchmarkCompressVotes(b *testing.B) {
+	msgs := recordedVoteTraffic(b)
+	var enc *StatefulEncoder
+	var raw, compressed int
+	var buf []byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		// replaying votes the encoder has already seen would inflate the ratio
+		if i%len(msgs) == 0 {
+			enc = NewStatefulEncoder()
+		}
+		msg := msgs[i%len(msgs)]
+		buf, _ = enc.Compress(buf[:0], msg)
+		raw += len(msg)
+		compressed += len(buf)
+	}
+	b.ReportMetric(float64(compressed)/float64(raw), "ratio")
+}
+
+func BenchmarkDecompressVotes(b *testing.B) {
+	msgs := recordedVoteTraffic(b)
+	enc := NewStatefulEncoder()
+	compressed := make([][]byte, len(msgs))
+	for i, msg := range msgs {
+		compressed[i], _ = enc.Compress(nil, msg)
+	}
+	b.ResetTimer()
+	var dec *StatefulDecoder
+	var buf []byte
+	for i := 0; i < b.N; i++ {
+		// the decoder must see the stream from the start
+		if i%len(compressed) == 0 {
+			dec = NewStatefulDecoder(4096)
+		}
+		var err error
+		buf, err = dec.Decompress(buf[:0], compressed[i%len(compressed)])
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
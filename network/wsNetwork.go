@@ -47,6 +47,7 @@ import (
 	"github.com/Quarkonium-chain/go-quarkonium/network/addr"
 	"github.com/Quarkonium-chain/go-quarkonium/network/limitcaller"
 	"github.com/Quarkonium-chain/go-quarkonium/network/limitlistener"
+	"github.com/Quarkonium-chain/go-quarkonium/network/messagerecorder"
 	"github.com/Quarkonium-chain/go-quarkonium/network/p2p"
 	"github.com/Quarkonium-chain/go-quarkonium/network/phonebook"
 	"github.com/Quarkonium-chain/go-quarkonium/protocol"
@@ -205,6 +206,9 @@ type WebsocketNetwork struct {
 	identityScheme  identityChallengeScheme
 	identityTracker identityTracker
 
+	// reputation scores misbehaving peers by IP address and bans them
+	reputation *PeerReputation
+
 	// outgoingMessagesBufferSize is the size used for outgoing messages.
 	outgoingMessagesBufferSize int
 
@@ -301,6 +305,13 @@ type msgBroadcaster struct {
 	broadcastQueueBulk     chan broadcastRequest
 	// slowWritingPeerMonitorInterval defines the interval between two consecutive tests for slow peer writing
 	slowWritingPeerMonitorInterval time.Duration
+	// txInventory is set if EnableTxInventory is, to announce transaction groups to the peers supporting it
+	txInventory *txInventory
+	// txTopicPeer is set on p2p along with txInventory. Transactions are published on their topic
+	// to the peers it returns true for, and only sent over the streams to the other peers.
+	txTopicPeer func(wp *wsPeer) bool
+	// recorder records the broadcast messages, if set
+	recorder *messagerecorder.Recorder
 }
 
 // msgHandler contains the logic for handling incoming messages and managing a readBuffer. It provides
@@ -310,6 +321,8 @@ type msgHandler struct {
 	log        logging.Logger
 	config     config.Local
 	readBuffer chan IncomingMessage
+	// recorder records the messages dispatched to the handlers, if set
+	recorder *messagerecorder.Recorder
 	Multiplexer
 }
 
@@ -380,8 +393,14 @@ func (wn *msgBroadcaster) BroadcastArray(ctx context.Context, tags []protocol.Ta
 	}
 
 	request := broadcastRequest{tags: tags, data: data, enqueueTime: time.Now(), ctx: ctx}
-	if except != nil {
-		request.except = except.(*wsPeer)
+	if wn.recorder != nil {
+		for i := range tags {
+			wn.recorder.Record(messagerecorder.Outbound, request.enqueueTime, tags[i], "", data[i])
+		}
+	}
+	// peers of other kinds, like GossipSub senders in hybrid mode, have no stream to exclude here
+	if wsp, ok := except.(*wsPeer); ok {
+		request.except = wsp
 	}
 
 	broadcastQueue := wn.broadcastQueueBulk
@@ -605,6 +624,9 @@ func (wn *WebsocketNetwork) setup() {
 	if wn.broadcaster.slowWritingPeerMonitorInterval == 0 {
 		wn.broadcaster.slowWritingPeerMonitorInterval = slowWritingPeerMonitorInterval
 	}
+	if wn.config.EnableTxInventory {
+		wn.broadcaster.txInventory = makeTxInventory(wn.log, requestTxInventory, wn.penalizeTxInventoryRequest)
+	}
 	wn.meshUpdateRequests = make(chan meshRequest, 5)
 	wn.readyChan = make(chan struct{})
 	wn.tryConnectAddrs = make(map[string]int64)
@@ -728,6 +750,10 @@ func (wn *WebsocketNetwork) Start() error {
 	}
 	wn.wg.Add(1)
 	go wn.broadcaster.broadcastThread(&wn.wg, wn, "network", "WebsocketNetwork")
+	if wn.broadcaster.txInventory != nil {
+		wn.wg.Add(1)
+		go wn.broadcaster.txInventory.loop(wn.ctx, &wn.wg)
+	}
 	if wn.prioScheme != nil {
 		wn.wg.Add(1)
 		go wn.prioWeightRefresh()
@@ -879,6 +905,20 @@ func getCommonHeaders(headers http.Header) (otherTelemetryGUID, otherInstanceNam
 
 // checkIncomingConnectionLimits perform the connection limits counting for the incoming connections.
 func (wn *WebsocketNetwork) checkIncomingConnectionLimits(response http.ResponseWriter, request *http.Request, remoteHost, otherTelemetryGUID, otherInstanceName string) int {
+	if wn.reputation.Banned(remoteHost) {
+		networkConnectionsDroppedTotal.Inc(map[string]string{"reason": "banned"})
+		wn.log.EventWithDetails(telemetryspec.Network, telemetryspec.ConnectPeerFailEvent,
+			telemetryspec.ConnectPeerFailEventDetails{
+				Address:       remoteHost,
+				TelemetryGUID: otherTelemetryGUID,
+				Incoming:      true,
+				InstanceName:  otherInstanceName,
+				Reason:        "Banned",
+			})
+		response.WriteHeader(http.StatusForbidden)
+		return http.StatusForbidden
+	}
+
 	if wn.numIncomingPeers() >= wn.config.IncomingConnectionsLimit {
 		networkConnectionsDroppedTotal.Inc(map[string]string{"reason": "incoming_connection_limit"})
 		wn.log.EventWithDetails(telemetryspec.Network, telemetryspec.ConnectPeerFailEvent,
@@ -1025,7 +1065,7 @@ func (wn *WebsocketNetwork) ServeHTTP(response http.ResponseWriter, request *htt
 	responseHeader.Set(ProtocolVersionHeader, matchingVersion)
 	responseHeader.Set(GenesisHeader, wn.GenesisID)
 	// set the features we support
-	responseHeader.Set(PeerFeaturesHeader, PeerFeatureProposalCompression)
+	responseHeader.Set(PeerFeaturesHeader, wn.announcedPeerFeatures())
 	var challenge string
 	if wn.prioScheme != nil {
 		challenge = wn.prioScheme.NewPrioChallenge()
@@ -1070,7 +1110,9 @@ func (wn *WebsocketNetwork) ServeHTTP(response http.ResponseWriter, request *htt
 		identity:          peerID,
 		identityChallenge: peerIDChallenge,
 		identityVerified:  atomic.Uint32{},
-		features:          decodePeerFeatures(matchingVersion, request.Header.Get(PeerFeaturesHeader)),
+		features:          wn.negotiatePeerFeatures(matchingVersion, request.Header.Get(PeerFeaturesHeader)),
+		txInventory:       wn.broadcaster.txInventory,
+		recorder:          wn.handler.recorder,
 	}
 	peer.TelemetryGUID = trackedRequest.otherTelemetryGUID
 	peer.init(wn.config, wn.outgoingMessagesBufferSize)
@@ -1127,6 +1169,9 @@ func (wn *msgHandler) messageHandlerThread(wg *sync.WaitGroup, peersConnectivity
 			if wn.config.EnableOutgoingNetworkMessageFiltering && len(msg.Data) >= messageFilterSize {
 				wn.sendFilterMessage(msg, net)
 			}
+			if wn.recorder != nil {
+				wn.recorder.Record(messagerecorder.Inbound, time.Unix(0, msg.Received), msg.Tag, recordedPeerAddress(msg.Sender), msg.Data)
+			}
 			//wn.log.Debugf("msg handling %#v [%d]byte", msg.Tag, len(msg.Data))
 			start := time.Now()
 
@@ -1166,6 +1211,18 @@ func (wn *msgHandler) messageHandlerThread(wg *sync.WaitGroup, peersConnectivity
 	}
 }
 
+// recordedPeerAddress returns the address messages of the peer are recorded with
+func recordedPeerAddress(peer Peer) string {
+	switch p := peer.(type) {
+	case HTTPPeer:
+		return p.GetAddress()
+	case gossipSubPeer:
+		return p.peerID.String()
+	default:
+		return ""
+	}
+}
+
 // checkPeersConnectivity tests the last timestamp where each of these
 // peers was communicated with, and disconnect the peer if it has been too long since
 // last time.
@@ -1394,6 +1451,11 @@ func (wn *msgBroadcaster) innerBroadcast(request broadcastRequest, prio bool, pe
 
 	start := time.Now()
 	data, digests := wn.preparePeerData(request, prio)
+	var invData [][]byte
+	var invDigests, announce []crypto.Digest
+	if wn.txInventory != nil {
+		invData, invDigests, announce = wn.txInventory.split(request, data, digests)
+	}
 
 	// first send to all the easy outbound peers who don't block, get them started.
 	sentMessageCount := 0
@@ -1404,7 +1466,17 @@ func (wn *msgBroadcaster) innerBroadcast(request broadcastRequest, prio bool, pe
 		if peer == request.except {
 			continue
 		}
-		ok := peer.writeNonBlockMsgs(request.ctx, data, prio, digests, request.enqueueTime)
+		if wn.txTopicPeer != nil && request.tags[0] == protocol.TxnTag && wn.txTopicPeer(peer) {
+			continue
+		}
+		var ok bool
+		if len(announce) > 0 && peer.txInventoryEnabled() {
+			// large transaction groups are announced to the peer rather than sent
+			ok = len(invData) == 0 || peer.writeNonBlockMsgs(request.ctx, invData, prio, invDigests, request.enqueueTime)
+			wn.txInventory.announce(peer, announce)
+		} else {
+			ok = peer.writeNonBlockMsgs(request.ctx, data, prio, digests, request.enqueueTime)
+		}
 		if ok {
 			sentMessageCount++
 			continue
@@ -1599,6 +1671,10 @@ func (wn *WebsocketNetwork) checkNewConnectionsNeeded() bool {
 			// filter out self-public address, so we won't try to connect to ourselves.
 			continue
 		}
+		if u, err := addr.ParseHostOrURL(na); err == nil && wn.reputation.Banned(justHost(u.Host)) {
+			// addresses resolving to a banned IP are dropped once connected
+			continue
+		}
 		gossipAddr, ok := wn.tryConnectReserveAddr(na)
 		if ok {
 			wn.wg.Add(1)
@@ -1917,6 +1993,38 @@ const PeerFeaturesHeader = "X-Algorand-Peer-Features"
 // supports proposal payload compression with zstd
 const PeerFeatureProposalCompression = "ppzstd"
 
+// PeerFeatureVoteCompression is a value for PeerFeaturesHeader indicating peer
+// supports stateful compression of agreement votes
+const PeerFeatureVoteCompression = "avvpack"
+
+// PeerFeatureTxInventory is a value for PeerFeaturesHeader indicating peer
+// supports transaction inventory announcements
+const PeerFeatureTxInventory = "txinv"
+
+// announcedPeerFeatures returns the PeerFeaturesHeader value listing the features we support
+func (wn *WebsocketNetwork) announcedPeerFeatures() string {
+	features := PeerFeatureProposalCompression
+	if wn.config.EnableVoteCompression {
+		features += "," + PeerFeatureVoteCompression
+	}
+	if wn.config.EnableTxInventory {
+		features += "," + PeerFeatureTxInventory
+	}
+	return features
+}
+
+// negotiatePeerFeatures returns the features announced by the peer that we support as well
+func (wn *WebsocketNetwork) negotiatePeerFeatures(version string, announcedFeatures string) peerFeatureFlag {
+	features := decodePeerFeatures(version, announcedFeatures)
+	if !wn.config.EnableVoteCompression {
+		features &^= pfCompressedVote
+	}
+	if !wn.config.EnableTxInventory {
+		features &^= pfTxInventory
+	}
+	return features
+}
+
 var websocketsScheme = map[string]string{"http": "ws", "https": "wss"}
 
 var errBadAddr = errors.New("bad address")
@@ -2049,7 +2157,7 @@ func (wn *WebsocketNetwork) tryConnect(netAddr, gossipAddr string) {
 	// for backward compatibility, include the ProtocolVersion header as well.
 	requestHeader.Set(ProtocolVersionHeader, wn.protocolVersion)
 	// set the features header (comma-separated list)
-	requestHeader.Set(PeerFeaturesHeader, PeerFeatureProposalCompression)
+	requestHeader.Set(PeerFeaturesHeader, wn.announcedPeerFeatures())
 	SetUserAgentHeader(requestHeader)
 	myInstanceName := wn.log.GetInstanceName()
 	requestHeader.Set(InstanceNameHeader, myInstanceName)
@@ -2081,6 +2189,8 @@ func (wn *WebsocketNetwork) tryConnect(netAddr, gossipAddr string) {
 				wn.log.Warnf("ws connect(%s) fail - bad handshake, precondition failed : '%s'", gossipAddr, errString)
 			case http.StatusLoopDetected:
 				wn.log.Infof("ws connect(%s) aborted due to connecting to self", gossipAddr)
+			case http.StatusForbidden:
+				wn.log.Infof("ws connect(%s) refused, the peer has banned us", gossipAddr)
 			case http.StatusTooManyRequests:
 				wn.log.Infof("ws connect(%s) aborted due to connecting too frequently", gossipAddr)
 				retryAfterHeader := response.Header.Get(TooManyRequestsRetryAfterHeader)
@@ -2112,6 +2222,12 @@ func (wn *WebsocketNetwork) tryConnect(netAddr, gossipAddr string) {
 		}
 	}
 
+	if wn.reputation.Banned(justHost(conn.RemoteAddr().String())) {
+		networkConnectionsDroppedTotal.Inc(map[string]string{"reason": "banned"})
+		closeEarly("Banned")
+		return
+	}
+
 	// no need to test the response.StatusCode since we know it's going to be http.StatusSwitchingProtocols, as it's already being tested inside websocketDialer.DialContext.
 	// we need to examine the headers here to extract which protocol version we should be using.
 	responseHeaderOk, matchingVersion := wn.checkServerResponseVariables(response.Header, gossipAddr)
@@ -2153,7 +2269,9 @@ func (wn *WebsocketNetwork) tryConnect(netAddr, gossipAddr string) {
 		throttledOutgoingConnection: throttledConnection,
 		version:                     matchingVersion,
 		identity:                    peerID,
-		features:                    decodePeerFeatures(matchingVersion, response.Header.Get(PeerFeaturesHeader)),
+		features:                    wn.negotiatePeerFeatures(matchingVersion, response.Header.Get(PeerFeaturesHeader)),
+		txInventory:                 wn.broadcaster.txInventory,
+		recorder:                    wn.handler.recorder,
 	}
 	peer.TelemetryGUID, peer.InstanceName, _ = getCommonHeaders(response.Header)
 
@@ -2283,20 +2401,27 @@ func (wn *WebsocketNetwork) SetPrioScheme(s NetPrioScheme) {
 	wn.prioScheme = s
 }
 
+// SetPeerReputation specifies the reputation service used to score and ban peers.
+// It must be called before Start.
+func (wn *WebsocketNetwork) SetPeerReputation(r *PeerReputation) {
+	wn.reputation = r
+	wn.requestsTracker.reputation = r
+}
+
+// SetMessageRecorder specifies the recorder of the messages sent and received.
+// It must be called before Start.
+func (wn *WebsocketNetwork) SetMessageRecorder(r *messagerecorder.Recorder) {
+	wn.handler.recorder = r
+	wn.broadcaster.recorder = r
+}
+
 // called from wsPeer to report that it has closed
 func (wn *WebsocketNetwork) peerRemoteClose(peer *wsPeer, reason disconnectReason) {
 	wn.removePeer(peer, reason)
 }
 
-func (wn *WebsocketNetwork) removePeer(peer *wsPeer, reason disconnectReason) {
-	// first logging, then take the lock and do the actual accounting.
-	// definitely don't change this to do the logging while holding the lock.
-	localAddr, _ := wn.Address()
-	logEntry := wn.log.With("event", "Disconnected").With("remote", peer.GetAddress()).With("local", localAddr)
-	if peer.outgoing && peer.peerMessageDelay > 0 {
-		logEntry = logEntry.With("messageDelay", peer.peerMessageDelay)
-	}
-	logEntry.Infof("Peer %s disconnected: %s", peer.GetAddress(), reason)
+// peerHost returns the host a peer is identified by, for logging and scoring
+func peerHost(peer *wsPeer) string {
 	peerAddr := peer.OriginAddress()
 	// we might be able to get addr out of conn, or it might be closed
 	if peerAddr == "" && peer.conn != nil {
@@ -2315,6 +2440,24 @@ func (wn *WebsocketNetwork) removePeer(peer *wsPeer, reason disconnectReason) {
 			peerAddr = justHost(peer.GetAddress())
 		}
 	}
+	return peerAddr
+}
+
+// penalizeTxInventoryRequest is the txInventory penalize function of the websocket network
+func (wn *WebsocketNetwork) penalizeTxInventoryRequest(wp *wsPeer) {
+	wn.reputation.reportBadTxInventoryRequest(peerHost(wp))
+}
+
+func (wn *WebsocketNetwork) removePeer(peer *wsPeer, reason disconnectReason) {
+	// first logging, then take the lock and do the actual accounting.
+	// definitely don't change this to do the logging while holding the lock.
+	localAddr, _ := wn.Address()
+	logEntry := wn.log.With("event", "Disconnected").With("remote", peer.GetAddress()).With("local", localAddr)
+	if peer.outgoing && peer.peerMessageDelay > 0 {
+		logEntry = logEntry.With("messageDelay", peer.peerMessageDelay)
+	}
+	logEntry.Infof("Peer %s disconnected: %s", peer.GetAddress(), reason)
+	peerAddr := peerHost(peer)
 	eventDetails := telemetryspec.PeerEventDetails{
 		Address:       peerAddr,
 		TelemetryGUID: peer.TelemetryGUID,
@@ -2339,9 +2482,18 @@ func (wn *WebsocketNetwork) removePeer(peer *wsPeer, reason disconnectReason) {
 	incomingPeers.Set(uint64(wn.numIncomingPeers()))
 	outgoingPeers.Set(uint64(wn.numOutgoingPeers()))
 
+	// a peer can be removed more than once, only score it the time it is actually removed
+	removed := false
+	defer func() {
+		if removed {
+			wn.reputation.reportDisconnect(peerAddr, reason)
+		}
+	}()
+
 	wn.peersLock.Lock()
 	defer wn.peersLock.Unlock()
 	if peer.peerIndex < len(wn.peers) && wn.peers[peer.peerIndex] == peer {
+		removed = true
 		heap.Remove(peersHeap{wn}, peer.peerIndex)
 		wn.prioTracker.removePeer(peer)
 		wn.identityTracker.removeIdentity(peer)
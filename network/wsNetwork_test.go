@@ -30,6 +30,7 @@ import (
 	"net/http/httptest"
 	"net/url"
 	"os"
+	"path/filepath"
 	"regexp"
 	"runtime"
 	"sort"
@@ -40,6 +41,7 @@ import (
 	"time"
 
 	"github.com/Quarkonium-chain/go-quarkonium/internal/rapidgen"
+	"github.com/Quarkonium-chain/go-quarkonium/network/messagerecorder"
 	"github.com/Quarkonium-chain/go-quarkonium/network/phonebook"
 	"pgregory.net/rapid"
 
@@ -3734,6 +3736,75 @@ func TestPreparePeerData(t *testing.T) {
 	}
 }
 
+func TestWebsocketNetworkVoteCompression(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	tests := []struct {
+		enableA, enableB bool
+		expected         peerFeatureFlag
+	}{
+		{true, true, pfCompressedProposal | pfCompressedVote},
+		{true, false, pfCompressedProposal},
+		{false, true, pfCompressedProposal},
+	}
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("A_%v+B_%v", test.enableA, test.enableB), func(t *testing.T) {
+			netA := makeTestWebsocketNode(t)
+			netA.config.GossipFanout = 1
+			netA.config.EnableVoteCompression = test.enableA
+			netA.Start()
+			defer netStop(t, netA, "A")
+			netB := makeTestWebsocketNode(t)
+			netB.config.GossipFanout = 1
+			netB.config.EnableVoteCompression = test.enableB
+			addrA, postListen := netA.Address()
+			require.True(t, postListen)
+			netB.phonebook.ReplacePeerList([]string{addrA}, "default", phonebook.PhoneBookEntryRelayRole)
+			netB.Start()
+			defer netStop(t, netB, "B")
+
+			// vote-like messages sharing senders and keys, and one that is not msgpack
+			// and so is sent non-compressed
+			var messages [][]byte
+			for i := 0; i < 20; i++ {
+				snd := make([]byte, 32)
+				snd[0] = byte(i % 4)
+				messages = append(messages, protocol.EncodeReflect(map[string]interface{}{
+					"r":   map[string]interface{}{"snd": snd, "rnd": uint64(i)},
+					"sig": map[string]interface{}{"p": snd, "ps": make([]byte, 64)},
+				}))
+			}
+			messages = append(messages, []byte("foo"))
+			matcher := newMessageMatcher(t, messages)
+			counterDone := matcher.done
+			netB.RegisterHandlers([]TaggedMessageHandler{{Tag: protocol.AgreementVoteTag, MessageHandler: matcher}})
+
+			readyTimeout := time.NewTimer(2 * time.Second)
+			waitReady(t, netA, readyTimeout.C)
+			waitReady(t, netB, readyTimeout.C)
+
+			peers := netA.GetPeers(PeersConnectedIn)
+			require.Len(t, peers, 1)
+			require.Equal(t, test.expected, peers[0].(*wsPeer).features)
+			peers = netB.GetPeers(PeersConnectedOut)
+			require.Len(t, peers, 1)
+			require.Equal(t, test.expected, peers[0].(*wsPeer).features)
+
+			for _, msg := range messages {
+				netA.Broadcast(context.Background(), protocol.AgreementVoteTag, msg, false, nil)
+			}
+
+			select {
+			case <-counterDone:
+			case <-time.After(2 * time.Second):
+				t.Errorf("timeout, count=%d, wanted %d", len(matcher.received), len(messages))
+			}
+
+			require.True(t, matcher.Match())
+		})
+	}
+}
+
 func TestWebsocketNetworkTelemetryTCP(t *testing.T) {
 	partitiontest.PartitionTest(t)
 
@@ -4639,3 +4710,136 @@ func TestWebsocketNetworkHTTPClient(t *testing.T) {
 	_, err = netB.GetHTTPClient("invalid")
 	require.Error(t, err)
 }
+
+// TestWebsocketNetworkPeerReputation checks that disconnecting a peer for bad data
+// scores its IP address, and that a banned IP address is refused incoming connections.
+func TestWebsocketNetworkPeerReputation(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	netA := makeTestWebsocketNode(t)
+	netA.config.GossipFanout = 1
+	reputation := MakePeerReputation(netA.log, netA.config, "")
+	netA.SetPeerReputation(reputation)
+	netA.Start()
+	defer netStop(t, netA, "A")
+	addrA, postListen := netA.Address()
+	require.True(t, postListen)
+
+	netB := makeTestWebsocketNode(t)
+	netB.config.GossipFanout = 1
+	netB.phonebook.ReplacePeerList([]string{addrA}, "default", phonebook.PhoneBookEntryRelayRole)
+	netB.Start()
+	defer netStop(t, netB, "B")
+
+	readyTimeout := time.NewTimer(2 * time.Second)
+	waitReady(t, netA, readyTimeout.C)
+	waitReady(t, netB, readyTimeout.C)
+
+	peers := netA.GetPeers(PeersConnectedIn)
+	require.Len(t, peers, 1)
+	netA.Disconnect(peers[0].(*wsPeer))
+
+	entries := reputation.Entries()
+	require.Len(t, entries, 1)
+	require.Equal(t, "127.0.0.1", entries[0].Peer)
+	require.Equal(t, string(disconnectBadData), entries[0].LastOffense)
+	require.False(t, reputation.Banned("127.0.0.1"))
+
+	for !reputation.Banned("127.0.0.1") {
+		reputation.reportRateLimited("127.0.0.1")
+	}
+
+	netC := makeTestWebsocketNode(t)
+	netC.config.GossipFanout = 1
+	netC.phonebook.ReplacePeerList([]string{addrA}, "default", phonebook.PhoneBookEntryRelayRole)
+	netC.Start()
+	defer netStop(t, netC, "C")
+
+	netC.RequestConnectOutgoing(false, nil)
+	time.Sleep(500 * time.Millisecond)
+	require.Empty(t, netA.GetPeers(PeersConnectedIn))
+	require.Empty(t, netC.GetPeers(PeersConnectedOut))
+
+	require.True(t, reputation.Clear("127.0.0.1"))
+	netC.RequestConnectOutgoing(false, nil)
+	require.Eventually(t, func() bool {
+		return len(netC.GetPeers(PeersConnectedOut)) == 1
+	}, 5*time.Second, 50*time.Millisecond)
+}
+
+// TestWebsocketNetworkMessageRecorder records the messages exchanged by a node and replays
+// the received ones into message handlers.
+func TestWebsocketNetworkMessageRecorder(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	path := filepath.Join(t.TempDir(), messagerecorder.Filename)
+	recording, err := messagerecorder.MakeWriter(path, 0, 0)
+	require.NoError(t, err)
+	recorder := messagerecorder.MakeRecorder(logging.TestingLog(t), recording)
+
+	netA := makeTestWebsocketNode(t)
+	netA.config.GossipFanout = 1
+	netA.Start()
+	defer netStop(t, netA, "A")
+	netB := makeTestWebsocketNode(t)
+	netB.config.GossipFanout = 1
+	netB.SetMessageRecorder(recorder)
+	addrA, postListen := netA.Address()
+	require.True(t, postListen)
+	netB.phonebook.ReplacePeerList([]string{addrA}, "default", phonebook.PhoneBookEntryRelayRole)
+	netB.Start()
+	defer netStop(t, netB, "B")
+
+	var received [][]byte
+	for i := 0; i < 5; i++ {
+		received = append(received, []byte(fmt.Sprintf("received %d", i)))
+	}
+	matcher := newMessageMatcher(t, received)
+	netB.RegisterHandlers([]TaggedMessageHandler{{Tag: protocol.TxnTag, MessageHandler: matcher}})
+
+	readyTimeout := time.NewTimer(2 * time.Second)
+	waitReady(t, netA, readyTimeout.C)
+	waitReady(t, netB, readyTimeout.C)
+
+	for _, msg := range received {
+		netA.Broadcast(context.Background(), protocol.TxnTag, msg, false, nil)
+	}
+	select {
+	case <-matcher.done:
+	case <-time.After(2 * time.Second):
+		t.Fatalf("timeout, count=%d, wanted %d", len(matcher.received), len(received))
+	}
+	sent := []byte("sent")
+	netB.Broadcast(context.Background(), protocol.AgreementVoteTag, sent, true, nil)
+	recorder.Close()
+
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+	var inbound [][]byte
+	var outbound int
+	replayed := newMessageMatcher(t, received)
+	var replay Multiplexer
+	replay.RegisterHandlers([]TaggedMessageHandler{{Tag: protocol.TxnTag, MessageHandler: replayed}})
+	err = messagerecorder.Replay(context.Background(), messagerecorder.MakeReader(f), 0, func(rec messagerecorder.Record) error {
+		if rec.Direction == messagerecorder.Outbound {
+			// unicasts, like the messages of interest, are recorded with their peer
+			if rec.Peer == "" {
+				require.Equal(t, protocol.AgreementVoteTag, rec.Tag)
+				require.Equal(t, sent, rec.Data)
+				outbound++
+			}
+			return nil
+		}
+		require.Equal(t, addrA, rec.Peer)
+		if rec.Tag == protocol.TxnTag {
+			inbound = append(inbound, rec.Data)
+		}
+		replay.Handle(IncomingMessage{Tag: rec.Tag, Data: rec.Data, Received: rec.Time.UnixNano()})
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, outbound)
+	require.ElementsMatch(t, received, inbound)
+	require.True(t, replayed.Match())
+}
@@ -37,6 +37,8 @@ import (
 	"github.com/Quarkonium-chain/go-quarkonium/crypto"
 	"github.com/Quarkonium-chain/go-quarkonium/data/basics"
 	"github.com/Quarkonium-chain/go-quarkonium/logging"
+	"github.com/Quarkonium-chain/go-quarkonium/network/messagerecorder"
+	"github.com/Quarkonium-chain/go-quarkonium/network/vpack"
 	"github.com/Quarkonium-chain/go-quarkonium/protocol"
 	"github.com/Quarkonium-chain/go-quarkonium/util"
 )
@@ -248,6 +250,22 @@ type wsPeer struct {
 	// peer features derived from the peer version
 	features peerFeatureFlag
 
+	// voteEncoder compresses votes sent to the peer if it supports pfCompressedVote.
+	// It is only used by the write loop.
+	voteEncoder    *vpack.StatefulEncoder
+	voteEncoderBuf []byte
+
+	// txInventory serves the transaction groups announced to the peer and requests the ones it announces
+	txInventory *txInventory
+	// txInventoryPeer is set if the peer supports pfTxInventory, or once it sent an inventory message since p2p
+	// peers may not know the protocols of the other peer yet when the stream is accepted
+	txInventoryPeer atomic.Bool
+	// txKnown has the digests of the transaction groups the peer announced, sent or was announced
+	txKnown *messageFilter
+
+	// recorder records the messages unicast to the peer, if set
+	recorder *messagerecorder.Recorder
+
 	// responseChannels used by the client to wait on the response of the request
 	responseChannels map[uint64]chan *Response
 
@@ -405,6 +423,10 @@ func (wp *wsPeer) RoutingAddr() []byte {
 func (wp *wsPeer) Unicast(ctx context.Context, msg []byte, tag protocol.Tag) error {
 	var err error
 
+	if wp.recorder != nil {
+		wp.recorder.Record(messagerecorder.Outbound, time.Now(), tag, wp.GetAddress(), msg)
+	}
+
 	tbytes := []byte(tag)
 	mbytes := make([]byte, len(tbytes)+len(msg))
 	copy(mbytes, tbytes)
@@ -502,6 +524,10 @@ func (wp *wsPeer) init(config config.Local, sendBufferLength int) {
 	if config.EnableOutgoingNetworkMessageFiltering {
 		wp.outgoingMsgFilter = makeMessageFilter(config.OutgoingMessageFilterBucketCount, config.OutgoingMessageFilterBucketSize)
 	}
+	if wp.txInventory != nil {
+		wp.txKnown = makeMessageFilter(txInventorySeenBuckets, txInventoryKnownBucketSize)
+		wp.txInventoryPeer.Store(wp.features&pfTxInventory != 0)
+	}
 
 	wp.wg.Add(2)
 	go wp.readLoop()
@@ -668,8 +694,24 @@ func (wp *wsPeer) readLoop() {
 			// network maintenance message handled immediately instead of handing off to general handlers
 			wp.handleFilterMessage(msg)
 			continue
+		case protocol.TxnAnnounceTag, protocol.TxnRequestTag:
+			if wp.txInventory == nil {
+				unknownProtocolTagMessagesTotal.Inc(nil)
+				wp.unkMessageCount.Add(1)
+				continue
+			}
+			if err = wp.handleTxInventoryMessage(msg); err != nil {
+				wp.log.Warnf("wsPeer readLoop: bad %s message from %s: %v", msg.Tag, wp.conn.RemoteAddrString(), err)
+				networkConnectionsDroppedTotal.Inc(map[string]string{"reason": "protocol"})
+				cleanupCloseError = disconnectBadData
+				return
+			}
+			continue
 		case protocol.TxnTag:
 			wp.txMessageCount.Add(1)
+			if wp.txInventory != nil {
+				wp.txInventory.received(wp, msg.Data)
+			}
 		case protocol.AgreementVoteTag:
 			wp.avMessageCount.Add(1)
 		case protocol.ProposalPayloadTag:
@@ -812,7 +854,12 @@ func (wp *wsPeer) writeLoopSendMsg(msg sendMessage) disconnectReason {
 	}
 	// the tags are always 2 char long; note that this is safe since it's only being used for messages that we have generated locally.
 	tag := protocol.Tag(msg.data[:2])
-	if !wp.sendMessageTag[tag] {
+	interest := tag
+	if tag == protocol.TxnAnnounceTag || tag == protocol.TxnRequestTag {
+		// inventory messages follow the peer's interest in transactions
+		interest = protocol.TxnTag
+	}
+	if !wp.sendMessageTag[interest] {
 		// the peer isn't interested in this message.
 		return disconnectReasonNone
 	}
@@ -826,9 +873,22 @@ func (wp *wsPeer) writeLoopSendMsg(msg sendMessage) disconnectReason {
 		return disconnectStaleWrite
 	}
 
+	data := msg.data
+	if tag == protocol.AgreementVoteTag && wp.features&pfCompressedVote != 0 {
+		// votes are compressed here rather than when broadcast since the encoder state
+		// is per connection and must follow the order messages are written in
+		if wp.voteEncoder == nil {
+			wp.voteEncoder = vpack.NewStatefulEncoder()
+		}
+		if comp, ok := voteCompressMsg(wp.voteEncoder, wp.voteEncoderBuf, msg.data); ok {
+			// the buffer is reused once the message is written
+			data, wp.voteEncoderBuf = comp, comp
+		}
+	}
+
 	wp.intermittentOutgoingMessageEnqueueTime.Store(msg.enqueued.UnixNano())
 	defer wp.intermittentOutgoingMessageEnqueueTime.Store(0)
-	err := wp.conn.WriteMessage(websocket.BinaryMessage, msg.data)
+	err := wp.conn.WriteMessage(websocket.BinaryMessage, data)
 	if err != nil {
 		if wp.didInnerClose.Load() == 0 {
 			wp.log.Warn("peer write error ", err)
@@ -838,14 +898,14 @@ func (wp *wsPeer) writeLoopSendMsg(msg sendMessage) disconnectReason {
 	}
 	wp.lastPacketTime.Store(time.Now().UnixNano())
 	if wp.peerType == peerTypeWs {
-		networkSentBytesTotal.AddUint64(uint64(len(msg.data)), nil)
-		networkSentBytesByTag.Add(string(tag), uint64(len(msg.data)))
+		networkSentBytesTotal.AddUint64(uint64(len(data)), nil)
+		networkSentBytesByTag.Add(string(tag), uint64(len(data)))
 		networkMessageSentTotal.AddUint64(1, nil)
 		networkMessageSentByTag.Add(string(tag), 1)
 		networkMessageQueueMicrosTotal.AddUint64(uint64(time.Since(msg.peerEnqueued).Nanoseconds()/1000), nil)
 	} else {
-		networkP2PSentBytesTotal.AddUint64(uint64(len(msg.data)), nil)
-		networkP2PSentBytesByTag.Add(string(tag), uint64(len(msg.data)))
+		networkP2PSentBytesTotal.AddUint64(uint64(len(data)), nil)
+		networkP2PSentBytesByTag.Add(string(tag), uint64(len(data)))
 		networkP2PMessageSentTotal.AddUint64(1, nil)
 		networkP2PMessageSentByTag.Add(string(tag), 1)
 		networkP2PMessageQueueMicrosTotal.AddUint64(uint64(time.Since(msg.peerEnqueued).Nanoseconds()/1000), nil)
@@ -1126,6 +1186,8 @@ type peerFeatureFlag int
 
 const (
 	pfCompressedProposal peerFeatureFlag = 1 << iota
+	pfCompressedVote
+	pfTxInventory
 )
 
 // versionPeerFeatures defines protocol version when peer features were introduced
@@ -1167,8 +1229,13 @@ func decodePeerFeatures(version string, announcedFeatures string) peerFeatureFla
 	parts := strings.Split(announcedFeatures, ",")
 	for _, part := range parts {
 		part = strings.TrimSpace(part)
-		if part == PeerFeatureProposalCompression {
+		switch part {
+		case PeerFeatureProposalCompression:
 			features |= pfCompressedProposal
+		case PeerFeatureVoteCompression:
+			features |= pfCompressedVote
+		case PeerFeatureTxInventory:
+			features |= pfTxInventory
 		}
 	}
 	return features
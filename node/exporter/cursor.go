@@ -0,0 +1,81 @@
+// Copyright (C) 2019-2024 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package exporter
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/Quarkonium-chain/go-quarkonium/data/basics"
+)
+
+// cursor persists, in a file, the next round to be exported and the first
+// round that was never exported, as two decimal numbers. They only differ
+// while replaying.
+type cursor struct {
+	path string
+}
+
+// load returns the persisted rounds, and false if nothing was persisted yet.
+func (c cursor) load() (next basics.Round, head basics.Round, ok bool, err error) {
+	data, err := os.ReadFile(c.path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return 0, 0, false, nil
+	}
+	if err != nil {
+		return 0, 0, false, err
+	}
+	fields := strings.Fields(string(data))
+	if len(fields) != 2 {
+		return 0, 0, false, fmt.Errorf("malformed exporter cursor %s: expected 2 rounds, found %d", c.path, len(fields))
+	}
+	rounds := make([]basics.Round, len(fields))
+	for i, field := range fields {
+		rnd, err := strconv.ParseUint(field, 10, 64)
+		if err != nil {
+			return 0, 0, false, fmt.Errorf("malformed exporter cursor %s: %w", c.path, err)
+		}
+		rounds[i] = basics.Round(rnd)
+	}
+	return rounds[0], rounds[1], true, nil
+}
+
+// save persists next and head. The cursor is written to a temporary file
+// first, so that a crash never leaves a partially written cursor behind.
+func (c cursor) save(next basics.Round, head basics.Round) error {
+	tmp := c.path + ".tmp"
+	f, err := os.OpenFile(tmp, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0644)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(f, "%d %d\n", next, head)
+	if err == nil {
+		err = f.Sync()
+	}
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	return os.Rename(tmp, c.path)
+}
@@ -0,0 +1,340 @@
+// Copyright (C) 2019-2024 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+// Package exporter writes the committed blocks of a node, along with the
+// state deltas of their transaction groups, to a Sink. The last exported round
+// is persisted, so that every block is delivered at least once even if the
+// node or the sink goes down.
+package exporter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/Quarkonium-chain/go-quarkonium/config"
+	"github.com/Quarkonium-chain/go-quarkonium/data/basics"
+	"github.com/Quarkonium-chain/go-quarkonium/data/bookkeeping"
+	"github.com/Quarkonium-chain/go-quarkonium/data/transactions/logic"
+	"github.com/Quarkonium-chain/go-quarkonium/ledger/eval"
+	"github.com/Quarkonium-chain/go-quarkonium/logging"
+)
+
+// CursorFilename is the name of the file, in the genesis directory, holding
+// the next round to be exported.
+const CursorFilename = "exporter.cursor"
+
+// RetryInterval is the time the exporter waits before retrying a round that
+// could not be exported.
+var RetryInterval = 5 * time.Second
+
+// Record is the unit written to a Sink: a committed block, and the state
+// deltas of each of its transaction groups, in payset order.
+type Record struct {
+	Round  basics.Round                `codec:"round"`
+	Block  bookkeeping.Block           `codec:"block"`
+	Deltas []eval.TxnGroupDeltaWithIds `codec:"deltas,omitempty"`
+
+	// DeltasUnavailable is set when a replayed block is exported again after
+	// its group deltas were dropped by the ledger. A block that was never
+	// exported is not written without its deltas: the exporter stops instead.
+	DeltasUnavailable bool `codec:"deltas-unavailable,omitempty"`
+}
+
+// errDeltasUnavailable is returned when the ledger does not hold the group
+// deltas of a block anymore, or holds the ones of another evaluation of its
+// round.
+var errDeltasUnavailable = errors.New("the group deltas of the block are not held by the ledger")
+
+// Ledger captures the aspects of the ledger that are used by the exporter.
+type Ledger interface {
+	Latest() basics.Round
+	Wait(basics.Round) chan struct{}
+	Block(basics.Round) (bookkeeping.Block, error)
+	GetTracer() logic.EvalTracer
+}
+
+// Exporter follows the ledger and writes a Record for every committed round
+// to its Sink.
+type Exporter struct {
+	ledger     Ledger
+	sink       Sink
+	cursor     cursor
+	startRound basics.Round
+	log        logging.Logger
+
+	// setSyncRound, if set, keeps the ledger of a follower node from dropping
+	// the group deltas of the rounds from the given one.
+	setSyncRound func(basics.Round) error
+
+	mu       sync.Mutex
+	replay   *basics.Round
+	replayCh chan struct{}
+
+	ctx      context.Context
+	shutdown context.CancelFunc
+	wg       sync.WaitGroup
+}
+
+// MakeExporter creates an Exporter writing to the sink selected by cfg. The
+// cursor is kept in genesisDir. Follower nodes pass their SetSyncRound as
+// setSyncRound, so that the exporter holds their sync round at the next round
+// to be exported; other nodes pass nil.
+func MakeExporter(cfg config.Local, genesisDir string, ledger Ledger, setSyncRound func(basics.Round) error, log logging.Logger) (*Exporter, error) {
+	sink, err := MakeSink(cfg, genesisDir)
+	if err != nil {
+		return nil, err
+	}
+	e := makeExporter(ledger, sink, filepath.Join(genesisDir, CursorFilename), basics.Round(cfg.ExporterStartRound), log)
+	e.setSyncRound = setSyncRound
+	return e, nil
+}
+
+func makeExporter(ledger Ledger, sink Sink, cursorPath string, startRound basics.Round, log logging.Logger) *Exporter {
+	return &Exporter{
+		ledger:     ledger,
+		sink:       sink,
+		cursor:     cursor{path: cursorPath},
+		startRound: startRound,
+		log:        log,
+		replayCh:   make(chan struct{}, 1),
+	}
+}
+
+// Start starts the goroutine of the exporter.
+func (e *Exporter) Start() {
+	e.ctx, e.shutdown = context.WithCancel(context.Background())
+	e.wg.Add(1)
+	go e.loop()
+}
+
+// Stop stops the goroutine of the exporter, waits for it to exit, and closes
+// the sink.
+func (e *Exporter) Stop() {
+	e.shutdown()
+	e.wg.Wait()
+	if err := e.sink.Close(); err != nil {
+		e.log.Warnf("exporter could not close its sink: %v", err)
+	}
+}
+
+// Replay makes the exporter go back to rnd, and export every round from there
+// again. It also resumes an exporter that stopped at a round whose group
+// deltas are no longer available, skipping the rounds before rnd.
+func (e *Exporter) Replay(rnd basics.Round) {
+	e.mu.Lock()
+	e.replay = &rnd
+	e.mu.Unlock()
+	select {
+	case e.replayCh <- struct{}{}:
+	default:
+	}
+}
+
+func (e *Exporter) takeReplay() (basics.Round, bool) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.replay == nil {
+		return 0, false
+	}
+	rnd := *e.replay
+	e.replay = nil
+	return rnd, true
+}
+
+// firstRound returns the round to resume exporting from, and the first round
+// that was never exported.
+func (e *Exporter) firstRound() (next basics.Round, head basics.Round, err error) {
+	next, head, ok, err := e.cursor.load()
+	if err != nil || ok {
+		return next, head, err
+	}
+	if e.startRound != 0 {
+		return e.startRound, e.startRound, nil
+	}
+	next = e.ledger.Latest() + 1
+	return next, next, nil
+}
+
+// holdSyncRound keeps the ledger of follower nodes from running so far ahead
+// of rnd that it drops its group deltas.
+func (e *Exporter) holdSyncRound(rnd basics.Round) {
+	if e.setSyncRound == nil {
+		return
+	}
+	if err := e.setSyncRound(rnd); err != nil {
+		e.log.Warnf("exporter could not hold the sync round at %d: %v", rnd, err)
+	}
+}
+
+// sleep waits for d, and returns false if the exporter is stopped meanwhile.
+func (e *Exporter) sleep(d time.Duration) bool {
+	select {
+	case <-e.ctx.Done():
+		return false
+	case <-time.After(d):
+		return true
+	}
+}
+
+func (e *Exporter) loop() {
+	defer e.wg.Done()
+
+	next, head, err := e.firstRound()
+	for err != nil {
+		e.log.Errorf("exporter could not load its cursor: %v", err)
+		if !e.sleep(RetryInterval) {
+			return
+		}
+		next, head, err = e.firstRound()
+	}
+	e.log.Infof("exporter starting from round %d", next)
+	e.holdSyncRound(next)
+
+	for {
+		if rnd, ok := e.takeReplay(); ok {
+			e.log.Infof("exporter replaying from round %d", rnd)
+			next = rnd
+			head = max(head, next)
+			if err := e.cursor.save(next, head); err != nil {
+				e.log.Warnf("exporter could not save its cursor: %v", err)
+			}
+			e.holdSyncRound(next)
+		}
+
+		if next > e.ledger.Latest() {
+			select {
+			case <-e.ctx.Done():
+				return
+			case <-e.ledger.Wait(next):
+			case <-e.replayCh:
+			}
+			continue
+		}
+
+		// Rounds that were exported already are exported again without
+		// their deltas if they are gone, but the first export of a round
+		// must include them.
+		rec, err := e.makeRecord(next, next >= head)
+		if err == nil {
+			err = e.sink.Write(&rec)
+		}
+		if errors.Is(err, errDeltasUnavailable) {
+			// Retrying can't bring the deltas back, so the exporter waits
+			// for a replay to skip the round explicitly.
+			e.log.Errorf("exporter stopped at round %d: %v; replay from a later round to skip it", next, err)
+			select {
+			case <-e.ctx.Done():
+				return
+			case <-e.replayCh:
+			}
+			continue
+		}
+		if err != nil {
+			// The round is retried until it succeeds, so that no round is
+			// ever skipped.
+			e.log.Warnf("exporter could not export round %d: %v", next, err)
+			if !e.sleep(RetryInterval) {
+				return
+			}
+			continue
+		}
+
+		next++
+		head = max(head, next)
+		if err := e.cursor.save(next, head); err != nil {
+			e.log.Warnf("exporter could not save its cursor: %v", err)
+		}
+		e.holdSyncRound(next)
+	}
+}
+
+// makeRecord assembles the Record of round rnd. If requireDeltas is set, it
+// returns errDeltasUnavailable instead of a Record without the group deltas.
+func (e *Exporter) makeRecord(rnd basics.Round, requireDeltas bool) (Record, error) {
+	blk, err := e.ledger.Block(rnd)
+	if err != nil {
+		return Record{}, err
+	}
+	rec := Record{Round: rnd, Block: blk}
+	if len(blk.Payset) == 0 {
+		return rec, nil
+	}
+
+	var deltas []eval.TxnGroupDeltaWithIds
+	tracer, ok := e.ledger.GetTracer().(*eval.TxnGroupDeltaTracer)
+	if !ok {
+		err = errors.New("the ledger has no group delta tracer")
+	} else {
+		deltas, err = tracer.GetDeltasForRound(rnd)
+		if err == nil {
+			rec.Deltas, err = orderDeltas(blk, deltas)
+		}
+	}
+	if err != nil {
+		if requireDeltas {
+			return Record{}, fmt.Errorf("%w: %v", errDeltasUnavailable, err)
+		}
+		e.log.Infof("exporter has no group deltas for round %d: %v", rnd, err)
+		rec.Deltas = nil
+		rec.DeltasUnavailable = true
+	}
+	return rec, nil
+}
+
+// orderDeltas returns the deltas of the transaction groups of blk, in payset
+// order. The tracer holds the deltas of the last evaluation of a round, which
+// is not necessarily the evaluation of the committed block: deltas of groups
+// outside of the payset are dropped, and an error is returned unless every
+// group of the payset has a delta.
+func orderDeltas(blk bookkeeping.Block, deltas []eval.TxnGroupDeltaWithIds) ([]eval.TxnGroupDeltaWithIds, error) {
+	groups, err := blk.DecodePaysetGroups()
+	if err != nil {
+		return nil, err
+	}
+	index := make(map[string]int)
+	for i, group := range groups {
+		if gid := group[0].Txn.Group; !gid.IsZero() {
+			index[gid.String()] = i
+		}
+		for _, stxn := range group {
+			index[stxn.ID().String()] = i
+		}
+	}
+
+	ordered := make([]eval.TxnGroupDeltaWithIds, len(groups))
+	found := make([]bool, len(groups))
+	for _, delta := range deltas {
+		if len(delta.Ids) == 0 {
+			continue
+		}
+		i, ok := index[delta.Ids[0]]
+		if !ok {
+			continue
+		}
+		ordered[i] = delta
+		found[i] = true
+	}
+	for i := range found {
+		if !found[i] {
+			return nil, fmt.Errorf("no delta for transaction group %d of %d", i, len(groups))
+		}
+	}
+	return ordered, nil
+}
@@ -0,0 +1,361 @@
+// Copyright (C) 2019-2024 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package exporter
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Quarkonium-chain/go-quarkonium/crypto"
+	"github.com/Quarkonium-chain/go-quarkonium/data/basics"
+	"github.com/Quarkonium-chain/go-quarkonium/data/bookkeeping"
+	"github.com/Quarkonium-chain/go-quarkonium/data/transactions"
+	"github.com/Quarkonium-chain/go-quarkonium/data/transactions/logic"
+	"github.com/Quarkonium-chain/go-quarkonium/ledger/eval"
+	"github.com/Quarkonium-chain/go-quarkonium/ledger/ledgercore"
+	"github.com/Quarkonium-chain/go-quarkonium/logging"
+	"github.com/Quarkonium-chain/go-quarkonium/protocol"
+	"github.com/Quarkonium-chain/go-quarkonium/test/partitiontest"
+)
+
+// mockLedger holds blocks of two transaction groups each: a payment, and a
+// group of two payments. The group deltas of every block are kept by a
+// TxnGroupDeltaTracer.
+type mockLedger struct {
+	mu      sync.Mutex
+	blocks  []bookkeeping.Block
+	waiters map[basics.Round]chan struct{}
+	tracer  *eval.TxnGroupDeltaTracer
+}
+
+func makeMockLedger(t *testing.T, rounds int) *mockLedger {
+	ml := &mockLedger{
+		waiters: make(map[basics.Round]chan struct{}),
+		tracer:  eval.MakeTxnGroupDeltaTracer(1000),
+	}
+	for i := 0; i < rounds; i++ {
+		ml.addBlock(t)
+	}
+	return ml
+}
+
+func (ml *mockLedger) addBlock(t *testing.T) {
+	ml.mu.Lock()
+	rnd := basics.Round(len(ml.blocks))
+	hdr := bookkeeping.BlockHeader{
+		Round:        rnd,
+		GenesisHash:  crypto.Digest{0x01},
+		UpgradeState: bookkeeping.UpgradeState{CurrentProtocol: protocol.ConsensusCurrentVersion},
+	}
+	blk := bookkeeping.Block{BlockHeader: hdr}
+	if rnd > 0 {
+		pay := func(note byte) transactions.SignedTxn {
+			return transactions.SignedTxn{Txn: transactions.Transaction{
+				Type: protocol.PaymentTx,
+				Header: transactions.Header{
+					Sender:      basics.Address{0x01},
+					FirstValid:  rnd,
+					LastValid:   rnd + 10,
+					Note:        []byte{byte(rnd), note},
+					GenesisHash: hdr.GenesisHash,
+				},
+			}}
+		}
+		single := pay(0)
+		group := []transactions.SignedTxn{pay(1), pay(2)}
+		gid := crypto.Digest{byte(rnd), 0xff}
+		group[0].Txn.Group = gid
+		group[1].Txn.Group = gid
+
+		ml.tracer.BeforeBlock(&hdr)
+		for i, txns := range [][]transactions.SignedTxn{{single}, group} {
+			var ep logic.EvalParams
+			for _, stxn := range txns {
+				stib, err := hdr.EncodeSignedTxn(stxn, transactions.ApplyData{})
+				require.NoError(t, err)
+				blk.Payset = append(blk.Payset, stib)
+				ep.TxnGroup = append(ep.TxnGroup, transactions.SignedTxnWithAD{SignedTxn: stxn})
+			}
+			delta := ledgercore.StateDelta{KvMods: map[string]ledgercore.KvValueDelta{
+				fmt.Sprintf("%d-%d", rnd, i): {Data: []byte{byte(i)}},
+			}}
+			ml.tracer.AfterTxnGroup(&ep, &delta, nil)
+		}
+	}
+	ml.blocks = append(ml.blocks, blk)
+	ml.mu.Unlock()
+	ml.Wait(rnd)
+}
+
+func (ml *mockLedger) Latest() basics.Round {
+	ml.mu.Lock()
+	defer ml.mu.Unlock()
+	return basics.Round(len(ml.blocks) - 1)
+}
+
+func (ml *mockLedger) Wait(r basics.Round) chan struct{} {
+	ml.mu.Lock()
+	defer ml.mu.Unlock()
+	ch, ok := ml.waiters[r]
+	if !ok {
+		ch = make(chan struct{})
+		ml.waiters[r] = ch
+	}
+	if int(r) < len(ml.blocks) {
+		select {
+		case <-ch:
+		default:
+			close(ch)
+		}
+	}
+	return ch
+}
+
+func (ml *mockLedger) Block(r basics.Round) (bookkeeping.Block, error) {
+	ml.mu.Lock()
+	defer ml.mu.Unlock()
+	if int(r) >= len(ml.blocks) {
+		return bookkeeping.Block{}, ledgercore.ErrNoEntry{Round: r}
+	}
+	return ml.blocks[r], nil
+}
+
+func (ml *mockLedger) GetTracer() logic.EvalTracer {
+	return ml.tracer
+}
+
+// mockSink collects records, and fails while failing is set.
+type mockSink struct {
+	mu      sync.Mutex
+	records []Record
+	failing bool
+	written chan basics.Round
+}
+
+func makeMockSink() *mockSink {
+	return &mockSink{written: make(chan basics.Round, 100)}
+}
+
+func (ms *mockSink) Write(rec *Record) error {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+	if ms.failing {
+		return errors.New("sink is failing")
+	}
+	ms.records = append(ms.records, *rec)
+	ms.written <- rec.Round
+	return nil
+}
+
+func (ms *mockSink) Close() error {
+	return nil
+}
+
+func (ms *mockSink) setFailing(failing bool) {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+	ms.failing = failing
+}
+
+func (ms *mockSink) expect(t *testing.T, rounds ...basics.Round) {
+	for _, rnd := range rounds {
+		select {
+		case written := <-ms.written:
+			require.Equal(t, rnd, written)
+		case <-time.After(5 * time.Second):
+			require.FailNow(t, "round was not exported", "round %d", rnd)
+		}
+	}
+}
+
+func (ms *mockSink) expectNothing(t *testing.T) {
+	select {
+	case written := <-ms.written:
+		require.FailNow(t, "unexpected export", "round %d", written)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestExporterFollowsLedger(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	ledger := makeMockLedger(t, 3)
+	sink := makeMockSink()
+	cursorPath := filepath.Join(t.TempDir(), CursorFilename)
+	e := makeExporter(ledger, sink, cursorPath, 0, logging.TestingLog(t))
+	e.Start()
+
+	// without a cursor, the exporter starts with the next round
+	sink.expectNothing(t)
+	ledger.addBlock(t)
+	ledger.addBlock(t)
+	sink.expect(t, 3, 4)
+	e.Stop()
+
+	rec := sink.records[0]
+	require.Equal(t, basics.Round(3), rec.Block.Round())
+	require.False(t, rec.DeltasUnavailable)
+	require.Len(t, rec.Deltas, 2)
+	require.Contains(t, rec.Deltas[0].Delta.KvMods, "3-0")
+	require.Contains(t, rec.Deltas[1].Delta.KvMods, "3-1")
+
+	next, head, ok, err := cursor{path: cursorPath}.load()
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, basics.Round(5), next)
+	require.Equal(t, basics.Round(5), head)
+
+	// a restarted exporter resumes from its cursor
+	ledger.addBlock(t)
+	e = makeExporter(ledger, sink, cursorPath, 0, logging.TestingLog(t))
+	e.Start()
+	defer e.Stop()
+	sink.expect(t, 5)
+	sink.expectNothing(t)
+}
+
+func TestExporterStartRoundAndReplay(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	ledger := makeMockLedger(t, 5)
+	sink := makeMockSink()
+	e := makeExporter(ledger, sink, filepath.Join(t.TempDir(), CursorFilename), 2, logging.TestingLog(t))
+	e.Start()
+	defer e.Stop()
+
+	sink.expect(t, 2, 3, 4)
+	sink.expectNothing(t)
+
+	e.Replay(1)
+	sink.expect(t, 1, 2, 3, 4)
+	sink.expectNothing(t)
+}
+
+func TestExporterRetries(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	defer func(d time.Duration) { RetryInterval = d }(RetryInterval)
+	RetryInterval = 10 * time.Millisecond
+
+	ledger := makeMockLedger(t, 2)
+	sink := makeMockSink()
+	sink.setFailing(true)
+	e := makeExporter(ledger, sink, filepath.Join(t.TempDir(), CursorFilename), 1, logging.TestingLog(t))
+	e.Start()
+	defer e.Stop()
+
+	// nothing is skipped while the sink is failing
+	sink.expectNothing(t)
+	ledger.addBlock(t)
+	sink.setFailing(false)
+	sink.expect(t, 1, 2)
+}
+
+func TestExporterDeltasUnavailable(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	ledger := makeMockLedger(t, 3)
+	e := makeExporter(ledger, makeMockSink(), "", 0, logging.TestingLog(t))
+
+	rec, err := e.makeRecord(2, true)
+	require.NoError(t, err)
+	require.False(t, rec.DeltasUnavailable)
+	require.Len(t, rec.Deltas, 2)
+
+	// deltas of another evaluation of the round don't cover the block
+	hdr := ledger.blocks[2].BlockHeader
+	ledger.tracer.BeforeBlock(&hdr)
+	other := transactions.SignedTxnWithAD{SignedTxn: transactions.SignedTxn{Txn: transactions.Transaction{
+		Type:   protocol.PaymentTx,
+		Header: transactions.Header{Sender: basics.Address{0x02}},
+	}}}
+	ledger.tracer.AfterTxnGroup(&logic.EvalParams{TxnGroup: []transactions.SignedTxnWithAD{other}}, &ledgercore.StateDelta{}, nil)
+	_, err = e.makeRecord(2, true)
+	require.ErrorIs(t, err, errDeltasUnavailable)
+	rec, err = e.makeRecord(2, false)
+	require.NoError(t, err)
+	require.True(t, rec.DeltasUnavailable)
+	require.Empty(t, rec.Deltas)
+
+	// deltas dropped by the tracer
+	ledger.tracer.BeforeBlock(&bookkeeping.BlockHeader{Round: 1 + 1000})
+	_, err = e.makeRecord(1, true)
+	require.ErrorIs(t, err, errDeltasUnavailable)
+
+	// blocks without transactions have no deltas to begin with
+	rec, err = e.makeRecord(0, true)
+	require.NoError(t, err)
+	require.False(t, rec.DeltasUnavailable)
+
+	_, err = e.makeRecord(3, true)
+	require.ErrorAs(t, err, &ledgercore.ErrNoEntry{})
+}
+
+func TestExporterStopsWithoutDeltas(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	ledger := makeMockLedger(t, 5)
+	sink := makeMockSink()
+	cursorPath := filepath.Join(t.TempDir(), CursorFilename)
+	e := makeExporter(ledger, sink, cursorPath, 1, logging.TestingLog(t))
+	var held []basics.Round
+	var heldMu sync.Mutex
+	e.setSyncRound = func(rnd basics.Round) error {
+		heldMu.Lock()
+		defer heldMu.Unlock()
+		held = append(held, rnd)
+		return nil
+	}
+
+	// the deltas of round 3 are dropped before it was ever exported
+	ledger.tracer.BeforeBlock(&bookkeeping.BlockHeader{Round: 3 + 1000})
+	e.Start()
+	defer e.Stop()
+	sink.expect(t, 1, 2)
+	sink.expectNothing(t)
+
+	// the cursor stays at the round missing its deltas
+	next, head, ok, err := cursor{path: cursorPath}.load()
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, basics.Round(3), next)
+	require.Equal(t, basics.Round(3), head)
+	heldMu.Lock()
+	require.Equal(t, []basics.Round{1, 2, 3}, held)
+	heldMu.Unlock()
+
+	// replaying rounds that were exported already writes them without
+	// their deltas, but the exporter stops again at round 3
+	ledger.tracer.BeforeBlock(&bookkeeping.BlockHeader{Round: 1 + 1000})
+	e.Replay(1)
+	sink.expect(t, 1, 2)
+	sink.expectNothing(t)
+	require.True(t, sink.records[2].DeltasUnavailable)
+	require.False(t, sink.records[3].DeltasUnavailable)
+
+	// replaying from a later round skips round 3
+	e.Replay(4)
+	sink.expect(t, 4)
+	sink.expectNothing(t)
+	require.False(t, sink.records[4].DeltasUnavailable)
+}
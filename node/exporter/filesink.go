@@ -0,0 +1,118 @@
+// Copyright (C) 2019-2024 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package exporter
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/Quarkonium-chain/go-quarkonium/data/basics"
+)
+
+// FileSink writes records to files in a directory. A new file is started
+// whenever the current one grows past the size limit, and every file is named
+// after the first round written to it, so that the files sort in round order.
+type FileSink struct {
+	dir     string
+	format  string
+	maxSize uint64
+
+	f    *os.File
+	size uint64
+}
+
+// MakeFileSink creates a FileSink writing to dir, which is created if needed.
+// A maxSize of 0 disables rotation.
+func MakeFileSink(dir string, format string, maxSize uint64) (*FileSink, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	return &FileSink{dir: dir, format: format, maxSize: maxSize}, nil
+}
+
+// Filename returns the name of the file holding the records from round rnd
+// onward.
+func (s *FileSink) Filename(rnd basics.Round) string {
+	ext := "ndjson"
+	if s.format == FormatMsgp {
+		ext = "msgp"
+	}
+	return filepath.Join(s.dir, fmt.Sprintf("export-%020d.%s", rnd, ext))
+}
+
+// Write implements Sink. The file is synced after every record.
+func (s *FileSink) Write(rec *Record) error {
+	data, err := encodeRecord(rec, s.format)
+	if err != nil {
+		return err
+	}
+	if s.f == nil || (s.maxSize > 0 && s.size > 0 && s.size+uint64(len(data)) > s.maxSize) {
+		if err := s.rotate(rec.Round); err != nil {
+			return err
+		}
+	}
+
+	_, err = s.f.Write(data)
+	if err == nil {
+		err = s.f.Sync()
+	}
+	if err != nil {
+		// drop whatever was written of the record, and reopen the file on
+		// the next write.
+		s.f.Truncate(int64(s.size))
+		s.f.Close()
+		s.f = nil
+		return err
+	}
+	s.size += uint64(len(data))
+	return nil
+}
+
+// rotate closes the current file, and opens the file starting at round rnd.
+// If that file already exists, after a restart, it is appended to.
+func (s *FileSink) rotate(rnd basics.Round) error {
+	if s.f != nil {
+		err := s.f.Close()
+		s.f = nil
+		if err != nil {
+			return err
+		}
+	}
+	f, err := os.OpenFile(s.Filename(rnd), os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0644)
+	if err != nil {
+		return err
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return err
+	}
+	s.f = f
+	s.size = uint64(info.Size())
+	return nil
+}
+
+// Close implements Sink.
+func (s *FileSink) Close() error {
+	if s.f == nil {
+		return nil
+	}
+	err := s.f.Close()
+	s.f = nil
+	return err
+}
@@ -0,0 +1,114 @@
+// Copyright (C) 2019-2024 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package exporter
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/algorand/go-codec/codec"
+
+	"github.com/Quarkonium-chain/go-quarkonium/config"
+	"github.com/Quarkonium-chain/go-quarkonium/ledger/eval"
+	"github.com/Quarkonium-chain/go-quarkonium/protocol"
+)
+
+// Sink receives the records of the exporter. Once Write returns without
+// error, the record is considered delivered and is not written again, unless
+// the exporter is replayed or restarted before persisting its cursor.
+type Sink interface {
+	Write(rec *Record) error
+	Close() error
+}
+
+// Formats of the records written by the built-in sinks.
+const (
+	// FormatJSON writes each record as a single line of JSON.
+	FormatJSON = "json"
+	// FormatMsgp writes each record as a msgpack object.
+	FormatMsgp = "msgp"
+)
+
+// Built-in sinks, as selected by config.Local.ExporterSink.
+const (
+	SinkFile = "file"
+	SinkUnix = "unix"
+)
+
+// jsonLineHandle is protocol.JSONStrictHandle, without indentation, so that
+// every record fits on a single line.
+var jsonLineHandle *codec.JsonHandle
+
+func init() {
+	jsonLineHandle = new(codec.JsonHandle)
+	jsonLineHandle.ErrorIfNoField = protocol.JSONStrictHandle.ErrorIfNoField
+	jsonLineHandle.ErrorIfNoArrayExpand = protocol.JSONStrictHandle.ErrorIfNoArrayExpand
+	jsonLineHandle.Canonical = protocol.JSONStrictHandle.Canonical
+	jsonLineHandle.RecursiveEmptyCheck = protocol.JSONStrictHandle.RecursiveEmptyCheck
+	jsonLineHandle.HTMLCharsAsIs = protocol.JSONStrictHandle.HTMLCharsAsIs
+	jsonLineHandle.MapKeyAsString = protocol.JSONStrictHandle.MapKeyAsString
+}
+
+// MakeSink creates the sink selected by cfg.
+func MakeSink(cfg config.Local, genesisDir string) (Sink, error) {
+	switch cfg.ExporterFormat {
+	case FormatJSON, FormatMsgp:
+	default:
+		return nil, fmt.Errorf("unknown exporter format %q", cfg.ExporterFormat)
+	}
+
+	switch cfg.ExporterSink {
+	case SinkFile:
+		dir := cfg.ExporterPath
+		if dir == "" {
+			dir = filepath.Join(genesisDir, "export")
+		}
+		return MakeFileSink(dir, cfg.ExporterFormat, cfg.ExporterFileMaxSize)
+	case SinkUnix:
+		if cfg.ExporterPath == "" {
+			return nil, fmt.Errorf("the %s exporter sink requires ExporterPath", SinkUnix)
+		}
+		return MakeSocketSink(cfg.ExporterPath, cfg.ExporterFormat), nil
+	default:
+		return nil, fmt.Errorf("unknown exporter sink %q", cfg.ExporterSink)
+	}
+}
+
+// encodeRecord encodes rec in format. JSON records end with a newline.
+func encodeRecord(rec *Record, format string) ([]byte, error) {
+	if format == FormatMsgp {
+		return protocol.EncodeReflect(rec), nil
+	}
+
+	if len(rec.Deltas) > 0 {
+		// Zero out the Txleases maps of a copy since they cannot be
+		// represented in JSON, as they are maps with an object key.
+		jsonRec := *rec
+		jsonRec.Deltas = make([]eval.TxnGroupDeltaWithIds, len(rec.Deltas))
+		copy(jsonRec.Deltas, rec.Deltas)
+		for i := range jsonRec.Deltas {
+			jsonRec.Deltas[i].Delta.Txleases = nil
+		}
+		rec = &jsonRec
+	}
+	var data []byte
+	enc := codec.NewEncoderBytes(&data, jsonLineHandle)
+	if err := enc.Encode(rec); err != nil {
+		return nil, fmt.Errorf("failed to encode record of round %d: %w", rec.Round, err)
+	}
+	return append(data, '\n'), nil
+}
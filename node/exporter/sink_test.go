@@ -0,0 +1,190 @@
+// Copyright (C) 2019-2024 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package exporter
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Quarkonium-chain/go-quarkonium/config"
+	"github.com/Quarkonium-chain/go-quarkonium/data/basics"
+	"github.com/Quarkonium-chain/go-quarkonium/logging"
+	"github.com/Quarkonium-chain/go-quarkonium/protocol"
+	"github.com/Quarkonium-chain/go-quarkonium/test/partitiontest"
+)
+
+func makeTestRecords(t *testing.T, rounds int) []Record {
+	ledger := makeMockLedger(t, rounds)
+	e := makeExporter(ledger, nil, "", 0, logging.TestingLog(t))
+	var recs []Record
+	for rnd := 0; rnd < rounds; rnd++ {
+		rec, err := e.makeRecord(basics.Round(rnd), true)
+		require.NoError(t, err)
+		recs = append(recs, rec)
+	}
+	return recs
+}
+
+func TestFileSinkJSON(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	recs := makeTestRecords(t, 4)
+	dir := t.TempDir()
+	sink, err := MakeFileSink(dir, FormatJSON, 0)
+	require.NoError(t, err)
+	for i := range recs {
+		require.NoError(t, sink.Write(&recs[i]))
+	}
+	require.NoError(t, sink.Close())
+
+	data, err := os.ReadFile(sink.Filename(0))
+	require.NoError(t, err)
+	lines := bytes.Split(bytes.TrimSuffix(data, []byte("\n")), []byte("\n"))
+	require.Len(t, lines, len(recs))
+	for i, line := range lines {
+		var decoded map[string]interface{}
+		require.NoError(t, json.Unmarshal(line, &decoded))
+		require.EqualValues(t, i, decoded["round"])
+
+		var rec Record
+		require.NoError(t, protocol.DecodeJSON(line, &rec))
+		require.Equal(t, recs[i].Block, rec.Block)
+		require.Len(t, rec.Deltas, len(recs[i].Deltas))
+	}
+}
+
+func TestFileSinkRotation(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	recs := makeTestRecords(t, 5)
+	dir := t.TempDir()
+	size := uint64(len(protocol.EncodeReflect(&recs[1])))
+	sink, err := MakeFileSink(dir, FormatMsgp, 2*size+1)
+	require.NoError(t, err)
+	for i := 1; i < len(recs); i++ {
+		require.NoError(t, sink.Write(&recs[i]))
+	}
+	require.NoError(t, sink.Close())
+
+	files, err := filepath.Glob(filepath.Join(dir, "export-*.msgp"))
+	require.NoError(t, err)
+	require.Equal(t, []string{sink.Filename(1), sink.Filename(3)}, files)
+
+	// every file holds consecutive msgpack records
+	expected := recs[1:]
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		require.NoError(t, err)
+		dec := protocol.NewDecoderBytes(data)
+		for {
+			var rec Record
+			if dec.Decode(&rec) != nil {
+				break
+			}
+			require.Equal(t, expected[0].Round, rec.Round)
+			require.Equal(t, expected[0].Deltas, rec.Deltas)
+			expected = expected[1:]
+		}
+	}
+	require.Empty(t, expected)
+
+	// a restarted sink appends to the file of the round it resumes from
+	sink, err = MakeFileSink(dir, FormatMsgp, 0)
+	require.NoError(t, err)
+	require.NoError(t, sink.Write(&recs[3]))
+	require.NoError(t, sink.Close())
+	info, err := os.Stat(sink.Filename(3))
+	require.NoError(t, err)
+	require.Greater(t, info.Size(), int64(2*size))
+}
+
+func TestSocketSink(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	recs := makeTestRecords(t, 3)
+	path := filepath.Join(t.TempDir(), "export.sock")
+	sink := MakeSocketSink(path, FormatJSON)
+
+	// nobody is listening yet
+	require.Error(t, sink.Write(&recs[0]))
+
+	listener, err := net.Listen("unix", path)
+	require.NoError(t, err)
+	defer listener.Close()
+	lines := make(chan string, len(recs))
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		scanner := bufio.NewScanner(conn)
+		scanner.Buffer(nil, 1<<20)
+		for scanner.Scan() {
+			lines <- scanner.Text()
+		}
+	}()
+
+	for i := range recs {
+		require.NoError(t, sink.Write(&recs[i]))
+	}
+	for i := range recs {
+		var rec Record
+		require.NoError(t, protocol.DecodeJSON([]byte(<-lines), &rec))
+		require.Equal(t, recs[i].Round, rec.Round)
+	}
+	require.NoError(t, sink.Close())
+}
+
+func TestMakeSink(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	dir := t.TempDir()
+	cfg := config.GetDefaultLocal()
+	sink, err := MakeSink(cfg, dir)
+	require.NoError(t, err)
+	require.IsType(t, &FileSink{}, sink)
+	require.DirExists(t, filepath.Join(dir, "export"))
+
+	cfg.ExporterSink = SinkUnix
+	_, err = MakeSink(cfg, dir)
+	require.ErrorContains(t, err, "requires ExporterPath")
+	cfg.ExporterPath = filepath.Join(dir, "export.sock")
+	sink, err = MakeSink(cfg, dir)
+	require.NoError(t, err)
+	require.IsType(t, &SocketSink{}, sink)
+
+	cfg.ExporterSink = "kafka"
+	_, err = MakeSink(cfg, dir)
+	require.ErrorContains(t, err, "unknown exporter sink")
+
+	cfg.ExporterSink = SinkFile
+	cfg.ExporterFormat = "xml"
+	_, err = MakeSink(cfg, dir)
+	require.ErrorContains(t, err, "unknown exporter format")
+}
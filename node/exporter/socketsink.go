@@ -0,0 +1,78 @@
+// Copyright (C) 2019-2024 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package exporter
+
+import (
+	"net"
+	"time"
+)
+
+// SocketWriteTimeout is the time allowed for connecting to the socket of a
+// SocketSink, and for writing a single record to it.
+var SocketWriteTimeout = 30 * time.Second
+
+// SocketSink writes records to a unix domain socket, which another process is
+// listening on. The connection is established on the first write, and again
+// after any failure.
+type SocketSink struct {
+	path   string
+	format string
+	conn   net.Conn
+}
+
+// MakeSocketSink creates a SocketSink connecting to the socket at path.
+func MakeSocketSink(path string, format string) *SocketSink {
+	return &SocketSink{path: path, format: format}
+}
+
+// Write implements Sink.
+func (s *SocketSink) Write(rec *Record) error {
+	data, err := encodeRecord(rec, s.format)
+	if err != nil {
+		return err
+	}
+	if s.conn == nil {
+		conn, err := net.DialTimeout("unix", s.path, SocketWriteTimeout)
+		if err != nil {
+			return err
+		}
+		s.conn = conn
+	}
+
+	err = s.conn.SetWriteDeadline(time.Now().Add(SocketWriteTimeout))
+	if err == nil {
+		_, err = s.conn.Write(data)
+	}
+	if err != nil {
+		// the reader can't tell where the partially written record ends, so
+		// it gets a new connection.
+		s.conn.Close()
+		s.conn = nil
+		return err
+	}
+	return nil
+}
+
+// Close implements Sink.
+func (s *SocketSink) Close() error {
+	if s.conn == nil {
+		return nil
+	}
+	err := s.conn.Close()
+	s.conn = nil
+	return err
+}
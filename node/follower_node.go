@@ -38,6 +38,7 @@ import (
 	"github.com/Quarkonium-chain/go-quarkonium/ledger/simulation"
 	"github.com/Quarkonium-chain/go-quarkonium/logging"
 	"github.com/Quarkonium-chain/go-quarkonium/network"
+	"github.com/Quarkonium-chain/go-quarkonium/node/exporter"
 	"github.com/Quarkonium-chain/go-quarkonium/protocol"
 	"github.com/Quarkonium-chain/go-quarkonium/rpcs"
 	"github.com/Quarkonium-chain/go-quarkonium/util/execpool"
@@ -53,9 +54,12 @@ type AlgorandFollowerNode struct {
 	ledger *data.Ledger
 	net    network.GossipNode
 
+	simulationSessions *simulation.Sessions
+
 	catchupService           *catchup.Service
 	catchpointCatchupService *catchup.CatchpointCatchupService
 	blockService             *rpcs.BlockService
+	exporter                 *exporter.Exporter
 
 	genesisDirs config.ResolvedGenesisDirs
 	genesisID   string
@@ -121,6 +125,7 @@ func MakeFollower(log logging.Logger, rootDir string, cfg config.Local, phoneboo
 		log.Errorf("Cannot initialize ledger (%v): %v", ledgerPaths, err)
 		return nil, err
 	}
+	node.simulationSessions = simulation.MakeSessions(node.ledger, cfg.EnableDeveloperAPI)
 
 	node.ledger.RegisterBlockListeners([]ledgercore.BlockListener{node})
 
@@ -132,6 +137,17 @@ func MakeFollower(log logging.Logger, rootDir string, cfg config.Local, phoneboo
 	node.catchupBlockAuth = blockAuthenticatorImpl{Ledger: node.ledger, AsyncVoteVerifier: agreement.MakeAsyncVoteVerifier(node.lowPriorityCryptoVerificationPool)}
 	node.catchupService = catchup.MakeService(node.log, node.config, p2pNode, node.ledger, node.catchupBlockAuth, make(chan catchup.PendingUnmatchedCertificate), node.lowPriorityCryptoVerificationPool)
 
+	if cfg.EnableExporter {
+		setSyncRound := func(rnd basics.Round) error {
+			return node.SetSyncRound(uint64(rnd))
+		}
+		node.exporter, err = exporter.MakeExporter(cfg, node.genesisDirs.RootGenesisDir, node.ledger, setSyncRound, node.log)
+		if err != nil {
+			log.Errorf("Cannot initialize exporter: %v", err)
+			return nil, err
+		}
+	}
+
 	// Initialize sync round to the latest db round + 1 so that nothing falls out of the cache on Start
 	err = node.SetSyncRound(uint64(node.Ledger().LatestTrackerCommitted() + 1))
 	if err != nil {
@@ -185,6 +201,10 @@ func (node *AlgorandFollowerNode) Start() error {
 		return nil
 	}
 
+	if node.exporter != nil {
+		node.exporter.Start()
+	}
+
 	var err error
 	if node.catchpointCatchupService != nil {
 		err = startNetwork()
@@ -216,6 +236,9 @@ func (node *AlgorandFollowerNode) Stop() {
 	if !node.config.DisableNetworking {
 		node.net.Stop()
 	}
+	if node.exporter != nil {
+		node.exporter.Stop()
+	}
 	if node.catchpointCatchupService != nil {
 		node.catchpointCatchupService.Stop()
 	} else {
@@ -255,6 +278,32 @@ func (node *AlgorandFollowerNode) Simulate(request simulation.Request) (result s
 	return simulator.Simulate(request)
 }
 
+// SimulationSessions returns the simulation sessions kept by the node, which
+// chain simulations on top of each other's state.
+func (node *AlgorandFollowerNode) SimulationSessions() *simulation.Sessions {
+	return node.simulationSessions
+}
+
+// PeerReputation returns no peers in follower mode
+func (node *AlgorandFollowerNode) PeerReputation() []network.PeerReputationEntry {
+	return nil
+}
+
+// ClearPeerReputation no-ops in follower mode
+func (node *AlgorandFollowerNode) ClearPeerReputation(_ string) bool {
+	return false
+}
+
+// ReplayExporter makes the exporter export every round from rnd again. It
+// returns false if the exporter is not enabled.
+func (node *AlgorandFollowerNode) ReplayExporter(rnd basics.Round) bool {
+	if node.exporter == nil {
+		return false
+	}
+	node.exporter.Replay(rnd)
+	return true
+}
+
 // GetPendingTransaction no-ops in follower mode
 func (node *AlgorandFollowerNode) GetPendingTransaction(_ transactions.Txid) (res TxnWithStatus, found bool) {
 	return
@@ -0,0 +1,194 @@
+// Copyright (C) 2019-2024 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package node
+
+import (
+	"context"
+	"sync"
+
+	"github.com/Quarkonium-chain/go-quarkonium/config"
+	"github.com/Quarkonium-chain/go-quarkonium/data/account"
+	"github.com/Quarkonium-chain/go-quarkonium/data/basics"
+	"github.com/Quarkonium-chain/go-quarkonium/data/bookkeeping"
+	"github.com/Quarkonium-chain/go-quarkonium/data/transactions"
+	"github.com/Quarkonium-chain/go-quarkonium/ledger/apply"
+	"github.com/Quarkonium-chain/go-quarkonium/ledger/ledgercore"
+	"github.com/Quarkonium-chain/go-quarkonium/logging"
+	"github.com/Quarkonium-chain/go-quarkonium/protocol"
+)
+
+// heartbeatValidity is the number of rounds a heartbeat stays valid. If it
+// has not been accepted by then, a new one is sent.
+const heartbeatValidity = 10
+
+// heartbeatParticipants captures the aspects of the AccountManager that are
+// used by the heartbeat service.
+type heartbeatParticipants interface {
+	Keys(rnd basics.Round) []account.ParticipationRecordForRound
+}
+
+// heartbeatLedger captures the aspects of the ledger that are used by the
+// heartbeat service.
+type heartbeatLedger interface {
+	Latest() basics.Round
+	Wait(basics.Round) chan struct{}
+	BlockHdr(basics.Round) (bookkeeping.BlockHeader, error)
+	LookupAccount(basics.Round, basics.Address) (ledgercore.AccountData, basics.Round, basics.MicroAlgos, error)
+}
+
+// heartbeatBroadcaster captures the node's ability to broadcast a new
+// transaction.
+type heartbeatBroadcaster interface {
+	BroadcastInternalSignedTxGroup([]transactions.SignedTxn) error
+}
+
+// heartbeatService sends free heartbeats for the online accounts that this
+// node has participation keys for, whenever one of them is challenged, so
+// that it is not suspended for being absent.
+type heartbeatService struct {
+	accts  heartbeatParticipants
+	ledger heartbeatLedger
+	bcast  heartbeatBroadcaster
+	log    logging.Logger
+
+	ctx      context.Context
+	shutdown context.CancelFunc
+	wg       sync.WaitGroup
+}
+
+func makeHeartbeatService(accts heartbeatParticipants, ledger heartbeatLedger, bcast heartbeatBroadcaster, log logging.Logger) *heartbeatService {
+	return &heartbeatService{
+		accts:  accts,
+		ledger: ledger,
+		bcast:  bcast,
+		log:    log,
+	}
+}
+
+// Start starts the goroutine of the heartbeat service.
+func (hs *heartbeatService) Start() {
+	hs.ctx, hs.shutdown = context.WithCancel(context.Background())
+	hs.wg.Add(1)
+	go hs.loop()
+}
+
+// Stop stops the goroutine of the heartbeat service, and waits for it to exit.
+func (hs *heartbeatService) Stop() {
+	hs.shutdown()
+	hs.wg.Wait()
+}
+
+// findChallenged returns the participation records of the online accounts
+// that have been challenged in the round current, and have not been seen
+// since.
+func (hs *heartbeatService) findChallenged(rules config.ProposerPayoutRules, current basics.Round) []account.ParticipationRecordForRound {
+	ch, err := apply.FindChallenge(rules, current, hs.ledger, apply.ChRisky)
+	if err != nil {
+		hs.log.Warnf("heartbeat service could not find the challenge of round %d: %v", current, err)
+		return nil
+	}
+	if ch.IsZero() {
+		return nil
+	}
+
+	var found []account.ParticipationRecordForRound
+	for _, pr := range hs.accts.Keys(current + 1) {
+		acct, _, _, err := hs.ledger.LookupAccount(current, pr.Account)
+		if err != nil {
+			hs.log.Warnf("heartbeat service could not look up %v: %v", pr.Account, err)
+			continue
+		}
+		if pr.Voting == nil || acct.Status != basics.Online || acct.VoteID != pr.Voting.OneTimeSignatureVerifier {
+			continue
+		}
+		if ch.Failed(pr.Account, acct.LastSeen()) {
+			found = append(found, pr)
+		}
+	}
+	return found
+}
+
+// prepareHeartbeat creates a free heartbeat for the account of pr, to be
+// evaluated in the rounds after latest.
+func (hs *heartbeatService) prepareHeartbeat(pr account.ParticipationRecordForRound, latest *bookkeeping.BlockHeader) transactions.SignedTxn {
+	var stxn transactions.SignedTxn
+	stxn.Txn.Type = protocol.HeartbeatTx
+	stxn.Txn.Header = transactions.Header{
+		Sender:      pr.Account,
+		FirstValid:  latest.Round + 1,
+		LastValid:   min(latest.Round+heartbeatValidity, pr.LastValid),
+		GenesisHash: latest.GenesisHash,
+	}
+
+	id := basics.OneTimeIDForRound(stxn.Txn.LastValid, pr.KeyDilution)
+	stxn.Txn.HeartbeatTxnFields = &transactions.HeartbeatTxnFields{
+		HbAddress:     pr.Account,
+		HbProof:       pr.Voting.Sign(id, latest.Seed).ToHeartbeatProof(),
+		HbSeed:        latest.Seed,
+		HbVoteID:      pr.Voting.OneTimeSignatureVerifier,
+		HbKeyDilution: pr.KeyDilution,
+	}
+	return stxn
+}
+
+// loop waits for every new round, and sends a heartbeat for each of the
+// node's challenged accounts. A heartbeat is not sent again for an account
+// until the previous one has expired, since the account is no longer
+// challenged once its heartbeat is accepted.
+func (hs *heartbeatService) loop() {
+	defer hs.wg.Done()
+	suppress := make(map[basics.Address]basics.Round)
+	latest := hs.ledger.Latest()
+	for {
+		select {
+		case <-hs.ctx.Done():
+			return
+		case <-hs.ledger.Wait(latest + 1):
+		}
+
+		latest = hs.ledger.Latest()
+		hdr, err := hs.ledger.BlockHdr(latest)
+		if err != nil {
+			hs.log.Warnf("heartbeat service could not fetch block header for round %d: %v", latest, err)
+			continue
+		}
+		proto := config.Consensus[hdr.CurrentProtocol]
+		if !proto.Heartbeat {
+			continue
+		}
+
+		for addr, lastValid := range suppress {
+			if lastValid <= latest {
+				delete(suppress, addr)
+			}
+		}
+
+		for _, pr := range hs.findChallenged(proto.Payouts, latest) {
+			if _, ok := suppress[pr.Account]; ok {
+				continue
+			}
+			stxn := hs.prepareHeartbeat(pr, &hdr)
+			hs.log.Infof("sending heartbeat for %v in round %d", pr.Account, stxn.Txn.FirstValid)
+			err = hs.bcast.BroadcastInternalSignedTxGroup([]transactions.SignedTxn{stxn})
+			if err != nil {
+				hs.log.Warnf("heartbeat service could not broadcast heartbeat for %v: %v", pr.Account, err)
+				continue
+			}
+			suppress[pr.Account] = stxn.Txn.LastValid
+		}
+	}
+}
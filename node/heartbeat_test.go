@@ -0,0 +1,261 @@
+// Copyright (C) 2019-2024 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package node
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Quarkonium-chain/go-quarkonium/config"
+	"github.com/Quarkonium-chain/go-quarkonium/crypto"
+	"github.com/Quarkonium-chain/go-quarkonium/data/account"
+	"github.com/Quarkonium-chain/go-quarkonium/data/basics"
+	"github.com/Quarkonium-chain/go-quarkonium/data/bookkeeping"
+	"github.com/Quarkonium-chain/go-quarkonium/data/committee"
+	"github.com/Quarkonium-chain/go-quarkonium/data/transactions"
+	"github.com/Quarkonium-chain/go-quarkonium/ledger/ledgercore"
+	"github.com/Quarkonium-chain/go-quarkonium/logging"
+	"github.com/Quarkonium-chain/go-quarkonium/protocol"
+	"github.com/Quarkonium-chain/go-quarkonium/test/partitiontest"
+)
+
+type mockHbParticipants []account.ParticipationRecordForRound
+
+func (mp mockHbParticipants) Keys(rnd basics.Round) []account.ParticipationRecordForRound {
+	var keys []account.ParticipationRecordForRound
+	for _, pr := range mp {
+		if pr.FirstValid <= rnd && rnd <= pr.LastValid {
+			keys = append(keys, pr)
+		}
+	}
+	return keys
+}
+
+// mockHbLedger is a ledger of the future protocol whose block seeds all
+// begin with challengeSeed, so every challenge matches addresses that begin
+// with it.
+type mockHbLedger struct {
+	mu            sync.Mutex
+	latest        basics.Round
+	waiters       map[basics.Round]chan struct{}
+	accounts      map[basics.Address]ledgercore.AccountData
+	challengeSeed byte
+}
+
+func (ml *mockHbLedger) Latest() basics.Round {
+	ml.mu.Lock()
+	defer ml.mu.Unlock()
+	return ml.latest
+}
+
+func (ml *mockHbLedger) Wait(r basics.Round) chan struct{} {
+	ml.mu.Lock()
+	defer ml.mu.Unlock()
+	if ml.waiters == nil {
+		ml.waiters = make(map[basics.Round]chan struct{})
+	}
+	ch, ok := ml.waiters[r]
+	if !ok {
+		ch = make(chan struct{})
+		ml.waiters[r] = ch
+	}
+	if r <= ml.latest {
+		select {
+		case <-ch:
+		default:
+			close(ch)
+		}
+	}
+	return ch
+}
+
+func (ml *mockHbLedger) advance() {
+	ml.mu.Lock()
+	ml.latest++
+	latest := ml.latest
+	ml.mu.Unlock()
+	ml.Wait(latest)
+}
+
+func (ml *mockHbLedger) BlockHdr(r basics.Round) (bookkeeping.BlockHeader, error) {
+	if r > ml.Latest() {
+		return bookkeeping.BlockHeader{}, fmt.Errorf("round %d is not available", r)
+	}
+	return bookkeeping.BlockHeader{
+		Round:        r,
+		Seed:         committee.Seed{ml.challengeSeed, byte(r), byte(r >> 8)},
+		GenesisHash:  crypto.Digest{0x01},
+		UpgradeState: bookkeeping.UpgradeState{CurrentProtocol: protocol.ConsensusFuture},
+	}, nil
+}
+
+func (ml *mockHbLedger) LookupAccount(r basics.Round, addr basics.Address) (ledgercore.AccountData, basics.Round, basics.MicroAlgos, error) {
+	ml.mu.Lock()
+	defer ml.mu.Unlock()
+	return ml.accounts[addr], r, basics.MicroAlgos{}, nil
+}
+
+type mockHbBroadcaster struct {
+	txns chan transactions.SignedTxn
+}
+
+func (mb mockHbBroadcaster) BroadcastInternalSignedTxGroup(txgroup []transactions.SignedTxn) error {
+	for _, stxn := range txgroup {
+		mb.txns <- stxn
+	}
+	return nil
+}
+
+func makeHbParticipant(addr basics.Address, lastValid basics.Round) account.ParticipationRecordForRound {
+	var pr account.ParticipationRecordForRound
+	pr.Account = addr
+	pr.LastValid = lastValid
+	pr.KeyDilution = 100
+	pr.Voting = crypto.GenerateOneTimeSignatureSecrets(0, uint64(lastValid)/pr.KeyDilution+1)
+	return pr
+}
+
+func TestHeartbeatFindChallenged(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	rules := config.Consensus[protocol.ConsensusFuture].Payouts
+	interval := basics.Round(rules.ChallengeInterval)
+
+	challenged := makeHbParticipant(basics.Address{0xaa}, 5000)
+	seen := makeHbParticipant(basics.Address{0xaa, 0x01}, 5000)
+	offline := makeHbParticipant(basics.Address{0xaa, 0x02}, 5000)
+	rekeyed := makeHbParticipant(basics.Address{0xaa, 0x03}, 5000)
+	unchallenged := makeHbParticipant(basics.Address{0x55}, 5000)
+
+	online := func(pr account.ParticipationRecordForRound, lastSeen basics.Round) ledgercore.AccountData {
+		var ad ledgercore.AccountData
+		ad.Status = basics.Online
+		ad.VoteID = pr.Voting.OneTimeSignatureVerifier
+		ad.LastProposed = lastSeen
+		return ad
+	}
+	ledger := &mockHbLedger{
+		latest:        interval + 5,
+		challengeSeed: 0xaa,
+		accounts: map[basics.Address]ledgercore.AccountData{
+			challenged.Account:   online(challenged, 1),
+			seen.Account:         online(seen, interval+1),
+			offline.Account:      {},
+			rekeyed.Account:      online(seen, 1),
+			unchallenged.Account: online(unchallenged, 1),
+		},
+	}
+	accts := mockHbParticipants{challenged, seen, offline, rekeyed, unchallenged}
+	hs := makeHeartbeatService(accts, ledger, nil, logging.TestingLog(t))
+
+	found := hs.findChallenged(rules, interval+5)
+	require.Len(t, found, 1)
+	require.Equal(t, challenged.Account, found[0].Account)
+
+	// nothing is found outside of the first grace period
+	require.Empty(t, hs.findChallenged(rules, interval-5))
+	require.Empty(t, hs.findChallenged(rules, interval+basics.Round(rules.ChallengeGracePeriod)+1))
+}
+
+func TestHeartbeatPrepare(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	pr := makeHbParticipant(basics.Address{0xaa}, 1000)
+	ledger := &mockHbLedger{latest: 500, challengeSeed: 0xaa}
+	hs := makeHeartbeatService(nil, ledger, nil, logging.TestingLog(t))
+
+	hdr, err := ledger.BlockHdr(500)
+	require.NoError(t, err)
+	stxn := hs.prepareHeartbeat(pr, &hdr)
+	require.Equal(t, protocol.HeartbeatTx, stxn.Txn.Type)
+	require.Equal(t, pr.Account, stxn.Txn.Sender)
+	require.Zero(t, stxn.Txn.Fee)
+	require.Equal(t, basics.Round(501), stxn.Txn.FirstValid)
+	require.Equal(t, basics.Round(500+heartbeatValidity), stxn.Txn.LastValid)
+	require.Equal(t, hdr.GenesisHash, stxn.Txn.GenesisHash)
+
+	hb := stxn.Txn.HeartbeatTxnFields
+	require.NotNil(t, hb)
+	require.Equal(t, pr.Account, hb.HbAddress)
+	require.Equal(t, hdr.Seed, hb.HbSeed)
+	require.Equal(t, pr.KeyDilution, hb.HbKeyDilution)
+	id := basics.OneTimeIDForRound(stxn.Txn.LastValid, hb.HbKeyDilution)
+	require.True(t, hb.HbVoteID.Verify(id, hb.HbSeed, hb.HbProof.ToOneTimeSignature()))
+
+	// the heartbeat does not outlive the participation keys
+	ledger.latest = 995
+	hdr, err = ledger.BlockHdr(995)
+	require.NoError(t, err)
+	stxn = hs.prepareHeartbeat(pr, &hdr)
+	require.Equal(t, pr.LastValid, stxn.Txn.LastValid)
+}
+
+func TestHeartbeatService(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	rules := config.Consensus[protocol.ConsensusFuture].Payouts
+	interval := basics.Round(rules.ChallengeInterval)
+
+	pr := makeHbParticipant(basics.Address{0xaa}, 5000)
+	var ad ledgercore.AccountData
+	ad.Status = basics.Online
+	ad.VoteID = pr.Voting.OneTimeSignatureVerifier
+	ledger := &mockHbLedger{
+		latest:        interval - 1,
+		challengeSeed: 0xaa,
+		accounts:      map[basics.Address]ledgercore.AccountData{pr.Account: ad},
+	}
+	bcast := mockHbBroadcaster{txns: make(chan transactions.SignedTxn, 10)}
+	hs := makeHeartbeatService(mockHbParticipants{pr}, ledger, bcast, logging.TestingLog(t))
+	hs.Start()
+	defer hs.Stop()
+
+	// the challenge round itself does not trigger a heartbeat
+	ledger.advance()
+	select {
+	case stxn := <-bcast.txns:
+		require.Failf(t, "unexpected heartbeat", "%+v", stxn)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	ledger.advance()
+	var stxn transactions.SignedTxn
+	select {
+	case stxn = <-bcast.txns:
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "no heartbeat was sent")
+	}
+	require.Equal(t, pr.Account, stxn.Txn.HeartbeatTxnFields.HbAddress)
+	require.Equal(t, interval+2, stxn.Txn.FirstValid)
+
+	// no new heartbeat while the first one is still valid
+	for i := 0; i < heartbeatValidity-1; i++ {
+		ledger.advance()
+	}
+	select {
+	case stxn := <-bcast.txns:
+		require.Failf(t, "unexpected heartbeat", "%+v", stxn)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
@@ -48,8 +48,10 @@ import (
 	"github.com/Quarkonium-chain/go-quarkonium/ledger/simulation"
 	"github.com/Quarkonium-chain/go-quarkonium/logging"
 	"github.com/Quarkonium-chain/go-quarkonium/network"
+	"github.com/Quarkonium-chain/go-quarkonium/network/messagerecorder"
 	"github.com/Quarkonium-chain/go-quarkonium/network/messagetracer"
 	"github.com/Quarkonium-chain/go-quarkonium/network/p2p"
+	"github.com/Quarkonium-chain/go-quarkonium/node/exporter"
 	"github.com/Quarkonium-chain/go-quarkonium/protocol"
 	"github.com/Quarkonium-chain/go-quarkonium/rpcs"
 	"github.com/Quarkonium-chain/go-quarkonium/stateproof"
@@ -118,6 +120,8 @@ type AlgorandFullNode struct {
 	ledger *data.Ledger
 	net    network.GossipNode
 
+	simulationSessions *simulation.Sessions
+
 	transactionPool *pools.TransactionPool
 	txHandler       *data.TxHandler
 	accountManager  *data.AccountManager
@@ -128,6 +132,8 @@ type AlgorandFullNode struct {
 	blockService             *rpcs.BlockService
 	ledgerService            *rpcs.LedgerService
 	txPoolSyncerService      *rpcs.TxSyncer
+	reputation               *network.PeerReputation
+	recorder                 *messagerecorder.Recorder
 
 	genesisDirs     config.ResolvedGenesisDirs
 	genesisID       string
@@ -154,6 +160,8 @@ type AlgorandFullNode struct {
 	tracer messagetracer.MessageTracer
 
 	stateProofWorker *stateproof.Worker
+	heartbeatService *heartbeatService
+	exporter         *exporter.Exporter
 	partHandles      []db.Accessor
 }
 
@@ -198,19 +206,37 @@ func MakeFull(log logging.Logger, rootDir string, cfg config.Local, phonebookAdd
 	}
 
 	// tie network, block fetcher, and agreement services together
+	node.reputation = network.MakePeerReputation(node.log, cfg, filepath.Join(node.genesisDirs.RootGenesisDir, network.PeerReputationFilename))
+	if cfg.EnableMessageRecorder {
+		var recording *messagerecorder.Writer
+		recording, err = messagerecorder.MakeWriter(filepath.Join(node.genesisDirs.HotGenesisDir, messagerecorder.Filename), cfg.MessageRecorderFileSizeLimit, int(cfg.MessageRecorderArchives))
+		if err != nil {
+			log.Errorf("Cannot create the message recording: %v", err)
+			return nil, err
+		}
+		node.recorder = messagerecorder.MakeRecorder(node.log, recording)
+	}
 	var p2pNode network.GossipNode
 	if cfg.EnableP2PHybridMode {
-		p2pNode, err = network.NewHybridP2PNetwork(node.log, node.config, rootDir, phonebookAddresses, genesis.ID(), genesis.Network, node)
+		var hybridNode *network.HybridP2PNetwork
+		hybridNode, err = network.NewHybridP2PNetwork(node.log, node.config, rootDir, phonebookAddresses, genesis.ID(), genesis.Network, node)
 		if err != nil {
 			log.Errorf("could not create hybrid p2p node: %v", err)
 			return nil, err
 		}
+		hybridNode.SetPeerReputation(node.reputation)
+		hybridNode.SetMessageRecorder(node.recorder)
+		p2pNode = hybridNode
 	} else if cfg.EnableP2P {
-		p2pNode, err = network.NewP2PNetwork(node.log, node.config, rootDir, phonebookAddresses, genesis.ID(), genesis.Network, node, nil)
+		var p2pNet *network.P2PNetwork
+		p2pNet, err = network.NewP2PNetwork(node.log, node.config, rootDir, phonebookAddresses, genesis.ID(), genesis.Network, node, nil)
 		if err != nil {
 			log.Errorf("could not create p2p node: %v", err)
 			return nil, err
 		}
+		p2pNet.SetPeerReputation(node.reputation)
+		p2pNet.SetMessageRecorder(node.recorder)
+		p2pNode = p2pNet
 	} else {
 		var wsNode *network.WebsocketNetwork
 		wsNode, err = network.NewWebsocketNetwork(node.log, node.config, phonebookAddresses, genesis.ID(), genesis.Network, node, nil)
@@ -219,6 +245,8 @@ func MakeFull(log logging.Logger, rootDir string, cfg config.Local, phonebookAdd
 			return nil, err
 		}
 		wsNode.SetPrioScheme(node)
+		wsNode.SetPeerReputation(node.reputation)
+		wsNode.SetMessageRecorder(node.recorder)
 		p2pNode = wsNode
 	}
 	node.net = p2pNode
@@ -235,6 +263,7 @@ func MakeFull(log logging.Logger, rootDir string, cfg config.Local, phonebookAdd
 		log.Errorf("Cannot initialize ledger (%v): %v", ledgerPaths, err)
 		return nil, err
 	}
+	node.simulationSessions = simulation.MakeSessions(node.ledger, cfg.EnableDeveloperAPI)
 
 	registry, err := ensureParticipationDB(node.genesisDirs.ColdGenesisDir, node.log)
 	if err != nil {
@@ -337,6 +366,15 @@ func MakeFull(log logging.Logger, rootDir string, cfg config.Local, phonebookAdd
 	gossip.SetTrace(agreementParameters.Network, node.tracer)
 
 	node.stateProofWorker = stateproof.NewWorker(node.genesisDirs.StateproofGenesisDir, node.log, node.accountManager, node.ledger.Ledger, node.net, node)
+	node.heartbeatService = makeHeartbeatService(node.accountManager, node.ledger.Ledger, node, node.log)
+
+	if cfg.EnableExporter {
+		node.exporter, err = exporter.MakeExporter(cfg, node.genesisDirs.RootGenesisDir, node.ledger, nil, node.log)
+		if err != nil {
+			log.Errorf("Cannot initialize exporter: %v", err)
+			return nil, err
+		}
+	}
 
 	return node, err
 }
@@ -369,6 +407,10 @@ func (node *AlgorandFullNode) Start() error {
 		return nil
 	}
 
+	if node.exporter != nil {
+		node.exporter.Start()
+	}
+
 	if node.catchpointCatchupService != nil {
 		startNetwork()
 		node.catchpointCatchupService.Start(node.ctx)
@@ -380,6 +422,7 @@ func (node *AlgorandFullNode) Start() error {
 		node.ledgerService.Start()
 		node.txHandler.Start()
 		node.stateProofWorker.Start()
+		node.heartbeatService.Start()
 		err := startNetwork()
 		if err != nil {
 			return err
@@ -456,9 +499,14 @@ func (node *AlgorandFullNode) Stop() {
 	if !node.config.DisableNetworking {
 		node.net.Stop()
 	}
+	node.recorder.Close()
+	if node.exporter != nil {
+		node.exporter.Stop()
+	}
 	if node.catchpointCatchupService != nil {
 		node.catchpointCatchupService.Stop()
 	} else {
+		node.heartbeatService.Stop()
 		node.stateProofWorker.Stop()
 		node.txHandler.Stop()
 		node.agreementService.Shutdown()
@@ -618,6 +666,12 @@ func (node *AlgorandFullNode) Simulate(request simulation.Request) (result simul
 	return simulator.Simulate(request)
 }
 
+// SimulationSessions returns the simulation sessions kept by the node, which
+// chain simulations on top of each other's state.
+func (node *AlgorandFullNode) SimulationSessions() *simulation.Sessions {
+	return node.simulationSessions
+}
+
 // GetPendingTransaction looks for the required txID in the recent ledger
 // blocks, in the txpool, and in the txpool's status cache.  It returns
 // the SignedTxn (with status information), and a bool to indicate if the
@@ -1193,6 +1247,30 @@ func (node *AlgorandFullNode) AbortCatchup(catchpoint string) error {
 	return nil
 }
 
+// PeerReputation returns the reputation of the peers that misbehaved recently, banned peers first.
+// this function is intended to be called externally via the REST api interface.
+func (node *AlgorandFullNode) PeerReputation() []network.PeerReputationEntry {
+	return node.reputation.Entries()
+}
+
+// ClearPeerReputation forgets the misbehavior of a peer, lifting its ban.
+// It returns false if the peer is not known.
+// this function is intended to be called externally via the REST api interface.
+func (node *AlgorandFullNode) ClearPeerReputation(peer string) bool {
+	return node.reputation.Clear(peer)
+}
+
+// ReplayExporter makes the exporter export every round from rnd again. It
+// returns false if the exporter is not enabled.
+// this function is intended to be called externally via the REST api interface.
+func (node *AlgorandFullNode) ReplayExporter(rnd basics.Round) bool {
+	if node.exporter == nil {
+		return false
+	}
+	node.exporter.Replay(rnd)
+	return true
+}
+
 // SetCatchpointCatchupMode change the node's operational mode from catchpoint catchup mode and back, it returns a
 // channel which contains the updated node context. This function need to work asynchronously so that the caller could
 // detect and handle the use case where the node is being shut down while we're switching to/from catchup mode without
@@ -1220,6 +1298,7 @@ func (node *AlgorandFullNode) SetCatchpointCatchupMode(catchpointCatchupMode boo
 			}()
 			node.net.ClearHandlers()
 			node.net.ClearValidatorHandlers()
+			node.heartbeatService.Stop()
 			node.stateProofWorker.Stop()
 			node.txHandler.Stop()
 			node.agreementService.Shutdown()
@@ -1248,6 +1327,7 @@ func (node *AlgorandFullNode) SetCatchpointCatchupMode(catchpointCatchupMode boo
 		node.ledgerService.Start()
 		node.txHandler.Start()
 		node.stateProofWorker.Start()
+		node.heartbeatService.Start()
 
 		// Set up a context we can use to cancel goroutines on Stop()
 		node.ctx, node.cancelCtx = context.WithCancel(context.Background())
@@ -41,6 +41,7 @@ import (
 	"github.com/Quarkonium-chain/go-quarkonium/data/transactions"
 	"github.com/Quarkonium-chain/go-quarkonium/logging"
 	"github.com/Quarkonium-chain/go-quarkonium/network"
+	"github.com/Quarkonium-chain/go-quarkonium/network/messagerecorder"
 	"github.com/Quarkonium-chain/go-quarkonium/network/p2p"
 	"github.com/Quarkonium-chain/go-quarkonium/protocol"
 	"github.com/Quarkonium-chain/go-quarkonium/stateproof"
@@ -650,6 +651,7 @@ func TestConfiguredDataDirs(t *testing.T) {
 	cfg.ColdDataDir = testDirCold
 	cfg.CatchpointTracking = 2
 	cfg.CatchpointInterval = 1
+	cfg.EnableMessageRecorder = true
 
 	// the logger is set up by the server, so we don't test this here
 	log := logging.Base()
@@ -663,6 +665,9 @@ func TestConfiguredDataDirs(t *testing.T) {
 	// confirm hot data dir exists and contains a genesis dir
 	require.DirExists(t, filepath.Join(testDirHot, genesis.ID()))
 
+	// confirm the message recording is in the genesis dir of hot data dir
+	require.FileExists(t, filepath.Join(testDirHot, genesis.ID(), messagerecorder.Filename))
+
 	// confirm the tracker is in the genesis dir of hot data dir
 	require.FileExists(t, filepath.Join(testDirHot, genesis.ID(), "ledger.tracker.sqlite"))
 
@@ -804,6 +809,9 @@ func TestMaxSizesCorrect(t *testing.T) {
 	require.Equal(t, spSize, protocol.StateProofSigTag.MaxMessageSize())
 	msSize := uint64(crypto.DigestMaxSize())
 	require.Equal(t, msSize, protocol.MsgDigestSkipTag.MaxMessageSize())
+	invSize := uint64(network.TxInventoryMsgMaxSize())
+	require.Equal(t, invSize, protocol.TxnAnnounceTag.MaxMessageSize())
+	require.Equal(t, invSize, protocol.TxnRequestTag.MaxMessageSize())
 
 	// We want to check that the TxnTag's max size is big enough, but it is
 	// foolish to try to be exact here.  We will confirm that it is bigger that
@@ -63,6 +63,7 @@ const (
 	Seed                             HashID = "SD"
 	SpecialAddr                      HashID = "SpecialAddr"
 	SignedTxnInBlock                 HashID = "STIB"
+	StateTrieNode                    HashID = "STN"
 
 	StateProofCoin    HashID = "spc"
 	StateProofMessage HashID = "spm"
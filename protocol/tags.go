@@ -37,6 +37,8 @@ const (
 	PingReplyTag         Tag = "pj" // was removed in 3.2.1
 	ProposalPayloadTag   Tag = "PP"
 	StateProofSigTag     Tag = "SP"
+	TxnAnnounceTag       Tag = "TA"
+	TxnRequestTag        Tag = "TR"
 	TopicMsgRespTag      Tag = "TS"
 	TxnTag               Tag = "TX"
 	//UniCatchupReqTag   Tag = "UC" was replaced by UniEnsBlockReqTag
@@ -54,7 +56,7 @@ const (
 const AgreementVoteTagMaxSize = 1228
 
 // MsgOfInterestTagMaxSize is the maximum size of a MsgOfInterestTag message
-const MsgOfInterestTagMaxSize = 45
+const MsgOfInterestTagMaxSize = 51
 
 // MsgDigestSkipTagMaxSize is the maximum size of a MsgDigestSkipTag message
 const MsgDigestSkipTagMaxSize = 69
@@ -72,6 +74,14 @@ const ProposalPayloadTagMaxSize = 5250313
 // StateProofSigTagMaxSize is the maximum size of a StateProofSigTag message
 const StateProofSigTagMaxSize = 6378
 
+// TxnAnnounceTagMaxSize is the maximum size of a TxnAnnounceTag message,
+// which is at most 256 transaction group digests of 32 bytes each
+const TxnAnnounceTagMaxSize = 256 * 32
+
+// TxnRequestTagMaxSize is the maximum size of a TxnRequestTag message,
+// which is at most 256 transaction group digests of 32 bytes each
+const TxnRequestTagMaxSize = 256 * 32
+
 // TopicMsgRespTagMaxSize is the maximum size of a TopicMsgRespTag message
 // This is a response to a topic message request (either UE or MI) and the largest possible
 // response is the largest possible block.
@@ -120,6 +130,10 @@ func (tag Tag) MaxMessageSize() uint64 {
 		return ProposalPayloadTagMaxSize
 	case StateProofSigTag:
 		return StateProofSigTagMaxSize
+	case TxnAnnounceTag:
+		return TxnAnnounceTagMaxSize
+	case TxnRequestTag:
+		return TxnRequestTagMaxSize
 	case TopicMsgRespTag:
 		return TopicMsgRespTagMaxSize
 	case TxnTag:
@@ -142,6 +156,8 @@ var TagList = []Tag{
 	NetPrioResponseTag,
 	ProposalPayloadTag,
 	StateProofSigTag,
+	TxnAnnounceTag,
+	TxnRequestTag,
 	TopicMsgRespTag,
 	TxnTag,
 	UniEnsBlockReqTag,
@@ -285,6 +285,8 @@ func TestLockdownTagList(t *testing.T) {
 		NetPrioResponseTag,
 		ProposalPayloadTag,
 		StateProofSigTag,
+		TxnAnnounceTag,
+		TxnRequestTag,
 		TopicMsgRespTag,
 		TxnTag,
 		UniEnsBlockReqTag,
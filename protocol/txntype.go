@@ -47,6 +47,9 @@ const (
 	// StateProofTx records a state proof
 	StateProofTx TxType = "stpf"
 
+	// HeartbeatTx demonstrates that an online account's participation keys are live
+	HeartbeatTx TxType = "hb"
+
 	// UnknownTx signals an error
 	UnknownTx TxType = "unknown"
 )
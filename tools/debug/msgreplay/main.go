@@ -0,0 +1,271 @@
+// Copyright (C) 2019-2024 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"compress/gzip"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+	"github.com/gofrs/flock"
+
+	"github.com/Quarkonium-chain/go-quarkonium/config"
+	"github.com/Quarkonium-chain/go-quarkonium/data/bookkeeping"
+	"github.com/Quarkonium-chain/go-quarkonium/logging"
+	"github.com/Quarkonium-chain/go-quarkonium/network"
+	"github.com/Quarkonium-chain/go-quarkonium/network/messagerecorder"
+	"github.com/Quarkonium-chain/go-quarkonium/node"
+	"github.com/Quarkonium-chain/go-quarkonium/protocol"
+)
+
+var dataDirectory = flag.String("d", "", "Data directory of the node to replay the recording to, which is run in-process without any other peer")
+var tags = flag.String("tags", "AV,PP,TX,VB,SP", "Comma-separated list of tags to replay, or * for all")
+var speed = flag.Float64("speed", 1, "Replay speed relative to the recording, or 0 to replay without waiting between messages")
+var dumpFlag = flag.Bool("dump", false, "Print the recorded messages instead of replaying them")
+var connectTimeout = flag.Duration("timeout", 30*time.Second, "Time to wait for the node to connect to the replay peer")
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage: %s [flags] recording...\n", os.Args[0])
+	fmt.Fprintf(os.Stderr, "A recording is the network.rec file written by a node with EnableMessageRecorder, and its rotated archives are read along with it.\n")
+	flag.PrintDefaults()
+}
+
+// openRecording returns a reader of the recordings at paths, each with its archives oldest first
+func openRecording(paths []string) (*messagerecorder.Reader, func(), error) {
+	var readers []io.Reader
+	var files []*os.File
+	closeFiles := func() {
+		for _, f := range files {
+			f.Close()
+		}
+	}
+	for _, path := range paths {
+		recFiles := messagerecorder.Files(path)
+		if len(recFiles) == 0 {
+			closeFiles()
+			return nil, nil, fmt.Errorf("recording %s not found", path)
+		}
+		for _, name := range recFiles {
+			f, err := os.Open(name)
+			if err != nil {
+				closeFiles()
+				return nil, nil, err
+			}
+			files = append(files, f)
+			if !strings.HasSuffix(name, ".gz") {
+				readers = append(readers, f)
+				continue
+			}
+			gz, err := gzip.NewReader(f)
+			if err != nil {
+				closeFiles()
+				return nil, nil, fmt.Errorf("%s: %w", name, err)
+			}
+			readers = append(readers, gz)
+		}
+	}
+	return messagerecorder.MakeReader(io.MultiReader(readers...)), closeFiles, nil
+}
+
+func tagFilter() map[protocol.Tag]bool {
+	if *tags == "*" {
+		return nil
+	}
+	filter := make(map[protocol.Tag]bool)
+	for _, t := range strings.Split(*tags, ",") {
+		filter[protocol.Tag(t)] = true
+	}
+	return filter
+}
+
+func dump(ctx context.Context, src *messagerecorder.Reader, filter map[protocol.Tag]bool) error {
+	return messagerecorder.Replay(ctx, src, 0, func(rec messagerecorder.Record) error {
+		if filter != nil && !filter[rec.Tag] {
+			return nil
+		}
+		peer := rec.Peer
+		if peer == "" {
+			peer = "*"
+		}
+		fmt.Printf("%s %-3s %s %s [%d bytes]\n", rec.Time.Format("2006-01-02 15:04:05.000000"), rec.Direction, peer, rec.Tag, len(rec.Data))
+		return nil
+	})
+}
+
+// isolate returns cfg changed so that the node neither listens for incoming
+// connections nor connects to any peer other than those it is given, and
+// doesn't overwrite the recording with its own.
+func isolate(cfg config.Local) config.Local {
+	cfg.NetAddress = ""
+	cfg.PublicAddress = ""
+	cfg.DNSBootstrapID = ""
+	cfg.EnableP2P = false
+	cfg.EnableP2PHybridMode = false
+	cfg.EnableGossipService = false
+	cfg.ForceRelayMessages = false
+	cfg.GossipFanout = 1
+	cfg.EnableMessageRecorder = false
+	return cfg
+}
+
+// replay runs the node of the data directory in-process, isolated from the
+// network, and sends it the recorded inbound messages from a replay peer on
+// the loopback interface, the only peer it connects to, so that the node
+// dispatches them to its message handlers as if they were received from a
+// regular peer.
+func replay(ctx context.Context, log logging.Logger, src *messagerecorder.Reader, filter map[protocol.Tag]bool) error {
+	dataDir, err := filepath.Abs(*dataDirectory)
+	if err != nil {
+		return err
+	}
+	// the node must not be running, or it would be connected to its peers
+	fileLock := flock.New(filepath.Join(dataDir, "algod.lock"))
+	locked, err := fileLock.TryLock()
+	if err != nil {
+		return err
+	}
+	if !locked {
+		return fmt.Errorf("failed to lock %s, is algod running in this data directory?", fileLock.Path())
+	}
+	defer fileLock.Unlock()
+
+	genesis, err := bookkeeping.LoadGenesisFromFile(filepath.Join(dataDir, config.GenesisJSONFile))
+	if err != nil {
+		return err
+	}
+	cfg, err := config.LoadConfigFromDisk(dataDir)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	peerConf := config.GetDefaultLocal()
+	peerConf.NetAddress = "127.0.0.1:0"
+	peerConf.DNSBootstrapID = ""
+	peerConf.GossipFanout = 0
+	peer, err := network.NewWebsocketGossipNode(log, peerConf, nil, genesis.ID(), genesis.Network)
+	if err != nil {
+		return err
+	}
+	err = peer.Start()
+	if err != nil {
+		return err
+	}
+	defer peer.Stop()
+	peerAddress, _ := peer.Address()
+
+	// the node logs where algod would
+	liveLog, _ := cfg.ResolveLogPaths(dataDir)
+	nodeLogFile, err := os.OpenFile(liveLog, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return err
+	}
+	defer nodeLogFile.Close()
+	nodeLog := logging.NewLogger()
+	nodeLog.SetOutput(nodeLogFile)
+	nodeLog.SetJSONFormatter()
+	nodeLog.SetLevel(logging.Level(cfg.BaseLoggerDebugLevel))
+
+	n, err := node.MakeFull(nodeLog, dataDir, isolate(cfg), []string{peerAddress}, genesis)
+	if err != nil {
+		return err
+	}
+	err = n.Start()
+	if err != nil {
+		return err
+	}
+	defer n.Stop()
+	log.Infof("replaying to the node of %s, logging to %s", dataDir, nodeLogFile.Name())
+
+	deadline := time.Now().Add(*connectTimeout)
+	for len(peer.GetPeers(network.PeersConnectedIn)) == 0 {
+		if time.Now().After(deadline) {
+			return fmt.Errorf("the node didn't connect to the replay peer %s", peerAddress)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(100 * time.Millisecond):
+		}
+	}
+
+	var replayed, skipped int
+	start := time.Now()
+	err = messagerecorder.Replay(ctx, src, *speed, func(rec messagerecorder.Record) error {
+		if rec.Direction != messagerecorder.Inbound || (filter != nil && !filter[rec.Tag]) {
+			skipped++
+			return nil
+		}
+		replayed++
+		return peer.Broadcast(ctx, rec.Tag, rec.Data, true, nil)
+	})
+	log.Infof("replayed %d messages in %v, skipped %d", replayed, time.Since(start), skipped)
+	if err != nil {
+		return err
+	}
+
+	// keep the node running to let it handle the messages, and to inspect it
+	log.Infof("the node keeps running until interrupted")
+	<-ctx.Done()
+	return nil
+}
+
+func main() {
+	flag.Usage = usage
+	flag.Parse()
+	if flag.NArg() == 0 {
+		usage()
+		os.Exit(1)
+	}
+
+	log := logging.Base()
+	log.SetLevel(logging.Info)
+	log.SetOutput(os.Stderr)
+	deadlock.Opts.Disable = true
+
+	src, closeFiles, err := openRecording(flag.Args())
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Cannot open recording: %v\n", err)
+		os.Exit(1)
+	}
+	defer closeFiles()
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer cancel()
+
+	if *dumpFlag {
+		err = dump(ctx, src, tagFilter())
+	} else {
+		if *dataDirectory == "" {
+			fmt.Fprintf(os.Stderr, "A data directory -d to replay the recording to is required\n")
+			os.Exit(1)
+		}
+		err = replay(ctx, log, src, tagFilter())
+	}
+	if err != nil && !errors.Is(err, context.Canceled) {
+		fmt.Fprintf(os.Stderr, "Replay failed: %v\n", err)
+		os.Exit(1)
+	}
+}
@@ -136,6 +136,11 @@ var (
 	// TransactionMessagesBacklogSize "Number of transaction messages in the TX handler backlog queue"
 	TransactionMessagesBacklogSize = MetricName{Name: "algod_transaction_messages_backlog_size", Description: "Number of transaction messages in the TX handler backlog queue"}
 
+	// TransactionPoolReplacedGroups "Number of pending transaction groups replaced by a higher fee group"
+	TransactionPoolReplacedGroups = MetricName{Name: "algod_tx_pool_replaced_groups", Description: "Number of pending transaction groups replaced by a higher fee group"}
+	// TransactionPoolEvictedGroups "Number of pending transaction groups evicted from the full pool by higher fee groups"
+	TransactionPoolEvictedGroups = MetricName{Name: "algod_tx_pool_evicted_groups", Description: "Number of pending transaction groups evicted from the full pool by higher fee groups"}
+
 	// TransactionMessagesP2PRejectMessage "Number of rejected p2p pubsub transaction messages"
 	TransactionMessagesP2PRejectMessage = MetricName{Name: "algod_transaction_messages_p2p_reject", Description: "Number of rejected p2p pubsub transaction messages"}
 	// TransactionMessagesP2PDuplicateMessage "Number of duplicate p2p pubsub transaction messages"}